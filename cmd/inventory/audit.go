@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/gardener/inventory/pkg/audit"
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+// NewAuditCommand returns a new command for interfacing with the audit log.
+func NewAuditCommand() *cli.Command {
+	cmd := &cli.Command{
+		Name:  "audit",
+		Usage: "audit log operations",
+		Subcommands: []*cli.Command{
+			{
+				Name:    "list",
+				Usage:   "list audit log entries",
+				Aliases: []string{"ls"},
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "action",
+						Usage: "filter by action, e.g. task:enqueue",
+					},
+					&cli.StringFlag{
+						Name:  "identity",
+						Usage: "filter by identity",
+					},
+					&cli.DurationFlag{
+						Name:  "since",
+						Usage: "list entries recorded within the given duration",
+					},
+					&cli.IntFlag{
+						Name:    "limit",
+						Aliases: []string{"l"},
+						Usage:   "max number of entries",
+						Value:   dbutils.DefaultAuditLogLimit,
+					},
+				},
+				Action: execAuditListCmd,
+			},
+		},
+	}
+
+	return cmd
+}
+
+// execAuditListCmd prints the audit log entries.
+func execAuditListCmd(ctx *cli.Context) error {
+	opts := dbutils.AuditLogOptions{
+		Action:   ctx.String("action"),
+		Identity: ctx.String("identity"),
+		Limit:    ctx.Int("limit"),
+	}
+	if since := ctx.Duration("since"); since > 0 {
+		opts.Since = time.Now().Add(-since)
+	}
+
+	conf := getConfig(ctx)
+	db, err := newReadOnlyDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	items, err := dbutils.ListAuditLog(ctx.Context, db, opts)
+	if err != nil {
+		return err
+	}
+
+	if isStructuredOutput(ctx) {
+		return printStructured(ctx, os.Stdout, items)
+	}
+
+	headers := []string{
+		"TIMESTAMP",
+		"IDENTITY",
+		"SOURCE",
+		"ACTION",
+		"PARAMETERS",
+		"ERROR",
+	}
+	table := newTableWriter(os.Stdout, headers)
+	for _, item := range items {
+		params := make([]string, 0, len(item.Parameters))
+		for k, v := range item.Parameters {
+			params = append(params, k+"="+v)
+		}
+		slices.Sort(params)
+
+		row := []string{
+			item.Timestamp.Format(time.RFC3339),
+			item.Identity,
+			item.Source,
+			item.Action,
+			strings.Join(params, " "),
+			item.Error,
+		}
+		if err := table.Append(row); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+// withAudit returns a [cli.ActionFunc], which executes the given action and
+// records it in the audit log, if enabled. The values of the command flags and
+// the command arguments are recorded as parameters of the action.
+func withAudit(action string, fn cli.ActionFunc) cli.ActionFunc {
+	return func(ctx *cli.Context) error {
+		actionErr := fn(ctx)
+
+		conf := getConfig(ctx)
+		if !conf.Audit.IsEnabled {
+			return actionErr
+		}
+
+		params := make(map[string]string)
+		for _, flag := range ctx.Command.Flags {
+			name := flag.Names()[0]
+			if value := ctx.Value(name); value != nil {
+				params[name] = fmt.Sprint(value)
+			}
+		}
+		if ctx.Args().Present() {
+			params["args"] = strings.Join(ctx.Args().Slice(), " ")
+		}
+
+		item := &models.AuditLog{
+			Identity:   audit.GetCLIIdentity(conf.Audit),
+			Source:     audit.SourceCLI,
+			Action:     action,
+			Parameters: params,
+		}
+		if actionErr != nil {
+			item.Error = actionErr.Error()
+		}
+
+		db, err := newDB(conf)
+		if err != nil {
+			return errors.Join(actionErr, fmt.Errorf("cannot record audit log: %w", err))
+		}
+		defer db.Close() // nolint: errcheck
+
+		if err := audit.NewDBRecorder(db).Record(ctx.Context, item); err != nil {
+			return errors.Join(actionErr, fmt.Errorf("cannot record audit log: %w", err))
+		}
+
+		return actionErr
+	}
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/metrics"
+)
+
+// configureProviderClients configures the API clients of the supported
+// providers concurrently. Failure to configure the clients of a provider is
+// logged and reported via the [metrics.ClientConfigurationFailed] metric,
+// without affecting the remaining providers.
+func configureProviderClients(ctx context.Context, conf *config.Config) {
+	providers := map[string]func(context.Context, *config.Config) error{
+		"aws":       configureAWSClients,
+		"gcp":       configureGCPClients,
+		"azure":     configureAzureClients,
+		"openstack": configureOpenStackClients,
+	}
+
+	var wg sync.WaitGroup
+	for provider, configureFunc := range providers {
+		wg.Go(func() {
+			start := time.Now()
+			if err := configureFunc(ctx, conf); err != nil {
+				slog.Error(
+					"failed to configure API clients",
+					"provider", provider,
+					"reason", err,
+				)
+				metrics.ClientConfigurationFailed.WithLabelValues(provider).Set(1)
+
+				return
+			}
+
+			metrics.ClientConfigurationFailed.WithLabelValues(provider).Set(0)
+			slog.Info(
+				"configured API clients",
+				"provider", provider,
+				"duration", time.Since(start),
+			)
+		})
+	}
+
+	wg.Wait()
+}
+
+// configureServiceClients configures the API clients of the enabled services of
+// the given provider. Failing services are reported, but do not prevent
+// configuring the clients of the remaining services.
+func configureServiceClients(
+	ctx context.Context,
+	conf *config.Config,
+	provider string,
+	configFuncs map[string]func(ctx context.Context, conf *config.Config) error,
+	enabled map[string]bool,
+) error {
+	var errs error
+	for svc, configFunc := range configFuncs {
+		if !enabled[svc] {
+			slog.Info(
+				"service is not enabled, will not create API clients",
+				"provider", provider,
+				"service", svc,
+			)
+
+			continue
+		}
+
+		if err := configFunc(ctx, conf); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("unable to configure %s clients for %s: %w", provider, svc, err))
+		}
+	}
+
+	return errs
+}
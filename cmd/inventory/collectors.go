@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	awstasks "github.com/gardener/inventory/pkg/aws/tasks"
+	aztasks "github.com/gardener/inventory/pkg/azure/tasks"
+	"github.com/gardener/inventory/pkg/core/config"
+	gcptasks "github.com/gardener/inventory/pkg/gcp/tasks"
+	openstacktasks "github.com/gardener/inventory/pkg/openstack/tasks"
+)
+
+// enabledCollectors returns a mapping between the names of the collector tasks
+// and whether the provider and service the collector depends on are enabled.
+// Tasks, which are not part of the mapping, are always considered enabled.
+func enabledCollectors(conf *config.Config) map[string]bool {
+	collectors := map[string]bool{
+		awstasks.TaskCollectAll:       conf.AWS.IsEnabled,
+		gcptasks.TaskCollectAll:       conf.GCP.IsEnabled,
+		aztasks.TaskCollectAll:        conf.Azure.IsEnabled,
+		openstacktasks.TaskCollectAll: conf.OpenStack.IsEnabled,
+	}
+
+	addCollectors(collectors, conf.AWS.IsEnabled, awstasks.ServiceCollectors(conf.AWS.Services))
+	addCollectors(collectors, conf.GCP.IsEnabled, gcptasks.ServiceCollectors(conf.GCP.Services))
+	addCollectors(collectors, conf.Azure.IsEnabled, aztasks.ServiceCollectors(conf.Azure.Services))
+	addCollectors(collectors, conf.OpenStack.IsEnabled, openstacktasks.ServiceCollectors(conf.OpenStack.Services))
+
+	return collectors
+}
+
+// addCollectors adds the given service collectors of a provider to the
+// collectors mapping. Collectors of disabled providers are disabled as well.
+func addCollectors(collectors map[string]bool, providerEnabled bool, services map[string]bool) {
+	for name, enabled := range services {
+		collectors[name] = providerEnabled && enabled
+	}
+}
+
+// isCollectorEnabled returns true, if the task with the given name is enabled
+// according to the provider and service configuration.
+func isCollectorEnabled(collectors map[string]bool, name string) bool {
+	enabled, ok := collectors[name]
+	if !ok {
+		return true
+	}
+
+	return enabled
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hibiken/asynq"
+	"github.com/urfave/cli/v2"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// registeredTaskNames returns the sorted names of the registered tasks.
+func registeredTaskNames() []string {
+	names := make([]string, 0, registry.TaskRegistry.Length())
+	_ = registry.TaskRegistry.Range(func(name string, _ asynq.Handler) error {
+		names = append(names, name)
+
+		return nil
+	})
+	slices.Sort(names)
+
+	return names
+}
+
+// registeredModelNames returns the sorted names of the registered models.
+func registeredModelNames() []string {
+	names := make([]string, 0, registry.ModelRegistry.Length())
+	_ = registry.ModelRegistry.Range(func(name string, _ any) error {
+		names = append(names, name)
+
+		return nil
+	})
+	slices.Sort(names)
+
+	return names
+}
+
+// newNameCompleter returns a [cli.BashCompleteFunc], which completes the
+// values of the given flags, and the first positional argument, if positional
+// is true, using the names returned by the given function. Otherwise, the flags
+// and subcommands of the command are completed.
+func newNameCompleter(names func() []string, positional bool, flags ...string) cli.BashCompleteFunc {
+	return func(ctx *cli.Context) {
+		// The argument preceding the completion flag, which is appended
+		// by the completion scripts, as used by the default completion
+		// of urfave/cli.
+		var lastArg string
+		if len(os.Args) > 2 {
+			lastArg = os.Args[len(os.Args)-2]
+		}
+
+		isFlag := strings.HasPrefix(lastArg, "-")
+		switch {
+		case isFlag && slices.Contains(flags, strings.TrimLeft(lastArg, "-")):
+		case !isFlag && positional && ctx.NArg() == 0:
+		default:
+			cli.DefaultCompleteWithFlags(ctx.Command)(ctx)
+
+			return
+		}
+
+		for _, name := range names() {
+			_, _ = fmt.Fprintln(ctx.App.Writer, name)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/robfig/cron/v3"
+	"github.com/urfave/cli/v2"
+
+	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// errConfigValidationFailed is an error, which is returned when at least one of
+// the config validation checks failed.
+var errConfigValidationFailed = errors.New("config validation failed")
+
+// errUnknownQueue is an error, which is returned when a periodic job refers to
+// a queue, which is not processed by the workers.
+var errUnknownQueue = errors.New("unknown queue")
+
+// errUnknownTask is an error, which is returned when a periodic job refers to
+// a task, which is not registered.
+var errUnknownTask = errors.New("unknown task")
+
+// errUnknownModel is an error, which is returned when the configuration refers
+// to a model, which is not registered.
+var errUnknownModel = errors.New("unknown model")
+
+// configValidationResult represents the result of validating a single
+// component of the configuration.
+type configValidationResult struct {
+	// Component is the name of the validated component.
+	Component string `json:"component" yaml:"component"`
+
+	// Status is one of OK, FAILED or SKIPPED.
+	Status string `json:"status" yaml:"status"`
+
+	// Error provides the reason for a failed validation.
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// NewConfigCommand returns a new [cli.Command] for config-related operations.
+func NewConfigCommand() *cli.Command {
+	cmd := &cli.Command{
+		Name:    "config",
+		Usage:   "config operations",
+		Aliases: []string{"c"},
+		Subcommands: []*cli.Command{
+			{
+				Name:  "validate",
+				Usage: "validate the config without starting any services",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "format",
+						Usage: "output format to use (table, json or yaml), overrides the global --output option",
+					},
+				},
+				Action: execConfigValidateCmd,
+			},
+		},
+	}
+
+	return cmd
+}
+
+// execConfigValidateCmd validates the config and prints the results.
+func execConfigValidateCmd(ctx *cli.Context) error {
+	if ctx.IsSet("format") {
+		format := ctx.String("format")
+		if err := validateOutputFormat(format); err != nil {
+			return err
+		}
+		if err := ctx.Set("output", format); err != nil {
+			return err
+		}
+	}
+
+	conf := getConfig(ctx)
+	validators := []struct {
+		component string
+		isEnabled bool
+		validate  func(conf *config.Config) error
+	}{
+		{"aws", conf.AWS.IsEnabled, validateAWSConfig},
+		{"azure", conf.Azure.IsEnabled, validateAzureConfig},
+		{"gcp", conf.GCP.IsEnabled, validateGCPConfig},
+		{"openstack", conf.OpenStack.IsEnabled, validateOpenStackConfig},
+		{"gardener", conf.Gardener.IsEnabled, validateGardenerConfig},
+		{"dashboard", true, validateDashboardConfig},
+		{"scheduler", true, validateSchedulerConfig},
+		{"replay", conf.Replay.Mode != "", validateReplayConfig},
+		{"events", conf.Events.IsEnabled, validateEventsConfig},
+		{"servicenow", conf.ServiceNow.IsEnabled, validateServiceNowConfig},
+		{"queries", conf.Queries.IsEnabled, validateQueriesConfig},
+	}
+
+	results := make([]configValidationResult, 0, len(validators))
+	for _, v := range validators {
+		result := configValidationResult{
+			Component: v.component,
+			Status:    statusOK,
+		}
+
+		if !v.isEnabled {
+			result.Status = statusSkipped
+		} else if err := v.validate(conf); err != nil {
+			result.Status = statusFailed
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	headers := []string{"COMPONENT", "STATUS", "ERROR"}
+	table := newOutputWriter(ctx, os.Stdout, headers)
+	for _, r := range results {
+		if err := table.Append([]string{r.Component, r.Status, r.Error}); err != nil {
+			return err
+		}
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	for _, r := range results {
+		if r.Status == statusFailed {
+			return errConfigValidationFailed
+		}
+	}
+
+	return nil
+}
+
+// validateSchedulerConfig validates the scheduler configuration by parsing the
+// cron specs of the periodic jobs, and verifying that the jobs and routes
+// refer to registered tasks and to queues, which are processed by the workers.
+func validateSchedulerConfig(conf *config.Config) error {
+	queues := conf.Worker.ProcessedQueues()
+
+	defaultQueue := conf.Scheduler.DefaultQueue
+	if defaultQueue == "" {
+		defaultQueue = config.DefaultQueueName
+	}
+
+	if _, ok := queues[defaultQueue]; !ok {
+		return fmt.Errorf("%w: default queue %s", errUnknownQueue, defaultQueue)
+	}
+
+	// Tasks routed to a queue, which is not processed by the workers,
+	// would never be processed.
+	for _, route := range conf.Routes {
+		if _, ok := queues[route.Label]; !ok {
+			return fmt.Errorf("%w: route %s refers to %s", errUnknownQueue, route.Pattern, route.Label)
+		}
+	}
+
+	leConf := conf.Scheduler.LeaderElection
+	if leConf.IsEnabled {
+		backends := []string{
+			config.LeaderElectionBackendRedis,
+			config.LeaderElectionBackendKubernetes,
+		}
+		if !slices.Contains(backends, leConf.Backend) {
+			return fmt.Errorf("%w: %s", errUnknownLeaderElectionBackend, leConf.Backend)
+		}
+	}
+
+	for _, job := range conf.Scheduler.Jobs {
+		if _, err := cron.ParseStandard(job.Spec); err != nil {
+			return fmt.Errorf("invalid spec %q for job %s: %w", job.Spec, job.Name, err)
+		}
+
+		if _, ok := registry.TaskRegistry.Get(job.Name); !ok {
+			return fmt.Errorf("%w: %s", errUnknownTask, job.Name)
+		}
+
+		if job.Queue == "" {
+			continue
+		}
+
+		if _, ok := queues[job.Queue]; !ok {
+			return fmt.Errorf("%w: job %s refers to %s", errUnknownQueue, job.Name, job.Queue)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,322 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	resourcemanager "cloud.google.com/go/resourcemanager/apiv3"
+	"cloud.google.com/go/resourcemanager/apiv3/resourcemanagerpb"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	gardenerversioned "github.com/gardener/gardener/pkg/client/core/clientset/versioned"
+	"github.com/urfave/cli/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openstackclients "github.com/gardener/inventory/pkg/clients/openstack"
+	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/utils/ptr"
+	"github.com/gardener/inventory/pkg/version"
+)
+
+// errCredentialsCheckFailed is an error, which is returned when at least one of
+// the named credentials failed the check.
+var errCredentialsCheckFailed = errors.New("credentials check failed")
+
+// credentialsCheckResult represents the result of checking a single named
+// credential.
+type credentialsCheckResult struct {
+	// Provider is the name of the provider.
+	Provider string `json:"provider" yaml:"provider"`
+
+	// Credentials is the name of the named credentials.
+	Credentials string `json:"credentials" yaml:"credentials"`
+
+	// Status is either OK or FAILED.
+	Status string `json:"status" yaml:"status"`
+
+	// Reason provides details about the identity used by the credentials,
+	// or the reason for a failure.
+	Reason string `json:"reason" yaml:"reason"`
+}
+
+// credentialsCheckFunc performs a cheap authenticated API call using the given
+// named credentials. On success it returns details about the authenticated
+// identity.
+type credentialsCheckFunc func(ctx context.Context, conf *config.Config, name string) (string, error)
+
+// NewCredentialsCommand returns a new [cli.Command] for operations on the
+// configured named credentials.
+func NewCredentialsCommand() *cli.Command {
+	cmd := &cli.Command{
+		Name:    "credentials",
+		Usage:   "named credentials operations",
+		Aliases: []string{"creds"},
+		Subcommands: []*cli.Command{
+			{
+				Name:  "check",
+				Usage: "check that the configured named credentials can authenticate",
+				Flags: []cli.Flag{
+					&cli.StringSliceFlag{
+						Name:    "provider",
+						Usage:   "only check credentials of the given provider",
+						Aliases: []string{"p"},
+					},
+					&cli.DurationFlag{
+						Name:  "timeout",
+						Usage: "timeout for checking a single named credential",
+						Value: 30 * time.Second,
+					},
+				},
+				Action: execCredentialsCheckCmd,
+			},
+		},
+	}
+
+	return cmd
+}
+
+// execCredentialsCheckCmd checks each configured named credential of the
+// enabled providers and prints the results.
+func execCredentialsCheckCmd(ctx *cli.Context) error {
+	conf := getConfig(ctx)
+	providers := splitFlagValues(ctx.StringSlice("provider"))
+	timeout := ctx.Duration("timeout")
+
+	// OpenStack credentials may be read from Vault secrets.
+	if conf.OpenStack.IsEnabled {
+		if err := configureVaultClients(ctx.Context, conf); err != nil {
+			return err
+		}
+	}
+
+	type providerChecks struct {
+		provider  string
+		isEnabled bool
+		names     []string
+		checkFunc credentialsCheckFunc
+	}
+
+	items := []providerChecks{
+		{"aws", conf.AWS.IsEnabled, sortedKeys(conf.AWS.Credentials), checkAWSCredentials},
+		{"azure", conf.Azure.IsEnabled, sortedKeys(conf.Azure.Credentials), checkAzureCredentials},
+		{"gcp", conf.GCP.IsEnabled, sortedKeys(conf.GCP.Credentials), checkGCPCredentials},
+		{"openstack", conf.OpenStack.IsEnabled, sortedKeys(conf.OpenStack.Credentials), checkOpenStackCredentials},
+		{"gardener", conf.Gardener.IsEnabled, getGardenerLandscapeNames(conf), checkGardenerCredentials},
+	}
+
+	results := make([]credentialsCheckResult, 0)
+	for _, item := range items {
+		if !item.isEnabled {
+			continue
+		}
+		if len(providers) > 0 && !slices.Contains(providers, item.provider) {
+			continue
+		}
+
+		for _, name := range item.names {
+			checkCtx, cancel := context.WithTimeout(ctx.Context, timeout)
+			reason, err := item.checkFunc(checkCtx, conf, name)
+			cancel()
+
+			result := credentialsCheckResult{
+				Provider:    item.provider,
+				Credentials: name,
+				Status:      statusOK,
+				Reason:      reason,
+			}
+			if err != nil {
+				result.Status = statusFailed
+				result.Reason = err.Error()
+			}
+			results = append(results, result)
+		}
+	}
+
+	failed := slices.ContainsFunc(results, func(r credentialsCheckResult) bool {
+		return r.Status == statusFailed
+	})
+
+	if isStructuredOutput(ctx) {
+		if err := printStructured(ctx, os.Stdout, results); err != nil {
+			return err
+		}
+	} else {
+		headers := []string{"PROVIDER", "CREDENTIALS", "STATUS", "REASON"}
+		table := newTableWriter(os.Stdout, headers)
+		for _, r := range results {
+			if err := table.Append([]string{r.Provider, r.Credentials, r.Status, r.Reason}); err != nil {
+				return err
+			}
+		}
+		if err := table.Render(); err != nil {
+			return err
+		}
+	}
+
+	if failed {
+		return errCredentialsCheckFailed
+	}
+
+	return nil
+}
+
+// sortedKeys returns the sorted keys of the given map.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// checkAWSCredentials checks the given AWS named credentials by getting the
+// caller identity.
+func checkAWSCredentials(ctx context.Context, conf *config.Config, name string) (string, error) {
+	awsConf, err := loadAWSConfig(ctx, conf, name)
+	if err != nil {
+		return "", err
+	}
+
+	stsClient := sts.NewFromConfig(awsConf)
+	callerIdentity, err := stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", err
+	}
+
+	reason := fmt.Sprintf(
+		"account %s, arn %s",
+		ptr.StringFromPointer(callerIdentity.Account),
+		ptr.StringFromPointer(callerIdentity.Arn),
+	)
+
+	return reason, nil
+}
+
+// checkAzureCredentials checks the given Azure named credentials by listing
+// the subscriptions, which the credentials have access to.
+func checkAzureCredentials(ctx context.Context, conf *config.Config, name string) (string, error) {
+	tokenProvider, err := getAzureTokenProvider(conf, name)
+	if err != nil {
+		return "", err
+	}
+
+	subscriptions, err := getAzureSubscriptions(ctx, conf, tokenProvider)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d subscription(s)", len(subscriptions)), nil
+}
+
+// checkGCPCredentials checks the given GCP named credentials by getting each
+// of the projects associated with the credentials.
+func checkGCPCredentials(ctx context.Context, conf *config.Config, name string) (string, error) {
+	if conf.GCP.UserAgent == "" {
+		conf.GCP.UserAgent = fmt.Sprintf("gardener-inventory/%s", version.Version)
+	}
+
+	opts, err := getGCPClientOptions(ctx, conf, name)
+	if err != nil {
+		return "", err
+	}
+
+	client, err := resourcemanager.NewProjectsRESTClient(ctx, opts...)
+	if err != nil {
+		return "", err
+	}
+	defer client.Close() // nolint: errcheck
+
+	projects := conf.GCP.Credentials[name].Projects
+	for _, project := range projects {
+		req := &resourcemanagerpb.GetProjectRequest{
+			Name: fmt.Sprintf("projects/%s", project),
+		}
+		if _, err := client.GetProject(ctx, req); err != nil {
+			return "", fmt.Errorf("project %s: %w", project, err)
+		}
+	}
+
+	return fmt.Sprintf("%d project(s)", len(projects)), nil
+}
+
+// checkOpenStackCredentials checks the given OpenStack named credentials by
+// issuing a token and looking up the project.
+func checkOpenStackCredentials(ctx context.Context, conf *config.Config, name string) (string, error) {
+	creds := conf.OpenStack.Credentials[name]
+	providerClient, err := newOpenStackProviderClient(ctx, conf, &creds)
+	if err != nil {
+		return "", err
+	}
+
+	clientScope := openstackclients.ClientScope{
+		NamedCredentials: name,
+		Project:          creds.Project,
+		Domain:           creds.Domain,
+		Region:           creds.Region,
+	}
+	projectID, err := getProjectIDForClient(ctx, providerClient, clientScope)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("project %s (%s)", creds.Project, projectID), nil
+}
+
+// getGardenerLandscapeNames returns the names of the configured Gardener
+// landscapes, which are checked by [checkGardenerCredentials]. The primary
+// landscape is named after its authentication method, if no landscape name has
+// been configured.
+func getGardenerLandscapeNames(conf *config.Config) []string {
+	primary := conf.Gardener.Landscape
+	if primary == "" {
+		primary = conf.Gardener.Authentication
+	}
+
+	names := []string{primary}
+	for _, landscape := range conf.Gardener.Landscapes {
+		names = append(names, landscape.Name)
+	}
+
+	return names
+}
+
+// checkGardenerCredentials checks the Gardener API credentials of the given
+// landscape by listing projects.
+func checkGardenerCredentials(ctx context.Context, conf *config.Config, name string) (string, error) {
+	if err := validateGardenerConfig(conf); err != nil {
+		return "", err
+	}
+
+	landscape := getPrimaryGardenerLandscape(conf)
+	for _, item := range conf.Gardener.Landscapes {
+		if item.Name == name {
+			landscape = item
+		}
+	}
+
+	restConfig, err := getGardenerRestConfig(landscape)
+	if err != nil {
+		return "", err
+	}
+	restConfig.UserAgent = conf.Gardener.UserAgent
+
+	client, err := gardenerversioned.NewForConfig(restConfig)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := client.CoreV1beta1().Projects().List(ctx, metav1.ListOptions{Limit: 1}); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("host %s", restConfig.Host), nil
+}
@@ -5,8 +5,11 @@
 package main
 
 import (
+	"encoding/json"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/hibiken/asynq/x/metrics"
@@ -16,7 +19,13 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/urfave/cli/v2"
 
+	"github.com/gardener/inventory/pkg/api"
+	"github.com/gardener/inventory/pkg/audit"
 	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/core/supervisor"
+	"github.com/gardener/inventory/pkg/pages"
+	"github.com/gardener/inventory/pkg/scaler"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
 )
 
 // NewDashboardCommand returns a new command for interfacing with the dashboard.
@@ -46,8 +55,14 @@ func NewDashboardCommand() *cli.Command {
 				Aliases: []string{"s"},
 				Action: func(ctx *cli.Context) error {
 					conf := getConfig(ctx)
-					redisClientOpt := newRedisClientOpt(conf)
-					inspector := newInspector(conf)
+					redisClientOpt, err := newRedisClientOpt(conf)
+					if err != nil {
+						return err
+					}
+					inspector, err := newInspector(conf)
+					if err != nil {
+						return err
+					}
 					defer inspector.Close() // nolint: errcheck
 
 					// Asynq UI
@@ -69,19 +84,119 @@ func NewDashboardCommand() *cli.Command {
 						collectors.NewGoCollector(),
 					)
 
+					sup := supervisor.New()
+
 					mux := http.NewServeMux()
 					mux.Handle("/", ui)
 					mux.Handle("/metrics", promhttp.HandlerFor(promRegistry, promhttp.HandlerOpts{}))
+					mux.Handle("/healthz", sup.HealthHandler())
+
+					// Queue backlog for autoscaling the workers
+					if conf.Dashboard.Scaler {
+						mux.Handle(scaler.Prefix, scaler.NewHandler(inspector))
+					}
+
+					// API
+					if conf.Dashboard.API {
+						db, err := newReadOnlyDB(conf)
+						if err != nil {
+							return err
+						}
+						defer db.Close() // nolint: errcheck
+
+						// Tasks may be enqueued by authenticated
+						// requests only
+						var apiHandler http.Handler
+						if conf.Dashboard.APIAuth {
+							client, err := newAsynqClient(conf)
+							if err != nil {
+								return err
+							}
+							defer client.Close() // nolint: errcheck
+
+							// Ingested records are written to the
+							// primary database
+							opts := make([]api.Option, 0)
+							if conf.Dashboard.APIIngest {
+								ingestDB, err := newDB(conf)
+								if err != nil {
+									return err
+								}
+								defer ingestDB.Close() // nolint: errcheck
+								opts = append(opts, api.WithIngestDB(ingestDB))
+							}
+
+							// Named queries are run against the
+							// read-only database
+							if conf.Queries.IsEnabled {
+								queries, err := loadNamedQueries(conf)
+								if err != nil {
+									return err
+								}
+								queryOpts := dbutils.QueryOptions{
+									Timeout: conf.Queries.StatementTimeout,
+									MaxRows: conf.Queries.MaxRows,
+								}
+								opts = append(opts, api.WithQueries(queries, queryOpts))
+							}
+							apiHandler = api.NewAuthHandler(api.NewDBTokenStore(db), api.NewHandler(db, client, opts...))
+						} else {
+							apiHandler = api.NewHandler(db, nil)
+						}
+						mux.Handle(api.Prefix, apiHandler)
+					}
+
+					// Shoot and provider account pages
+					if conf.Dashboard.Pages {
+						db, err := newReadOnlyDB(conf)
+						if err != nil {
+							return err
+						}
+						defer db.Close() // nolint: errcheck
+						pagesHandler := pages.NewHandler(db)
+						mux.Handle(pages.ShootsPrefix, pagesHandler)
+						mux.Handle(pages.AccountsPrefix, pagesHandler)
+					}
+
+					// Audit log of mutating requests
+					var handler http.Handler = mux
+					if conf.Audit.IsEnabled {
+						db, err := newDB(conf)
+						if err != nil {
+							return err
+						}
+						defer db.Close() // nolint: errcheck
+						handler = audit.NewHandler(audit.NewDBRecorder(db), conf.Audit.IdentityHeader, mux)
+					}
 
 					srv := &http.Server{
 						Addr:              conf.Dashboard.Address,
 						ReadHeaderTimeout: time.Second * 30,
-						Handler:           mux,
+						Handler:           handler,
 					}
 
-					slog.Info("starting server", "address", conf.Dashboard.Address, "ui", "/", "metrics", "/metrics")
+					slog.Info("starting server", "address", conf.Dashboard.Address, "ui", "/", "metrics", "/metrics", "health", "/healthz", "api", conf.Dashboard.API, "api_auth", conf.Dashboard.APIAuth, "api_ingest", conf.Dashboard.APIIngest, "api_queries", conf.Dashboard.APIAuth && conf.Queries.IsEnabled, "pages", conf.Dashboard.Pages, "audit", conf.Audit.IsEnabled)
+					sup.Add(supervisor.HTTPServerComponent("dashboard-server", srv))
+
+					return sup.Run(ctx.Context)
+				},
+			},
+			{
+				Name:  "openapi",
+				Usage: "print the OpenAPI document of the api",
+				Action: func(ctx *cli.Context) error {
+					doc := api.OpenAPIDocument()
+					if isStructuredOutput(ctx) {
+						return printStructured(ctx, os.Stdout, doc)
+					}
+
+					data, err := json.MarshalIndent(doc, "", "  ")
+					if err != nil {
+						return err
+					}
+					fmt.Println(string(data))
 
-					return srv.ListenAndServe()
+					return nil
 				},
 			},
 		},
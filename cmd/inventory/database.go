@@ -9,14 +9,22 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/olekukonko/tablewriter"
+	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/migrate"
 	"github.com/urfave/cli/v2"
+
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
 )
 
+// errSchemaDrift is an error, which is returned when the database schema is
+// missing tables, columns or indexes of the registered models.
+var errSchemaDrift = errors.New("database schema drift detected")
+
 // NewDatabaseCommand returns a new command for interfacing with the database.
 func NewDatabaseCommand() *cli.Command {
 	cmd := &cli.Command{
@@ -28,31 +36,31 @@ func NewDatabaseCommand() *cli.Command {
 				Name:    "init",
 				Usage:   "initialize migration tables",
 				Aliases: []string{"i"},
-				Action:  execDatabaseInitCmd,
+				Action:  withAudit("database:init", execDatabaseInitCmd),
 			},
 			{
 				Name:    "migrate",
 				Usage:   "apply pending migrations",
 				Aliases: []string{"m"},
-				Action:  execDatabaseMigrateCmd,
+				Action:  withAudit("database:migrate", execDatabaseMigrateCmd),
 			},
 			{
 				Name:    "rollback",
 				Usage:   "rollback last migration group",
 				Aliases: []string{"r"},
-				Action:  execDatabaseRollbackCmd,
+				Action:  withAudit("database:rollback", execDatabaseRollbackCmd),
 			},
 			{
 				Name:    "lock",
 				Usage:   "lock migrations",
 				Aliases: []string{"l"},
-				Action:  execDatabaseLockCmd,
+				Action:  withAudit("database:lock", execDatabaseLockCmd),
 			},
 			{
 				Name:    "unlock",
 				Usage:   "unlock migrations",
 				Aliases: []string{"u"},
-				Action:  execDatabaseUnlockCmd,
+				Action:  withAudit("database:unlock", execDatabaseUnlockCmd),
 			},
 			{
 				Name:    "create",
@@ -60,6 +68,12 @@ func NewDatabaseCommand() *cli.Command {
 				Aliases: []string{"c"},
 				Action:  execDatabaseCreateMigrationCmd,
 			},
+			{
+				Name:      "new-migration",
+				Usage:     "generate a new migration from the registered models",
+				ArgsUsage: "<description>",
+				Action:    execDatabaseNewMigrationCmd,
+			},
 			{
 				Name:    "status",
 				Usage:   "display migration status",
@@ -78,6 +92,142 @@ func NewDatabaseCommand() *cli.Command {
 				Aliases: []string{"p"},
 				Action:  execDatabasePendingCmd,
 			},
+			{
+				Name:    "partition",
+				Usage:   "manage table partitions",
+				Aliases: []string{"part"},
+				Subcommands: []*cli.Command{
+					{
+						Name:    "list",
+						Usage:   "list the partitions of a table",
+						Aliases: []string{"ls"},
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "table",
+								Usage:    "name of the partitioned table",
+								Required: true,
+							},
+						},
+						Action: execDatabasePartitionListCmd,
+					},
+					{
+						Name:  "convert",
+						Usage: "convert an existing table into a partitioned one",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "table",
+								Usage:    "name of the table to convert",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "strategy",
+								Usage: "partitioning strategy to use (list or range)",
+								Value: dbutils.PartitionStrategyList,
+							},
+							&cli.StringFlag{
+								Name:     "column",
+								Usage:    "column to partition the table by",
+								Required: true,
+							},
+						},
+						Action: withAudit("database:partition:convert", execDatabasePartitionConvertCmd),
+					},
+					{
+						Name:  "create",
+						Usage: "create a new list partition",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "table",
+								Usage:    "name of the partitioned table",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "name",
+								Usage:    "name of the partition",
+								Required: true,
+							},
+							&cli.StringSliceFlag{
+								Name:     "value",
+								Usage:    "value of the partition column to include",
+								Required: true,
+							},
+						},
+						Action: withAudit("database:partition:create", execDatabasePartitionCreateCmd),
+					},
+					{
+						Name:  "create-monthly",
+						Usage: "create monthly range partitions",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "table",
+								Usage:    "name of the partitioned table",
+								Required: true,
+							},
+							&cli.IntFlag{
+								Name:  "months",
+								Usage: "number of months to create partitions for, starting with the current one",
+								Value: 3,
+							},
+						},
+						Action: withAudit("database:partition:create-monthly", execDatabasePartitionCreateMonthlyCmd),
+					},
+					{
+						Name:  "detach",
+						Usage: "detach a partition from a table",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "table",
+								Usage:    "name of the partitioned table",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "name",
+								Usage:    "name of the partition",
+								Required: true,
+							},
+						},
+						Action: withAudit("database:partition:detach", execDatabasePartitionDetachCmd),
+					},
+					{
+						Name:  "prune-monthly",
+						Usage: "detach or drop monthly range partitions older than the retention",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "table",
+								Usage:    "name of the partitioned table",
+								Required: true,
+							},
+							&cli.IntFlag{
+								Name:  "keep",
+								Usage: "number of months to keep, including the current one",
+								Value: 3,
+							},
+							&cli.BoolFlag{
+								Name:  "drop",
+								Usage: "drop the expired partitions along with their records, instead of detaching them",
+							},
+						},
+						Action: withAudit("database:partition:prune-monthly", execDatabasePartitionPruneMonthlyCmd),
+					},
+				},
+			},
+			{
+				Name:   "diff",
+				Usage:  "compare the registered models against the database schema",
+				Action: execDatabaseDiffCmd,
+			},
+			{
+				Name:  "fixtures",
+				Usage: "manage development fixtures",
+				Subcommands: []*cli.Command{
+					{
+						Name:      "load",
+						Usage:     "load YAML/JSON fixtures into the registered models",
+						ArgsUsage: "<dir>",
+						Action:    withAudit("database:fixtures:load", execDatabaseFixturesLoadCmd),
+					},
+				},
+			},
 		},
 	}
 
@@ -86,7 +236,7 @@ func NewDatabaseCommand() *cli.Command {
 
 // tabulateMigrations adds the given migration items to a table and returns it.
 // The returned table can be further customized, if needed, and rendered.
-func tabulateMigrations(items migrate.MigrationSlice) (*tablewriter.Table, error) {
+func tabulateMigrations(ctx *cli.Context, items migrate.MigrationSlice) (tableWriter, error) {
 	headers := []string{
 		"ID",
 		"NAME",
@@ -94,7 +244,7 @@ func tabulateMigrations(items migrate.MigrationSlice) (*tablewriter.Table, error
 		"GROUP-ID",
 		"MIGRATED-AT",
 	}
-	table := newTableWriter(os.Stdout, headers)
+	table := newOutputWriter(ctx, os.Stdout, headers)
 
 	for _, item := range items {
 		id := na
@@ -278,6 +428,15 @@ func execDatabaseCreateMigrationCmd(ctx *cli.Context) error {
 		return err
 	}
 
+	if isStructuredOutput(ctx) {
+		paths := make([]string, 0, len(files))
+		for _, item := range files {
+			paths = append(paths, item.Path)
+		}
+
+		return printStructured(ctx, os.Stdout, paths)
+	}
+
 	for _, item := range files {
 		fmt.Println(item.Path)
 	}
@@ -285,6 +444,77 @@ func execDatabaseCreateMigrationCmd(ctx *cli.Context) error {
 	return nil
 }
 
+// execDatabaseNewMigrationCmd generates a new migration, which creates the
+// tables, columns and indexes of the registered models missing in the
+// database.
+func execDatabaseNewMigrationCmd(ctx *cli.Context) error {
+	name := strings.Join(ctx.Args().Slice(), "_")
+	if name == "" {
+		return errors.New("must specify migration description")
+	}
+
+	conf := getConfig(ctx)
+	db, err := newDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	migration, err := dbutils.GenerateMigration(ctx.Context, db)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range migration.Skipped {
+		slog.Warn(
+			"no statements generated for schema drift",
+			"model", item.Model,
+			"table", item.Table,
+			"kind", item.Kind,
+			"name", item.Name,
+		)
+	}
+
+	if migration.IsEmpty() {
+		slog.Info("database schema is up to date")
+
+		return nil
+	}
+
+	migrator, err := newMigrator(conf, db)
+	if err != nil {
+		return err
+	}
+
+	files, err := migrator.CreateTxSQLMigrations(ctx.Context, name)
+	if err != nil {
+		return err
+	}
+
+	up, down := files[0], files[1]
+	contents := map[string][]string{
+		up.Path:   migration.Up,
+		down.Path: migration.Down,
+	}
+	for path, statements := range contents {
+		data := strings.Join(statements, "\n\n") + "\n"
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil { // nolint: gosec
+			return err
+		}
+	}
+
+	paths := []string{up.Path, down.Path}
+	if isStructuredOutput(ctx) {
+		return printStructured(ctx, os.Stdout, paths)
+	}
+
+	for _, path := range paths {
+		fmt.Println(path)
+	}
+
+	return nil
+}
+
 // execDatabaseStatusCmd runs the database migration status command.
 func execDatabaseStatusCmd(ctx *cli.Context) error {
 	conf := getConfig(ctx)
@@ -306,6 +536,16 @@ func execDatabaseStatusCmd(ctx *cli.Context) error {
 	pending := ms.Unapplied()
 	group := ms.LastGroup()
 
+	if isStructuredOutput(ctx) {
+		status := map[string]any{
+			"pending":    len(pending),
+			"version":    group.String(),
+			"up_to_date": len(pending) == 0,
+		}
+
+		return printStructured(ctx, os.Stdout, status)
+	}
+
 	fmt.Printf("pending migration(s): %d\n", len(pending))
 	fmt.Printf("database version: %s\n", group)
 
@@ -337,11 +577,11 @@ func execDatabaseAppliedCmd(ctx *cli.Context) error {
 	}
 
 	items := ms.Applied()
-	if len(items) == 0 {
+	if len(items) == 0 && !isStructuredOutput(ctx) {
 		return nil
 	}
 
-	table, err := tabulateMigrations(items)
+	table, err := tabulateMigrations(ctx, items)
 	if err != nil {
 		return err
 	}
@@ -368,14 +608,303 @@ func execDatabasePendingCmd(ctx *cli.Context) error {
 	}
 
 	items := ms.Unapplied()
-	if len(items) == 0 {
+	if len(items) == 0 && !isStructuredOutput(ctx) {
 		return nil
 	}
 
-	table, err := tabulateMigrations(items)
+	table, err := tabulateMigrations(ctx, items)
 	if err != nil {
 		return err
 	}
 
 	return table.Render()
 }
+
+// execDatabasePartitionListCmd displays the partitions of a table.
+func execDatabasePartitionListCmd(ctx *cli.Context) error {
+	conf := getConfig(ctx)
+	db, err := newDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	items, err := dbutils.ListPartitions(ctx.Context, db, ctx.String("table"))
+	if err != nil {
+		return err
+	}
+
+	if len(items) == 0 && !isStructuredOutput(ctx) {
+		return nil
+	}
+
+	headers := []string{
+		"NAME",
+		"BOUND",
+		"ESTIMATED-ROWS",
+	}
+	table := newOutputWriter(ctx, os.Stdout, headers)
+	for _, item := range items {
+		row := []string{
+			item.Name,
+			item.Bound,
+			strconv.FormatInt(item.EstimatedRows, 10),
+		}
+		if err := table.Append(row); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+// execDatabasePartitionConvertCmd converts a table into a partitioned one.
+func execDatabasePartitionConvertCmd(ctx *cli.Context) error {
+	conf := getConfig(ctx)
+	db, err := newDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	tableName := ctx.String("table")
+	err = dbutils.ConvertToPartitioned(
+		ctx.Context,
+		db,
+		tableName,
+		ctx.String("strategy"),
+		ctx.String("column"),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("converted %s to a partitioned table\n", tableName)
+
+	return nil
+}
+
+// execDatabasePartitionCreateCmd creates a new list partition.
+func execDatabasePartitionCreateCmd(ctx *cli.Context) error {
+	conf := getConfig(ctx)
+	db, err := newDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	name := ctx.String("name")
+	err = dbutils.CreatePartition(
+		ctx.Context,
+		db,
+		ctx.String("table"),
+		name,
+		"FOR VALUES IN (?)",
+		bun.In(ctx.StringSlice("value")),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("created partition %s\n", name)
+
+	return nil
+}
+
+// execDatabasePartitionCreateMonthlyCmd creates monthly range partitions,
+// starting with the current month.
+func execDatabasePartitionCreateMonthlyCmd(ctx *cli.Context) error {
+	conf := getConfig(ctx)
+	db, err := newDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	tableName := ctx.String("table")
+	months := ctx.Int("months")
+	if months <= 0 {
+		return fmt.Errorf("invalid number of months %d", months)
+	}
+
+	existing, err := dbutils.ListPartitions(ctx.Context, db, tableName)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range dbutils.MonthlyPartitions(tableName, time.Now(), months) {
+		exists := slices.ContainsFunc(existing, func(p dbutils.Partition) bool {
+			return p.Name == item.Name
+		})
+		if exists {
+			continue
+		}
+
+		err := dbutils.CreatePartition(
+			ctx.Context,
+			db,
+			tableName,
+			item.Name,
+			"FOR VALUES FROM (?) TO (?)",
+			item.From,
+			item.To,
+		)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("created partition %s\n", item.Name)
+	}
+
+	return nil
+}
+
+// execDatabasePartitionPruneMonthlyCmd detaches or drops the monthly range
+// partitions, which are older than the number of months to keep.
+func execDatabasePartitionPruneMonthlyCmd(ctx *cli.Context) error {
+	conf := getConfig(ctx)
+	db, err := newDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	tableName := ctx.String("table")
+	keep := ctx.Int("keep")
+	if keep <= 0 {
+		return fmt.Errorf("invalid number of months to keep %d", keep)
+	}
+
+	existing, err := dbutils.ListPartitions(ctx.Context, db, tableName)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range dbutils.ExpiredMonthlyPartitions(tableName, existing, time.Now(), keep) {
+		if ctx.Bool("drop") {
+			if err := dbutils.DropPartition(ctx.Context, db, tableName, item.Name); err != nil {
+				return err
+			}
+			fmt.Printf("dropped partition %s\n", item.Name)
+
+			continue
+		}
+
+		if err := dbutils.DetachPartition(ctx.Context, db, tableName, item.Name); err != nil {
+			return err
+		}
+		fmt.Printf("detached partition %s\n", item.Name)
+	}
+
+	return nil
+}
+
+// execDatabasePartitionDetachCmd detaches a partition from a table.
+func execDatabasePartitionDetachCmd(ctx *cli.Context) error {
+	conf := getConfig(ctx)
+	db, err := newDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	name := ctx.String("name")
+	if err := dbutils.DetachPartition(ctx.Context, db, ctx.String("table"), name); err != nil {
+		return err
+	}
+
+	fmt.Printf("detached partition %s\n", name)
+
+	return nil
+}
+
+// execDatabaseFixturesLoadCmd loads the fixtures from the given directory into
+// the database.
+func execDatabaseFixturesLoadCmd(ctx *cli.Context) error {
+	dir := ctx.Args().First()
+	if dir == "" {
+		return errors.New("no fixtures directory specified")
+	}
+
+	conf := getConfig(ctx)
+	db, err := newDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	items, err := dbutils.LoadFixtures(ctx.Context, db, dir)
+	if err != nil {
+		return err
+	}
+
+	headers := []string{
+		"FILE",
+		"MODEL",
+		"RECORDS",
+		"INSERTED",
+	}
+	table := newOutputWriter(ctx, os.Stdout, headers)
+	for _, item := range items {
+		row := []string{
+			item.File,
+			item.Model,
+			strconv.Itoa(item.Records),
+			strconv.FormatInt(item.Inserted, 10),
+		}
+		if err := table.Append(row); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+// execDatabaseDiffCmd compares the registered models against the database
+// schema and reports the differences. It returns an error, if tables, columns
+// or indexes of the models are missing in the database.
+func execDatabaseDiffCmd(ctx *cli.Context) error {
+	conf := getConfig(ctx)
+	db, err := newReadOnlyDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	items, err := dbutils.DiffSchema(ctx.Context, db)
+	if err != nil {
+		return err
+	}
+
+	missing := 0
+	headers := []string{
+		"MODEL",
+		"TABLE",
+		"KIND",
+		"NAME",
+	}
+	table := newOutputWriter(ctx, os.Stdout, headers)
+	for _, item := range items {
+		if item.IsMissing() {
+			missing++
+		}
+		row := []string{
+			item.Model,
+			item.Table,
+			item.Kind,
+			item.Name,
+		}
+		if err := table.Append(row); err != nil {
+			return err
+		}
+	}
+
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if missing > 0 {
+		return fmt.Errorf("%w: %d issue(s)", errSchemaDrift, missing)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"errors"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+// errNoIPQuery is an error, which is returned when no IP address or CIDR was
+// specified.
+var errNoIPQuery = errors.New("must specify ip address or cidr")
+
+// NewIPCommand returns a new command for looking up IP addresses.
+func NewIPCommand() *cli.Command {
+	cmd := &cli.Command{
+		Name:  "ip",
+		Usage: "ip address operations",
+		Subcommands: []*cli.Command{
+			{
+				Name:      "lookup",
+				Usage:     "find the resources using an ip address or cidr",
+				Aliases:   []string{"l"},
+				ArgsUsage: "<address|cidr>",
+				Action:    execIPLookupCmd,
+			},
+		},
+	}
+
+	return cmd
+}
+
+// execIPLookupCmd searches the collected resources for the given IP address or
+// CIDR and prints where the address is used and by which shoot.
+func execIPLookupCmd(ctx *cli.Context) error {
+	if ctx.NArg() == 0 {
+		return errNoIPQuery
+	}
+
+	prefix, err := dbutils.ParseIPQuery(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	conf := getConfig(ctx)
+	db, err := newReadOnlyDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	items, err := dbutils.LookupIP(ctx.Context, db, prefix)
+	if err != nil {
+		return err
+	}
+
+	if isStructuredOutput(ctx) {
+		return printStructured(ctx, os.Stdout, items)
+	}
+
+	headers := []string{
+		"IP",
+		"MODEL",
+		"COLUMN",
+		"NAME",
+		"SHOOT",
+		"PROJECT",
+		"ID",
+	}
+	table := newTableWriter(os.Stdout, headers)
+	for _, item := range items {
+		row := []string{
+			item.IP,
+			item.Model,
+			item.Column,
+			item.Name,
+			item.Shoot,
+			item.Project,
+			item.ID,
+		}
+		if err := table.Append(row); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
@@ -6,14 +6,13 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"log/slog"
 	"os"
 
 	"github.com/urfave/cli/v2"
 
-	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/utils/printer"
 	"github.com/gardener/inventory/pkg/version"
 )
 
@@ -46,12 +45,26 @@ func main() {
 				Usage:   "database uri to connect to",
 				EnvVars: []string{"DATABASE_URI"},
 			},
+			&cli.StringFlag{
+				Name:    "database-read-only-uri",
+				Usage:   "database uri of a read replica to use for queries",
+				EnvVars: []string{"DATABASE_READ_ONLY_URI"},
+			},
+			&cli.StringFlag{
+				Name:    "output",
+				Usage:   "output format to use (table, wide, json or yaml)",
+				Value:   printer.FormatTable,
+				EnvVars: []string{"INVENTORY_OUTPUT"},
+			},
 		},
 		Before: func(ctx *cli.Context) error {
-			configPaths := ctx.StringSlice("config")
-			conf, err := config.Parse(configPaths...)
+			if err := validateOutputFormat(ctx.String("output")); err != nil {
+				return err
+			}
+
+			conf, err := loadConfig(ctx)
 			if err != nil {
-				return fmt.Errorf("cannot parse config: %w", err)
+				return err
 			}
 
 			logger, err := newLogger(os.Stdout, conf)
@@ -60,19 +73,6 @@ func main() {
 			}
 			slog.SetDefault(logger)
 
-			// Overrides from flags/options
-			if ctx.IsSet("debug") {
-				conf.Debug = ctx.Bool("debug")
-			}
-
-			if ctx.IsSet("redis-endpoint") {
-				conf.Redis.Endpoint = ctx.String("redis-endpoint")
-			}
-
-			if ctx.IsSet("database-uri") {
-				conf.Database.DSN = ctx.String("database-uri")
-			}
-
 			ctx.Context = context.WithValue(ctx.Context, configKey{}, conf)
 
 			return nil
@@ -85,6 +85,14 @@ func main() {
 			NewQueueCommand(),
 			NewModelCommand(),
 			NewDashboardCommand(),
+			NewAuditCommand(),
+			NewTokenCommand(),
+			NewStatsCommand(),
+			NewIPCommand(),
+			NewSearchCommand(),
+			NewQueryCommand(),
+			NewCredentialsCommand(),
+			NewConfigCommand(),
 		},
 	}
 
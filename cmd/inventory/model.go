@@ -5,18 +5,28 @@
 package main
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
 	"github.com/urfave/cli/v2"
 
+	auxmodels "github.com/gardener/inventory/pkg/auxiliary/models"
 	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/exporter"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
 )
 
 // NewModelCommand returns a new command for interfacing with the models.
@@ -30,7 +40,7 @@ func NewModelCommand() *cli.Command {
 				Name:    "list",
 				Usage:   "list registered models",
 				Aliases: []string{"ls"},
-				Action: func(_ *cli.Context) error {
+				Action: func(ctx *cli.Context) error {
 					models := make([]string, 0, registry.ModelRegistry.Length())
 					walker := func(name string, _ any) error {
 						models = append(models, name)
@@ -43,17 +53,45 @@ func NewModelCommand() *cli.Command {
 					}
 
 					sort.Strings(models)
-					for _, model := range models {
-						fmt.Println(model)
+					if isStructuredOutput(ctx) {
+						return printStructured(ctx, os.Stdout, models)
+					}
+
+					if !isWideOutput(ctx) {
+						for _, model := range models {
+							fmt.Println(model)
+						}
+
+						return nil
+					}
+
+					headers := []string{
+						"NAME",
+						"TABLE",
+						"INGESTIBLE",
+					}
+					tables := pgdialect.New().Tables()
+					table := newTableWriter(os.Stdout, headers)
+					for _, name := range models {
+						model, _ := registry.ModelRegistry.Get(name)
+						row := []string{
+							name,
+							tables.Get(reflect.TypeOf(model).Elem()).Name,
+							strconv.FormatBool(registry.IngestModelRegistry.Exists(name)),
+						}
+						if err := table.Append(row); err != nil {
+							return err
+						}
 					}
 
-					return nil
+					return table.Render()
 				},
 			},
 			{
-				Name:    "query",
-				Usage:   "query data for a given model",
-				Aliases: []string{"q"},
+				Name:         "query",
+				Usage:        "query data for a given model",
+				Aliases:      []string{"q"},
+				BashComplete: newNameCompleter(registeredModelNames, false, "model", "m"),
 				Flags: []cli.Flag{
 					&cli.StringFlag{
 						Name:     "model",
@@ -125,7 +163,7 @@ func NewModelCommand() *cli.Command {
 
 					// Configure database connection
 					conf := getConfig(ctx)
-					db, err := newDB(conf)
+					db, err := newReadOnlyDB(conf)
 					if err != nil {
 						return err
 					}
@@ -196,8 +234,414 @@ func NewModelCommand() *cli.Command {
 					return tmpl.Execute(os.Stdout, items.Interface())
 				},
 			},
+			{
+				Name:         "export",
+				Usage:        "export data for a given model as JSON lines, CSV or Parquet",
+				Aliases:      []string{"e"},
+				BashComplete: newNameCompleter(registeredModelNames, false, "model", "m"),
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "model",
+						Aliases:  []string{"m"},
+						Usage:    "model name to export",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  "as-of",
+						Usage: "export data as of the given snapshot run id or RFC3339 timestamp",
+					},
+					&cli.IntFlag{
+						Name:    "limit",
+						Aliases: []string{"l"},
+						Usage:   "export up to this number of records",
+						Value:   0,
+					},
+					&cli.StringFlag{
+						Name:    "format",
+						Aliases: []string{"f"},
+						Usage:   fmt.Sprintf("export format, one of %s", strings.Join(exporter.Formats, ", ")),
+						Value:   exporter.DefaultFormat,
+					},
+				},
+				Action: execModelExportCmd,
+			},
+			{
+				Name:    "snapshots",
+				Usage:   "list snapshot runs",
+				Aliases: []string{"snap"},
+				Action:  execModelSnapshotsCmd,
+			},
+			{
+				Name:   "stats",
+				Usage:  "display the estimated number of rows and sizes of the model tables",
+				Action: execModelStatsCmd,
+			},
+			{
+				Name:         "count",
+				Usage:        "display the exact number of records of the models",
+				BashComplete: newNameCompleter(registeredModelNames, false, "model", "m"),
+				Flags: []cli.Flag{
+					&cli.StringSliceFlag{
+						Name:    "model",
+						Aliases: []string{"m"},
+						Usage:   "model name or shell pattern, e.g. 'aws:model:*'",
+					},
+					&cli.BoolFlag{
+						Name:    "all",
+						Aliases: []string{"a"},
+						Usage:   "count the records of all registered models",
+					},
+				},
+				Action: execModelCountCmd,
+			},
+			{
+				Name:         "describe",
+				Usage:        "describe the table, columns, unique keys and relations of a model",
+				Aliases:      []string{"desc"},
+				ArgsUsage:    "<name>",
+				BashComplete: newNameCompleter(registeredModelNames, true),
+				Action:       execModelDescribeCmd,
+			},
 		},
 	}
 
 	return cmd
 }
+
+// execModelExportCmd exports the records of a model in the format specified
+// via --format. When --as-of is specified the records are read from the
+// matching snapshot run, instead of the live tables.
+func execModelExportCmd(ctx *cli.Context) error {
+	modelName := ctx.String("model")
+	model, ok := registry.ModelRegistry.Get(modelName)
+	if !ok {
+		return fmt.Errorf("model %q not found in registry", modelName)
+	}
+
+	limit := ctx.Int("limit")
+	if limit < 0 {
+		return fmt.Errorf("invalid limit %d", limit)
+	}
+
+	format := ctx.String("format")
+	if _, err := exporter.ContentType(format); err != nil {
+		return err
+	}
+
+	conf := getConfig(ctx)
+	db, err := newReadOnlyDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	var query *bun.SelectQuery
+	table := db.Table(reflect.TypeOf(model).Elem())
+	asOf := ctx.String("as-of")
+	switch asOf {
+	case "":
+		query = db.NewSelect().
+			ColumnExpr("to_jsonb(t) AS data").
+			TableExpr("? AS t", bun.Ident(table.Name))
+	default:
+		runID, err := resolveSnapshotRun(ctx.Context, db, asOf)
+		if err != nil {
+			return err
+		}
+		query = db.NewSelect().
+			Model((*auxmodels.SnapshotItem)(nil)).
+			Column("data").
+			Where("run_id = ? AND model_name = ?", runID, modelName)
+	}
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	// The rows are streamed, so that large tables are not kept in
+	// memory at once.
+	rows, err := query.Rows(ctx.Context)
+	if err != nil {
+		return err
+	}
+	defer rows.Close() // nolint: errcheck
+
+	_, err = exporter.EncodeRecords(os.Stdout, format, exporter.ColumnsFor(table), rows)
+
+	return err
+}
+
+// execModelSnapshotsCmd lists the snapshot runs.
+func execModelSnapshotsCmd(ctx *cli.Context) error {
+	conf := getConfig(ctx)
+	db, err := newReadOnlyDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	items := make([]auxmodels.SnapshotRun, 0)
+	err = db.NewSelect().
+		Model(&items).
+		Order("started_at DESC").
+		Scan(ctx.Context)
+
+	if err != nil {
+		return err
+	}
+
+	if len(items) == 0 && !isStructuredOutput(ctx) {
+		return nil
+	}
+
+	headers := []string{
+		"ID",
+		"STARTED-AT",
+		"COMPLETED-AT",
+		"STATUS",
+	}
+	table := newOutputWriter(ctx, os.Stdout, headers)
+	for _, item := range items {
+		completedAt := na
+		if !item.CompletedAt.IsZero() {
+			completedAt = item.CompletedAt.String()
+		}
+		row := []string{
+			item.ID.String(),
+			item.StartedAt.String(),
+			completedAt,
+			item.Status,
+		}
+		if err := table.Append(row); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+// resolveSnapshotRun returns the ID of the snapshot run matching the given
+// value, which is either a snapshot run id, or an RFC3339 timestamp. When a
+// timestamp is given, the most recent completed snapshot run, which started
+// at or before that time is used.
+func resolveSnapshotRun(ctx context.Context, db *bun.DB, asOf string) (uuid.UUID, error) {
+	var run auxmodels.SnapshotRun
+	if id, err := uuid.Parse(asOf); err == nil {
+		err := db.NewSelect().
+			Model(&run).
+			Where("id = ?", id).
+			Scan(ctx)
+
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return uuid.Nil, fmt.Errorf("snapshot run %s not found", id)
+		case err != nil:
+			return uuid.Nil, err
+		case run.Status != auxmodels.SnapshotStatusCompleted:
+			return uuid.Nil, fmt.Errorf("snapshot run %s is not completed: %s", id, run.Status)
+		default:
+			return run.ID, nil
+		}
+	}
+
+	ts, err := time.Parse(time.RFC3339, asOf)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid snapshot run id or timestamp %q", asOf)
+	}
+
+	err = db.NewSelect().
+		Model(&run).
+		Where("status = ?", auxmodels.SnapshotStatusCompleted).
+		Where("started_at <= ?", ts).
+		Order("started_at DESC").
+		Limit(1).
+		Scan(ctx)
+
+	switch {
+	case err == nil:
+		return run.ID, nil
+	case errors.Is(err, sql.ErrNoRows):
+		return uuid.Nil, fmt.Errorf("no snapshot run found as of %s", asOf)
+	default:
+		return uuid.Nil, err
+	}
+}
+
+// execModelStatsCmd displays the estimated number of rows and the sizes of the
+// tables of the registered models.
+func execModelStatsCmd(ctx *cli.Context) error {
+	conf := getConfig(ctx)
+	db, err := newReadOnlyDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	items, err := dbutils.ComputeTableStats(ctx.Context, db)
+	if err != nil {
+		return err
+	}
+
+	headers := []string{
+		"MODEL",
+		"TABLE",
+		"ESTIMATED-ROWS",
+		"SIZE-BYTES",
+	}
+	table := newOutputWriter(ctx, os.Stdout, headers)
+	for _, item := range items {
+		row := []string{
+			item.Model,
+			item.Table,
+			strconv.FormatInt(item.EstimatedRows, 10),
+			strconv.FormatInt(item.SizeBytes, 10),
+		}
+		if err := table.Append(row); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+// execModelCountCmd prints the exact number of records of the models, which
+// are specified via --model, or of all registered models, if --all is
+// specified.
+func execModelCountCmd(ctx *cli.Context) error {
+	patterns := ctx.StringSlice("model")
+	all := ctx.Bool("all")
+	switch {
+	case all && len(patterns) > 0:
+		return fmt.Errorf("cannot use --model and --all at the same time")
+	case !all && len(patterns) == 0:
+		return fmt.Errorf("must specify --model or --all")
+	}
+
+	conf := getConfig(ctx)
+	db, err := newReadOnlyDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	items, err := dbutils.CountModels(ctx.Context, db, patterns)
+	if err != nil {
+		return err
+	}
+
+	if isStructuredOutput(ctx) {
+		return printStructured(ctx, os.Stdout, items)
+	}
+
+	headers := []string{
+		"MODEL",
+		"COUNT",
+	}
+	wideHeaders := []string{
+		"TABLE",
+		"MIN UPDATED AT",
+		"MAX UPDATED AT",
+	}
+	table := newOutputWriter(ctx, os.Stdout, headers, wideHeaders...)
+	for _, item := range items {
+		minUpdatedAt := na
+		if item.MinUpdatedAt != nil {
+			minUpdatedAt = item.MinUpdatedAt.Format(time.RFC3339)
+		}
+		maxUpdatedAt := na
+		if item.MaxUpdatedAt != nil {
+			maxUpdatedAt = item.MaxUpdatedAt.Format(time.RFC3339)
+		}
+
+		row := []string{
+			item.Model,
+			strconv.FormatInt(item.Count, 10),
+			item.Table,
+			minUpdatedAt,
+			maxUpdatedAt,
+		}
+		if err := table.Append(row); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+// execModelDescribeCmd prints the table, columns, unique keys and relations of
+// a model.
+func execModelDescribeCmd(ctx *cli.Context) error {
+	name := ctx.Args().First()
+	if name == "" {
+		return fmt.Errorf("must specify model name")
+	}
+
+	desc, err := dbutils.DescribeModel(name)
+	if err != nil {
+		return err
+	}
+
+	if isStructuredOutput(ctx) {
+		return printStructured(ctx, os.Stdout, desc)
+	}
+
+	accountColumn := desc.AccountColumn
+	if accountColumn == "" {
+		accountColumn = na
+	}
+
+	fmt.Printf("%-20s: %s\n", "Name", desc.Model)
+	fmt.Printf("%-20s: %s\n", "Table", desc.Table)
+	fmt.Printf("%-20s: %s\n", "Account Column", accountColumn)
+	fmt.Printf("%-20s: %s\n", "Ingestible", strconv.FormatBool(desc.Ingestible))
+
+	fmt.Printf("\nColumns\n")
+	columns := newTableWriter(os.Stdout, []string{"NAME", "TYPE", "PRIMARY KEY", "NOT NULL", "DEFAULT"})
+	for _, column := range desc.Columns {
+		row := []string{
+			column.Name,
+			column.Type,
+			strconv.FormatBool(column.PrimaryKey),
+			strconv.FormatBool(column.NotNull),
+			column.Default,
+		}
+		if err := columns.Append(row); err != nil {
+			return err
+		}
+	}
+	if err := columns.Render(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nUnique Keys\n")
+	uniqueKeys := newTableWriter(os.Stdout, []string{"NAME", "COLUMNS"})
+	for _, key := range desc.UniqueKeys {
+		if err := uniqueKeys.Append([]string{key.Name, strings.Join(key.Columns, ", ")}); err != nil {
+			return err
+		}
+	}
+	if err := uniqueKeys.Render(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nRelations\n")
+	relations := newTableWriter(os.Stdout, []string{"NAME", "TYPE", "MODEL", "COLUMNS", "JOIN COLUMNS"})
+	for _, rel := range desc.Relations {
+		model := rel.Model
+		if model == "" {
+			model = rel.Table
+		}
+		row := []string{
+			rel.Name,
+			rel.Type,
+			model,
+			strings.Join(rel.Columns, ", "),
+			strings.Join(rel.JoinColumns, ", "),
+		}
+		if err := relations.Append(row); err != nil {
+			return err
+		}
+	}
+
+	return relations.Render()
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"io"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/gardener/inventory/pkg/utils/printer"
+)
+
+// tableWriter is the interface used by commands for rendering tabular data.
+type tableWriter = printer.TableWriter
+
+// validateOutputFormat validates the given output format.
+func validateOutputFormat(format string) error {
+	return printer.ValidateFormat(format)
+}
+
+// getOutputFormat returns the output format configured via the global
+// `--output' flag.
+func getOutputFormat(ctx *cli.Context) string {
+	format := ctx.String("output")
+	if format == "" {
+		return printer.FormatTable
+	}
+
+	return format
+}
+
+// isStructuredOutput returns true, if the output format configured via the
+// global `--output' flag is a machine-readable one.
+func isStructuredOutput(ctx *cli.Context) bool {
+	return printer.IsStructured(getOutputFormat(ctx))
+}
+
+// isWideOutput returns true, if the output format configured via the global
+// `--output' flag is the wide table format.
+func isWideOutput(ctx *cli.Context) bool {
+	return getOutputFormat(ctx) == printer.FormatWide
+}
+
+// printStructured encodes the given value to the [io.Writer] using the output
+// format configured via the global `--output' flag.
+func printStructured(ctx *cli.Context, w io.Writer, v any) error {
+	return printer.Print(w, getOutputFormat(ctx), v)
+}
+
+// newOutputWriter returns a [tableWriter], which renders the rows using the
+// output format configured via the global `--output' flag. The values of the
+// wide headers follow the values of the headers in each row, and are rendered
+// with the wide and the machine-readable output formats only.
+func newOutputWriter(ctx *cli.Context, w io.Writer, headers []string, wideHeaders ...string) tableWriter {
+	return printer.New(w, getOutputFormat(ctx), headers, wideHeaders...)
+}
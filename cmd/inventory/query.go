@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+// NewQueryCommand returns a new command for running the named read-only SQL
+// queries.
+func NewQueryCommand() *cli.Command {
+	cmd := &cli.Command{
+		Name:  "query",
+		Usage: "named read-only query operations",
+		Before: func(ctx *cli.Context) error {
+			return validateQueriesConfig(getConfig(ctx))
+		},
+		Subcommands: []*cli.Command{
+			{
+				Name:    "list",
+				Usage:   "list the named queries",
+				Aliases: []string{"ls"},
+				Action:  execQueryListCmd,
+			},
+			{
+				Name:      "run",
+				Usage:     "run a named query",
+				ArgsUsage: "<name>",
+				Flags: []cli.Flag{
+					&cli.StringSliceFlag{
+						Name:    "param",
+						Aliases: []string{"p"},
+						Usage:   "query parameter in the form of name=value",
+					},
+					&cli.IntFlag{
+						Name:    "limit",
+						Aliases: []string{"l"},
+						Usage:   "max number of rows, limited by the configured max rows",
+					},
+				},
+				Action: execQueryRunCmd,
+			},
+		},
+	}
+
+	return cmd
+}
+
+// execQueryListCmd prints the named queries.
+func execQueryListCmd(ctx *cli.Context) error {
+	queries, err := loadNamedQueries(getConfig(ctx))
+	if err != nil {
+		return err
+	}
+
+	headers := []string{
+		"NAME",
+		"PARAMS",
+		"DESCRIPTION",
+	}
+	table := newOutputWriter(ctx, os.Stdout, headers)
+	for _, name := range slices.Sorted(maps.Keys(queries)) {
+		q := queries[name]
+		params := make([]string, 0, len(q.Params))
+		for _, p := range q.Params {
+			params = append(params, p.Name)
+		}
+
+		row := []string{
+			q.Name,
+			strings.Join(params, ", "),
+			q.Description,
+		}
+		if err := table.Append(row); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+// execQueryRunCmd runs a named query and prints the returned rows.
+func execQueryRunCmd(ctx *cli.Context) error {
+	conf := getConfig(ctx)
+	queries, err := loadNamedQueries(conf)
+	if err != nil {
+		return err
+	}
+
+	name := ctx.Args().First()
+	q, ok := queries[name]
+	if !ok {
+		return fmt.Errorf("%w: %q", dbutils.ErrUnknownQuery, name)
+	}
+
+	opts := dbutils.QueryOptions{
+		Params:  make(map[string]string),
+		Timeout: conf.Queries.StatementTimeout,
+		MaxRows: conf.Queries.MaxRows,
+	}
+	for _, param := range ctx.StringSlice("param") {
+		key, value, ok := strings.Cut(param, "=")
+		if !ok {
+			return fmt.Errorf("%w: invalid parameter %q", dbutils.ErrInvalidQueryParams, param)
+		}
+		opts.Params[key] = value
+	}
+	if limit := ctx.Int("limit"); limit > 0 && (opts.MaxRows <= 0 || limit < opts.MaxRows) {
+		opts.MaxRows = limit
+	}
+
+	db, err := newReadOnlyDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	result, err := dbutils.RunNamedQuery(ctx.Context, db, q, opts)
+	if err != nil {
+		return err
+	}
+
+	if isStructuredOutput(ctx) {
+		return printStructured(ctx, os.Stdout, result)
+	}
+
+	headers := make([]string, 0, len(result.Columns))
+	for _, column := range result.Columns {
+		headers = append(headers, strings.ToUpper(column))
+	}
+	table := newTableWriter(os.Stdout, headers)
+	for _, item := range result.Rows {
+		row := make([]string, 0, len(result.Columns))
+		for _, column := range result.Columns {
+			value := item[column]
+			if value == nil {
+				row = append(row, na)
+
+				continue
+			}
+			row = append(row, fmt.Sprint(value))
+		}
+		if err := table.Append(row); err != nil {
+			return err
+		}
+	}
+
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if result.Truncated {
+		fmt.Fprintf(os.Stderr, "\nResult truncated to %d rows\n", len(result.Rows))
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ package main
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -25,18 +26,60 @@ func NewQueueCommand() *cli.Command {
 				Aliases: []string{"ls"},
 				Action: func(ctx *cli.Context) error {
 					conf := getConfig(ctx)
-					inspector := newInspector(conf)
+					inspector, err := newInspector(conf)
+					if err != nil {
+						return err
+					}
 					defer inspector.Close() // nolint: errcheck
 					queues, err := inspector.Queues()
 					if err != nil {
 						return err
 					}
 
+					if isStructuredOutput(ctx) {
+						return printStructured(ctx, os.Stdout, queues)
+					}
+
+					if !isWideOutput(ctx) {
+						for _, item := range queues {
+							fmt.Println(item)
+						}
+
+						return nil
+					}
+
+					headers := []string{
+						"NAME",
+						"SIZE",
+						"PENDING",
+						"ACTIVE",
+						"RETRY",
+						"ARCHIVED",
+						"LATENCY",
+						"IS PAUSED",
+					}
+					table := newTableWriter(os.Stdout, headers)
 					for _, item := range queues {
-						fmt.Println(item)
+						q, err := inspector.GetQueueInfo(item)
+						if err != nil {
+							return err
+						}
+						row := []string{
+							q.Queue,
+							strconv.Itoa(q.Size),
+							strconv.Itoa(q.Pending),
+							strconv.Itoa(q.Active),
+							strconv.Itoa(q.Retry),
+							strconv.Itoa(q.Archived),
+							q.Latency.String(),
+							strconv.FormatBool(q.Paused),
+						}
+						if err := table.Append(row); err != nil {
+							return err
+						}
 					}
 
-					return nil
+					return table.Render()
 				},
 			},
 			{
@@ -54,13 +97,38 @@ func NewQueueCommand() *cli.Command {
 				Action: func(ctx *cli.Context) error {
 					queueName := ctx.String("name")
 					conf := getConfig(ctx)
-					inspector := newInspector(conf)
+					inspector, err := newInspector(conf)
+					if err != nil {
+						return err
+					}
 					defer inspector.Close() // nolint: errcheck
 					q, err := inspector.GetQueueInfo(queueName)
 					if err != nil {
 						return err
 					}
 
+					if isStructuredOutput(ctx) {
+						info := map[string]any{
+							"name":         q.Queue,
+							"memory_usage": q.MemoryUsage,
+							"latency":      q.Latency.String(),
+							"size":         q.Size,
+							"groups":       q.Groups,
+							"pending":      q.Pending,
+							"active":       q.Active,
+							"scheduled":    q.Scheduled,
+							"retry":        q.Retry,
+							"archived":     q.Archived,
+							"completed":    q.Completed,
+							"aggregating":  q.Aggregating,
+							"processed":    q.Processed,
+							"failed":       q.Failed,
+							"is_paused":    q.Paused,
+						}
+
+						return printStructured(ctx, os.Stdout, info)
+					}
+
 					fmt.Printf("%-20s: %s\n", "Name", q.Queue)
 					fmt.Printf("%-20s: %d\n", "Memory Usage", q.MemoryUsage)
 					fmt.Printf("%-20s: %s\n", "Latency", q.Latency.String())
@@ -92,14 +160,17 @@ func NewQueueCommand() *cli.Command {
 						Aliases: []string{"name"},
 					},
 				},
-				Action: func(ctx *cli.Context) error {
+				Action: withAudit("queue:pause", func(ctx *cli.Context) error {
 					queueName := ctx.String("name")
 					conf := getConfig(ctx)
-					inspector := newInspector(conf)
+					inspector, err := newInspector(conf)
+					if err != nil {
+						return err
+					}
 					defer inspector.Close() // nolint: errcheck
 
 					return inspector.PauseQueue(queueName)
-				},
+				}),
 			},
 			{
 				Name:    "resume",
@@ -113,14 +184,17 @@ func NewQueueCommand() *cli.Command {
 						Aliases: []string{"name"},
 					},
 				},
-				Action: func(ctx *cli.Context) error {
+				Action: withAudit("queue:resume", func(ctx *cli.Context) error {
 					queueName := ctx.String("name")
 					conf := getConfig(ctx)
-					inspector := newInspector(conf)
+					inspector, err := newInspector(conf)
+					if err != nil {
+						return err
+					}
 					defer inspector.Close() // nolint: errcheck
 
 					return inspector.UnpauseQueue(queueName)
-				},
+				}),
 			},
 			{
 				Name:    "drain",
@@ -140,11 +214,14 @@ func NewQueueCommand() *cli.Command {
 						Required: true,
 					},
 				},
-				Action: func(ctx *cli.Context) error {
+				Action: withAudit("queue:drain", func(ctx *cli.Context) error {
 					queueName := ctx.String("name")
 					messageType := ctx.String("type")
 					conf := getConfig(ctx)
-					inspector := newInspector(conf)
+					inspector, err := newInspector(conf)
+					if err != nil {
+						return err
+					}
 					defer inspector.Close() // nolint: errcheck
 
 					typeToFunc := map[string]func(queue string) (int, error){
@@ -165,10 +242,10 @@ func NewQueueCommand() *cli.Command {
 						return fmt.Errorf("message type should be one of %s", strings.Join(messageTypes, ", "))
 					}
 
-					_, err := deleteFunc(queueName)
+					_, err = deleteFunc(queueName)
 
 					return err
-				},
+				}),
 			},
 		},
 	}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/core/supervisor"
+)
+
+// reloadClientsChannel is the Redis Pub/Sub channel, on which workers receive
+// requests for reloading their API clients.
+const reloadClientsChannel = "inventory:worker:reload-clients"
+
+// errReloadChannelClosed is an error, which is returned when the subscription
+// to the [reloadClientsChannel] has been closed unexpectedly.
+var errReloadChannelClosed = errors.New("reload clients channel closed")
+
+// newReloadClientsComponent returns a [supervisor.Component], which reloads the
+// API clients of the worker, whenever a message is published on the
+// [reloadClientsChannel], or when the worker receives a SIGHUP signal.
+func newReloadClientsComponent(cliCtx *cli.Context, conf *config.Config) supervisor.Component {
+	run := func(ctx context.Context) error {
+		client, err := newRedisClient(conf)
+		if err != nil {
+			return err
+		}
+		defer client.Close() // nolint: errcheck
+
+		pubsub := client.Subscribe(ctx, reloadClientsChannel)
+		defer pubsub.Close() // nolint: errcheck
+
+		// Wait for the subscription to be confirmed
+		if _, err := pubsub.Receive(ctx); err != nil {
+			return err
+		}
+
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGHUP)
+		defer signal.Stop(signals)
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-signals:
+				reloadClients(ctx, cliCtx, "signal")
+			case _, ok := <-messages:
+				if !ok {
+					return errReloadChannelClosed
+				}
+				reloadClients(ctx, cliCtx, "redis")
+			}
+		}
+	}
+
+	return supervisor.Component{
+		Name:          "clients-reloader",
+		Run:           run,
+		RestartPolicy: supervisor.RestartOnFailure,
+	}
+}
+
+// reloadClients parses the config files again and re-configures the Vault
+// clients and the API clients of the supported providers. Clients of existing
+// named credentials are replaced, and clients of new named credentials are
+// registered. The Gardener clients and any other settings of the config, which
+// is stored in the context of the worker, are not reloaded.
+func reloadClients(ctx context.Context, cliCtx *cli.Context, source string) {
+	slog.Info("reloading API clients", "source", source)
+	conf, err := loadConfig(cliCtx)
+	if err != nil {
+		slog.Error("failed to reload config", "reason", err)
+
+		return
+	}
+
+	if err := resetAzureSubscriptions(ctx, conf); err != nil {
+		slog.Warn("failed to reset cached Azure subscriptions", "reason", err)
+	}
+
+	if err := configureVaultClients(ctx, conf); err != nil {
+		slog.Error("failed to reload Vault clients", "reason", err)
+
+		return
+	}
+
+	configureProviderClients(ctx, conf)
+	slog.Info("reloaded API clients", "source", source)
+}
@@ -5,18 +5,40 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/hibiken/asynq"
+	"github.com/robfig/cron/v3"
 	"github.com/urfave/cli/v2"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
 
+	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/core/leaderelection"
 	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/core/supervisor"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
 )
 
+// defaultLeaderElectionRedisKey is the default Redis key used for leader
+// election between scheduler replicas.
+const defaultLeaderElectionRedisKey = "inventory:scheduler:leader"
+
+// defaultLeaderElectionLeaseName is the default name of the Lease resource used
+// for leader election between scheduler replicas.
+const defaultLeaderElectionLeaseName = "inventory-scheduler"
+
+// errUnknownLeaderElectionBackend is an error, which is returned when an
+// unsupported leader election backend was specified.
+var errUnknownLeaderElectionBackend = errors.New("unknown leader election backend")
+
 // NewSchedulerCommand returns a new command for interfacing with the scheduler.
 func NewSchedulerCommand() *cli.Command {
 	cmd := &cli.Command{
@@ -30,61 +52,31 @@ func NewSchedulerCommand() *cli.Command {
 				Aliases: []string{"s"},
 				Action: func(ctx *cli.Context) error {
 					conf := getConfig(ctx)
-					scheduler := newScheduler(conf)
-
-					// Add the periodic tasks from the registry
-					walker := func(spec string, task *asynq.Task) error {
-						// TODO(dnaeon): add support for specifying queue for tasks
-						// originating from the registry.
-						queue := conf.Scheduler.DefaultQueue
-						id, err := scheduler.Register(
-							spec,
-							task,
-							asynq.Queue(queue),
-						)
-						if err != nil {
-							return err
-						}
-						slog.Info(
-							"periodic task registered",
-							"id", id,
-							"name", task.Type(),
-							"spec", spec,
-							"queue", queue,
-							"source", "registry",
-						)
-
-						return nil
-					}
-					if err := registry.ScheduledTaskRegistry.Range(walker); err != nil {
-						return err
+					run := func(ctx context.Context) error {
+						return runScheduler(ctx, conf)
 					}
 
-					// Add tasks from configuration file as well
-					for _, job := range conf.Scheduler.Jobs {
-						task := asynq.NewTask(job.Name, []byte(job.Payload))
-						queue := conf.Scheduler.DefaultQueue
-						if job.Queue != "" {
-							queue = job.Queue
-						}
-
-						id, err := scheduler.Register(job.Spec, task, asynq.Queue(queue))
+					if conf.Scheduler.LeaderElection.IsEnabled {
+						elector, err := newLeaderElector(conf)
 						if err != nil {
 							return err
 						}
-
-						slog.Info(
-							"periodic task registered",
-							"id", id,
-							"name", task.Type(),
-							"spec", job.Spec,
-							"desc", job.Desc,
-							"queue", queue,
-							"source", "config",
-						)
+						defer elector.Close() // nolint: errcheck
+						run = func(ctx context.Context) error {
+							return elector.Run(ctx, func(ctx context.Context) error {
+								return runScheduler(ctx, conf)
+							})
+						}
 					}
 
-					return scheduler.Run()
+					sup := supervisor.New()
+					sup.Add(supervisor.Component{
+						Name:          "scheduler",
+						Run:           run,
+						RestartPolicy: supervisor.RestartNever,
+					})
+
+					return sup.Run(ctx.Context)
 				},
 			},
 			{
@@ -105,14 +97,28 @@ func NewSchedulerCommand() *cli.Command {
 				},
 				Action: func(ctx *cli.Context) error {
 					conf := getConfig(ctx)
-					inspector := newInspector(conf)
+					inspector, err := newInspector(conf)
+					if err != nil {
+						return err
+					}
 					defer inspector.Close() // nolint: errcheck
 					items, err := inspector.SchedulerEntries()
 					if err != nil {
 						return err
 					}
 
-					if len(items) == 0 {
+					// Jobs with a deadline are enqueued by the
+					// scheduler directly, and are not known to
+					// the asynq scheduler entries.
+					collectors := enabledCollectors(conf)
+					deadlineJobs := make([]*config.PeriodicJob, 0)
+					for _, job := range conf.Scheduler.Jobs {
+						if job.Deadline > 0 && isCollectorEnabled(collectors, job.Name) {
+							deadlineJobs = append(deadlineJobs, job)
+						}
+					}
+
+					if len(items) == 0 && len(deadlineJobs) == 0 && !isStructuredOutput(ctx) {
 						return nil
 					}
 
@@ -125,7 +131,7 @@ func NewSchedulerCommand() *cli.Command {
 						"OPTS",
 					}
 
-					table := newTableWriter(os.Stdout, headers)
+					table := newOutputWriter(ctx, os.Stdout, headers)
 					for _, item := range items {
 						nextIn := time.Until(item.Next)
 						prev := item.Prev.String()
@@ -151,6 +157,37 @@ func NewSchedulerCommand() *cli.Command {
 						}
 					}
 
+					// The previous run and the ID of jobs with a
+					// deadline are not known outside of the
+					// scheduler.
+					uniqueOpts := asynqutils.NewUniqueOptionsFromConfig(conf.UniqueTasks)
+					for _, job := range deadlineJobs {
+						schedule, err := cron.ParseStandard(job.Spec)
+						if err != nil {
+							return err
+						}
+
+						_, jobOpts := periodicJobOptions(conf, uniqueOpts, job)
+						opts := make([]string, 0, len(jobOpts)+1)
+						for _, opt := range jobOpts {
+							opts = append(opts, opt.String())
+						}
+						opts = append(opts, fmt.Sprintf("Deadline(+%s)", job.Deadline))
+
+						now := time.Now().UTC()
+						row := []string{
+							na,
+							job.Spec,
+							job.Name,
+							na,
+							fmt.Sprintf("In %s", schedule.Next(now).Sub(now).String()),
+							strings.Join(opts, ", "),
+						}
+						if err := table.Append(row); err != nil {
+							return err
+						}
+					}
+
 					return table.Render()
 				},
 			},
@@ -159,3 +196,203 @@ func NewSchedulerCommand() *cli.Command {
 
 	return cmd
 }
+
+// runScheduler creates a new scheduler, registers the periodic tasks and runs
+// the scheduler until the given context is cancelled.
+func runScheduler(ctx context.Context, conf *config.Config) error {
+	scheduler, err := newScheduler(conf)
+	if err != nil {
+		return err
+	}
+
+	// The scheduler is started before registering the periodic tasks,
+	// since [asynq.Scheduler.Shutdown] does not close the Redis client of
+	// a scheduler, which has not been started yet. Periodic tasks may be
+	// registered with a running scheduler as well.
+	if err := scheduler.Start(); err != nil {
+		return err
+	}
+	defer scheduler.Shutdown()
+
+	client, err := newAsynqClient(conf)
+	if err != nil {
+		return err
+	}
+	defer client.Close() // nolint: errcheck
+	deadlineScheduler := cron.New(cron.WithLocation(time.UTC))
+
+	// Periodic tasks are not enqueued again, while a previous instance
+	// of the same task is still pending or being processed.
+	uniqueOpts := asynqutils.NewUniqueOptionsFromConfig(conf.UniqueTasks)
+
+	// Jobs for collectors of disabled providers or services are skipped
+	collectors := enabledCollectors(conf)
+
+	// Add the periodic tasks from the registry
+	walker := func(spec string, task *asynq.Task) error {
+		if !isCollectorEnabled(collectors, task.Type()) {
+			slog.Info("skipping periodic task of disabled collector", "name", task.Type(), "source", "registry")
+
+			return nil
+		}
+
+		// Tasks originating from the registry are routed according
+		// to the configured routes.
+		queue := conf.Scheduler.DefaultQueue
+		if routed, ok := conf.RouteQueue(task.Type()); ok {
+			queue = routed
+		}
+		opts := append(slices.Clone(uniqueOpts), asynq.Queue(queue))
+		id, err := scheduler.Register(spec, task, opts...)
+		if err != nil {
+			return err
+		}
+		slog.Info(
+			"periodic task registered",
+			"id", id,
+			"name", task.Type(),
+			"spec", spec,
+			"queue", queue,
+			"source", "registry",
+		)
+
+		return nil
+	}
+	if err := registry.ScheduledTaskRegistry.Range(walker); err != nil {
+		return err
+	}
+
+	// Add tasks from configuration file as well
+	for _, job := range conf.Scheduler.Jobs {
+		if !isCollectorEnabled(collectors, job.Name) {
+			slog.Info("skipping periodic task of disabled collector", "name", job.Name, "source", "config")
+
+			continue
+		}
+
+		queue, opts := periodicJobOptions(conf, uniqueOpts, job)
+		task := asynq.NewTask(job.Name, []byte(job.Payload))
+
+		// Jobs with a deadline relative to the time the task is
+		// enqueued are enqueued by a [asynqutils.DeadlineJob]. These
+		// are not known to the asynq scheduler, and are listed from
+		// the configuration by the `scheduler jobs' command instead.
+		if job.Deadline > 0 {
+			deadlineJob := asynqutils.NewDeadlineJob(client, task, job.Deadline, opts...)
+			if _, err := deadlineScheduler.AddJob(job.Spec, deadlineJob); err != nil {
+				return err
+			}
+
+			slog.Info(
+				"periodic task registered",
+				"name", task.Type(),
+				"spec", job.Spec,
+				"desc", job.Desc,
+				"queue", queue,
+				"deadline", job.Deadline,
+				"source", "config",
+			)
+
+			continue
+		}
+
+		id, err := scheduler.Register(job.Spec, task, opts...)
+		if err != nil {
+			return err
+		}
+
+		slog.Info(
+			"periodic task registered",
+			"id", id,
+			"name", task.Type(),
+			"spec", job.Spec,
+			"desc", job.Desc,
+			"queue", queue,
+			"source", "config",
+		)
+	}
+
+	deadlineScheduler.Start()
+	<-ctx.Done()
+	<-deadlineScheduler.Stop().Done()
+
+	return nil
+}
+
+// periodicJobOptions returns the queue and the options for enqueueing the
+// given periodic job from the configuration. The deadline of the job is not
+// part of the options, since it is computed anew for each enqueued task.
+func periodicJobOptions(conf *config.Config, uniqueOpts []asynq.Option, job *config.PeriodicJob) (string, []asynq.Option) {
+	queue := conf.Scheduler.DefaultQueue
+	if routed, ok := conf.RouteQueue(job.Name); ok {
+		queue = routed
+	}
+	if job.Queue != "" {
+		queue = job.Queue
+	}
+
+	opts := append(slices.Clone(uniqueOpts), asynq.Queue(queue))
+	if job.Timeout > 0 {
+		opts = append(opts, asynq.Timeout(job.Timeout))
+	}
+	if job.MaxRetry != nil {
+		opts = append(opts, asynq.MaxRetry(*job.MaxRetry))
+	}
+	if job.Retention > 0 {
+		opts = append(opts, asynq.Retention(job.Retention))
+	}
+
+	return queue, opts
+}
+
+// newLeaderElector creates a new [leaderelection.Elector] for the scheduler
+// based on the provided [config.Config] spec.
+func newLeaderElector(conf *config.Config) (leaderelection.Elector, error) {
+	leConf := conf.Scheduler.LeaderElection
+	opts := []leaderelection.Option{
+		leaderelection.WithIdentity(leConf.Identity),
+		leaderelection.WithLeaseDuration(leConf.LeaseDuration),
+		leaderelection.WithRenewDeadline(leConf.RenewDeadline),
+		leaderelection.WithRetryPeriod(leConf.RetryPeriod),
+	}
+
+	switch leConf.Backend {
+	case config.LeaderElectionBackendRedis:
+		client, err := newRedisClient(conf)
+		if err != nil {
+			return nil, err
+		}
+
+		key := leConf.Redis.Key
+		if key == "" {
+			key = defaultLeaderElectionRedisKey
+		}
+
+		elector, err := leaderelection.NewRedisElector(client, key, opts...)
+		if err != nil {
+			_ = client.Close()
+
+			return nil, err
+		}
+
+		return elector, nil
+	case config.LeaderElectionBackendKubernetes:
+		restConfig, err := clientcmd.BuildConfigFromFlags("", leConf.Kubernetes.Kubeconfig)
+		if err != nil {
+			return nil, err
+		}
+		client, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		name := leConf.Kubernetes.Name
+		if name == "" {
+			name = defaultLeaderElectionLeaseName
+		}
+
+		return leaderelection.NewKubernetesElector(client, leConf.Kubernetes.Namespace, name, opts...)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownLeaderElectionBackend, leConf.Backend)
+	}
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+// NewSearchCommand returns a new command for searching resources by name or
+// ID.
+func NewSearchCommand() *cli.Command {
+	cmd := &cli.Command{
+		Name:      "search",
+		Usage:     "search resources by name or id",
+		ArgsUsage: "<term>",
+		Flags: []cli.Flag{
+			&cli.IntFlag{
+				Name:    "limit",
+				Aliases: []string{"l"},
+				Usage:   "max number of records for each searched column",
+				Value:   dbutils.DefaultSearchLimit,
+			},
+		},
+		Action: execSearchCmd,
+	}
+
+	return cmd
+}
+
+// execSearchCmd searches the well-known identifier columns of all registered
+// models and prints the matching records.
+func execSearchCmd(ctx *cli.Context) error {
+	opts := dbutils.SearchOptions{
+		Term:  strings.Join(ctx.Args().Slice(), " "),
+		Limit: ctx.Int("limit"),
+	}
+	if strings.TrimSpace(opts.Term) == "" {
+		return dbutils.ErrNoSearchTerm
+	}
+
+	conf := getConfig(ctx)
+	db, err := newReadOnlyDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	items, err := dbutils.Search(ctx.Context, db, opts)
+	if err != nil {
+		return err
+	}
+
+	if isStructuredOutput(ctx) {
+		return printStructured(ctx, os.Stdout, items)
+	}
+
+	headers := []string{
+		"PROVIDER",
+		"MODEL",
+		"COLUMN",
+		"VALUE",
+		"ID",
+	}
+	table := newTableWriter(os.Stdout, headers)
+	for _, item := range items {
+		id, _ := item.Row["id"].(string)
+		row := []string{
+			item.Provider,
+			item.Model,
+			item.Column,
+			item.Value,
+			id,
+		}
+		if err := table.Append(row); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
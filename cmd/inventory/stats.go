@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+// NewStatsCommand returns a new command for computing statistics about the
+// collected resources.
+func NewStatsCommand() *cli.Command {
+	cmd := &cli.Command{
+		Name:         "stats",
+		Usage:        "compute resource counts and sums grouped by columns",
+		BashComplete: newNameCompleter(registeredModelNames, false, "model", "m"),
+		Flags: []cli.Flag{
+			&cli.StringSliceFlag{
+				Name:    "model",
+				Aliases: []string{"m"},
+				Usage:   "model name or pattern to include, e.g. aws:model:*",
+			},
+			&cli.StringSliceFlag{
+				Name:    "group-by",
+				Aliases: []string{"g"},
+				Usage:   "column to group by, incl. the provider and model pseudo columns",
+			},
+			&cli.StringSliceFlag{
+				Name:    "sum",
+				Aliases: []string{"s"},
+				Usage:   "numeric column to sum up for each group",
+			},
+		},
+		Action: execStatsCmd,
+	}
+
+	return cmd
+}
+
+// splitFlagValues returns the values of a string slice flag, where each value
+// may also be a comma-separated list.
+func splitFlagValues(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		for item := range strings.SplitSeq(value, ",") {
+			item = strings.TrimSpace(item)
+			if item != "" {
+				result = append(result, item)
+			}
+		}
+	}
+
+	return result
+}
+
+// execStatsCmd computes and prints the stats.
+func execStatsCmd(ctx *cli.Context) error {
+	opts := dbutils.StatsOptions{
+		Models:  splitFlagValues(ctx.StringSlice("model")),
+		GroupBy: splitFlagValues(ctx.StringSlice("group-by")),
+		Sum:     splitFlagValues(ctx.StringSlice("sum")),
+	}
+
+	conf := getConfig(ctx)
+	db, err := newReadOnlyDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	rows, err := dbutils.ComputeStats(ctx.Context, db, opts)
+	if err != nil {
+		return err
+	}
+
+	if isStructuredOutput(ctx) {
+		return printStructured(ctx, os.Stdout, rows)
+	}
+
+	headers := make([]string, 0, len(opts.GroupBy)+len(opts.Sum)+1)
+	for _, column := range opts.GroupBy {
+		headers = append(headers, strings.ToUpper(column))
+	}
+	headers = append(headers, "COUNT")
+	for _, column := range opts.Sum {
+		headers = append(headers, "SUM("+strings.ToUpper(column)+")")
+	}
+
+	table := newTableWriter(os.Stdout, headers)
+	for _, item := range rows {
+		row := make([]string, 0, len(headers))
+		for _, column := range opts.GroupBy {
+			row = append(row, item.Group[column])
+		}
+		row = append(row, strconv.FormatInt(item.Count, 10))
+		for _, column := range opts.Sum {
+			row = append(row, strconv.FormatFloat(item.Sums[column], 'f', -1, 64))
+		}
+		if err := table.Append(row); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
@@ -5,6 +5,8 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -16,8 +18,16 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/gardener/inventory/pkg/core/registry"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/schema"
 )
 
+// errNoTaskName is an error, which is returned when no task name was specified.
+var errNoTaskName = errors.New("must specify task name")
+
+// errTaskNotFound is an error, which is returned when a task is not known.
+var errTaskNotFound = errors.New("task not found")
+
 // NewTaskCommand returns a [cli.Command] for interfacing with task-related
 // operations.
 func NewTaskCommand() *cli.Command {
@@ -30,7 +40,7 @@ func NewTaskCommand() *cli.Command {
 				Name:    "list",
 				Usage:   "list registered tasks",
 				Aliases: []string{"ls"},
-				Action: func(_ *cli.Context) error {
+				Action: func(ctx *cli.Context) error {
 					tasks := make([]string, 0, registry.TaskRegistry.Length())
 					walker := func(name string, _ asynq.Handler) error {
 						tasks = append(tasks, name)
@@ -43,11 +53,40 @@ func NewTaskCommand() *cli.Command {
 					}
 
 					sort.Strings(tasks)
+					if isStructuredOutput(ctx) {
+						return printStructured(ctx, os.Stdout, tasks)
+					}
+
+					if !isWideOutput(ctx) {
+						for _, task := range tasks {
+							fmt.Println(task)
+						}
+
+						return nil
+					}
+
+					conf := getConfig(ctx)
+					headers := []string{
+						"NAME",
+						"ROUTE",
+						"DESCRIPTION",
+					}
+					table := newTableWriter(os.Stdout, headers)
 					for _, task := range tasks {
-						fmt.Println(task)
+						route := na
+						if queue, ok := conf.RouteQueue(task); ok {
+							route = queue
+						}
+						description, ok := registry.TaskDescriptionRegistry.Get(task)
+						if !ok {
+							description = na
+						}
+						if err := table.Append([]string{task, route, description}); err != nil {
+							return err
+						}
 					}
 
-					return nil
+					return table.Render()
 				},
 			},
 			{
@@ -61,14 +100,17 @@ func NewTaskCommand() *cli.Command {
 						Required: true,
 					},
 				},
-				Action: func(ctx *cli.Context) error {
+				Action: withAudit("task:cancel", func(ctx *cli.Context) error {
 					taskID := ctx.String("id")
 					conf := getConfig(ctx)
-					inspector := newInspector(conf)
+					inspector, err := newInspector(conf)
+					if err != nil {
+						return err
+					}
 					defer inspector.Close() // nolint: errcheck
 
 					return inspector.CancelProcessing(taskID)
-				},
+				}),
 			},
 			{
 				Name:    "delete",
@@ -87,15 +129,18 @@ func NewTaskCommand() *cli.Command {
 						Value:   "default",
 					},
 				},
-				Action: func(ctx *cli.Context) error {
+				Action: withAudit("task:delete", func(ctx *cli.Context) error {
 					taskID := ctx.String("id")
 					queue := ctx.String("queue")
 					conf := getConfig(ctx)
-					inspector := newInspector(conf)
+					inspector, err := newInspector(conf)
+					if err != nil {
+						return err
+					}
 					defer inspector.Close() // nolint: errcheck
 
 					return inspector.DeleteTask(queue, taskID)
-				},
+				}),
 			},
 			{
 				Name:    "active",
@@ -265,9 +310,10 @@ func NewTaskCommand() *cli.Command {
 				},
 			},
 			{
-				Name:    "enqueue",
-				Usage:   "submit a task",
-				Aliases: []string{"submit"},
+				Name:         "enqueue",
+				Usage:        "submit a task",
+				Aliases:      []string{"submit"},
+				BashComplete: newNameCompleter(registeredTaskNames, false, "task", "t"),
 				Flags: []cli.Flag{
 					&cli.StringFlag{
 						Name:     "task",
@@ -295,45 +341,61 @@ func NewTaskCommand() *cli.Command {
 						Value: 30 * time.Minute,
 					},
 				},
-				Action: func(ctx *cli.Context) error {
+				Action: withAudit("task:enqueue", func(ctx *cli.Context) error {
 					conf := getConfig(ctx)
-					client := newAsynqClient(conf)
+					client, err := newAsynqClient(conf)
+					if err != nil {
+						return err
+					}
 					defer client.Close() // nolint: errcheck
 
 					taskName := ctx.String("task")
 					timeout := ctx.Duration("timeout")
 					queue := ctx.String("queue")
+					if routed, ok := conf.RouteQueue(taskName); ok && !ctx.IsSet("queue") {
+						queue = routed
+					}
 
-					var payload []byte
-					payloadData := ctx.String("payload")
-					payloadFile := ctx.Path("payload-file")
-					switch {
-					case payloadData != "" && payloadFile != "":
-						return fmt.Errorf("cannot use --payload and --payload-file at the same time")
-					case payloadData != "":
-						payload = []byte(payloadData)
-					case payloadFile != "":
-						data, err := os.ReadFile(filepath.Clean(payloadFile))
-						if err != nil {
-							return fmt.Errorf("cannot read payload file: %w", err)
-						}
-						payload = data
+					payload, err := readTaskPayload(ctx, taskName)
+					if err != nil {
+						return err
 					}
 
 					task := asynq.NewTask(taskName, payload)
-					opts := []asynq.Option{
-						asynq.Queue(queue),
-						asynq.Timeout(timeout),
-					}
+					opts := asynqutils.NewUniqueOptionsFromConfig(conf.UniqueTasks)
+					opts = append(opts, asynq.Queue(queue), asynq.Timeout(timeout))
 					info, err := client.EnqueueContext(ctx.Context, task, opts...)
 					if err != nil {
 						return fmt.Errorf("cannot enqueue %q task: %w", taskName, err)
 					}
 
+					if isStructuredOutput(ctx) {
+						result := map[string]string{
+							"queue": info.Queue,
+							"id":    info.ID,
+						}
+
+						return printStructured(ctx, os.Stdout, result)
+					}
+
 					fmt.Printf("%s/%s\n", info.Queue, info.ID)
 
 					return nil
+				}),
+			},
+			{
+				Name:         "describe",
+				Usage:        "describe a registered task and its payload",
+				Aliases:      []string{"desc"},
+				ArgsUsage:    "<name>",
+				BashComplete: newNameCompleter(registeredTaskNames, true),
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "schema",
+						Usage: "print the json schema of the payload",
+					},
 				},
+				Action: execTaskDescribeCmd,
 			},
 			{
 				Name:    "inspect",
@@ -356,7 +418,10 @@ func NewTaskCommand() *cli.Command {
 					queueName := ctx.String("queue")
 					taskID := ctx.String("id")
 					conf := getConfig(ctx)
-					inspector := newInspector(conf)
+					inspector, err := newInspector(conf)
+					if err != nil {
+						return err
+					}
 					defer inspector.Close() // nolint: errcheck
 					info, err := inspector.GetTaskInfo(queueName, taskID)
 					if err != nil {
@@ -383,6 +448,30 @@ func NewTaskCommand() *cli.Command {
 						completedAt = na
 					}
 
+					if isStructuredOutput(ctx) {
+						result := map[string]any{
+							"id":              info.ID,
+							"queue":           info.Queue,
+							"type":            info.Type,
+							"state":           info.State.String(),
+							"group":           info.Group,
+							"is_orphaned":     info.IsOrphaned,
+							"retried":         info.Retried,
+							"max_retry":       info.MaxRetry,
+							"timeout":         info.Timeout.String(),
+							"deadline":        deadline,
+							"retention":       info.Retention.String(),
+							"last_failed_at":  lastFailedAt,
+							"next_process_at": nextProcessAt,
+							"completed_at":    completedAt,
+							"last_error":      info.LastErr,
+							"payload":         string(info.Payload),
+							"result":          string(info.Result),
+						}
+
+						return printStructured(ctx, os.Stdout, result)
+					}
+
 					fmt.Printf("%-20s: %s\n", "ID", info.ID)
 					fmt.Printf("%-20s: %s\n", "Queue", info.Queue)
 					fmt.Printf("%-20s: %s\n", "Type/Name", info.Type)
@@ -427,13 +516,186 @@ func NewTaskCommand() *cli.Command {
 	return cmd
 }
 
+// readTaskPayload returns the task payload specified via the `--payload' or
+// `--payload-file' flags, after validating it against the schema registered
+// for the task, if any.
+func readTaskPayload(ctx *cli.Context, taskName string) ([]byte, error) {
+	var payload []byte
+	payloadData := ctx.String("payload")
+	payloadFile := ctx.Path("payload-file")
+	switch {
+	case payloadData != "" && payloadFile != "":
+		return nil, errors.New("cannot use --payload and --payload-file at the same time")
+	case payloadData != "":
+		payload = []byte(payloadData)
+	case payloadFile != "":
+		data, err := os.ReadFile(filepath.Clean(payloadFile))
+		if err != nil {
+			return nil, fmt.Errorf("cannot read payload file: %w", err)
+		}
+		payload = data
+	}
+
+	if err := validateTaskPayload(taskName, payload); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// validateTaskPayload validates the given payload against the schema
+// registered for the task, if any.
+func validateTaskPayload(taskName string, payload []byte) error {
+	if payload == nil {
+		return nil
+	}
+
+	s, ok := registry.PayloadSchemaRegistry.Get(taskName)
+	if !ok {
+		return nil
+	}
+
+	if err := s.ValidatePayload(payload); err != nil {
+		return fmt.Errorf("invalid payload for %q task: %w", taskName, err)
+	}
+
+	return nil
+}
+
+// execTaskDescribeCmd describes the task with the given name, including its
+// purpose, the periodic jobs for it, the fields of its payload and an example
+// payload.
+func execTaskDescribeCmd(ctx *cli.Context) error {
+	if ctx.NArg() == 0 {
+		return errNoTaskName
+	}
+
+	conf := getConfig(ctx)
+	taskName := ctx.Args().First()
+	_, registered := registry.TaskRegistry.Get(taskName)
+	payloadSchema, hasSchema := registry.PayloadSchemaRegistry.Get(taskName)
+
+	description, ok := registry.TaskDescriptionRegistry.Get(taskName)
+	if !ok {
+		description = na
+	}
+
+	route := na
+	if queue, ok := conf.RouteQueue(taskName); ok {
+		route = queue
+	}
+
+	// Periodic jobs from the registry are keyed by their cron spec
+	schedules := make([]string, 0)
+	walker := func(spec string, task *asynq.Task) error {
+		if task.Type() == taskName {
+			schedules = append(schedules, spec)
+		}
+
+		return nil
+	}
+	if err := registry.ScheduledTaskRegistry.Range(walker); err != nil {
+		return err
+	}
+	for _, job := range conf.Scheduler.Jobs {
+		if job.Name == taskName {
+			schedules = append(schedules, job.Spec)
+		}
+	}
+	sort.Strings(schedules)
+
+	if !registered && !hasSchema && len(schedules) == 0 {
+		return fmt.Errorf("%w: %s", errTaskNotFound, taskName)
+	}
+
+	fields := make([]schema.Field, 0)
+	var example any
+	if hasSchema {
+		fields = payloadSchema.Fields()
+		example = payloadSchema.Example()
+	}
+
+	if isStructuredOutput(ctx) {
+		result := map[string]any{
+			"name":        taskName,
+			"description": description,
+			"registered":  registered,
+			"route":       route,
+			"schedules":   schedules,
+			"fields":      fields,
+			"example":     example,
+		}
+		if ctx.Bool("schema") {
+			result["schema"] = payloadSchema
+		}
+
+		return printStructured(ctx, os.Stdout, result)
+	}
+
+	fmt.Printf("%-20s: %s\n", "Name", taskName)
+	fmt.Printf("%-20s: %s\n", "Description", description)
+	fmt.Printf("%-20s: %s\n", "Registered", strconv.FormatBool(registered))
+	fmt.Printf("%-20s: %s\n", "Route", route)
+
+	fmt.Printf("\nSchedules\n")
+	fmt.Println("---------")
+	if len(schedules) == 0 {
+		fmt.Println("<none>")
+	}
+	for _, spec := range schedules {
+		fmt.Println(spec)
+	}
+
+	fmt.Printf("\nPayload Fields\n")
+	fmt.Println("--------------")
+	if !hasSchema {
+		fmt.Println("<none>")
+
+		return nil
+	}
+
+	headers := []string{"FIELD", "TYPE", "DESCRIPTION"}
+	table := newTableWriter(os.Stdout, headers)
+	for _, field := range fields {
+		if err := table.Append([]string{field.Path, field.Type, field.Description}); err != nil {
+			return err
+		}
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nExample Payload\n")
+	fmt.Println("---------------")
+	data, err := json.MarshalIndent(example, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+
+	if ctx.Bool("schema") {
+		fmt.Printf("\nPayload Schema\n")
+		fmt.Println("--------------")
+		data, err := json.MarshalIndent(payloadSchema, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	}
+
+	return nil
+}
+
 // printTasksInState prints the tasks in the given state
 func printTasksInState(ctx *cli.Context, state asynq.TaskState) error {
 	page := ctx.Int("page")
 	size := ctx.Int("size")
 	queueName := ctx.String("queue")
 	conf := getConfig(ctx)
-	inspector := newInspector(conf)
+	inspector, err := newInspector(conf)
+	if err != nil {
+		return err
+	}
 	defer inspector.Close() // nolint: errcheck
 	headers := []string{
 		"ID",
@@ -441,7 +703,13 @@ func printTasksInState(ctx *cli.Context, state asynq.TaskState) error {
 		"RETRIED",
 		"IS ORPHANED",
 	}
-	table := newTableWriter(os.Stdout, headers)
+	wideHeaders := []string{
+		"QUEUE",
+		"NEXT PROCESS AT",
+		"LAST FAILED AT",
+		"LAST ERROR",
+	}
+	table := newOutputWriter(ctx, os.Stdout, headers, wideHeaders...)
 
 	stateToFunc := map[asynq.TaskState]func(queue string, opts ...asynq.ListOption) ([]*asynq.TaskInfo, error){
 		asynq.TaskStateActive:    inspector.ListActiveTasks,
@@ -462,16 +730,33 @@ func printTasksInState(ctx *cli.Context, state asynq.TaskState) error {
 		return err
 	}
 
-	if len(items) == 0 {
+	if len(items) == 0 && !isStructuredOutput(ctx) {
 		return nil
 	}
 
 	for _, item := range items {
+		nextProcessAt := item.NextProcessAt.String()
+		if item.NextProcessAt.IsZero() {
+			nextProcessAt = na
+		}
+		lastFailedAt := item.LastFailedAt.String()
+		if item.LastFailedAt.IsZero() {
+			lastFailedAt = na
+		}
+		lastErr := item.LastErr
+		if lastErr == "" {
+			lastErr = na
+		}
+
 		row := []string{
 			item.ID,
 			item.Type,
 			fmt.Sprintf("%d/%d", item.Retried, item.MaxRetry),
 			strconv.FormatBool(item.IsOrphaned),
+			item.Queue,
+			nextProcessAt,
+			lastFailedAt,
+			lastErr,
 		}
 		if err := table.Append(row); err != nil {
 			return err
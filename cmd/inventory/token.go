@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/gardener/inventory/pkg/api"
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+// NewTokenCommand returns a new command for managing API tokens.
+func NewTokenCommand() *cli.Command {
+	cmd := &cli.Command{
+		Name:  "token",
+		Usage: "api token operations",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "mint a new api token",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "name",
+						Usage:    "name of the token",
+						Required: true,
+					},
+					&cli.StringSliceFlag{
+						Name:     "scope",
+						Usage:    fmt.Sprintf("scope to grant, one of %s", strings.Join(api.Scopes, ", ")),
+						Required: true,
+					},
+					&cli.DurationFlag{
+						Name:  "ttl",
+						Usage: "time after which the token expires, never if zero",
+					},
+				},
+				Action: withAudit("token:create", execTokenCreateCmd),
+			},
+			{
+				Name:    "list",
+				Usage:   "list api tokens",
+				Aliases: []string{"ls"},
+				Action:  execTokenListCmd,
+			},
+			{
+				Name:  "revoke",
+				Usage: "revoke an api token",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "name",
+						Usage:    "name of the token",
+						Required: true,
+					},
+				},
+				Action: withAudit("token:revoke", execTokenRevokeCmd),
+			},
+		},
+	}
+
+	return cmd
+}
+
+// execTokenCreateCmd mints a new API token and prints it. Only the hash of the
+// token is stored, so the token cannot be retrieved afterwards.
+func execTokenCreateCmd(ctx *cli.Context) error {
+	scopes := ctx.StringSlice("scope")
+	if err := api.ValidateScopes(scopes); err != nil {
+		return err
+	}
+
+	token, err := api.GenerateToken()
+	if err != nil {
+		return err
+	}
+
+	item := &models.APIToken{
+		Name:      ctx.String("name"),
+		TokenHash: api.HashToken(token),
+		Scopes:    scopes,
+	}
+	if ttl := ctx.Duration("ttl"); ttl > 0 {
+		item.ExpiresAt = time.Now().Add(ttl)
+	}
+
+	conf := getConfig(ctx)
+	db, err := newDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	if err := dbutils.InsertAPIToken(ctx.Context, db, item); err != nil {
+		return fmt.Errorf("cannot create api token: %w", err)
+	}
+
+	if isStructuredOutput(ctx) {
+		result := map[string]string{
+			"name":  item.Name,
+			"token": token,
+		}
+
+		return printStructured(ctx, os.Stdout, result)
+	}
+
+	fmt.Println(token)
+
+	return nil
+}
+
+// execTokenListCmd prints the API tokens.
+func execTokenListCmd(ctx *cli.Context) error {
+	conf := getConfig(ctx)
+	db, err := newReadOnlyDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	items, err := dbutils.ListAPITokens(ctx.Context, db)
+	if err != nil {
+		return err
+	}
+
+	type tokenInfo struct {
+		Name      string    `json:"name" yaml:"name"`
+		Scopes    []string  `json:"scopes" yaml:"scopes"`
+		CreatedAt time.Time `json:"created_at" yaml:"created_at"`
+		ExpiresAt time.Time `json:"expires_at,omitzero" yaml:"expires_at,omitempty"`
+		RevokedAt time.Time `json:"revoked_at,omitzero" yaml:"revoked_at,omitempty"`
+		IsValid   bool      `json:"is_valid" yaml:"is_valid"`
+	}
+
+	now := time.Now()
+	infos := make([]tokenInfo, 0, len(items))
+	for _, item := range items {
+		infos = append(infos, tokenInfo{
+			Name:      item.Name,
+			Scopes:    item.Scopes,
+			CreatedAt: item.CreatedAt,
+			ExpiresAt: item.ExpiresAt,
+			RevokedAt: item.RevokedAt,
+			IsValid:   item.IsValid(now),
+		})
+	}
+
+	if isStructuredOutput(ctx) {
+		return printStructured(ctx, os.Stdout, infos)
+	}
+
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return "-"
+		}
+
+		return t.Format(time.RFC3339)
+	}
+
+	headers := []string{
+		"NAME",
+		"SCOPES",
+		"CREATED",
+		"EXPIRES",
+		"REVOKED",
+		"VALID",
+	}
+	table := newTableWriter(os.Stdout, headers)
+	for _, info := range infos {
+		row := []string{
+			info.Name,
+			strings.Join(info.Scopes, ","),
+			formatTime(info.CreatedAt),
+			formatTime(info.ExpiresAt),
+			formatTime(info.RevokedAt),
+			fmt.Sprintf("%t", info.IsValid),
+		}
+		if err := table.Append(row); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+// execTokenRevokeCmd revokes an API token.
+func execTokenRevokeCmd(ctx *cli.Context) error {
+	conf := getConfig(ctx)
+	db, err := newDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	return dbutils.RevokeAPIToken(ctx.Context, db, ctx.String("name"))
+}
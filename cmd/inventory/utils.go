@@ -11,12 +11,14 @@ import (
 	"io"
 	"io/fs"
 	"log/slog"
+	"net/http"
 	"net/url"
 	"os"
+	"time"
 
 	"github.com/hibiken/asynq"
 	"github.com/olekukonko/tablewriter"
-	"github.com/olekukonko/tablewriter/tw"
+	"github.com/redis/go-redis/v9"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/extra/bundebug"
 	"github.com/uptrace/bun/migrate"
@@ -24,15 +26,29 @@ import (
 
 	"github.com/gardener/inventory/internal/pkg/migrations"
 	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/core/replay"
+	"github.com/gardener/inventory/pkg/core/timeout"
+	"github.com/gardener/inventory/pkg/events"
+	"github.com/gardener/inventory/pkg/metrics"
+	"github.com/gardener/inventory/pkg/servicenow"
 	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
 	workerutils "github.com/gardener/inventory/pkg/utils/asynq/worker"
 	dbutils "github.com/gardener/inventory/pkg/utils/db"
+	"github.com/gardener/inventory/pkg/utils/printer"
 	slogutils "github.com/gardener/inventory/pkg/utils/slog"
 )
 
 // na is the const used to represent N/A values
 const na = "N/A"
 
+// Statuses reported by commands performing checks.
+const (
+	statusOK      = "OK"
+	statusFailed  = "FAILED"
+	statusSkipped = "SKIPPED"
+)
+
 // configKey is the key used to store the parsed configuration in the context
 type configKey struct{}
 
@@ -40,6 +56,14 @@ type configKey struct{}
 // service was not configured with a bind address.
 var errNoDashboardAddress = errors.New("no bind address specified")
 
+// errNoQueriesDirectory is an error, which is returned when the named queries
+// are enabled without a directory containing the query files.
+var errNoQueriesDirectory = errors.New("no queries directory specified")
+
+// errQueriesDisabled is an error, which is returned when running named
+// queries, while they are not enabled.
+var errQueriesDisabled = errors.New("named queries are not enabled")
+
 // errNoServiceCredentials is an error, which is returned when a cloud provider
 // API service (e.g. AWS, GCP, etc.)  does not have any named credentials
 // configured.
@@ -57,6 +81,35 @@ var errNoAuthenticationMethod = errors.New("no authentication method specified")
 // unknown/unsupported authentication method in named credentials.
 var errUnknownAuthenticationMethod = errors.New("unknown authentication method specified")
 
+// loadConfig parses the config files specified by the `--config' flag, and
+// applies any overrides specified by the global flags.
+func loadConfig(ctx *cli.Context) (*config.Config, error) {
+	configPaths := ctx.StringSlice("config")
+	conf, err := config.Parse(configPaths...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse config: %w", err)
+	}
+
+	// Overrides from flags/options
+	if ctx.IsSet("debug") {
+		conf.Debug = ctx.Bool("debug")
+	}
+
+	if ctx.IsSet("redis-endpoint") {
+		conf.Redis.Endpoint = ctx.String("redis-endpoint")
+	}
+
+	if ctx.IsSet("database-uri") {
+		conf.Database.DSN = ctx.String("database-uri")
+	}
+
+	if ctx.IsSet("database-read-only-uri") {
+		conf.Database.ReadOnlyDSN = ctx.String("database-read-only-uri")
+	}
+
+	return conf, nil
+}
+
 // getConfig extracts and returns the [config.Config] from app's context.
 func getConfig(ctx *cli.Context) *config.Config {
 	conf, ok := ctx.Context.Value(configKey{}).(*config.Config)
@@ -78,34 +131,157 @@ func validateDashboardConfig(conf *config.Config) error {
 	return err
 }
 
+// validateReplayConfig validates the settings for recording and replaying the
+// responses of the provider APIs.
+func validateReplayConfig(conf *config.Config) error {
+	if conf.Replay.Mode == "" {
+		return nil
+	}
+
+	_, err := replay.NewTransport(conf.Replay.Mode, conf.Replay.Dir, nil)
+
+	return err
+}
+
+// validateEventsConfig validates the settings for publishing change events.
+func validateEventsConfig(conf *config.Config) error {
+	return events.ValidateConfig(conf.Events)
+}
+
+// validateServiceNowConfig validates the settings for synchronizing resources
+// into the ServiceNow CMDB, and verifies that the mappings refer to registered
+// models.
+func validateServiceNowConfig(conf *config.Config) error {
+	if err := servicenow.ValidateConfig(conf.ServiceNow); err != nil {
+		return err
+	}
+
+	for _, mapping := range conf.ServiceNow.Mappings {
+		if _, ok := registry.ModelRegistry.Get(mapping.Model); !ok {
+			return fmt.Errorf("servicenow: %w: %s", errUnknownModel, mapping.Model)
+		}
+	}
+
+	return nil
+}
+
+// validateQueriesConfig validates the settings for the named queries, and
+// verifies that the query files are valid.
+func validateQueriesConfig(conf *config.Config) error {
+	if conf.Queries.StatementTimeout < 0 || conf.Queries.MaxRows < 0 {
+		return fmt.Errorf("%w: statement timeout and max rows must not be negative", dbutils.ErrInvalidQuery)
+	}
+
+	_, err := loadNamedQueries(conf)
+
+	return err
+}
+
+// loadNamedQueries loads the named queries from the configured directory.
+func loadNamedQueries(conf *config.Config) (map[string]*dbutils.NamedQuery, error) {
+	if !conf.Queries.IsEnabled {
+		return nil, errQueriesDisabled
+	}
+	if conf.Queries.Directory == "" {
+		return nil, errNoQueriesDirectory
+	}
+
+	return dbutils.LoadNamedQueries(conf.Queries.Directory)
+}
+
+// isReplayEnabled returns true, if the responses of the provider APIs are
+// replayed from recordings.
+func isReplayEnabled(conf *config.Config) bool {
+	return conf.Replay.Mode == replay.ModeReplay
+}
+
+// newProviderTransport returns the [http.RoundTripper] used by the provider
+// API clients, which counts the API calls of tasks, and cancels requests after
+// the given timeout. When recording or replaying is enabled, the returned
+// transport records the responses of the given transport, or replays recorded
+// responses respectively.
+func newProviderTransport(conf *config.Config, apiTimeout time.Duration, next http.RoundTripper) (http.RoundTripper, error) {
+	if conf.Replay.Mode == "" {
+		return metrics.NewAPICallTransport(timeout.NewTransport(apiTimeout, next)), nil
+	}
+
+	transport, err := replay.NewTransport(conf.Replay.Mode, conf.Replay.Dir, next)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics.NewAPICallTransport(timeout.NewTransport(apiTimeout, transport)), nil
+}
+
+// newProviderHTTPClient returns an [http.Client], which uses the transport
+// returned by [newProviderTransport].
+func newProviderHTTPClient(conf *config.Config, apiTimeout time.Duration) (*http.Client, error) {
+	transport, err := newProviderTransport(conf, apiTimeout, http.DefaultTransport.(*http.Transport).Clone())
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Client{Transport: transport}, nil
+}
+
 // newLogger creates a new [slog.Logger] based on the provided [config.Config]
-// spec, which outputs to the given [io.Writer].
+// spec, which outputs to the given [io.Writer], and any additional outputs
+// enabled in the config.
 func newLogger(w io.Writer, conf *config.Config) (*slog.Logger, error) {
-	return slogutils.NewFromConfig(w, conf.Logging)
+	out, err := slogutils.NewWriterFromConfig(w, conf.Logging)
+	if err != nil {
+		return nil, err
+	}
+
+	return slogutils.NewFromConfig(out, conf.Logging)
 }
 
 // newRedisClientOpt returns a new [asynq.RedisClientOpt] from the given config.
-func newRedisClientOpt(conf *config.Config) asynq.RedisClientOpt {
+func newRedisClientOpt(conf *config.Config) (asynq.RedisClientOpt, error) {
 	return asynqutils.NewRedisClientOptFromConfig(conf.Redis)
 }
 
+// newRedisClient creates a new [redis.UniversalClient] from the given config.
+func newRedisClient(conf *config.Config) (redis.UniversalClient, error) {
+	redisClientOpt, err := newRedisClientOpt(conf)
+	if err != nil {
+		return nil, err
+	}
+	client, ok := redisClientOpt.MakeRedisClient().(redis.UniversalClient)
+	if !ok {
+		return nil, errors.New("unable to create redis client")
+	}
+
+	return client, nil
+}
+
 // newAsynqClient creates a new [asynq.Client] from the given config
-func newAsynqClient(conf *config.Config) *asynq.Client {
-	redisClientOpt := newRedisClientOpt(conf)
+func newAsynqClient(conf *config.Config) (*asynq.Client, error) {
+	redisClientOpt, err := newRedisClientOpt(conf)
+	if err != nil {
+		return nil, err
+	}
 
-	return asynq.NewClient(redisClientOpt)
+	return asynq.NewClient(redisClientOpt), nil
 }
 
 // newInspector returns a new [asynq.Inspector] from the given config.
-func newInspector(conf *config.Config) *asynq.Inspector {
-	redisClientOpt := newRedisClientOpt(conf)
+func newInspector(conf *config.Config) (*asynq.Inspector, error) {
+	redisClientOpt, err := newRedisClientOpt(conf)
+	if err != nil {
+		return nil, err
+	}
 
-	return asynq.NewInspector(redisClientOpt)
+	return asynq.NewInspector(redisClientOpt), nil
 }
 
 // newWorker creates a new [workerutils.Worker] from the given config.
-func newWorker(ctx context.Context, conf *config.Config) *workerutils.Worker {
-	redisClientOpt := newRedisClientOpt(conf)
+func newWorker(ctx context.Context, conf *config.Config) (*workerutils.Worker, error) {
+	redisClientOpt, err := newRedisClientOpt(conf)
+	if err != nil {
+		return nil, err
+	}
+
 	opts := make([]workerutils.Option, 0)
 	logLevel := asynq.InfoLevel
 	if conf.Debug {
@@ -114,18 +290,29 @@ func newWorker(ctx context.Context, conf *config.Config) *workerutils.Worker {
 
 	opts = append(opts, workerutils.WithLogLevel(logLevel))
 	opts = append(opts, workerutils.WithErrorHandler(asynqutils.NewDefaultErrorHandler()))
+	opts = append(opts, workerutils.WithGroupAggregator(asynqutils.NewGroupAggregator(registry.GroupAggregatorRegistry)))
+	opts = append(opts, workerutils.WithRetryDelayFunc(asynqutils.RetryDelay))
 	worker := workerutils.NewFromConfig(ctx, redisClientOpt, conf.Worker, opts...)
 
 	// Configure middlewares
 	middlewares := []asynq.MiddlewareFunc{
-		asynqutils.NewLoggerMiddleware(slog.Default()),
+		asynqutils.NewLoggerMiddleware(slog.Default(), conf.Logging),
 		asynqutils.NewConfigMiddleware(conf),
 		asynqutils.NewMeasuringMiddleware(),
 		asynqutils.NewMetricsMiddleware(),
 	}
+	if conf.Worker.CircuitBreaker.IsEnabled {
+		middlewares = append(middlewares, asynqutils.NewCircuitBreakerMiddleware(conf.Worker.CircuitBreaker))
+	}
+	middlewares = append(
+		middlewares,
+		asynqutils.NewErrorClassificationMiddleware(),
+		asynqutils.NewCollectionStateMiddleware(),
+		asynqutils.NewPayloadValidationMiddleware(registry.PayloadSchemaRegistry),
+	)
 	worker.UseMiddlewares(middlewares...)
 
-	return worker
+	return worker, nil
 }
 
 // newDB returns a new [bun.DB] database from the given config.
@@ -139,6 +326,18 @@ func newDB(conf *config.Config) (*bun.DB, error) {
 	return db, nil
 }
 
+// newReadOnlyDB returns a new [bun.DB] database from the given config, which
+// is meant to be used for read-only queries.
+func newReadOnlyDB(conf *config.Config) (*bun.DB, error) {
+	db, err := dbutils.NewReadOnlyFromConfig(conf.Database)
+	if err != nil {
+		return nil, err
+	}
+	db.AddQueryHook(bundebug.NewQueryHook(bundebug.WithVerbose(conf.Debug)))
+
+	return db, nil
+}
+
 // newMigrator creates a new [github.com/uptrace/bun/migrate.Migrator] from the
 // given config.
 func newMigrator(conf *config.Config, db *bun.DB) (*migrate.Migrator, error) {
@@ -172,27 +371,22 @@ func newMigrator(conf *config.Config, db *bun.DB) (*migrate.Migrator, error) {
 }
 
 // newScheduler creates a new [asynq.Scheduler] from the given config.
-func newScheduler(conf *config.Config) *asynq.Scheduler {
-	redisClientOpt := newRedisClientOpt(conf)
+func newScheduler(conf *config.Config) (*asynq.Scheduler, error) {
+	redisClientOpt, err := newRedisClientOpt(conf)
+	if err != nil {
+		return nil, err
+	}
 
 	// TODO: Logger, etc.
 	// TODO: PostEnqueue hook to emit metrics per tasks
-	preEnqueueFunc := func(t *asynq.Task, _ []asynq.Option) {
-		slog.Info("enqueueing task", "name", t.Type())
-	}
-
-	errEnqueueFunc := func(t *asynq.Task, _ []asynq.Option, err error) {
-		slog.Error("failed to enqueue", "name", t.Type(), "error", err)
-	}
-
 	logLevel := asynq.InfoLevel
 	if conf.Debug {
 		logLevel = asynq.DebugLevel
 	}
 
 	opts := &asynq.SchedulerOpts{
-		PreEnqueueFunc:      preEnqueueFunc,
-		EnqueueErrorHandler: errEnqueueFunc,
+		PreEnqueueFunc:      asynqutils.SchedulerPreEnqueueFunc,
+		EnqueueErrorHandler: asynqutils.SchedulerEnqueueErrorHandler,
 		LogLevel:            logLevel,
 	}
 
@@ -202,32 +396,11 @@ func newScheduler(conf *config.Config) *asynq.Scheduler {
 
 	scheduler := asynq.NewScheduler(redisClientOpt, opts)
 
-	return scheduler
+	return scheduler, nil
 }
 
 // newTableWriter creates a new [tablewriter.Table] with the given [io.Writer]
 // and headers
 func newTableWriter(w io.Writer, headers []string) *tablewriter.Table {
-	opts := []tablewriter.Option{
-		tablewriter.WithHeader(headers),
-		tablewriter.WithRendition(
-			tw.Rendition{
-				Borders: tw.Border{
-					Top:    tw.Off,
-					Bottom: tw.Off,
-					Left:   tw.Off,
-					Right:  tw.Off,
-				},
-			},
-		),
-	}
-	table := tablewriter.NewWriter(w).Options(opts...)
-
-	table.Configure(func(cfg *tablewriter.Config) {
-		cfg.Row.Alignment.Global = tw.AlignLeft
-		cfg.Row.Formatting.AutoWrap = tw.WrapNone
-		cfg.Header.Alignment.Global = tw.AlignLeft
-	})
-
-	return table
+	return printer.NewTable(w, headers)
 }
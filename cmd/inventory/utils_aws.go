@@ -15,11 +15,15 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 
 	"github.com/gardener/inventory/pkg/aws/stscreds/kubesatoken"
@@ -49,18 +53,24 @@ func validateAWSConfig(conf *config.Config) error {
 		return errNoAWSRegion
 	}
 
-	// Make sure that services have configured named credentials
-	services := map[string][]string{
-		"ec2":     conf.AWS.Services.EC2.UseCredentials,
-		"elb":     conf.AWS.Services.ELB.UseCredentials,
-		"elbv2":   conf.AWS.Services.ELBv2.UseCredentials,
-		"s3":      conf.AWS.Services.S3.UseCredentials,
-		"route53": conf.AWS.Services.Route53.UseCredentials,
+	// Make sure that the enabled services have configured named
+	// credentials
+	services := map[string]config.AWSServiceConfig{
+		"ec2":     conf.AWS.Services.EC2,
+		"elb":     conf.AWS.Services.ELB,
+		"elbv2":   conf.AWS.Services.ELBv2,
+		"s3":      conf.AWS.Services.S3,
+		"route53": conf.AWS.Services.Route53,
 	}
 
-	for service, namedCredentials := range services {
+	for service, serviceConfig := range services {
+		if !serviceConfig.Enabled() {
+			continue
+		}
+
 		// We expect at least one named credential to be present per
 		// service
+		namedCredentials := serviceConfig.UseCredentials
 		if len(namedCredentials) == 0 {
 			return fmt.Errorf("aws: %w: %s", errNoServiceCredentials, service)
 		}
@@ -74,6 +84,21 @@ func validateAWSConfig(conf *config.Config) error {
 		}
 	}
 
+	// Optional services may have no named credentials, but the ones they
+	// refer to must be configured.
+	optionalServices := map[string][]string{
+		"service_quotas": conf.AWS.Services.ServiceQuotas.UseCredentials,
+		"acm":            conf.AWS.Services.ACM.UseCredentials,
+	}
+
+	for service, namedCredentials := range optionalServices {
+		for _, nc := range namedCredentials {
+			if _, ok := conf.AWS.Credentials[nc]; !ok {
+				return fmt.Errorf("aws: %w: service %s refers %s", errUnknownNamedCredentials, service, nc)
+			}
+		}
+	}
+
 	// Each named credential must use a valid token retriever
 	supportedTokenRetrievers := []string{
 		config.DefaultAWSTokenRetriever,
@@ -191,9 +216,39 @@ func loadAWSConfig(ctx context.Context, conf *config.Config, namedCredentials st
 		return aws.Config{}, errUnknownAWSTokenRetriever
 	}
 
+	httpClient, err := newProviderHTTPClient(conf, conf.AWS.APITimeout)
+	if err != nil {
+		return aws.Config{}, err
+	}
+	opts = append(opts, awsconfig.WithHTTPClient(httpClient))
+
+	// Recorded responses are replayed without the need for live
+	// credentials, so we sign requests using static ones instead.
+	if isReplayEnabled(conf) {
+		credsProvider := credentials.NewStaticCredentialsProvider("replay", "replay", "")
+		opts = append(opts, awsconfig.WithCredentialsProvider(credsProvider))
+	}
+
 	return awsconfig.LoadDefaultConfig(ctx, opts...)
 }
 
+// isAWSAccountAllowed returns true, if collection from the given AWS account is
+// allowed by the configured account filters.
+func isAWSAccountAllowed(conf *config.Config, service string, namedCreds string, accountID string) bool {
+	if conf.AWS.Accounts.Allows(accountID) {
+		return true
+	}
+
+	slog.Info(
+		"skipping filtered AWS account",
+		"service", service,
+		"credentials", namedCreds,
+		"account_id", accountID,
+	)
+
+	return false
+}
+
 // configureEC2Clientset configures the [awsclients.EC2Clientset] registry.
 func configureEC2Clientset(ctx context.Context, conf *config.Config) error {
 	for _, namedCreds := range conf.AWS.Services.EC2.UseCredentials {
@@ -211,9 +266,13 @@ func configureEC2Clientset(ctx context.Context, conf *config.Config) error {
 		if err != nil {
 			return err
 		}
+		accountID := ptr.StringFromPointer(callerIdentity.Account)
+		if !isAWSAccountAllowed(conf, "ec2", namedCreds, accountID) {
+			continue
+		}
 		client := &awsclients.Client[*ec2.Client]{
 			NamedCredentials: namedCreds,
-			AccountID:        ptr.StringFromPointer(callerIdentity.Account),
+			AccountID:        accountID,
 			ARN:              ptr.StringFromPointer(callerIdentity.Arn),
 			UserID:           ptr.StringFromPointer(callerIdentity.UserId),
 			Client:           awsClient,
@@ -249,9 +308,13 @@ func configureELBClientset(ctx context.Context, conf *config.Config) error {
 		if err != nil {
 			return err
 		}
+		accountID := ptr.StringFromPointer(callerIdentity.Account)
+		if !isAWSAccountAllowed(conf, "elb", namedCreds, accountID) {
+			continue
+		}
 		client := &awsclients.Client[*elb.Client]{
 			NamedCredentials: namedCreds,
-			AccountID:        ptr.StringFromPointer(callerIdentity.Account),
+			AccountID:        accountID,
 			ARN:              ptr.StringFromPointer(callerIdentity.Arn),
 			UserID:           ptr.StringFromPointer(callerIdentity.UserId),
 			Client:           awsClient,
@@ -287,9 +350,13 @@ func configureELBv2Clientset(ctx context.Context, conf *config.Config) error {
 		if err != nil {
 			return err
 		}
+		accountID := ptr.StringFromPointer(callerIdentity.Account)
+		if !isAWSAccountAllowed(conf, "elbv2", namedCreds, accountID) {
+			continue
+		}
 		client := &awsclients.Client[*elbv2.Client]{
 			NamedCredentials: namedCreds,
-			AccountID:        ptr.StringFromPointer(callerIdentity.Account),
+			AccountID:        accountID,
 			ARN:              ptr.StringFromPointer(callerIdentity.Arn),
 			UserID:           ptr.StringFromPointer(callerIdentity.UserId),
 			Client:           awsClient,
@@ -325,9 +392,13 @@ func configureS3Clientset(ctx context.Context, conf *config.Config) error {
 		if err != nil {
 			return err
 		}
+		accountID := ptr.StringFromPointer(callerIdentity.Account)
+		if !isAWSAccountAllowed(conf, "s3", namedCreds, accountID) {
+			continue
+		}
 		client := &awsclients.Client[*s3.Client]{
 			NamedCredentials: namedCreds,
-			AccountID:        ptr.StringFromPointer(callerIdentity.Account),
+			AccountID:        accountID,
 			ARN:              ptr.StringFromPointer(callerIdentity.Arn),
 			UserID:           ptr.StringFromPointer(callerIdentity.UserId),
 			Client:           awsClient,
@@ -380,9 +451,13 @@ func configureRoute53Clientset(ctx context.Context, conf *config.Config) error {
 		if err != nil {
 			return err
 		}
+		accountID := ptr.StringFromPointer(callerIdentity.Account)
+		if !isAWSAccountAllowed(conf, "route53", namedCreds, accountID) {
+			continue
+		}
 		client := &awsclients.Client[*route53.Client]{
 			NamedCredentials: namedCreds,
-			AccountID:        ptr.StringFromPointer(callerIdentity.Account),
+			AccountID:        accountID,
 			ARN:              ptr.StringFromPointer(callerIdentity.Arn),
 			UserID:           ptr.StringFromPointer(callerIdentity.UserId),
 			Client:           awsClient,
@@ -401,6 +476,109 @@ func configureRoute53Clientset(ctx context.Context, conf *config.Config) error {
 	return nil
 }
 
+// configureServiceQuotasClientset configures the
+// [awsclients.ServiceQuotasClientset] and [awsclients.CloudWatchClientset]
+// registries. The CloudWatch clients are used for fetching the current usage
+// of the service quotas.
+func configureServiceQuotasClientset(ctx context.Context, conf *config.Config) error {
+	for _, namedCreds := range conf.AWS.Services.ServiceQuotas.UseCredentials {
+		awsConf, err := loadAWSConfig(ctx, conf, namedCreds)
+		if err != nil {
+			return err
+		}
+
+		// Get the caller identity information associated with the named
+		// credentials which were used to create the clients and register
+		// them.
+		stsClient := sts.NewFromConfig(awsConf)
+		callerIdentity, err := stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+		if err != nil {
+			return err
+		}
+		accountID := ptr.StringFromPointer(callerIdentity.Account)
+		if !isAWSAccountAllowed(conf, "service_quotas", namedCreds, accountID) {
+			continue
+		}
+
+		quotasClient := &awsclients.Client[*servicequotas.Client]{
+			NamedCredentials: namedCreds,
+			AccountID:        accountID,
+			ARN:              ptr.StringFromPointer(callerIdentity.Arn),
+			UserID:           ptr.StringFromPointer(callerIdentity.UserId),
+			Client:           servicequotas.NewFromConfig(awsConf),
+		}
+		awsclients.ServiceQuotasClientset.Overwrite(quotasClient.AccountID, quotasClient)
+		slog.Info(
+			"configured AWS client",
+			"service", "service_quotas",
+			"credentials", quotasClient.NamedCredentials,
+			"account_id", quotasClient.AccountID,
+			"arn", quotasClient.ARN,
+			"user_id", quotasClient.UserID,
+		)
+
+		cwClient := &awsclients.Client[*cloudwatch.Client]{
+			NamedCredentials: namedCreds,
+			AccountID:        quotasClient.AccountID,
+			ARN:              quotasClient.ARN,
+			UserID:           quotasClient.UserID,
+			Client:           cloudwatch.NewFromConfig(awsConf),
+		}
+		awsclients.CloudWatchClientset.Overwrite(cwClient.AccountID, cwClient)
+		slog.Info(
+			"configured AWS client",
+			"service", "cloudwatch",
+			"credentials", cwClient.NamedCredentials,
+			"account_id", cwClient.AccountID,
+			"arn", cwClient.ARN,
+			"user_id", cwClient.UserID,
+		)
+	}
+
+	return nil
+}
+
+// configureACMClientset configures the [awsclients.ACMClientset] registry.
+func configureACMClientset(ctx context.Context, conf *config.Config) error {
+	for _, namedCreds := range conf.AWS.Services.ACM.UseCredentials {
+		awsConf, err := loadAWSConfig(ctx, conf, namedCreds)
+		if err != nil {
+			return err
+		}
+
+		// Get the caller identity information associated with the named
+		// credentials which were used to create the client and register
+		// it.
+		stsClient := sts.NewFromConfig(awsConf)
+		callerIdentity, err := stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+		if err != nil {
+			return err
+		}
+		accountID := ptr.StringFromPointer(callerIdentity.Account)
+		if !isAWSAccountAllowed(conf, "acm", namedCreds, accountID) {
+			continue
+		}
+		client := &awsclients.Client[*acm.Client]{
+			NamedCredentials: namedCreds,
+			AccountID:        accountID,
+			ARN:              ptr.StringFromPointer(callerIdentity.Arn),
+			UserID:           ptr.StringFromPointer(callerIdentity.UserId),
+			Client:           acm.NewFromConfig(awsConf),
+		}
+		awsclients.ACMClientset.Overwrite(client.AccountID, client)
+		slog.Info(
+			"configured AWS client",
+			"service", "acm",
+			"credentials", client.NamedCredentials,
+			"account_id", client.AccountID,
+			"arn", client.ARN,
+			"user_id", client.UserID,
+		)
+	}
+
+	return nil
+}
+
 // configureAWSClients creates the AWS clients for the supported by Inventory
 // AWS services and registers them.
 func configureAWSClients(ctx context.Context, conf *config.Config) error {
@@ -416,18 +594,24 @@ func configureAWSClients(ctx context.Context, conf *config.Config) error {
 	}
 
 	configFuncs := map[string]func(ctx context.Context, conf *config.Config) error{
-		"ec2":     configureEC2Clientset,
-		"elb":     configureELBClientset,
-		"elbv2":   configureELBv2Clientset,
-		"s3":      configureS3Clientset,
-		"route53": configureRoute53Clientset,
+		"ec2":            configureEC2Clientset,
+		"elb":            configureELBClientset,
+		"elbv2":          configureELBv2Clientset,
+		"s3":             configureS3Clientset,
+		"route53":        configureRoute53Clientset,
+		"service_quotas": configureServiceQuotasClientset,
+		"acm":            configureACMClientset,
 	}
 
-	for svc, configFunc := range configFuncs {
-		if err := configFunc(ctx, conf); err != nil {
-			return fmt.Errorf("unable to configure AWS clients for %s: %w", svc, err)
-		}
+	enabled := map[string]bool{
+		"ec2":            conf.AWS.Services.EC2.Enabled(),
+		"elb":            conf.AWS.Services.ELB.Enabled(),
+		"elbv2":          conf.AWS.Services.ELBv2.Enabled(),
+		"s3":             conf.AWS.Services.S3.Enabled(),
+		"route53":        conf.AWS.Services.Route53.Enabled(),
+		"service_quotas": conf.AWS.Services.ServiceQuotas.Enabled(),
+		"acm":            conf.AWS.Services.ACM.Enabled(),
 	}
 
-	return nil
+	return configureServiceClients(ctx, conf, "AWS", configFuncs, enabled)
 }
@@ -6,14 +6,17 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"slices"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	armcompute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v6"
 	armnetwork "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v6"
@@ -21,9 +24,11 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/subscription/armsubscription"
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
+	"github.com/redis/go-redis/v9"
 
 	azureclients "github.com/gardener/inventory/pkg/clients/azure"
 	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/core/registry"
 	"github.com/gardener/inventory/pkg/utils/ptr"
 )
 
@@ -39,19 +44,29 @@ var errAzureNoTenantID = errors.New("no tenant id specified")
 // Identity Federation is configured without a token file path.
 var errAzureNoTokenFile = errors.New("no token file specified")
 
+// errAzureEmptySubscriptionID is an error, which is returned when named
+// credentials specify an empty subscription id.
+var errAzureEmptySubscriptionID = errors.New("empty subscription id specified")
+
 // validateAzureConfig validates the Azure configuration settings.
 func validateAzureConfig(conf *config.Config) error {
-	// Make sure that the services have named credentials configured.
-	services := map[string][]string{
-		"compute":          conf.Azure.Services.Compute.UseCredentials,
-		"resource_manager": conf.Azure.Services.ResourceManager.UseCredentials,
-		"network":          conf.Azure.Services.Network.UseCredentials,
-		"storage":          conf.Azure.Services.Storage.UseCredentials,
-		"graph":            conf.Azure.Services.Graph.UseCredentials,
+	// Make sure that the enabled services have named credentials
+	// configured.
+	services := map[string]config.AzureServiceConfig{
+		"compute":          conf.Azure.Services.Compute,
+		"resource_manager": conf.Azure.Services.ResourceManager,
+		"network":          conf.Azure.Services.Network,
+		"storage":          conf.Azure.Services.Storage,
+		"graph":            conf.Azure.Services.Graph,
 	}
 
-	for service, namedCredentials := range services {
+	for service, serviceConfig := range services {
+		if !serviceConfig.Enabled() {
+			continue
+		}
+
 		// We expect named credentials to be specified explicitly
+		namedCredentials := serviceConfig.UseCredentials
 		if len(namedCredentials) == 0 {
 			return fmt.Errorf("azure: %w: %s", errNoServiceCredentials, service)
 		}
@@ -78,6 +93,9 @@ func validateAzureConfig(conf *config.Config) error {
 		if !slices.Contains(supportedAuthnMethods, creds.Authentication) {
 			return fmt.Errorf("azure: %w: %s uses %s", errUnknownAuthenticationMethod, name, creds.Authentication)
 		}
+		if slices.Contains(creds.Subscriptions, "") {
+			return fmt.Errorf("azure: %w: credentials %s", errAzureEmptySubscriptionID, name)
+		}
 	}
 
 	return nil
@@ -111,13 +129,15 @@ func configureAzureClients(ctx context.Context, conf *config.Config) error {
 		}
 	}
 
-	for svc, configFunc := range configFuncs {
-		if err := configFunc(ctx, conf); err != nil {
-			return fmt.Errorf("unable to configure Azure clients for %s: %w", svc, err)
-		}
+	enabled := map[string]bool{
+		"compute":          conf.Azure.Services.Compute.Enabled(),
+		"resource_manager": conf.Azure.Services.ResourceManager.Enabled(),
+		"network":          conf.Azure.Services.Network.Enabled(),
+		"storage":          conf.Azure.Services.Storage.Enabled(),
+		"graph":            conf.Azure.Services.Graph.Enabled(),
 	}
 
-	return nil
+	return configureServiceClients(ctx, conf, "Azure", configFuncs, enabled)
 }
 
 // getAzureTokenProvider returns an [azcore.TokenCredential] for the given named
@@ -128,6 +148,10 @@ func getAzureTokenProvider(conf *config.Config, namedCredentials string) (azcore
 		return nil, fmt.Errorf("azure: %w: %s", errUnknownNamedCredentials, namedCredentials)
 	}
 
+	if isReplayEnabled(conf) {
+		return replayTokenCredential{}, nil
+	}
+
 	switch creds.Authentication {
 	case config.AzureAuthenticationMethodDefault:
 		return azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{})
@@ -154,10 +178,44 @@ func getAzureTokenProvider(conf *config.Config, namedCredentials string) (azcore
 	}
 }
 
+// newAzureClientOptions returns the [arm.ClientOptions] for the Azure API
+// clients, which count the API calls of tasks, and record or replay the
+// responses of the APIs, if enabled.
+func newAzureClientOptions(conf *config.Config) (*arm.ClientOptions, error) {
+	httpClient, err := newProviderHTTPClient(conf, conf.Azure.APITimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &arm.ClientOptions{}
+	opts.Transport = httpClient
+
+	return opts, nil
+}
+
+// replayTokenCredential is an [azcore.TokenCredential], which provides static
+// tokens for replaying recorded responses without live credentials.
+type replayTokenCredential struct{}
+
+// GetToken implements the [azcore.TokenCredential] interface.
+func (replayTokenCredential) GetToken(_ context.Context, _ policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	token := azcore.AccessToken{
+		Token:     "replay",
+		ExpiresOn: time.Now().Add(time.Hour),
+	}
+
+	return token, nil
+}
+
 // getAzureSubscriptions returns the slice of [armsubscription.Subscription] to
 // which the given [azcore.TokenCredential] has access to.
-func getAzureSubscriptions(ctx context.Context, creds azcore.TokenCredential) ([]*armsubscription.Subscription, error) {
-	factory, err := armsubscription.NewClientFactory(creds, &arm.ClientOptions{})
+func getAzureSubscriptions(ctx context.Context, conf *config.Config, creds azcore.TokenCredential) ([]*armsubscription.Subscription, error) {
+	clientOpts, err := newAzureClientOptions(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	factory, err := armsubscription.NewClientFactory(creds, clientOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -176,8 +234,147 @@ func getAzureSubscriptions(ctx context.Context, creds azcore.TokenCredential) ([
 	return result, nil
 }
 
+// isAzureSubscriptionAllowed returns true, if collection from the given Azure
+// subscription is allowed by the configured subscription filters.
+func isAzureSubscriptionAllowed(conf *config.Config, service string, namedCreds string, subscriptionID string, subscriptionName string) bool {
+	if conf.Azure.Subscriptions.Allows(subscriptionID, subscriptionName) {
+		return true
+	}
+
+	slog.Info(
+		"skipping filtered Azure subscription",
+		"service", service,
+		"credentials", namedCreds,
+		"subscription_id", subscriptionID,
+		"subscription_name", subscriptionName,
+	)
+
+	return false
+}
+
+// azureSubscriptionsCacheKeyPrefix is the prefix of the Redis keys, which
+// cache the subscriptions discovered for named credentials.
+const azureSubscriptionsCacheKeyPrefix = "inventory:azure:subscriptions:"
+
+// azureSubscriptions contains the subscriptions, which were already discovered
+// for named credentials, so that they are not discovered again for each Azure
+// service.
+var azureSubscriptions = registry.New[string, []*armsubscription.Subscription]()
+
+// getAzureNamedCredentialsSubscriptions returns the slice of
+// [armsubscription.Subscription] for the given named credentials. The
+// subscriptions are either the ones explicitly specified in the named
+// credentials config, or the ones discovered using the given
+// [azcore.TokenCredential]. Discovered subscriptions are reused by the Azure
+// services, and are optionally cached in Redis.
+func getAzureNamedCredentialsSubscriptions(ctx context.Context, conf *config.Config, namedCreds string, creds azcore.TokenCredential) ([]*armsubscription.Subscription, error) {
+	nc, ok := conf.Azure.Credentials[namedCreds]
+	if !ok {
+		return nil, fmt.Errorf("azure: %w: %s", errUnknownNamedCredentials, namedCreds)
+	}
+
+	if len(nc.Subscriptions) > 0 {
+		result := make([]*armsubscription.Subscription, 0, len(nc.Subscriptions))
+		for _, id := range nc.Subscriptions {
+			result = append(result, &armsubscription.Subscription{SubscriptionID: ptr.To(id)})
+		}
+
+		return result, nil
+	}
+
+	if result, ok := azureSubscriptions.Get(namedCreds); ok {
+		return result, nil
+	}
+
+	cacheConf := conf.Azure.SubscriptionCache
+	if !cacheConf.IsEnabled {
+		result, err := getAzureSubscriptions(ctx, conf, creds)
+		if err != nil {
+			return nil, err
+		}
+		azureSubscriptions.Overwrite(namedCreds, result)
+
+		return result, nil
+	}
+
+	client, err := newRedisClient(conf)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close() // nolint: errcheck
+
+	key := azureSubscriptionsCacheKeyPrefix + namedCreds
+	data, err := client.Get(ctx, key).Bytes()
+	switch {
+	case err == nil:
+		var result []*armsubscription.Subscription
+		if err := json.Unmarshal(data, &result); err == nil {
+			slog.Info("using cached Azure subscriptions", "credentials", namedCreds, "count", len(result))
+			azureSubscriptions.Overwrite(namedCreds, result)
+
+			return result, nil
+		}
+		slog.Warn("invalid cached Azure subscriptions", "credentials", namedCreds, "reason", err)
+	case !errors.Is(err, redis.Nil):
+		slog.Warn("cannot get cached Azure subscriptions", "credentials", namedCreds, "reason", err)
+	}
+
+	result, err := getAzureSubscriptions(ctx, conf, creds)
+	if err != nil {
+		return nil, err
+	}
+	azureSubscriptions.Overwrite(namedCreds, result)
+
+	data, err = json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Set(ctx, key, data, cacheConf.TTL).Err(); err != nil {
+		slog.Warn("cannot cache Azure subscriptions", "credentials", namedCreds, "reason", err)
+	}
+
+	return result, nil
+}
+
+// resetAzureSubscriptions forgets about the subscriptions, which were already
+// discovered for named credentials, including the ones cached in Redis, so
+// that they are discovered again when the Azure API clients are configured.
+func resetAzureSubscriptions(ctx context.Context, conf *config.Config) error {
+	namedCredentials := make([]string, 0)
+	_ = azureSubscriptions.Range(func(name string, _ []*armsubscription.Subscription) error {
+		namedCredentials = append(namedCredentials, name)
+
+		return nil
+	})
+	for _, name := range namedCredentials {
+		azureSubscriptions.Unregister(name)
+	}
+
+	if !conf.Azure.SubscriptionCache.IsEnabled || len(conf.Azure.Credentials) == 0 {
+		return nil
+	}
+
+	client, err := newRedisClient(conf)
+	if err != nil {
+		return err
+	}
+	defer client.Close() // nolint: errcheck
+
+	keys := make([]string, 0, len(conf.Azure.Credentials))
+	for name := range conf.Azure.Credentials {
+		keys = append(keys, azureSubscriptionsCacheKeyPrefix+name)
+	}
+
+	return client.Del(ctx, keys...).Err()
+}
+
 // configureAzureComputeClientsets configures the Azure Compute API clientsets.
 func configureAzureComputeClientsets(ctx context.Context, conf *config.Config) error {
+	clientOpts, err := newAzureClientOptions(conf)
+	if err != nil {
+		return err
+	}
+
 	// For each configured named credential we will get the token provider,
 	// then get the list of Subscriptions to which the credentials have
 	// access to. Each Subscription is then registered as a client using the
@@ -191,7 +388,7 @@ func configureAzureComputeClientsets(ctx context.Context, conf *config.Config) e
 		// Get the subscriptions to which the current credentials have
 		// access to and register each subscription as a known client in
 		// our clientset.
-		subscriptions, err := getAzureSubscriptions(ctx, tokenProvider)
+		subscriptions, err := getAzureNamedCredentialsSubscriptions(ctx, conf, namedCreds, tokenProvider)
 		if err != nil {
 			return err
 		}
@@ -202,11 +399,14 @@ func configureAzureComputeClientsets(ctx context.Context, conf *config.Config) e
 			if subscriptionID == "" {
 				return fmt.Errorf("empty subscription id for named credentials %s", namedCreds)
 			}
+			if !isAzureSubscriptionAllowed(conf, "compute", namedCreds, subscriptionID, subscriptionName) {
+				continue
+			}
 
 			factory, err := armcompute.NewClientFactory(
 				subscriptionID,
 				tokenProvider,
-				&arm.ClientOptions{},
+				clientOpts,
 			)
 			if err != nil {
 				return err
@@ -240,6 +440,11 @@ func configureAzureComputeClientsets(ctx context.Context, conf *config.Config) e
 // configureAzureResourceManagerClientsets configures the Azure Resource Manager
 // API clientsets.
 func configureAzureResourceManagerClientsets(ctx context.Context, conf *config.Config) error {
+	clientOpts, err := newAzureClientOptions(conf)
+	if err != nil {
+		return err
+	}
+
 	// Similar to the way we do it for Compute API clients, we first need to
 	// get the token provider, and then for each Subscription to which the
 	// named credentials have access we create and register an API client.
@@ -252,12 +457,12 @@ func configureAzureResourceManagerClientsets(ctx context.Context, conf *config.C
 		// Get the subscriptions to which the current credentials have
 		// access to and register each subscription as a known client in
 		// our clientset.
-		subscriptions, err := getAzureSubscriptions(ctx, tokenProvider)
+		subscriptions, err := getAzureNamedCredentialsSubscriptions(ctx, conf, namedCreds, tokenProvider)
 		if err != nil {
 			return err
 		}
 
-		subFactory, err := armsubscription.NewClientFactory(tokenProvider, &arm.ClientOptions{})
+		subFactory, err := armsubscription.NewClientFactory(tokenProvider, clientOpts)
 		if err != nil {
 			return err
 		}
@@ -269,6 +474,9 @@ func configureAzureResourceManagerClientsets(ctx context.Context, conf *config.C
 			if subscriptionID == "" {
 				return fmt.Errorf("empty subscription id for named credentials %s", namedCreds)
 			}
+			if !isAzureSubscriptionAllowed(conf, "resource_manager", namedCreds, subscriptionID, subscriptionName) {
+				continue
+			}
 
 			// Register Subscription clients
 			azureclients.SubscriptionsClientset.Overwrite(
@@ -293,7 +501,7 @@ func configureAzureResourceManagerClientsets(ctx context.Context, conf *config.C
 			rgFactory, err := armresources.NewClientFactory(
 				subscriptionID,
 				tokenProvider,
-				&arm.ClientOptions{},
+				clientOpts,
 			)
 			if err != nil {
 				return err
@@ -325,6 +533,11 @@ func configureAzureResourceManagerClientsets(ctx context.Context, conf *config.C
 
 // configureAzureNetworkClientsets configures the Azure Network API clientsets.
 func configureAzureNetworkClientsets(ctx context.Context, conf *config.Config) error {
+	clientOpts, err := newAzureClientOptions(conf)
+	if err != nil {
+		return err
+	}
+
 	for _, namedCreds := range conf.Azure.Services.Network.UseCredentials {
 		tokenProvider, err := getAzureTokenProvider(conf, namedCreds)
 		if err != nil {
@@ -334,7 +547,7 @@ func configureAzureNetworkClientsets(ctx context.Context, conf *config.Config) e
 		// Get the subscriptions to which the current credentials have
 		// access to and register each subscription as a known client in
 		// our clientset.
-		subscriptions, err := getAzureSubscriptions(ctx, tokenProvider)
+		subscriptions, err := getAzureNamedCredentialsSubscriptions(ctx, conf, namedCreds, tokenProvider)
 		if err != nil {
 			return err
 		}
@@ -345,11 +558,14 @@ func configureAzureNetworkClientsets(ctx context.Context, conf *config.Config) e
 			if subscriptionID == "" {
 				return fmt.Errorf("empty subscription id for named credentials %s", namedCreds)
 			}
+			if !isAzureSubscriptionAllowed(conf, "network", namedCreds, subscriptionID, subscriptionName) {
+				continue
+			}
 
 			factory, err := armnetwork.NewClientFactory(
 				subscriptionID,
 				tokenProvider,
-				&arm.ClientOptions{},
+				clientOpts,
 			)
 			if err != nil {
 				return err
@@ -456,6 +672,27 @@ func configureAzureNetworkClientsets(ctx context.Context, conf *config.Config) e
 				"subscription_id", subscriptionID,
 				"subscription_name", subscriptionName,
 			)
+
+			appGatewaysClient := factory.NewApplicationGatewaysClient()
+
+			// Register Application Gateways client
+			azureclients.ApplicationGatewaysClientset.Overwrite(
+				subscriptionID,
+				&azureclients.Client[*armnetwork.ApplicationGatewaysClient]{
+					NamedCredentials: namedCreds,
+					SubscriptionID:   subscriptionID,
+					SubscriptionName: subscriptionName,
+					Client:           appGatewaysClient,
+				},
+			)
+			slog.Info(
+				"configured Azure client",
+				"service", "network",
+				"sub_service", "application-gateways",
+				"credentials", namedCreds,
+				"subscription_id", subscriptionID,
+				"subscription_name", subscriptionName,
+			)
 		}
 	}
 
@@ -464,6 +701,11 @@ func configureAzureNetworkClientsets(ctx context.Context, conf *config.Config) e
 
 // configureAzureStorageClientsets configures the Azure Storage API clientsets.
 func configureAzureStorageClientsets(ctx context.Context, conf *config.Config) error {
+	clientOpts, err := newAzureClientOptions(conf)
+	if err != nil {
+		return err
+	}
+
 	for _, namedCreds := range conf.Azure.Services.Storage.UseCredentials {
 		tokenProvider, err := getAzureTokenProvider(conf, namedCreds)
 		if err != nil {
@@ -473,7 +715,7 @@ func configureAzureStorageClientsets(ctx context.Context, conf *config.Config) e
 		// Get the subscriptions to which the current credentials have
 		// access to and register each subscription as a known client in
 		// our clientset.
-		subscriptions, err := getAzureSubscriptions(ctx, tokenProvider)
+		subscriptions, err := getAzureNamedCredentialsSubscriptions(ctx, conf, namedCreds, tokenProvider)
 		if err != nil {
 			return err
 		}
@@ -484,11 +726,14 @@ func configureAzureStorageClientsets(ctx context.Context, conf *config.Config) e
 			if subscriptionID == "" {
 				return fmt.Errorf("empty subscription id for named credentials %s", namedCreds)
 			}
+			if !isAzureSubscriptionAllowed(conf, "storage", namedCreds, subscriptionID, subscriptionName) {
+				continue
+			}
 
 			factory, err := armstorage.NewClientFactory(
 				subscriptionID,
 				tokenProvider,
-				&arm.ClientOptions{},
+				clientOpts,
 			)
 			if err != nil {
 				return err
@@ -533,6 +778,18 @@ func configureAzureStorageClientsets(ctx context.Context, conf *config.Config) e
 				"subscription_id", subscriptionID,
 				"subscription_name", subscriptionName,
 			)
+
+			// Register the credentials for accessing the
+			// data plane of Storage Accounts
+			azureclients.StorageCredentialsClientset.Overwrite(
+				subscriptionID,
+				&azureclients.Client[azcore.TokenCredential]{
+					NamedCredentials: namedCreds,
+					SubscriptionID:   subscriptionID,
+					SubscriptionName: subscriptionName,
+					Client:           tokenProvider,
+				},
+			)
 		}
 	}
 
@@ -541,8 +798,13 @@ func configureAzureStorageClientsets(ctx context.Context, conf *config.Config) e
 
 // getAzureTenants returns the slice of [armsubscription.TenantIDDescription] to
 // which the given [azcore.TokenCredential] has access to.
-func getAzureTenants(ctx context.Context, creds azcore.TokenCredential) ([]*armsubscription.TenantIDDescription, error) {
-	factory, err := armsubscription.NewClientFactory(creds, &arm.ClientOptions{})
+func getAzureTenants(ctx context.Context, conf *config.Config, creds azcore.TokenCredential) ([]*armsubscription.TenantIDDescription, error) {
+	clientOpts, err := newAzureClientOptions(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	factory, err := armsubscription.NewClientFactory(creds, clientOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -577,7 +839,7 @@ func configureAzureGraphClientsets(ctx context.Context, conf *config.Config) err
 			return err
 		}
 
-		tenants, err := getAzureTenants(ctx, tokenProvider)
+		tenants, err := getAzureTenants(ctx, conf, tokenProvider)
 		if err != nil {
 			return err
 		}
@@ -9,14 +9,17 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"os"
 	"slices"
 
+	"golang.org/x/sync/errgroup"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 
 	gardenerclient "github.com/gardener/inventory/pkg/clients/gardener"
 	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/core/supervisor"
 	"github.com/gardener/inventory/pkg/version"
 )
 
@@ -32,14 +35,45 @@ var errNoGardenerKubeconfig = errors.New("no kubeconfig file specified")
 // file was not specified.
 var errNoGardenerTokenFile = errors.New("no token file specified")
 
+// errInvalidGardenerLandscape is an error, which is returned when an additional
+// Gardener landscape is misconfigured.
+var errInvalidGardenerLandscape = errors.New("invalid landscape")
+
 // validateGardenerConfig validates the Gardener configuration
 func validateGardenerConfig(conf *config.Config) error {
 	if conf.Gardener.UserAgent == "" {
 		conf.Gardener.UserAgent = fmt.Sprintf("gardener-inventory/%s", version.Version)
 	}
 
-	if conf.Gardener.Endpoint == "" {
-		return fmt.Errorf("gardener: %w", errNoGardenerEndpoint)
+	if err := validateGardenerLandscapeConfig(getPrimaryGardenerLandscape(conf)); err != nil {
+		return fmt.Errorf("gardener: %w", err)
+	}
+
+	seen := make(map[string]bool, len(conf.Gardener.Landscapes))
+	for _, landscape := range conf.Gardener.Landscapes {
+		switch {
+		case landscape.Name == "":
+			return fmt.Errorf("gardener: %w: no name specified", errInvalidGardenerLandscape)
+		case landscape.Name == conf.Gardener.Landscape:
+			return fmt.Errorf("gardener: %w: %s is the primary landscape", errInvalidGardenerLandscape, landscape.Name)
+		case seen[landscape.Name]:
+			return fmt.Errorf("gardener: %w: %s is specified more than once", errInvalidGardenerLandscape, landscape.Name)
+		}
+		seen[landscape.Name] = true
+
+		if err := validateGardenerLandscapeConfig(landscape); err != nil {
+			return fmt.Errorf("gardener: landscape %s: %w", landscape.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// validateGardenerLandscapeConfig validates the settings of a single Gardener
+// landscape.
+func validateGardenerLandscapeConfig(conf config.GardenerLandscapeConfig) error {
+	if conf.Endpoint == "" {
+		return errNoGardenerEndpoint
 	}
 
 	supportedAuthnMethods := []string{
@@ -48,52 +82,67 @@ func validateGardenerConfig(conf *config.Config) error {
 		config.GardenerAuthenticationMethodKubeconfig,
 	}
 
-	if conf.Gardener.Authentication == "" {
-		return fmt.Errorf("gardener: %w", errNoAuthenticationMethod)
+	if conf.Authentication == "" {
+		return errNoAuthenticationMethod
 	}
 
-	if !slices.Contains(supportedAuthnMethods, conf.Gardener.Authentication) {
-		return fmt.Errorf("gardener: %w: %s", errUnknownAuthenticationMethod, conf.Gardener.Authentication)
+	if !slices.Contains(supportedAuthnMethods, conf.Authentication) {
+		return fmt.Errorf("%w: %s", errUnknownAuthenticationMethod, conf.Authentication)
 	}
 
 	return nil
 }
 
+// getPrimaryGardenerLandscape returns the settings of the primary Gardener
+// landscape, i.e. the one configured at the top-level of the Gardener
+// configuration.
+func getPrimaryGardenerLandscape(conf *config.Config) config.GardenerLandscapeConfig {
+	return config.GardenerLandscapeConfig{
+		Name:           conf.Gardener.Landscape,
+		Endpoint:       conf.Gardener.Endpoint,
+		Authentication: conf.Gardener.Authentication,
+		TokenPath:      conf.Gardener.TokenPath,
+		Kubeconfig:     conf.Gardener.Kubeconfig,
+		ExcludedSeeds:  conf.Gardener.ExcludedSeeds,
+	}
+}
+
 // getGardenerRestConfig creates a [rest.Config] based on the provided
-// [config.Config] settings.
-func getGardenerRestConfig(conf *config.Config) (*rest.Config, error) {
-	switch conf.Gardener.Authentication {
+// [config.GardenerLandscapeConfig] settings.
+func getGardenerRestConfig(conf config.GardenerLandscapeConfig) (*rest.Config, error) {
+	switch conf.Authentication {
 	case config.GardenerAuthenticationMethodInCluster:
 		// In-cluster authentication
 		return rest.InClusterConfig()
 	case config.GardenerAuthenticationMethodKubeconfig:
 		// Kubeconfig authentication
-		if conf.Gardener.Kubeconfig == "" {
+		if conf.Kubeconfig == "" {
 			kubeconfigFromEnv := os.Getenv("KUBECONFIG")
 			if kubeconfigFromEnv == "" {
 				return nil, errNoGardenerKubeconfig
 			}
 			slog.Info(
 				"Gardener API client configured via KUBECONFIG",
+				"landscape", conf.Name,
 				"kubeconfig", kubeconfigFromEnv,
 			)
-			conf.Gardener.Kubeconfig = kubeconfigFromEnv
+			conf.Kubeconfig = kubeconfigFromEnv
 		}
 
-		return clientcmd.BuildConfigFromFlags("", conf.Gardener.Kubeconfig)
+		return clientcmd.BuildConfigFromFlags("", conf.Kubeconfig)
 	case config.GardenerAuthenticationMethodToken:
 		// Token file authentication
-		if conf.Gardener.TokenPath == "" {
+		if conf.TokenPath == "" {
 			return nil, errNoGardenerTokenFile
 		}
 		restConfig := &rest.Config{
-			Host:            conf.Gardener.Endpoint,
-			BearerTokenFile: conf.Gardener.TokenPath,
+			Host:            conf.Endpoint,
+			BearerTokenFile: conf.TokenPath,
 		}
 
 		return restConfig, nil
 	default:
-		return nil, fmt.Errorf("%w: %s", errUnknownAuthenticationMethod, conf.Gardener.Authentication)
+		return nil, fmt.Errorf("%w: %s", errUnknownAuthenticationMethod, conf.Authentication)
 	}
 }
 
@@ -117,7 +166,7 @@ func configureGardenerClient(_ context.Context, conf *config.Config) error {
 		return err
 	}
 
-	restConfig, err := getGardenerRestConfig(conf)
+	restConfig, err := getGardenerRestConfig(getPrimaryGardenerLandscape(conf))
 	if err != nil {
 		return fmt.Errorf("gardener: %w", err)
 	}
@@ -132,11 +181,26 @@ func configureGardenerClient(_ context.Context, conf *config.Config) error {
 
 	gardenerClientOpts := []gardenerclient.Option{
 		gardenerclient.WithRestConfig(restConfig),
+		gardenerclient.WithLandscape(conf.Gardener.Landscape),
 		gardenerclient.WithExcludedSeeds(conf.Gardener.ExcludedSeeds),
 		gardenerclient.WithGKESoilCluster(gkeSoilClusterConf),
 		gardenerclient.WithUserAgent(conf.Gardener.UserAgent),
 	}
 
+	if conf.Replay.Mode != "" {
+		if err := validateReplayConfig(conf); err != nil {
+			return fmt.Errorf("gardener: %w", err)
+		}
+	}
+
+	wrapper := func(rt http.RoundTripper) http.RoundTripper {
+		// The replay settings have been validated already
+		transport, _ := newProviderTransport(conf, conf.Gardener.APITimeout, rt)
+
+		return transport
+	}
+	gardenerClientOpts = append(gardenerClientOpts, gardenerclient.WithTransportWrapper(wrapper))
+
 	gardenClient, err := gardenerclient.New(gardenerClientOpts...)
 	if err != nil {
 		return err
@@ -144,8 +208,71 @@ func configureGardenerClient(_ context.Context, conf *config.Config) error {
 	gardenerclient.SetDefaultClient(gardenClient)
 	slog.Info(
 		"configured Gardener API client",
+		"landscape", conf.Gardener.Landscape,
 		"host", restConfig.Host,
 	)
 
+	// The soil clusters are configured for the primary landscape only
+	for _, landscape := range conf.Gardener.Landscapes {
+		restConfig, err := getGardenerRestConfig(landscape)
+		if err != nil {
+			return fmt.Errorf("gardener: landscape %s: %w", landscape.Name, err)
+		}
+		restConfig.UserAgent = conf.Gardener.UserAgent
+
+		client, err := gardenerclient.New(
+			gardenerclient.WithRestConfig(restConfig),
+			gardenerclient.WithLandscape(landscape.Name),
+			gardenerclient.WithExcludedSeeds(landscape.ExcludedSeeds),
+			gardenerclient.WithUserAgent(conf.Gardener.UserAgent),
+			gardenerclient.WithTransportWrapper(wrapper),
+		)
+		if err != nil {
+			return fmt.Errorf("gardener: landscape %s: %w", landscape.Name, err)
+		}
+		gardenerclient.Clients.Overwrite(landscape.Name, client)
+		slog.Info(
+			"configured Gardener API client",
+			"landscape", landscape.Name,
+			"host", restConfig.Host,
+		)
+	}
+
 	return nil
 }
+
+// newGardenerCacheComponent returns a [supervisor.Component], which runs the
+// caches of the shoots and seeds of the configured Gardener landscapes.
+func newGardenerCacheComponent(conf *config.Config) supervisor.Component {
+	run := func(ctx context.Context) error {
+		clients := make([]*gardenerclient.Client, 0)
+		if gardenerclient.IsDefaultClientSet() {
+			clients = append(clients, gardenerclient.DefaultClient)
+		}
+		for _, landscape := range gardenerclient.Landscapes() {
+			if client, ok := gardenerclient.GetClient(landscape); ok {
+				clients = append(clients, client)
+			}
+		}
+
+		group, ctx := errgroup.WithContext(ctx)
+		for _, client := range clients {
+			group.Go(func() error {
+				slog.Info("starting gardener cache", "landscape", client.Landscape())
+				if err := client.RunCache(ctx, conf.Gardener.Cache.ResyncPeriod); err != nil {
+					return fmt.Errorf("gardener cache: landscape %s: %w", client.Landscape(), err)
+				}
+
+				return nil
+			})
+		}
+
+		return group.Wait()
+	}
+
+	return supervisor.Component{
+		Name:          "gardener-cache",
+		Run:           run,
+		RestartPolicy: supervisor.RestartOnFailure,
+	}
+}
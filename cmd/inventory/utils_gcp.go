@@ -9,16 +9,22 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"slices"
 
 	compute "cloud.google.com/go/compute/apiv1"
 	container "cloud.google.com/go/container/apiv1"
 	resourcemanager "cloud.google.com/go/resourcemanager/apiv3"
 	"cloud.google.com/go/storage"
+	iam "google.golang.org/api/iam/v1"
 	"google.golang.org/api/option"
+	sqladmin "google.golang.org/api/sqladmin/v1"
+	htransport "google.golang.org/api/transport/http"
 
 	gcpclients "github.com/gardener/inventory/pkg/clients/gcp"
 	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/core/replay"
 	"github.com/gardener/inventory/pkg/version"
 )
 
@@ -36,17 +42,23 @@ func validateGCPConfig(conf *config.Config) error {
 		conf.GCP.UserAgent = fmt.Sprintf("gardener-inventory/%s", version.Version)
 	}
 
-	// Make sure that the GCP services have named credentials configured.
-	services := map[string][]string{
-		"resource_manager":  conf.GCP.Services.ResourceManager.UseCredentials,
-		"compute":           conf.GCP.Services.Compute.UseCredentials,
-		"storage":           conf.GCP.Services.Storage.UseCredentials,
-		"gke":               conf.GCP.Services.GKE.UseCredentials,
-		"soil-gcp-regional": {conf.GCP.SoilCluster.UseCredentials},
+	// Make sure that the enabled GCP services have named credentials
+	// configured.
+	services := map[string]config.GCPServiceConfig{
+		"resource_manager":  conf.GCP.Services.ResourceManager,
+		"compute":           conf.GCP.Services.Compute,
+		"storage":           conf.GCP.Services.Storage,
+		"gke":               conf.GCP.Services.GKE,
+		"soil-gcp-regional": {UseCredentials: []string{conf.GCP.SoilCluster.UseCredentials}},
 	}
 
-	for service, namedCredentials := range services {
+	for service, serviceConfig := range services {
+		if !serviceConfig.Enabled() {
+			continue
+		}
+
 		// We expect named credentials to be specified explicitly
+		namedCredentials := serviceConfig.UseCredentials
 		if len(namedCredentials) == 0 {
 			return fmt.Errorf("gcp: %w: %s", errNoServiceCredentials, service)
 		}
@@ -59,6 +71,21 @@ func validateGCPConfig(conf *config.Config) error {
 		}
 	}
 
+	// Optional services may be left without named credentials, but if
+	// specified they must refer to existing named credentials.
+	optionalServices := map[string][]string{
+		"cloud_sql": conf.GCP.Services.CloudSQL.UseCredentials,
+		"iam":       conf.GCP.Services.IAM.UseCredentials,
+	}
+
+	for service, namedCredentials := range optionalServices {
+		for _, nc := range namedCredentials {
+			if _, ok := conf.GCP.Credentials[nc]; !ok {
+				return fmt.Errorf("gcp: %w: service %s refers to %s", errUnknownNamedCredentials, service, nc)
+			}
+		}
+	}
+
 	// Validate the named credentials for using valid authentication
 	// methods/strategies.
 	supportedAuthnMethods := []string{
@@ -83,7 +110,7 @@ func validateGCPConfig(conf *config.Config) error {
 
 // getGCPClientOptions returns the slice of [option.ClientOption], which are
 // derived from the configured named credentials settings.
-func getGCPClientOptions(conf *config.Config, namedCredentials string) ([]option.ClientOption, error) {
+func getGCPClientOptions(ctx context.Context, conf *config.Config, namedCredentials string) ([]option.ClientOption, error) {
 	creds, ok := conf.GCP.Credentials[namedCredentials]
 	if !ok {
 		return nil, fmt.Errorf("gcp: %w: %s", errUnknownNamedCredentials, namedCredentials)
@@ -109,14 +136,66 @@ func getGCPClientOptions(conf *config.Config, namedCredentials string) ([]option
 		return nil, fmt.Errorf("gcp: %w: %s uses %s", errUnknownAuthenticationMethod, namedCredentials, creds.Authentication)
 	}
 
-	return opts, nil
+	return withGCPProviderTransport(ctx, conf, opts)
+}
+
+// withGCPProviderTransport returns the given options along with an HTTP client,
+// which counts the API calls of tasks, and records or replays the responses of
+// the GCP APIs, if enabled.
+func withGCPProviderTransport(ctx context.Context, conf *config.Config, opts []option.ClientOption) ([]option.ClientOption, error) {
+	switch conf.Replay.Mode {
+	case replay.ModeReplay:
+		// A custom HTTP client takes precedence over the
+		// authentication options, which allows replaying without
+		// live credentials.
+		httpClient, err := newProviderHTTPClient(conf, conf.GCP.APITimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(opts, option.WithHTTPClient(httpClient)), nil
+	default:
+		// The authenticated transport wraps the provider one, so
+		// that requests are recorded as sent to the GCP APIs.
+		base, err := newProviderTransport(conf, conf.GCP.APITimeout, http.DefaultTransport.(*http.Transport).Clone())
+		if err != nil {
+			return nil, err
+		}
+		// The clients don't apply their default scopes to a custom
+		// HTTP client, so we request the scope covering all of the
+		// GCP APIs used by the collectors.
+		transportOpts := append(slices.Clone(opts), option.WithScopes(sqladmin.CloudPlatformScope))
+		transport, err := htransport.NewTransport(ctx, base, transportOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(opts, option.WithHTTPClient(&http.Client{Transport: transport})), nil
+	}
+}
+
+// isGCPProjectAllowed returns true, if collection from the given GCP project is
+// allowed by the configured project filters.
+func isGCPProjectAllowed(conf *config.Config, service string, namedCreds string, project string) bool {
+	if conf.GCP.Projects.Allows(project) {
+		return true
+	}
+
+	slog.Info(
+		"skipping filtered GCP project",
+		"service", service,
+		"credentials", namedCreds,
+		"project", project,
+	)
+
+	return false
 }
 
 // configureGCPResourceManagerClientsets configures the GCP Resource Manager API
 // clientsets.
 func configureGCPResourceManagerClientsets(ctx context.Context, conf *config.Config) error {
 	for _, namedCreds := range conf.GCP.Services.ResourceManager.UseCredentials {
-		opts, err := getGCPClientOptions(conf, namedCreds)
+		opts, err := getGCPClientOptions(ctx, conf, namedCreds)
 		if err != nil {
 			return err
 		}
@@ -128,11 +207,16 @@ func configureGCPResourceManagerClientsets(ctx context.Context, conf *config.Con
 
 		// Register the client for each specified GCP project
 		for _, project := range nc.Projects {
+			if !isGCPProjectAllowed(conf, "resource_manager", namedCreds, project) {
+				continue
+			}
+
 			c, err := resourcemanager.NewProjectsRESTClient(ctx, opts...)
 			if err != nil {
 				return fmt.Errorf("gcp: cannot create client for %s: %w", namedCreds, err)
 			}
-			gcpclients.ProjectsClientset.Overwrite(
+			overwriteGCPClient(
+				gcpclients.ProjectsClientset,
 				project,
 				&gcpclients.Client[*resourcemanager.ProjectsClient]{
 					NamedCredentials: namedCreds,
@@ -156,7 +240,7 @@ func configureGCPResourceManagerClientsets(ctx context.Context, conf *config.Con
 // configureGCPComputeClientsets configures the GCP Compute API clientsets.
 func configureGCPComputeClientsets(ctx context.Context, conf *config.Config) error {
 	for _, namedCreds := range conf.GCP.Services.Compute.UseCredentials {
-		opts, err := getGCPClientOptions(conf, namedCreds)
+		opts, err := getGCPClientOptions(ctx, conf, namedCreds)
 		if err != nil {
 			return err
 		}
@@ -168,12 +252,17 @@ func configureGCPComputeClientsets(ctx context.Context, conf *config.Config) err
 
 		// Register the client for each specified GCP project
 		for _, project := range nc.Projects {
+			if !isGCPProjectAllowed(conf, "compute", namedCreds, project) {
+				continue
+			}
+
 			// Instances
 			instanceClient, err := compute.NewInstancesRESTClient(ctx, opts...)
 			if err != nil {
 				return fmt.Errorf("gcp: cannot create instance client for %s: %w", namedCreds, err)
 			}
-			gcpclients.InstancesClientset.Overwrite(
+			overwriteGCPClient(
+				gcpclients.InstancesClientset,
 				project,
 				&gcpclients.Client[*compute.InstancesClient]{
 					NamedCredentials: namedCreds,
@@ -194,7 +283,8 @@ func configureGCPComputeClientsets(ctx context.Context, conf *config.Config) err
 			if err != nil {
 				return fmt.Errorf("gcp: cannot create network client for %s: %w", namedCreds, err)
 			}
-			gcpclients.NetworksClientset.Overwrite(
+			overwriteGCPClient(
+				gcpclients.NetworksClientset,
 				project,
 				&gcpclients.Client[*compute.NetworksClient]{
 					NamedCredentials: namedCreds,
@@ -215,7 +305,8 @@ func configureGCPComputeClientsets(ctx context.Context, conf *config.Config) err
 			if err != nil {
 				return fmt.Errorf("gcp: cannot create addresses client for %s: %w", namedCreds, err)
 			}
-			gcpclients.AddressesClientset.Overwrite(
+			overwriteGCPClient(
+				gcpclients.AddressesClientset,
 				project,
 				&gcpclients.Client[*compute.AddressesClient]{
 					NamedCredentials: namedCreds,
@@ -236,7 +327,8 @@ func configureGCPComputeClientsets(ctx context.Context, conf *config.Config) err
 			if err != nil {
 				return fmt.Errorf("gcp: cannot create global addresses client for %s: %w", namedCreds, err)
 			}
-			gcpclients.GlobalAddressesClientset.Overwrite(
+			overwriteGCPClient(
+				gcpclients.GlobalAddressesClientset,
 				project,
 				&gcpclients.Client[*compute.GlobalAddressesClient]{
 					NamedCredentials: namedCreds,
@@ -257,7 +349,8 @@ func configureGCPComputeClientsets(ctx context.Context, conf *config.Config) err
 			if err != nil {
 				return fmt.Errorf("gcp: cannot create subnet client for %s: %w", namedCreds, err)
 			}
-			gcpclients.SubnetworksClientset.Overwrite(
+			overwriteGCPClient(
+				gcpclients.SubnetworksClientset,
 				project,
 				&gcpclients.Client[*compute.SubnetworksClient]{
 					NamedCredentials: namedCreds,
@@ -279,7 +372,8 @@ func configureGCPComputeClientsets(ctx context.Context, conf *config.Config) err
 			if err != nil {
 				return fmt.Errorf("gcp: cannot create disk client for %s: %w", namedCreds, err)
 			}
-			gcpclients.DisksClientset.Overwrite(
+			overwriteGCPClient(
+				gcpclients.DisksClientset,
 				project,
 				&gcpclients.Client[*compute.DisksClient]{
 					NamedCredentials: namedCreds,
@@ -300,7 +394,8 @@ func configureGCPComputeClientsets(ctx context.Context, conf *config.Config) err
 			if err != nil {
 				return fmt.Errorf("gcp: cannot create forwarding rules client for %s: %w", namedCreds, err)
 			}
-			gcpclients.ForwardingRulesClientset.Overwrite(
+			overwriteGCPClient(
+				gcpclients.ForwardingRulesClientset,
 				project,
 				&gcpclients.Client[*compute.ForwardingRulesClient]{
 					NamedCredentials: namedCreds,
@@ -321,7 +416,8 @@ func configureGCPComputeClientsets(ctx context.Context, conf *config.Config) err
 			if err != nil {
 				return fmt.Errorf("gcp: cannot create target pools client for %s: %w", namedCreds, err)
 			}
-			gcpclients.TargetPoolsClientset.Overwrite(
+			overwriteGCPClient(
+				gcpclients.TargetPoolsClientset,
 				project,
 				&gcpclients.Client[*compute.TargetPoolsClient]{
 					NamedCredentials: namedCreds,
@@ -336,6 +432,138 @@ func configureGCPComputeClientsets(ctx context.Context, conf *config.Config) err
 				"credentials", namedCreds,
 				"project", project,
 			)
+
+			// Reservations clients
+			reservationsClient, err := compute.NewReservationsRESTClient(ctx, opts...)
+			if err != nil {
+				return fmt.Errorf("gcp: cannot create reservations client for %s: %w", namedCreds, err)
+			}
+			overwriteGCPClient(
+				gcpclients.ReservationsClientset,
+				project,
+				&gcpclients.Client[*compute.ReservationsClient]{
+					NamedCredentials: namedCreds,
+					ProjectID:        project,
+					Client:           reservationsClient,
+				},
+			)
+			slog.Info(
+				"configured GCP client",
+				"service", "compute",
+				"sub_service", "reservations",
+				"credentials", namedCreds,
+				"project", project,
+			)
+
+			// Region Commitments clients
+			commitmentsClient, err := compute.NewRegionCommitmentsRESTClient(ctx, opts...)
+			if err != nil {
+				return fmt.Errorf("gcp: cannot create region commitments client for %s: %w", namedCreds, err)
+			}
+			overwriteGCPClient(
+				gcpclients.RegionCommitmentsClientset,
+				project,
+				&gcpclients.Client[*compute.RegionCommitmentsClient]{
+					NamedCredentials: namedCreds,
+					ProjectID:        project,
+					Client:           commitmentsClient,
+				},
+			)
+			slog.Info(
+				"configured GCP client",
+				"service", "compute",
+				"sub_service", "region-commitments",
+				"credentials", namedCreds,
+				"project", project,
+			)
+
+			// Regions clients
+			regionsClient, err := compute.NewRegionsRESTClient(ctx, opts...)
+			if err != nil {
+				return fmt.Errorf("gcp: cannot create regions client for %s: %w", namedCreds, err)
+			}
+			overwriteGCPClient(
+				gcpclients.RegionsClientset,
+				project,
+				&gcpclients.Client[*compute.RegionsClient]{
+					NamedCredentials: namedCreds,
+					ProjectID:        project,
+					Client:           regionsClient,
+				},
+			)
+			slog.Info(
+				"configured GCP client",
+				"service", "compute",
+				"sub_service", "regions",
+				"credentials", namedCreds,
+				"project", project,
+			)
+
+			// SSL certificates clients
+			sslCertificatesClient, err := compute.NewSslCertificatesRESTClient(ctx, opts...)
+			if err != nil {
+				return fmt.Errorf("gcp: cannot create SSL certificates client for %s: %w", namedCreds, err)
+			}
+			overwriteGCPClient(
+				gcpclients.SSLCertificatesClientset,
+				project,
+				&gcpclients.Client[*compute.SslCertificatesClient]{
+					NamedCredentials: namedCreds,
+					ProjectID:        project,
+					Client:           sslCertificatesClient,
+				},
+			)
+			slog.Info(
+				"configured GCP client",
+				"service", "compute",
+				"sub_service", "ssl-certificates",
+				"credentials", namedCreds,
+				"project", project,
+			)
+
+			// Target HTTPS proxies clients
+			httpsProxiesClient, err := compute.NewTargetHttpsProxiesRESTClient(ctx, opts...)
+			if err != nil {
+				return fmt.Errorf("gcp: cannot create target HTTPS proxies client for %s: %w", namedCreds, err)
+			}
+			overwriteGCPClient(
+				gcpclients.TargetHTTPSProxiesClientset,
+				project,
+				&gcpclients.Client[*compute.TargetHttpsProxiesClient]{
+					NamedCredentials: namedCreds,
+					ProjectID:        project,
+					Client:           httpsProxiesClient,
+				},
+			)
+			slog.Info(
+				"configured GCP client",
+				"service", "compute",
+				"sub_service", "target-https-proxies",
+				"credentials", namedCreds,
+				"project", project,
+			)
+
+			// Target SSL proxies clients
+			sslProxiesClient, err := compute.NewTargetSslProxiesRESTClient(ctx, opts...)
+			if err != nil {
+				return fmt.Errorf("gcp: cannot create target SSL proxies client for %s: %w", namedCreds, err)
+			}
+			overwriteGCPClient(
+				gcpclients.TargetSSLProxiesClientset,
+				project,
+				&gcpclients.Client[*compute.TargetSslProxiesClient]{
+					NamedCredentials: namedCreds,
+					ProjectID:        project,
+					Client:           sslProxiesClient,
+				},
+			)
+			slog.Info(
+				"configured GCP client",
+				"service", "compute",
+				"sub_service", "target-ssl-proxies",
+				"credentials", namedCreds,
+				"project", project,
+			)
 		}
 	}
 
@@ -345,7 +573,7 @@ func configureGCPComputeClientsets(ctx context.Context, conf *config.Config) err
 // configureGCPStorageClientsets configures the GCP storage API clientsets.
 func configureGCPStorageClientsets(ctx context.Context, conf *config.Config) error {
 	for _, namedCreds := range conf.GCP.Services.Storage.UseCredentials {
-		opts, err := getGCPClientOptions(conf, namedCreds)
+		opts, err := getGCPClientOptions(ctx, conf, namedCreds)
 		if err != nil {
 			return err
 		}
@@ -357,12 +585,17 @@ func configureGCPStorageClientsets(ctx context.Context, conf *config.Config) err
 
 		// Register the client for each specified GCP project
 		for _, project := range nc.Projects {
+			if !isGCPProjectAllowed(conf, "storage", namedCreds, project) {
+				continue
+			}
+
 			// Buckets
 			storageClient, err := storage.NewClient(ctx, opts...)
 			if err != nil {
 				return fmt.Errorf("gcp: cannot create gcp storage client for %s: %w", namedCreds, err)
 			}
-			gcpclients.StorageClientset.Overwrite(
+			overwriteGCPClient(
+				gcpclients.StorageClientset,
 				project,
 				&gcpclients.Client[*storage.Client]{
 					NamedCredentials: namedCreds,
@@ -385,7 +618,7 @@ func configureGCPStorageClientsets(ctx context.Context, conf *config.Config) err
 // configureGKEClientsets configures the GKE related API clients.
 func configureGKEClientsets(ctx context.Context, conf *config.Config) error {
 	for _, namedCreds := range conf.GCP.Services.GKE.UseCredentials {
-		opts, err := getGCPClientOptions(conf, namedCreds)
+		opts, err := getGCPClientOptions(ctx, conf, namedCreds)
 		if err != nil {
 			return err
 		}
@@ -397,11 +630,16 @@ func configureGKEClientsets(ctx context.Context, conf *config.Config) error {
 
 		// Register the client for each specified GCP project
 		for _, project := range nc.Projects {
+			if !isGCPProjectAllowed(conf, "cluster_manager", namedCreds, project) {
+				continue
+			}
+
 			client, err := container.NewClusterManagerRESTClient(ctx, opts...)
 			if err != nil {
 				return fmt.Errorf("gcp: cannot create gcp cluster manager client for %s: %w", namedCreds, err)
 			}
-			gcpclients.ClusterManagerClientset.Overwrite(
+			overwriteGCPClient(
+				gcpclients.ClusterManagerClientset,
 				project,
 				&gcpclients.Client[*container.ClusterManagerClient]{
 					NamedCredentials: namedCreds,
@@ -422,6 +660,95 @@ func configureGKEClientsets(ctx context.Context, conf *config.Config) error {
 	return nil
 }
 
+// configureGCPCloudSQLClientsets configures the GCP Cloud SQL Admin API
+// clientsets.
+func configureGCPCloudSQLClientsets(ctx context.Context, conf *config.Config) error {
+	for _, namedCreds := range conf.GCP.Services.CloudSQL.UseCredentials {
+		opts, err := getGCPClientOptions(ctx, conf, namedCreds)
+		if err != nil {
+			return err
+		}
+
+		nc, ok := conf.GCP.Credentials[namedCreds]
+		if !ok {
+			return fmt.Errorf("gcp: %w: %s", errUnknownNamedCredentials, namedCreds)
+		}
+
+		// Register the client for each specified GCP project
+		for _, project := range nc.Projects {
+			if !isGCPProjectAllowed(conf, "cloud_sql", namedCreds, project) {
+				continue
+			}
+
+			client, err := sqladmin.NewService(ctx, opts...)
+			if err != nil {
+				return fmt.Errorf("gcp: cannot create gcp sql admin client for %s: %w", namedCreds, err)
+			}
+			gcpclients.SQLAdminClientset.Overwrite(
+				project,
+				&gcpclients.Client[*sqladmin.Service]{
+					NamedCredentials: namedCreds,
+					ProjectID:        project,
+					Client:           client,
+				},
+			)
+
+			slog.Info(
+				"configured GCP client",
+				"service", "cloud_sql",
+				"credentials", namedCreds,
+				"project", project,
+			)
+		}
+	}
+
+	return nil
+}
+
+// configureGCPIAMClientsets configures the GCP IAM API clientsets.
+func configureGCPIAMClientsets(ctx context.Context, conf *config.Config) error {
+	for _, namedCreds := range conf.GCP.Services.IAM.UseCredentials {
+		opts, err := getGCPClientOptions(ctx, conf, namedCreds)
+		if err != nil {
+			return err
+		}
+
+		nc, ok := conf.GCP.Credentials[namedCreds]
+		if !ok {
+			return fmt.Errorf("gcp: %w: %s", errUnknownNamedCredentials, namedCreds)
+		}
+
+		// Register the client for each specified GCP project
+		for _, project := range nc.Projects {
+			if !isGCPProjectAllowed(conf, "iam", namedCreds, project) {
+				continue
+			}
+
+			client, err := iam.NewService(ctx, opts...)
+			if err != nil {
+				return fmt.Errorf("gcp: cannot create gcp iam client for %s: %w", namedCreds, err)
+			}
+			gcpclients.IAMClientset.Overwrite(
+				project,
+				&gcpclients.Client[*iam.Service]{
+					NamedCredentials: namedCreds,
+					ProjectID:        project,
+					Client:           client,
+				},
+			)
+
+			slog.Info(
+				"configured GCP client",
+				"service", "iam",
+				"credentials", namedCreds,
+				"project", project,
+			)
+		}
+	}
+
+	return nil
+}
+
 // configureGCPClients creates the GCP API clients from the specified
 // configuration.
 func configureGCPClients(ctx context.Context, conf *config.Config) error {
@@ -441,15 +768,43 @@ func configureGCPClients(ctx context.Context, conf *config.Config) error {
 		"compute":          configureGCPComputeClientsets,
 		"storage":          configureGCPStorageClientsets,
 		"gke":              configureGKEClientsets,
+		"cloud_sql":        configureGCPCloudSQLClientsets,
+		"iam":              configureGCPIAMClientsets,
 	}
 
-	for svc, configFunc := range configFuncs {
-		if err := configFunc(ctx, conf); err != nil {
-			return fmt.Errorf("unable to configure GCP clients for %s: %w", svc, err)
-		}
+	enabled := map[string]bool{
+		"resource_manager": conf.GCP.Services.ResourceManager.Enabled(),
+		"compute":          conf.GCP.Services.Compute.Enabled(),
+		"storage":          conf.GCP.Services.Storage.Enabled(),
+		"gke":              conf.GCP.Services.GKE.Enabled(),
+		"cloud_sql":        conf.GCP.Services.CloudSQL.Enabled(),
+		"iam":              conf.GCP.Services.IAM.Enabled(),
 	}
 
-	return nil
+	return configureServiceClients(ctx, conf, "GCP", configFuncs, enabled)
+}
+
+// overwriteGCPClient registers the given client for the project with the
+// clientset, and closes the client it replaces, if any, e.g. when the clients
+// are reloaded.
+func overwriteGCPClient[T interface{ Close() error }](
+	clientset *registry.Registry[string, *gcpclients.Client[T]],
+	project string,
+	client *gcpclients.Client[T],
+) {
+	prev, ok := clientset.Swap(project, client)
+	if !ok || prev == nil {
+		return
+	}
+
+	if err := prev.Client.Close(); err != nil {
+		slog.Warn(
+			"failed to close replaced GCP client",
+			"credentials", prev.NamedCredentials,
+			"project", project,
+			"reason", err,
+		)
+	}
 }
 
 // closeGCPClients closes the existing GCP client connections
@@ -497,4 +852,28 @@ func closeGCPClients() {
 	_ = gcpclients.TargetPoolsClientset.Range(func(_ string, client *gcpclients.Client[*compute.TargetPoolsClient]) error {
 		return client.Client.Close()
 	})
+
+	_ = gcpclients.ReservationsClientset.Range(func(_ string, client *gcpclients.Client[*compute.ReservationsClient]) error {
+		return client.Client.Close()
+	})
+
+	_ = gcpclients.RegionCommitmentsClientset.Range(func(_ string, client *gcpclients.Client[*compute.RegionCommitmentsClient]) error {
+		return client.Client.Close()
+	})
+
+	_ = gcpclients.RegionsClientset.Range(func(_ string, client *gcpclients.Client[*compute.RegionsClient]) error {
+		return client.Client.Close()
+	})
+
+	_ = gcpclients.SSLCertificatesClientset.Range(func(_ string, client *gcpclients.Client[*compute.SslCertificatesClient]) error {
+		return client.Client.Close()
+	})
+
+	_ = gcpclients.TargetHTTPSProxiesClientset.Range(func(_ string, client *gcpclients.Client[*compute.TargetHttpsProxiesClient]) error {
+		return client.Client.Close()
+	})
+
+	_ = gcpclients.TargetSSLProxiesClientset.Range(func(_ string, client *gcpclients.Client[*compute.TargetSslProxiesClient]) error {
+		return client.Client.Close()
+	})
 }
@@ -175,17 +175,21 @@ func configureOpenStackClients(ctx context.Context, conf *config.Config) error {
 		"block_storage":  configureOpenStackBlockStorageClientsets,
 	}
 
-	for svc, configFunc := range configFuncs {
-		if err := configFunc(ctx, conf); err != nil {
-			return fmt.Errorf("unable to configure OpenStack clients for %s: %w", svc, err)
-		}
+	enabled := map[string]bool{
+		"compute":        conf.OpenStack.Services.Compute.Enabled(),
+		"network":        conf.OpenStack.Services.Network.Enabled(),
+		"object_storage": conf.OpenStack.Services.ObjectStorage.Enabled(),
+		"load_balancer":  conf.OpenStack.Services.LoadBalancer.Enabled(),
+		"identity":       conf.OpenStack.Services.Identity.Enabled(),
+		"block_storage":  conf.OpenStack.Services.BlockStorage.Enabled(),
 	}
 
-	return nil
+	return configureServiceClients(ctx, conf, "OpenStack", configFuncs, enabled)
 }
 
 func newOpenStackProviderClient(
 	ctx context.Context,
+	conf *config.Config,
 	creds *config.OpenStackCredentialsConfig,
 ) (*gophercloud.ProviderClient, error) {
 	var authOpts gophercloud.AuthOptions
@@ -299,7 +303,12 @@ func newOpenStackProviderClient(
 		return nil, fmt.Errorf("unknown authentication method: %s", creds.Authentication)
 	}
 
-	return gophercloudconfig.NewProviderClient(ctx, authOpts)
+	httpClient, err := newProviderHTTPClient(conf, conf.OpenStack.APITimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return gophercloudconfig.NewProviderClient(ctx, authOpts, gophercloudconfig.WithHTTPClient(*httpClient))
 }
 
 func configureOpenStackServiceClientset(
@@ -315,7 +324,7 @@ func configureOpenStackServiceClientset(
 			return fmt.Errorf("openstack: %w: %q", errUnknownNamedCredentials, credentials)
 		}
 
-		providerClient, err := newOpenStackProviderClient(ctx, &namedCreds)
+		providerClient, err := newOpenStackProviderClient(ctx, conf, &namedCreds)
 
 		if err != nil {
 			return fmt.Errorf("unable to create client for service with credentials %s: %w", credentials, err)
@@ -342,6 +351,17 @@ func configureOpenStackServiceClientset(
 		}
 
 		clientScope.ProjectID = projectID
+		if !conf.OpenStack.Projects.Allows(namedCreds.Project, projectID) {
+			slog.Info(
+				"skipping filtered OpenStack project",
+				"service", serviceName,
+				"credentials", credentials,
+				"project", namedCreds.Project,
+				"project_id", projectID,
+			)
+
+			continue
+		}
 
 		client := openstackclients.Client[*gophercloud.ServiceClient]{
 			ClientScope: clientScope,
@@ -23,6 +23,11 @@ import (
 	dbclient "github.com/gardener/inventory/pkg/clients/db"
 	"github.com/gardener/inventory/pkg/core/config"
 	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/core/supervisor"
+	"github.com/gardener/inventory/pkg/events"
+	"github.com/gardener/inventory/pkg/servicenow"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	slogutils "github.com/gardener/inventory/pkg/utils/slog"
 )
 
 // NewWorkerCommand returns a new command for interfacing with the workers.
@@ -38,14 +43,17 @@ func NewWorkerCommand() *cli.Command {
 				Aliases: []string{"ls"},
 				Action: func(ctx *cli.Context) error {
 					conf := getConfig(ctx)
-					inspector := newInspector(conf)
+					inspector, err := newInspector(conf)
+					if err != nil {
+						return err
+					}
 					defer inspector.Close() // nolint: errcheck
 					servers, err := inspector.Servers()
 					if err != nil {
 						return err
 					}
 
-					if len(servers) == 0 {
+					if len(servers) == 0 && !isStructuredOutput(ctx) {
 						return nil
 					}
 
@@ -58,7 +66,7 @@ func NewWorkerCommand() *cli.Command {
 						"UPTIME",
 						"QUEUES",
 					}
-					table := newTableWriter(os.Stdout, headers)
+					table := newOutputWriter(ctx, os.Stdout, headers)
 
 					for _, item := range servers {
 						uptime := time.Since(item.Started)
@@ -121,7 +129,10 @@ func NewWorkerCommand() *cli.Command {
 					}
 
 					conf := getConfig(ctx)
-					inspector := newInspector(conf)
+					inspector, err := newInspector(conf)
+					if err != nil {
+						return err
+					}
 					defer inspector.Close() // nolint: errcheck
 					servers, err := inspector.Servers()
 					if err != nil {
@@ -143,6 +154,27 @@ func NewWorkerCommand() *cli.Command {
 					return nil
 				},
 			},
+			{
+				Name:  "reload-clients",
+				Usage: "reload the API clients of the running workers",
+				Action: func(ctx *cli.Context) error {
+					conf := getConfig(ctx)
+					client, err := newRedisClient(conf)
+					if err != nil {
+						return err
+					}
+					defer client.Close() // nolint: errcheck
+
+					receivers, err := client.Publish(ctx.Context, reloadClientsChannel, "reload").Result()
+					if err != nil {
+						return err
+					}
+
+					fmt.Printf("Reload requested, received by %d worker(s)\n", receivers) // #nolint
+
+					return nil
+				},
+			},
 			{
 				Name:    "start",
 				Usage:   "start worker",
@@ -154,11 +186,20 @@ func NewWorkerCommand() *cli.Command {
 						return err
 					}
 					defer db.Close() // nolint: errcheck
-					client := newAsynqClient(conf)
+					client, err := newAsynqClient(conf)
+					if err != nil {
+						return err
+					}
 					defer client.Close() // nolint: errcheck
-					inspector := newInspector(conf)
+					inspector, err := newInspector(conf)
+					if err != nil {
+						return err
+					}
 					defer inspector.Close() // nolint: errcheck
-					worker := newWorker(ctx.Context, conf)
+					worker, err := newWorker(ctx.Context, conf)
+					if err != nil {
+						return err
+					}
 
 					// Gardener client configs
 					if err := configureGardenerClient(ctx.Context, conf); err != nil {
@@ -171,28 +212,45 @@ func NewWorkerCommand() *cli.Command {
 
 					slog.Info("configuring asynq client")
 					asynqclient.SetClient(client)
+					asynqclient.SetDefaultOptions(asynqutils.NewUniqueOptionsFromConfig(conf.UniqueTasks)...)
 
 					// Initialize async inspector
 					slog.Info("configuring asynq inspector")
 					asynqclient.SetInspector(inspector)
 
-					// Vault clients are configured first in
-					// order to enable other datasources to
-					// be initialized from Vault secrets.
-					configureClientFuncs := []func(context.Context, *config.Config) error{
-						configureVaultClients,
-						configureAWSClients,
-						configureGCPClients,
-						configureAzureClients,
-						configureOpenStackClients,
+					// Change events are published only
+					// when enabled.
+					if conf.Events.IsEnabled {
+						slog.Info("configuring events publisher", "backend", conf.Events.Backend)
+						publisher, err := events.NewPublisher(conf.Events)
+						if err != nil {
+							return err
+						}
+						defer publisher.Close() // nolint: errcheck
+						events.SetPublisher(publisher)
 					}
 
-					for _, configureClientsFunc := range configureClientFuncs {
-						if err := configureClientsFunc(ctx.Context, conf); err != nil {
+					if conf.ServiceNow.IsEnabled {
+						slog.Info("configuring servicenow client", "url", conf.ServiceNow.URL)
+						client, err := servicenow.NewClient(conf.ServiceNow)
+						if err != nil {
 							return err
 						}
+						servicenow.SetClient(client)
 					}
 
+					// Vault clients are configured first in
+					// order to enable other datasources to
+					// be initialized from Vault secrets.
+					if err := configureVaultClients(ctx.Context, conf); err != nil {
+						return err
+					}
+
+					// Failing providers are reported, but
+					// do not prevent the worker from
+					// collecting from the remaining ones.
+					configureProviderClients(ctx.Context, conf)
+
 					defer closeGCPClients()
 
 					// Register our task handlers using the default registry
@@ -209,13 +267,134 @@ func NewWorkerCommand() *cli.Command {
 						slog.Info("queue configuration", "name", queue, "priority", priority)
 					}
 
-					defer worker.Shutdown()
+					components := []supervisor.Component{
+						newReloadClientsComponent(ctx, conf),
+					}
+					if conf.Gardener.IsEnabled && conf.Gardener.Cache.IsEnabled {
+						components = append(components, newGardenerCacheComponent(conf))
+					}
 
-					return worker.Run()
+					return worker.Run(ctx.Context, components...)
+				},
+			},
+			{
+				Name:         "run-task",
+				Usage:        "run a single task in the foreground for debugging",
+				BashComplete: newNameCompleter(registeredTaskNames, false, "task", "t"),
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "task",
+						Aliases:  []string{"t"},
+						Usage:    "name of task to run",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  "payload",
+						Usage: "task payload",
+					},
+					&cli.PathFlag{
+						Name:  "payload-file",
+						Usage: "path to a payload file",
+					},
+					&cli.DurationFlag{
+						Name:  "timeout",
+						Usage: "set timeout for task",
+						Value: 30 * time.Minute,
+					},
 				},
+				Action: execWorkerRunTaskCmd,
 			},
 		},
 	}
 
 	return cmd
 }
+
+// execWorkerRunTaskCmd runs the handler of a single task in the foreground
+// with debug logging, without connecting to Redis. Tasks enqueued by the
+// handler fail with [asynqclient.ErrNoClient].
+func execWorkerRunTaskCmd(ctx *cli.Context) error {
+	conf := getConfig(ctx)
+	taskName := ctx.String("task")
+	handler, ok := registry.TaskRegistry.Get(taskName)
+	if !ok {
+		return fmt.Errorf("%w: %s", errTaskNotFound, taskName)
+	}
+
+	payload, err := readTaskPayload(ctx, taskName)
+	if err != nil {
+		return err
+	}
+
+	// Debug logging is always enabled, including the database queries.
+	// The default logger is reused with its level overridden, so that
+	// no additional log sinks are opened.
+	conf.Debug = true
+	logger := slog.New(slogutils.NewLevelHandler(slog.LevelDebug, slog.Default().Handler()))
+	slog.SetDefault(logger)
+
+	db, err := newDB(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	if err := configureGardenerClient(ctx.Context, conf); err != nil {
+		return err
+	}
+
+	slog.Info("configuring db client")
+	dbclient.SetDB(db)
+
+	if conf.Events.IsEnabled {
+		slog.Info("configuring events publisher", "backend", conf.Events.Backend)
+		publisher, err := events.NewPublisher(conf.Events)
+		if err != nil {
+			return err
+		}
+		defer publisher.Close() // nolint: errcheck
+		events.SetPublisher(publisher)
+	}
+
+	if conf.ServiceNow.IsEnabled {
+		slog.Info("configuring servicenow client", "url", conf.ServiceNow.URL)
+		client, err := servicenow.NewClient(conf.ServiceNow)
+		if err != nil {
+			return err
+		}
+		servicenow.SetClient(client)
+	}
+
+	if err := configureVaultClients(ctx.Context, conf); err != nil {
+		return err
+	}
+	configureProviderClients(ctx.Context, conf)
+	defer closeGCPClients()
+
+	// The middlewares are applied in the same order as by the worker,
+	// except for recording the collection state, so that debugging runs
+	// are not reported as regular collections.
+	middlewares := []asynq.MiddlewareFunc{
+		// The logger rules, sampling and rate limits are not
+		// applied, so that none of the debug output is suppressed.
+		asynqutils.NewLoggerMiddleware(logger, config.LoggingConfig{}),
+		asynqutils.NewConfigMiddleware(conf),
+		asynqutils.NewMeasuringMiddleware(),
+		asynqutils.NewMetricsMiddleware(),
+		asynqutils.NewErrorClassificationMiddleware(),
+		asynqutils.NewPayloadValidationMiddleware(registry.PayloadSchemaRegistry),
+	}
+	for _, mw := range slices.Backward(middlewares) {
+		handler = mw(handler)
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx.Context, ctx.Duration("timeout"))
+	defer cancel()
+
+	task := asynq.NewTask(taskName, payload)
+	if err := handler.ProcessTask(runCtx, task); err != nil {
+		return fmt.Errorf("task %q failed: %w", taskName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,6 @@
+-- description: Shoots hosted on a seed
+-- param: seed Name of the seed
+SELECT project_name, name, technical_id, k8s_version, is_hibernated
+FROM g_shoot
+WHERE seed_name = ?seed
+ORDER BY project_name, name;
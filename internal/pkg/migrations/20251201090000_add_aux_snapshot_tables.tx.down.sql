@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "aux_snapshot_item";
+DROP TABLE IF EXISTS "aux_snapshot_run";
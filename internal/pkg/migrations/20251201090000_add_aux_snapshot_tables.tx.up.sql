@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS "aux_snapshot_run" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid (),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "started_at" timestamptz NOT NULL,
+    "completed_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "aux_snapshot_item" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid (),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "run_id" uuid NOT NULL REFERENCES "aux_snapshot_run" ("id") ON DELETE CASCADE,
+    "model_name" varchar NOT NULL,
+    "data" jsonb NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS "aux_snapshot_item_run_model_idx" ON "aux_snapshot_item" ("run_id", "model_name");
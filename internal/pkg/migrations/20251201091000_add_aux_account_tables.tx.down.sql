@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "l_aux_account_to_resource";
+DROP TABLE IF EXISTS "aux_account";
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS "aux_account" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid (),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "provider" varchar NOT NULL,
+    "account_id" varchar NOT NULL,
+    "display_name" varchar NOT NULL,
+    "source" varchar NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "aux_account_key" UNIQUE ("provider", "account_id")
+);
+
+CREATE TABLE IF NOT EXISTS "l_aux_account_to_resource" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid (),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "account_id" uuid NOT NULL,
+    "model_name" varchar NOT NULL,
+    "resource_id" uuid NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "l_aux_account_to_resource_key" UNIQUE ("account_id", "model_name", "resource_id")
+);
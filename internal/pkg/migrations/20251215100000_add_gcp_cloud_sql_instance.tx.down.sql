@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "gcp_cloud_sql_instance";
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS "gcp_cloud_sql_instance" (
+    "name" varchar NOT NULL,
+    "project_id" varchar NOT NULL,
+    "region" varchar NOT NULL,
+    "zone" varchar,
+    "database_version" varchar NOT NULL,
+    "state" varchar NOT NULL,
+    "instance_type" varchar NOT NULL,
+    "backend_type" varchar NOT NULL,
+    "edition" varchar,
+    "tier" varchar,
+    "availability_type" varchar,
+    "data_disk_size_gb" bigint NOT NULL,
+    "data_disk_type" varchar,
+    "connection_name" varchar NOT NULL,
+    "private_network" varchar,
+    "primary_ip" inet,
+    "private_ip" inet,
+    "master_instance_name" varchar,
+    "creation_timestamp" varchar,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "gcp_cloud_sql_instance_key" UNIQUE ("name", "project_id")
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "l_gcp_cloud_sql_instance_to_project";
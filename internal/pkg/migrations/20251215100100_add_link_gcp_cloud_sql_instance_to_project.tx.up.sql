@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "l_gcp_cloud_sql_instance_to_project" (
+    "instance_id" uuid NOT NULL,
+    "project_id" uuid NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("instance_id") REFERENCES "gcp_cloud_sql_instance" ("id") ON DELETE CASCADE,
+    FOREIGN KEY ("project_id") REFERENCES "gcp_project" ("id") ON DELETE CASCADE,
+    CONSTRAINT "l_gcp_cloud_sql_instance_to_project_key" UNIQUE ("instance_id", "project_id")
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "gcp_service_account_key";
+DROP TABLE IF EXISTS "gcp_service_account";
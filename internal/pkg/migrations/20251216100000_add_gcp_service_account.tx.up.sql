@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS "gcp_service_account" (
+    "email" varchar NOT NULL,
+    "project_id" varchar NOT NULL,
+    "unique_id" varchar NOT NULL,
+    "name" varchar NOT NULL,
+    "display_name" varchar,
+    "description" varchar,
+    "disabled" boolean NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "gcp_service_account_key" UNIQUE ("email", "project_id")
+);
+
+CREATE TABLE IF NOT EXISTS "gcp_service_account_key" (
+    "key_id" varchar NOT NULL,
+    "service_account_email" varchar NOT NULL,
+    "project_id" varchar NOT NULL,
+    "key_type" varchar NOT NULL,
+    "key_origin" varchar,
+    "key_algorithm" varchar,
+    "disabled" boolean NOT NULL,
+    "valid_after" timestamptz,
+    "valid_before" timestamptz,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "gcp_service_account_key_key" UNIQUE ("key_id", "service_account_email", "project_id")
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "l_gcp_service_account_key_to_service_account";
+DROP TABLE IF EXISTS "l_gcp_service_account_to_project";
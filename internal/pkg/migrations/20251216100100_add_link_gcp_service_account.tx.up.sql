@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS "l_gcp_service_account_to_project" (
+    "service_account_id" uuid NOT NULL,
+    "project_id" uuid NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("service_account_id") REFERENCES "gcp_service_account" ("id") ON DELETE CASCADE,
+    FOREIGN KEY ("project_id") REFERENCES "gcp_project" ("id") ON DELETE CASCADE,
+    CONSTRAINT "l_gcp_service_account_to_project_key" UNIQUE ("service_account_id", "project_id")
+);
+
+CREATE TABLE IF NOT EXISTS "l_gcp_service_account_key_to_service_account" (
+    "key_id" uuid NOT NULL,
+    "service_account_id" uuid NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("key_id") REFERENCES "gcp_service_account_key" ("id") ON DELETE CASCADE,
+    FOREIGN KEY ("service_account_id") REFERENCES "gcp_service_account" ("id") ON DELETE CASCADE,
+    CONSTRAINT "l_gcp_service_account_key_to_service_account_key" UNIQUE ("key_id", "service_account_id")
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "gcp_commitment";
+DROP TABLE IF EXISTS "gcp_reservation";
@@ -0,0 +1,47 @@
+CREATE TABLE IF NOT EXISTS "gcp_reservation" (
+    "reservation_id" bigint NOT NULL,
+    "project_id" varchar NOT NULL,
+    "name" varchar NOT NULL,
+    "description" varchar NOT NULL,
+    "zone" varchar NOT NULL,
+    "region" varchar NOT NULL,
+    "status" varchar NOT NULL,
+    "machine_type" varchar,
+    "count" bigint NOT NULL,
+    "in_use_count" bigint NOT NULL,
+    "specific_required" boolean NOT NULL,
+    "commitment" varchar,
+    "creation_timestamp" varchar,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "gcp_reservation_key" UNIQUE ("reservation_id", "project_id")
+);
+
+CREATE TABLE IF NOT EXISTS "gcp_commitment" (
+    "commitment_id" bigint NOT NULL,
+    "project_id" varchar NOT NULL,
+    "name" varchar NOT NULL,
+    "description" varchar NOT NULL,
+    "region" varchar NOT NULL,
+    "status" varchar NOT NULL,
+    "plan" varchar NOT NULL,
+    "type" varchar,
+    "category" varchar,
+    "auto_renew" boolean NOT NULL,
+    "vcpus" bigint NOT NULL,
+    "memory_mb" bigint NOT NULL,
+    "start_timestamp" varchar,
+    "end_timestamp" varchar,
+    "creation_timestamp" varchar,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "gcp_commitment_key" UNIQUE ("commitment_id", "project_id")
+);
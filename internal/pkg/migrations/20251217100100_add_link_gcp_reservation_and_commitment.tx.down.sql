@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "l_gcp_commitment_to_project";
+DROP TABLE IF EXISTS "l_gcp_reservation_to_project";
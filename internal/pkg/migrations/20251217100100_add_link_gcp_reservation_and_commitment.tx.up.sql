@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS "l_gcp_reservation_to_project" (
+    "reservation_id" uuid NOT NULL,
+    "project_id" uuid NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("reservation_id") REFERENCES "gcp_reservation" ("id") ON DELETE CASCADE,
+    FOREIGN KEY ("project_id") REFERENCES "gcp_project" ("id") ON DELETE CASCADE,
+    CONSTRAINT "l_gcp_reservation_to_project_key" UNIQUE ("reservation_id", "project_id")
+);
+
+CREATE TABLE IF NOT EXISTS "l_gcp_commitment_to_project" (
+    "commitment_id" uuid NOT NULL,
+    "project_id" uuid NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("commitment_id") REFERENCES "gcp_commitment" ("id") ON DELETE CASCADE,
+    FOREIGN KEY ("project_id") REFERENCES "gcp_project" ("id") ON DELETE CASCADE,
+    CONSTRAINT "l_gcp_commitment_to_project_key" UNIQUE ("commitment_id", "project_id")
+);
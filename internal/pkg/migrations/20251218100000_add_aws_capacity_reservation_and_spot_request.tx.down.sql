@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "aws_spot_instance_request";
+DROP TABLE IF EXISTS "aws_capacity_reservation";
@@ -0,0 +1,51 @@
+CREATE TABLE IF NOT EXISTS "aws_capacity_reservation" (
+    "reservation_id" varchar NOT NULL,
+    "account_id" varchar NOT NULL,
+    "arn" varchar NOT NULL,
+    "owner_id" varchar NOT NULL,
+    "instance_type" varchar NOT NULL,
+    "instance_platform" varchar NOT NULL,
+    "tenancy" varchar NOT NULL,
+    "state" varchar NOT NULL,
+    "total_instance_count" integer NOT NULL,
+    "available_instance_count" integer NOT NULL,
+    "instance_match_criteria" varchar NOT NULL,
+    "end_date_type" varchar NOT NULL,
+    "start_date" timestamptz,
+    "end_date" timestamptz,
+    "create_date" timestamptz,
+    "az" varchar NOT NULL,
+    "az_id" varchar NOT NULL,
+    "region_name" varchar NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "aws_capacity_reservation_key" UNIQUE ("reservation_id", "account_id")
+);
+
+CREATE TABLE IF NOT EXISTS "aws_spot_instance_request" (
+    "request_id" varchar NOT NULL,
+    "account_id" varchar NOT NULL,
+    "type" varchar NOT NULL,
+    "state" varchar NOT NULL,
+    "status_code" varchar NOT NULL,
+    "instance_id" varchar NOT NULL,
+    "instance_type" varchar NOT NULL,
+    "product_description" varchar NOT NULL,
+    "spot_price" varchar NOT NULL,
+    "valid_from" timestamptz,
+    "valid_until" timestamptz,
+    "create_time" timestamptz,
+    "az" varchar NOT NULL,
+    "region_name" varchar NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "aws_spot_instance_request_key" UNIQUE ("request_id", "account_id")
+);
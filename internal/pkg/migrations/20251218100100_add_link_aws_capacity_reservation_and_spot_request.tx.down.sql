@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "l_aws_spot_instance_request_to_az";
+DROP TABLE IF EXISTS "l_aws_spot_instance_request_to_region";
+DROP TABLE IF EXISTS "l_aws_capacity_reservation_to_az";
+DROP TABLE IF EXISTS "l_aws_capacity_reservation_to_region";
@@ -0,0 +1,55 @@
+CREATE TABLE IF NOT EXISTS "l_aws_capacity_reservation_to_region" (
+    "cr_id" uuid NOT NULL,
+    "region_id" uuid NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("cr_id") REFERENCES "aws_capacity_reservation" ("id") ON DELETE CASCADE,
+    FOREIGN KEY ("region_id") REFERENCES "aws_region" ("id") ON DELETE CASCADE,
+    CONSTRAINT "l_aws_capacity_reservation_to_region_key" UNIQUE ("cr_id", "region_id")
+);
+
+CREATE TABLE IF NOT EXISTS "l_aws_capacity_reservation_to_az" (
+    "cr_id" uuid NOT NULL,
+    "az_id" uuid NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("cr_id") REFERENCES "aws_capacity_reservation" ("id") ON DELETE CASCADE,
+    FOREIGN KEY ("az_id") REFERENCES "aws_az" ("id") ON DELETE CASCADE,
+    CONSTRAINT "l_aws_capacity_reservation_to_az_key" UNIQUE ("cr_id", "az_id")
+);
+
+CREATE TABLE IF NOT EXISTS "l_aws_spot_instance_request_to_region" (
+    "sir_id" uuid NOT NULL,
+    "region_id" uuid NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("sir_id") REFERENCES "aws_spot_instance_request" ("id") ON DELETE CASCADE,
+    FOREIGN KEY ("region_id") REFERENCES "aws_region" ("id") ON DELETE CASCADE,
+    CONSTRAINT "l_aws_spot_instance_request_to_region_key" UNIQUE ("sir_id", "region_id")
+);
+
+CREATE TABLE IF NOT EXISTS "l_aws_spot_instance_request_to_az" (
+    "sir_id" uuid NOT NULL,
+    "az_id" uuid NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("sir_id") REFERENCES "aws_spot_instance_request" ("id") ON DELETE CASCADE,
+    FOREIGN KEY ("az_id") REFERENCES "aws_az" ("id") ON DELETE CASCADE,
+    CONSTRAINT "l_aws_spot_instance_request_to_az_key" UNIQUE ("sir_id", "az_id")
+);
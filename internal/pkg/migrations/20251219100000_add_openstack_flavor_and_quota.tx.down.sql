@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "openstack_quota";
+DROP TABLE IF EXISTS "openstack_flavor";
@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS "openstack_flavor" (
+    "flavor_id" varchar NOT NULL,
+    "project_id" varchar NOT NULL,
+    "domain" varchar NOT NULL,
+    "region" varchar NOT NULL,
+    "name" varchar NOT NULL,
+    "description" varchar NOT NULL,
+    "vcpus" integer NOT NULL,
+    "ram" integer NOT NULL,
+    "disk" integer NOT NULL,
+    "ephemeral" integer NOT NULL,
+    "swap" integer NOT NULL,
+    "rxtx_factor" double precision NOT NULL,
+    "is_public" boolean NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "openstack_flavor_key" UNIQUE ("flavor_id", "project_id", "domain", "region")
+);
+
+CREATE TABLE IF NOT EXISTS "openstack_quota" (
+    "project_id" varchar NOT NULL,
+    "domain" varchar NOT NULL,
+    "region" varchar NOT NULL,
+    "service" varchar NOT NULL,
+    "resource" varchar NOT NULL,
+    "quota_limit" integer NOT NULL,
+    "in_use" integer NOT NULL,
+    "reserved" integer NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "openstack_quota_key" UNIQUE ("project_id", "domain", "region", "service", "resource")
+);
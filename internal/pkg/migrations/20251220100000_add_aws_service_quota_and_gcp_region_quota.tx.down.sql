@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "gcp_region_quota";
+DROP TABLE IF EXISTS "l_aws_service_quota_to_region";
+DROP TABLE IF EXISTS "aws_service_quota";
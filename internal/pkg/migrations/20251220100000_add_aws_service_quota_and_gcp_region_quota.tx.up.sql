@@ -0,0 +1,49 @@
+CREATE TABLE IF NOT EXISTS "aws_service_quota" (
+    "account_id" varchar NOT NULL,
+    "region_name" varchar NOT NULL,
+    "service_code" varchar NOT NULL,
+    "quota_code" varchar NOT NULL,
+    "service_name" varchar NOT NULL,
+    "quota_name" varchar NOT NULL,
+    "quota_value" double precision NOT NULL,
+    "unit" varchar NOT NULL,
+    "adjustable" boolean NOT NULL,
+    "global_quota" boolean NOT NULL,
+    "usage" double precision,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "aws_service_quota_key" UNIQUE ("account_id", "region_name", "service_code", "quota_code")
+);
+
+CREATE TABLE IF NOT EXISTS "l_aws_service_quota_to_region" (
+    "sq_id" uuid NOT NULL,
+    "region_id" uuid NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("sq_id") REFERENCES "aws_service_quota" ("id") ON DELETE CASCADE,
+    FOREIGN KEY ("region_id") REFERENCES "aws_region" ("id") ON DELETE CASCADE,
+    CONSTRAINT "l_aws_service_quota_to_region_key" UNIQUE ("sq_id", "region_id")
+);
+
+CREATE TABLE IF NOT EXISTS "gcp_region_quota" (
+    "project_id" varchar NOT NULL,
+    "region" varchar NOT NULL,
+    "metric" varchar NOT NULL,
+    "quota_limit" double precision NOT NULL,
+    "usage" double precision NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "gcp_region_quota_key" UNIQUE ("project_id", "region", "metric")
+);
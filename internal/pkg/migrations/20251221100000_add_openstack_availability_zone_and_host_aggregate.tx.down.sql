@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "l_openstack_server_to_az";
+DROP TABLE IF EXISTS "openstack_host_aggregate";
+DROP TABLE IF EXISTS "openstack_availability_zone";
@@ -0,0 +1,46 @@
+CREATE TABLE IF NOT EXISTS "openstack_availability_zone" (
+    "name" varchar NOT NULL,
+    "project_id" varchar NOT NULL,
+    "domain" varchar NOT NULL,
+    "region" varchar NOT NULL,
+    "available" boolean NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "openstack_availability_zone_key" UNIQUE ("name", "project_id", "domain", "region")
+);
+
+CREATE TABLE IF NOT EXISTS "openstack_host_aggregate" (
+    "aggregate_id" integer NOT NULL,
+    "project_id" varchar NOT NULL,
+    "domain" varchar NOT NULL,
+    "region" varchar NOT NULL,
+    "aggregate_uuid" varchar NOT NULL,
+    "name" varchar NOT NULL,
+    "availability_zone" varchar NOT NULL,
+    "hosts" varchar[],
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "openstack_host_aggregate_key" UNIQUE ("aggregate_id", "project_id", "domain", "region")
+);
+
+CREATE TABLE IF NOT EXISTS "l_openstack_server_to_az" (
+    "server_id" uuid NOT NULL,
+    "az_id" uuid NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("server_id") REFERENCES "openstack_server" ("id") ON DELETE CASCADE,
+    FOREIGN KEY ("az_id") REFERENCES "openstack_availability_zone" ("id") ON DELETE CASCADE,
+    CONSTRAINT "l_openstack_server_to_az_key" UNIQUE ("server_id", "az_id")
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "openstack_server_metadata";
+ALTER TABLE "openstack_server" DROP COLUMN IF EXISTS "key_name";
@@ -0,0 +1,15 @@
+ALTER TABLE "openstack_server" ADD COLUMN "key_name" varchar NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS "openstack_server_metadata" (
+    "server_id" varchar NOT NULL,
+    "project_id" varchar NOT NULL,
+    "key" varchar NOT NULL,
+    "value" varchar NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "openstack_server_metadata_key" UNIQUE ("server_id", "project_id", "key")
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "l_openstack_server_to_server_group";
+DROP TABLE IF EXISTS "openstack_server_group_member";
+DROP TABLE IF EXISTS "openstack_server_group";
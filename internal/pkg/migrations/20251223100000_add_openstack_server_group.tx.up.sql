@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS "openstack_server_group" (
+    "server_group_id" varchar NOT NULL,
+    "project_id" varchar NOT NULL,
+    "domain" varchar NOT NULL,
+    "region" varchar NOT NULL,
+    "name" varchar NOT NULL,
+    "user_id" varchar NOT NULL,
+    "policy" varchar NOT NULL,
+    "max_server_per_host" integer NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "openstack_server_group_key" UNIQUE ("server_group_id", "project_id")
+);
+
+CREATE TABLE IF NOT EXISTS "openstack_server_group_member" (
+    "server_group_id" varchar NOT NULL,
+    "server_id" varchar NOT NULL,
+    "project_id" varchar NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "openstack_server_group_member_key" UNIQUE ("server_group_id", "server_id", "project_id")
+);
+
+CREATE TABLE IF NOT EXISTS "l_openstack_server_to_server_group" (
+    "server_id" uuid NOT NULL,
+    "server_group_id" uuid NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("server_id") REFERENCES "openstack_server" ("id") ON DELETE CASCADE,
+    FOREIGN KEY ("server_group_id") REFERENCES "openstack_server_group" ("id") ON DELETE CASCADE,
+    CONSTRAINT "l_openstack_server_to_server_group_key" UNIQUE ("server_id", "server_group_id")
+);
@@ -0,0 +1,2 @@
+ALTER TABLE "g_persistent_volume" DROP COLUMN IF EXISTS "claim_namespace";
+DROP TABLE IF EXISTS "l_aux_shoot_to_resource";
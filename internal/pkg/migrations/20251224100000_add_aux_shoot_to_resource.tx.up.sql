@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS "l_aux_shoot_to_resource" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid (),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "shoot_id" uuid NOT NULL,
+    "model_name" varchar NOT NULL,
+    "resource_id" uuid NOT NULL,
+    "kind" varchar NOT NULL,
+    "method" varchar NOT NULL,
+    "confidence" varchar NOT NULL,
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("shoot_id") REFERENCES "g_shoot" ("id") ON DELETE CASCADE,
+    CONSTRAINT "l_aux_shoot_to_resource_key" UNIQUE ("shoot_id", "model_name", "resource_id")
+);
+
+ALTER TABLE "g_persistent_volume" ADD COLUMN "claim_namespace" varchar;
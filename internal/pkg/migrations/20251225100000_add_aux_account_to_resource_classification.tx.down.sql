@@ -0,0 +1 @@
+ALTER TABLE "l_aux_account_to_resource" DROP COLUMN IF EXISTS "classification";
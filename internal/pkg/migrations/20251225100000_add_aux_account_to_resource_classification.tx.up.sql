@@ -0,0 +1 @@
+ALTER TABLE "l_aux_account_to_resource" ADD COLUMN "classification" varchar NOT NULL DEFAULT 'unknown';
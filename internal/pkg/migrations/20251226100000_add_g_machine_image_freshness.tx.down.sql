@@ -0,0 +1,3 @@
+DROP VIEW IF EXISTS "g_machine_image_freshness";
+ALTER TABLE "gcp_disk" DROP COLUMN IF EXISTS "source_image";
+DROP TABLE IF EXISTS "g_cloud_profile_image_version";
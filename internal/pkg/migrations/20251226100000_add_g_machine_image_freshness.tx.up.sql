@@ -0,0 +1,121 @@
+CREATE TABLE IF NOT EXISTS "g_cloud_profile_image_version" (
+    "name" varchar NOT NULL,
+    "version" varchar NOT NULL,
+    "cloud_profile_name" varchar NOT NULL,
+    "classification" varchar,
+    "expiration_date" timestamptz,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "g_cloud_profile_image_version_key" UNIQUE ("name", "version", "cloud_profile_name")
+);
+
+ALTER TABLE "gcp_disk" ADD COLUMN "source_image" varchar;
+
+CREATE OR REPLACE VIEW "g_machine_image_freshness" AS
+WITH machine_image AS (
+    SELECT
+        m.id AS machine_id,
+        m.name AS machine_name,
+        m.namespace,
+        s.name AS shoot,
+        s.project_name AS project,
+        s.cloud_profile,
+        'aws' AS provider,
+        i.image_id AS image_ref,
+        cpi.name AS image_name,
+        cpi.version AS image_version
+    FROM g_machine AS m
+    INNER JOIN g_shoot AS s ON s.technical_id = m.namespace
+    INNER JOIN aws_instance AS i ON i.instance_id = substring(m.provider_id from '[^/]+$')
+    LEFT JOIN g_cloud_profile_aws_image AS cpi ON cpi.ami = i.image_id
+        AND cpi.region_name = i.region_name
+        AND cpi.cloud_profile_name = s.cloud_profile
+    WHERE m.provider_id LIKE 'aws://%'
+    UNION ALL
+    SELECT
+        m.id AS machine_id,
+        m.name AS machine_name,
+        m.namespace,
+        s.name AS shoot,
+        s.project_name AS project,
+        s.cloud_profile,
+        'gcp' AS provider,
+        d.source_image AS image_ref,
+        cpi.name AS image_name,
+        cpi.version AS image_version
+    FROM g_machine AS m
+    INNER JOIN g_shoot AS s ON s.technical_id = m.namespace
+    INNER JOIN gcp_instance AS i ON i.project_id = split_part(m.provider_id, '/', 3)
+        AND i.name = substring(m.provider_id from '[^/]+$')
+    INNER JOIN gcp_attached_disk AS ad ON ad.project_id = i.project_id AND ad.instance_name = i.name
+    INNER JOIN gcp_disk AS d ON d.project_id = ad.project_id AND d.name = ad.disk_name AND d.zone = ad.zone
+    LEFT JOIN g_cloud_profile_gcp_image AS cpi ON cpi.image = d.source_image
+        AND cpi.cloud_profile_name = s.cloud_profile
+    WHERE m.provider_id LIKE 'gce://%' AND d.source_image IS NOT NULL
+    UNION ALL
+    SELECT
+        m.id AS machine_id,
+        m.name AS machine_name,
+        m.namespace,
+        s.name AS shoot,
+        s.project_name AS project,
+        s.cloud_profile,
+        'azure' AS provider,
+        vm.gallery_image_id AS image_ref,
+        cpi.name AS image_name,
+        cpi.version AS image_version
+    FROM g_machine AS m
+    INNER JOIN g_shoot AS s ON s.technical_id = m.namespace
+    INNER JOIN az_vm AS vm ON lower(vm.subscription_id) = lower(split_part(m.provider_id, '/', 5))
+        AND lower(vm.resource_group) = lower(split_part(m.provider_id, '/', 7))
+        AND vm.name = substring(m.provider_id from '[^/]+$')
+    LEFT JOIN g_cloud_profile_azure_image AS cpi ON lower(cpi.image_id) = lower(vm.gallery_image_id)
+        AND cpi.cloud_profile_name = s.cloud_profile
+    WHERE m.provider_id LIKE 'azure://%'
+    UNION ALL
+    SELECT
+        m.id AS machine_id,
+        m.name AS machine_name,
+        m.namespace,
+        s.name AS shoot,
+        s.project_name AS project,
+        s.cloud_profile,
+        'openstack' AS provider,
+        srv.image_id AS image_ref,
+        cpi.name AS image_name,
+        cpi.version AS image_version
+    FROM g_machine AS m
+    INNER JOIN g_shoot AS s ON s.technical_id = m.namespace
+    INNER JOIN openstack_server AS srv ON srv.server_id = substring(m.provider_id from '[^/]+$')
+    LEFT JOIN g_cloud_profile_openstack_image AS cpi ON cpi.image_id = srv.image_id
+        AND cpi.region_name = srv.region
+        AND cpi.cloud_profile_name = s.cloud_profile
+    WHERE m.provider_id LIKE 'openstack://%'
+)
+SELECT
+    mi.machine_id,
+    mi.machine_name,
+    mi.namespace,
+    mi.shoot,
+    mi.project,
+    mi.cloud_profile,
+    mi.provider,
+    mi.image_ref,
+    mi.image_name,
+    mi.image_version,
+    v.classification,
+    v.expiration_date,
+    CASE
+        WHEN mi.image_name IS NULL THEN 'not_in_cloud_profile'
+        WHEN v.expiration_date IS NOT NULL AND v.expiration_date < now() THEN 'expired'
+        WHEN v.classification = 'deprecated' THEN 'deprecated'
+        ELSE 'current'
+    END AS status
+FROM machine_image AS mi
+LEFT JOIN g_cloud_profile_image_version AS v ON v.cloud_profile_name = mi.cloud_profile
+    AND v.name = mi.image_name
+    AND v.version = mi.image_version;
@@ -0,0 +1,2 @@
+DROP VIEW IF EXISTS "g_shoot_k8s_version_skew";
+DROP TABLE IF EXISTS "g_cloud_profile_k8s_version";
@@ -0,0 +1,64 @@
+CREATE TABLE IF NOT EXISTS "g_cloud_profile_k8s_version" (
+    "version" varchar NOT NULL,
+    "cloud_profile_name" varchar NOT NULL,
+    "classification" varchar,
+    "expiration_date" timestamptz,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "g_cloud_profile_k8s_version_key" UNIQUE ("version", "cloud_profile_name")
+);
+
+CREATE OR REPLACE VIEW "g_shoot_k8s_version_skew" AS
+WITH supported AS (
+    SELECT
+        cloud_profile_name,
+        max(split_part(version, '.', 2)::int) AS latest_minor
+    FROM g_cloud_profile_k8s_version
+    WHERE version ~ '^[0-9]+\.[0-9]+'
+        AND (classification IS NULL OR classification <> 'preview')
+        AND (expiration_date IS NULL OR expiration_date > now())
+    GROUP BY cloud_profile_name
+), shoot AS (
+    SELECT
+        s.name,
+        s.project_name,
+        s.technical_id,
+        s.seed_name,
+        s.cloud_profile,
+        s.k8s_version AS shoot_version,
+        seed.kubernetes_version AS seed_version,
+        CASE WHEN s.k8s_version ~ '^v*[0-9]+\.[0-9]+'
+            THEN split_part(ltrim(s.k8s_version, 'v'), '.', 2)::int
+        END AS shoot_minor,
+        CASE WHEN seed.kubernetes_version ~ '^v*[0-9]+\.[0-9]+'
+            THEN split_part(ltrim(seed.kubernetes_version, 'v'), '.', 2)::int
+        END AS seed_minor
+    FROM g_shoot AS s
+    LEFT JOIN g_seed AS seed ON seed.name = s.seed_name
+)
+SELECT
+    sh.name AS shoot,
+    sh.project_name AS project,
+    sh.technical_id,
+    sh.seed_name AS seed,
+    sh.cloud_profile,
+    sh.shoot_version,
+    sh.seed_version,
+    sh.seed_minor - sh.shoot_minor AS seed_minor_skew,
+    sup.latest_minor - sh.shoot_minor AS cloud_profile_minor_skew,
+    v.classification,
+    v.expiration_date,
+    CASE
+        WHEN v.version IS NULL THEN 'not_in_cloud_profile'
+        WHEN v.expiration_date IS NOT NULL AND v.expiration_date < now() THEN 'expired'
+        WHEN v.classification = 'deprecated' THEN 'deprecated'
+        ELSE 'supported'
+    END AS status
+FROM shoot AS sh
+LEFT JOIN supported AS sup ON sup.cloud_profile_name = sh.cloud_profile
+LEFT JOIN g_cloud_profile_k8s_version AS v ON v.cloud_profile_name = sh.cloud_profile
+    AND v.version = sh.shoot_version;
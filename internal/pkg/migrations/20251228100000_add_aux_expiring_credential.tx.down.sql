@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "aux_expiring_credential";
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "aux_expiring_credential" (
+    "kind" varchar NOT NULL,
+    "name" varchar NOT NULL,
+    "source" varchar NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "aux_expiring_credential_key" UNIQUE ("kind", "name", "source")
+);
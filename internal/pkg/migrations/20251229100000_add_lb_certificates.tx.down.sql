@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "aws_lb_certificate";
+DROP TABLE IF EXISTS "gcp_ssl_certificate";
+DROP TABLE IF EXISTS "gcp_target_proxy_certificate";
+DROP TABLE IF EXISTS "az_app_gateway_certificate";
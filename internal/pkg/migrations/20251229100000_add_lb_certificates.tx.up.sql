@@ -0,0 +1,78 @@
+CREATE TABLE IF NOT EXISTS "aws_lb_certificate" (
+    "dns_name" varchar NOT NULL,
+    "account_id" varchar NOT NULL,
+    "listener_port" integer NOT NULL,
+    "certificate_arn" varchar NOT NULL,
+    "load_balancer_name" varchar NOT NULL,
+    "region_name" varchar NOT NULL,
+    "protocol" varchar NOT NULL,
+    "is_default" boolean NOT NULL,
+    "subject" varchar,
+    "subject_alternative_names" varchar[],
+    "not_after" timestamptz,
+    "status" varchar,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "aws_lb_certificate_key" UNIQUE ("dns_name", "account_id", "listener_port", "certificate_arn")
+);
+
+CREATE TABLE IF NOT EXISTS "gcp_ssl_certificate" (
+    "certificate_id" bigint NOT NULL,
+    "project_id" varchar NOT NULL,
+    "name" varchar NOT NULL,
+    "region" varchar NOT NULL,
+    "type" varchar NOT NULL,
+    "subject" varchar,
+    "subject_alternative_names" varchar[],
+    "not_after" timestamptz,
+    "self_link" varchar NOT NULL,
+    "creation_timestamp" varchar,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "gcp_ssl_certificate_key" UNIQUE ("certificate_id", "project_id")
+);
+
+CREATE TABLE IF NOT EXISTS "gcp_target_proxy_certificate" (
+    "proxy_self_link" varchar NOT NULL,
+    "certificate_self_link" varchar NOT NULL,
+    "project_id" varchar NOT NULL,
+    "proxy_name" varchar NOT NULL,
+    "proxy_type" varchar NOT NULL,
+    "region" varchar NOT NULL,
+    "certificate_name" varchar NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "gcp_target_proxy_certificate_key" UNIQUE ("proxy_self_link", "certificate_self_link")
+);
+
+CREATE TABLE IF NOT EXISTS "az_app_gateway_certificate" (
+    "name" varchar NOT NULL,
+    "gateway_name" varchar NOT NULL,
+    "subscription_id" varchar NOT NULL,
+    "resource_group" varchar NOT NULL,
+    "location" varchar NOT NULL,
+    "provisioning_state" varchar NOT NULL,
+    "key_vault_secret_id" varchar,
+    "subject" varchar,
+    "subject_alternative_names" varchar[],
+    "not_after" timestamptz,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "az_app_gateway_certificate_key" UNIQUE ("subscription_id", "resource_group", "gateway_name", "name")
+);
@@ -0,0 +1,2 @@
+DROP MATERIALIZED VIEW IF EXISTS "aux_account_resource_summary";
+DROP MATERIALIZED VIEW IF EXISTS "aux_shoot_resource_count";
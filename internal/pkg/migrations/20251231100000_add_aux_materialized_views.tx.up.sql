@@ -0,0 +1,30 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS "aux_shoot_resource_count" AS
+SELECT
+    s.id AS shoot_id,
+    s.name AS shoot,
+    s.project_name AS project,
+    s.technical_id,
+    l.model_name,
+    l.kind,
+    count(*) AS count
+FROM l_aux_shoot_to_resource AS l
+INNER JOIN g_shoot AS s ON s.id = l.shoot_id
+GROUP BY s.id, s.name, s.project_name, s.technical_id, l.model_name, l.kind;
+
+CREATE UNIQUE INDEX IF NOT EXISTS "aux_shoot_resource_count_key"
+ON "aux_shoot_resource_count" ("shoot_id", "model_name", "kind");
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS "aux_account_resource_summary" AS
+SELECT
+    a.provider,
+    a.account_id,
+    a.display_name,
+    l.model_name,
+    l.classification,
+    count(*) AS count
+FROM l_aux_account_to_resource AS l
+INNER JOIN aux_account AS a ON a.id = l.account_id
+GROUP BY a.provider, a.account_id, a.display_name, l.model_name, l.classification;
+
+CREATE UNIQUE INDEX IF NOT EXISTS "aux_account_resource_summary_key"
+ON "aux_account_resource_summary" ("provider", "account_id", "model_name", "classification");
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS "aux_collection_state" (
+    "task_name" varchar NOT NULL,
+    "account_id" varchar NOT NULL,
+    "last_success_at" timestamptz NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "aux_collection_state_key" UNIQUE ("task_name", "account_id")
+);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "audit_log" (
+    "identity" varchar NOT NULL,
+    "source" varchar NOT NULL,
+    "action" varchar NOT NULL,
+    "parameters" jsonb,
+    "error" varchar,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS "audit_log_created_at_idx" ON "audit_log" ("created_at");
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "aux_api_token" (
+    "name" varchar NOT NULL,
+    "token_hash" varchar NOT NULL,
+    "scopes" varchar[],
+    "expires_at" timestamptz,
+    "revoked_at" timestamptz,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "aux_api_token_name_key" UNIQUE ("name"),
+    CONSTRAINT "aux_api_token_token_hash_key" UNIQUE ("token_hash")
+);
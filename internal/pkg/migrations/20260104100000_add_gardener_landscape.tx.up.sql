@@ -0,0 +1,241 @@
+DROP VIEW IF EXISTS "g_machine_image_freshness";
+DROP VIEW IF EXISTS "g_shoot_k8s_version_skew";
+
+ALTER TABLE "g_project" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_project" DROP CONSTRAINT IF EXISTS "g_project_name_key";
+ALTER TABLE "g_project" ADD CONSTRAINT "g_project_name_key" UNIQUE ("name", "landscape");
+
+ALTER TABLE "g_project_member" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_project_member" DROP CONSTRAINT IF EXISTS "g_project_member_key";
+ALTER TABLE "g_project_member" ADD CONSTRAINT "g_project_member_key" UNIQUE ("name", "project_name", "landscape");
+
+ALTER TABLE "g_seed" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_seed" DROP CONSTRAINT IF EXISTS "g_seed_name_key";
+ALTER TABLE "g_seed" ADD CONSTRAINT "g_seed_name_key" UNIQUE ("name", "landscape");
+
+ALTER TABLE "g_shoot" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_shoot" DROP CONSTRAINT IF EXISTS "g_shoot_technical_id_key";
+ALTER TABLE "g_shoot" ADD CONSTRAINT "g_shoot_technical_id_key" UNIQUE ("technical_id", "landscape");
+
+ALTER TABLE "g_machine" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_machine" DROP CONSTRAINT IF EXISTS "g_machine_name_namespace_key";
+ALTER TABLE "g_machine" ADD CONSTRAINT "g_machine_name_namespace_key" UNIQUE ("name", "namespace", "landscape");
+
+ALTER TABLE "g_backup_bucket" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_backup_bucket" DROP CONSTRAINT IF EXISTS "g_backup_bucket_name_key";
+ALTER TABLE "g_backup_bucket" ADD CONSTRAINT "g_backup_bucket_name_key" UNIQUE ("name", "landscape");
+
+ALTER TABLE "g_cloud_profile" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_cloud_profile" DROP CONSTRAINT IF EXISTS "g_cloud_profile_name_key";
+ALTER TABLE "g_cloud_profile" ADD CONSTRAINT "g_cloud_profile_name_key" UNIQUE ("name", "landscape");
+
+ALTER TABLE "g_cloud_profile_aws_image" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_cloud_profile_aws_image" DROP CONSTRAINT IF EXISTS "g_cloud_profile_aws_image_key";
+ALTER TABLE "g_cloud_profile_aws_image" ADD CONSTRAINT "g_cloud_profile_aws_image_key" UNIQUE ("name", "version", "region_name", "ami", "cloud_profile_name", "landscape");
+
+ALTER TABLE "g_cloud_profile_gcp_image" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_cloud_profile_gcp_image" DROP CONSTRAINT IF EXISTS "g_cloud_profile_gcp_image_key";
+ALTER TABLE "g_cloud_profile_gcp_image" ADD CONSTRAINT "g_cloud_profile_gcp_image_key" UNIQUE ("name", "image", "version", "cloud_profile_name", "landscape");
+
+ALTER TABLE "g_cloud_profile_azure_image" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_cloud_profile_azure_image" DROP CONSTRAINT IF EXISTS "g_cloud_profile_azure_image_key";
+ALTER TABLE "g_cloud_profile_azure_image" ADD CONSTRAINT "g_cloud_profile_azure_image_key" UNIQUE ("name", "version", "architecture", "cloud_profile_name", "image_id", "landscape");
+
+ALTER TABLE "g_cloud_profile_openstack_image" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_cloud_profile_openstack_image" DROP CONSTRAINT IF EXISTS "g_cloud_profile_openstack_image_key";
+ALTER TABLE "g_cloud_profile_openstack_image" ADD CONSTRAINT "g_cloud_profile_openstack_image_key" UNIQUE ("name", "version", "region_name", "image_id", "cloud_profile_name", "landscape");
+
+ALTER TABLE "g_cloud_profile_image_version" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_cloud_profile_image_version" DROP CONSTRAINT IF EXISTS "g_cloud_profile_image_version_key";
+ALTER TABLE "g_cloud_profile_image_version" ADD CONSTRAINT "g_cloud_profile_image_version_key" UNIQUE ("name", "version", "cloud_profile_name", "landscape");
+
+ALTER TABLE "g_cloud_profile_k8s_version" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_cloud_profile_k8s_version" DROP CONSTRAINT IF EXISTS "g_cloud_profile_k8s_version_key";
+ALTER TABLE "g_cloud_profile_k8s_version" ADD CONSTRAINT "g_cloud_profile_k8s_version_key" UNIQUE ("version", "cloud_profile_name", "landscape");
+
+ALTER TABLE "g_persistent_volume" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_persistent_volume" DROP CONSTRAINT IF EXISTS "g_persistent_volume_key";
+ALTER TABLE "g_persistent_volume" ADD CONSTRAINT "g_persistent_volume_key" UNIQUE ("name", "seed_name", "landscape");
+
+ALTER TABLE "g_dns_record" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_dns_record" DROP CONSTRAINT IF EXISTS "g_dns_record_key";
+ALTER TABLE "g_dns_record" ADD CONSTRAINT "g_dns_record_key" UNIQUE ("name", "namespace", "seed_name", "value", "landscape");
+
+ALTER TABLE "g_dns_entry" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_dns_entry" DROP CONSTRAINT IF EXISTS "g_dns_entry_key";
+ALTER TABLE "g_dns_entry" ADD CONSTRAINT "g_dns_entry_key" UNIQUE ("name", "namespace", "seed_name", "value", "landscape");
+
+ALTER TABLE "g_bastion" ADD COLUMN IF NOT EXISTS "landscape" VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE "g_bastion" DROP CONSTRAINT IF EXISTS "g_bastion_key";
+ALTER TABLE "g_bastion" ADD CONSTRAINT "g_bastion_key" UNIQUE ("name", "namespace", "seed_name", "landscape");
+
+CREATE VIEW "g_machine_image_freshness" AS
+WITH machine_image AS (
+    SELECT
+        m.id AS machine_id,
+        m.name AS machine_name,
+        m.namespace,
+        m.landscape,
+        s.name AS shoot,
+        s.project_name AS project,
+        s.cloud_profile,
+        'aws' AS provider,
+        i.image_id AS image_ref,
+        cpi.name AS image_name,
+        cpi.version AS image_version
+    FROM g_machine AS m
+    INNER JOIN g_shoot AS s ON s.technical_id = m.namespace AND s.landscape = m.landscape
+    INNER JOIN aws_instance AS i ON i.instance_id = substring(m.provider_id from '[^/]+$')
+    LEFT JOIN g_cloud_profile_aws_image AS cpi ON cpi.ami = i.image_id
+        AND cpi.region_name = i.region_name
+        AND cpi.cloud_profile_name = s.cloud_profile
+        AND cpi.landscape = s.landscape
+    WHERE m.provider_id LIKE 'aws://%'
+    UNION ALL
+    SELECT
+        m.id AS machine_id,
+        m.name AS machine_name,
+        m.namespace,
+        m.landscape,
+        s.name AS shoot,
+        s.project_name AS project,
+        s.cloud_profile,
+        'gcp' AS provider,
+        d.source_image AS image_ref,
+        cpi.name AS image_name,
+        cpi.version AS image_version
+    FROM g_machine AS m
+    INNER JOIN g_shoot AS s ON s.technical_id = m.namespace AND s.landscape = m.landscape
+    INNER JOIN gcp_instance AS i ON i.project_id = split_part(m.provider_id, '/', 3)
+        AND i.name = substring(m.provider_id from '[^/]+$')
+    INNER JOIN gcp_attached_disk AS ad ON ad.project_id = i.project_id AND ad.instance_name = i.name
+    INNER JOIN gcp_disk AS d ON d.project_id = ad.project_id AND d.name = ad.disk_name AND d.zone = ad.zone
+    LEFT JOIN g_cloud_profile_gcp_image AS cpi ON cpi.image = d.source_image
+        AND cpi.cloud_profile_name = s.cloud_profile
+        AND cpi.landscape = s.landscape
+    WHERE m.provider_id LIKE 'gce://%' AND d.source_image IS NOT NULL
+    UNION ALL
+    SELECT
+        m.id AS machine_id,
+        m.name AS machine_name,
+        m.namespace,
+        m.landscape,
+        s.name AS shoot,
+        s.project_name AS project,
+        s.cloud_profile,
+        'azure' AS provider,
+        vm.gallery_image_id AS image_ref,
+        cpi.name AS image_name,
+        cpi.version AS image_version
+    FROM g_machine AS m
+    INNER JOIN g_shoot AS s ON s.technical_id = m.namespace AND s.landscape = m.landscape
+    INNER JOIN az_vm AS vm ON lower(vm.subscription_id) = lower(split_part(m.provider_id, '/', 5))
+        AND lower(vm.resource_group) = lower(split_part(m.provider_id, '/', 7))
+        AND vm.name = substring(m.provider_id from '[^/]+$')
+    LEFT JOIN g_cloud_profile_azure_image AS cpi ON lower(cpi.image_id) = lower(vm.gallery_image_id)
+        AND cpi.cloud_profile_name = s.cloud_profile
+        AND cpi.landscape = s.landscape
+    WHERE m.provider_id LIKE 'azure://%'
+    UNION ALL
+    SELECT
+        m.id AS machine_id,
+        m.name AS machine_name,
+        m.namespace,
+        m.landscape,
+        s.name AS shoot,
+        s.project_name AS project,
+        s.cloud_profile,
+        'openstack' AS provider,
+        srv.image_id AS image_ref,
+        cpi.name AS image_name,
+        cpi.version AS image_version
+    FROM g_machine AS m
+    INNER JOIN g_shoot AS s ON s.technical_id = m.namespace AND s.landscape = m.landscape
+    INNER JOIN openstack_server AS srv ON srv.server_id = substring(m.provider_id from '[^/]+$')
+    LEFT JOIN g_cloud_profile_openstack_image AS cpi ON cpi.image_id = srv.image_id
+        AND cpi.region_name = srv.region
+        AND cpi.cloud_profile_name = s.cloud_profile
+        AND cpi.landscape = s.landscape
+    WHERE m.provider_id LIKE 'openstack://%'
+)
+SELECT
+    mi.machine_id,
+    mi.machine_name,
+    mi.namespace,
+    mi.landscape,
+    mi.shoot,
+    mi.project,
+    mi.cloud_profile,
+    mi.provider,
+    mi.image_ref,
+    mi.image_name,
+    mi.image_version,
+    v.classification,
+    v.expiration_date,
+    CASE
+        WHEN mi.image_name IS NULL THEN 'not_in_cloud_profile'
+        WHEN v.expiration_date IS NOT NULL AND v.expiration_date < now() THEN 'expired'
+        WHEN v.classification = 'deprecated' THEN 'deprecated'
+        ELSE 'current'
+    END AS status
+FROM machine_image AS mi
+LEFT JOIN g_cloud_profile_image_version AS v ON v.cloud_profile_name = mi.cloud_profile
+    AND v.name = mi.image_name
+    AND v.version = mi.image_version
+    AND v.landscape = mi.landscape;
+
+CREATE VIEW "g_shoot_k8s_version_skew" AS
+WITH supported AS (
+    SELECT
+        cloud_profile_name,
+        landscape,
+        max(split_part(version, '.', 2)::int) AS latest_minor
+    FROM g_cloud_profile_k8s_version
+    WHERE version ~ '^[0-9]+\.[0-9]+'
+        AND (classification IS NULL OR classification <> 'preview')
+        AND (expiration_date IS NULL OR expiration_date > now())
+    GROUP BY cloud_profile_name, landscape
+), shoot AS (
+    SELECT
+        s.name,
+        s.project_name,
+        s.technical_id,
+        s.landscape,
+        s.seed_name,
+        s.cloud_profile,
+        s.k8s_version AS shoot_version,
+        seed.kubernetes_version AS seed_version,
+        CASE WHEN s.k8s_version ~ '^v*[0-9]+\.[0-9]+'
+            THEN split_part(ltrim(s.k8s_version, 'v'), '.', 2)::int
+        END AS shoot_minor,
+        CASE WHEN seed.kubernetes_version ~ '^v*[0-9]+\.[0-9]+'
+            THEN split_part(ltrim(seed.kubernetes_version, 'v'), '.', 2)::int
+        END AS seed_minor
+    FROM g_shoot AS s
+    LEFT JOIN g_seed AS seed ON seed.name = s.seed_name AND seed.landscape = s.landscape
+)
+SELECT
+    sh.name AS shoot,
+    sh.project_name AS project,
+    sh.technical_id,
+    sh.landscape,
+    sh.seed_name AS seed,
+    sh.cloud_profile,
+    sh.shoot_version,
+    sh.seed_version,
+    sh.seed_minor - sh.shoot_minor AS seed_minor_skew,
+    sup.latest_minor - sh.shoot_minor AS cloud_profile_minor_skew,
+    v.classification,
+    v.expiration_date,
+    CASE
+        WHEN v.version IS NULL THEN 'not_in_cloud_profile'
+        WHEN v.expiration_date IS NOT NULL AND v.expiration_date < now() THEN 'expired'
+        WHEN v.classification = 'deprecated' THEN 'deprecated'
+        ELSE 'supported'
+    END AS status
+FROM shoot AS sh
+LEFT JOIN supported AS sup ON sup.cloud_profile_name = sh.cloud_profile
+    AND sup.landscape = sh.landscape
+LEFT JOIN g_cloud_profile_k8s_version AS v ON v.cloud_profile_name = sh.cloud_profile
+    AND v.version = sh.shoot_version
+    AND v.landscape = sh.landscape;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "g_resource_quota";
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS "g_resource_quota" (
+    "name" varchar NOT NULL,
+    "namespace" varchar NOT NULL,
+    "resource" varchar NOT NULL,
+    "landscape" varchar NOT NULL,
+    "project_name" varchar NOT NULL,
+    "quota_limit" double precision NOT NULL,
+    "usage" double precision NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "g_resource_quota_key" UNIQUE ("name", "namespace", "resource", "landscape")
+);
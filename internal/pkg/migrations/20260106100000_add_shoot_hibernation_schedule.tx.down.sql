@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "g_shoot_hibernation_schedule";
+
+ALTER TABLE "g_shoot" DROP COLUMN IF EXISTS "next_wake_up_at";
+ALTER TABLE "g_shoot" DROP COLUMN IF EXISTS "next_hibernation_at";
+ALTER TABLE "g_shoot" DROP COLUMN IF EXISTS "hibernation_enabled";
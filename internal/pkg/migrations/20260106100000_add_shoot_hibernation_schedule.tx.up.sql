@@ -0,0 +1,20 @@
+ALTER TABLE "g_shoot" ADD COLUMN IF NOT EXISTS "hibernation_enabled" boolean NOT NULL DEFAULT false;
+ALTER TABLE "g_shoot" ADD COLUMN IF NOT EXISTS "next_hibernation_at" timestamptz;
+ALTER TABLE "g_shoot" ADD COLUMN IF NOT EXISTS "next_wake_up_at" timestamptz;
+
+CREATE TABLE IF NOT EXISTS "g_shoot_hibernation_schedule" (
+    "technical_id" varchar NOT NULL,
+    "landscape" varchar NOT NULL,
+    "start_spec" varchar NOT NULL,
+    "end_spec" varchar NOT NULL,
+    "location" varchar NOT NULL,
+    "next_hibernation_at" timestamptz,
+    "next_wake_up_at" timestamptz,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "g_shoot_hibernation_schedule_key" UNIQUE ("technical_id", "landscape", "start_spec", "end_spec", "location")
+);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "g_shoot_advertised_address";
+
+ALTER TABLE "g_shoot" DROP COLUMN IF EXISTS "dns_domain";
+ALTER TABLE "g_shoot" DROP COLUMN IF EXISTS "exposure_class";
@@ -0,0 +1,18 @@
+ALTER TABLE "g_shoot" ADD COLUMN IF NOT EXISTS "exposure_class" varchar;
+ALTER TABLE "g_shoot" ADD COLUMN IF NOT EXISTS "dns_domain" varchar;
+
+CREATE TABLE IF NOT EXISTS "g_shoot_advertised_address" (
+    "technical_id" varchar NOT NULL,
+    "landscape" varchar NOT NULL,
+    "name" varchar NOT NULL,
+    "url" varchar NOT NULL,
+    "host" varchar NOT NULL,
+    "ip" inet,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "g_shoot_advertised_address_key" UNIQUE ("technical_id", "landscape", "name")
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "g_machine_class";
+
+ALTER TABLE "g_machine" DROP COLUMN IF EXISTS "machine_class";
@@ -0,0 +1,27 @@
+ALTER TABLE "g_machine" ADD COLUMN IF NOT EXISTS "machine_class" varchar;
+
+CREATE TABLE IF NOT EXISTS "g_machine_class" (
+    "name" varchar NOT NULL,
+    "namespace" varchar NOT NULL,
+    "landscape" varchar NOT NULL,
+    "seed_name" varchar NOT NULL,
+    "machine_deployment" varchar NOT NULL,
+    "provider" varchar,
+    "region" varchar,
+    "zone" varchar,
+    "architecture" varchar,
+    "machine_type" varchar,
+    "image" varchar,
+    "volume_size" bigint,
+    "volume_type" varchar,
+    "network" varchar,
+    "provider_spec" jsonb,
+    "creation_timestamp" timestamptz,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "g_machine_class_key" UNIQUE ("name", "namespace", "landscape")
+);
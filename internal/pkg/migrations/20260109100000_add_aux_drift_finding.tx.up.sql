@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "aux_drift_finding" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid (),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "check_name" varchar NOT NULL,
+    "resource_id" uuid NOT NULL,
+    "shoot_id" uuid NOT NULL,
+    "model_name" varchar NOT NULL,
+    "attribute" varchar NOT NULL,
+    "desired" varchar NOT NULL,
+    "actual" varchar NOT NULL,
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("shoot_id") REFERENCES "g_shoot" ("id") ON DELETE CASCADE,
+    CONSTRAINT "aux_drift_finding_key" UNIQUE ("check_name", "resource_id")
+);
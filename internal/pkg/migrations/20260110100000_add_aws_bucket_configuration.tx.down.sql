@@ -0,0 +1,11 @@
+ALTER TABLE "aws_bucket" DROP COLUMN IF EXISTS "lifecycle_rules_enabled";
+ALTER TABLE "aws_bucket" DROP COLUMN IF EXISTS "lifecycle_rules";
+ALTER TABLE "aws_bucket" DROP COLUMN IF EXISTS "restrict_public_buckets";
+ALTER TABLE "aws_bucket" DROP COLUMN IF EXISTS "block_public_policy";
+ALTER TABLE "aws_bucket" DROP COLUMN IF EXISTS "ignore_public_acls";
+ALTER TABLE "aws_bucket" DROP COLUMN IF EXISTS "block_public_acls";
+ALTER TABLE "aws_bucket" DROP COLUMN IF EXISTS "mfa_delete_enabled";
+ALTER TABLE "aws_bucket" DROP COLUMN IF EXISTS "versioning_status";
+ALTER TABLE "aws_bucket" DROP COLUMN IF EXISTS "bucket_key_enabled";
+ALTER TABLE "aws_bucket" DROP COLUMN IF EXISTS "kms_key_id";
+ALTER TABLE "aws_bucket" DROP COLUMN IF EXISTS "encryption_algorithm";
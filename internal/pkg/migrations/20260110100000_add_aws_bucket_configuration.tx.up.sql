@@ -0,0 +1,11 @@
+ALTER TABLE "aws_bucket" ADD COLUMN IF NOT EXISTS "encryption_algorithm" varchar;
+ALTER TABLE "aws_bucket" ADD COLUMN IF NOT EXISTS "kms_key_id" varchar;
+ALTER TABLE "aws_bucket" ADD COLUMN IF NOT EXISTS "bucket_key_enabled" boolean NOT NULL DEFAULT false;
+ALTER TABLE "aws_bucket" ADD COLUMN IF NOT EXISTS "versioning_status" varchar;
+ALTER TABLE "aws_bucket" ADD COLUMN IF NOT EXISTS "mfa_delete_enabled" boolean NOT NULL DEFAULT false;
+ALTER TABLE "aws_bucket" ADD COLUMN IF NOT EXISTS "block_public_acls" boolean NOT NULL DEFAULT false;
+ALTER TABLE "aws_bucket" ADD COLUMN IF NOT EXISTS "ignore_public_acls" boolean NOT NULL DEFAULT false;
+ALTER TABLE "aws_bucket" ADD COLUMN IF NOT EXISTS "block_public_policy" boolean NOT NULL DEFAULT false;
+ALTER TABLE "aws_bucket" ADD COLUMN IF NOT EXISTS "restrict_public_buckets" boolean NOT NULL DEFAULT false;
+ALTER TABLE "aws_bucket" ADD COLUMN IF NOT EXISTS "lifecycle_rules" integer NOT NULL DEFAULT 0;
+ALTER TABLE "aws_bucket" ADD COLUMN IF NOT EXISTS "lifecycle_rules_enabled" integer NOT NULL DEFAULT 0;
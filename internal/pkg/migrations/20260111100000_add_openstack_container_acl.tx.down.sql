@@ -0,0 +1,115 @@
+CREATE OR REPLACE VIEW "aux_public_exposure" AS
+WITH exposure AS (
+    SELECT
+        'aws' AS provider,
+        'aws:model:network_interface' AS model_name,
+        ni.id AS resource_id,
+        ni.interface_id AS resource_name,
+        ni.account_id,
+        ni.region_name AS region,
+        ni.public_ip_address::inet AS public_ip,
+        CASE
+            WHEN i.id IS NOT NULL THEN 'aws:model:instance'
+            WHEN lb.lb_id IS NOT NULL THEN 'aws:model:loadbalancer'
+        END AS owner_model_name,
+        COALESCE(i.id, lb.lb_id) AS owner_id,
+        NULL::uuid AS shoot_id
+    FROM aws_net_interface AS ni
+    LEFT JOIN aws_instance AS i ON i.instance_id = ni.instance_id AND i.account_id = ni.account_id
+    LEFT JOIN l_aws_lb_to_net_interface AS lb ON lb.ni_id = ni.id
+    WHERE ni.public_ip_address <> ''
+    UNION ALL
+    SELECT
+        'gcp' AS provider,
+        'gcp:model:address' AS model_name,
+        a.id AS resource_id,
+        a.name AS resource_name,
+        a.project_id AS account_id,
+        a.region,
+        a.address AS public_ip,
+        CASE
+            WHEN fr.id IS NOT NULL THEN 'gcp:model:forwarding_rule'
+            WHEN i.id IS NOT NULL THEN 'gcp:model:instance'
+        END AS owner_model_name,
+        COALESCE(fr.id, i.id) AS owner_id,
+        NULL::uuid AS shoot_id
+    FROM gcp_address AS a
+    LEFT JOIN LATERAL (
+        SELECT r.id FROM gcp_forwarding_rule AS r
+        WHERE r.project_id = a.project_id AND r.ip_address = a.address
+        LIMIT 1
+    ) AS fr ON true
+    LEFT JOIN LATERAL (
+        SELECT vm.id FROM gcp_nic AS nic
+        INNER JOIN gcp_instance AS vm ON vm.project_id = nic.project_id AND vm.instance_id = nic.instance_id
+        WHERE nic.project_id = a.project_id AND nic.nat_ip = a.address
+        LIMIT 1
+    ) AS i ON true
+    WHERE a.address_type = 'EXTERNAL'
+    UNION ALL
+    SELECT
+        'azure' AS provider,
+        'az:model:public_address' AS model_name,
+        pa.id AS resource_id,
+        pa.name AS resource_name,
+        pa.subscription_id AS account_id,
+        pa.location AS region,
+        pa.ip_address AS public_ip,
+        NULL AS owner_model_name,
+        NULL::uuid AS owner_id,
+        s.id AS shoot_id
+    FROM az_public_address AS pa
+    LEFT JOIN g_shoot AS s ON s.technical_id = pa.resource_group
+    WHERE pa.ip_address IS NOT NULL
+    UNION ALL
+    SELECT
+        'openstack' AS provider,
+        'openstack:model:floating_ip' AS model_name,
+        fip.id AS resource_id,
+        fip.floating_ip_id AS resource_name,
+        fip.project_id AS account_id,
+        fip.region,
+        fip.floating_ip AS public_ip,
+        CASE
+            WHEN srv.id IS NOT NULL THEN 'openstack:model:server'
+            WHEN lb.id IS NOT NULL THEN 'openstack:model:loadbalancer'
+        END AS owner_model_name,
+        COALESCE(srv.id, lb.id) AS owner_id,
+        NULL::uuid AS shoot_id
+    FROM openstack_floating_ip AS fip
+    LEFT JOIN LATERAL (
+        SELECT p.device_id FROM openstack_port AS p
+        WHERE p.port_id = fip.port_id AND p.project_id = fip.project_id
+        LIMIT 1
+    ) AS port ON true
+    LEFT JOIN openstack_server AS srv ON srv.server_id = port.device_id AND srv.project_id = fip.project_id
+    LEFT JOIN openstack_loadbalancer AS lb ON lb.loadbalancer_id = port.device_id AND lb.project_id = fip.project_id
+)
+SELECT
+    e.provider,
+    e.model_name,
+    e.resource_id,
+    e.resource_name,
+    e.account_id,
+    e.region,
+    e.public_ip,
+    e.owner_model_name,
+    e.owner_id,
+    s.id AS shoot_id,
+    s.name AS shoot,
+    s.project_name AS project,
+    s.technical_id
+FROM exposure AS e
+LEFT JOIN LATERAL (
+    SELECT l.shoot_id FROM l_aux_shoot_to_resource AS l
+    WHERE l.model_name = e.owner_model_name AND l.resource_id = e.owner_id
+    ORDER BY CASE l.confidence WHEN 'high' THEN 0 WHEN 'medium' THEN 1 ELSE 2 END
+    LIMIT 1
+) AS link ON true
+LEFT JOIN g_shoot AS s ON s.id = COALESCE(e.shoot_id, link.shoot_id);
+
+ALTER TABLE "openstack_container" DROP COLUMN IF EXISTS "has_temp_url_key";
+ALTER TABLE "openstack_container" DROP COLUMN IF EXISTS "public_listing";
+ALTER TABLE "openstack_container" DROP COLUMN IF EXISTS "public_read";
+ALTER TABLE "openstack_container" DROP COLUMN IF EXISTS "write_acl";
+ALTER TABLE "openstack_container" DROP COLUMN IF EXISTS "read_acl";
@@ -0,0 +1,7 @@
+DROP VIEW IF EXISTS "g_shoot_backup_freshness";
+DROP TABLE IF EXISTS "g_backup_entry";
+
+ALTER TABLE "g_backup_bucket" DROP COLUMN IF EXISTS "last_error";
+ALTER TABLE "g_backup_bucket" DROP COLUMN IF EXISTS "last_operation_at";
+ALTER TABLE "g_backup_bucket" DROP COLUMN IF EXISTS "observed_generation";
+ALTER TABLE "g_backup_bucket" DROP COLUMN IF EXISTS "generation";
@@ -0,0 +1,58 @@
+ALTER TABLE "g_backup_bucket" ADD COLUMN IF NOT EXISTS "generation" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "g_backup_bucket" ADD COLUMN IF NOT EXISTS "observed_generation" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "g_backup_bucket" ADD COLUMN IF NOT EXISTS "last_operation_at" timestamptz;
+ALTER TABLE "g_backup_bucket" ADD COLUMN IF NOT EXISTS "last_error" varchar;
+
+CREATE TABLE IF NOT EXISTS "g_backup_entry" (
+    "name" varchar NOT NULL,
+    "namespace" varchar NOT NULL,
+    "landscape" varchar NOT NULL,
+    "bucket_name" varchar NOT NULL,
+    "seed_name" varchar,
+    "shoot_name" varchar,
+    "generation" bigint NOT NULL,
+    "observed_generation" bigint NOT NULL,
+    "state" varchar,
+    "last_operation_type" varchar,
+    "last_operation_at" timestamptz,
+    "last_error" varchar,
+    "creation_timestamp" timestamptz,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "g_backup_entry_key" UNIQUE ("name", "namespace", "landscape")
+);
+
+CREATE OR REPLACE VIEW "g_shoot_backup_freshness" AS
+SELECT
+    s.id AS shoot_id,
+    s.landscape,
+    s.project_name,
+    s.name AS shoot,
+    s.technical_id,
+    s.seed_name,
+    s.is_hibernated,
+    be.name AS backup_entry,
+    be.bucket_name,
+    be.state,
+    be.generation,
+    be.observed_generation,
+    be.last_operation_type,
+    be.last_operation_at,
+    be.last_error,
+    bb.state AS bucket_state,
+    bb.last_error AS bucket_last_error
+FROM g_shoot AS s
+LEFT JOIN LATERAL (
+    SELECT e.* FROM g_backup_entry AS e
+    WHERE e.namespace = s.namespace
+        AND e.shoot_name = s.name
+        AND e.landscape = s.landscape
+        AND e.name NOT LIKE 'source-%'
+    ORDER BY e.creation_timestamp DESC
+    LIMIT 1
+) AS be ON true
+LEFT JOIN g_backup_bucket AS bb ON bb.name = be.bucket_name AND bb.landscape = be.landscape;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "g_cloud_profile_zone";
+DROP TABLE IF EXISTS "g_cloud_profile_volume_type";
+DROP TABLE IF EXISTS "g_cloud_profile_machine_type";
@@ -0,0 +1,52 @@
+CREATE TABLE IF NOT EXISTS "g_cloud_profile_machine_type" (
+    "name" varchar NOT NULL,
+    "cloud_profile_name" varchar NOT NULL,
+    "landscape" varchar NOT NULL,
+    "cpu" double precision NOT NULL,
+    "gpu" bigint NOT NULL,
+    "memory" bigint NOT NULL,
+    "architecture" varchar,
+    "storage_class" varchar,
+    "storage_type" varchar,
+    "storage_size" bigint,
+    "usable" boolean NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "g_cloud_profile_machine_type_key" UNIQUE ("name", "cloud_profile_name", "landscape")
+);
+
+CREATE TABLE IF NOT EXISTS "g_cloud_profile_volume_type" (
+    "name" varchar NOT NULL,
+    "cloud_profile_name" varchar NOT NULL,
+    "landscape" varchar NOT NULL,
+    "class" varchar,
+    "min_size" bigint,
+    "usable" boolean NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "g_cloud_profile_volume_type_key" UNIQUE ("name", "cloud_profile_name", "landscape")
+);
+
+CREATE TABLE IF NOT EXISTS "g_cloud_profile_zone" (
+    "region_name" varchar NOT NULL,
+    "zone" varchar NOT NULL,
+    "cloud_profile_name" varchar NOT NULL,
+    "landscape" varchar NOT NULL,
+    "unavailable_machine_types" varchar[],
+    "unavailable_volume_types" varchar[],
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "g_cloud_profile_zone_key" UNIQUE ("region_name", "zone", "cloud_profile_name", "landscape")
+);
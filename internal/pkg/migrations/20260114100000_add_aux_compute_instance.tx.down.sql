@@ -0,0 +1 @@
+DROP VIEW IF EXISTS "aux_compute_instance";
@@ -0,0 +1,93 @@
+CREATE OR REPLACE VIEW "aux_compute_instance" AS
+WITH instance AS (
+    SELECT
+        i.id,
+        i.created_at,
+        i.updated_at,
+        'aws' AS provider,
+        'aws:model:instance' AS model_name,
+        i.account_id,
+        i.region_name AS region,
+        NULL::varchar AS zone,
+        i.name,
+        i.instance_id,
+        i.state,
+        i.instance_type AS machine_type,
+        i.image_id AS image
+    FROM aws_instance AS i
+    UNION ALL
+    SELECT
+        i.id,
+        i.created_at,
+        i.updated_at,
+        'gcp' AS provider,
+        'gcp:model:instance' AS model_name,
+        i.project_id AS account_id,
+        i.region,
+        i.zone,
+        i.name,
+        i.instance_id::text AS instance_id,
+        i.status AS state,
+        substring(i.machine_type from '[^/]+$') AS machine_type,
+        NULLIF(i.source_machine_image, '') AS image
+    FROM gcp_instance AS i
+    UNION ALL
+    SELECT
+        vm.id,
+        vm.created_at,
+        vm.updated_at,
+        'azure' AS provider,
+        'az:model:vm' AS model_name,
+        vm.subscription_id AS account_id,
+        vm.location AS region,
+        NULL::varchar AS zone,
+        vm.name,
+        vm.resource_group || '/' || vm.name AS instance_id,
+        COALESCE(vm.power_state, vm.provisioning_state) AS state,
+        vm.vm_size AS machine_type,
+        vm.gallery_image_id AS image
+    FROM az_vm AS vm
+    UNION ALL
+    SELECT
+        srv.id,
+        srv.created_at,
+        srv.updated_at,
+        'openstack' AS provider,
+        'openstack:model:server' AS model_name,
+        srv.project_id AS account_id,
+        srv.region,
+        srv.availability_zone AS zone,
+        srv.name,
+        srv.server_id AS instance_id,
+        srv.status AS state,
+        NULL::varchar AS machine_type,
+        srv.image_id AS image
+    FROM openstack_server AS srv
+)
+SELECT
+    i.id,
+    i.created_at,
+    i.updated_at,
+    i.provider,
+    i.model_name,
+    i.account_id,
+    i.region,
+    i.zone,
+    i.name,
+    i.instance_id,
+    i.state,
+    i.machine_type,
+    i.image,
+    s.id AS shoot_id,
+    s.name AS shoot,
+    s.project_name AS project,
+    s.technical_id,
+    s.landscape
+FROM instance AS i
+LEFT JOIN LATERAL (
+    SELECT l.shoot_id FROM l_aux_shoot_to_resource AS l
+    WHERE l.model_name = i.model_name AND l.resource_id = i.id
+    ORDER BY CASE l.confidence WHEN 'high' THEN 0 WHEN 'medium' THEN 1 ELSE 2 END
+    LIMIT 1
+) AS link ON true
+LEFT JOIN g_shoot AS s ON s.id = link.shoot_id;
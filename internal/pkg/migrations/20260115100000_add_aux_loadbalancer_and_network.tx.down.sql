@@ -0,0 +1,3 @@
+DROP VIEW IF EXISTS "aux_network";
+
+DROP VIEW IF EXISTS "aux_loadbalancer";
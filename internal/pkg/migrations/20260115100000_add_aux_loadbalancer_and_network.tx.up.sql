@@ -0,0 +1,182 @@
+CREATE OR REPLACE VIEW "aux_loadbalancer" AS
+WITH lb AS (
+    SELECT
+        lb.id,
+        lb.created_at,
+        lb.updated_at,
+        'aws' AS provider,
+        'aws:model:loadbalancer' AS model_name,
+        lb.account_id,
+        lb.region_name AS region,
+        lb.name,
+        COALESCE(NULLIF(lb.load_balancer_id, ''), lb.name) AS loadbalancer_id,
+        lb.type,
+        lb.scheme,
+        NULLIF(lb.state, '') AS state,
+        lb.dns_name AS address,
+        lb.vpc_id AS network_id
+    FROM aws_loadbalancer AS lb
+    UNION ALL
+    SELECT
+        fr.id,
+        fr.created_at,
+        fr.updated_at,
+        'gcp' AS provider,
+        'gcp:model:forwarding_rule' AS model_name,
+        fr.project_id AS account_id,
+        fr.region,
+        fr.name,
+        fr.rule_id::text AS loadbalancer_id,
+        fr.ip_protocol AS type,
+        fr.load_balancing_scheme AS scheme,
+        NULL::varchar AS state,
+        host(fr.ip_address) AS address,
+        substring(fr.network from '[^/]+$') AS network_id
+    FROM gcp_forwarding_rule AS fr
+    UNION ALL
+    SELECT
+        lb.id,
+        lb.created_at,
+        lb.updated_at,
+        'azure' AS provider,
+        'az:model:loadbalancer' AS model_name,
+        lb.subscription_id AS account_id,
+        lb.location AS region,
+        lb.name,
+        lb.resource_group || '/' || lb.name AS loadbalancer_id,
+        lb.sku_name AS type,
+        NULL::varchar AS scheme,
+        lb.provisioning_state AS state,
+        NULL::varchar AS address,
+        NULL::varchar AS network_id
+    FROM az_lb AS lb
+    UNION ALL
+    SELECT
+        lb.id,
+        lb.created_at,
+        lb.updated_at,
+        'openstack' AS provider,
+        'openstack:model:loadbalancer' AS model_name,
+        lb.project_id AS account_id,
+        lb.region,
+        lb.name,
+        lb.loadbalancer_id,
+        lb.provider AS type,
+        NULL::varchar AS scheme,
+        lb.status AS state,
+        lb.vip_address AS address,
+        lb.vip_network_id AS network_id
+    FROM openstack_loadbalancer AS lb
+)
+SELECT
+    lb.id,
+    lb.created_at,
+    lb.updated_at,
+    lb.provider,
+    lb.model_name,
+    lb.account_id,
+    lb.region,
+    lb.name,
+    lb.loadbalancer_id,
+    lb.type,
+    lb.scheme,
+    lb.state,
+    lb.address,
+    lb.network_id,
+    s.id AS shoot_id,
+    s.name AS shoot,
+    s.project_name AS project,
+    s.technical_id,
+    s.landscape
+FROM lb
+LEFT JOIN LATERAL (
+    SELECT l.shoot_id FROM l_aux_shoot_to_resource AS l
+    WHERE l.model_name = lb.model_name AND l.resource_id = lb.id
+    ORDER BY CASE l.confidence WHEN 'high' THEN 0 WHEN 'medium' THEN 1 ELSE 2 END
+    LIMIT 1
+) AS link ON true
+LEFT JOIN g_shoot AS s ON s.id = link.shoot_id;
+
+CREATE OR REPLACE VIEW "aux_network" AS
+WITH network AS (
+    SELECT
+        v.id,
+        v.created_at,
+        v.updated_at,
+        'aws' AS provider,
+        'aws:model:vpc' AS model_name,
+        v.account_id,
+        v.region_name AS region,
+        v.name,
+        v.vpc_id AS network_id,
+        v.ipv4_cidr AS cidr,
+        v.state
+    FROM aws_vpc AS v
+    UNION ALL
+    SELECT
+        v.id,
+        v.created_at,
+        v.updated_at,
+        'gcp' AS provider,
+        'gcp:model:vpc' AS model_name,
+        v.project_id AS account_id,
+        NULL::varchar AS region,
+        v.name,
+        v.vpc_id::text AS network_id,
+        NULL::varchar AS cidr,
+        NULL::varchar AS state
+    FROM gcp_vpc AS v
+    UNION ALL
+    SELECT
+        v.id,
+        v.created_at,
+        v.updated_at,
+        'azure' AS provider,
+        'az:model:vpc' AS model_name,
+        v.subscription_id AS account_id,
+        v.location AS region,
+        v.name,
+        v.resource_group || '/' || v.name AS network_id,
+        NULL::varchar AS cidr,
+        v.provisioning_state AS state
+    FROM az_vpc AS v
+    UNION ALL
+    SELECT
+        n.id,
+        n.created_at,
+        n.updated_at,
+        'openstack' AS provider,
+        'openstack:model:network' AS model_name,
+        n.project_id AS account_id,
+        n.region,
+        n.name,
+        n.network_id,
+        NULL::varchar AS cidr,
+        n.status AS state
+    FROM openstack_network AS n
+)
+SELECT
+    n.id,
+    n.created_at,
+    n.updated_at,
+    n.provider,
+    n.model_name,
+    n.account_id,
+    n.region,
+    n.name,
+    n.network_id,
+    n.cidr,
+    n.state,
+    s.id AS shoot_id,
+    s.name AS shoot,
+    s.project_name AS project,
+    s.technical_id,
+    s.landscape
+FROM network AS n
+LEFT JOIN LATERAL (
+    SELECT l.shoot_id FROM l_aux_shoot_to_resource AS l
+    WHERE l.model_name = n.model_name AND l.resource_id = n.id
+    ORDER BY CASE l.confidence WHEN 'high' THEN 0 WHEN 'medium' THEN 1 ELSE 2 END
+    LIMIT 1
+) AS link ON true
+LEFT JOIN g_shoot AS s ON s.id = link.shoot_id;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "aux_external_resource" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid (),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "source" varchar NOT NULL,
+    "kind" varchar NOT NULL,
+    "external_id" varchar NOT NULL,
+    "name" varchar NOT NULL,
+    "location" varchar,
+    "ip_address" inet,
+    "labels" jsonb,
+    "attributes" jsonb,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "aux_external_resource_key" UNIQUE ("source", "kind", "external_id")
+);
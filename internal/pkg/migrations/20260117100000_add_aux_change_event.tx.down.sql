@@ -0,0 +1,2 @@
+DROP FUNCTION IF EXISTS aux_record_change CASCADE;
+DROP TABLE IF EXISTS "aux_change_event";
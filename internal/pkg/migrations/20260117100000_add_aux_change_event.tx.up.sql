@@ -0,0 +1,51 @@
+CREATE TABLE IF NOT EXISTS "aux_change_event" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid (),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "model_name" varchar NOT NULL,
+    "operation" varchar NOT NULL,
+    "record_id" uuid NOT NULL,
+    "diff" jsonb,
+    "data" jsonb,
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS "aux_change_event_created_at_idx" ON "aux_change_event" ("created_at");
+
+-- aux_record_change function records the changes of the rows of a table as
+-- change events. It is installed as a row-level trigger by the
+-- aux:task:publish-events task, which passes the model name as the first
+-- argument of the trigger. Updates, which change nothing but the updated_at
+-- column are not recorded.
+CREATE OR REPLACE FUNCTION aux_record_change()
+RETURNS trigger AS
+$func$
+DECLARE
+       change jsonb;
+BEGIN
+       IF TG_OP = 'INSERT' THEN
+          INSERT INTO aux_change_event (created_at, updated_at, model_name, operation, record_id, data)
+          VALUES (clock_timestamp(), clock_timestamp(), TG_ARGV[0], 'create', NEW.id, to_jsonb(NEW));
+
+          RETURN NEW;
+       ELSIF TG_OP = 'UPDATE' THEN
+          SELECT jsonb_object_agg(n.key, jsonb_build_object('old', o.value, 'new', n.value))
+          INTO change
+          FROM jsonb_each(to_jsonb(NEW)) AS n
+          JOIN jsonb_each(to_jsonb(OLD)) AS o ON o.key = n.key
+          WHERE n.key <> 'updated_at' AND n.value IS DISTINCT FROM o.value;
+
+          IF change IS NOT NULL THEN
+             INSERT INTO aux_change_event (created_at, updated_at, model_name, operation, record_id, diff, data)
+             VALUES (clock_timestamp(), clock_timestamp(), TG_ARGV[0], 'update', NEW.id, change, to_jsonb(NEW));
+          END IF;
+
+          RETURN NEW;
+       END IF;
+
+       INSERT INTO aux_change_event (created_at, updated_at, model_name, operation, record_id, data)
+       VALUES (clock_timestamp(), clock_timestamp(), TG_ARGV[0], 'delete', OLD.id, to_jsonb(OLD));
+
+       RETURN OLD;
+END;
+$func$ LANGUAGE plpgsql;
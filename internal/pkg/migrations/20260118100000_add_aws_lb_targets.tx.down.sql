@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "l_aws_lb_to_instance";
+DROP TABLE IF EXISTS "aws_lb_target";
+DROP TABLE IF EXISTS "aws_target_group";
+DROP TABLE IF EXISTS "aws_lb_listener";
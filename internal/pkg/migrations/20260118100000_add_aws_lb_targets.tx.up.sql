@@ -0,0 +1,77 @@
+CREATE TABLE IF NOT EXISTS "aws_lb_listener" (
+    "dns_name" varchar NOT NULL,
+    "account_id" varchar NOT NULL,
+    "port" integer NOT NULL,
+    "load_balancer_name" varchar NOT NULL,
+    "region_name" varchar NOT NULL,
+    "protocol" varchar NOT NULL,
+    "listener_arn" varchar,
+    "target_group_arns" varchar[],
+    "instance_port" integer,
+    "instance_protocol" varchar,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "aws_lb_listener_key" UNIQUE ("dns_name", "account_id", "port")
+);
+
+CREATE TABLE IF NOT EXISTS "aws_target_group" (
+    "target_group_arn" varchar NOT NULL,
+    "account_id" varchar NOT NULL,
+    "name" varchar NOT NULL,
+    "region_name" varchar NOT NULL,
+    "target_type" varchar NOT NULL,
+    "protocol" varchar,
+    "port" integer,
+    "vpc_id" varchar,
+    "health_check_protocol" varchar,
+    "health_check_path" varchar,
+    "load_balancer_arns" varchar[],
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "aws_target_group_key" UNIQUE ("target_group_arn", "account_id")
+);
+
+CREATE TABLE IF NOT EXISTS "aws_lb_target" (
+    "dns_name" varchar NOT NULL,
+    "account_id" varchar NOT NULL,
+    "target_group_arn" varchar NOT NULL,
+    "target_id" varchar NOT NULL,
+    "port" integer NOT NULL,
+    "region_name" varchar NOT NULL,
+    "target_type" varchar NOT NULL,
+    "az" varchar,
+    "state" varchar NOT NULL,
+    "reason" varchar,
+    "description" varchar,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    CONSTRAINT "aws_lb_target_key" UNIQUE ("dns_name", "account_id", "target_group_arn", "target_id", "port")
+);
+
+CREATE INDEX IF NOT EXISTS "aws_lb_target_target_id_idx" ON "aws_lb_target" ("target_id", "account_id");
+
+CREATE TABLE IF NOT EXISTS "l_aws_lb_to_instance" (
+    "lb_id" uuid NOT NULL,
+    "instance_id" uuid NOT NULL,
+
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("lb_id") REFERENCES "aws_loadbalancer" ("id") ON DELETE CASCADE,
+    FOREIGN KEY ("instance_id") REFERENCES "aws_instance" ("id") ON DELETE CASCADE,
+    CONSTRAINT "l_aws_lb_to_instance_key" UNIQUE ("lb_id", "instance_id")
+);
@@ -0,0 +1 @@
+DROP VIEW IF EXISTS "aux_account_landscape";
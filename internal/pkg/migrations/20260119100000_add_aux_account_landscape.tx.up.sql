@@ -0,0 +1,32 @@
+-- The cloud resources do not record the Gardener landscape they belong to, so
+-- the landscapes of an account are derived from the Machines running in it.
+CREATE OR REPLACE VIEW "aux_account_landscape" AS
+    SELECT DISTINCT
+        'aws' AS provider,
+        i.account_id,
+        m.landscape
+    FROM g_machine AS m
+    INNER JOIN aws_instance AS i ON i.instance_id = substring(m.provider_id from '[^/]+$')
+    WHERE m.provider_id LIKE 'aws://%'
+    UNION
+    SELECT DISTINCT
+        'gcp' AS provider,
+        split_part(m.provider_id, '/', 3) AS account_id,
+        m.landscape
+    FROM g_machine AS m
+    WHERE m.provider_id LIKE 'gce://%'
+    UNION
+    SELECT DISTINCT
+        'azure' AS provider,
+        lower(split_part(m.provider_id, '/', 5)) AS account_id,
+        m.landscape
+    FROM g_machine AS m
+    WHERE m.provider_id LIKE 'azure://%'
+    UNION
+    SELECT DISTINCT
+        'openstack' AS provider,
+        srv.project_id AS account_id,
+        m.landscape
+    FROM g_machine AS m
+    INNER JOIN openstack_server AS srv ON srv.server_id = substring(m.provider_id from '[^/]+$')
+    WHERE m.provider_id LIKE 'openstack://%';
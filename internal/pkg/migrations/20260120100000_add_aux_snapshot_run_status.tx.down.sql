@@ -0,0 +1 @@
+ALTER TABLE aux_snapshot_run DROP COLUMN status;
@@ -0,0 +1,2 @@
+ALTER TABLE aux_snapshot_run ADD COLUMN status VARCHAR NOT NULL DEFAULT 'running';
+UPDATE aux_snapshot_run SET status = 'completed' WHERE completed_at IS NOT NULL;
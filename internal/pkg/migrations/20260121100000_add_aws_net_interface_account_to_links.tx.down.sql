@@ -0,0 +1,11 @@
+ALTER TABLE l_aws_lb_to_net_interface
+	DROP CONSTRAINT l_aws_lb_to_net_interface_ni_id_fkey,
+	ADD CONSTRAINT l_aws_lb_to_net_interface_ni_id_fkey FOREIGN KEY (ni_id) REFERENCES aws_net_interface (id) ON DELETE CASCADE,
+	DROP COLUMN ni_account_id;
+
+ALTER TABLE l_aws_instance_to_net_interface
+	DROP CONSTRAINT l_aws_instance_to_net_interface_ni_id_fkey,
+	ADD CONSTRAINT l_aws_instance_to_net_interface_ni_id_fkey FOREIGN KEY (ni_id) REFERENCES aws_net_interface (id) ON DELETE CASCADE,
+	DROP COLUMN ni_account_id;
+
+ALTER TABLE aws_net_interface DROP CONSTRAINT aws_net_interface_id_account_id_key;
@@ -0,0 +1,15 @@
+ALTER TABLE aws_net_interface ADD CONSTRAINT aws_net_interface_id_account_id_key UNIQUE (id, account_id);
+
+ALTER TABLE l_aws_instance_to_net_interface ADD COLUMN ni_account_id VARCHAR;
+UPDATE l_aws_instance_to_net_interface AS l SET ni_account_id = ni.account_id FROM aws_net_interface AS ni WHERE ni.id = l.ni_id;
+ALTER TABLE l_aws_instance_to_net_interface
+	ALTER COLUMN ni_account_id SET NOT NULL,
+	DROP CONSTRAINT l_aws_instance_to_net_interface_ni_id_fkey,
+	ADD CONSTRAINT l_aws_instance_to_net_interface_ni_id_fkey FOREIGN KEY (ni_id, ni_account_id) REFERENCES aws_net_interface (id, account_id) ON DELETE CASCADE;
+
+ALTER TABLE l_aws_lb_to_net_interface ADD COLUMN ni_account_id VARCHAR;
+UPDATE l_aws_lb_to_net_interface AS l SET ni_account_id = ni.account_id FROM aws_net_interface AS ni WHERE ni.id = l.ni_id;
+ALTER TABLE l_aws_lb_to_net_interface
+	ALTER COLUMN ni_account_id SET NOT NULL,
+	DROP CONSTRAINT l_aws_lb_to_net_interface_ni_id_fkey,
+	ADD CONSTRAINT l_aws_lb_to_net_interface_ni_id_fkey FOREIGN KEY (ni_id, ni_account_id) REFERENCES aws_net_interface (id, account_id) ON DELETE CASCADE;
@@ -0,0 +1,605 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package api provides the HTTP API for querying the collected resources and
+// the audit log, and for enqueueing tasks.
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/backstage"
+	"github.com/gardener/inventory/pkg/core/registry"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+	"github.com/gardener/inventory/pkg/utils/schema"
+)
+
+// Prefix is the path prefix of the API endpoints.
+const Prefix = "/api/v1/"
+
+// DefaultQueue is the queue used for enqueueing tasks, if not specified.
+const DefaultQueue = "default"
+
+// errUnknownTask is an error, which is returned when enqueueing a task, which
+// is not registered.
+var errUnknownTask = errors.New("unknown task")
+
+// errModelNotIngestible is an error, which is returned when pushing records for
+// a model, which is not registered with [registry.IngestModelRegistry].
+var errModelNotIngestible = errors.New("model does not accept ingestion")
+
+// maxIngestBodySize specifies the max size of the request body accepted by the
+// ingestion endpoint.
+const maxIngestBodySize = 10 << 20
+
+// errorResponse represents the response returned by the API on errors.
+type errorResponse struct {
+	Error string `json:"error" desc:"Error message"`
+}
+
+// TaskEnqueuer enqueues tasks. It is implemented by [asynq.Client].
+type TaskEnqueuer interface {
+	// EnqueueContext enqueues the given task.
+	EnqueueContext(ctx context.Context, task *asynq.Task, opts ...asynq.Option) (*asynq.TaskInfo, error)
+}
+
+var _ TaskEnqueuer = &asynq.Client{}
+
+// options provides the optional settings of the API handler.
+type options struct {
+	ingestDB     *bun.DB
+	queries      map[string]*dbutils.NamedQuery
+	queryOptions dbutils.QueryOptions
+}
+
+// Option is a function, which configures the API handler.
+type Option func(o *options)
+
+// WithIngestDB is an [Option], which enables the endpoint for ingesting records
+// pushed by external systems. The records are persisted in the given database,
+// which must be writable.
+func WithIngestDB(db *bun.DB) Option {
+	opt := func(o *options) {
+		o.ingestDB = db
+	}
+
+	return opt
+}
+
+// WithQueries is an [Option], which enables the endpoints for listing and
+// running the given named queries. The timeout and max number of rows of the
+// queries are specified via the given [dbutils.QueryOptions].
+func WithQueries(queries map[string]*dbutils.NamedQuery, opts dbutils.QueryOptions) Option {
+	opt := func(o *options) {
+		o.queries = queries
+		o.queryOptions = opts
+	}
+
+	return opt
+}
+
+// enqueueRequest represents the request for enqueueing a task.
+type enqueueRequest struct {
+	Task    string          `json:"task" desc:"Name of the task to enqueue"`
+	Payload json.RawMessage `json:"payload" desc:"Payload of the task"`
+	Queue   string          `json:"queue" desc:"Queue to enqueue the task in"`
+}
+
+// enqueueResponse represents the response for an enqueued task.
+type enqueueResponse struct {
+	ID    string `json:"id" desc:"ID of the enqueued task"`
+	Queue string `json:"queue" desc:"Queue of the enqueued task"`
+}
+
+// ingestResponse represents the response for ingested records.
+type ingestResponse struct {
+	Model string `json:"model" desc:"Name of the model"`
+	Count int64  `json:"count" desc:"Number of ingested records"`
+}
+
+// NewHandler returns an [http.Handler], which serves the API endpoints using
+// the given database. The endpoint for enqueueing tasks is served only, if the
+// given [TaskEnqueuer] is not nil. The endpoint for ingesting records is served
+// only, if configured via [WithIngestDB], and the endpoints for the named
+// queries are served only, if configured via [WithQueries].
+//
+// The following endpoints are provided.
+//
+//   - GET /api/v1/search?q=<term>&limit=<n>
+//   - GET /api/v1/ip-lookup?q=<address|cidr>
+//   - GET /api/v1/audit?action=<action>&identity=<identity>&since=<duration>&limit=<n>
+//   - GET /api/v1/compute-instances?provider=<provider>&account=<id>&region=<region>&shoot=<technical-id>&project=<project>&limit=<n>
+//   - GET /api/v1/backstage/catalog?landscape=<landscape>&owner=<entity-ref>
+//   - POST /api/v1/tasks
+//   - POST /api/v1/ingest/{model}
+//   - GET /api/v1/queries
+//   - GET /api/v1/queries/{name}?<param>=<value>&limit=<n>
+//   - GET /api/v1/openapi.json
+//
+// The OpenAPI document served by the handler describes the enabled endpoints
+// only.
+//
+// When the requests are authenticated via [NewAuthHandler], the results are
+// limited to the resources of the providers granted by the scopes of the API
+// token.
+func NewHandler(db *bun.DB, enqueuer TaskEnqueuer, opts ...Option) http.Handler {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	mux := http.NewServeMux()
+	enabled := make([]route, 0)
+	for _, rt := range newRoutes(db, enqueuer, o) {
+		if !rt.isEnabled {
+			continue
+		}
+		mux.HandleFunc(rt.method+" "+Prefix+rt.path, rt.handler)
+		enabled = append(enabled, rt)
+	}
+
+	doc := newOpenAPIDocument(enabled)
+	mux.HandleFunc("GET "+Prefix+"openapi.json", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, doc)
+	})
+
+	return mux
+}
+
+// newRoutes returns the routes of the API. Routes, which depend on optional
+// settings, are disabled unless the respective settings are provided.
+func newRoutes(db *bun.DB, enqueuer TaskEnqueuer, o *options) []route {
+	routes := []route{
+		{
+			method:  http.MethodGet,
+			path:    "search",
+			summary: "Search resources by name or ID",
+			scope:   "read:<provider>",
+			params: []param{
+				queryParam("q", "The search term, e.g. a name or ID", true),
+				intQueryParam("limit", "The max number of results"),
+			},
+			response:  schema.For[[]dbutils.SearchResult](),
+			isEnabled: true,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				opts := dbutils.SearchOptions{
+					Term: r.URL.Query().Get("q"),
+				}
+				if opts.Term == "" {
+					writeError(w, http.StatusBadRequest, dbutils.ErrNoSearchTerm)
+
+					return
+				}
+
+				if limit := r.URL.Query().Get("limit"); limit != "" {
+					n, err := strconv.Atoi(limit)
+					if err != nil {
+						writeError(w, http.StatusBadRequest, err)
+
+						return
+					}
+					opts.Limit = n
+				}
+
+				items, err := dbutils.Search(r.Context(), db, opts)
+				if err != nil {
+					writeError(w, http.StatusInternalServerError, err)
+
+					return
+				}
+
+				items = slices.DeleteFunc(items, func(item dbutils.SearchResult) bool {
+					return !canReadModel(r.Context(), item.Model)
+				})
+				writeJSON(w, http.StatusOK, items)
+			},
+		},
+		{
+			method:  http.MethodGet,
+			path:    "ip-lookup",
+			summary: "Find the resources using an IP address or a CIDR",
+			scope:   "read:<provider>",
+			params: []param{
+				queryParam("q", "The IP address or CIDR", true),
+			},
+			response:  schema.For[[]dbutils.IPLookupResult](),
+			isEnabled: true,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				prefix, err := dbutils.ParseIPQuery(r.URL.Query().Get("q"))
+				if err != nil {
+					writeError(w, http.StatusBadRequest, err)
+
+					return
+				}
+
+				items, err := dbutils.LookupIP(r.Context(), db, prefix)
+				if err != nil {
+					writeError(w, http.StatusInternalServerError, err)
+
+					return
+				}
+
+				items = slices.DeleteFunc(items, func(item dbutils.IPLookupResult) bool {
+					return !canReadModel(r.Context(), item.Model)
+				})
+				writeJSON(w, http.StatusOK, items)
+			},
+		},
+		{
+			method:  http.MethodGet,
+			path:    "audit",
+			summary: "List the entries of the audit log",
+			scope:   ScopeReadAudit,
+			params: []param{
+				queryParam("action", "The action to filter by, e.g. task:enqueue", false),
+				queryParam("identity", "The identity to filter by", false),
+				queryParam("since", "The max age of the entries, e.g. 24h", false),
+				intQueryParam("limit", "The max number of entries"),
+			},
+			response:  schema.For[[]dbutils.AuditLogEntry](),
+			isEnabled: true,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if !hasScope(r.Context(), ScopeReadAudit) {
+					writeError(w, http.StatusForbidden, errForbidden)
+
+					return
+				}
+
+				query := r.URL.Query()
+				opts := dbutils.AuditLogOptions{
+					Action:   query.Get("action"),
+					Identity: query.Get("identity"),
+				}
+
+				if since := query.Get("since"); since != "" {
+					d, err := time.ParseDuration(since)
+					if err != nil {
+						writeError(w, http.StatusBadRequest, err)
+
+						return
+					}
+					opts.Since = time.Now().Add(-d)
+				}
+
+				if limit := query.Get("limit"); limit != "" {
+					n, err := strconv.Atoi(limit)
+					if err != nil {
+						writeError(w, http.StatusBadRequest, err)
+
+						return
+					}
+					opts.Limit = n
+				}
+
+				items, err := dbutils.ListAuditLog(r.Context(), db, opts)
+				if err != nil {
+					writeError(w, http.StatusInternalServerError, err)
+
+					return
+				}
+
+				writeJSON(w, http.StatusOK, items)
+			},
+		},
+		{
+			method:  http.MethodGet,
+			path:    "compute-instances",
+			summary: "List the compute instances of all providers",
+			scope:   "read:<provider>",
+			params: []param{
+				queryParam("provider", "The provider to filter by, e.g. aws", false),
+				queryParam("account", "The account, project or subscription to filter by", false),
+				queryParam("region", "The region to filter by", false),
+				queryParam("shoot", "The technical ID of the shoot to filter by", false),
+				queryParam("project", "The Gardener project to filter by", false),
+				intQueryParam("limit", "The max number of instances"),
+			},
+			response:  schema.For[[]dbutils.ComputeInstance](),
+			isEnabled: true,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				query := r.URL.Query()
+				opts := dbutils.ComputeInstanceOptions{
+					Provider:  query.Get("provider"),
+					AccountID: query.Get("account"),
+					Region:    query.Get("region"),
+					Shoot:     query.Get("shoot"),
+					Project:   query.Get("project"),
+				}
+
+				if limit := query.Get("limit"); limit != "" {
+					n, err := strconv.Atoi(limit)
+					if err != nil {
+						writeError(w, http.StatusBadRequest, err)
+
+						return
+					}
+					opts.Limit = n
+				}
+
+				items, err := dbutils.ListComputeInstances(r.Context(), db, opts)
+				if err != nil {
+					writeError(w, http.StatusInternalServerError, err)
+
+					return
+				}
+
+				items = slices.DeleteFunc(items, func(item dbutils.ComputeInstance) bool {
+					return !canReadModel(r.Context(), item.Model)
+				})
+				writeJSON(w, http.StatusOK, items)
+			},
+		},
+		{
+			method:  http.MethodGet,
+			path:    "backstage/catalog",
+			summary: "Render the Backstage catalog entities as YAML",
+			scope:   ScopeReadGardener,
+			params: []param{
+				queryParam("landscape", "The Gardener landscape to filter by", false),
+				queryParam("owner", "The owner of entities without an owner, e.g. group:gardener", false),
+			},
+			response:    &schema.Schema{Type: schema.Types{schema.TypeString}},
+			contentType: backstage.ContentType,
+			isEnabled:   true,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if !canReadModel(r.Context(), "g:model:shoot") {
+					writeError(w, http.StatusForbidden, errForbidden)
+
+					return
+				}
+
+				query := r.URL.Query()
+				opts := backstage.Options{
+					Landscape: query.Get("landscape"),
+					Owner:     query.Get("owner"),
+				}
+
+				entities, err := backstage.ListEntities(r.Context(), db, opts)
+				if err != nil {
+					writeError(w, http.StatusInternalServerError, err)
+
+					return
+				}
+
+				var buf bytes.Buffer
+				if err := backstage.Encode(&buf, entities); err != nil {
+					writeError(w, http.StatusInternalServerError, err)
+
+					return
+				}
+
+				w.Header().Set("Content-Type", backstage.ContentType)
+				w.WriteHeader(http.StatusOK)
+				if _, err := w.Write(buf.Bytes()); err != nil {
+					slog.Error("failed to write api response", "reason", err)
+				}
+			},
+		},
+		{
+			method:    http.MethodPost,
+			path:      "tasks",
+			summary:   "Enqueue a task",
+			scope:     ScopeTriggerTasks,
+			request:   schema.For[enqueueRequest](),
+			response:  schema.For[enqueueResponse](),
+			status:    http.StatusAccepted,
+			isEnabled: enqueuer != nil,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if !hasScope(r.Context(), ScopeTriggerTasks) {
+					writeError(w, http.StatusForbidden, errForbidden)
+
+					return
+				}
+
+				var req enqueueRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					writeError(w, http.StatusBadRequest, err)
+
+					return
+				}
+
+				if _, ok := registry.TaskRegistry.Get(req.Task); !ok {
+					writeError(w, http.StatusBadRequest, fmt.Errorf("%w: %s", errUnknownTask, req.Task))
+
+					return
+				}
+
+				queue := req.Queue
+				if queue == "" {
+					queue = DefaultQueue
+				}
+
+				var payload []byte
+				if len(req.Payload) > 0 && string(req.Payload) != "null" {
+					payload = req.Payload
+				}
+
+				task := asynq.NewTask(req.Task, payload)
+				info, err := enqueuer.EnqueueContext(r.Context(), task, asynq.Queue(queue))
+				if err != nil {
+					writeError(w, http.StatusInternalServerError, err)
+
+					return
+				}
+
+				writeJSON(w, http.StatusAccepted, enqueueResponse{ID: info.ID, Queue: info.Queue})
+			},
+		},
+		{
+			method:    http.MethodPost,
+			path:      "ingest/{model}",
+			summary:   "Push records of a model",
+			scope:     ScopeWriteIngest,
+			params:    []param{pathParam("model", "The name of the model")},
+			response:  schema.For[ingestResponse](),
+			models:    ingestModelSchemas,
+			isEnabled: o.ingestDB != nil,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if !hasScope(r.Context(), ScopeWriteIngest) {
+					writeError(w, http.StatusForbidden, errForbidden)
+
+					return
+				}
+
+				modelName := r.PathValue("model")
+				ingest, ok := registry.IngestModelRegistry.Get(modelName)
+				if !ok {
+					writeError(w, http.StatusBadRequest, fmt.Errorf("%w: %s", errModelNotIngestible, modelName))
+
+					return
+				}
+
+				model, ok := registry.ModelRegistry.Get(modelName)
+				if !ok {
+					writeError(w, http.StatusBadRequest, fmt.Errorf("%w: %s", errModelNotIngestible, modelName))
+
+					return
+				}
+
+				data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIngestBodySize))
+				if err != nil {
+					writeError(w, http.StatusBadRequest, err)
+
+					return
+				}
+
+				table := o.ingestDB.Table(reflect.TypeOf(model).Elem())
+				records, err := dbutils.DecodeDocuments(table, data)
+				if err != nil {
+					writeError(w, http.StatusBadRequest, err)
+
+					return
+				}
+
+				count, err := dbutils.IngestDocuments(r.Context(), o.ingestDB, table, records, ingest.ConflictColumns)
+				if err != nil {
+					writeError(w, http.StatusInternalServerError, err)
+
+					return
+				}
+
+				writeJSON(w, http.StatusOK, ingestResponse{Model: modelName, Count: count})
+			},
+		},
+		{
+			method:    http.MethodGet,
+			path:      "queries",
+			summary:   "List the named queries",
+			scope:     ScopeReadQueries,
+			response:  schema.For[[]dbutils.NamedQuery](),
+			isEnabled: o.queries != nil,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if !hasScope(r.Context(), ScopeReadQueries) {
+					writeError(w, http.StatusForbidden, errForbidden)
+
+					return
+				}
+
+				items := make([]*dbutils.NamedQuery, 0, len(o.queries))
+				for _, name := range slices.Sorted(maps.Keys(o.queries)) {
+					items = append(items, o.queries[name])
+				}
+				writeJSON(w, http.StatusOK, items)
+			},
+		},
+		{
+			method:  http.MethodGet,
+			path:    "queries/{name}",
+			summary: "Run a named query, passing its parameters as query parameters",
+			scope:   ScopeReadQueries,
+			params: []param{
+				pathParam("name", "The name of the query"),
+				intQueryParam("limit", "The max number of rows, limited by the configured max rows"),
+			},
+			response:  schema.For[dbutils.QueryResult](),
+			isEnabled: o.queries != nil,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if !hasScope(r.Context(), ScopeReadQueries) {
+					writeError(w, http.StatusForbidden, errForbidden)
+
+					return
+				}
+
+				name := r.PathValue("name")
+				q, ok := o.queries[name]
+				if !ok {
+					writeError(w, http.StatusBadRequest, fmt.Errorf("%w: %s", dbutils.ErrUnknownQuery, name))
+
+					return
+				}
+
+				opts := o.queryOptions
+				opts.Params = make(map[string]string)
+				for key, values := range r.URL.Query() {
+					if key == "limit" {
+						continue
+					}
+					opts.Params[key] = values[0]
+				}
+
+				if limit := r.URL.Query().Get("limit"); limit != "" {
+					n, err := strconv.Atoi(limit)
+					if err != nil {
+						writeError(w, http.StatusBadRequest, err)
+
+						return
+					}
+					if n > 0 && (opts.MaxRows <= 0 || n < opts.MaxRows) {
+						opts.MaxRows = n
+					}
+				}
+
+				result, err := dbutils.RunNamedQuery(r.Context(), db, q, opts)
+				if err != nil {
+					code := http.StatusInternalServerError
+					if errors.Is(err, dbutils.ErrInvalidQueryParams) {
+						code = http.StatusBadRequest
+					}
+					writeError(w, code, err)
+
+					return
+				}
+
+				writeJSON(w, http.StatusOK, result)
+			},
+		},
+	}
+
+	return routes
+}
+
+// writeJSON writes the given value as JSON with the given status code.
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Error("failed to encode api response", "reason", err)
+	}
+}
+
+// writeError writes the given error as JSON with the given status code.
+// Internal errors are logged, and a generic message is returned instead.
+func writeError(w http.ResponseWriter, code int, err error) {
+	if code == http.StatusInternalServerError {
+		slog.Error("api request failed", "reason", err)
+		err = errors.New(http.StatusText(code))
+	}
+
+	writeJSON(w, code, errorResponse{Error: err.Error()})
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/api"
+)
+
+func TestHandlerBadRequests(t *testing.T) {
+	handler := api.NewHandler(nil, nil)
+	testCases := []struct {
+		desc   string
+		method string
+		target string
+		wanted int
+	}{
+		{
+			desc:   "search without term",
+			method: http.MethodGet,
+			target: api.Prefix + "search",
+			wanted: http.StatusBadRequest,
+		},
+		{
+			desc:   "search with invalid limit",
+			method: http.MethodGet,
+			target: api.Prefix + "search?q=foo&limit=bar",
+			wanted: http.StatusBadRequest,
+		},
+		{
+			desc:   "ip lookup with invalid address",
+			method: http.MethodGet,
+			target: api.Prefix + "ip-lookup?q=not-an-ip",
+			wanted: http.StatusBadRequest,
+		},
+		{
+			desc:   "audit with invalid since",
+			method: http.MethodGet,
+			target: api.Prefix + "audit?since=yesterday",
+			wanted: http.StatusBadRequest,
+		},
+		{
+			desc:   "audit with invalid limit",
+			method: http.MethodGet,
+			target: api.Prefix + "audit?limit=bar",
+			wanted: http.StatusBadRequest,
+		},
+		{
+			desc:   "compute instances with invalid limit",
+			method: http.MethodGet,
+			target: api.Prefix + "compute-instances?limit=bar",
+			wanted: http.StatusBadRequest,
+		},
+		{
+			desc:   "unsupported method",
+			method: http.MethodPost,
+			target: api.Prefix + "search?q=foo",
+			wanted: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.target, nil)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tc.wanted {
+				t.Fatalf("got status %d wanted %d", rec.Code, tc.wanted)
+			}
+		})
+	}
+}
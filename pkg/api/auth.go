@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/audit"
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+	"github.com/gardener/inventory/pkg/core/registry"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+// Scopes, which may be granted to API tokens
+const (
+	// ScopeReadAWS grants read access to the AWS resources.
+	ScopeReadAWS = "read:aws"
+
+	// ScopeReadGCP grants read access to the GCP resources.
+	ScopeReadGCP = "read:gcp"
+
+	// ScopeReadAzure grants read access to the Azure resources.
+	ScopeReadAzure = "read:azure"
+
+	// ScopeReadOpenStack grants read access to the OpenStack resources.
+	ScopeReadOpenStack = "read:openstack"
+
+	// ScopeReadGardener grants read access to the Gardener resources.
+	ScopeReadGardener = "read:gardener"
+
+	// ScopeReadAux grants read access to the auxiliary resources.
+	ScopeReadAux = "read:aux"
+
+	// ScopeReadAudit grants read access to the audit log.
+	ScopeReadAudit = "read:audit"
+
+	// ScopeTriggerTasks grants access to enqueueing tasks.
+	ScopeTriggerTasks = "trigger:tasks"
+
+	// ScopeWriteIngest grants access to pushing records via the ingestion
+	// endpoint.
+	ScopeWriteIngest = "write:ingest"
+
+	// ScopeReadQueries grants access to running the named queries.
+	ScopeReadQueries = "read:queries"
+)
+
+// Scopes provides the list of known scopes.
+var Scopes = []string{
+	ScopeReadAWS,
+	ScopeReadGCP,
+	ScopeReadAzure,
+	ScopeReadOpenStack,
+	ScopeReadGardener,
+	ScopeReadAux,
+	ScopeReadAudit,
+	ScopeTriggerTasks,
+	ScopeWriteIngest,
+	ScopeReadQueries,
+}
+
+// providerScopes maps the prefixes of the model names to the scopes, which
+// grant read access to them.
+var providerScopes = map[string]string{
+	"aws":       ScopeReadAWS,
+	"gcp":       ScopeReadGCP,
+	"az":        ScopeReadAzure,
+	"openstack": ScopeReadOpenStack,
+	"g":         ScopeReadGardener,
+	"aux":       ScopeReadAux,
+}
+
+// TokenPrefix is the prefix of the generated API tokens, which makes them
+// easier to identify, e.g. by secret scanners.
+const TokenPrefix = "inv_"
+
+// ErrInvalidScope is an error, which is returned when an unknown scope is
+// specified.
+var ErrInvalidScope = errors.New("invalid scope")
+
+// errUnauthorized is an error, which is returned when a request does not carry
+// a valid API token.
+var errUnauthorized = errors.New("missing or invalid api token")
+
+// errForbidden is an error, which is returned when the API token of a request
+// does not grant the required scope.
+var errForbidden = errors.New("insufficient scope")
+
+// ValidateScopes returns an error, if any of the given scopes is not known.
+func ValidateScopes(scopes []string) error {
+	for _, scope := range scopes {
+		if !slices.Contains(Scopes, scope) {
+			return fmt.Errorf("%w: %s", ErrInvalidScope, scope)
+		}
+	}
+
+	return nil
+}
+
+// GenerateToken returns a new random API token.
+func GenerateToken() (string, error) {
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+
+	return TokenPrefix + base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// HashToken returns the hex-encoded SHA-256 hash of the given API token.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// TokenStore provides the API tokens.
+type TokenStore interface {
+	// GetToken returns the API token with the given hash, or
+	// [sql.ErrNoRows], if it does not exist.
+	GetToken(ctx context.Context, hash string) (*models.APIToken, error)
+}
+
+// DBTokenStore is a [TokenStore], which reads the API tokens from the
+// database.
+type DBTokenStore struct {
+	db bun.IDB
+}
+
+var _ TokenStore = &DBTokenStore{}
+
+// NewDBTokenStore returns a new [DBTokenStore] using the given database.
+func NewDBTokenStore(db bun.IDB) *DBTokenStore {
+	return &DBTokenStore{db: db}
+}
+
+// GetToken implements the [TokenStore] interface.
+func (s *DBTokenStore) GetToken(ctx context.Context, hash string) (*models.APIToken, error) {
+	return dbutils.GetAPITokenByHash(ctx, s.db, hash)
+}
+
+// tokenKey is the key used to store the authenticated [models.APIToken] in the
+// context of a request.
+type tokenKey struct{}
+
+// NewAuthHandler returns an [http.Handler], which authenticates the requests
+// via the API tokens from the given [TokenStore], before passing them to the
+// next [http.Handler]. The token is expected in the Authorization header using
+// the Bearer scheme.
+func NewAuthHandler(store TokenStore, next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+			writeUnauthorized(w)
+
+			return
+		}
+
+		item, err := store.GetToken(r.Context(), HashToken(token))
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			writeUnauthorized(w)
+
+			return
+		case err != nil:
+			writeError(w, http.StatusInternalServerError, err)
+
+			return
+		case !item.IsValid(time.Now()):
+			writeUnauthorized(w)
+
+			return
+		}
+
+		audit.SetIdentity(r.Context(), "token:"+item.Name)
+		ctx := context.WithValue(r.Context(), tokenKey{}, item)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	}
+
+	return http.HandlerFunc(fn)
+}
+
+// writeUnauthorized writes the response for requests without a valid API
+// token.
+func writeUnauthorized(w http.ResponseWriter) {
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	writeError(w, http.StatusUnauthorized, errUnauthorized)
+}
+
+// hasScope returns true, if the API token of the request with the given
+// context grants the given scope. When authentication is disabled, all scopes
+// are granted.
+func hasScope(ctx context.Context, scope string) bool {
+	item, ok := ctx.Value(tokenKey{}).(*models.APIToken)
+	if !ok {
+		return true
+	}
+
+	return slices.Contains(item.Scopes, scope)
+}
+
+// canReadModel returns true, if the API token of the request with the given
+// context grants read access to the model with the given name. Models
+// registered with [registry.SensitiveModelRegistry] are never readable.
+func canReadModel(ctx context.Context, model string) bool {
+	if registry.SensitiveModelRegistry.Exists(model) {
+		return false
+	}
+
+	provider, _, _ := strings.Cut(model, ":")
+	scope, ok := providerScopes[provider]
+	if !ok {
+		return false
+	}
+
+	return hasScope(ctx, scope)
+}
@@ -0,0 +1,298 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+
+	"github.com/gardener/inventory/pkg/api"
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+	"github.com/gardener/inventory/pkg/core/registry"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+// fakeTokenStore is an [api.TokenStore], which keeps the tokens in memory.
+type fakeTokenStore struct {
+	tokens map[string]*models.APIToken
+}
+
+func (f *fakeTokenStore) GetToken(_ context.Context, hash string) (*models.APIToken, error) {
+	item, ok := f.tokens[hash]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return item, nil
+}
+
+// fakeEnqueuer is an [api.TaskEnqueuer], which keeps the tasks in memory.
+type fakeEnqueuer struct {
+	tasks []*asynq.Task
+}
+
+func (f *fakeEnqueuer) EnqueueContext(_ context.Context, task *asynq.Task, _ ...asynq.Option) (*asynq.TaskInfo, error) {
+	f.tasks = append(f.tasks, task)
+
+	return &asynq.TaskInfo{ID: "id", Queue: api.DefaultQueue}, nil
+}
+
+func TestAuthHandler(t *testing.T) {
+	taskName := "test:task:noop"
+	registry.TaskRegistry.MustRegister(taskName, asynq.HandlerFunc(func(context.Context, *asynq.Task) error {
+		return nil
+	}))
+
+	store := &fakeTokenStore{
+		tokens: map[string]*models.APIToken{
+			api.HashToken("reader"): {
+				Name:   "reader",
+				Scopes: []string{api.ScopeReadAWS},
+			},
+			api.HashToken("trigger"): {
+				Name:   "trigger",
+				Scopes: []string{api.ScopeTriggerTasks},
+			},
+			api.HashToken("ingest"): {
+				Name:   "ingest",
+				Scopes: []string{api.ScopeWriteIngest},
+			},
+			api.HashToken("queries"): {
+				Name:   "queries",
+				Scopes: []string{api.ScopeReadQueries},
+			},
+			api.HashToken("revoked"): {
+				Name:      "revoked",
+				Scopes:    []string{api.ScopeTriggerTasks},
+				RevokedAt: time.Now().Add(-time.Hour),
+			},
+			api.HashToken("expired"): {
+				Name:      "expired",
+				Scopes:    []string{api.ScopeTriggerTasks},
+				ExpiresAt: time.Now().Add(-time.Hour),
+			},
+		},
+	}
+	enqueuer := &fakeEnqueuer{}
+
+	// The database is never connected to, since the ingested documents
+	// are rejected before being persisted.
+	ingestDB := bun.NewDB(sql.OpenDB(pgdriver.NewConnector()), pgdialect.New())
+	defer ingestDB.Close() // nolint: errcheck
+	queries := map[string]*dbutils.NamedQuery{
+		"shoots": {
+			Name:   "shoots",
+			Params: []dbutils.QueryParam{{Name: "project"}},
+			Query:  "SELECT name FROM g_shoot WHERE project_name = ?project",
+		},
+	}
+	handler := api.NewAuthHandler(store, api.NewHandler(nil, enqueuer, api.WithIngestDB(ingestDB), api.WithQueries(queries, dbutils.QueryOptions{})))
+
+	testCases := []struct {
+		desc   string
+		token  string
+		method string
+		target string
+		body   string
+		wanted int
+	}{
+		{
+			desc:   "missing token",
+			method: http.MethodGet,
+			target: api.Prefix + "audit",
+			wanted: http.StatusUnauthorized,
+		},
+		{
+			desc:   "unknown token",
+			token:  "unknown",
+			method: http.MethodGet,
+			target: api.Prefix + "audit",
+			wanted: http.StatusUnauthorized,
+		},
+		{
+			desc:   "revoked token",
+			token:  "revoked",
+			method: http.MethodPost,
+			target: api.Prefix + "tasks",
+			body:   `{"task": "test:task:noop"}`,
+			wanted: http.StatusUnauthorized,
+		},
+		{
+			desc:   "expired token",
+			token:  "expired",
+			method: http.MethodPost,
+			target: api.Prefix + "tasks",
+			body:   `{"task": "test:task:noop"}`,
+			wanted: http.StatusUnauthorized,
+		},
+		{
+			desc:   "audit without scope",
+			token:  "reader",
+			method: http.MethodGet,
+			target: api.Prefix + "audit",
+			wanted: http.StatusForbidden,
+		},
+		{
+			desc:   "backstage catalog without scope",
+			token:  "reader",
+			method: http.MethodGet,
+			target: api.Prefix + "backstage/catalog",
+			wanted: http.StatusForbidden,
+		},
+		{
+			desc:   "enqueue without scope",
+			token:  "reader",
+			method: http.MethodPost,
+			target: api.Prefix + "tasks",
+			body:   `{"task": "test:task:noop"}`,
+			wanted: http.StatusForbidden,
+		},
+		{
+			desc:   "enqueue unknown task",
+			token:  "trigger",
+			method: http.MethodPost,
+			target: api.Prefix + "tasks",
+			body:   `{"task": "test:task:unknown"}`,
+			wanted: http.StatusBadRequest,
+		},
+		{
+			desc:   "ingest without scope",
+			token:  "trigger",
+			method: http.MethodPost,
+			target: api.Prefix + "ingest/aux:model:external_resource",
+			body:   `[]`,
+			wanted: http.StatusForbidden,
+		},
+		{
+			desc:   "ingest model not accepting ingestion",
+			token:  "ingest",
+			method: http.MethodPost,
+			target: api.Prefix + "ingest/g:model:shoot",
+			body:   `[]`,
+			wanted: http.StatusBadRequest,
+		},
+		{
+			desc:   "ingest invalid document",
+			token:  "ingest",
+			method: http.MethodPost,
+			target: api.Prefix + "ingest/aux:model:external_resource",
+			body:   `[{"source": "dns", "kind": "appliance", "external_id": "1", "name": "ns1", "color": "red"}]`,
+			wanted: http.StatusBadRequest,
+		},
+		{
+			desc:   "queries without scope",
+			token:  "reader",
+			method: http.MethodGet,
+			target: api.Prefix + "queries",
+			wanted: http.StatusForbidden,
+		},
+		{
+			desc:   "list queries",
+			token:  "queries",
+			method: http.MethodGet,
+			target: api.Prefix + "queries",
+			wanted: http.StatusOK,
+		},
+		{
+			desc:   "run query without scope",
+			token:  "reader",
+			method: http.MethodGet,
+			target: api.Prefix + "queries/shoots?project=foo",
+			wanted: http.StatusForbidden,
+		},
+		{
+			desc:   "run unknown query",
+			token:  "queries",
+			method: http.MethodGet,
+			target: api.Prefix + "queries/seeds",
+			wanted: http.StatusBadRequest,
+		},
+		{
+			desc:   "run query with missing param",
+			token:  "queries",
+			method: http.MethodGet,
+			target: api.Prefix + "queries/shoots",
+			wanted: http.StatusBadRequest,
+		},
+		{
+			desc:   "run query with unknown param",
+			token:  "queries",
+			method: http.MethodGet,
+			target: api.Prefix + "queries/shoots?project=foo&region=eu",
+			wanted: http.StatusBadRequest,
+		},
+		{
+			desc:   "enqueue task",
+			token:  "trigger",
+			method: http.MethodPost,
+			target: api.Prefix + "tasks",
+			body:   `{"task": "test:task:noop", "payload": {"foo": "bar"}}`,
+			wanted: http.StatusAccepted,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body))
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tc.wanted {
+				t.Fatalf("got status %d wanted %d: %s", rec.Code, tc.wanted, rec.Body.String())
+			}
+		})
+	}
+
+	if len(enqueuer.tasks) != 1 {
+		t.Fatalf("got %d enqueued tasks wanted 1", len(enqueuer.tasks))
+	}
+
+	var payload map[string]string
+	if err := json.Unmarshal(enqueuer.tasks[0].Payload(), &payload); err != nil || payload["foo"] != "bar" {
+		t.Fatalf("unexpected payload %q", enqueuer.tasks[0].Payload())
+	}
+}
+
+func TestValidateScopes(t *testing.T) {
+	if err := api.ValidateScopes([]string{api.ScopeReadAWS, api.ScopeTriggerTasks}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := api.ValidateScopes([]string{"read:everything"}); !errors.Is(err, api.ErrInvalidScope) {
+		t.Fatalf("got %v wanted %v", err, api.ErrInvalidScope)
+	}
+}
+
+func TestGenerateToken(t *testing.T) {
+	a, err := api.GenerateToken()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	b, err := api.GenerateToken()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if a == b || !strings.HasPrefix(a, api.TokenPrefix) {
+		t.Fatalf("unexpected tokens %q and %q", a, b)
+	}
+	if api.HashToken(a) == a || api.HashToken(a) != api.HashToken(a) {
+		t.Fatal("unexpected token hash")
+	}
+}
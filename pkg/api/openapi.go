@@ -0,0 +1,373 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"encoding"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/uptrace/bun/dialect/pgdialect"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+	"github.com/gardener/inventory/pkg/utils/schema"
+	"github.com/gardener/inventory/pkg/version"
+)
+
+// openAPIVersion is the version of the OpenAPI specification, which the
+// generated documents conform to.
+const openAPIVersion = "3.1.0"
+
+// bearerAuthScheme is the name of the security scheme for API tokens.
+const bearerAuthScheme = "bearerAuth"
+
+// route describes an endpoint of the API, which is served by the handler and
+// described in the OpenAPI document.
+type route struct {
+	// method specifies the HTTP method of the endpoint.
+	method string
+
+	// path specifies the path of the endpoint relative to [Prefix].
+	path string
+
+	// summary provides a short description of the endpoint.
+	summary string
+
+	// scope specifies the scope, which grants access to the endpoint.
+	scope string
+
+	// params specifies the query and path parameters of the endpoint.
+	params []param
+
+	// request specifies the schema of the request body, if any.
+	request *schema.Schema
+
+	// response specifies the schema of the response body.
+	response *schema.Schema
+
+	// contentType specifies the content type of the response body. If
+	// empty, application/json is used.
+	contentType string
+
+	// status specifies the status code of successful responses. If zero,
+	// [http.StatusOK] is used.
+	status int
+
+	// models returns the schemas of the request bodies per model for
+	// endpoints with a {model} path parameter. The OpenAPI document
+	// describes a separate operation for each model.
+	models func() map[string]*schema.Schema
+
+	// isEnabled specifies whether the endpoint is served or not.
+	isEnabled bool
+
+	// handler specifies the handler of the endpoint.
+	handler http.HandlerFunc
+}
+
+// param describes a query or path parameter of an endpoint.
+type param struct {
+	name        string
+	in          string
+	description string
+	required    bool
+	schema      *schema.Schema
+}
+
+// queryParam returns a string query [param].
+func queryParam(name, description string, required bool) param {
+	return param{
+		name:        name,
+		in:          "query",
+		description: description,
+		required:    required,
+		schema:      &schema.Schema{Type: schema.Types{schema.TypeString}},
+	}
+}
+
+// intQueryParam returns an optional integer query [param].
+func intQueryParam(name, description string) param {
+	return param{
+		name:        name,
+		in:          "query",
+		description: description,
+		schema:      &schema.Schema{Type: schema.Types{schema.TypeInteger}},
+	}
+}
+
+// pathParam returns a string path [param].
+func pathParam(name, description string) param {
+	return param{
+		name:        name,
+		in:          "path",
+		description: description,
+		required:    true,
+		schema:      &schema.Schema{Type: schema.Types{schema.TypeString}},
+	}
+}
+
+// Document represents an OpenAPI document.
+type Document struct {
+	OpenAPI    string                           `json:"openapi" yaml:"openapi"`
+	Info       Info                             `json:"info" yaml:"info"`
+	Paths      map[string]map[string]*Operation `json:"paths" yaml:"paths"`
+	Components Components                       `json:"components" yaml:"components"`
+	Security   []map[string][]string            `json:"security" yaml:"security"`
+}
+
+// Info represents the metadata of an OpenAPI document.
+type Info struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Version     string `json:"version" yaml:"version"`
+}
+
+// Operation represents an operation of an OpenAPI document.
+type Operation struct {
+	OperationID string               `json:"operationId" yaml:"operationId"`
+	Summary     string               `json:"summary" yaml:"summary"`
+	Description string               `json:"description,omitempty" yaml:"description,omitempty"`
+	Parameters  []Parameter          `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty" yaml:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses" yaml:"responses"`
+}
+
+// Parameter represents a parameter of an [Operation].
+type Parameter struct {
+	Name        string         `json:"name" yaml:"name"`
+	In          string         `json:"in" yaml:"in"`
+	Description string         `json:"description,omitempty" yaml:"description,omitempty"`
+	Required    bool           `json:"required,omitempty" yaml:"required,omitempty"`
+	Schema      *schema.Schema `json:"schema" yaml:"schema"`
+}
+
+// RequestBody represents the request body of an [Operation].
+type RequestBody struct {
+	Required bool                 `json:"required" yaml:"required"`
+	Content  map[string]MediaType `json:"content" yaml:"content"`
+}
+
+// Response represents a response of an [Operation].
+type Response struct {
+	Description string               `json:"description" yaml:"description"`
+	Content     map[string]MediaType `json:"content,omitempty" yaml:"content,omitempty"`
+}
+
+// MediaType represents the content of a request or response body.
+type MediaType struct {
+	Schema *schema.Schema `json:"schema" yaml:"schema"`
+}
+
+// Components represents the reusable components of an OpenAPI document.
+type Components struct {
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes" yaml:"securitySchemes"`
+}
+
+// SecurityScheme represents a security scheme of an OpenAPI document.
+type SecurityScheme struct {
+	Type        string `json:"type" yaml:"type"`
+	Scheme      string `json:"scheme" yaml:"scheme"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// OpenAPIDocument returns the OpenAPI document, which describes all endpoints
+// of the API, including the ones, which are served only when enabled.
+func OpenAPIDocument() *Document {
+	return newOpenAPIDocument(newRoutes(nil, nil, &options{}))
+}
+
+// newOpenAPIDocument returns the OpenAPI document for the given routes.
+func newOpenAPIDocument(routes []route) *Document {
+	doc := &Document{
+		OpenAPI: openAPIVersion,
+		Info: Info{
+			Title:       "Gardener Inventory API",
+			Description: "API for querying the collected resources and the audit log, and for enqueueing tasks.",
+			Version:     version.Version,
+		},
+		Paths: make(map[string]map[string]*Operation),
+		Components: Components{
+			SecuritySchemes: map[string]SecurityScheme{
+				bearerAuthScheme: {
+					Type:        "http",
+					Scheme:      "bearer",
+					Description: "API token, which is required when authentication is enabled.",
+				},
+			},
+		},
+		// Authentication is optional, since it depends on the
+		// configuration of the dashboard.
+		Security: []map[string][]string{
+			{bearerAuthScheme: {}},
+			{},
+		},
+	}
+
+	for _, rt := range routes {
+		if rt.models == nil {
+			addOperation(doc, rt, rt.path, rt.params, rt.request)
+
+			continue
+		}
+
+		// Describe a separate operation per model, so that the
+		// generated clients are typed.
+		params := slices.DeleteFunc(slices.Clone(rt.params), func(p param) bool {
+			return p.name == "model"
+		})
+		for name, s := range rt.models() {
+			path := strings.ReplaceAll(rt.path, "{model}", name)
+			addOperation(doc, rt, path, params, s)
+		}
+	}
+
+	return doc
+}
+
+// addOperation adds the [Operation] of the given route to the OpenAPI document
+// using the given path, parameters and request body.
+func addOperation(doc *Document, rt route, path string, params []param, request *schema.Schema) {
+	op := &Operation{
+		OperationID: operationID(rt.method, path),
+		Summary:     rt.summary,
+		Responses:   make(map[string]*Response),
+	}
+
+	if rt.scope != "" {
+		op.Description = "Requires the `" + rt.scope + "` scope, when authentication is enabled."
+	}
+
+	for _, p := range params {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:        p.name,
+			In:          p.in,
+			Description: p.description,
+			Required:    p.required,
+			Schema:      p.schema,
+		})
+	}
+
+	if request != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]MediaType{
+				"application/json": {Schema: request},
+			},
+		}
+	}
+
+	status := rt.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	contentType := rt.contentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	op.Responses[strconv.Itoa(status)] = &Response{
+		Description: http.StatusText(status),
+		Content: map[string]MediaType{
+			contentType: {Schema: rt.response},
+		},
+	}
+
+	errorCodes := []int{http.StatusUnauthorized, http.StatusForbidden, http.StatusInternalServerError}
+	if len(params) > 0 || request != nil {
+		errorCodes = append(errorCodes, http.StatusBadRequest)
+	}
+	for _, code := range errorCodes {
+		op.Responses[strconv.Itoa(code)] = &Response{
+			Description: http.StatusText(code),
+			Content: map[string]MediaType{
+				"application/json": {Schema: schema.For[errorResponse]()},
+			},
+		}
+	}
+
+	path = Prefix + path
+	if _, ok := doc.Paths[path]; !ok {
+		doc.Paths[path] = make(map[string]*Operation)
+	}
+	doc.Paths[path][strings.ToLower(rt.method)] = op
+}
+
+// operationID returns the ID of the operation with the given method and path,
+// e.g. getComputeInstances for GET compute-instances.
+func operationID(method, path string) string {
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+	upper := true
+	for _, r := range path {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// ingestModelSchemas returns the schemas of the request bodies for ingesting
+// the records of the models registered with [registry.IngestModelRegistry].
+// The properties of the records are derived from the columns of the models,
+// except for the columns managed by the inventory.
+func ingestModelSchemas() map[string]*schema.Schema {
+	tables := pgdialect.New().Tables()
+	result := make(map[string]*schema.Schema)
+	_ = registry.IngestModelRegistry.Range(func(name string, _ registry.IngestModel) error {
+		model, ok := registry.ModelRegistry.Get(name)
+		if !ok {
+			return nil
+		}
+
+		table := tables.Get(reflect.TypeOf(model).Elem())
+		record := &schema.Schema{
+			Type:       schema.Types{schema.TypeObject},
+			Properties: make(map[string]*schema.Schema),
+		}
+		for _, field := range table.Fields {
+			if slices.Contains(dbutils.ManagedColumns, field.Name) {
+				continue
+			}
+			record.Properties[field.Name] = columnSchema(field.IndirectType)
+			if field.NotNull && field.SQLDefault == "" {
+				record.Required = append(record.Required, field.Name)
+			}
+		}
+
+		result[name] = &schema.Schema{
+			Type:  schema.Types{schema.TypeArray},
+			Items: record,
+		}
+
+		return nil
+	})
+
+	return result
+}
+
+// columnSchema returns the [schema.Schema] of a column with the given type.
+// Values, which are encoded as text, e.g. UUIDs and IP addresses, are
+// described as strings.
+func columnSchema(t reflect.Type) *schema.Schema {
+	textMarshaler := reflect.TypeFor[encoding.TextMarshaler]()
+	if t != reflect.TypeFor[time.Time]() && (t.Implements(textMarshaler) || reflect.PointerTo(t).Implements(textMarshaler)) {
+		return &schema.Schema{Type: schema.Types{schema.TypeString}}
+	}
+
+	return schema.ForType(t)
+}
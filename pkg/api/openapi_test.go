@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/api"
+	_ "github.com/gardener/inventory/pkg/auxiliary/models"
+)
+
+func TestOpenAPIEndpoint(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		enqueuer api.TaskEnqueuer
+		path     string
+		wanted   bool
+	}{
+		{
+			desc:   "search",
+			path:   api.Prefix + "search",
+			wanted: true,
+		},
+		{
+			desc:   "tasks without enqueuer",
+			path:   api.Prefix + "tasks",
+			wanted: false,
+		},
+		{
+			desc:     "tasks with enqueuer",
+			enqueuer: &fakeEnqueuer{},
+			path:     api.Prefix + "tasks",
+			wanted:   true,
+		},
+		{
+			desc:   "ingest without ingest db",
+			path:   api.Prefix + "ingest/aux:model:external_resource",
+			wanted: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			handler := api.NewHandler(nil, tc.enqueuer)
+			req := httptest.NewRequest(http.MethodGet, api.Prefix+"openapi.json", nil)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("got status %d wanted %d", rec.Code, http.StatusOK)
+			}
+
+			var doc struct {
+				Paths map[string]any `json:"paths"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if _, ok := doc.Paths[tc.path]; ok != tc.wanted {
+				t.Fatalf("got path %s %t wanted %t", tc.path, ok, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	doc := api.OpenAPIDocument()
+
+	path := api.Prefix + "ingest/aux:model:external_resource"
+	op, ok := doc.Paths[path]["post"]
+	if !ok {
+		t.Fatalf("no operation for POST %s", path)
+	}
+
+	if op.OperationID != "postIngestAuxModelExternalResource" {
+		t.Fatalf("got operation id %q wanted %q", op.OperationID, "postIngestAuxModelExternalResource")
+	}
+
+	if op.RequestBody == nil {
+		t.Fatal("no request body")
+	}
+
+	items := op.RequestBody.Content["application/json"].Schema.Items
+	if items == nil {
+		t.Fatal("request body is not an array")
+	}
+
+	for _, column := range []string{"id", "created_at", "updated_at"} {
+		if _, ok := items.Properties[column]; ok {
+			t.Fatalf("managed column %q is described", column)
+		}
+	}
+
+	if _, ok := items.Properties["external_id"]; !ok {
+		t.Fatal("column external_id is not described")
+	}
+
+	if _, ok := doc.Paths[api.Prefix+"ingest/{model}"]; ok {
+		t.Fatal("generic ingest path is described")
+	}
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+
+	"github.com/gardener/inventory/pkg/api"
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+// fakeConnector is a [driver.Connector], which records the executed queries
+// and returns the configured rows for the queries selecting from a table.
+type fakeConnector struct {
+	mu      sync.Mutex
+	queries []string
+
+	// rows maps a table name to the rows of the search results returned
+	// for queries selecting from that table.
+	rows map[string][][]driver.Value
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{connector: c}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver {
+	return nil
+}
+
+func (c *fakeConnector) record(query string) [][]driver.Value {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.queries = append(c.queries, query)
+	for table, rows := range c.rows {
+		if strings.Contains(query, `"`+table+`" AS t`) {
+			return rows
+		}
+	}
+
+	return nil
+}
+
+type fakeConn struct {
+	connector *fakeConnector
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, driver.ErrSkip
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	return &fakeRows{rows: c.connector.record(query)}, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"value", "row"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+
+	return nil
+}
+
+func TestSearchExcludesAPITokens(t *testing.T) {
+	tokenRow := []driver.Value{"ci-token", []byte(`{"name": "ci-token", "token_hash": "deadbeef"}`)}
+	connector := &fakeConnector{
+		rows: map[string][][]driver.Value{
+			"aux_api_token": {tokenRow},
+			"audit_log":     {tokenRow},
+		},
+	}
+	db := bun.NewDB(sql.OpenDB(connector), pgdialect.New())
+	defer db.Close() // nolint: errcheck
+
+	store := &fakeTokenStore{
+		tokens: map[string]*models.APIToken{
+			api.HashToken("aux-reader"): {
+				Name:   "aux-reader",
+				Scopes: []string{api.ScopeReadAux},
+			},
+		},
+	}
+	handler := api.NewAuthHandler(store, api.NewHandler(db, &fakeEnqueuer{}))
+
+	req := httptest.NewRequest(http.MethodGet, api.Prefix+"search?q=*", nil)
+	req.Header.Set("Authorization", "Bearer aux-reader")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got status %d wanted %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var items []dbutils.SearchResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &items); err != nil {
+		t.Fatalf("cannot decode response: %s", err)
+	}
+	if len(items) != 0 {
+		t.Fatalf("got %d results wanted 0: %s", len(items), rec.Body.String())
+	}
+	if len(connector.queries) == 0 {
+		t.Fatal("got no search queries")
+	}
+	for _, query := range connector.queries {
+		if strings.Contains(query, "aux_api_token") || strings.Contains(query, "audit_log") {
+			t.Fatalf("sensitive table searched: %s", query)
+		}
+	}
+}
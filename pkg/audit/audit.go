@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package audit provides utilities for recording the mutating operations
+// performed via the CLI and the Dashboard in the audit log.
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/http"
+	"os/user"
+	"strconv"
+
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+	"github.com/gardener/inventory/pkg/core/config"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+// Sources of the audit log entries
+const (
+	// SourceCLI specifies that the operation was performed via the CLI.
+	SourceCLI = "cli"
+
+	// SourceDashboard specifies that the operation was performed via the
+	// Dashboard.
+	SourceDashboard = "dashboard"
+)
+
+// UnknownIdentity is the identity recorded, when the identity cannot be
+// determined.
+const UnknownIdentity = "unknown"
+
+// Recorder records entries in the audit log.
+type Recorder interface {
+	// Record records the given entry.
+	Record(ctx context.Context, item *models.AuditLog) error
+}
+
+// DBRecorder is a [Recorder], which records the entries in the database.
+type DBRecorder struct {
+	db bun.IDB
+}
+
+var _ Recorder = &DBRecorder{}
+
+// NewDBRecorder returns a new [DBRecorder] using the given database.
+func NewDBRecorder(db bun.IDB) *DBRecorder {
+	return &DBRecorder{db: db}
+}
+
+// Record implements the [Recorder] interface.
+func (r *DBRecorder) Record(ctx context.Context, item *models.AuditLog) error {
+	return dbutils.InsertAuditLog(ctx, r.db, item)
+}
+
+// GetCLIIdentity returns the identity recorded for the operations performed via
+// the CLI, which is either the configured identity, or the name of the current
+// OS user.
+func GetCLIIdentity(conf config.AuditConfig) string {
+	if conf.Identity != "" {
+		return conf.Identity
+	}
+
+	u, err := user.Current()
+	if err != nil || u.Username == "" {
+		return UnknownIdentity
+	}
+
+	return u.Username
+}
+
+// getRequestIdentity returns the identity of the user, which sent the given
+// request.
+func getRequestIdentity(r *http.Request, header string) string {
+	if header != "" {
+		if identity := r.Header.Get(header); identity != "" {
+			return identity
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if host == "" {
+		return UnknownIdentity
+	}
+
+	return host
+}
+
+// identityKey is the key used to store the identity of the authenticated user
+// in the context of a request.
+type identityKey struct{}
+
+// SetIdentity sets the identity recorded by the [NewHandler] for the request
+// with the given context, e.g. once the request has been authenticated by an
+// inner handler.
+func SetIdentity(ctx context.Context, identity string) {
+	if holder, ok := ctx.Value(identityKey{}).(*string); ok {
+		*holder = identity
+	}
+}
+
+// statusRecorder is an [http.ResponseWriter], which keeps track of the status
+// code of the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	code int
+}
+
+// WriteHeader implements the [http.ResponseWriter] interface.
+func (s *statusRecorder) WriteHeader(code int) {
+	s.code = code
+	s.ResponseWriter.WriteHeader(code)
+}
+
+// Unwrap returns the wrapped [http.ResponseWriter], so that it can be used
+// with [http.ResponseController].
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
+
+// NewHandler returns an [http.Handler], which records the mutating requests
+// served by the next [http.Handler] using the given [Recorder]. Requests with
+// the GET, HEAD and OPTIONS methods are not recorded. The identity of the user
+// is read from the given header, if set, and falls back to the remote address
+// of the request otherwise. Inner handlers may override the identity via
+// [SetIdentity].
+func NewHandler(recorder Recorder, identityHeader string, next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		identity := new(string)
+		r = r.WithContext(context.WithValue(r.Context(), identityKey{}, identity))
+		rec := &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		if *identity == "" {
+			*identity = getRequestIdentity(r, identityHeader)
+		}
+
+		params := map[string]string{
+			"path":   r.URL.Path,
+			"status": strconv.Itoa(rec.code),
+		}
+		if r.URL.RawQuery != "" {
+			params["query"] = r.URL.RawQuery
+		}
+
+		item := &models.AuditLog{
+			Identity:   *identity,
+			Source:     SourceDashboard,
+			Action:     r.Method + " " + r.URL.Path,
+			Parameters: params,
+		}
+		if rec.code >= http.StatusBadRequest {
+			item.Error = http.StatusText(rec.code)
+		}
+
+		// The request has been served already, so failures are logged
+		// only.
+		if err := recorder.Record(context.WithoutCancel(r.Context()), item); err != nil {
+			slog.Error("failed to record audit log", "action", item.Action, "reason", err)
+		}
+	}
+
+	return http.HandlerFunc(fn)
+}
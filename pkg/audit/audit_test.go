@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/audit"
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+)
+
+// fakeRecorder is an [audit.Recorder], which keeps the entries in memory.
+type fakeRecorder struct {
+	items []*models.AuditLog
+}
+
+func (f *fakeRecorder) Record(_ context.Context, item *models.AuditLog) error {
+	f.items = append(f.items, item)
+
+	return nil
+}
+
+func TestHandler(t *testing.T) {
+	recorder := &fakeRecorder{}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	handler := audit.NewHandler(recorder, "X-Forwarded-User", next)
+
+	requests := []*http.Request{
+		httptest.NewRequest(http.MethodGet, "/api/queues", nil),
+		httptest.NewRequest(http.MethodPost, "/api/queues/default:pause", nil),
+		httptest.NewRequest(http.MethodDelete, "/api/queues/default/pending_tasks/foo", nil),
+	}
+	requests[1].Header.Set("X-Forwarded-User", "alice")
+	requests[2].RemoteAddr = "10.0.0.1:12345"
+
+	for _, req := range requests {
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	if len(recorder.items) != 2 {
+		t.Fatalf("got %d entries wanted 2", len(recorder.items))
+	}
+
+	testCases := []struct {
+		action   string
+		identity string
+		status   string
+		err      string
+	}{
+		{action: "POST /api/queues/default:pause", identity: "alice", status: "200"},
+		{action: "DELETE /api/queues/default/pending_tasks/foo", identity: "10.0.0.1", status: "404", err: "Not Found"},
+	}
+
+	for i, tc := range testCases {
+		item := recorder.items[i]
+		if item.Source != audit.SourceDashboard {
+			t.Fatalf("got source %q wanted %q", item.Source, audit.SourceDashboard)
+		}
+		if item.Action != tc.action || item.Identity != tc.identity || item.Error != tc.err {
+			t.Fatalf("unexpected entry %+v", item)
+		}
+		if item.Parameters["status"] != tc.status {
+			t.Fatalf("got status %q wanted %q", item.Parameters["status"], tc.status)
+		}
+	}
+}
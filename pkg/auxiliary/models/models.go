@@ -5,8 +5,11 @@
 package models
 
 import (
+	"encoding/json"
+	"net"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 
 	coremodels "github.com/gardener/inventory/pkg/core/models"
@@ -35,7 +38,557 @@ type HousekeeperRun struct {
 	Count int64 `bun:"count,notnull"`
 }
 
+// SnapshotRun represents a single run of the snapshot task. The ID of the
+// run is used to query the state of the inventory as of the time the
+// snapshot was taken.
+type SnapshotRun struct {
+	bun.BaseModel `bun:"table:aux_snapshot_run"`
+	coremodels.Model
+
+	// StartedAt specifies when the snapshot was started.
+	StartedAt time.Time `bun:"started_at,notnull"`
+
+	// CompletedAt specifies when the snapshot was completed.
+	CompletedAt time.Time `bun:"completed_at,nullzero"`
+
+	// Status specifies the status of the snapshot run.
+	Status string `bun:"status,notnull"`
+
+	// Items specifies the snapshot items captured during this run.
+	Items []*SnapshotItem `bun:"rel:has-many,join:id=run_id"`
+}
+
+// Statuses of a [SnapshotRun].
+const (
+	// SnapshotStatusRunning is used for snapshot runs, which are in
+	// progress.
+	SnapshotStatusRunning = "running"
+
+	// SnapshotStatusCompleted is used for snapshot runs, which captured
+	// all models.
+	SnapshotStatusCompleted = "completed"
+
+	// SnapshotStatusPartial is used for snapshot runs, which failed to
+	// capture some of the models.
+	SnapshotStatusPartial = "partial"
+
+	// SnapshotStatusFailed is used for snapshot runs, which failed to
+	// capture any of the models.
+	SnapshotStatusFailed = "failed"
+)
+
+// SnapshotItem represents a single record of a model, which was captured
+// during a [SnapshotRun].
+type SnapshotItem struct {
+	bun.BaseModel `bun:"table:aux_snapshot_item"`
+	coremodels.Model
+
+	// RunID specifies the ID of the [SnapshotRun].
+	RunID uuid.UUID `bun:"run_id,notnull,type:uuid"`
+
+	// ModelName specifies the name of the captured model.
+	ModelName string `bun:"model_name,notnull"`
+
+	// Data provides the captured record as JSON.
+	Data json.RawMessage `bun:"data,type:jsonb,notnull"`
+
+	// Run specifies the [SnapshotRun] this item belongs to.
+	Run *SnapshotRun `bun:"rel:has-one,join:run_id=id"`
+}
+
+// Account represents a provider account in a common shape, e.g. an AWS
+// account, GCP project, Azure subscription, OpenStack project or Gardener
+// project.
+type Account struct {
+	bun.BaseModel `bun:"table:aux_account"`
+	coremodels.Model
+
+	// Provider specifies the provider of the account, e.g. aws, gcp, az,
+	// openstack or g.
+	Provider string `bun:"provider,notnull,unique:aux_account_key"`
+
+	// AccountID specifies the provider-specific ID of the account.
+	AccountID string `bun:"account_id,notnull,unique:aux_account_key"`
+
+	// DisplayName specifies the human-friendly name of the account.
+	DisplayName string `bun:"display_name,notnull"`
+
+	// Source specifies the name of the model from which the account was
+	// collected.
+	Source string `bun:"source,notnull"`
+}
+
+// Classifications of the resources, which are linked with an [Account].
+const (
+	// ClassificationGardenerManaged is used for resources, which have been
+	// resolved as cloud resources of a Gardener Shoot.
+	ClassificationGardenerManaged = "gardener_managed"
+
+	// ClassificationLandscapeInfrastructure is used for resources, which
+	// match a configured landscape infrastructure rule.
+	ClassificationLandscapeInfrastructure = "landscape_infrastructure"
+
+	// ClassificationUnknown is used for resources, which could not be
+	// classified.
+	ClassificationUnknown = "unknown"
+)
+
+// AccountToResource represents a link table connecting an [Account] with
+// any record of a model, which belongs to the account.
+type AccountToResource struct {
+	bun.BaseModel `bun:"table:l_aux_account_to_resource"`
+	coremodels.Model
+
+	// AccountID specifies the ID of the [Account].
+	AccountID uuid.UUID `bun:"account_id,notnull,type:uuid,unique:l_aux_account_to_resource_key"`
+
+	// ModelName specifies the name of the linked model.
+	ModelName string `bun:"model_name,notnull,unique:l_aux_account_to_resource_key"`
+
+	// ResourceID specifies the ID of the linked record.
+	ResourceID uuid.UUID `bun:"resource_id,notnull,type:uuid,unique:l_aux_account_to_resource_key"`
+
+	// Classification specifies whether the linked record is managed by
+	// Gardener, is part of the landscape infrastructure or is unknown.
+	Classification string `bun:"classification,notnull"`
+}
+
+// ShootToResource represents a link table connecting a Gardener Shoot with
+// any record of a model, which has been resolved as a cloud resource of the
+// Shoot.
+type ShootToResource struct {
+	bun.BaseModel `bun:"table:l_aux_shoot_to_resource"`
+	coremodels.Model
+
+	// ShootID specifies the ID of the Gardener Shoot.
+	ShootID uuid.UUID `bun:"shoot_id,notnull,type:uuid,unique:l_aux_shoot_to_resource_key"`
+
+	// ModelName specifies the name of the linked model.
+	ModelName string `bun:"model_name,notnull,unique:l_aux_shoot_to_resource_key"`
+
+	// ResourceID specifies the ID of the linked record.
+	ResourceID uuid.UUID `bun:"resource_id,notnull,type:uuid,unique:l_aux_shoot_to_resource_key"`
+
+	// Kind specifies the kind of the resource, e.g. instance.
+	Kind string `bun:"kind,notnull"`
+
+	// Method specifies how the resource was resolved.
+	Method string `bun:"method,notnull"`
+
+	// Confidence specifies the confidence level of the mapping.
+	Confidence string `bun:"confidence,notnull"`
+}
+
+// DriftFinding represents a cloud resource of a Gardener Shoot, whose attribute
+// differs from the desired worker configuration specified by the MachineClass
+// of the respective Gardener Machine.
+type DriftFinding struct {
+	bun.BaseModel `bun:"table:aux_drift_finding"`
+	coremodels.Model
+
+	// CheckName specifies the name of the drift check, which reported the
+	// finding.
+	CheckName string `bun:"check_name,notnull,unique:aux_drift_finding_key"`
+
+	// ResourceID specifies the ID of the drifted record.
+	ResourceID uuid.UUID `bun:"resource_id,notnull,type:uuid,unique:aux_drift_finding_key"`
+
+	// ShootID specifies the ID of the Gardener Shoot.
+	ShootID uuid.UUID `bun:"shoot_id,notnull,type:uuid"`
+
+	// ModelName specifies the name of the model of the drifted record.
+	ModelName string `bun:"model_name,notnull"`
+
+	// Attribute specifies the drifted attribute, e.g. machine_type.
+	Attribute string `bun:"attribute,notnull"`
+
+	// Desired specifies the value from the MachineClass.
+	Desired string `bun:"desired,notnull"`
+
+	// Actual specifies the value of the cloud resource.
+	Actual string `bun:"actual,notnull"`
+}
+
+// ExpiringCredential represents a credential with a limited lifetime, which
+// is used by the Inventory system, e.g. a viewer kubeconfig, a workload
+// identity token or a service account key.
+type ExpiringCredential struct {
+	bun.BaseModel `bun:"table:aux_expiring_credential"`
+	coremodels.Model
+
+	// Kind specifies the kind of the credential.
+	Kind string `bun:"kind,notnull,unique:aux_expiring_credential_key"`
+
+	// Name specifies the name of the credential.
+	Name string `bun:"name,notnull,unique:aux_expiring_credential_key"`
+
+	// Source specifies where the credential originates from.
+	Source string `bun:"source,notnull,unique:aux_expiring_credential_key"`
+
+	// ExpiresAt specifies when the credential expires.
+	ExpiresAt time.Time `bun:"expires_at,notnull"`
+}
+
+// CollectionState represents the state of the collection for a given task
+// and account. It is updated each time a task has been successfully executed,
+// so that stalled collections can be detected.
+type CollectionState struct {
+	bun.BaseModel `bun:"table:aux_collection_state"`
+	coremodels.Model
+
+	// TaskName specifies the name of the task.
+	TaskName string `bun:"task_name,notnull,unique:aux_collection_state_key"`
+
+	// AccountID specifies the AWS Account ID, GCP Project ID, Azure
+	// Subscription ID, OpenStack Project ID or Gardener Seed name, for
+	// which the task was executed. It is empty for tasks, which are not
+	// specific to an account.
+	AccountID string `bun:"account_id,notnull,unique:aux_collection_state_key"`
+
+	// LastSuccessAt specifies when the task was last successfully
+	// executed.
+	LastSuccessAt time.Time `bun:"last_success_at,notnull"`
+}
+
+// AuditLog represents a single mutating operation, which was performed via the
+// CLI or the Dashboard, e.g. enqueueing a task or migrating the database. The
+// time of the operation is recorded in the CreatedAt field.
+type AuditLog struct {
+	bun.BaseModel `bun:"table:audit_log"`
+	coremodels.Model
+
+	// Identity specifies the user or identity, which performed the
+	// operation.
+	Identity string `bun:"identity,notnull"`
+
+	// Source specifies where the operation was performed, e.g. cli or
+	// dashboard.
+	Source string `bun:"source,notnull"`
+
+	// Action specifies the operation, e.g. task:enqueue.
+	Action string `bun:"action,notnull"`
+
+	// Parameters specifies the parameters of the operation.
+	Parameters map[string]string `bun:"parameters,type:jsonb"`
+
+	// Error specifies the error returned by the operation, if any.
+	Error string `bun:"error,nullzero"`
+}
+
+// APIToken represents a token for authenticating against the API. Only the
+// SHA-256 hash of the token is stored.
+type APIToken struct {
+	bun.BaseModel `bun:"table:aux_api_token"`
+	coremodels.Model
+
+	// Name specifies the name of the token.
+	Name string `bun:"name,notnull,unique"`
+
+	// TokenHash specifies the hex-encoded SHA-256 hash of the token.
+	TokenHash string `bun:"token_hash,notnull,unique"`
+
+	// Scopes specifies the scopes granted to the token, e.g. read:aws.
+	Scopes []string `bun:"scopes,array"`
+
+	// ExpiresAt specifies when the token expires. Tokens without expiry
+	// time are valid until revoked.
+	ExpiresAt time.Time `bun:"expires_at,nullzero"`
+
+	// RevokedAt specifies when the token was revoked.
+	RevokedAt time.Time `bun:"revoked_at,nullzero"`
+}
+
+// IsValid returns true, if the token is neither revoked, nor expired at the
+// given time.
+func (t *APIToken) IsValid(now time.Time) bool {
+	if !t.RevokedAt.IsZero() {
+		return false
+	}
+
+	return t.ExpiresAt.IsZero() || now.Before(t.ExpiresAt)
+}
+
+// ComputeInstance represents a virtual machine of any supported cloud
+// provider in a common shape. It is backed by the `aux_compute_instance'
+// view, which unifies AWS Instances, GCP Instances, Azure VMs and OpenStack
+// Servers, and resolves the Gardener Shoot of each instance.
+type ComputeInstance struct {
+	bun.BaseModel `bun:"table:aux_compute_instance"`
+	coremodels.Model
+
+	// Provider specifies the cloud provider, e.g. aws, gcp, azure or
+	// openstack.
+	Provider string `bun:"provider"`
+
+	// ModelName specifies the name of the model of the underlying record.
+	ModelName string `bun:"model_name"`
+
+	// AccountID specifies the AWS account, GCP project, Azure subscription
+	// or OpenStack project of the instance.
+	AccountID string `bun:"account_id"`
+
+	// Region specifies the region of the instance.
+	Region string `bun:"region"`
+
+	// Zone specifies the zone of the instance, if known.
+	Zone string `bun:"zone,nullzero"`
+
+	// Name specifies the name of the instance.
+	Name string `bun:"name"`
+
+	// InstanceID specifies the provider-specific ID of the instance.
+	InstanceID string `bun:"instance_id"`
+
+	// State specifies the state of the instance as reported by the
+	// provider.
+	State string `bun:"state,nullzero"`
+
+	// MachineType specifies the machine type of the instance, if known.
+	MachineType string `bun:"machine_type,nullzero"`
+
+	// Image specifies the image of the instance, if known.
+	Image string `bun:"image,nullzero"`
+
+	// ShootID specifies the ID of the Gardener Shoot, if any.
+	ShootID uuid.UUID `bun:"shoot_id,nullzero,type:uuid"`
+
+	// Shoot specifies the name of the Gardener Shoot, if any.
+	Shoot string `bun:"shoot,nullzero"`
+
+	// Project specifies the Gardener Project of the Shoot, if any.
+	Project string `bun:"project,nullzero"`
+
+	// TechnicalID specifies the technical ID of the Shoot, if any.
+	TechnicalID string `bun:"technical_id,nullzero"`
+
+	// Landscape specifies the Gardener landscape of the Shoot, if any.
+	Landscape string `bun:"landscape,nullzero"`
+}
+
+// IsView returns true, since the model is backed by a view.
+func (ComputeInstance) IsView() bool {
+	return true
+}
+
+// LoadBalancer represents a load balancer of any supported cloud provider in a
+// common shape. It is backed by the `aux_loadbalancer' view, which unifies AWS
+// Load Balancers, GCP Forwarding Rules, Azure Load Balancers and OpenStack
+// Load Balancers.
+type LoadBalancer struct {
+	bun.BaseModel `bun:"table:aux_loadbalancer"`
+	coremodels.Model
+
+	// Provider specifies the cloud provider, e.g. aws, gcp, azure or
+	// openstack.
+	Provider string `bun:"provider"`
+
+	// ModelName specifies the name of the model of the underlying record.
+	ModelName string `bun:"model_name"`
+
+	// AccountID specifies the AWS account, GCP project, Azure subscription
+	// or OpenStack project of the load balancer.
+	AccountID string `bun:"account_id"`
+
+	// Region specifies the region of the load balancer.
+	Region string `bun:"region"`
+
+	// Name specifies the name of the load balancer.
+	Name string `bun:"name"`
+
+	// LoadBalancerID specifies the provider-specific ID of the load
+	// balancer.
+	LoadBalancerID string `bun:"loadbalancer_id"`
+
+	// Type specifies the provider-specific type of the load balancer, e.g.
+	// the AWS load balancer type or the Azure SKU.
+	Type string `bun:"type,nullzero"`
+
+	// Scheme specifies whether the load balancer is internal or external,
+	// if known.
+	Scheme string `bun:"scheme,nullzero"`
+
+	// State specifies the state of the load balancer, if known.
+	State string `bun:"state,nullzero"`
+
+	// Address specifies the DNS name or IP address of the load balancer,
+	// if known.
+	Address string `bun:"address,nullzero"`
+
+	// NetworkID specifies the provider-specific ID of the network of the
+	// load balancer, if known.
+	NetworkID string `bun:"network_id,nullzero"`
+
+	// ShootID specifies the ID of the Gardener Shoot, if any.
+	ShootID uuid.UUID `bun:"shoot_id,nullzero,type:uuid"`
+
+	// Shoot specifies the name of the Gardener Shoot, if any.
+	Shoot string `bun:"shoot,nullzero"`
+
+	// Project specifies the Gardener Project of the Shoot, if any.
+	Project string `bun:"project,nullzero"`
+
+	// TechnicalID specifies the technical ID of the Shoot, if any.
+	TechnicalID string `bun:"technical_id,nullzero"`
+
+	// Landscape specifies the Gardener landscape of the Shoot, if any.
+	Landscape string `bun:"landscape,nullzero"`
+}
+
+// IsView returns true, since the model is backed by a view.
+func (LoadBalancer) IsView() bool {
+	return true
+}
+
+// Network represents a network of any supported cloud provider in a common
+// shape. It is backed by the `aux_network' view, which unifies AWS VPCs, GCP
+// VPCs, Azure VPCs and OpenStack Networks.
+type Network struct {
+	bun.BaseModel `bun:"table:aux_network"`
+	coremodels.Model
+
+	// Provider specifies the cloud provider, e.g. aws, gcp, azure or
+	// openstack.
+	Provider string `bun:"provider"`
+
+	// ModelName specifies the name of the model of the underlying record.
+	ModelName string `bun:"model_name"`
+
+	// AccountID specifies the AWS account, GCP project, Azure subscription
+	// or OpenStack project of the network.
+	AccountID string `bun:"account_id"`
+
+	// Region specifies the region of the network. GCP VPCs are global and
+	// have no region.
+	Region string `bun:"region,nullzero"`
+
+	// Name specifies the name of the network.
+	Name string `bun:"name"`
+
+	// NetworkID specifies the provider-specific ID of the network.
+	NetworkID string `bun:"network_id"`
+
+	// CIDR specifies the primary IPv4 CIDR of the network, if known.
+	CIDR string `bun:"cidr,nullzero"`
+
+	// State specifies the state of the network, if known.
+	State string `bun:"state,nullzero"`
+
+	// ShootID specifies the ID of the Gardener Shoot, if any.
+	ShootID uuid.UUID `bun:"shoot_id,nullzero,type:uuid"`
+
+	// Shoot specifies the name of the Gardener Shoot, if any.
+	Shoot string `bun:"shoot,nullzero"`
+
+	// Project specifies the Gardener Project of the Shoot, if any.
+	Project string `bun:"project,nullzero"`
+
+	// TechnicalID specifies the technical ID of the Shoot, if any.
+	TechnicalID string `bun:"technical_id,nullzero"`
+
+	// Landscape specifies the Gardener landscape of the Shoot, if any.
+	Landscape string `bun:"landscape,nullzero"`
+}
+
+// IsView returns true, since the model is backed by a view.
+func (Network) IsView() bool {
+	return true
+}
+
+// ExternalResource represents a resource, which is not collected by the
+// inventory, but pushed by an external system via the ingestion API, e.g. a
+// DNS appliance or an on-premise load balancer.
+type ExternalResource struct {
+	bun.BaseModel `bun:"table:aux_external_resource"`
+	coremodels.Model
+
+	// Source specifies the external system, which pushed the resource.
+	Source string `bun:"source,notnull,unique:aux_external_resource_key"`
+
+	// Kind specifies the kind of the resource, e.g. dns_appliance.
+	Kind string `bun:"kind,notnull,unique:aux_external_resource_key"`
+
+	// ExternalID specifies the ID of the resource within the external
+	// system.
+	ExternalID string `bun:"external_id,notnull,unique:aux_external_resource_key"`
+
+	// Name specifies the name of the resource.
+	Name string `bun:"name,notnull"`
+
+	// Location specifies the location of the resource, e.g. a data center
+	// or region, if known.
+	Location string `bun:"location,nullzero"`
+
+	// IPAddress specifies the IP address of the resource, if any.
+	IPAddress net.IP `bun:"ip_address,nullzero,type:inet"`
+
+	// Labels specifies the labels of the resource.
+	Labels map[string]string `bun:"labels,type:jsonb"`
+
+	// Attributes specifies any additional attributes of the resource.
+	Attributes map[string]any `bun:"attributes,type:jsonb"`
+}
+
+// ChangeEvent represents a change of a record, which is recorded by the
+// aux_record_change trigger function, until it is published by the
+// aux:task:publish-events task.
+type ChangeEvent struct {
+	bun.BaseModel `bun:"table:aux_change_event"`
+	coremodels.Model
+
+	// ModelName specifies the name of the model of the changed record.
+	ModelName string `bun:"model_name,notnull"`
+
+	// Operation specifies the kind of change, i.e. create, update or
+	// delete.
+	Operation string `bun:"operation,notnull"`
+
+	// RecordID specifies the ID of the changed record.
+	RecordID uuid.UUID `bun:"record_id,notnull,type:uuid"`
+
+	// Diff specifies the old and new values of the changed columns of
+	// updated records.
+	Diff json.RawMessage `bun:"diff,type:jsonb,nullzero"`
+
+	// Data specifies the record after the change, or before the change
+	// for deleted records.
+	Data json.RawMessage `bun:"data,type:jsonb,nullzero"`
+}
+
 func init() {
 	// Register the models with the default registry
 	registry.ModelRegistry.MustRegister("aux:model:housekeeper_run", &HousekeeperRun{})
+	registry.ModelRegistry.MustRegister("aux:model:snapshot_run", &SnapshotRun{})
+	registry.ModelRegistry.MustRegister("aux:model:snapshot_item", &SnapshotItem{})
+	registry.ModelRegistry.MustRegister("aux:model:account", &Account{})
+	registry.ModelRegistry.MustRegister("aux:model:link_account_to_resource", &AccountToResource{})
+	registry.ModelRegistry.MustRegister("aux:model:link_shoot_to_resource", &ShootToResource{})
+	registry.ModelRegistry.MustRegister("aux:model:drift_finding", &DriftFinding{})
+	registry.ModelRegistry.MustRegister("aux:model:expiring_credential", &ExpiringCredential{})
+	registry.ModelRegistry.MustRegister("aux:model:collection_state", &CollectionState{})
+	registry.ModelRegistry.MustRegister("aux:model:audit_log", &AuditLog{})
+	registry.ModelRegistry.MustRegister("aux:model:api_token", &APIToken{})
+	registry.ModelRegistry.MustRegister("aux:model:compute_instance", &ComputeInstance{})
+	registry.ModelRegistry.MustRegister("aux:model:loadbalancer", &LoadBalancer{})
+	registry.ModelRegistry.MustRegister("aux:model:network", &Network{})
+	registry.ModelRegistry.MustRegister("aux:model:external_resource", &ExternalResource{})
+	registry.ModelRegistry.MustRegister("aux:model:change_event", &ChangeEvent{})
+
+	// Register the models, which must not be exposed via generic lookups
+	registry.SensitiveModelRegistry.MustRegister("aux:model:api_token", registry.SensitiveModel{
+		Reason: "API token names, hashes and scopes",
+	})
+	registry.SensitiveModelRegistry.MustRegister("aux:model:audit_log", registry.SensitiveModel{
+		Reason: "Identities and parameters of audited operations",
+	})
+	registry.SensitiveModelRegistry.MustRegister("aux:model:snapshot_item", registry.SensitiveModel{
+		Reason: "Copies of records of any model",
+	})
+	registry.SensitiveModelRegistry.MustRegister("aux:model:change_event", registry.SensitiveModel{
+		Reason: "Changed values of records of any model",
+	})
+
+	// Register the models accepting records from external systems
+	registry.IngestModelRegistry.MustRegister("aux:model:external_resource", registry.IngestModel{
+		ConflictColumns: []string{"source", "kind", "external_id"},
+	})
 }
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/schema"
+)
+
+// ErrNoProvider is an error, which is returned when no provider was specified.
+var ErrNoProvider = errors.New("no provider specified")
+
+// ErrUnknownProvider is an error, which is returned when no account collector
+// is registered for the specified provider.
+var ErrUnknownProvider = errors.New("unknown provider")
+
+// ErrNoAccountID is an error, which is returned when no account ID was
+// specified.
+var ErrNoAccountID = errors.New("no account id specified")
+
+// ErrNoAccountClients is an error, which is returned when no API clients are
+// configured for the specified account.
+var ErrNoAccountClients = errors.New("no clients configured for account")
+
+// CollectAccountTaskType is the name of the task responsible for collecting
+// the resources of a single provider account.
+const CollectAccountTaskType = "core:task:collect-account"
+
+// DefaultAccountLinkDelay is the default delay after which the link tasks are
+// enqueued by the [CollectAccountTaskType] task.
+const DefaultAccountLinkDelay = 2 * time.Minute
+
+// CollectAccountPayload represents the payload of the [CollectAccountTaskType]
+// task.
+type CollectAccountPayload struct {
+	// Provider specifies the name of the provider, e.g. aws, gcp, az or
+	// openstack.
+	Provider string `yaml:"provider" json:"provider" desc:"The name of the provider, e.g. aws, gcp, az or openstack" example:"aws"`
+
+	// AccountID specifies the ID of the account, i.e. the AWS Account ID,
+	// the GCP Project ID, the Azure Subscription ID or the OpenStack
+	// Project ID.
+	AccountID string `yaml:"account_id" json:"account_id" desc:"The AWS Account ID, GCP Project ID, Azure Subscription ID or OpenStack Project ID" example:"123456789012"`
+
+	// LinkDelay specifies the delay after which the link tasks are
+	// enqueued, so that they are processed after the collection of the
+	// resources of the account.
+	LinkDelay time.Duration `yaml:"link_delay" json:"link_delay" desc:"The delay after which the link tasks are enqueued" example:"2m"`
+}
+
+// HandleCollectAccountTask enqueues the collectors of a provider, restricted
+// to a single account, followed by the tasks for linking the collected
+// resources. The collectors are registered with
+// [registry.AccountCollectorRegistry].
+func HandleCollectAccountTask(ctx context.Context, t *asynq.Task) error {
+	var payload CollectAccountPayload
+	if err := asynqutils.Unmarshal(t.Payload(), &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.Provider == "" {
+		return asynqutils.SkipRetry(ErrNoProvider)
+	}
+
+	if payload.AccountID == "" {
+		return asynqutils.SkipRetry(ErrNoAccountID)
+	}
+
+	if payload.LinkDelay == 0 {
+		payload.LinkDelay = DefaultAccountLinkDelay
+	}
+
+	collector, ok := registry.AccountCollectorRegistry.Get(payload.Provider)
+	if !ok {
+		return asynqutils.SkipRetry(fmt.Errorf("%w: %s", ErrUnknownProvider, payload.Provider))
+	}
+
+	items, err := collector.NewTasks(ctx, payload.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if len(items) == 0 {
+		return asynqutils.SkipRetry(fmt.Errorf("%w: %s", ErrNoAccountClients, payload.AccountID))
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	allErrs := make([]error, 0)
+
+	for _, task := range items {
+		queue := collectionTaskQueue(ctx, task)
+		err := enqueueCollectionTask(ctx, task, asynq.Queue(queue))
+		allErrs = append(allErrs, err)
+	}
+
+	for _, name := range collector.LinkTasks {
+		task := asynq.NewTask(name, nil)
+		queue := collectionTaskQueue(ctx, task)
+		err := enqueueCollectionTask(ctx, task, asynq.Queue(queue), asynq.ProcessIn(payload.LinkDelay))
+		allErrs = append(allErrs, err)
+	}
+
+	// The accounts are linked after the provider link tasks have been
+	// processed.
+	task := asynq.NewTask(LinkAccountsTaskType, nil)
+	queue := collectionTaskQueue(ctx, task)
+	err = enqueueCollectionTask(ctx, task, asynq.Queue(queue), asynq.ProcessIn(2*payload.LinkDelay))
+	allErrs = append(allErrs, err)
+
+	logger.Info(
+		"enqueued account collection",
+		"provider", payload.Provider,
+		"account_id", payload.AccountID,
+		"tasks", len(items),
+	)
+
+	return errors.Join(allErrs...)
+}
+
+func init() {
+	registry.TaskRegistry.MustRegister(CollectAccountTaskType, asynq.HandlerFunc(HandleCollectAccountTask))
+	registry.PayloadSchemaRegistry.MustRegister(CollectAccountTaskType, schema.For[CollectAccountPayload]())
+	registry.TaskDescriptionRegistry.MustRegister(CollectAccountTaskType, "Collects the resources of a single provider account.")
+}
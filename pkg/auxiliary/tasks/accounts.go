@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/hibiken/asynq"
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// ErrModelNotFound is an error, which is returned when a model could not be
+// found in the [registry.ModelRegistry].
+var ErrModelNotFound = errors.New("model not found in registry")
+
+const (
+	// CollectAccountsTaskType is the name of the task responsible for
+	// collecting the accounts of all registered providers.
+	CollectAccountsTaskType = "aux:task:collect-accounts"
+
+	// LinkAccountsTaskType is the name of the task responsible for linking
+	// the registered models with their accounts.
+	LinkAccountsTaskType = "aux:task:link-accounts"
+)
+
+// tableNameFor returns the name of the table for the given model name.
+func tableNameFor(name string) (string, error) {
+	model, ok := registry.ModelRegistry.Get(name)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrModelNotFound, name)
+	}
+
+	return db.DB.Table(reflect.TypeOf(model).Elem()).Name, nil
+}
+
+// HandleCollectAccountsTask collects the accounts from each source registered
+// with [registry.AccountSourceRegistry].
+func HandleCollectAccountsTask(ctx context.Context, _ *asynq.Task) error {
+	logger := asynqutils.GetLogger(ctx)
+	allErrs := make([]error, 0)
+
+	err := registry.AccountSourceRegistry.Range(func(provider string, src registry.AccountSource) error {
+		table, err := tableNameFor(src.ModelName)
+		if err != nil {
+			allErrs = append(allErrs, err)
+
+			return nil
+		}
+
+		query := `INSERT INTO aux_account (provider, account_id, display_name, source)
+SELECT DISTINCT ON (t.?) ?, t.?, t.?, ? FROM ? AS t
+ON CONFLICT (provider, account_id) DO UPDATE SET
+display_name = EXCLUDED.display_name,
+source = EXCLUDED.source,
+updated_at = EXCLUDED.updated_at`
+
+		out, err := db.DB.NewRaw(
+			query,
+			bun.Ident(src.IDColumn),
+			provider,
+			bun.Ident(src.IDColumn),
+			bun.Ident(src.NameColumn),
+			src.ModelName,
+			bun.Ident(table),
+		).Exec(ctx)
+
+		if err != nil {
+			logger.Error("failed to collect accounts", "provider", provider, "reason", err)
+			allErrs = append(allErrs, err)
+
+			return nil
+		}
+
+		count, err := out.RowsAffected()
+		if err != nil {
+			return nil
+		}
+		logger.Info("collected accounts", "provider", provider, "count", count)
+
+		return nil
+	})
+
+	allErrs = append(allErrs, err)
+
+	return errors.Join(allErrs...)
+}
+
+// HandleLinkAccountsTask links the records of each model registered with
+// [registry.AccountLinkRegistry] with their respective accounts.
+func HandleLinkAccountsTask(ctx context.Context, _ *asynq.Task) error {
+	logger := asynqutils.GetLogger(ctx)
+	allErrs := make([]error, 0)
+
+	err := registry.AccountLinkRegistry.Range(func(name string, link registry.AccountLink) error {
+		table, err := tableNameFor(name)
+		if err != nil {
+			allErrs = append(allErrs, err)
+
+			return nil
+		}
+
+		query := `INSERT INTO l_aux_account_to_resource (account_id, model_name, resource_id)
+SELECT a.id, ?, t.id FROM ? AS t
+INNER JOIN aux_account AS a ON a.provider = ? AND a.account_id = t.?
+ON CONFLICT (account_id, model_name, resource_id) DO UPDATE SET
+updated_at = EXCLUDED.updated_at`
+
+		out, err := db.DB.NewRaw(
+			query,
+			name,
+			bun.Ident(table),
+			link.Provider,
+			bun.Ident(link.Column),
+		).Exec(ctx)
+
+		if err != nil {
+			logger.Error("failed to link model with accounts", "name", name, "reason", err)
+			allErrs = append(allErrs, err)
+
+			return nil
+		}
+
+		count, err := out.RowsAffected()
+		if err != nil {
+			return nil
+		}
+		logger.Info("linked model with accounts", "name", name, "count", count)
+
+		return nil
+	})
+
+	allErrs = append(allErrs, err)
+
+	return errors.Join(allErrs...)
+}
+
+func init() {
+	registry.TaskRegistry.MustRegister(CollectAccountsTaskType, asynq.HandlerFunc(HandleCollectAccountsTask))
+	registry.TaskRegistry.MustRegister(LinkAccountsTaskType, asynq.HandlerFunc(HandleLinkAccountsTask))
+	registry.TaskDescriptionRegistry.MustRegister(CollectAccountsTaskType, "Collects the accounts of all registered providers.")
+	registry.TaskDescriptionRegistry.MustRegister(LinkAccountsTaskType, "Links the registered models with their accounts.")
+}
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/schema"
+)
+
+const (
+	// ClassifyResourcesTaskType is the name of the task responsible for
+	// classifying the resources linked with accounts.
+	ClassifyResourcesTaskType = "aux:task:classify-resources"
+)
+
+// ClassifyResourcesPayload represents the payload of the classify resources
+// task.
+type ClassifyResourcesPayload struct {
+	// Landscape provides the rules for classifying resources as landscape
+	// infrastructure.
+	Landscape []LandscapeRule `yaml:"landscape" json:"landscape" desc:"The rules for classifying resources as landscape infrastructure"`
+}
+
+// LandscapeRule represents a rule, which classifies the records of a model as
+// landscape infrastructure, when the value of the given column matches the
+// regular expression.
+type LandscapeRule struct {
+	// Model specifies the model name.
+	Model string `yaml:"model" json:"model"`
+
+	// Column specifies the column of the model to match against.
+	Column string `yaml:"column" json:"column"`
+
+	// Pattern specifies the POSIX regular expression to match.
+	Pattern string `yaml:"pattern" json:"pattern"`
+}
+
+// HandleClassifyResourcesTask classifies the resources linked with accounts
+// as Gardener-managed, landscape infrastructure or unknown.
+//
+// Resources which have been resolved by the
+// [HandleReconcileShootResourcesTask] are classified as Gardener-managed.
+// Resources matching one of the configured landscape rules are classified as
+// landscape infrastructure. Everything else is classified as unknown.
+func HandleClassifyResourcesTask(ctx context.Context, task *asynq.Task) error {
+	var payload ClassifyResourcesPayload
+	if data := task.Payload(); data != nil {
+		if err := asynqutils.Unmarshal(data, &payload); err != nil {
+			return asynqutils.SkipRetry(err)
+		}
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+
+	// Rules for unknown models are reported, but do not prevent the
+	// remaining rules from being applied.
+	allErrs := make([]error, 0)
+	rules := make([]LandscapeRule, 0, len(payload.Landscape))
+	tables := make([]string, 0, len(payload.Landscape))
+	for _, rule := range payload.Landscape {
+		table, err := tableNameFor(rule.Model)
+		if err != nil {
+			logger.Warn("model not found in registry", "name", rule.Model)
+			allErrs = append(allErrs, err)
+
+			continue
+		}
+		rules = append(rules, rule)
+		tables = append(tables, table)
+	}
+
+	// The classification is reset and the landscape rules are re-applied
+	// within a single transaction, so that readers never observe
+	// landscape infrastructure classified as unknown.
+	err := db.DB.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		query := `UPDATE l_aux_account_to_resource AS l SET classification = CASE
+WHEN EXISTS (SELECT 1 FROM l_aux_shoot_to_resource AS sr WHERE sr.model_name = l.model_name AND sr.resource_id = l.resource_id) THEN ?
+ELSE ? END`
+
+		_, err := tx.NewRaw(
+			query,
+			models.ClassificationGardenerManaged,
+			models.ClassificationUnknown,
+		).Exec(ctx)
+
+		if err != nil {
+			logger.Error("failed to classify resources", "reason", err)
+
+			return err
+		}
+
+		for i, rule := range rules {
+			query := `UPDATE l_aux_account_to_resource AS l SET classification = ?
+FROM ? AS t
+WHERE l.model_name = ? AND l.resource_id = t.id AND l.classification = ? AND t.? ~ ?`
+
+			out, err := tx.NewRaw(
+				query,
+				models.ClassificationLandscapeInfrastructure,
+				bun.Ident(tables[i]),
+				rule.Model,
+				models.ClassificationUnknown,
+				bun.Ident(rule.Column),
+				rule.Pattern,
+			).Exec(ctx)
+
+			if err != nil {
+				logger.Error("failed to apply landscape rule", "name", rule.Model, "reason", err)
+
+				return err
+			}
+
+			count, err := out.RowsAffected()
+			if err != nil {
+				return err
+			}
+			logger.Info("classified landscape resources", "name", rule.Model, "count", count)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return errors.Join(append(allErrs, err)...)
+	}
+
+	allErrs = append(allErrs, reportClassifiedResources(ctx))
+
+	return errors.Join(allErrs...)
+}
+
+// reportClassifiedResources reports the number of resources per model and
+// classification.
+func reportClassifiedResources(ctx context.Context) error {
+	var rows []struct {
+		ModelName      string `bun:"model_name"`
+		Classification string `bun:"classification"`
+		Count          int64  `bun:"count"`
+	}
+
+	err := db.DB.NewSelect().
+		Model((*models.AccountToResource)(nil)).
+		Column("model_name", "classification").
+		ColumnExpr("count(*) AS count").
+		Group("model_name", "classification").
+		Scan(ctx, &rows)
+
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		metric := prometheus.MustNewConstMetric(
+			classifiedResourcesDesc,
+			prometheus.GaugeValue,
+			float64(row.Count),
+			row.ModelName,
+			row.Classification,
+		)
+		key := metrics.Key(ClassifyResourcesTaskType, row.ModelName, row.Classification)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}
+
+	return nil
+}
+
+func init() {
+	registry.TaskRegistry.MustRegister(ClassifyResourcesTaskType, asynq.HandlerFunc(HandleClassifyResourcesTask))
+	registry.PayloadSchemaRegistry.MustRegister(ClassifyResourcesTaskType, schema.For[ClassifyResourcesPayload]())
+	registry.TaskDescriptionRegistry.MustRegister(ClassifyResourcesTaskType, "Classifies the resources linked with accounts.")
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+const (
+	// ReportCollectionStateTaskType is the name of the task responsible for
+	// reporting the time of the last successful collection per task and
+	// account.
+	ReportCollectionStateTaskType = "aux:task:report-collection-state"
+)
+
+// HandleReportCollectionStateTask reports the time of the last successful
+// execution of each task per account.
+//
+// The state is recorded by the collection state middleware of the workers in
+// the `aux_collection_state' table, so that the reported metrics can be used
+// for alerting on silently stalled collections, regardless of which worker
+// executed the tasks.
+func HandleReportCollectionStateTask(ctx context.Context, _ *asynq.Task) error {
+	logger := asynqutils.GetLogger(ctx)
+	items := make([]models.CollectionState, 0)
+	if err := db.DB.NewSelect().Model(&items).Scan(ctx); err != nil {
+		logger.Error("failed to report collection state", "reason", err)
+
+		return err
+	}
+
+	for _, item := range items {
+		metric := prometheus.MustNewConstMetric(
+			lastSuccessfulCollectionDesc,
+			prometheus.GaugeValue,
+			float64(item.LastSuccessAt.Unix()),
+			item.TaskName,
+			item.AccountID,
+		)
+		key := metrics.Key(ReportCollectionStateTaskType, item.TaskName, item.AccountID)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}
+
+	logger.Info("reported collection state", "count", len(items))
+
+	return nil
+}
+
+func init() {
+	registry.TaskRegistry.MustRegister(ReportCollectionStateTaskType, asynq.HandlerFunc(HandleReportCollectionStateTask))
+	registry.TaskDescriptionRegistry.MustRegister(ReportCollectionStateTaskType, "Reports the time of the last successful collection per task and account.")
+}
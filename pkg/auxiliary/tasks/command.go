@@ -13,6 +13,7 @@ import (
 
 	"github.com/gardener/inventory/pkg/core/registry"
 	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/schema"
 )
 
 // ErrNoCommand is an error which is returned when the task for executing
@@ -30,15 +31,15 @@ const (
 // commands.
 type CommandPayload struct {
 	// Command specifies the path to the command to be executed
-	Command string `yaml:"command" json:"command"`
+	Command string `yaml:"command" json:"command" desc:"The path to the command to be executed" example:"/usr/local/bin/report"`
 
 	// Args specifies any optional arguments to be passed to the command.
-	Args []string `yaml:"args" json:"args"`
+	Args []string `yaml:"args" json:"args" desc:"Any optional arguments to be passed to the command" example:"--verbose"`
 
 	// Dir specifies the working directory of the command. If not specified
 	// then the external command will be executed in the calling process'
 	// current directory.
-	Dir string `yaml:"dir" json:"dir"`
+	Dir string `yaml:"dir" json:"dir" desc:"The working directory of the command" example:"/tmp"`
 }
 
 // HandleCommandTask executes the command specified as part of the payload.
@@ -74,4 +75,6 @@ func HandleCommandTask(ctx context.Context, task *asynq.Task) error {
 
 func init() {
 	registry.TaskRegistry.MustRegister(CommandTaskType, asynq.HandlerFunc(HandleCommandTask))
+	registry.PayloadSchemaRegistry.MustRegister(CommandTaskType, schema.For[CommandPayload]())
+	registry.TaskDescriptionRegistry.MustRegister(CommandTaskType, "Executes external commands.")
 }
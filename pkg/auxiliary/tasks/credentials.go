@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/schema"
+)
+
+const (
+	// CollectExpiringCredentialsTaskType is the name of the task
+	// responsible for collecting the expiration times of the credentials
+	// used by the Inventory system.
+	CollectExpiringCredentialsTaskType = "aux:task:collect-expiring-credentials"
+)
+
+// DefaultExpiringCredentialsWindow is the default window within which
+// expiring credentials are reported.
+const DefaultExpiringCredentialsWindow = 7 * 24 * time.Hour
+
+// CollectExpiringCredentialsPayload represents the payload of the collect
+// expiring credentials task.
+type CollectExpiringCredentialsPayload struct {
+	// Window specifies the duration from now, within which expiring
+	// credentials are reported.
+	Window time.Duration `yaml:"window" json:"window" desc:"The duration from now, within which expiring credentials are reported" example:"168h"`
+
+	// KindWindows specifies per-kind windows, which take precedence over
+	// Window. This is useful for short-lived credentials, which are
+	// renewed automatically, e.g. viewer kubeconfigs and Vault tokens.
+	KindWindows map[string]time.Duration `yaml:"kind_windows" json:"kind_windows" desc:"Per-kind windows, which take precedence over Window"`
+}
+
+// WindowFor returns the window, within which expiring credentials of the given
+// kind are reported. Per-kind windows take precedence over the window of the
+// payload, which defaults to [DefaultExpiringCredentialsWindow].
+func (p CollectExpiringCredentialsPayload) WindowFor(kind string) time.Duration {
+	if window, ok := p.KindWindows[kind]; ok {
+		return window
+	}
+
+	if p.Window <= 0 {
+		return DefaultExpiringCredentialsWindow
+	}
+
+	return p.Window
+}
+
+// ExpiresWithin returns true, if the given expiration time is within the
+// window from now. Credentials, which have expired already, are always within
+// the window.
+func ExpiresWithin(expiresAt, now time.Time, window time.Duration) bool {
+	return !expiresAt.After(now.Add(window))
+}
+
+// HandleCollectExpiringCredentialsTask collects the credentials from each
+// source registered with [registry.ExpiringCredentialRegistry], and reports
+// the credentials, which expire within the configured window.
+func HandleCollectExpiringCredentialsTask(ctx context.Context, task *asynq.Task) error {
+	payload := CollectExpiringCredentialsPayload{
+		Window: DefaultExpiringCredentialsWindow,
+	}
+	if data := task.Payload(); data != nil {
+		if err := asynqutils.Unmarshal(data, &payload); err != nil {
+			return asynqutils.SkipRetry(err)
+		}
+	}
+
+	if payload.Window <= 0 {
+		payload.Window = DefaultExpiringCredentialsWindow
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	allErrs := make([]error, 0)
+	items := make([]models.ExpiringCredential, 0)
+
+	err := registry.ExpiringCredentialRegistry.Range(func(kind string, src registry.ExpiringCredentialSource) error {
+		creds, err := src(ctx)
+		if err != nil {
+			logger.Error("failed to get expiring credentials", "kind", kind, "reason", err)
+			allErrs = append(allErrs, err)
+
+			return nil
+		}
+
+		for _, c := range creds {
+			item := models.ExpiringCredential{
+				Kind:      c.Kind,
+				Name:      c.Name,
+				Source:    c.Source,
+				ExpiresAt: c.ExpiresAt,
+			}
+			items = append(items, item)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	allErrs = append(allErrs, persistExpiringCredentials(ctx, items))
+	reportExpiringCredentials(ctx, items, payload)
+
+	return errors.Join(allErrs...)
+}
+
+// persistExpiringCredentials persists the given expiring credentials.
+func persistExpiringCredentials(ctx context.Context, items []models.ExpiringCredential) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (kind, name, source) DO UPDATE").
+		Set("expires_at = EXCLUDED.expires_at").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error("could not insert expiring credentials into db", "reason", err)
+
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info("populated expiring credentials", "count", count)
+
+	return nil
+}
+
+// reportExpiringCredentials emits a warning for each of the given credentials,
+// which expire within the window configured in the payload, and reports their
+// number per kind.
+func reportExpiringCredentials(ctx context.Context, items []models.ExpiringCredential, payload CollectExpiringCredentialsPayload) {
+	logger := asynqutils.GetLogger(ctx)
+	now := time.Now()
+	counts := make(map[string]int64)
+
+	for _, item := range items {
+		// Make sure that kinds without expiring credentials are
+		// reported as well.
+		if _, ok := counts[item.Kind]; !ok {
+			counts[item.Kind] = 0
+		}
+
+		if !ExpiresWithin(item.ExpiresAt, now, payload.WindowFor(item.Kind)) {
+			continue
+		}
+
+		counts[item.Kind]++
+		msg := "credential is about to expire"
+		if !item.ExpiresAt.After(now) {
+			msg = "credential has expired"
+		}
+		logger.Warn(
+			msg,
+			"kind", item.Kind,
+			"name", item.Name,
+			"source", item.Source,
+			"expires_at", item.ExpiresAt,
+		)
+	}
+
+	for kind, count := range counts {
+		metric := prometheus.MustNewConstMetric(
+			expiringCredentialsDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			kind,
+		)
+		key := metrics.Key(CollectExpiringCredentialsTaskType, kind)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}
+}
+
+func init() {
+	registry.TaskRegistry.MustRegister(CollectExpiringCredentialsTaskType, asynq.HandlerFunc(HandleCollectExpiringCredentialsTask))
+	registry.PayloadSchemaRegistry.MustRegister(CollectExpiringCredentialsTaskType, schema.For[CollectExpiringCredentialsPayload]())
+	registry.TaskDescriptionRegistry.MustRegister(CollectExpiringCredentialsTaskType, "Collects the expiration times of the credentials used by the Inventory system.")
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gardener/inventory/pkg/auxiliary/tasks"
+)
+
+func TestCollectExpiringCredentialsPayloadWindowFor(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		payload tasks.CollectExpiringCredentialsPayload
+		kind    string
+		wanted  time.Duration
+	}{
+		{
+			desc:    "default window",
+			payload: tasks.CollectExpiringCredentialsPayload{},
+			kind:    "vault_token",
+			wanted:  tasks.DefaultExpiringCredentialsWindow,
+		},
+		{
+			desc:    "negative window falls back to default",
+			payload: tasks.CollectExpiringCredentialsPayload{Window: -time.Hour},
+			kind:    "vault_token",
+			wanted:  tasks.DefaultExpiringCredentialsWindow,
+		},
+		{
+			desc:    "configured window",
+			payload: tasks.CollectExpiringCredentialsPayload{Window: 24 * time.Hour},
+			kind:    "vault_token",
+			wanted:  24 * time.Hour,
+		},
+		{
+			desc: "kind window takes precedence",
+			payload: tasks.CollectExpiringCredentialsPayload{
+				Window:      24 * time.Hour,
+				KindWindows: map[string]time.Duration{"vault_token": time.Hour},
+			},
+			kind:   "vault_token",
+			wanted: time.Hour,
+		},
+		{
+			desc: "kind window of other kind",
+			payload: tasks.CollectExpiringCredentialsPayload{
+				KindWindows: map[string]time.Duration{"vault_token": time.Hour},
+			},
+			kind:   "kubeconfig",
+			wanted: tasks.DefaultExpiringCredentialsWindow,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := tc.payload.WindowFor(tc.kind)
+			if got != tc.wanted {
+				t.Fatalf("got window %s, wanted %s", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestExpiresWithin(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		desc      string
+		expiresAt time.Time
+		window    time.Duration
+		wanted    bool
+	}{
+		{
+			desc:      "expires after window",
+			expiresAt: now.Add(48 * time.Hour),
+			window:    24 * time.Hour,
+			wanted:    false,
+		},
+		{
+			desc:      "expires within window",
+			expiresAt: now.Add(12 * time.Hour),
+			window:    24 * time.Hour,
+			wanted:    true,
+		},
+		{
+			desc:      "expires at end of window",
+			expiresAt: now.Add(24 * time.Hour),
+			window:    24 * time.Hour,
+			wanted:    true,
+		},
+		{
+			desc:      "expired",
+			expiresAt: now.Add(-time.Hour),
+			window:    24 * time.Hour,
+			wanted:    true,
+		},
+		{
+			desc:      "expired with zero window",
+			expiresAt: now.Add(-time.Hour),
+			window:    0,
+			wanted:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := tasks.ExpiresWithin(tc.expiresAt, now, tc.window)
+			if got != tc.wanted {
+				t.Fatalf("got %t, wanted %t", got, tc.wanted)
+			}
+		})
+	}
+}
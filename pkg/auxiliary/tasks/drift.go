@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+const (
+	// DetectDriftTaskType is the name of the task responsible for detecting
+	// drift between the desired worker configuration of Gardener Shoots
+	// and the actual cloud resources.
+	DetectDriftTaskType = "aux:task:detect-drift"
+)
+
+// HandleDetectDriftTask runs the drift checks registered with
+// [registry.DriftCheckRegistry] and persists the findings in the
+// `aux_drift_finding' table. Findings, which are no longer reported by a
+// check are removed.
+func HandleDetectDriftTask(ctx context.Context, _ *asynq.Task) error {
+	logger := asynqutils.GetLogger(ctx)
+	allErrs := make([]error, 0)
+
+	err := registry.DriftCheckRegistry.Range(func(name string, check registry.DriftCheck) error {
+		var count int64
+		err := db.DB.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			query := `INSERT INTO aux_drift_finding (check_name, resource_id, shoot_id, model_name, attribute, desired, actual)
+SELECT DISTINCT ON (q.resource_id) ?, q.resource_id, q.shoot_id, ?, ?, q.desired, q.actual FROM (?) AS q
+ON CONFLICT (check_name, resource_id) DO UPDATE SET
+shoot_id = EXCLUDED.shoot_id,
+model_name = EXCLUDED.model_name,
+attribute = EXCLUDED.attribute,
+desired = EXCLUDED.desired,
+actual = EXCLUDED.actual,
+updated_at = EXCLUDED.updated_at`
+
+			out, err := tx.NewRaw(
+				query,
+				name,
+				check.ModelName,
+				check.Attribute,
+				bun.Safe(check.Query),
+			).Exec(ctx)
+			if err != nil {
+				return err
+			}
+
+			count, err = out.RowsAffected()
+			if err != nil {
+				return err
+			}
+
+			// Findings, which were not reported within the current
+			// transaction have been resolved.
+			_, err = tx.NewRaw(
+				"DELETE FROM aux_drift_finding WHERE check_name = ? AND updated_at < now()",
+				name,
+			).Exec(ctx)
+
+			return err
+		})
+
+		if err != nil {
+			logger.Error("failed to run drift check", "check", name, "reason", err)
+			allErrs = append(allErrs, err)
+
+			return nil
+		}
+
+		metric := prometheus.MustNewConstMetric(
+			driftFindingsDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			name,
+			check.ModelName,
+			check.Attribute,
+		)
+		key := metrics.Key(DetectDriftTaskType, name)
+		metrics.DefaultCollector.AddMetric(key, metric)
+		logger.Info("detected drift", "check", name, "model", check.ModelName, "attribute", check.Attribute, "count", count)
+
+		return nil
+	})
+
+	allErrs = append(allErrs, err)
+
+	return errors.Join(allErrs...)
+}
+
+func init() {
+	registry.TaskRegistry.MustRegister(DetectDriftTaskType, asynq.HandlerFunc(HandleDetectDriftTask))
+	registry.TaskDescriptionRegistry.MustRegister(DetectDriftTaskType, "Detects drift between MachineClasses and the actual cloud resources.")
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/events"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+	"github.com/gardener/inventory/pkg/utils/schema"
+)
+
+// ErrNoEventsPublisher is an error, which is returned when publishing change
+// events was requested, but no publisher is configured for the worker.
+var ErrNoEventsPublisher = errors.New("no events publisher configured")
+
+const (
+	// PublishEventsTaskType is the name of the task responsible for
+	// publishing the change events of the inventory records.
+	PublishEventsTaskType = "aux:task:publish-events"
+
+	// DefaultPublishEventsBatchSize is the default number of events
+	// published at once.
+	DefaultPublishEventsBatchSize = 500
+)
+
+// PublishEventsPayload represents the payload of the publish events task.
+type PublishEventsPayload struct {
+	// Models specifies the list of model names, whose changes are
+	// published.
+	Models []string `yaml:"models" json:"models" desc:"The list of model names, whose changes are published" example:"aws:model:instance"`
+
+	// BatchSize specifies the number of events published at once. If not
+	// specified, [DefaultPublishEventsBatchSize] is used.
+	BatchSize int `yaml:"batch_size" json:"batch_size" desc:"The number of events published at once" example:"500"`
+}
+
+// HandlePublishEventsTask records the changes of the records of the models
+// specified in the payload, and publishes the recorded changes to the
+// configured message broker.
+//
+// The changes are recorded by a trigger on the tables of the models, which is
+// installed when a model is added to the payload, and removed when a model is
+// removed from the payload. The trigger records the created, updated and
+// deleted records within the transaction of the upsert, and the recorded
+// events are removed once they have been published.
+func HandlePublishEventsTask(ctx context.Context, task *asynq.Task) error {
+	var payload PublishEventsPayload
+	if data := task.Payload(); data != nil {
+		if err := asynqutils.Unmarshal(data, &payload); err != nil {
+			return asynqutils.SkipRetry(err)
+		}
+	}
+
+	if payload.BatchSize <= 0 {
+		payload.BatchSize = DefaultPublishEventsBatchSize
+	}
+
+	publisher := events.DefaultPublisher
+	if publisher == nil {
+		return asynqutils.SkipRetry(ErrNoEventsPublisher)
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	enabled, err := dbutils.ConfigureChangeEvents(ctx, db.DB, payload.Models)
+	if err != nil {
+		logger.Error("failed to configure change events", "reason", err)
+
+		return err
+	}
+
+	type metricKey struct {
+		model     string
+		operation string
+	}
+	published := make(map[metricKey]int)
+	publish := func(ctx context.Context, items []models.ChangeEvent) error {
+		batch := make([]events.Event, 0, len(items))
+		for _, item := range items {
+			batch = append(batch, events.Event{
+				ID:        item.ID,
+				Time:      item.CreatedAt,
+				Model:     item.ModelName,
+				Operation: item.Operation,
+				RecordID:  item.RecordID,
+				Diff:      item.Diff,
+				Data:      item.Data,
+			})
+		}
+
+		if err := publisher.Publish(ctx, batch...); err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			published[metricKey{item.ModelName, item.Operation}]++
+		}
+
+		return nil
+	}
+
+	count, err := dbutils.PublishChangeEvents(ctx, db.DB, payload.BatchSize, publish)
+	for key, value := range published {
+		metric := prometheus.MustNewConstMetric(
+			publishedEventsDesc,
+			prometheus.GaugeValue,
+			float64(value),
+			key.model,
+			key.operation,
+		)
+		metrics.DefaultCollector.AddMetric(metrics.Key(PublishEventsTaskType, key.model, key.operation), metric)
+	}
+
+	if err != nil {
+		logger.Error("failed to publish change events", "published", count, "reason", err)
+
+		return err
+	}
+
+	logger.Info("published change events", "models", len(enabled), "count", count)
+
+	return nil
+}
+
+func init() {
+	registry.TaskRegistry.MustRegister(PublishEventsTaskType, asynq.HandlerFunc(HandlePublishEventsTask))
+	registry.PayloadSchemaRegistry.MustRegister(PublishEventsTaskType, schema.For[PublishEventsPayload]())
+	registry.TaskDescriptionRegistry.MustRegister(PublishEventsTaskType, "Publishes the changes of the records of models to Kafka or NATS.")
+}
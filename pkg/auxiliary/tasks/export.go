@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/uptrace/bun"
+	bunschema "github.com/uptrace/bun/schema"
+
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/exporter"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/schema"
+)
+
+// ErrNoExportModels is an error, which is returned when the export task was
+// called without specifying any models to export.
+var ErrNoExportModels = errors.New("no models specified for export")
+
+const (
+	// ExportModelsTaskType is the name of the task responsible for
+	// exporting the records of models to an object store bucket.
+	ExportModelsTaskType = "aux:task:export-models"
+)
+
+// ExportModelsPayload represents the payload of the export task.
+type ExportModelsPayload struct {
+	// Models specifies the list of model names to be exported.
+	Models []string `yaml:"models" json:"models" desc:"The list of model names to be exported" example:"aws:model:instance"`
+
+	// Format specifies the export format, i.e. ndjson, csv or parquet. If
+	// not specified, [exporter.DefaultFormat] is used.
+	Format string `yaml:"format" json:"format" desc:"The export format, i.e. ndjson, csv or parquet" example:"ndjson"`
+
+	// Destination specifies the bucket, to which the records are
+	// uploaded.
+	Destination exporter.Destination `yaml:"destination" json:"destination" desc:"The bucket, to which the records are uploaded"`
+}
+
+// HandleExportModelsTask exports the current records of the models specified
+// in the payload, and uploads a single object per model to the configured
+// bucket. The objects are keyed by the name of the table and the date of the
+// export, as returned by [exporter.ObjectKey].
+func HandleExportModelsTask(ctx context.Context, task *asynq.Task) error {
+	var payload ExportModelsPayload
+	if err := asynqutils.Unmarshal(task.Payload(), &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if len(payload.Models) == 0 {
+		return asynqutils.SkipRetry(ErrNoExportModels)
+	}
+
+	if payload.Format == "" {
+		payload.Format = exporter.DefaultFormat
+	}
+
+	if _, err := exporter.ContentType(payload.Format); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	uploader, err := exporter.NewUploader(payload.Destination)
+	if err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	// Capture all errors from all models during an export.
+	allErrs := make([]error, 0)
+
+	logger := asynqutils.GetLogger(ctx)
+	now := time.Now()
+	for _, name := range payload.Models {
+		// Look up the registry for the actual model type
+		model, ok := registry.ModelRegistry.Get(name)
+		if !ok {
+			logger.Warn("model not found in registry", "name", name)
+
+			continue
+		}
+
+		table := db.DB.Table(reflect.TypeOf(model).Elem())
+		key := exporter.ObjectKey(payload.Destination.Prefix, table.Name, payload.Format, now)
+		count, err := exportModel(ctx, uploader, key, payload.Format, table)
+		if err != nil {
+			logger.Error("failed to export records", "name", name, "key", key, "reason", err)
+			allErrs = append(allErrs, err)
+
+			continue
+		}
+
+		metric := prometheus.MustNewConstMetric(
+			exportedRecordsDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			name,
+			payload.Destination.Provider,
+			payload.Destination.Bucket,
+		)
+		metricKey := metrics.Key(ExportModelsTaskType, name, payload.Destination.Provider, payload.Destination.Bucket)
+		metrics.DefaultCollector.AddMetric(metricKey, metric)
+		logger.Info("exported records", "name", name, "key", key, "count", count)
+	}
+
+	return errors.Join(allErrs...)
+}
+
+// exportModel streams the records of the given table to the uploader, and
+// returns the number of exported records. The rows are read one at a time, so
+// that the records are not kept in memory.
+func exportModel(ctx context.Context, uploader exporter.Uploader, key, format string, table *bunschema.Table) (int, error) {
+	rows, err := db.DB.QueryContext(ctx, "SELECT to_jsonb(t) FROM ? AS t", bun.Ident(table.Name))
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close() // nolint: errcheck
+
+	return exporter.Export(ctx, uploader, key, format, exporter.ColumnsFor(table), rows)
+}
+
+func init() {
+	registry.TaskRegistry.MustRegister(ExportModelsTaskType, asynq.HandlerFunc(HandleExportModelsTask))
+	registry.PayloadSchemaRegistry.MustRegister(ExportModelsTaskType, schema.For[ExportModelsPayload]())
+	registry.TaskDescriptionRegistry.MustRegister(ExportModelsTaskType, "Exports the records of models to an object store bucket.")
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/gardener/inventory/pkg/core/registry"
 	"github.com/gardener/inventory/pkg/metrics"
 	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/schema"
 )
 
 const (
@@ -28,13 +29,13 @@ const (
 // HousekeeperPayload represents the payload of the housekeeper task.
 type HousekeeperPayload struct {
 	// Retention provides the retention configuration of objects.
-	Retention []HousekeeperRetentionConfig `yaml:"retention" json:"retention"`
+	Retention []HousekeeperRetentionConfig `yaml:"retention" json:"retention" desc:"The retention configuration of objects"`
 }
 
 // HousekeeperRetentionConfig represents the retention configuration for a given model.
 type HousekeeperRetentionConfig struct {
 	// Name specifies the model name.
-	Name string `yaml:"name" json:"name"`
+	Name string `yaml:"name" json:"name" desc:"The model name" example:"aws:model:instance"`
 
 	// Duration specifies the max duration for which an object will be kept,
 	// if it hasn't been updated recently.
@@ -47,7 +48,7 @@ type HousekeeperRetentionConfig struct {
 	// If the object is not update anymore by the time the housekeeper runs,
 	// after 20:00:00 this object will be considered as stale and removed
 	// from the database.
-	Duration time.Duration `yaml:"duration" json:"duration"`
+	Duration time.Duration `yaml:"duration" json:"duration" desc:"The max duration for which an object will be kept, if it hasn't been updated recently" example:"24h"`
 }
 
 // HandleHousekeeperTask performs housekeeping activities, such as deleting
@@ -131,4 +132,6 @@ func HandleHousekeeperTask(ctx context.Context, task *asynq.Task) error {
 
 func init() {
 	registry.TaskRegistry.MustRegister(HousekeeperTaskType, asynq.HandlerFunc(HandleHousekeeperTask))
+	registry.PayloadSchemaRegistry.MustRegister(HousekeeperTaskType, schema.For[HousekeeperPayload]())
+	registry.TaskDescriptionRegistry.MustRegister(HousekeeperTaskType, "Cleans up stale records from the database.")
 }
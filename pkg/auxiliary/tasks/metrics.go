@@ -19,11 +19,134 @@ var (
 		[]string{"model_name"},
 		nil,
 	)
+
+	// shootResourcesDesc is the descriptor for a metric, which tracks the
+	// number of cloud resources resolved for Gardener Shoots.
+	shootResourcesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "shoot_resources"),
+		"Gauge which tracks the number of cloud resources resolved for Gardener Shoots",
+		[]string{"resolver", "model_name", "confidence"},
+		nil,
+	)
+
+	// classifiedResourcesDesc is the descriptor for a metric, which tracks
+	// the number of resources per model and classification.
+	classifiedResourcesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "classified_resources"),
+		"Gauge which tracks the number of resources per model and classification",
+		[]string{"model_name", "classification"},
+		nil,
+	)
+
+	// expiringCredentialsDesc is the descriptor for a metric, which tracks
+	// the number of credentials expiring within the configured window.
+	expiringCredentialsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "expiring_credentials"),
+		"Gauge which tracks the number of credentials expiring within the configured window",
+		[]string{"kind"},
+		nil,
+	)
+
+	// publicExposureDesc is the descriptor for a metric, which tracks the
+	// number of resources with public IP addresses.
+	publicExposureDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "public_exposure"),
+		"Gauge which tracks the number of resources with public IP addresses",
+		[]string{"provider", "project"},
+		nil,
+	)
+
+	// viewRefreshDurationDesc is the descriptor for a metric, which tracks
+	// the time it took to refresh a materialized view.
+	viewRefreshDurationDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "materialized_view_refresh_seconds"),
+		"Gauge which tracks the time in seconds it took to refresh a materialized view",
+		[]string{"view"},
+		nil,
+	)
+
+	// lastSuccessfulCollectionDesc is the descriptor for a metric, which
+	// tracks the time of the last successful collection per task and
+	// account.
+	lastSuccessfulCollectionDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "last_successful_collection_timestamp"),
+		"Gauge which tracks the Unix time of the last successful collection per task and account",
+		[]string{"task", "account"},
+		nil,
+	)
+
+	// tableEstimatedRowsDesc is the descriptor for a metric, which tracks
+	// the estimated number of rows in the table of a model.
+	tableEstimatedRowsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "table_estimated_rows"),
+		"Gauge which tracks the estimated number of rows in the table of a model",
+		[]string{"model", "table"},
+		nil,
+	)
+
+	// tableSizeBytesDesc is the descriptor for a metric, which tracks the
+	// total size of the table of a model in bytes.
+	tableSizeBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "table_size_bytes"),
+		"Gauge which tracks the total size in bytes of the table of a model, including indexes",
+		[]string{"model", "table"},
+		nil,
+	)
+
+	// driftFindingsDesc is the descriptor for a metric, which tracks the
+	// number of cloud resources drifted from the desired worker
+	// configuration.
+	driftFindingsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "drift_findings"),
+		"Gauge which tracks the number of cloud resources drifted from their MachineClass",
+		[]string{"check", "model_name", "attribute"},
+		nil,
+	)
+
+	// exportedRecordsDesc is the descriptor for a metric, which tracks the
+	// number of records exported to an object store bucket.
+	exportedRecordsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "exported_records"),
+		"Gauge which tracks the number of records exported to an object store bucket",
+		[]string{"model_name", "provider", "bucket"},
+		nil,
+	)
+
+	// publishedEventsDesc is the descriptor for a metric, which tracks the
+	// number of change events published to the message broker.
+	publishedEventsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "published_events"),
+		"Gauge which tracks the number of change events published to the message broker",
+		[]string{"model_name", "operation"},
+		nil,
+	)
+
+	// serviceNowSyncedItemsDesc is the descriptor for a metric, which
+	// tracks the number of configuration items synchronized into the
+	// ServiceNow CMDB.
+	serviceNowSyncedItemsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "servicenow_synced_items"),
+		"Gauge which tracks the number of configuration items synchronized into the ServiceNow CMDB",
+		[]string{"model_name", "operation"},
+		nil,
+	)
 )
 
 // init registers the metric descriptors with the [metrics.DefaultCollector]
 func init() {
 	metrics.DefaultCollector.AddDesc(
 		hkDeletedRecordsDesc,
+		shootResourcesDesc,
+		classifiedResourcesDesc,
+		expiringCredentialsDesc,
+		publicExposureDesc,
+		viewRefreshDurationDesc,
+		lastSuccessfulCollectionDesc,
+		tableEstimatedRowsDesc,
+		tableSizeBytesDesc,
+		driftFindingsDesc,
+		exportedRecordsDesc,
+		publishedEventsDesc,
+		serviceNowSyncedItemsDesc,
 	)
 }
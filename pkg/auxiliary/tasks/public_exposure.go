@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+const (
+	// ReportPublicExposureTaskType is the name of the task responsible for
+	// reporting the resources with public IP addresses.
+	ReportPublicExposureTaskType = "aux:task:report-public-exposure"
+)
+
+// HandleReportPublicExposureTask reports the number of resources with public
+// IP addresses per provider and Gardener project.
+//
+// The resources are provided by the `aux_public_exposure' view, which joins
+// the AWS Elastic Network Interfaces, GCP Addresses, Azure Public IP Addresses
+// and OpenStack Floating IPs with the Gardener Shoots owning them. OpenStack
+// Swift containers, which are publicly readable, are included as well.
+// Resources, which could not be resolved to a Shoot, are reported with an
+// empty project.
+func HandleReportPublicExposureTask(ctx context.Context, _ *asynq.Task) error {
+	logger := asynqutils.GetLogger(ctx)
+
+	var rows []struct {
+		Provider string `bun:"provider"`
+		Project  string `bun:"project"`
+		Count    int64  `bun:"count"`
+	}
+
+	err := db.DB.NewSelect().
+		TableExpr("aux_public_exposure").
+		Column("provider").
+		ColumnExpr("COALESCE(project, '') AS project").
+		ColumnExpr("count(*) AS count").
+		GroupExpr("provider, COALESCE(project, '')").
+		Scan(ctx, &rows)
+
+	if err != nil {
+		logger.Error("failed to report public exposure", "reason", err)
+
+		return err
+	}
+
+	for _, row := range rows {
+		metric := prometheus.MustNewConstMetric(
+			publicExposureDesc,
+			prometheus.GaugeValue,
+			float64(row.Count),
+			row.Provider,
+			row.Project,
+		)
+		key := metrics.Key(ReportPublicExposureTaskType, row.Provider, row.Project)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}
+
+	logger.Info("reported public exposure", "count", len(rows))
+
+	return nil
+}
+
+func init() {
+	registry.TaskRegistry.MustRegister(ReportPublicExposureTaskType, asynq.HandlerFunc(HandleReportPublicExposureTask))
+	registry.TaskDescriptionRegistry.MustRegister(ReportPublicExposureTaskType, "Reports the resources with public IP addresses.")
+}
@@ -13,6 +13,7 @@ import (
 	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
 	"github.com/gardener/inventory/pkg/core/registry"
 	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/schema"
 )
 
 const (
@@ -27,7 +28,7 @@ const (
 // DeleteQueuePayload represents the payload of a task management task.
 type DeleteQueuePayload struct {
 	// Name of the queue that holds the tasks.
-	Queue string `yaml:"queue" json:"queue"`
+	Queue string `yaml:"queue" json:"queue" desc:"Name of the queue that holds the tasks" example:"default"`
 }
 
 // HandleDeleteArchivedTask deletes archived tasks.
@@ -76,7 +77,33 @@ func HandleDeleteCompletedTask(ctx context.Context, task *asynq.Task) error {
 	return nil
 }
 
+// HandleDiscardGroupTask drops the tasks of a group, which could not be
+// aggregated.
+func HandleDiscardGroupTask(ctx context.Context, task *asynq.Task) error {
+	var payload asynqutils.DiscardGroupPayload
+	if err := asynqutils.Unmarshal(task.Payload(), &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Warn(
+		"discarded grouped tasks",
+		"group", payload.Group,
+		"type", payload.Type,
+		"count", payload.Count,
+	)
+
+	return nil
+}
+
 func init() {
 	registry.TaskRegistry.MustRegister(DeleteArchivedTaskType, asynq.HandlerFunc(HandleDeleteArchivedTask))
 	registry.TaskRegistry.MustRegister(DeleteCompletedTaskType, asynq.HandlerFunc(HandleDeleteCompletedTask))
+	registry.TaskRegistry.MustRegister(asynqutils.DiscardGroupTaskType, asynq.HandlerFunc(HandleDiscardGroupTask))
+	registry.PayloadSchemaRegistry.MustRegister(DeleteArchivedTaskType, schema.For[DeleteQueuePayload]())
+	registry.PayloadSchemaRegistry.MustRegister(DeleteCompletedTaskType, schema.For[DeleteQueuePayload]())
+	registry.PayloadSchemaRegistry.MustRegister(asynqutils.DiscardGroupTaskType, schema.For[asynqutils.DiscardGroupPayload]())
+	registry.TaskDescriptionRegistry.MustRegister(DeleteArchivedTaskType, "Deletes archived tasks from a task queue.")
+	registry.TaskDescriptionRegistry.MustRegister(DeleteCompletedTaskType, "Deletes completed tasks from a task queue.")
+	registry.TaskDescriptionRegistry.MustRegister(asynqutils.DiscardGroupTaskType, "Drops the tasks of a group, which cannot be aggregated.")
 }
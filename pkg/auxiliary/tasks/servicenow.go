@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/metrics"
+	"github.com/gardener/inventory/pkg/servicenow"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/schema"
+)
+
+// ErrNoServiceNowClient is an error, which is returned when synchronizing
+// resources into ServiceNow was requested, but no ServiceNow client is
+// configured for the worker.
+var ErrNoServiceNowClient = errors.New("no servicenow client configured")
+
+// ErrNoServiceNowModels is an error, which is returned when the ServiceNow
+// sync task was called without specifying any models.
+var ErrNoServiceNowModels = errors.New("no models specified for servicenow sync")
+
+const (
+	// SyncServiceNowTaskType is the name of the task responsible for
+	// synchronizing the records of models into the ServiceNow CMDB.
+	SyncServiceNowTaskType = "aux:task:sync-servicenow"
+
+	// DefaultServiceNowBatchSize is the default number of configuration
+	// items sent to ServiceNow at once.
+	DefaultServiceNowBatchSize = 100
+
+	// serviceNowOperationError is the operation reported for the
+	// configuration items, which could not be reconciled.
+	serviceNowOperationError = "ERROR"
+)
+
+// SyncServiceNowPayload represents the payload of the ServiceNow sync task.
+type SyncServiceNowPayload struct {
+	// Models specifies the list of model names to be synchronized. Each
+	// model must have a mapping in the servicenow section of the
+	// configuration.
+	Models []string `yaml:"models" json:"models" desc:"The list of model names to be synchronized" example:"g:model:shoot"`
+
+	// BatchSize specifies the number of configuration items sent to
+	// ServiceNow at once. If not specified, [DefaultServiceNowBatchSize]
+	// is used.
+	BatchSize int `yaml:"batch_size" json:"batch_size" desc:"The number of configuration items sent at once" example:"100"`
+}
+
+// HandleSyncServiceNowTask creates or updates the records of the models
+// specified in the payload as configuration items in the ServiceNow CMDB. The
+// records are mapped to configuration items according to the mappings
+// configured in the servicenow section of the configuration, and are
+// identified by the identification rules of the respective CMDB classes.
+func HandleSyncServiceNowTask(ctx context.Context, task *asynq.Task) error {
+	var payload SyncServiceNowPayload
+	if err := asynqutils.Unmarshal(task.Payload(), &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if len(payload.Models) == 0 {
+		return asynqutils.SkipRetry(ErrNoServiceNowModels)
+	}
+
+	if payload.BatchSize <= 0 {
+		payload.BatchSize = DefaultServiceNowBatchSize
+	}
+
+	client := servicenow.DefaultClient
+	if client == nil {
+		return asynqutils.SkipRetry(ErrNoServiceNowClient)
+	}
+
+	// Capture all errors from all models during a sync.
+	allErrs := make([]error, 0)
+
+	logger := asynqutils.GetLogger(ctx)
+	for _, name := range payload.Models {
+		model, ok := registry.ModelRegistry.Get(name)
+		if !ok {
+			logger.Warn("model not found in registry", "name", name)
+
+			continue
+		}
+
+		mapping, ok := client.Mapping(name)
+		if !ok {
+			logger.Warn("no servicenow mapping configured", "name", name)
+
+			continue
+		}
+
+		table := db.DB.Table(reflect.TypeOf(model).Elem())
+		records := make([]string, 0)
+		err := db.DB.NewRaw(
+			"SELECT to_jsonb(t) FROM ? AS t",
+			bun.Ident(table.Name),
+		).Scan(ctx, &records)
+
+		if err != nil {
+			logger.Error("failed to query records", "name", name, "reason", err)
+			allErrs = append(allErrs, err)
+
+			continue
+		}
+
+		items := make([]servicenow.Item, 0, len(records))
+		for _, record := range records {
+			item, err := servicenow.BuildItem(mapping, record)
+			if err != nil {
+				logger.Error("failed to map record", "name", name, "reason", err)
+				allErrs = append(allErrs, err)
+
+				continue
+			}
+			items = append(items, item)
+		}
+
+		operations := make(map[string]int)
+		for start := 0; start < len(items); start += payload.BatchSize {
+			end := min(start+payload.BatchSize, len(items))
+			results, err := client.IdentifyReconcile(ctx, items[start:end])
+			if err != nil {
+				logger.Error("failed to synchronize items", "name", name, "reason", err)
+				allErrs = append(allErrs, err)
+
+				break
+			}
+
+			for _, result := range results {
+				operation := result.Operation
+				if len(result.Errors) > 0 {
+					operation = serviceNowOperationError
+					logger.Warn(
+						"failed to reconcile item",
+						"name", name,
+						"class", result.ClassName,
+						"error", result.Errors[0].Error,
+						"message", result.Errors[0].Message,
+					)
+				}
+				operations[operation]++
+			}
+		}
+
+		for operation, count := range operations {
+			metric := prometheus.MustNewConstMetric(
+				serviceNowSyncedItemsDesc,
+				prometheus.GaugeValue,
+				float64(count),
+				name,
+				operation,
+			)
+			key := metrics.Key(SyncServiceNowTaskType, name, operation)
+			metrics.DefaultCollector.AddMetric(key, metric)
+		}
+		logger.Info("synchronized items", "name", name, "class", mapping.ClassName, "count", len(items))
+	}
+
+	return errors.Join(allErrs...)
+}
+
+func init() {
+	registry.TaskRegistry.MustRegister(SyncServiceNowTaskType, asynq.HandlerFunc(HandleSyncServiceNowTask))
+	registry.PayloadSchemaRegistry.MustRegister(SyncServiceNowTaskType, schema.For[SyncServiceNowPayload]())
+	registry.TaskDescriptionRegistry.MustRegister(SyncServiceNowTaskType, "Synchronizes the records of models into the ServiceNow CMDB.")
+}
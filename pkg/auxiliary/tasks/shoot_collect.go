@@ -0,0 +1,308 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	gardenermodels "github.com/gardener/inventory/pkg/gardener/models"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/schema"
+)
+
+// ErrNoShootName is an error, which is returned when no shoot name was
+// specified.
+var ErrNoShootName = errors.New("no shoot name specified")
+
+// ErrShootNotFound is an error, which is returned when the shoot could not be
+// found in the database.
+var ErrShootNotFound = errors.New("shoot not found")
+
+// ErrAmbiguousShoot is an error, which is returned when the shoot name matches
+// shoots from multiple projects.
+var ErrAmbiguousShoot = errors.New("shoot name matches multiple shoots, specify a project name or landscape")
+
+// CollectShootTaskType is the name of the task responsible for collecting the
+// resources of a single Gardener Shoot.
+const CollectShootTaskType = "aux:task:collect-shoot"
+
+// DefaultShootLinkDelay is the default delay after which the link tasks are
+// enqueued by the [CollectShootTaskType] task.
+const DefaultShootLinkDelay = 2 * time.Minute
+
+// CollectShootPayload represents the payload of the [CollectShootTaskType]
+// task.
+type CollectShootPayload struct {
+	// Name specifies the name of the Shoot.
+	Name string `yaml:"name" json:"name" desc:"The name of the Shoot" example:"my-shoot"`
+
+	// ProjectName specifies the name of the project of the Shoot. It is
+	// required, if the Shoot name is not unique across projects.
+	ProjectName string `yaml:"project_name" json:"project_name" desc:"The name of the project of the Shoot" example:"my-project"`
+
+	// Landscape specifies the name of the Gardener landscape of the
+	// Shoot. It is required, if the Shoot name is not unique across
+	// landscapes.
+	Landscape string `yaml:"landscape" json:"landscape" desc:"The name of the Gardener landscape of the Shoot" example:"canary"`
+
+	// LinkDelay specifies the delay after which the link tasks are
+	// enqueued, so that they are processed after the collection of the
+	// resources of the Shoot.
+	LinkDelay time.Duration `yaml:"link_delay" json:"link_delay" desc:"The delay after which the link tasks are enqueued" example:"2m"`
+}
+
+// HandleCollectShootTask enqueues the collectors registered with
+// [registry.ShootCollectorRegistry], which are relevant to the provider,
+// accounts and region of a single Gardener Shoot, followed by the tasks for
+// linking the collected resources.
+func HandleCollectShootTask(ctx context.Context, t *asynq.Task) error {
+	var payload CollectShootPayload
+	if err := asynqutils.Unmarshal(t.Payload(), &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.Name == "" {
+		return asynqutils.SkipRetry(ErrNoShootName)
+	}
+
+	if payload.LinkDelay == 0 {
+		payload.LinkDelay = DefaultShootLinkDelay
+	}
+
+	scope, err := getShootScope(ctx, payload)
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	allErrs := make([]error, 0)
+	linkTasks := make([]string, 0)
+
+	err = registry.ShootCollectorRegistry.Range(func(provider string, c registry.ShootCollector) error {
+		if c.ProviderType != "" && c.ProviderType != scope.ProviderType {
+			return nil
+		}
+
+		providerScope := scope.ShootScope
+		providerScope.Accounts = scope.AccountsByProvider[provider]
+		items, err := c.NewTasks(providerScope)
+		if err != nil {
+			logger.Error("failed to create shoot collector tasks", "provider", provider, "reason", err)
+			allErrs = append(allErrs, err)
+
+			return nil
+		}
+
+		for _, task := range items {
+			queue := collectionTaskQueue(ctx, task)
+			if err := enqueueCollectionTask(ctx, task, asynq.Queue(queue)); err != nil {
+				allErrs = append(allErrs, err)
+			}
+		}
+
+		linkTasks = append(linkTasks, c.LinkTasks...)
+
+		return nil
+	})
+	allErrs = append(allErrs, err)
+
+	// The shoot resources and accounts are linked after the provider
+	// link tasks have been processed.
+	for _, name := range linkTasks {
+		task := asynq.NewTask(name, nil)
+		queue := collectionTaskQueue(ctx, task)
+		err := enqueueCollectionTask(ctx, task, asynq.Queue(queue), asynq.ProcessIn(payload.LinkDelay))
+		allErrs = append(allErrs, err)
+	}
+
+	for _, name := range []string{ReconcileShootResourcesTaskType, LinkAccountsTaskType} {
+		task := asynq.NewTask(name, nil)
+		queue := collectionTaskQueue(ctx, task)
+		err := enqueueCollectionTask(ctx, task, asynq.Queue(queue), asynq.ProcessIn(2*payload.LinkDelay))
+		allErrs = append(allErrs, err)
+	}
+
+	logger.Info(
+		"enqueued shoot collection",
+		"shoot", scope.Name,
+		"project", scope.ProjectName,
+		"landscape", scope.Landscape,
+		"provider", scope.ProviderType,
+		"region", scope.Region,
+	)
+
+	return errors.Join(allErrs...)
+}
+
+// shootScope wraps a [registry.ShootScope] along with the accounts of the
+// Shoot, keyed by provider name.
+type shootScope struct {
+	registry.ShootScope
+
+	// AccountsByProvider specifies the IDs of the accounts of the Shoot,
+	// keyed by provider name.
+	AccountsByProvider map[string][]string
+}
+
+// shootRef returns a reference to the Shoot specified in the given payload,
+// which is used in error messages, e.g. `my-shoot (project=dev, landscape=live)'.
+func shootRef(payload CollectShootPayload) string {
+	ref := payload.Name
+	attrs := make([]string, 0)
+	if payload.ProjectName != "" {
+		attrs = append(attrs, "project="+payload.ProjectName)
+	}
+	if payload.Landscape != "" {
+		attrs = append(attrs, "landscape="+payload.Landscape)
+	}
+	if len(attrs) > 0 {
+		ref += " (" + strings.Join(attrs, ", ") + ")"
+	}
+
+	return ref
+}
+
+// getShootScope returns the [shootScope] for the Shoot specified in the given
+// payload.
+func getShootScope(ctx context.Context, payload CollectShootPayload) (shootScope, error) {
+	var shoots []gardenermodels.Shoot
+	query := db.DB.NewSelect().
+		Model(&shoots).
+		Where("name = ?", payload.Name)
+
+	if payload.ProjectName != "" {
+		query = query.Where("project_name = ?", payload.ProjectName)
+	}
+
+	if payload.Landscape != "" {
+		query = query.Where("landscape = ?", payload.Landscape)
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return shootScope{}, err
+	}
+
+	switch {
+	case len(shoots) == 0:
+		return shootScope{}, asynqutils.SkipRetry(fmt.Errorf("%w: %s", ErrShootNotFound, shootRef(payload)))
+	case len(shoots) > 1:
+		return shootScope{}, asynqutils.SkipRetry(fmt.Errorf("%w: %s", ErrAmbiguousShoot, shootRef(payload)))
+	}
+
+	shoot := shoots[0]
+	scope := shootScope{
+		ShootScope: registry.ShootScope{
+			Name:        shoot.Name,
+			Namespace:   shoot.Namespace,
+			ProjectName: shoot.ProjectName,
+			TechnicalID: shoot.TechnicalID,
+			SeedName:    shoot.SeedName,
+			Landscape:   shoot.Landscape,
+			Region:      shoot.Region,
+		},
+		AccountsByProvider: make(map[string][]string),
+	}
+
+	// The provider type is taken from the Cloud Profile of the Shoot
+	var cloudProfile gardenermodels.CloudProfile
+	err := db.DB.NewSelect().
+		Model(&cloudProfile).
+		Where("name = ?", shoot.CloudProfile).
+		Where("landscape = ?", shoot.Landscape).
+		Limit(1).
+		Scan(ctx)
+
+	switch {
+	case err == nil:
+		scope.ProviderType = cloudProfile.Type
+	case errors.Is(err, sql.ErrNoRows):
+		asynqutils.GetLogger(ctx).Warn(
+			"cloud profile of shoot not found",
+			"shoot", shoot.Name,
+			"landscape", shoot.Landscape,
+			"cloud_profile", shoot.CloudProfile,
+		)
+	default:
+		return shootScope{}, err
+	}
+
+	// The accounts of the Shoot are the ones, in which cloud resources of
+	// the Shoot have been resolved.
+	var accounts []struct {
+		Provider  string `bun:"provider"`
+		AccountID string `bun:"account_id"`
+	}
+	err = db.DB.NewRaw(`SELECT DISTINCT a.provider, a.account_id
+FROM l_aux_shoot_to_resource AS s
+INNER JOIN l_aux_account_to_resource AS ar ON ar.model_name = s.model_name AND ar.resource_id = s.resource_id
+INNER JOIN aux_account AS a ON a.id = ar.account_id
+WHERE s.shoot_id = ?`, shoot.ID).Scan(ctx, &accounts)
+
+	if err != nil {
+		return shootScope{}, err
+	}
+
+	for _, a := range accounts {
+		scope.AccountsByProvider[a.Provider] = append(scope.AccountsByProvider[a.Provider], a.AccountID)
+	}
+
+	return scope, nil
+}
+
+// collectionTaskQueue returns the queue for the given task, which is part of the
+// collection of a single Gardener Shoot or provider account. The task is routed
+// according to the configured routes, same as the periodic jobs of the
+// scheduler, and is enqueued in the queue of the parent task otherwise.
+func collectionTaskQueue(ctx context.Context, task *asynq.Task) string {
+	conf := asynqutils.GetConfig(ctx)
+	if queue, ok := conf.RouteQueue(task.Type()); ok {
+		return queue
+	}
+
+	return asynqutils.GetQueueName(ctx)
+}
+
+// enqueueCollectionTask enqueues the given task, which is part of the
+// collection of a single Gardener Shoot or provider account. Duplicate tasks
+// are skipped.
+func enqueueCollectionTask(ctx context.Context, task *asynq.Task, opts ...asynq.Option) error {
+	logger := asynqutils.GetLogger(ctx)
+	info, err := asynqclient.Enqueue(task, opts...)
+	switch {
+	case errors.Is(err, asynq.ErrDuplicateTask):
+		logger.Info("skipping duplicate task", "type", task.Type())
+
+		return nil
+	case err != nil:
+		logger.Error("failed to enqueue task", "type", task.Type(), "reason", err)
+
+		return err
+	}
+
+	logger.Info(
+		"enqueued task",
+		"type", task.Type(),
+		"id", info.ID,
+		"queue", info.Queue,
+	)
+
+	return nil
+}
+
+func init() {
+	registry.TaskRegistry.MustRegister(CollectShootTaskType, asynq.HandlerFunc(HandleCollectShootTask))
+	registry.PayloadSchemaRegistry.MustRegister(CollectShootTaskType, schema.For[CollectShootPayload]())
+	registry.TaskDescriptionRegistry.MustRegister(CollectShootTaskType, "Collects the resources of a single Gardener Shoot.")
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+const (
+	// ReconcileShootResourcesTaskType is the name of the task responsible
+	// for resolving the cloud resources of Gardener Shoots.
+	ReconcileShootResourcesTaskType = "aux:task:reconcile-shoot-resources"
+)
+
+// HandleReconcileShootResourcesTask resolves the cloud resources of the
+// Gardener Shoots by using the resolvers registered with
+// [registry.ShootResourceRegistry], and persists the resolved mapping in the
+// `l_aux_shoot_to_resource' table.
+//
+// Links, which have not been resolved again by any of the resolvers, are
+// removed once all resolvers have run. Links of models, for which a resolver
+// has failed, are kept until the next successful run.
+func HandleReconcileShootResourcesTask(ctx context.Context, _ *asynq.Task) error {
+	logger := asynqutils.GetLogger(ctx)
+	allErrs := make([]error, 0)
+
+	// The links are upserted by each resolver in a separate statement,
+	// so the start time is taken from the database in order to find the
+	// links, which have not been resolved during this run.
+	var startedAt time.Time
+	if err := db.DB.NewSelect().ColumnExpr("now()").Scan(ctx, &startedAt); err != nil {
+		return err
+	}
+
+	failedModels := make([]string, 0)
+	err := registry.ShootResourceRegistry.Range(func(name string, res registry.ShootResource) error {
+		query := `INSERT INTO l_aux_shoot_to_resource (shoot_id, model_name, resource_id, kind, method, confidence)
+SELECT DISTINCT q.shoot_id, ?, q.resource_id, ?, ?, ? FROM (?) AS q
+ON CONFLICT (shoot_id, model_name, resource_id) DO UPDATE SET
+kind = EXCLUDED.kind,
+method = EXCLUDED.method,
+confidence = EXCLUDED.confidence,
+updated_at = EXCLUDED.updated_at`
+
+		out, err := db.DB.NewRaw(
+			query,
+			res.ModelName,
+			res.Kind,
+			res.Method,
+			res.Confidence,
+			bun.Safe(res.Query),
+		).Exec(ctx)
+
+		if err != nil {
+			logger.Error("failed to resolve shoot resources", "resolver", name, "reason", err)
+			allErrs = append(allErrs, err)
+			failedModels = append(failedModels, res.ModelName)
+
+			return nil
+		}
+
+		count, err := out.RowsAffected()
+		if err != nil {
+			allErrs = append(allErrs, err)
+
+			return nil
+		}
+
+		metric := prometheus.MustNewConstMetric(
+			shootResourcesDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			name,
+			res.ModelName,
+			res.Confidence,
+		)
+		key := metrics.Key(ReconcileShootResourcesTaskType, name)
+		metrics.DefaultCollector.AddMetric(key, metric)
+		logger.Info("resolved shoot resources", "resolver", name, "model", res.ModelName, "count", count)
+
+		return nil
+	})
+
+	if err != nil {
+		allErrs = append(allErrs, err)
+
+		return errors.Join(allErrs...)
+	}
+
+	q := db.DB.NewDelete().
+		Model((*models.ShootToResource)(nil)).
+		Where("updated_at < ?", startedAt)
+
+	if len(failedModels) > 0 {
+		q = q.Where("model_name NOT IN (?)", bun.In(failedModels))
+	}
+
+	out, err := q.Exec(ctx)
+	if err != nil {
+		logger.Error("failed to delete stale shoot resources", "reason", err)
+		allErrs = append(allErrs, err)
+
+		return errors.Join(allErrs...)
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		allErrs = append(allErrs, err)
+
+		return errors.Join(allErrs...)
+	}
+	logger.Info("deleted stale shoot resources", "count", count)
+
+	return errors.Join(allErrs...)
+}
+
+func init() {
+	registry.TaskRegistry.MustRegister(ReconcileShootResourcesTaskType, asynq.HandlerFunc(HandleReconcileShootResourcesTask))
+	registry.TaskDescriptionRegistry.MustRegister(ReconcileShootResourcesTaskType, "Resolves the cloud resources of Gardener Shoots.")
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/schema"
+)
+
+// ErrNoSnapshotModels is an error, which is returned when the snapshot task
+// was called without specifying any models to capture.
+var ErrNoSnapshotModels = errors.New("no models specified for snapshot")
+
+const (
+	// SnapshotTaskType is the name of the task responsible for capturing
+	// point-in-time snapshots of models.
+	SnapshotTaskType = "aux:task:snapshot"
+)
+
+// SnapshotPayload represents the payload of the snapshot task.
+type SnapshotPayload struct {
+	// Models specifies the list of model names to be captured.
+	Models []string `yaml:"models" json:"models" desc:"The list of model names to be captured" example:"aws:model:instance"`
+}
+
+// HandleSnapshotTask captures the current records of the models specified in
+// the payload and associates them with a new [models.SnapshotRun].
+func HandleSnapshotTask(ctx context.Context, task *asynq.Task) error {
+	var payload SnapshotPayload
+	if err := asynqutils.Unmarshal(task.Payload(), &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if len(payload.Models) == 0 {
+		return asynqutils.SkipRetry(ErrNoSnapshotModels)
+	}
+
+	// Unknown models are rejected before starting the snapshot run, since
+	// a run, which does not capture all of the models must not be marked
+	// as completed.
+	tables := make(map[string]string, len(payload.Models))
+	unknown := make([]string, 0)
+	for _, name := range payload.Models {
+		model, ok := registry.ModelRegistry.Get(name)
+		if !ok {
+			unknown = append(unknown, name)
+
+			continue
+		}
+		tables[name] = db.DB.Table(reflect.TypeOf(model).Elem()).Name
+	}
+
+	if len(unknown) > 0 {
+		return asynqutils.SkipRetry(fmt.Errorf("%w: %s", ErrModelNotFound, strings.Join(unknown, ", ")))
+	}
+
+	run := models.SnapshotRun{
+		StartedAt: time.Now(),
+		Status:    models.SnapshotStatusRunning,
+	}
+	_, err := db.DB.NewInsert().
+		Model(&run).
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	// Capture all errors from all models during a snapshot run.
+	allErrs := make([]error, 0)
+	captured := 0
+
+	logger := asynqutils.GetLogger(ctx)
+	for _, name := range payload.Models {
+		out, err := db.DB.NewRaw(
+			"INSERT INTO aux_snapshot_item (run_id, model_name, data) SELECT ?, ?, to_jsonb(t) FROM ? AS t",
+			run.ID,
+			name,
+			bun.Ident(tables[name]),
+		).Exec(ctx)
+
+		if err != nil {
+			logger.Error("failed to capture snapshot", "name", name, "reason", err)
+			allErrs = append(allErrs, fmt.Errorf("%s: %w", name, err))
+
+			continue
+		}
+		captured++
+
+		count, err := out.RowsAffected()
+		if err != nil {
+			logger.Error("failed to get number of captured rows", "name", name, "reason", err)
+
+			continue
+		}
+		logger.Info("captured snapshot", "name", name, "run_id", run.ID, "count", count)
+	}
+
+	// Only snapshot runs, which captured all models, are used to query
+	// the state of the inventory as of a given time. The errors are
+	// returned, so that the task is retried with a new snapshot run.
+	run.CompletedAt = time.Now()
+	switch {
+	case len(allErrs) == 0:
+		run.Status = models.SnapshotStatusCompleted
+	case captured > 0:
+		run.Status = models.SnapshotStatusPartial
+	default:
+		run.Status = models.SnapshotStatusFailed
+	}
+
+	_, err = db.DB.NewUpdate().
+		Model(&run).
+		Column("completed_at", "status").
+		WherePK().
+		Exec(ctx)
+
+	allErrs = append(allErrs, err)
+
+	return errors.Join(allErrs...)
+}
+
+func init() {
+	registry.TaskRegistry.MustRegister(SnapshotTaskType, asynq.HandlerFunc(HandleSnapshotTask))
+	registry.PayloadSchemaRegistry.MustRegister(SnapshotTaskType, schema.For[SnapshotPayload]())
+	registry.TaskDescriptionRegistry.MustRegister(SnapshotTaskType, "Captures point-in-time snapshots of models.")
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+const (
+	// ReportTableStatsTaskType is the name of the task responsible for
+	// reporting the estimated number of rows and sizes of the tables of
+	// the registered models.
+	ReportTableStatsTaskType = "aux:task:report-table-stats"
+)
+
+// HandleReportTableStatsTask reports the estimated number of rows and the
+// sizes of the tables of the registered models, so that the growth of the
+// Inventory database can be observed.
+func HandleReportTableStatsTask(ctx context.Context, _ *asynq.Task) error {
+	logger := asynqutils.GetLogger(ctx)
+	items, err := dbutils.ComputeTableStats(ctx, db.DB)
+	if err != nil {
+		logger.Error("failed to report table stats", "reason", err)
+
+		return err
+	}
+
+	for _, item := range items {
+		key := metrics.Key(ReportTableStatsTaskType, item.Model)
+		rows := prometheus.MustNewConstMetric(
+			tableEstimatedRowsDesc,
+			prometheus.GaugeValue,
+			float64(item.EstimatedRows),
+			item.Model,
+			item.Table,
+		)
+		metrics.DefaultCollector.AddMetric(metrics.Key(key, "rows"), rows)
+
+		size := prometheus.MustNewConstMetric(
+			tableSizeBytesDesc,
+			prometheus.GaugeValue,
+			float64(item.SizeBytes),
+			item.Model,
+			item.Table,
+		)
+		metrics.DefaultCollector.AddMetric(metrics.Key(key, "size"), size)
+	}
+
+	logger.Info("reported table stats", "count", len(items))
+
+	return nil
+}
+
+func init() {
+	registry.TaskRegistry.MustRegister(ReportTableStatsTaskType, asynq.HandlerFunc(HandleReportTableStatsTask))
+	registry.TaskDescriptionRegistry.MustRegister(ReportTableStatsTaskType, "Reports the estimated number of rows and sizes of the tables of the registered models.")
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/schema"
+)
+
+const (
+	// RefreshViewsTaskType is the name of the task responsible for
+	// refreshing the materialized views.
+	RefreshViewsTaskType = "core:task:refresh-views"
+)
+
+// RefreshViewsPayload represents the payload of the refresh views task.
+type RefreshViewsPayload struct {
+	// Views specifies the names of the materialized views to refresh. If
+	// empty, then all materialized views are refreshed.
+	Views []string `yaml:"views" json:"views" desc:"The names of the materialized views to refresh" example:"aux_shoot_resource_count"`
+}
+
+// materializedView represents a materialized view as reported by the
+// `pg_matviews' catalog.
+type materializedView struct {
+	// Name specifies the name of the materialized view.
+	Name string `bun:"name"`
+
+	// IsPopulated specifies whether the materialized view has been
+	// populated with data.
+	IsPopulated bool `bun:"is_populated"`
+
+	// HasUniqueIndex specifies whether the materialized view has a unique
+	// index, which is required for refreshing it concurrently.
+	HasUniqueIndex bool `bun:"has_unique_index"`
+}
+
+// HandleRefreshViewsTask refreshes the materialized views, which are defined
+// by the migrations.
+//
+// Materialized views with a unique index are refreshed concurrently, so that
+// they can still be queried while being refreshed. All other materialized
+// views are refreshed with an exclusive lock.
+func HandleRefreshViewsTask(ctx context.Context, task *asynq.Task) error {
+	var payload RefreshViewsPayload
+	if data := task.Payload(); data != nil {
+		if err := asynqutils.Unmarshal(data, &payload); err != nil {
+			return asynqutils.SkipRetry(err)
+		}
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	views := make([]materializedView, 0)
+	err := db.DB.NewRaw(`SELECT
+m.matviewname AS name,
+m.ispopulated AS is_populated,
+EXISTS (
+SELECT 1 FROM pg_index AS i
+INNER JOIN pg_class AS c ON c.oid = i.indrelid
+INNER JOIN pg_namespace AS n ON n.oid = c.relnamespace
+WHERE n.nspname = m.schemaname AND c.relname = m.matviewname
+AND i.indisunique AND i.indpred IS NULL AND i.indexprs IS NULL
+) AS has_unique_index
+FROM pg_matviews AS m
+WHERE m.schemaname = current_schema()
+ORDER BY m.matviewname`).Scan(ctx, &views)
+
+	if err != nil {
+		logger.Error("failed to get materialized views", "reason", err)
+
+		return err
+	}
+
+	allErrs := make([]error, 0)
+	for _, view := range views {
+		if len(payload.Views) > 0 && !slices.Contains(payload.Views, view.Name) {
+			continue
+		}
+
+		query := "REFRESH MATERIALIZED VIEW ?"
+		concurrently := view.IsPopulated && view.HasUniqueIndex
+		if concurrently {
+			query = "REFRESH MATERIALIZED VIEW CONCURRENTLY ?"
+		}
+
+		start := time.Now()
+		if _, err := db.DB.NewRaw(query, bun.Ident(view.Name)).Exec(ctx); err != nil {
+			logger.Error("failed to refresh materialized view", "view", view.Name, "reason", err)
+			allErrs = append(allErrs, err)
+
+			continue
+		}
+		elapsed := time.Since(start)
+
+		metric := prometheus.MustNewConstMetric(
+			viewRefreshDurationDesc,
+			prometheus.GaugeValue,
+			elapsed.Seconds(),
+			view.Name,
+		)
+		key := metrics.Key(RefreshViewsTaskType, view.Name)
+		metrics.DefaultCollector.AddMetric(key, metric)
+		logger.Info(
+			"refreshed materialized view",
+			"view", view.Name,
+			"concurrently", concurrently,
+			"duration", elapsed,
+		)
+	}
+
+	return errors.Join(allErrs...)
+}
+
+func init() {
+	registry.TaskRegistry.MustRegister(RefreshViewsTaskType, asynq.HandlerFunc(HandleRefreshViewsTask))
+	registry.PayloadSchemaRegistry.MustRegister(RefreshViewsTaskType, schema.For[RefreshViewsPayload]())
+	registry.TaskDescriptionRegistry.MustRegister(RefreshViewsTaskType, "Refreshes the materialized views.")
+}
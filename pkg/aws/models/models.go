@@ -29,6 +29,13 @@ const (
 	DHCPOptionSetModelName                  = "aws:model:dhcp_option_set"
 	HostedZoneModelName                     = "aws:model:hosted_zone"
 	ResourceRecordModelName                 = "aws:model:resource_record"
+	CapacityReservationModelName            = "aws:model:capacity_reservation"
+	SpotInstanceRequestModelName            = "aws:model:spot_instance_request"
+	ServiceQuotaModelName                   = "aws:model:service_quota"
+	LoadBalancerCertificateModelName        = "aws:model:lb_certificate"
+	LoadBalancerListenerModelName           = "aws:model:lb_listener"
+	TargetGroupModelName                    = "aws:model:target_group"
+	LoadBalancerTargetModelName             = "aws:model:lb_target"
 	RegionToAZModelName                     = "aws:model:link_region_to_az"
 	RegionToVPCModelName                    = "aws:model:link_region_to_vpc"
 	VPCToSubnetModelName                    = "aws:model:link_vpc_to_subnet"
@@ -42,23 +49,36 @@ const (
 	LoadBalancerToRegionModelName           = "aws:model:link_lb_to_region"
 	LoadBalancerToNetworkInterfaceModelName = "aws:model:link_lb_to_net_interface"
 	InstanceToNetworkInterfaceModelName     = "aws:model:link_instance_to_net_interface"
+	CapacityReservationToRegionModelName    = "aws:model:link_capacity_reservation_to_region"
+	CapacityReservationToAZModelName        = "aws:model:link_capacity_reservation_to_az"
+	SpotInstanceRequestToRegionModelName    = "aws:model:link_spot_instance_request_to_region"
+	SpotInstanceRequestToAZModelName        = "aws:model:link_spot_instance_request_to_az"
+	ServiceQuotaToRegionModelName           = "aws:model:link_service_quota_to_region"
+	LoadBalancerToInstanceModelName         = "aws:model:link_lb_to_instance"
 )
 
 // models specifies the mapping between name and model type, which will be
 // registered with [registry.ModelRegistry].
 var models = map[string]any{
-	RegionModelName:           &Region{},
-	AvailabilityZoneModelName: &AvailabilityZone{},
-	VPCModelName:              &VPC{},
-	SubnetModelName:           &Subnet{},
-	InstanceModelName:         &Instance{},
-	ImageModelName:            &Image{},
-	LoadBalancerModelName:     &LoadBalancer{},
-	BucketModelName:           &Bucket{},
-	NetworkInterfaceModelName: &NetworkInterface{},
-	DHCPOptionSetModelName:    &DHCPOptionSet{},
-	HostedZoneModelName:       &HostedZone{},
-	ResourceRecordModelName:   &ResourceRecord{},
+	RegionModelName:                  &Region{},
+	AvailabilityZoneModelName:        &AvailabilityZone{},
+	VPCModelName:                     &VPC{},
+	SubnetModelName:                  &Subnet{},
+	InstanceModelName:                &Instance{},
+	ImageModelName:                   &Image{},
+	LoadBalancerModelName:            &LoadBalancer{},
+	BucketModelName:                  &Bucket{},
+	NetworkInterfaceModelName:        &NetworkInterface{},
+	DHCPOptionSetModelName:           &DHCPOptionSet{},
+	HostedZoneModelName:              &HostedZone{},
+	ResourceRecordModelName:          &ResourceRecord{},
+	CapacityReservationModelName:     &CapacityReservation{},
+	SpotInstanceRequestModelName:     &SpotInstanceRequest{},
+	ServiceQuotaModelName:            &ServiceQuota{},
+	LoadBalancerCertificateModelName: &LoadBalancerCertificate{},
+	LoadBalancerListenerModelName:    &LoadBalancerListener{},
+	TargetGroupModelName:             &TargetGroup{},
+	LoadBalancerTargetModelName:      &LoadBalancerTarget{},
 
 	// Link models
 	RegionToAZModelName:                     &RegionToAZ{},
@@ -74,6 +94,12 @@ var models = map[string]any{
 	LoadBalancerToRegionModelName:           &LoadBalancerToRegion{},
 	LoadBalancerToNetworkInterfaceModelName: &LoadBalancerToNetworkInterface{},
 	InstanceToNetworkInterfaceModelName:     &InstanceToNetworkInterface{},
+	CapacityReservationToRegionModelName:    &CapacityReservationToRegion{},
+	CapacityReservationToAZModelName:        &CapacityReservationToAZ{},
+	SpotInstanceRequestToRegionModelName:    &SpotInstanceRequestToRegion{},
+	SpotInstanceRequestToAZModelName:        &SpotInstanceRequestToAZ{},
+	ServiceQuotaToRegionModelName:           &ServiceQuotaToRegion{},
+	LoadBalancerToInstanceModelName:         &LoadBalancerToInstance{},
 }
 
 // RegionToAZ represents a link table connecting the Region with AZ.
@@ -254,6 +280,11 @@ type InstanceToNetworkInterface struct {
 
 	InstanceID         uuid.UUID `bun:"instance_id,notnull,type:uuid,unique:l_aws_instance_to_net_interface_key"`
 	NetworkInterfaceID uuid.UUID `bun:"ni_id,notnull,type:uuid,unique:l_aws_instance_to_net_interface_key"`
+
+	// NetworkInterfaceAccountID is part of the foreign key to the
+	// [NetworkInterface], so that its table may be partitioned by
+	// account.
+	NetworkInterfaceAccountID string `bun:"ni_account_id,notnull"`
 }
 
 // Image represents an AWS AMI
@@ -325,11 +356,22 @@ type Bucket struct {
 	bun.BaseModel `bun:"table:aws_bucket"`
 	coremodels.Model
 
-	Name         string    `bun:"name,notnull,unique:aws_bucket_key"`
-	AccountID    string    `bun:"account_id,notnull,unique:aws_bucket_key"`
-	CreationDate time.Time `bun:"creation_date,notnull"`
-	RegionName   string    `bun:"region_name,notnull"`
-	Region       *Region   `bun:"rel:has-one,join:region_name=name,join:account_id=account_id"`
+	Name                  string    `bun:"name,notnull,unique:aws_bucket_key"`
+	AccountID             string    `bun:"account_id,notnull,unique:aws_bucket_key"`
+	CreationDate          time.Time `bun:"creation_date,notnull"`
+	RegionName            string    `bun:"region_name,notnull"`
+	EncryptionAlgorithm   string    `bun:"encryption_algorithm,nullzero"`
+	KMSKeyID              string    `bun:"kms_key_id,nullzero"`
+	BucketKeyEnabled      bool      `bun:"bucket_key_enabled,notnull"`
+	VersioningStatus      string    `bun:"versioning_status,nullzero"`
+	MFADeleteEnabled      bool      `bun:"mfa_delete_enabled,notnull"`
+	BlockPublicACLs       bool      `bun:"block_public_acls,notnull"`
+	IgnorePublicACLs      bool      `bun:"ignore_public_acls,notnull"`
+	BlockPublicPolicy     bool      `bun:"block_public_policy,notnull"`
+	RestrictPublicBuckets bool      `bun:"restrict_public_buckets,notnull"`
+	LifecycleRules        int       `bun:"lifecycle_rules,notnull"`
+	LifecycleRulesEnabled int       `bun:"lifecycle_rules_enabled,notnull"`
+	Region                *Region   `bun:"rel:has-one,join:region_name=name,join:account_id=account_id"`
 }
 
 // NetworkInterface represents an AWS Elastic Network Interface (ENI)
@@ -419,6 +461,11 @@ type LoadBalancerToNetworkInterface struct {
 
 	LoadBalancerID     uuid.UUID `bun:"lb_id,notnull,type:uuid,unique:l_aws_lb_to_net_interface_key"`
 	NetworkInterfaceID uuid.UUID `bun:"ni_id,notnull,type:uuid,unique:l_aws_lb_to_net_interface_key"`
+
+	// NetworkInterfaceAccountID is part of the foreign key to the
+	// [NetworkInterface], so that its table may be partitioned by
+	// account.
+	NetworkInterfaceAccountID string `bun:"ni_account_id,notnull"`
 }
 
 // DHCPOptionSet represents an AWS DHCP option set
@@ -433,9 +480,341 @@ type DHCPOptionSet struct {
 	Region     *Region `bun:"rel:has-one,join:region_name=name,join:account_id=account_id"`
 }
 
+// CapacityReservation represents an AWS EC2 On-Demand Capacity Reservation
+type CapacityReservation struct {
+	bun.BaseModel `bun:"table:aws_capacity_reservation"`
+	coremodels.Model
+
+	ReservationID          string            `bun:"reservation_id,notnull,unique:aws_capacity_reservation_key"`
+	AccountID              string            `bun:"account_id,notnull,unique:aws_capacity_reservation_key"`
+	ARN                    string            `bun:"arn,notnull"`
+	OwnerID                string            `bun:"owner_id,notnull"`
+	InstanceType           string            `bun:"instance_type,notnull"`
+	InstancePlatform       string            `bun:"instance_platform,notnull"`
+	Tenancy                string            `bun:"tenancy,notnull"`
+	State                  string            `bun:"state,notnull"`
+	TotalInstanceCount     int32             `bun:"total_instance_count,notnull"`
+	AvailableInstanceCount int32             `bun:"available_instance_count,notnull"`
+	InstanceMatchCriteria  string            `bun:"instance_match_criteria,notnull"`
+	EndDateType            string            `bun:"end_date_type,notnull"`
+	StartDate              time.Time         `bun:"start_date,nullzero"`
+	EndDate                time.Time         `bun:"end_date,nullzero"`
+	CreateDate             time.Time         `bun:"create_date,nullzero"`
+	AZ                     string            `bun:"az,notnull"`
+	AzID                   string            `bun:"az_id,notnull"`
+	RegionName             string            `bun:"region_name,notnull"`
+	Region                 *Region           `bun:"rel:has-one,join:region_name=name,join:account_id=account_id"`
+	AvailabilityZone       *AvailabilityZone `bun:"rel:has-one,join:az_id=zone_id,join:account_id=account_id"`
+}
+
+// CapacityReservationToRegion represents a link table connecting the
+// [CapacityReservation] with [Region].
+type CapacityReservationToRegion struct {
+	bun.BaseModel `bun:"table:l_aws_capacity_reservation_to_region"`
+	coremodels.Model
+
+	CapacityReservationID uuid.UUID `bun:"cr_id,notnull,type:uuid,unique:l_aws_capacity_reservation_to_region_key"`
+	RegionID              uuid.UUID `bun:"region_id,notnull,type:uuid,unique:l_aws_capacity_reservation_to_region_key"`
+}
+
+// CapacityReservationToAZ represents a link table connecting the
+// [CapacityReservation] with [AvailabilityZone].
+type CapacityReservationToAZ struct {
+	bun.BaseModel `bun:"table:l_aws_capacity_reservation_to_az"`
+	coremodels.Model
+
+	CapacityReservationID uuid.UUID `bun:"cr_id,notnull,type:uuid,unique:l_aws_capacity_reservation_to_az_key"`
+	AvailabilityZoneID    uuid.UUID `bun:"az_id,notnull,type:uuid,unique:l_aws_capacity_reservation_to_az_key"`
+}
+
+// SpotInstanceRequest represents an AWS EC2 Spot Instance request
+type SpotInstanceRequest struct {
+	bun.BaseModel `bun:"table:aws_spot_instance_request"`
+	coremodels.Model
+
+	RequestID          string            `bun:"request_id,notnull,unique:aws_spot_instance_request_key"`
+	AccountID          string            `bun:"account_id,notnull,unique:aws_spot_instance_request_key"`
+	Type               string            `bun:"type,notnull"`
+	State              string            `bun:"state,notnull"`
+	StatusCode         string            `bun:"status_code,notnull"`
+	InstanceID         string            `bun:"instance_id,notnull"`
+	InstanceType       string            `bun:"instance_type,notnull"`
+	ProductDescription string            `bun:"product_description,notnull"`
+	SpotPrice          string            `bun:"spot_price,notnull"`
+	ValidFrom          time.Time         `bun:"valid_from,nullzero"`
+	ValidUntil         time.Time         `bun:"valid_until,nullzero"`
+	CreateTime         time.Time         `bun:"create_time,nullzero"`
+	AZ                 string            `bun:"az,notnull"`
+	RegionName         string            `bun:"region_name,notnull"`
+	Region             *Region           `bun:"rel:has-one,join:region_name=name,join:account_id=account_id"`
+	AvailabilityZone   *AvailabilityZone `bun:"rel:has-one,join:az=name,join:account_id=account_id"`
+}
+
+// SpotInstanceRequestToRegion represents a link table connecting the
+// [SpotInstanceRequest] with [Region].
+type SpotInstanceRequestToRegion struct {
+	bun.BaseModel `bun:"table:l_aws_spot_instance_request_to_region"`
+	coremodels.Model
+
+	SpotInstanceRequestID uuid.UUID `bun:"sir_id,notnull,type:uuid,unique:l_aws_spot_instance_request_to_region_key"`
+	RegionID              uuid.UUID `bun:"region_id,notnull,type:uuid,unique:l_aws_spot_instance_request_to_region_key"`
+}
+
+// SpotInstanceRequestToAZ represents a link table connecting the
+// [SpotInstanceRequest] with [AvailabilityZone].
+type SpotInstanceRequestToAZ struct {
+	bun.BaseModel `bun:"table:l_aws_spot_instance_request_to_az"`
+	coremodels.Model
+
+	SpotInstanceRequestID uuid.UUID `bun:"sir_id,notnull,type:uuid,unique:l_aws_spot_instance_request_to_az_key"`
+	AvailabilityZoneID    uuid.UUID `bun:"az_id,notnull,type:uuid,unique:l_aws_spot_instance_request_to_az_key"`
+}
+
+// ServiceQuota represents an AWS Service Quota along with its current usage
+type ServiceQuota struct {
+	bun.BaseModel `bun:"table:aws_service_quota"`
+	coremodels.Model
+
+	AccountID   string  `bun:"account_id,notnull,unique:aws_service_quota_key"`
+	RegionName  string  `bun:"region_name,notnull,unique:aws_service_quota_key"`
+	ServiceCode string  `bun:"service_code,notnull,unique:aws_service_quota_key"`
+	QuotaCode   string  `bun:"quota_code,notnull,unique:aws_service_quota_key"`
+	ServiceName string  `bun:"service_name,notnull"`
+	QuotaName   string  `bun:"quota_name,notnull"`
+	Value       float64 `bun:"quota_value,notnull"`
+	Unit        string  `bun:"unit,notnull"`
+	Adjustable  bool    `bun:"adjustable,notnull"`
+	GlobalQuota bool    `bun:"global_quota,notnull"`
+
+	// Usage specifies the current usage of the quota as reported by
+	// CloudWatch. It is NULL for quotas, which do not provide a usage
+	// metric.
+	Usage  *float64 `bun:"usage"`
+	Region *Region  `bun:"rel:has-one,join:region_name=name,join:account_id=account_id"`
+}
+
+// ServiceQuotaToRegion represents a link table connecting the [ServiceQuota]
+// with [Region].
+type ServiceQuotaToRegion struct {
+	bun.BaseModel `bun:"table:l_aws_service_quota_to_region"`
+	coremodels.Model
+
+	ServiceQuotaID uuid.UUID `bun:"sq_id,notnull,type:uuid,unique:l_aws_service_quota_to_region_key"`
+	RegionID       uuid.UUID `bun:"region_id,notnull,type:uuid,unique:l_aws_service_quota_to_region_key"`
+}
+
+// LoadBalancerCertificate represents a TLS certificate attached to a listener
+// of an AWS Elastic Load Balancer.
+type LoadBalancerCertificate struct {
+	bun.BaseModel `bun:"table:aws_lb_certificate"`
+	coremodels.Model
+
+	DNSName          string `bun:"dns_name,notnull,unique:aws_lb_certificate_key"`
+	AccountID        string `bun:"account_id,notnull,unique:aws_lb_certificate_key"`
+	ListenerPort     int32  `bun:"listener_port,notnull,unique:aws_lb_certificate_key"`
+	CertificateARN   string `bun:"certificate_arn,notnull,unique:aws_lb_certificate_key"`
+	LoadBalancerName string `bun:"load_balancer_name,notnull"`
+	RegionName       string `bun:"region_name,notnull"`
+	Protocol         string `bun:"protocol,notnull"`
+	IsDefault        bool   `bun:"is_default,notnull"`
+
+	// The following fields are provided by Certificate Manager and are
+	// empty for certificates, which are not managed by it, e.g. IAM
+	// server certificates.
+	Subject                 string        `bun:"subject,nullzero"`
+	SubjectAlternativeNames []string      `bun:"subject_alternative_names,array,nullzero"`
+	NotAfter                time.Time     `bun:"not_after,nullzero"`
+	Status                  string        `bun:"status,nullzero"`
+	LoadBalancer            *LoadBalancer `bun:"rel:has-one,join:dns_name=dns_name,join:account_id=account_id"`
+}
+
+// LoadBalancerListener represents a listener of an AWS Elastic Load Balancer.
+type LoadBalancerListener struct {
+	bun.BaseModel `bun:"table:aws_lb_listener"`
+	coremodels.Model
+
+	DNSName          string `bun:"dns_name,notnull,unique:aws_lb_listener_key"`
+	AccountID        string `bun:"account_id,notnull,unique:aws_lb_listener_key"`
+	Port             int32  `bun:"port,notnull,unique:aws_lb_listener_key"`
+	LoadBalancerName string `bun:"load_balancer_name,notnull"`
+	RegionName       string `bun:"region_name,notnull"`
+	Protocol         string `bun:"protocol,notnull"`
+
+	// ListenerARN and TargetGroupARNs are available only for v2 Load
+	// Balancers. TargetGroupARNs specifies the target groups, to which
+	// the default actions of the listener forward.
+	ListenerARN     string   `bun:"listener_arn,nullzero"`
+	TargetGroupARNs []string `bun:"target_group_arns,array,nullzero"`
+
+	// InstancePort and InstanceProtocol are available only for v1 Load
+	// Balancers, which forward to the registered instances directly.
+	InstancePort     int32         `bun:"instance_port,nullzero"`
+	InstanceProtocol string        `bun:"instance_protocol,nullzero"`
+	LoadBalancer     *LoadBalancer `bun:"rel:has-one,join:dns_name=dns_name,join:account_id=account_id"`
+}
+
+// TargetGroup represents a target group of AWS Elastic Load Balancers v2.
+type TargetGroup struct {
+	bun.BaseModel `bun:"table:aws_target_group"`
+	coremodels.Model
+
+	TargetGroupARN      string   `bun:"target_group_arn,notnull,unique:aws_target_group_key"`
+	AccountID           string   `bun:"account_id,notnull,unique:aws_target_group_key"`
+	Name                string   `bun:"name,notnull"`
+	RegionName          string   `bun:"region_name,notnull"`
+	TargetType          string   `bun:"target_type,notnull"`
+	Protocol            string   `bun:"protocol,nullzero"`
+	Port                int32    `bun:"port,nullzero"`
+	VpcID               string   `bun:"vpc_id,nullzero"`
+	HealthCheckProtocol string   `bun:"health_check_protocol,nullzero"`
+	HealthCheckPath     string   `bun:"health_check_path,nullzero"`
+	LoadBalancerARNs    []string `bun:"load_balancer_arns,array,nullzero"`
+	VPC                 *VPC     `bun:"rel:has-one,join:vpc_id=vpc_id,join:account_id=account_id"`
+}
+
+// LoadBalancerTarget represents a target of an AWS Elastic Load Balancer along
+// with its health. Targets of v1 Load Balancers are the registered instances,
+// and have an empty target group ARN.
+type LoadBalancerTarget struct {
+	bun.BaseModel `bun:"table:aws_lb_target"`
+	coremodels.Model
+
+	DNSName        string `bun:"dns_name,notnull,unique:aws_lb_target_key"`
+	AccountID      string `bun:"account_id,notnull,unique:aws_lb_target_key"`
+	TargetGroupARN string `bun:"target_group_arn,notnull,unique:aws_lb_target_key"`
+	TargetID       string `bun:"target_id,notnull,unique:aws_lb_target_key"`
+	Port           int32  `bun:"port,notnull,unique:aws_lb_target_key"`
+	RegionName     string `bun:"region_name,notnull"`
+
+	// TargetType specifies the type of the target, i.e. `instance', `ip',
+	// `lambda' or `alb'.
+	TargetType   string        `bun:"target_type,notnull"`
+	AZ           string        `bun:"az,nullzero"`
+	State        string        `bun:"state,notnull"`
+	Reason       string        `bun:"reason,nullzero"`
+	Description  string        `bun:"description,nullzero"`
+	LoadBalancer *LoadBalancer `bun:"rel:has-one,join:dns_name=dns_name,join:account_id=account_id"`
+	TargetGroup  *TargetGroup  `bun:"rel:has-one,join:target_group_arn=target_group_arn,join:account_id=account_id"`
+	Instance     *Instance     `bun:"rel:has-one,join:target_id=instance_id,join:account_id=account_id"`
+}
+
+// LoadBalancerToInstance represents a link table connecting the
+// [LoadBalancer] with the [Instance] targets behind it.
+type LoadBalancerToInstance struct {
+	bun.BaseModel `bun:"table:l_aws_lb_to_instance"`
+	coremodels.Model
+
+	LoadBalancerID uuid.UUID `bun:"lb_id,notnull,type:uuid,unique:l_aws_lb_to_instance_key"`
+	InstanceID     uuid.UUID `bun:"instance_id,notnull,type:uuid,unique:l_aws_lb_to_instance_key"`
+}
+
+// accountLinks maps the models, which reference an AWS account, to the
+// column holding the reference.
+var accountLinks = map[string]string{
+	RegionModelName:                  "account_id",
+	AvailabilityZoneModelName:        "account_id",
+	VPCModelName:                     "account_id",
+	SubnetModelName:                  "account_id",
+	InstanceModelName:                "account_id",
+	ImageModelName:                   "account_id",
+	LoadBalancerModelName:            "account_id",
+	BucketModelName:                  "account_id",
+	NetworkInterfaceModelName:        "account_id",
+	HostedZoneModelName:              "account_id",
+	ResourceRecordModelName:          "account_id",
+	DHCPOptionSetModelName:           "account_id",
+	CapacityReservationModelName:     "account_id",
+	SpotInstanceRequestModelName:     "account_id",
+	ServiceQuotaModelName:            "account_id",
+	LoadBalancerCertificateModelName: "account_id",
+	LoadBalancerListenerModelName:    "account_id",
+	TargetGroupModelName:             "account_id",
+	LoadBalancerTargetModelName:      "account_id",
+}
+
+// shootResources specifies the resolvers, which map the models to the
+// Gardener Shoots owning them.
+var shootResources = map[string]registry.ShootResource{
+	"aws:instance:machine-provider-id": {
+		ModelName:  InstanceModelName,
+		Kind:       registry.ShootResourceKindInstance,
+		Method:     registry.ShootResourceMethodProviderID,
+		Confidence: registry.ShootResourceConfidenceHigh,
+		Query: `SELECT s.id AS shoot_id, i.id AS resource_id FROM g_machine AS m
+INNER JOIN g_shoot AS s ON s.technical_id = m.namespace AND s.landscape = m.landscape
+INNER JOIN aws_instance AS i ON i.instance_id = substring(m.provider_id from '[^/]+$')
+WHERE m.provider_id LIKE 'aws://%'`,
+	},
+	"aws:loadbalancer:vpc-technical-id": {
+		ModelName:  LoadBalancerModelName,
+		Kind:       registry.ShootResourceKindLoadBalancer,
+		Method:     registry.ShootResourceMethodTechnicalID,
+		Confidence: registry.ShootResourceConfidenceMedium,
+		Query: `SELECT s.id AS shoot_id, lb.id AS resource_id FROM aws_loadbalancer AS lb
+INNER JOIN aws_vpc AS v ON v.vpc_id = lb.vpc_id AND v.account_id = lb.account_id
+INNER JOIN aux_account_landscape AS al ON al.provider = 'aws' AND al.account_id = v.account_id
+INNER JOIN g_shoot AS s ON s.technical_id = v.name AND s.landscape = al.landscape`,
+	},
+	"aws:vpc:name-technical-id": {
+		ModelName:  VPCModelName,
+		Kind:       registry.ShootResourceKindNetwork,
+		Method:     registry.ShootResourceMethodTechnicalID,
+		Confidence: registry.ShootResourceConfidenceMedium,
+		Query: `SELECT s.id AS shoot_id, v.id AS resource_id FROM aws_vpc AS v
+INNER JOIN aux_account_landscape AS al ON al.provider = 'aws' AND al.account_id = v.account_id
+INNER JOIN g_shoot AS s ON s.technical_id = v.name AND s.landscape = al.landscape`,
+	},
+}
+
+// driftChecks specifies the checks, which compare the desired worker
+// configuration of Gardener Machines against the actual AWS resources.
+var driftChecks = map[string]registry.DriftCheck{
+	"aws:instance:machine-type": {
+		ModelName: InstanceModelName,
+		Attribute: registry.DriftAttributeMachineType,
+		Query: `SELECT s.id AS shoot_id, i.id AS resource_id, mc.machine_type AS desired, i.instance_type AS actual
+FROM g_machine AS m
+INNER JOIN g_shoot AS s ON s.technical_id = m.namespace AND s.landscape = m.landscape
+INNER JOIN g_machine_class AS mc ON mc.name = m.machine_class AND mc.namespace = m.namespace AND mc.landscape = m.landscape
+INNER JOIN aws_instance AS i ON i.instance_id = substring(m.provider_id from '[^/]+$')
+WHERE m.provider_id LIKE 'aws://%' AND i.instance_type <> mc.machine_type`,
+	},
+	"aws:instance:image": {
+		ModelName: InstanceModelName,
+		Attribute: registry.DriftAttributeImage,
+		Query: `SELECT s.id AS shoot_id, i.id AS resource_id, mc.image AS desired, i.image_id AS actual
+FROM g_machine AS m
+INNER JOIN g_shoot AS s ON s.technical_id = m.namespace AND s.landscape = m.landscape
+INNER JOIN g_machine_class AS mc ON mc.name = m.machine_class AND mc.namespace = m.namespace AND mc.landscape = m.landscape
+INNER JOIN aws_instance AS i ON i.instance_id = substring(m.provider_id from '[^/]+$')
+WHERE m.provider_id LIKE 'aws://%' AND i.image_id <> mc.image`,
+	},
+}
+
 // init registers the models with the [registry.ModelRegistry]
 func init() {
 	for k, v := range models {
 		registry.ModelRegistry.MustRegister(k, v)
 	}
+
+	registry.AccountSourceRegistry.MustRegister("aws", registry.AccountSource{
+		ModelName:  RegionModelName,
+		IDColumn:   "account_id",
+		NameColumn: "account_id",
+	})
+
+	for k, v := range accountLinks {
+		registry.AccountLinkRegistry.MustRegister(k, registry.AccountLink{
+			Provider: "aws",
+			Column:   v,
+		})
+	}
+
+	for k, v := range shootResources {
+		registry.ShootResourceRegistry.MustRegister(k, v)
+	}
+
+	for k, v := range driftChecks {
+		registry.DriftCheckRegistry.MustRegister(k, v)
+	}
 }
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/gardener/inventory/pkg/aws/models"
+	awsclients "github.com/gardener/inventory/pkg/clients/aws"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// taskPayload pairs the name of a task with its payload.
+type taskPayload struct {
+	name    string
+	payload any
+}
+
+// newAccountCollectTasks returns the tasks for collecting the AWS resources of
+// the given account. Regional resources are collected from the regions of the
+// account, which are known in the database. If no regions are known yet, then
+// only the regions and the global resources of the account are collected.
+func newAccountCollectTasks(ctx context.Context, accountID string) ([]*asynq.Task, error) {
+	if !awsclients.EC2Clientset.Exists(accountID) {
+		return nil, nil
+	}
+
+	regions := make([]models.Region, 0)
+	err := db.DB.NewSelect().
+		Model(&regions).
+		Where("account_id = ?", accountID).
+		Scan(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get regions: %w", err)
+	}
+
+	payloads := []taskPayload{
+		{TaskCollectRegions, CollectRegionsPayload{AccountID: accountID}},
+		{TaskCollectBuckets, CollectBucketsPayload{AccountID: accountID}},
+		{TaskCollectHostedZones, CollectHostedZonesPayload{AccountID: accountID}},
+	}
+
+	for _, r := range regions {
+		regional := []taskPayload{
+			{TaskCollectAvailabilityZones, CollectAvailabilityZonesPayload{Region: r.Name, AccountID: accountID}},
+			{TaskCollectVPCs, CollectVPCsPayload{Region: r.Name, AccountID: accountID}},
+			{TaskCollectSubnets, CollectSubnetsPayload{Region: r.Name, AccountID: accountID}},
+			{TaskCollectInstances, CollectInstancesPayload{Region: r.Name, AccountID: accountID}},
+			{TaskCollectImages, CollectImagesPayload{Region: r.Name, AccountID: accountID, Owners: []string{accountID}}},
+			{TaskCollectLoadBalancers, CollectLoadBalancersPayload{Region: r.Name, AccountID: accountID}},
+			{TaskCollectNetworkInterfaces, CollectNetworkInterfacesPayload{Region: r.Name, AccountID: accountID}},
+			{TaskCollectDHCPOptionSets, CollectDHCPOptionSetsPayload{Region: r.Name, AccountID: accountID}},
+			{TaskCollectCapacityReservations, CollectCapacityReservationsPayload{Region: r.Name, AccountID: accountID}},
+			{TaskCollectSpotInstanceRequests, CollectSpotInstanceRequestsPayload{Region: r.Name, AccountID: accountID}},
+			{TaskCollectLoadBalancerCertificates, CollectLoadBalancerCertificatesPayload{Region: r.Name, AccountID: accountID}},
+			{TaskCollectLoadBalancerTargets, CollectLoadBalancerTargetsPayload{Region: r.Name, AccountID: accountID}},
+		}
+		payloads = append(payloads, regional...)
+
+		for _, serviceCode := range ServiceQuotaServiceCodes {
+			payload := CollectServiceQuotasPayload{Region: r.Name, AccountID: accountID, ServiceCode: serviceCode}
+			payloads = append(payloads, taskPayload{TaskCollectServiceQuotas, payload})
+		}
+	}
+
+	items := make([]*asynq.Task, 0, len(payloads))
+	for _, item := range payloads {
+		task, err := asynqutils.NewTask(item.name, item.payload)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, task)
+	}
+
+	return items, nil
+}
+
+func init() {
+	registry.AccountCollectorRegistry.MustRegister("aws", registry.AccountCollector{
+		NewTasks:  newAccountCollectTasks,
+		LinkTasks: []string{TaskLinkAll},
+	})
+}
@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -33,11 +34,11 @@ const (
 // AWS AZs.
 type CollectAvailabilityZonesPayload struct {
 	// Region is the region from which to collect.
-	Region string `json:"region" yaml:"region"`
+	Region string `json:"region" yaml:"region" desc:"The region from which to collect" example:"eu-west-1"`
 
 	// AccountID specifies the AWS Account ID, which is associated with a
 	// registered client.
-	AccountID string `json:"account_id" yaml:"account_id"`
+	AccountID string `json:"account_id" yaml:"account_id" desc:"The AWS Account ID, which is associated with a registered client" example:"123456789012"`
 }
 
 // NewCollectAvailabilityZonesTask creates a new [asynq.Task] for collecting AWS
@@ -222,7 +223,18 @@ func enqueueCollectAvailabilityZones(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectAvailabilityZones, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"region", r.Name,
+				"account_id", r.AccountID,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
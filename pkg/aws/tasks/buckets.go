@@ -7,9 +7,11 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hibiken/asynq"
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -41,7 +43,7 @@ func NewCollectBucketsTask() *asynq.Task {
 type CollectBucketsPayload struct {
 	// AccountID specifies the AWS Account ID, which is associated with a
 	// registered client to use for collecting.
-	AccountID string `json:"account_id" yaml:"account_id"`
+	AccountID string `json:"account_id" yaml:"account_id" desc:"The AWS Account ID, which is associated with a registered client to use for collecting" example:"123456789012"`
 }
 
 // HandleCollectBucketsTask handles the collection of AWS S3 Buckets.
@@ -90,7 +92,17 @@ func enqueueCollectBuckets(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectBuckets, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"account_id", accountID,
+			)
+
+			return registry.ErrContinue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -172,6 +184,7 @@ func collectBuckets(ctx context.Context, payload CollectBucketsPayload) error {
 			CreationDate: ptr.Value(bucket.CreationDate, time.Time{}),
 			RegionName:   region,
 		}
+		getBucketConfiguration(ctx, client.Client, &item)
 		buckets = append(buckets, item)
 	}
 
@@ -184,6 +197,17 @@ func collectBuckets(ctx context.Context, payload CollectBucketsPayload) error {
 		On("CONFLICT (name, account_id) DO UPDATE").
 		Set("creation_date = EXCLUDED.creation_date").
 		Set("region_name = EXCLUDED.region_name").
+		Set("encryption_algorithm = EXCLUDED.encryption_algorithm").
+		Set("kms_key_id = EXCLUDED.kms_key_id").
+		Set("bucket_key_enabled = EXCLUDED.bucket_key_enabled").
+		Set("versioning_status = EXCLUDED.versioning_status").
+		Set("mfa_delete_enabled = EXCLUDED.mfa_delete_enabled").
+		Set("block_public_acls = EXCLUDED.block_public_acls").
+		Set("ignore_public_acls = EXCLUDED.ignore_public_acls").
+		Set("block_public_policy = EXCLUDED.block_public_policy").
+		Set("restrict_public_buckets = EXCLUDED.restrict_public_buckets").
+		Set("lifecycle_rules = EXCLUDED.lifecycle_rules").
+		Set("lifecycle_rules_enabled = EXCLUDED.lifecycle_rules_enabled").
 		Set("updated_at = EXCLUDED.updated_at").
 		Returning("id").
 		Exec(ctx)
@@ -227,3 +251,79 @@ func collectBuckets(ctx context.Context, payload CollectBucketsPayload) error {
 
 	return nil
 }
+
+// getBucketConfiguration populates the encryption, versioning, public access
+// block and lifecycle configuration of the given S3 bucket. Missing
+// configurations are not treated as errors, and failures to retrieve a
+// configuration are logged, so that the bucket itself is still collected.
+func getBucketConfiguration(ctx context.Context, client *s3.Client, item *models.Bucket) {
+	logger := asynqutils.GetLogger(ctx)
+	bucket := ptr.To(item.Name)
+
+	// The configuration API calls must be sent to the region of the
+	// bucket.
+	withRegion := func(o *s3.Options) {
+		o.Region = item.RegionName
+	}
+
+	logError := func(msg string, err error) {
+		logger.Warn(
+			msg,
+			"account_id", item.AccountID,
+			"bucket", item.Name,
+			"reason", err,
+		)
+	}
+
+	encryption, err := client.GetBucketEncryption(ctx, &s3.GetBucketEncryptionInput{Bucket: bucket}, withRegion)
+	switch {
+	case awsutils.IsErrorCode(err, "ServerSideEncryptionConfigurationNotFoundError"):
+		// Not configured
+	case err != nil:
+		logError("could not get bucket encryption", err)
+	case encryption.ServerSideEncryptionConfiguration != nil && len(encryption.ServerSideEncryptionConfiguration.Rules) > 0:
+		rule := encryption.ServerSideEncryptionConfiguration.Rules[0]
+		if rule.ApplyServerSideEncryptionByDefault != nil {
+			item.EncryptionAlgorithm = string(rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm)
+			item.KMSKeyID = ptr.StringFromPointer(rule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID)
+		}
+		item.BucketKeyEnabled = ptr.Value(rule.BucketKeyEnabled, false)
+	}
+
+	versioning, err := client.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{Bucket: bucket}, withRegion)
+	if err != nil {
+		logError("could not get bucket versioning", err)
+	} else {
+		item.VersioningStatus = string(versioning.Status)
+		item.MFADeleteEnabled = versioning.MFADelete == s3types.MFADeleteStatusEnabled
+	}
+
+	publicAccess, err := client.GetPublicAccessBlock(ctx, &s3.GetPublicAccessBlockInput{Bucket: bucket}, withRegion)
+	switch {
+	case awsutils.IsErrorCode(err, "NoSuchPublicAccessBlockConfiguration"):
+		// Not configured
+	case err != nil:
+		logError("could not get bucket public access block", err)
+	case publicAccess.PublicAccessBlockConfiguration != nil:
+		config := publicAccess.PublicAccessBlockConfiguration
+		item.BlockPublicACLs = ptr.Value(config.BlockPublicAcls, false)
+		item.IgnorePublicACLs = ptr.Value(config.IgnorePublicAcls, false)
+		item.BlockPublicPolicy = ptr.Value(config.BlockPublicPolicy, false)
+		item.RestrictPublicBuckets = ptr.Value(config.RestrictPublicBuckets, false)
+	}
+
+	lifecycle, err := client.GetBucketLifecycleConfiguration(ctx, &s3.GetBucketLifecycleConfigurationInput{Bucket: bucket}, withRegion)
+	switch {
+	case awsutils.IsErrorCode(err, "NoSuchLifecycleConfiguration"):
+		// Not configured
+	case err != nil:
+		logError("could not get bucket lifecycle configuration", err)
+	default:
+		item.LifecycleRules = len(lifecycle.Rules)
+		for _, rule := range lifecycle.Rules {
+			if rule.Status == s3types.ExpirationStatusEnabled {
+				item.LifecycleRulesEnabled++
+			}
+		}
+	}
+}
@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gardener/inventory/pkg/aws/constants"
+	"github.com/gardener/inventory/pkg/aws/models"
+	awsutils "github.com/gardener/inventory/pkg/aws/utils"
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	awsclients "github.com/gardener/inventory/pkg/clients/aws"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/ptr"
+)
+
+const (
+	// TaskCollectCapacityReservations is the name of the task for
+	// collecting AWS EC2 Capacity Reservations.
+	TaskCollectCapacityReservations = "aws:task:collect-capacity-reservations"
+)
+
+// CollectCapacityReservationsPayload is the payload, which is used for
+// collecting AWS EC2 Capacity Reservations.
+type CollectCapacityReservationsPayload struct {
+	// Region specifies the region from which to collect.
+	Region string `json:"region" yaml:"region" desc:"The region from which to collect" example:"eu-west-1"`
+
+	// AccountID specifies the AWS Account ID, which is associated with a
+	// registered client.
+	AccountID string `json:"account_id" yaml:"account_id" desc:"The AWS Account ID, which is associated with a registered client" example:"123456789012"`
+}
+
+// NewCollectCapacityReservationsTask creates a new [asynq.Task] for collecting
+// AWS EC2 Capacity Reservations without specifying a payload.
+func NewCollectCapacityReservationsTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectCapacityReservations, nil)
+}
+
+// HandleCollectCapacityReservationsTask handles the task for collecting AWS
+// EC2 Capacity Reservations.
+func HandleCollectCapacityReservationsTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we enqueue tasks for
+	// collecting capacity reservations for all known regions.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectCapacityReservations(ctx)
+	}
+
+	var payload CollectCapacityReservationsPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.AccountID == "" {
+		return asynqutils.SkipRetry(ErrNoAccountID)
+	}
+
+	if payload.Region == "" {
+		return asynqutils.SkipRetry(ErrNoRegion)
+	}
+
+	return collectCapacityReservations(ctx, payload)
+}
+
+// enqueueCollectCapacityReservations enqueues tasks for collecting AWS
+// capacity reservations from all known regions by creating payload with the respective
+// region and account id.
+func enqueueCollectCapacityReservations(ctx context.Context) error {
+	regions, err := awsutils.GetRegionsFromDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get regions: %w", err)
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	queue := asynqutils.GetQueueName(ctx)
+
+	// Enqueue task for each region
+	for _, r := range regions {
+		if !awsclients.EC2Clientset.Exists(r.AccountID) {
+			logger.Warn(
+				"AWS client not found",
+				"region", r.Name,
+				"account_id", r.AccountID,
+			)
+
+			continue
+		}
+
+		payload := CollectCapacityReservationsPayload{
+			Region:    r.Name,
+			AccountID: r.AccountID,
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for AWS capacity reservation",
+				"region", r.Name,
+				"account_id", r.AccountID,
+				"reason", err,
+			)
+
+			continue
+		}
+
+		task := asynq.NewTask(TaskCollectCapacityReservations, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"region", r.Name,
+				"account_id", r.AccountID,
+			)
+
+			continue
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"region", r.Name,
+				"account_id", r.AccountID,
+				"reason", err,
+			)
+
+			continue
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"region", r.Name,
+			"account_id", r.AccountID,
+		)
+	}
+
+	return nil
+}
+
+// collectCapacityReservations collects the AWS EC2 Capacity Reservations from
+// the specified payload region using the client associated with the specified
+// AccountID.
+func collectCapacityReservations(ctx context.Context, payload CollectCapacityReservationsPayload) error {
+	client, ok := awsclients.EC2Clientset.Get(payload.AccountID)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.AccountID))
+	}
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			capacityReservationsDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.AccountID,
+			payload.Region,
+		)
+		key := metrics.Key(TaskCollectCapacityReservations, payload.AccountID, payload.Region)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info(
+		"collecting AWS capacity reservations",
+		"region", payload.Region,
+		"account_id", payload.AccountID,
+	)
+
+	paginator := ec2.NewDescribeCapacityReservationsPaginator(
+		client.Client,
+		&ec2.DescribeCapacityReservationsInput{},
+		func(params *ec2.DescribeCapacityReservationsPaginatorOptions) {
+			params.Limit = int32(constants.PageSize)
+			params.StopOnDuplicateToken = true
+		},
+	)
+
+	// Fetch items from all pages
+	items := make([]types.CapacityReservation, 0)
+	for paginator.HasMorePages() {
+		metrics.IncPages(ctx)
+		page, err := paginator.NextPage(
+			ctx,
+			func(o *ec2.Options) {
+				o.Region = payload.Region
+			},
+		)
+
+		if err != nil {
+			logger.Error(
+				"could not describe capacity reservations",
+				"region", payload.Region,
+				"account_id", payload.AccountID,
+				"reason", err,
+			)
+
+			return awsutils.MaybeSkipRetry(err)
+		}
+		items = append(items, page.CapacityReservations...)
+	}
+
+	reservations := make([]models.CapacityReservation, 0, len(items))
+	for _, cr := range items {
+		if cr.CapacityReservationId == nil {
+			logger.Warn("empty capacity reservation id")
+
+			continue
+		}
+
+		item := models.CapacityReservation{
+			ReservationID:          ptr.StringFromPointer(cr.CapacityReservationId),
+			AccountID:              payload.AccountID,
+			ARN:                    ptr.StringFromPointer(cr.CapacityReservationArn),
+			OwnerID:                ptr.StringFromPointer(cr.OwnerId),
+			InstanceType:           ptr.StringFromPointer(cr.InstanceType),
+			InstancePlatform:       string(cr.InstancePlatform),
+			Tenancy:                string(cr.Tenancy),
+			State:                  string(cr.State),
+			TotalInstanceCount:     ptr.Value(cr.TotalInstanceCount, 0),
+			AvailableInstanceCount: ptr.Value(cr.AvailableInstanceCount, 0),
+			InstanceMatchCriteria:  string(cr.InstanceMatchCriteria),
+			EndDateType:            string(cr.EndDateType),
+			StartDate:              ptr.Value(cr.StartDate, time.Time{}),
+			EndDate:                ptr.Value(cr.EndDate, time.Time{}),
+			CreateDate:             ptr.Value(cr.CreateDate, time.Time{}),
+			AZ:                     ptr.StringFromPointer(cr.AvailabilityZone),
+			AzID:                   ptr.StringFromPointer(cr.AvailabilityZoneId),
+			RegionName:             payload.Region,
+		}
+		reservations = append(reservations, item)
+	}
+
+	if len(reservations) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&reservations).
+		On("CONFLICT (reservation_id, account_id) DO UPDATE").
+		Set("arn = EXCLUDED.arn").
+		Set("owner_id = EXCLUDED.owner_id").
+		Set("instance_type = EXCLUDED.instance_type").
+		Set("instance_platform = EXCLUDED.instance_platform").
+		Set("tenancy = EXCLUDED.tenancy").
+		Set("state = EXCLUDED.state").
+		Set("total_instance_count = EXCLUDED.total_instance_count").
+		Set("available_instance_count = EXCLUDED.available_instance_count").
+		Set("instance_match_criteria = EXCLUDED.instance_match_criteria").
+		Set("end_date_type = EXCLUDED.end_date_type").
+		Set("start_date = EXCLUDED.start_date").
+		Set("end_date = EXCLUDED.end_date").
+		Set("create_date = EXCLUDED.create_date").
+		Set("az = EXCLUDED.az").
+		Set("az_id = EXCLUDED.az_id").
+		Set("region_name = EXCLUDED.region_name").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert capacity reservations into db",
+			"region", payload.Region,
+			"account_id", payload.AccountID,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated AWS capacity reservations",
+		"region", payload.Region,
+		"account_id", payload.AccountID,
+		"count", count,
+	)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/gardener/inventory/pkg/aws/models"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// CredentialKindLoadBalancerCertificate is the kind of expiring credentials,
+// which represent the certificates attached to AWS load balancer listeners.
+const CredentialKindLoadBalancerCertificate = "aws_lb_certificate"
+
+// loadBalancerCertificateCredentials returns the collected certificates of AWS
+// load balancer listeners as [registry.ExpiringCredential] items. Certificates
+// attached to multiple listeners of the same load balancer are reported once.
+func loadBalancerCertificateCredentials(ctx context.Context) ([]registry.ExpiringCredential, error) {
+	var certs []models.LoadBalancerCertificate
+	err := db.DB.NewSelect().
+		Model(&certs).
+		Where("not_after IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[registry.ExpiringCredential]struct{})
+	items := make([]registry.ExpiringCredential, 0, len(certs))
+	for _, cert := range certs {
+		item := registry.ExpiringCredential{
+			Kind:      CredentialKindLoadBalancerCertificate,
+			Name:      cert.CertificateARN,
+			Source:    cert.LoadBalancerName,
+			ExpiresAt: cert.NotAfter,
+		}
+		if _, ok := seen[item]; ok {
+			continue
+		}
+		seen[item] = struct{}{}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func init() {
+	registry.ExpiringCredentialRegistry.MustRegister(CredentialKindLoadBalancerCertificate, loadBalancerCertificateCredentials)
+}
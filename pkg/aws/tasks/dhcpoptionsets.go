@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -32,11 +33,11 @@ const (
 // CollectDHCPOptionSetsPayload is the payload, which is used for collecting AWS DHCP option sets.
 type CollectDHCPOptionSetsPayload struct {
 	// Region specifies the region from which to collect.
-	Region string `json:"region" yaml:"region"`
+	Region string `json:"region" yaml:"region" desc:"The region from which to collect" example:"eu-west-1"`
 
 	// AccountID specifies the AWS Account ID, which is associated with a
 	// registered client.
-	AccountID string `json:"account_id" yaml:"account_id"`
+	AccountID string `json:"account_id" yaml:"account_id" desc:"The AWS Account ID, which is associated with a registered client" example:"123456789012"`
 }
 
 // NewCollectDHCPOptionSetsTask creates a new [asynq.Task] for collecting AWS DHCP option sets without
@@ -110,7 +111,18 @@ func enqueueCollectDHCPOptionSets(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectDHCPOptionSets, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"region", r.Name,
+				"account_id", r.AccountID,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -176,6 +188,7 @@ func collectDHCPOptionSets(ctx context.Context, payload CollectDHCPOptionSetsPay
 	// Fetch items from all pages
 	items := make([]types.DhcpOptions, 0)
 	for paginator.HasMorePages() {
+		metrics.IncPages(ctx)
 		page, err := paginator.NextPage(
 			ctx,
 			func(o *ec2.Options) {
@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/route53"
@@ -37,10 +38,10 @@ const (
 type CollectDNSRecordsPayload struct {
 	// AccountID specifies the AWS Account ID, which is associated with a
 	// registered client.
-	AccountID string `json:"account_id" yaml:"account_id"`
+	AccountID string `json:"account_id" yaml:"account_id" desc:"The AWS Account ID, which is associated with a registered client" example:"123456789012"`
 
 	// HostedZoneID specifies the hosted zone from which to collect DNS records.
-	HostedZoneID string `json:"hosted_zone_id" yaml:"hosted_zone_id"`
+	HostedZoneID string `json:"hosted_zone_id" yaml:"hosted_zone_id" desc:"The hosted zone from which to collect DNS records" example:"Z0123456789ABCDEFGHIJ"`
 }
 
 // NewCollectDNSRecordsTask creates a new [asynq.Task] for collecting AWS
@@ -114,7 +115,18 @@ func enqueueCollectDNSRecords(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectDNSRecords, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"account_id", hz.AccountID,
+				"hosted_zone_id", hz.HostedZoneID,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -189,6 +201,7 @@ func collectDNSRecords(ctx context.Context, payload CollectDNSRecordsPayload) er
 
 	recordSets := make([]types.ResourceRecordSet, 0)
 	for paginator.HasMorePages() {
+		metrics.IncPages(ctx)
 		page, err := paginator.NextPage(
 			ctx,
 		)
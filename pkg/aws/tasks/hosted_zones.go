@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/route53/types"
@@ -35,7 +36,7 @@ const (
 type CollectHostedZonesPayload struct {
 	// AccountID specifies the AWS Account ID, which is associated with a
 	// registered client.
-	AccountID string `json:"account_id" yaml:"account_id"`
+	AccountID string `json:"account_id" yaml:"account_id" desc:"The AWS Account ID, which is associated with a registered client" example:"123456789012"`
 }
 
 // NewCollectHostedZonesTask creates a new [asynq.Task] for collecting AWS
@@ -88,7 +89,17 @@ func enqueueCollectHostedZones(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectHostedZones, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"account_id", accountID,
+			)
+
+			return nil
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -154,6 +165,7 @@ func collectHostedZones(ctx context.Context, payload CollectHostedZonesPayload)
 	// Fetch items from all pages
 	items := make([]types.HostedZone, 0)
 	for paginator.HasMorePages() {
+		metrics.IncPages(ctx)
 		page, err := paginator.NextPage(
 			ctx,
 		)
@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -33,15 +34,15 @@ const (
 // CollectImagesPayload is the payload, which is used for collecting AWS AMIs.
 type CollectImagesPayload struct {
 	// Region specifies the region from which to collect.
-	Region string `json:"region" yaml:"region"`
+	Region string `json:"region" yaml:"region" desc:"The region from which to collect" example:"eu-west-1"`
 
 	// AccountID specifies the AWS Account ID, which is associated with a
 	// registered client.
-	AccountID string `json:"account_id" yaml:"account_id"`
+	AccountID string `json:"account_id" yaml:"account_id" desc:"The AWS Account ID, which is associated with a registered client" example:"123456789012"`
 
 	// Owners specifies owners of AMI images. Only images with the specified
 	// owners will be collected.
-	Owners []string `json:"owners" yaml:"owners"`
+	Owners []string `json:"owners" yaml:"owners" desc:"Owners of AMI images" example:"137112412989"`
 }
 
 // NewCollectImagesTask creates a new [asynq.Task] for collecting AWS AMIs
@@ -120,7 +121,18 @@ func enqueueCollectImages(ctx context.Context, payload CollectImagesPayload) err
 		}
 
 		task := asynq.NewTask(TaskCollectImages, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"region", r.Name,
+				"account_id", r.AccountID,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -189,6 +201,7 @@ func collectImages(ctx context.Context, payload CollectImagesPayload) error {
 	// Fetch items from all pages
 	items := make([]types.Image, 0)
 	for paginator.HasMorePages() {
+		metrics.IncPages(ctx)
 		page, err := paginator.NextPage(
 			ctx,
 			func(o *ec2.Options) {
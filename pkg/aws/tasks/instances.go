@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -36,11 +37,11 @@ const (
 // CollectInstancesPayload represents the payload for collecting EC2 Instances.
 type CollectInstancesPayload struct {
 	// Region specifies the region from which to collect.
-	Region string `json:"region" yaml:"region"`
+	Region string `json:"region" yaml:"region" desc:"The region from which to collect" example:"eu-west-1"`
 
 	// AccountID specifies the AWS Account ID, which is associated with a
 	// registered client.
-	AccountID string `json:"account_id" yaml:"account_id"`
+	AccountID string `json:"account_id" yaml:"account_id" desc:"The AWS Account ID, which is associated with a registered client" example:"123456789012"`
 }
 
 // NewCollectInstancesTask creates a new [asynq.Task] for collecting EC2
@@ -115,7 +116,18 @@ func enqueueCollectInstances(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectInstances, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"region", r.Name,
+				"account_id", r.AccountID,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -169,6 +181,7 @@ func collectInstances(ctx context.Context, payload CollectInstancesPayload) erro
 	// Fetch items from all pages
 	items := make([]types.Instance, 0)
 	for paginator.HasMorePages() {
+		metrics.IncPages(ctx)
 		page, err := paginator.NextPage(
 			ctx,
 			func(o *ec2.Options) {
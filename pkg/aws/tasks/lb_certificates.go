@@ -0,0 +1,452 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	v2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gardener/inventory/pkg/aws/constants"
+	"github.com/gardener/inventory/pkg/aws/models"
+	awsutils "github.com/gardener/inventory/pkg/aws/utils"
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	awsclients "github.com/gardener/inventory/pkg/clients/aws"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/ptr"
+)
+
+// TaskCollectLoadBalancerCertificates is the name of the task for collecting
+// the TLS certificates attached to AWS ELB listeners.
+const TaskCollectLoadBalancerCertificates = "aws:task:collect-lb-certificates"
+
+// CollectLoadBalancerCertificatesPayload is the payload, which is used for
+// collecting the TLS certificates attached to AWS ELB listeners.
+type CollectLoadBalancerCertificatesPayload struct {
+	// Region specifies the region from which to collect.
+	Region string `json:"region" yaml:"region" desc:"The region from which to collect" example:"eu-west-1"`
+
+	// AccountID specifies the AWS Account ID, which is associated with a
+	// registered client.
+	AccountID string `json:"account_id" yaml:"account_id" desc:"The AWS Account ID, which is associated with a registered client" example:"123456789012"`
+}
+
+// NewCollectLoadBalancerCertificatesTask creates a new [asynq.Task] for
+// collecting the TLS certificates attached to AWS ELB listeners, without
+// specifying a payload.
+func NewCollectLoadBalancerCertificatesTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectLoadBalancerCertificates, nil)
+}
+
+// HandleCollectLoadBalancerCertificatesTask handles the task for collecting
+// the TLS certificates attached to AWS ELB listeners.
+func HandleCollectLoadBalancerCertificatesTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we enqueue tasks for
+	// collecting certificates from all known regions and their respective
+	// accounts.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectLoadBalancerCertificates(ctx)
+	}
+
+	var payload CollectLoadBalancerCertificatesPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.AccountID == "" {
+		return asynqutils.SkipRetry(ErrNoAccountID)
+	}
+
+	if payload.Region == "" {
+		return asynqutils.SkipRetry(ErrNoRegion)
+	}
+
+	return collectLoadBalancerCertificates(ctx, payload)
+}
+
+// enqueueCollectLoadBalancerCertificates enqueues tasks for collecting the
+// TLS certificates attached to AWS ELB listeners from all known AWS Regions.
+func enqueueCollectLoadBalancerCertificates(ctx context.Context) error {
+	regions, err := awsutils.GetRegionsFromDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get regions: %w", err)
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	queue := asynqutils.GetQueueName(ctx)
+
+	for _, r := range regions {
+		payload := CollectLoadBalancerCertificatesPayload{
+			Region:    r.Name,
+			AccountID: r.AccountID,
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for AWS ELB certificates",
+				"region", r.Name,
+				"account_id", r.AccountID,
+				"reason", err,
+			)
+
+			continue
+		}
+
+		task := asynq.NewTask(TaskCollectLoadBalancerCertificates, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"region", r.Name,
+				"account_id", r.AccountID,
+			)
+
+			continue
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"region", r.Name,
+				"account_id", r.AccountID,
+				"reason", err,
+			)
+
+			continue
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"region", r.Name,
+			"account_id", r.AccountID,
+		)
+	}
+
+	return nil
+}
+
+// collectLoadBalancerCertificates collects the TLS certificates attached to
+// the AWS ELB listeners from the region specified in the payload.
+func collectLoadBalancerCertificates(ctx context.Context, payload CollectLoadBalancerCertificatesPayload) error {
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			lbCertificatesDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.AccountID,
+			payload.Region,
+		)
+		key := metrics.Key(TaskCollectLoadBalancerCertificates, payload.AccountID, payload.Region)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info(
+		"collecting AWS ELB certificates",
+		"region", payload.Region,
+		"account_id", payload.AccountID,
+	)
+
+	items := make([]models.LoadBalancerCertificate, 0)
+	if awsclients.ELBClientset.Exists(payload.AccountID) {
+		v1Items, err := getELBv1Certificates(ctx, payload)
+		if err != nil {
+			return err
+		}
+		items = append(items, v1Items...)
+	}
+
+	if awsclients.ELBv2Clientset.Exists(payload.AccountID) {
+		v2Items, err := getELBv2Certificates(ctx, payload)
+		if err != nil {
+			return err
+		}
+		items = append(items, v2Items...)
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	// Certificate Manager is optional, and provides the details of the
+	// certificates, e.g. subject and expiration.
+	if awsclients.ACMClientset.Exists(payload.AccountID) {
+		summaries, err := getACMCertificates(ctx, payload)
+		if err != nil {
+			return err
+		}
+
+		for i := range items {
+			summary, ok := summaries[items[i].CertificateARN]
+			if !ok {
+				continue
+			}
+			items[i].Subject = ptr.StringFromPointer(summary.DomainName)
+			items[i].SubjectAlternativeNames = summary.SubjectAlternativeNameSummaries
+			items[i].NotAfter = ptr.Value(summary.NotAfter, time.Time{})
+			items[i].Status = string(summary.Status)
+		}
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (dns_name, account_id, listener_port, certificate_arn) DO UPDATE").
+		Set("load_balancer_name = EXCLUDED.load_balancer_name").
+		Set("region_name = EXCLUDED.region_name").
+		Set("protocol = EXCLUDED.protocol").
+		Set("is_default = EXCLUDED.is_default").
+		Set("subject = EXCLUDED.subject").
+		Set("subject_alternative_names = EXCLUDED.subject_alternative_names").
+		Set("not_after = EXCLUDED.not_after").
+		Set("status = EXCLUDED.status").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert AWS ELB certificates into db",
+			"region", payload.Region,
+			"account_id", payload.AccountID,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated AWS ELB certificates",
+		"region", payload.Region,
+		"account_id", payload.AccountID,
+		"count", count,
+	)
+
+	return nil
+}
+
+// getELBv1Certificates returns the certificates attached to the listeners of
+// the ELB v1 (classic) load balancers.
+func getELBv1Certificates(ctx context.Context, payload CollectLoadBalancerCertificatesPayload) ([]models.LoadBalancerCertificate, error) {
+	client, ok := awsclients.ELBClientset.Get(payload.AccountID)
+	if !ok {
+		return nil, asynqutils.SkipRetry(ClientNotFound(payload.AccountID))
+	}
+
+	pageSize := int32(constants.PageSize)
+	paginator := elb.NewDescribeLoadBalancersPaginator(
+		client.Client,
+		&elb.DescribeLoadBalancersInput{PageSize: &pageSize},
+		func(params *elb.DescribeLoadBalancersPaginatorOptions) {
+			params.StopOnDuplicateToken = true
+		},
+	)
+
+	items := make([]models.LoadBalancerCertificate, 0)
+	for paginator.HasMorePages() {
+		metrics.IncPages(ctx)
+		page, err := paginator.NextPage(
+			ctx,
+			func(o *elb.Options) {
+				o.Region = payload.Region
+			},
+		)
+
+		if err != nil {
+			return nil, awsutils.MaybeSkipRetry(err)
+		}
+
+		for _, lb := range page.LoadBalancerDescriptions {
+			for _, ld := range lb.ListenerDescriptions {
+				if ld.Listener == nil || ld.Listener.SSLCertificateId == nil {
+					continue
+				}
+				item := models.LoadBalancerCertificate{
+					DNSName:          ptr.StringFromPointer(lb.DNSName),
+					AccountID:        payload.AccountID,
+					ListenerPort:     ld.Listener.LoadBalancerPort,
+					CertificateARN:   ptr.StringFromPointer(ld.Listener.SSLCertificateId),
+					LoadBalancerName: ptr.StringFromPointer(lb.LoadBalancerName),
+					RegionName:       payload.Region,
+					Protocol:         ptr.StringFromPointer(ld.Listener.Protocol),
+					IsDefault:        true,
+				}
+				items = append(items, item)
+			}
+		}
+	}
+
+	return items, nil
+}
+
+// getELBv2Certificates returns the certificates attached to the listeners of
+// the ELB v2 load balancers.
+func getELBv2Certificates(ctx context.Context, payload CollectLoadBalancerCertificatesPayload) ([]models.LoadBalancerCertificate, error) {
+	client, ok := awsclients.ELBv2Clientset.Get(payload.AccountID)
+	if !ok {
+		return nil, asynqutils.SkipRetry(ClientNotFound(payload.AccountID))
+	}
+
+	withRegion := func(o *elbv2.Options) {
+		o.Region = payload.Region
+	}
+
+	pageSize := int32(constants.PageSize)
+	paginator := elbv2.NewDescribeLoadBalancersPaginator(
+		client.Client,
+		&elbv2.DescribeLoadBalancersInput{PageSize: &pageSize},
+		func(params *elbv2.DescribeLoadBalancersPaginatorOptions) {
+			params.StopOnDuplicateToken = true
+		},
+	)
+
+	lbs := make([]v2types.LoadBalancer, 0)
+	for paginator.HasMorePages() {
+		metrics.IncPages(ctx)
+		page, err := paginator.NextPage(ctx, withRegion)
+		if err != nil {
+			return nil, awsutils.MaybeSkipRetry(err)
+		}
+		lbs = append(lbs, page.LoadBalancers...)
+	}
+
+	items := make([]models.LoadBalancerCertificate, 0)
+	for _, lb := range lbs {
+		listeners := elbv2.NewDescribeListenersPaginator(
+			client.Client,
+			&elbv2.DescribeListenersInput{
+				LoadBalancerArn: lb.LoadBalancerArn,
+				PageSize:        &pageSize,
+			},
+		)
+
+		for listeners.HasMorePages() {
+			metrics.IncPages(ctx)
+			page, err := listeners.NextPage(ctx, withRegion)
+			if err != nil {
+				return nil, awsutils.MaybeSkipRetry(err)
+			}
+
+			for _, listener := range page.Listeners {
+				// Only secure listeners have certificates
+				if len(listener.Certificates) == 0 {
+					continue
+				}
+
+				certs, err := getELBv2ListenerCertificates(ctx, client.Client, listener.ListenerArn, withRegion)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, cert := range certs {
+					item := models.LoadBalancerCertificate{
+						DNSName:          ptr.StringFromPointer(lb.DNSName),
+						AccountID:        payload.AccountID,
+						ListenerPort:     ptr.Value(listener.Port, 0),
+						CertificateARN:   ptr.StringFromPointer(cert.CertificateArn),
+						LoadBalancerName: ptr.StringFromPointer(lb.LoadBalancerName),
+						RegionName:       payload.Region,
+						Protocol:         string(listener.Protocol),
+						IsDefault:        ptr.Value(cert.IsDefault, false),
+					}
+					items = append(items, item)
+				}
+			}
+		}
+	}
+
+	return items, nil
+}
+
+// getELBv2ListenerCertificates returns the default and the additional
+// certificates of the given ELB v2 listener, each of them once.
+func getELBv2ListenerCertificates(ctx context.Context, client *elbv2.Client, listenerArn *string, optFns ...func(*elbv2.Options)) ([]v2types.Certificate, error) {
+	items := make([]v2types.Certificate, 0)
+	input := &elbv2.DescribeListenerCertificatesInput{
+		ListenerArn: listenerArn,
+	}
+
+	for {
+		out, err := client.DescribeListenerCertificates(ctx, input, optFns...)
+		if err != nil {
+			return nil, awsutils.MaybeSkipRetry(err)
+		}
+		items = append(items, out.Certificates...)
+
+		if out.NextMarker == nil {
+			break
+		}
+		input.Marker = out.NextMarker
+	}
+
+	return awsutils.DedupListenerCertificates(items), nil
+}
+
+// getACMCertificates returns the Certificate Manager certificates from the
+// region specified in the payload, keyed by ARN.
+func getACMCertificates(ctx context.Context, payload CollectLoadBalancerCertificatesPayload) (map[string]acmtypes.CertificateSummary, error) {
+	client, ok := awsclients.ACMClientset.Get(payload.AccountID)
+	if !ok {
+		return nil, asynqutils.SkipRetry(ClientNotFound(payload.AccountID))
+	}
+
+	// By default only RSA_2048 certificates are returned, so we need to
+	// include all key types explicitly.
+	paginator := acm.NewListCertificatesPaginator(
+		client.Client,
+		&acm.ListCertificatesInput{
+			Includes: &acmtypes.Filters{
+				KeyTypes: acmtypes.KeyAlgorithm("").Values(),
+			},
+		},
+		func(params *acm.ListCertificatesPaginatorOptions) {
+			params.StopOnDuplicateToken = true
+		},
+	)
+
+	result := make(map[string]acmtypes.CertificateSummary)
+	for paginator.HasMorePages() {
+		metrics.IncPages(ctx)
+		page, err := paginator.NextPage(
+			ctx,
+			func(o *acm.Options) {
+				o.Region = payload.Region
+			},
+		)
+
+		if err != nil {
+			return nil, awsutils.MaybeSkipRetry(err)
+		}
+
+		for _, summary := range page.CertificateSummaryList {
+			result[ptr.StringFromPointer(summary.CertificateArn)] = summary
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,602 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	v2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gardener/inventory/pkg/aws/constants"
+	"github.com/gardener/inventory/pkg/aws/models"
+	awsutils "github.com/gardener/inventory/pkg/aws/utils"
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	awsclients "github.com/gardener/inventory/pkg/clients/aws"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/ptr"
+)
+
+// TaskCollectLoadBalancerTargets is the name of the task for collecting the
+// listeners, target groups and targets of AWS ELBs.
+const TaskCollectLoadBalancerTargets = "aws:task:collect-lb-targets"
+
+// targetTypeInstance is the type of the targets of ELB v1 (classic) load
+// balancers, which are always EC2 instances.
+const targetTypeInstance = string(v2types.TargetTypeEnumInstance)
+
+// CollectLoadBalancerTargetsPayload is the payload, which is used for
+// collecting the listeners, target groups and targets of AWS ELBs.
+type CollectLoadBalancerTargetsPayload struct {
+	// Region specifies the region from which to collect.
+	Region string `json:"region" yaml:"region" desc:"The region from which to collect" example:"eu-west-1"`
+
+	// AccountID specifies the AWS Account ID, which is associated with a
+	// registered client.
+	AccountID string `json:"account_id" yaml:"account_id" desc:"The AWS Account ID, which is associated with a registered client" example:"123456789012"`
+}
+
+// loadBalancerTargets holds the listeners, target groups and targets of the
+// AWS ELBs collected from a region.
+type loadBalancerTargets struct {
+	listeners    []models.LoadBalancerListener
+	targetGroups []models.TargetGroup
+	targets      []models.LoadBalancerTarget
+}
+
+// NewCollectLoadBalancerTargetsTask creates a new [asynq.Task] for collecting
+// the listeners, target groups and targets of AWS ELBs, without specifying a
+// payload.
+func NewCollectLoadBalancerTargetsTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectLoadBalancerTargets, nil)
+}
+
+// HandleCollectLoadBalancerTargetsTask handles the task for collecting the
+// listeners, target groups and targets of AWS ELBs.
+func HandleCollectLoadBalancerTargetsTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we enqueue tasks for
+	// collecting the targets from all known regions and their respective
+	// accounts.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectLoadBalancerTargets(ctx)
+	}
+
+	var payload CollectLoadBalancerTargetsPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.AccountID == "" {
+		return asynqutils.SkipRetry(ErrNoAccountID)
+	}
+
+	if payload.Region == "" {
+		return asynqutils.SkipRetry(ErrNoRegion)
+	}
+
+	return collectLoadBalancerTargets(ctx, payload)
+}
+
+// enqueueCollectLoadBalancerTargets enqueues tasks for collecting the
+// listeners, target groups and targets of AWS ELBs from all known AWS Regions.
+func enqueueCollectLoadBalancerTargets(ctx context.Context) error {
+	regions, err := awsutils.GetRegionsFromDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get regions: %w", err)
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	queue := asynqutils.GetQueueName(ctx)
+
+	for _, r := range regions {
+		payload := CollectLoadBalancerTargetsPayload{
+			Region:    r.Name,
+			AccountID: r.AccountID,
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for AWS ELB targets",
+				"region", r.Name,
+				"account_id", r.AccountID,
+				"reason", err,
+			)
+
+			continue
+		}
+
+		task := asynq.NewTask(TaskCollectLoadBalancerTargets, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"region", r.Name,
+				"account_id", r.AccountID,
+			)
+
+			continue
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"region", r.Name,
+				"account_id", r.AccountID,
+				"reason", err,
+			)
+
+			continue
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"region", r.Name,
+			"account_id", r.AccountID,
+		)
+	}
+
+	return nil
+}
+
+// collectLoadBalancerTargets collects the listeners, target groups and targets
+// of the AWS ELBs from the region specified in the payload.
+func collectLoadBalancerTargets(ctx context.Context, payload CollectLoadBalancerTargetsPayload) error {
+	result := &loadBalancerTargets{}
+	defer func() {
+		stateCounts := make(map[string]int)
+		for _, target := range result.targets {
+			stateCounts[target.State]++
+		}
+		for state, count := range stateCounts {
+			metric := prometheus.MustNewConstMetric(
+				lbTargetsDesc,
+				prometheus.GaugeValue,
+				float64(count),
+				payload.AccountID,
+				payload.Region,
+				state,
+			)
+			key := metrics.Key(TaskCollectLoadBalancerTargets, payload.AccountID, payload.Region, state)
+			metrics.DefaultCollector.AddMetric(key, metric)
+		}
+	}()
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info(
+		"collecting AWS ELB targets",
+		"region", payload.Region,
+		"account_id", payload.AccountID,
+	)
+
+	if awsclients.ELBClientset.Exists(payload.AccountID) {
+		if err := getELBv1Targets(ctx, payload, result); err != nil {
+			return err
+		}
+	}
+
+	if awsclients.ELBv2Clientset.Exists(payload.AccountID) {
+		if err := getELBv2Targets(ctx, payload, result); err != nil {
+			return err
+		}
+	}
+
+	if err := upsertLoadBalancerListeners(ctx, payload, result.listeners); err != nil {
+		return err
+	}
+
+	if err := upsertTargetGroups(ctx, payload, result.targetGroups); err != nil {
+		return err
+	}
+
+	return upsertLoadBalancerTargets(ctx, payload, result.targets)
+}
+
+// getELBv1Targets adds the listeners and the registered instances of the ELB v1
+// (classic) load balancers to the given result.
+func getELBv1Targets(ctx context.Context, payload CollectLoadBalancerTargetsPayload, result *loadBalancerTargets) error {
+	client, ok := awsclients.ELBClientset.Get(payload.AccountID)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.AccountID))
+	}
+
+	withRegion := func(o *elb.Options) {
+		o.Region = payload.Region
+	}
+
+	pageSize := int32(constants.PageSize)
+	paginator := elb.NewDescribeLoadBalancersPaginator(
+		client.Client,
+		&elb.DescribeLoadBalancersInput{PageSize: &pageSize},
+		func(params *elb.DescribeLoadBalancersPaginatorOptions) {
+			params.StopOnDuplicateToken = true
+		},
+	)
+
+	for paginator.HasMorePages() {
+		metrics.IncPages(ctx)
+		page, err := paginator.NextPage(ctx, withRegion)
+		if err != nil {
+			return awsutils.MaybeSkipRetry(err)
+		}
+
+		for _, lb := range page.LoadBalancerDescriptions {
+			dnsName := ptr.StringFromPointer(lb.DNSName)
+			lbName := ptr.StringFromPointer(lb.LoadBalancerName)
+			for _, ld := range lb.ListenerDescriptions {
+				if ld.Listener == nil {
+					continue
+				}
+				item := models.LoadBalancerListener{
+					DNSName:          dnsName,
+					AccountID:        payload.AccountID,
+					Port:             ld.Listener.LoadBalancerPort,
+					LoadBalancerName: lbName,
+					RegionName:       payload.Region,
+					Protocol:         ptr.StringFromPointer(ld.Listener.Protocol),
+					InstancePort:     ptr.Value(ld.Listener.InstancePort, 0),
+					InstanceProtocol: ptr.StringFromPointer(ld.Listener.InstanceProtocol),
+				}
+				result.listeners = append(result.listeners, item)
+			}
+
+			// Classic load balancers have no target groups, and
+			// forward to the registered instances directly.
+			out, err := client.Client.DescribeInstanceHealth(
+				ctx,
+				&elb.DescribeInstanceHealthInput{LoadBalancerName: lb.LoadBalancerName},
+				withRegion,
+			)
+			if err != nil {
+				return awsutils.MaybeSkipRetry(err)
+			}
+
+			for _, state := range out.InstanceStates {
+				item := models.LoadBalancerTarget{
+					DNSName:     dnsName,
+					AccountID:   payload.AccountID,
+					TargetID:    ptr.StringFromPointer(state.InstanceId),
+					RegionName:  payload.Region,
+					TargetType:  targetTypeInstance,
+					State:       ptr.StringFromPointer(state.State),
+					Reason:      ptr.StringFromPointer(state.ReasonCode),
+					Description: ptr.StringFromPointer(state.Description),
+				}
+				result.targets = append(result.targets, item)
+			}
+		}
+	}
+
+	return nil
+}
+
+// getELBv2Targets adds the listeners, target groups and targets of the ELB v2
+// load balancers to the given result.
+func getELBv2Targets(ctx context.Context, payload CollectLoadBalancerTargetsPayload, result *loadBalancerTargets) error {
+	client, ok := awsclients.ELBv2Clientset.Get(payload.AccountID)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.AccountID))
+	}
+
+	withRegion := func(o *elbv2.Options) {
+		o.Region = payload.Region
+	}
+
+	pageSize := int32(constants.PageSize)
+	lbPaginator := elbv2.NewDescribeLoadBalancersPaginator(
+		client.Client,
+		&elbv2.DescribeLoadBalancersInput{PageSize: &pageSize},
+		func(params *elbv2.DescribeLoadBalancersPaginatorOptions) {
+			params.StopOnDuplicateToken = true
+		},
+	)
+
+	// Load balancers by ARN, which are referenced by the target groups
+	lbs := make(map[string]v2types.LoadBalancer)
+	for lbPaginator.HasMorePages() {
+		metrics.IncPages(ctx)
+		page, err := lbPaginator.NextPage(ctx, withRegion)
+		if err != nil {
+			return awsutils.MaybeSkipRetry(err)
+		}
+		for _, lb := range page.LoadBalancers {
+			lbs[ptr.StringFromPointer(lb.LoadBalancerArn)] = lb
+		}
+	}
+
+	for _, lb := range lbs {
+		listeners := elbv2.NewDescribeListenersPaginator(
+			client.Client,
+			&elbv2.DescribeListenersInput{
+				LoadBalancerArn: lb.LoadBalancerArn,
+				PageSize:        &pageSize,
+			},
+		)
+
+		for listeners.HasMorePages() {
+			metrics.IncPages(ctx)
+			page, err := listeners.NextPage(ctx, withRegion)
+			if err != nil {
+				return awsutils.MaybeSkipRetry(err)
+			}
+
+			for _, listener := range page.Listeners {
+				item := models.LoadBalancerListener{
+					DNSName:          ptr.StringFromPointer(lb.DNSName),
+					AccountID:        payload.AccountID,
+					Port:             ptr.Value(listener.Port, 0),
+					LoadBalancerName: ptr.StringFromPointer(lb.LoadBalancerName),
+					RegionName:       payload.Region,
+					Protocol:         string(listener.Protocol),
+					ListenerARN:      ptr.StringFromPointer(listener.ListenerArn),
+					TargetGroupARNs:  getForwardTargetGroupARNs(listener.DefaultActions),
+				}
+				result.listeners = append(result.listeners, item)
+			}
+		}
+	}
+
+	tgPaginator := elbv2.NewDescribeTargetGroupsPaginator(
+		client.Client,
+		&elbv2.DescribeTargetGroupsInput{PageSize: &pageSize},
+		func(params *elbv2.DescribeTargetGroupsPaginatorOptions) {
+			params.StopOnDuplicateToken = true
+		},
+	)
+
+	for tgPaginator.HasMorePages() {
+		metrics.IncPages(ctx)
+		page, err := tgPaginator.NextPage(ctx, withRegion)
+		if err != nil {
+			return awsutils.MaybeSkipRetry(err)
+		}
+
+		for _, tg := range page.TargetGroups {
+			tgARN := ptr.StringFromPointer(tg.TargetGroupArn)
+			item := models.TargetGroup{
+				TargetGroupARN:      tgARN,
+				AccountID:           payload.AccountID,
+				Name:                ptr.StringFromPointer(tg.TargetGroupName),
+				RegionName:          payload.Region,
+				TargetType:          string(tg.TargetType),
+				Protocol:            string(tg.Protocol),
+				Port:                ptr.Value(tg.Port, 0),
+				VpcID:               ptr.StringFromPointer(tg.VpcId),
+				HealthCheckProtocol: string(tg.HealthCheckProtocol),
+				HealthCheckPath:     ptr.StringFromPointer(tg.HealthCheckPath),
+				LoadBalancerARNs:    tg.LoadBalancerArns,
+			}
+			result.targetGroups = append(result.targetGroups, item)
+
+			// Target groups, which are not attached to a load
+			// balancer, do not report the health of their targets.
+			if len(tg.LoadBalancerArns) == 0 {
+				continue
+			}
+
+			out, err := client.Client.DescribeTargetHealth(
+				ctx,
+				&elbv2.DescribeTargetHealthInput{TargetGroupArn: tg.TargetGroupArn},
+				withRegion,
+			)
+			if err != nil {
+				return awsutils.MaybeSkipRetry(err)
+			}
+
+			// A target group may be attached to multiple load
+			// balancers, each of which fronts the targets.
+			for _, lbARN := range tg.LoadBalancerArns {
+				lb, ok := lbs[lbARN]
+				if !ok {
+					continue
+				}
+				for _, desc := range out.TargetHealthDescriptions {
+					if desc.Target == nil {
+						continue
+					}
+					item := models.LoadBalancerTarget{
+						DNSName:        ptr.StringFromPointer(lb.DNSName),
+						AccountID:      payload.AccountID,
+						TargetGroupARN: tgARN,
+						TargetID:       ptr.StringFromPointer(desc.Target.Id),
+						Port:           ptr.Value(desc.Target.Port, 0),
+						RegionName:     payload.Region,
+						TargetType:     string(tg.TargetType),
+						AZ:             ptr.StringFromPointer(desc.Target.AvailabilityZone),
+					}
+					if desc.TargetHealth != nil {
+						item.State = string(desc.TargetHealth.State)
+						item.Reason = string(desc.TargetHealth.Reason)
+						item.Description = ptr.StringFromPointer(desc.TargetHealth.Description)
+					}
+					result.targets = append(result.targets, item)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// getForwardTargetGroupARNs returns the ARNs of the target groups, to which the
+// given ELB v2 listener actions forward.
+func getForwardTargetGroupARNs(actions []v2types.Action) []string {
+	arns := make([]string, 0)
+	for _, action := range actions {
+		if action.Type != v2types.ActionTypeEnumForward {
+			continue
+		}
+		if action.ForwardConfig != nil {
+			for _, tg := range action.ForwardConfig.TargetGroups {
+				arns = append(arns, ptr.StringFromPointer(tg.TargetGroupArn))
+			}
+
+			continue
+		}
+		if action.TargetGroupArn != nil {
+			arns = append(arns, *action.TargetGroupArn)
+		}
+	}
+
+	return arns
+}
+
+// upsertLoadBalancerListeners upserts the given listeners of AWS ELBs into the
+// database.
+func upsertLoadBalancerListeners(ctx context.Context, payload CollectLoadBalancerTargetsPayload, items []models.LoadBalancerListener) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (dns_name, account_id, port) DO UPDATE").
+		Set("load_balancer_name = EXCLUDED.load_balancer_name").
+		Set("region_name = EXCLUDED.region_name").
+		Set("protocol = EXCLUDED.protocol").
+		Set("listener_arn = EXCLUDED.listener_arn").
+		Set("target_group_arns = EXCLUDED.target_group_arns").
+		Set("instance_port = EXCLUDED.instance_port").
+		Set("instance_protocol = EXCLUDED.instance_protocol").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert AWS ELB listeners into db",
+			"region", payload.Region,
+			"account_id", payload.AccountID,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated AWS ELB listeners",
+		"region", payload.Region,
+		"account_id", payload.AccountID,
+		"count", count,
+	)
+
+	return nil
+}
+
+// upsertTargetGroups upserts the given AWS ELB v2 target groups into the
+// database.
+func upsertTargetGroups(ctx context.Context, payload CollectLoadBalancerTargetsPayload, items []models.TargetGroup) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (target_group_arn, account_id) DO UPDATE").
+		Set("name = EXCLUDED.name").
+		Set("region_name = EXCLUDED.region_name").
+		Set("target_type = EXCLUDED.target_type").
+		Set("protocol = EXCLUDED.protocol").
+		Set("port = EXCLUDED.port").
+		Set("vpc_id = EXCLUDED.vpc_id").
+		Set("health_check_protocol = EXCLUDED.health_check_protocol").
+		Set("health_check_path = EXCLUDED.health_check_path").
+		Set("load_balancer_arns = EXCLUDED.load_balancer_arns").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert AWS target groups into db",
+			"region", payload.Region,
+			"account_id", payload.AccountID,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated AWS target groups",
+		"region", payload.Region,
+		"account_id", payload.AccountID,
+		"count", count,
+	)
+
+	return nil
+}
+
+// upsertLoadBalancerTargets upserts the given targets of AWS ELBs into the
+// database.
+func upsertLoadBalancerTargets(ctx context.Context, payload CollectLoadBalancerTargetsPayload, items []models.LoadBalancerTarget) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (dns_name, account_id, target_group_arn, target_id, port) DO UPDATE").
+		Set("region_name = EXCLUDED.region_name").
+		Set("target_type = EXCLUDED.target_type").
+		Set("az = EXCLUDED.az").
+		Set("state = EXCLUDED.state").
+		Set("reason = EXCLUDED.reason").
+		Set("description = EXCLUDED.description").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert AWS ELB targets into db",
+			"region", payload.Region,
+			"account_id", payload.AccountID,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated AWS ELB targets",
+		"region", payload.Region,
+		"account_id", payload.AccountID,
+		"count", count,
+	)
+
+	return nil
+}
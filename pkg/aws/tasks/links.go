@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 
 	"github.com/gardener/inventory/pkg/aws/constants"
@@ -561,8 +562,9 @@ func LinkNetworkInterfaceWithInstance(ctx context.Context, db *bun.DB) error {
 	links := make([]models.InstanceToNetworkInterface, 0, len(items))
 	for _, item := range items {
 		link := models.InstanceToNetworkInterface{
-			NetworkInterfaceID: item.ID,
-			InstanceID:         item.Instance.ID,
+			NetworkInterfaceID:        item.ID,
+			NetworkInterfaceAccountID: item.AccountID,
+			InstanceID:                item.Instance.ID,
 		}
 		links = append(links, link)
 	}
@@ -664,8 +666,9 @@ func LinkNetworkInterfaceWithLoadBalancer(ctx context.Context, db *bun.DB) error
 
 		for _, item := range interfaces {
 			link := models.LoadBalancerToNetworkInterface{
-				NetworkInterfaceID: item.ID,
-				LoadBalancerID:     lb.ID,
+				NetworkInterfaceID:        item.ID,
+				NetworkInterfaceAccountID: item.AccountID,
+				LoadBalancerID:            lb.ID,
 			}
 			links = append(links, link)
 		}
@@ -696,3 +699,306 @@ func LinkNetworkInterfaceWithLoadBalancer(ctx context.Context, db *bun.DB) error
 
 	return nil
 }
+
+// LinkCapacityReservationWithRegion creates links between the Capacity Reservation and Region.
+func LinkCapacityReservationWithRegion(ctx context.Context, db *bun.DB) error {
+	var items []models.CapacityReservation
+	err := db.NewSelect().
+		Model(&items).
+		Relation("Region").
+		Where("region.id IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	links := make([]models.CapacityReservationToRegion, 0, len(items))
+	for _, item := range items {
+		link := models.CapacityReservationToRegion{
+			CapacityReservationID: item.ID,
+			RegionID:              item.Region.ID,
+		}
+		links = append(links, link)
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	out, err := db.NewInsert().
+		Model(&links).
+		On("CONFLICT (cr_id, region_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("linked aws capacity reservation with region", "count", count)
+
+	return nil
+}
+
+// LinkCapacityReservationWithAZ creates links between the Capacity Reservation and AZ.
+func LinkCapacityReservationWithAZ(ctx context.Context, db *bun.DB) error {
+	var items []models.CapacityReservation
+	err := db.NewSelect().
+		Model(&items).
+		Relation("AvailabilityZone").
+		Where("availability_zone.id IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	links := make([]models.CapacityReservationToAZ, 0, len(items))
+	for _, item := range items {
+		link := models.CapacityReservationToAZ{
+			CapacityReservationID: item.ID,
+			AvailabilityZoneID:    item.AvailabilityZone.ID,
+		}
+		links = append(links, link)
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	out, err := db.NewInsert().
+		Model(&links).
+		On("CONFLICT (cr_id, az_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("linked aws capacity reservation with az", "count", count)
+
+	return nil
+}
+
+// LinkSpotInstanceRequestWithRegion creates links between the Spot Instance request and Region.
+func LinkSpotInstanceRequestWithRegion(ctx context.Context, db *bun.DB) error {
+	var items []models.SpotInstanceRequest
+	err := db.NewSelect().
+		Model(&items).
+		Relation("Region").
+		Where("region.id IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	links := make([]models.SpotInstanceRequestToRegion, 0, len(items))
+	for _, item := range items {
+		link := models.SpotInstanceRequestToRegion{
+			SpotInstanceRequestID: item.ID,
+			RegionID:              item.Region.ID,
+		}
+		links = append(links, link)
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	out, err := db.NewInsert().
+		Model(&links).
+		On("CONFLICT (sir_id, region_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("linked aws spot instance request with region", "count", count)
+
+	return nil
+}
+
+// LinkSpotInstanceRequestWithAZ creates links between the Spot Instance request and AZ.
+func LinkSpotInstanceRequestWithAZ(ctx context.Context, db *bun.DB) error {
+	var items []models.SpotInstanceRequest
+	err := db.NewSelect().
+		Model(&items).
+		Relation("AvailabilityZone").
+		Where("availability_zone.id IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	links := make([]models.SpotInstanceRequestToAZ, 0, len(items))
+	for _, item := range items {
+		link := models.SpotInstanceRequestToAZ{
+			SpotInstanceRequestID: item.ID,
+			AvailabilityZoneID:    item.AvailabilityZone.ID,
+		}
+		links = append(links, link)
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	out, err := db.NewInsert().
+		Model(&links).
+		On("CONFLICT (sir_id, az_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("linked aws spot instance request with az", "count", count)
+
+	return nil
+}
+
+// LinkServiceQuotaWithRegion creates links between the Service Quota and Region.
+func LinkServiceQuotaWithRegion(ctx context.Context, db *bun.DB) error {
+	var items []models.ServiceQuota
+	err := db.NewSelect().
+		Model(&items).
+		Relation("Region").
+		Where("region.id IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	links := make([]models.ServiceQuotaToRegion, 0, len(items))
+	for _, item := range items {
+		link := models.ServiceQuotaToRegion{
+			ServiceQuotaID: item.ID,
+			RegionID:       item.Region.ID,
+		}
+		links = append(links, link)
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	out, err := db.NewInsert().
+		Model(&links).
+		On("CONFLICT (sq_id, region_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("linked aws service quota with region", "count", count)
+
+	return nil
+}
+
+// LinkLoadBalancerWithInstance creates links between [models.LoadBalancer] and
+// the [models.Instance] targets behind it.
+func LinkLoadBalancerWithInstance(ctx context.Context, db *bun.DB) error {
+	var items []models.LoadBalancerTarget
+	err := db.NewSelect().
+		Model(&items).
+		Relation("LoadBalancer").
+		Relation("Instance").
+		Where("load_balancer.id IS NOT NULL").
+		Where("instance.id IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	// A load balancer may reach the same instance via multiple target
+	// groups or ports, which results in a single link.
+	type key struct {
+		lbID       uuid.UUID
+		instanceID uuid.UUID
+	}
+	seen := make(map[key]struct{})
+	links := make([]models.LoadBalancerToInstance, 0, len(items))
+	for _, item := range items {
+		k := key{lbID: item.LoadBalancer.ID, instanceID: item.Instance.ID}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		link := models.LoadBalancerToInstance{
+			LoadBalancerID: item.LoadBalancer.ID,
+			InstanceID:     item.Instance.ID,
+		}
+		links = append(links, link)
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	out, err := db.NewInsert().
+		Model(&links).
+		On("CONFLICT (lb_id, instance_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("linked aws load balancer with instance", "count", count)
+
+	return nil
+}
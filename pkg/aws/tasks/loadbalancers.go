@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -39,11 +40,11 @@ const (
 // ELBs.
 type CollectLoadBalancersPayload struct {
 	// Region specifies the region from which to collect.
-	Region string `json:"region" yaml:"region"`
+	Region string `json:"region" yaml:"region" desc:"The region from which to collect" example:"eu-west-1"`
 
 	// AccountID specifies the AWS Account ID, which is associated with a
 	// registered client.
-	AccountID string `json:"account_id" yaml:"account_id"`
+	AccountID string `json:"account_id" yaml:"account_id" desc:"The AWS Account ID, which is associated with a registered client" example:"123456789012"`
 }
 
 // NewCollectLoadBalancersTask creates a new [asynq.Task] for collecting AWS
@@ -107,7 +108,18 @@ func enqueueCollectLoadBalancers(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectLoadBalancers, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"region", r.Name,
+				"account_id", r.AccountID,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -200,6 +212,7 @@ func collectELBv2(ctx context.Context, payload CollectLoadBalancersPayload) erro
 	// Fetch items from all pages
 	items := make([]v2types.LoadBalancer, 0)
 	for paginator.HasMorePages() {
+		metrics.IncPages(ctx)
 		page, err := paginator.NextPage(
 			ctx,
 			func(o *elbv2.Options) {
@@ -332,6 +345,7 @@ func collectELBv1(ctx context.Context, payload CollectLoadBalancersPayload) erro
 	// Fetch items from all pages
 	items := make([]v1types.LoadBalancerDescription, 0)
 	for paginator.HasMorePages() {
+		metrics.IncPages(ctx)
 		page, err := paginator.NextPage(
 			ctx,
 			func(o *elb.Options) {
@@ -118,6 +118,51 @@ var (
 		[]string{"account_id", "hosted_zone_id"},
 		nil,
 	)
+
+	// capacityReservationsDesc is the descriptor for a metric, which
+	// tracks the number of collected AWS EC2 Capacity Reservations.
+	capacityReservationsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "aws_capacity_reservations"),
+		"A gauge which tracks the number of collected AWS EC2 Capacity Reservations",
+		[]string{"account_id", "region"},
+		nil,
+	)
+
+	// spotInstanceRequestsDesc is the descriptor for a metric, which
+	// tracks the number of collected AWS EC2 Spot Instance requests.
+	spotInstanceRequestsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "aws_spot_instance_requests"),
+		"A gauge which tracks the number of collected AWS EC2 Spot Instance requests",
+		[]string{"account_id", "region"},
+		nil,
+	)
+
+	// serviceQuotasDesc is the descriptor for a metric, which tracks the
+	// number of collected AWS Service Quotas.
+	serviceQuotasDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "aws_service_quotas"),
+		"A gauge which tracks the number of collected AWS Service Quotas",
+		[]string{"account_id", "region", "service_code"},
+		nil,
+	)
+
+	// lbCertificatesDesc is the descriptor for a metric, which tracks the
+	// number of collected TLS certificates attached to AWS ELB listeners.
+	lbCertificatesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "aws_lb_certificates"),
+		"A gauge which tracks the number of collected AWS ELB listener certificates",
+		[]string{"account_id", "region"},
+		nil,
+	)
+
+	// lbTargetsDesc is the descriptor for a metric, which tracks the
+	// number of collected AWS ELB targets by their health state.
+	lbTargetsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "aws_lb_targets"),
+		"A gauge which tracks the number of collected AWS ELB targets",
+		[]string{"account_id", "region", "state"},
+		nil,
+	)
 )
 
 // init registers the metrics with the [metrics.DefaultCollector]
@@ -135,5 +180,10 @@ func init() {
 		dhcpOptionSetDesc,
 		hostedZonesDesc,
 		dnsRecordsDesc,
+		capacityReservationsDesc,
+		spotInstanceRequestsDesc,
+		serviceQuotasDesc,
+		lbCertificatesDesc,
+		lbTargetsDesc,
 	)
 }
@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -21,8 +22,8 @@ import (
 	awsclients "github.com/gardener/inventory/pkg/clients/aws"
 	"github.com/gardener/inventory/pkg/clients/db"
 	"github.com/gardener/inventory/pkg/metrics"
-	"github.com/gardener/inventory/pkg/utils"
 	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
 	"github.com/gardener/inventory/pkg/utils/ptr"
 )
 
@@ -36,11 +37,11 @@ const (
 // Elastic Network Interfaces (ENI).
 type CollectNetworkInterfacesPayload struct {
 	// Region specifies the region from which to collect.
-	Region string `json:"region" yaml:"region"`
+	Region string `json:"region" yaml:"region" desc:"The region from which to collect" example:"eu-west-1"`
 
 	// AccountID specifies the AWS Account ID, which is associated with a
 	// registered client.
-	AccountID string `json:"account_id" yaml:"account_id"`
+	AccountID string `json:"account_id" yaml:"account_id" desc:"The AWS Account ID, which is associated with a registered client" example:"123456789012"`
 }
 
 // NewCollectNetworkInterfacesTask creates a new [asynq.Task] for collecting AWS
@@ -115,7 +116,18 @@ func enqueueCollectENIs(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectNetworkInterfaces, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"region", r.Name,
+				"account_id", r.AccountID,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -156,6 +168,56 @@ func collectENIs(ctx context.Context, payload CollectNetworkInterfacesPayload) e
 		"account_id", payload.AccountID,
 	)
 
+	// The network interfaces are upserted in batches while paginating,
+	// so that large accounts are not kept in memory at once.
+	vpcCounts := make(map[string]int)
+	upsert := func(ctx context.Context, items []models.NetworkInterface) (int64, error) {
+		out, err := db.DB.NewInsert().
+			Model(&items).
+			On("CONFLICT (interface_id, account_id) DO UPDATE").
+			Set("az = EXCLUDED.az").
+			Set("description = EXCLUDED.description").
+			Set("interface_type = EXCLUDED.interface_type").
+			Set("mac_address = EXCLUDED.mac_address").
+			Set("owner_id = EXCLUDED.owner_id").
+			Set("private_dns_name = EXCLUDED.private_dns_name").
+			Set("private_ip_address = EXCLUDED.private_ip_address").
+			Set("requester_id = EXCLUDED.requester_id").
+			Set("requester_managed = EXCLUDED.requester_managed").
+			Set("src_dst_check = EXCLUDED.src_dst_check").
+			Set("status = EXCLUDED.status").
+			Set("subnet_id = EXCLUDED.subnet_id").
+			Set("vpc_id = EXCLUDED.vpc_id").
+			Set("allocation_id = EXCLUDED.allocation_id").
+			Set("association_id = EXCLUDED.association_id").
+			Set("ip_owner_id = EXCLUDED.ip_owner_id").
+			Set("public_dns_name = EXCLUDED.public_dns_name").
+			Set("public_ip_address = EXCLUDED.public_ip_address").
+			Set("attachment_id = EXCLUDED.attachment_id").
+			Set("delete_on_termination = EXCLUDED.delete_on_termination").
+			Set("device_index = EXCLUDED.device_index").
+			Set("instance_id = EXCLUDED.instance_id").
+			Set("instance_owner_id = EXCLUDED.instance_owner_id").
+			Set("attachment_status = EXCLUDED.attachment_status").
+			Set("updated_at = EXCLUDED.updated_at").
+			Returning("id").
+			Exec(ctx)
+
+		if err != nil {
+			logger.Error(
+				"could not insert network interfaces into db",
+				"region", payload.Region,
+				"account_id", payload.AccountID,
+				"reason", err,
+			)
+
+			return 0, err
+		}
+
+		return out.RowsAffected()
+	}
+	batcher := dbutils.NewBatcher(asynqutils.GetConfig(ctx).Worker.BatchSize, upsert)
+
 	paginator := ec2.NewDescribeNetworkInterfacesPaginator(
 		client.Client,
 		&ec2.DescribeNetworkInterfacesInput{},
@@ -165,9 +227,8 @@ func collectENIs(ctx context.Context, payload CollectNetworkInterfacesPayload) e
 		},
 	)
 
-	// Fetch items from all pages
-	items := make([]types.NetworkInterface, 0)
 	for paginator.HasMorePages() {
+		metrics.IncPages(ctx)
 		page, err := paginator.NextPage(
 			ctx,
 			func(o *ec2.Options) {
@@ -185,120 +246,37 @@ func collectENIs(ctx context.Context, payload CollectNetworkInterfacesPayload) e
 
 			return awsutils.MaybeSkipRetry(err)
 		}
-		items = append(items, page.NetworkInterfaces...)
-	}
-
-	// Create model instances from the collected data
-	networkInterfaces := make([]models.NetworkInterface, 0, len(items))
-	for _, item := range items {
-		netInterface := models.NetworkInterface{
-			RegionName:       payload.Region,
-			AZ:               ptr.StringFromPointer(item.AvailabilityZone),
-			Description:      ptr.StringFromPointer(item.Description),
-			InterfaceType:    string(item.InterfaceType),
-			AccountID:        payload.AccountID,
-			MacAddress:       ptr.StringFromPointer(item.MacAddress),
-			InterfaceID:      ptr.StringFromPointer(item.NetworkInterfaceId),
-			OwnerID:          ptr.StringFromPointer(item.OwnerId),
-			PrivateDNSName:   ptr.StringFromPointer(item.PrivateDnsName),
-			PrivateIPAddress: ptr.StringFromPointer(item.PrivateIpAddress),
-			RequesterID:      ptr.StringFromPointer(item.RequesterId),
-			RequesterManaged: ptr.Value(item.RequesterManaged, false),
-			SourceDestCheck:  ptr.Value(item.SourceDestCheck, false),
-			Status:           string(item.Status),
-			SubnetID:         ptr.StringFromPointer(item.SubnetId),
-			VpcID:            ptr.StringFromPointer(item.VpcId),
-		}
-
-		// Association
-		if item.Association != nil {
-			netInterface.AllocationID = ptr.StringFromPointer(item.Association.AllocationId)
-			netInterface.AssociationID = ptr.StringFromPointer(item.Association.AssociationId)
-			netInterface.IPOwnerID = ptr.StringFromPointer(item.Association.IpOwnerId)
-			netInterface.PublicDNSName = ptr.StringFromPointer(item.Association.PublicDnsName)
-			netInterface.PublicIPAddress = ptr.StringFromPointer(item.Association.PublicIp)
-		}
 
-		// Attachment
-		if item.Attachment != nil {
-			netInterface.AttachmentID = ptr.StringFromPointer(item.Attachment.AttachmentId)
-			netInterface.DeleteOnTermination = ptr.Value(item.Attachment.DeleteOnTermination, false)
-			netInterface.DeviceIndex = int(ptr.Value(item.Attachment.DeviceIndex, 0))
-			netInterface.InstanceID = ptr.StringFromPointer(item.Attachment.InstanceId)
-			netInterface.InstanceOwnerID = ptr.StringFromPointer(item.Attachment.InstanceOwnerId)
-			netInterface.AttachmentStatus = string(item.Attachment.Status)
+		for _, item := range page.NetworkInterfaces {
+			netInterface := toNetworkInterfaceModel(payload, item)
+			vpcCounts[netInterface.VpcID]++
+			if err := batcher.Add(ctx, netInterface); err != nil {
+				return err
+			}
 		}
-
-		networkInterfaces = append(networkInterfaces, netInterface)
 	}
 
-	if len(networkInterfaces) == 0 {
-		return nil
-	}
-
-	out, err := db.DB.NewInsert().
-		Model(&networkInterfaces).
-		On("CONFLICT (interface_id, account_id) DO UPDATE").
-		Set("az = EXCLUDED.az").
-		Set("description = EXCLUDED.description").
-		Set("interface_type = EXCLUDED.interface_type").
-		Set("mac_address = EXCLUDED.mac_address").
-		Set("owner_id = EXCLUDED.owner_id").
-		Set("private_dns_name = EXCLUDED.private_dns_name").
-		Set("private_ip_address = EXCLUDED.private_ip_address").
-		Set("requester_id = EXCLUDED.requester_id").
-		Set("requester_managed = EXCLUDED.requester_managed").
-		Set("src_dst_check = EXCLUDED.src_dst_check").
-		Set("status = EXCLUDED.status").
-		Set("subnet_id = EXCLUDED.subnet_id").
-		Set("vpc_id = EXCLUDED.vpc_id").
-		Set("allocation_id = EXCLUDED.allocation_id").
-		Set("association_id = EXCLUDED.association_id").
-		Set("ip_owner_id = EXCLUDED.ip_owner_id").
-		Set("public_dns_name = EXCLUDED.public_dns_name").
-		Set("public_ip_address = EXCLUDED.public_ip_address").
-		Set("attachment_id = EXCLUDED.attachment_id").
-		Set("delete_on_termination = EXCLUDED.delete_on_termination").
-		Set("device_index = EXCLUDED.device_index").
-		Set("instance_id = EXCLUDED.instance_id").
-		Set("instance_owner_id = EXCLUDED.instance_owner_id").
-		Set("attachment_status = EXCLUDED.attachment_status").
-		Set("updated_at = EXCLUDED.updated_at").
-		Returning("id").
-		Exec(ctx)
-
-	if err != nil {
-		logger.Error(
-			"could not insert network interfaces into db",
-			"region", payload.Region,
-			"account_id", payload.AccountID,
-			"reason", err,
-		)
-
+	if err := batcher.Flush(ctx); err != nil {
 		return err
 	}
 
-	count, err := out.RowsAffected()
-	if err != nil {
-		return err
+	if batcher.Count() == 0 {
+		return nil
 	}
 
 	logger.Info(
 		"populated aws network interfaces",
 		"region", payload.Region,
 		"account_id", payload.AccountID,
-		"count", count,
+		"count", batcher.Count(),
 	)
 
 	// Emit metrics
-	groups := utils.GroupBy(networkInterfaces, func(item models.NetworkInterface) string {
-		return item.VpcID
-	})
-	for vpcID, items := range groups {
+	for vpcID, count := range vpcCounts {
 		metric := prometheus.MustNewConstMetric(
 			netInterfacesDesc,
 			prometheus.GaugeValue,
-			float64(len(items)),
+			float64(count),
 			payload.AccountID,
 			payload.Region,
 			vpcID,
@@ -309,3 +287,47 @@ func collectENIs(ctx context.Context, payload CollectNetworkInterfacesPayload) e
 
 	return nil
 }
+
+// toNetworkInterfaceModel converts the given AWS ENI to a
+// [models.NetworkInterface].
+func toNetworkInterfaceModel(payload CollectNetworkInterfacesPayload, item types.NetworkInterface) models.NetworkInterface {
+	netInterface := models.NetworkInterface{
+		RegionName:       payload.Region,
+		AZ:               ptr.StringFromPointer(item.AvailabilityZone),
+		Description:      ptr.StringFromPointer(item.Description),
+		InterfaceType:    string(item.InterfaceType),
+		AccountID:        payload.AccountID,
+		MacAddress:       ptr.StringFromPointer(item.MacAddress),
+		InterfaceID:      ptr.StringFromPointer(item.NetworkInterfaceId),
+		OwnerID:          ptr.StringFromPointer(item.OwnerId),
+		PrivateDNSName:   ptr.StringFromPointer(item.PrivateDnsName),
+		PrivateIPAddress: ptr.StringFromPointer(item.PrivateIpAddress),
+		RequesterID:      ptr.StringFromPointer(item.RequesterId),
+		RequesterManaged: ptr.Value(item.RequesterManaged, false),
+		SourceDestCheck:  ptr.Value(item.SourceDestCheck, false),
+		Status:           string(item.Status),
+		SubnetID:         ptr.StringFromPointer(item.SubnetId),
+		VpcID:            ptr.StringFromPointer(item.VpcId),
+	}
+
+	// Association
+	if item.Association != nil {
+		netInterface.AllocationID = ptr.StringFromPointer(item.Association.AllocationId)
+		netInterface.AssociationID = ptr.StringFromPointer(item.Association.AssociationId)
+		netInterface.IPOwnerID = ptr.StringFromPointer(item.Association.IpOwnerId)
+		netInterface.PublicDNSName = ptr.StringFromPointer(item.Association.PublicDnsName)
+		netInterface.PublicIPAddress = ptr.StringFromPointer(item.Association.PublicIp)
+	}
+
+	// Attachment
+	if item.Attachment != nil {
+		netInterface.AttachmentID = ptr.StringFromPointer(item.Attachment.AttachmentId)
+		netInterface.DeleteOnTermination = ptr.Value(item.Attachment.DeleteOnTermination, false)
+		netInterface.DeviceIndex = int(ptr.Value(item.Attachment.DeviceIndex, 0))
+		netInterface.InstanceID = ptr.StringFromPointer(item.Attachment.InstanceId)
+		netInterface.InstanceOwnerID = ptr.StringFromPointer(item.Attachment.InstanceOwnerId)
+		netInterface.AttachmentStatus = string(item.Attachment.Status)
+	}
+
+	return netInterface
+}
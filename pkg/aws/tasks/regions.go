@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -40,7 +41,7 @@ func NewCollectRegionsTask() *asynq.Task {
 type CollectRegionsPayload struct {
 	// AccountID specifies the AWS Account ID, which is associated with a
 	// registered client.
-	AccountID string `json:"account_id" yaml:"account_id"`
+	AccountID string `json:"account_id" yaml:"account_id" desc:"The AWS Account ID, which is associated with a registered client" example:"123456789012"`
 }
 
 // HandleCollectRegionsTask is the handler, which collects AWS Regions.
@@ -91,7 +92,17 @@ func enqueueCollectRegions(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectRegions, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"account_id", accountID,
+			)
+
+			return registry.ErrContinue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -0,0 +1,450 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gardener/inventory/pkg/aws/constants"
+	"github.com/gardener/inventory/pkg/aws/models"
+	awsutils "github.com/gardener/inventory/pkg/aws/utils"
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	awsclients "github.com/gardener/inventory/pkg/clients/aws"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/ptr"
+)
+
+const (
+	// TaskCollectServiceQuotas is the name of the task for collecting AWS
+	// Service Quotas and their current usage.
+	TaskCollectServiceQuotas = "aws:task:collect-service-quotas"
+)
+
+// ErrNoServiceCode is an error, which is returned when an expected service
+// code was not specified in a task payload.
+var ErrNoServiceCode = errors.New("no service code specified")
+
+// ServiceQuotaServiceCodes specifies the codes of the AWS services, for which
+// quotas are collected.
+var ServiceQuotaServiceCodes = []string{
+	"ec2",
+	"ebs",
+	"vpc",
+	"elasticloadbalancing",
+}
+
+const (
+	// serviceQuotaUsagePeriod is the period of the CloudWatch datapoints,
+	// which are used to determine the current usage of a quota.
+	serviceQuotaUsagePeriod = 5 * time.Minute
+
+	// serviceQuotaUsageWindow is the time window in which CloudWatch is
+	// looked up for the latest usage datapoint of a quota.
+	serviceQuotaUsageWindow = time.Hour
+
+	// serviceQuotaDefaultStat is the statistic used for the usage metric
+	// of a quota, if the quota does not provide a recommendation.
+	serviceQuotaDefaultStat = "Maximum"
+
+	// cloudWatchMaxQueries is the max number of metric data queries, which
+	// can be specified in a single GetMetricData call.
+	cloudWatchMaxQueries = 500
+)
+
+// CollectServiceQuotasPayload is the payload, which is used for collecting
+// AWS Service Quotas.
+type CollectServiceQuotasPayload struct {
+	// Region specifies the region from which to collect.
+	Region string `json:"region" yaml:"region" desc:"The region from which to collect" example:"eu-west-1"`
+
+	// AccountID specifies the AWS Account ID, which is associated with a
+	// registered client.
+	AccountID string `json:"account_id" yaml:"account_id" desc:"The AWS Account ID, which is associated with a registered client" example:"123456789012"`
+
+	// ServiceCode specifies the code of the service for which to collect
+	// quotas, e.g. ec2.
+	ServiceCode string `json:"service_code" yaml:"service_code" desc:"The code of the service for which to collect quotas" example:"ec2"`
+}
+
+// NewCollectServiceQuotasTask creates a new [asynq.Task] for collecting AWS
+// Service Quotas without specifying a payload.
+func NewCollectServiceQuotasTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectServiceQuotas, nil)
+}
+
+// HandleCollectServiceQuotasTask handles the task for collecting AWS Service
+// Quotas.
+func HandleCollectServiceQuotasTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we enqueue tasks for
+	// collecting service quotas for all known regions.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectServiceQuotas(ctx)
+	}
+
+	var payload CollectServiceQuotasPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.AccountID == "" {
+		return asynqutils.SkipRetry(ErrNoAccountID)
+	}
+
+	if payload.Region == "" {
+		return asynqutils.SkipRetry(ErrNoRegion)
+	}
+
+	if payload.ServiceCode == "" {
+		return asynqutils.SkipRetry(ErrNoServiceCode)
+	}
+
+	return collectServiceQuotas(ctx, payload)
+}
+
+// enqueueCollectServiceQuotas enqueues tasks for collecting AWS Service Quotas
+// from all known regions by creating payload with the respective region,
+// account id and service code.
+func enqueueCollectServiceQuotas(ctx context.Context) error {
+	regions, err := awsutils.GetRegionsFromDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get regions: %w", err)
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	queue := asynqutils.GetQueueName(ctx)
+
+	// Enqueue task for each region and service
+	for _, r := range regions {
+		if !awsclients.ServiceQuotasClientset.Exists(r.AccountID) {
+			logger.Warn(
+				"AWS client not found",
+				"region", r.Name,
+				"account_id", r.AccountID,
+			)
+
+			continue
+		}
+
+		for _, serviceCode := range ServiceQuotaServiceCodes {
+			payload := CollectServiceQuotasPayload{
+				Region:      r.Name,
+				AccountID:   r.AccountID,
+				ServiceCode: serviceCode,
+			}
+			data, err := json.Marshal(payload)
+			if err != nil {
+				logger.Error(
+					"failed to marshal payload for AWS service quotas",
+					"region", r.Name,
+					"account_id", r.AccountID,
+					"service_code", serviceCode,
+					"reason", err,
+				)
+
+				continue
+			}
+
+			task := asynq.NewTask(TaskCollectServiceQuotas, data)
+			info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+			if errors.Is(err, asynq.ErrDuplicateTask) {
+				logger.Info(
+					"skipping duplicate task",
+					"type", task.Type(),
+					"region", r.Name,
+					"account_id", r.AccountID,
+					"service_code", serviceCode,
+				)
+
+				continue
+			}
+
+			if err != nil {
+				logger.Error(
+					"failed to enqueue task",
+					"type", task.Type(),
+					"region", r.Name,
+					"account_id", r.AccountID,
+					"service_code", serviceCode,
+					"reason", err,
+				)
+
+				continue
+			}
+
+			logger.Info(
+				"enqueued task",
+				"type", task.Type(),
+				"id", info.ID,
+				"queue", info.Queue,
+				"region", r.Name,
+				"account_id", r.AccountID,
+				"service_code", serviceCode,
+			)
+		}
+	}
+
+	return nil
+}
+
+// collectServiceQuotas collects the AWS Service Quotas for the service and
+// region specified in the payload, using the client associated with the
+// specified AccountID.
+func collectServiceQuotas(ctx context.Context, payload CollectServiceQuotasPayload) error {
+	client, ok := awsclients.ServiceQuotasClientset.Get(payload.AccountID)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.AccountID))
+	}
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			serviceQuotasDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.AccountID,
+			payload.Region,
+			payload.ServiceCode,
+		)
+		key := metrics.Key(TaskCollectServiceQuotas, payload.AccountID, payload.Region, payload.ServiceCode)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info(
+		"collecting AWS service quotas",
+		"region", payload.Region,
+		"account_id", payload.AccountID,
+		"service_code", payload.ServiceCode,
+	)
+
+	paginator := servicequotas.NewListServiceQuotasPaginator(
+		client.Client,
+		&servicequotas.ListServiceQuotasInput{
+			ServiceCode: aws.String(payload.ServiceCode),
+		},
+		func(params *servicequotas.ListServiceQuotasPaginatorOptions) {
+			params.Limit = int32(constants.PageSize)
+			params.StopOnDuplicateToken = true
+		},
+	)
+
+	// Fetch items from all pages
+	items := make([]types.ServiceQuota, 0)
+	for paginator.HasMorePages() {
+		metrics.IncPages(ctx)
+		page, err := paginator.NextPage(
+			ctx,
+			func(o *servicequotas.Options) {
+				o.Region = payload.Region
+			},
+		)
+
+		if err != nil {
+			logger.Error(
+				"could not list service quotas",
+				"region", payload.Region,
+				"account_id", payload.AccountID,
+				"service_code", payload.ServiceCode,
+				"reason", err,
+			)
+
+			return awsutils.MaybeSkipRetry(err)
+		}
+		items = append(items, page.Quotas...)
+	}
+
+	quotas := make([]models.ServiceQuota, 0, len(items))
+	for _, q := range items {
+		if q.QuotaCode == nil {
+			logger.Warn("empty service quota code")
+
+			continue
+		}
+
+		item := models.ServiceQuota{
+			AccountID:   payload.AccountID,
+			RegionName:  payload.Region,
+			ServiceCode: payload.ServiceCode,
+			QuotaCode:   ptr.StringFromPointer(q.QuotaCode),
+			ServiceName: ptr.StringFromPointer(q.ServiceName),
+			QuotaName:   ptr.StringFromPointer(q.QuotaName),
+			Value:       ptr.Value(q.Value, 0),
+			Unit:        ptr.StringFromPointer(q.Unit),
+			Adjustable:  q.Adjustable,
+			GlobalQuota: q.GlobalQuota,
+		}
+		quotas = append(quotas, item)
+	}
+
+	if len(quotas) == 0 {
+		return nil
+	}
+
+	// The usage is collected on a best-effort basis, since not all
+	// quotas provide a usage metric.
+	usage, err := getServiceQuotasUsage(ctx, payload, items)
+	if err != nil {
+		logger.Warn(
+			"could not get service quotas usage",
+			"region", payload.Region,
+			"account_id", payload.AccountID,
+			"service_code", payload.ServiceCode,
+			"reason", err,
+		)
+	}
+
+	for i := range quotas {
+		if v, ok := usage[quotas[i].QuotaCode]; ok {
+			quotas[i].Usage = ptr.To(v)
+		}
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&quotas).
+		On("CONFLICT (account_id, region_name, service_code, quota_code) DO UPDATE").
+		Set("service_name = EXCLUDED.service_name").
+		Set("quota_name = EXCLUDED.quota_name").
+		Set("quota_value = EXCLUDED.quota_value").
+		Set("unit = EXCLUDED.unit").
+		Set("adjustable = EXCLUDED.adjustable").
+		Set("global_quota = EXCLUDED.global_quota").
+		Set("usage = EXCLUDED.usage").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert service quotas into db",
+			"region", payload.Region,
+			"account_id", payload.AccountID,
+			"service_code", payload.ServiceCode,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated AWS service quotas",
+		"region", payload.Region,
+		"account_id", payload.AccountID,
+		"service_code", payload.ServiceCode,
+		"count", count,
+	)
+
+	return nil
+}
+
+// getServiceQuotasUsage returns the latest usage datapoint from CloudWatch for
+// each of the given quotas, which provides a usage metric. The result is keyed
+// by the quota code.
+func getServiceQuotasUsage(ctx context.Context, payload CollectServiceQuotasPayload, quotas []types.ServiceQuota) (map[string]float64, error) {
+	result := make(map[string]float64)
+	client, ok := awsclients.CloudWatchClientset.Get(payload.AccountID)
+	if !ok {
+		return result, ClientNotFound(payload.AccountID)
+	}
+
+	// Maps the query ids to quota codes
+	queryIDs := make(map[string]string)
+	queries := make([]cwtypes.MetricDataQuery, 0)
+	for _, q := range quotas {
+		m := q.UsageMetric
+		if q.QuotaCode == nil || m == nil || m.MetricName == nil || m.MetricNamespace == nil {
+			continue
+		}
+
+		dimensions := make([]cwtypes.Dimension, 0, len(m.MetricDimensions))
+		for k, v := range m.MetricDimensions {
+			dimensions = append(dimensions, cwtypes.Dimension{
+				Name:  aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+
+		id := fmt.Sprintf("q%d", len(queries))
+		queryIDs[id] = ptr.StringFromPointer(q.QuotaCode)
+		queries = append(queries, cwtypes.MetricDataQuery{
+			Id: aws.String(id),
+			MetricStat: &cwtypes.MetricStat{
+				Metric: &cwtypes.Metric{
+					Namespace:  m.MetricNamespace,
+					MetricName: m.MetricName,
+					Dimensions: dimensions,
+				},
+				Period: aws.Int32(int32(serviceQuotaUsagePeriod.Seconds())),
+				Stat:   aws.String(ptr.Value(m.MetricStatisticRecommendation, serviceQuotaDefaultStat)),
+			},
+		})
+	}
+
+	end := time.Now()
+	start := end.Add(-serviceQuotaUsageWindow)
+	for len(queries) > 0 {
+		n := min(len(queries), cloudWatchMaxQueries)
+		batch := queries[:n]
+		queries = queries[n:]
+
+		paginator := cloudwatch.NewGetMetricDataPaginator(
+			client.Client,
+			&cloudwatch.GetMetricDataInput{
+				StartTime:         aws.Time(start),
+				EndTime:           aws.Time(end),
+				MetricDataQueries: batch,
+				ScanBy:            cwtypes.ScanByTimestampDescending,
+			},
+		)
+
+		for paginator.HasMorePages() {
+			metrics.IncPages(ctx)
+			page, err := paginator.NextPage(
+				ctx,
+				func(o *cloudwatch.Options) {
+					o.Region = payload.Region
+				},
+			)
+
+			if err != nil {
+				return result, err
+			}
+
+			for _, r := range page.MetricDataResults {
+				code, ok := queryIDs[ptr.StringFromPointer(r.Id)]
+				if !ok || len(r.Values) == 0 {
+					continue
+				}
+
+				// Values are sorted by timestamp in descending
+				// order, so we only keep the latest one.
+				if _, exists := result[code]; !exists {
+					result[code] = r.Values[0]
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
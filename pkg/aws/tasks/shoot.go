@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hibiken/asynq"
+
+	awsclients "github.com/gardener/inventory/pkg/clients/aws"
+	"github.com/gardener/inventory/pkg/core/registry"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// newShootCollectTasks returns the tasks for collecting the AWS resources of
+// the Gardener Shoot described by the given scope. If no accounts are known
+// for the Shoot, then the resources are collected from all registered
+// accounts in the region of the Shoot.
+func newShootCollectTasks(scope registry.ShootScope) ([]*asynq.Task, error) {
+	accounts := scope.Accounts
+	if len(accounts) == 0 {
+		_ = awsclients.EC2Clientset.Range(func(accountID string, _ *awsclients.Client[*ec2.Client]) error {
+			accounts = append(accounts, accountID)
+
+			return nil
+		})
+	}
+
+	items := make([]*asynq.Task, 0)
+	for _, accountID := range accounts {
+		if !awsclients.EC2Clientset.Exists(accountID) {
+			continue
+		}
+
+		payloads := []taskPayload{
+			{TaskCollectVPCs, CollectVPCsPayload{Region: scope.Region, AccountID: accountID}},
+			{TaskCollectSubnets, CollectSubnetsPayload{Region: scope.Region, AccountID: accountID}},
+			{TaskCollectInstances, CollectInstancesPayload{Region: scope.Region, AccountID: accountID}},
+			{TaskCollectNetworkInterfaces, CollectNetworkInterfacesPayload{Region: scope.Region, AccountID: accountID}},
+			{TaskCollectLoadBalancers, CollectLoadBalancersPayload{Region: scope.Region, AccountID: accountID}},
+		}
+
+		for _, item := range payloads {
+			task, err := asynqutils.NewTask(item.name, item.payload)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, task)
+		}
+	}
+
+	return items, nil
+}
+
+func init() {
+	registry.ShootCollectorRegistry.MustRegister("aws", registry.ShootCollector{
+		ProviderType: "aws",
+		NewTasks:     newShootCollectTasks,
+		LinkTasks:    []string{TaskLinkAll},
+	})
+}
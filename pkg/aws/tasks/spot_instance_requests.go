@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gardener/inventory/pkg/aws/constants"
+	"github.com/gardener/inventory/pkg/aws/models"
+	awsutils "github.com/gardener/inventory/pkg/aws/utils"
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	awsclients "github.com/gardener/inventory/pkg/clients/aws"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/ptr"
+)
+
+const (
+	// TaskCollectSpotInstanceRequests is the name of the task for
+	// collecting AWS EC2 Spot Instance requests.
+	TaskCollectSpotInstanceRequests = "aws:task:collect-spot-instance-requests"
+)
+
+// CollectSpotInstanceRequestsPayload is the payload, which is used for
+// collecting AWS EC2 Spot Instance requests.
+type CollectSpotInstanceRequestsPayload struct {
+	// Region specifies the region from which to collect.
+	Region string `json:"region" yaml:"region" desc:"The region from which to collect" example:"eu-west-1"`
+
+	// AccountID specifies the AWS Account ID, which is associated with a
+	// registered client.
+	AccountID string `json:"account_id" yaml:"account_id" desc:"The AWS Account ID, which is associated with a registered client" example:"123456789012"`
+}
+
+// NewCollectSpotInstanceRequestsTask creates a new [asynq.Task] for collecting
+// AWS EC2 Spot Instance requests without specifying a payload.
+func NewCollectSpotInstanceRequestsTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectSpotInstanceRequests, nil)
+}
+
+// HandleCollectSpotInstanceRequestsTask handles the task for collecting AWS
+// EC2 Spot Instance requests.
+func HandleCollectSpotInstanceRequestsTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we enqueue tasks for
+	// collecting spot instance requests for all known regions.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectSpotInstanceRequests(ctx)
+	}
+
+	var payload CollectSpotInstanceRequestsPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.AccountID == "" {
+		return asynqutils.SkipRetry(ErrNoAccountID)
+	}
+
+	if payload.Region == "" {
+		return asynqutils.SkipRetry(ErrNoRegion)
+	}
+
+	return collectSpotInstanceRequests(ctx, payload)
+}
+
+// enqueueCollectSpotInstanceRequests enqueues tasks for collecting AWS
+// spot instance requests from all known regions by creating payload with the respective
+// region and account id.
+func enqueueCollectSpotInstanceRequests(ctx context.Context) error {
+	regions, err := awsutils.GetRegionsFromDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get regions: %w", err)
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	queue := asynqutils.GetQueueName(ctx)
+
+	// Enqueue task for each region
+	for _, r := range regions {
+		if !awsclients.EC2Clientset.Exists(r.AccountID) {
+			logger.Warn(
+				"AWS client not found",
+				"region", r.Name,
+				"account_id", r.AccountID,
+			)
+
+			continue
+		}
+
+		payload := CollectSpotInstanceRequestsPayload{
+			Region:    r.Name,
+			AccountID: r.AccountID,
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for AWS spot instance request",
+				"region", r.Name,
+				"account_id", r.AccountID,
+				"reason", err,
+			)
+
+			continue
+		}
+
+		task := asynq.NewTask(TaskCollectSpotInstanceRequests, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"region", r.Name,
+				"account_id", r.AccountID,
+			)
+
+			continue
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"region", r.Name,
+				"account_id", r.AccountID,
+				"reason", err,
+			)
+
+			continue
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"region", r.Name,
+			"account_id", r.AccountID,
+		)
+	}
+
+	return nil
+}
+
+// collectSpotInstanceRequests collects the AWS EC2 Spot Instance requests from
+// the specified payload region using the client associated with the specified
+// AccountID.
+func collectSpotInstanceRequests(ctx context.Context, payload CollectSpotInstanceRequestsPayload) error {
+	client, ok := awsclients.EC2Clientset.Get(payload.AccountID)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.AccountID))
+	}
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			spotInstanceRequestsDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.AccountID,
+			payload.Region,
+		)
+		key := metrics.Key(TaskCollectSpotInstanceRequests, payload.AccountID, payload.Region)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info(
+		"collecting AWS spot instance requests",
+		"region", payload.Region,
+		"account_id", payload.AccountID,
+	)
+
+	paginator := ec2.NewDescribeSpotInstanceRequestsPaginator(
+		client.Client,
+		&ec2.DescribeSpotInstanceRequestsInput{},
+		func(params *ec2.DescribeSpotInstanceRequestsPaginatorOptions) {
+			params.Limit = int32(constants.PageSize)
+			params.StopOnDuplicateToken = true
+		},
+	)
+
+	// Fetch items from all pages
+	items := make([]types.SpotInstanceRequest, 0)
+	for paginator.HasMorePages() {
+		metrics.IncPages(ctx)
+		page, err := paginator.NextPage(
+			ctx,
+			func(o *ec2.Options) {
+				o.Region = payload.Region
+			},
+		)
+
+		if err != nil {
+			logger.Error(
+				"could not describe spot instance requests",
+				"region", payload.Region,
+				"account_id", payload.AccountID,
+				"reason", err,
+			)
+
+			return awsutils.MaybeSkipRetry(err)
+		}
+		items = append(items, page.SpotInstanceRequests...)
+	}
+
+	requests := make([]models.SpotInstanceRequest, 0, len(items))
+	for _, req := range items {
+		if req.SpotInstanceRequestId == nil {
+			logger.Warn("empty spot instance request id")
+
+			continue
+		}
+
+		item := models.SpotInstanceRequest{
+			RequestID:          ptr.StringFromPointer(req.SpotInstanceRequestId),
+			AccountID:          payload.AccountID,
+			Type:               string(req.Type),
+			State:              string(req.State),
+			InstanceID:         ptr.StringFromPointer(req.InstanceId),
+			ProductDescription: string(req.ProductDescription),
+			SpotPrice:          ptr.StringFromPointer(req.SpotPrice),
+			ValidFrom:          ptr.Value(req.ValidFrom, time.Time{}),
+			ValidUntil:         ptr.Value(req.ValidUntil, time.Time{}),
+			CreateTime:         ptr.Value(req.CreateTime, time.Time{}),
+			AZ:                 ptr.StringFromPointer(req.LaunchedAvailabilityZone),
+			RegionName:         payload.Region,
+		}
+
+		if req.Status != nil {
+			item.StatusCode = ptr.StringFromPointer(req.Status.Code)
+		}
+
+		if req.LaunchSpecification != nil {
+			item.InstanceType = string(req.LaunchSpecification.InstanceType)
+		}
+
+		requests = append(requests, item)
+	}
+
+	if len(requests) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&requests).
+		On("CONFLICT (request_id, account_id) DO UPDATE").
+		Set("type = EXCLUDED.type").
+		Set("state = EXCLUDED.state").
+		Set("status_code = EXCLUDED.status_code").
+		Set("instance_id = EXCLUDED.instance_id").
+		Set("instance_type = EXCLUDED.instance_type").
+		Set("product_description = EXCLUDED.product_description").
+		Set("spot_price = EXCLUDED.spot_price").
+		Set("valid_from = EXCLUDED.valid_from").
+		Set("valid_until = EXCLUDED.valid_until").
+		Set("create_time = EXCLUDED.create_time").
+		Set("az = EXCLUDED.az").
+		Set("region_name = EXCLUDED.region_name").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert spot instance requests into db",
+			"region", payload.Region,
+			"account_id", payload.AccountID,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated AWS spot instance requests",
+		"region", payload.Region,
+		"account_id", payload.AccountID,
+		"count", count,
+	)
+
+	return nil
+}
@@ -10,9 +10,11 @@ import (
 	"github.com/hibiken/asynq"
 
 	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/config"
 	"github.com/gardener/inventory/pkg/core/registry"
 	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
 	dbutils "github.com/gardener/inventory/pkg/utils/db"
+	"github.com/gardener/inventory/pkg/utils/schema"
 )
 
 const (
@@ -44,11 +46,44 @@ func HandleCollectAllTask(ctx context.Context, _ *asynq.Task) error {
 		NewCollectDHCPOptionSetsTask,
 		NewCollectHostedZonesTask,
 		NewCollectDNSRecordsTask,
+		NewCollectCapacityReservationsTask,
+		NewCollectSpotInstanceRequestsTask,
+		NewCollectServiceQuotasTask,
+		NewCollectLoadBalancerCertificatesTask,
+		NewCollectLoadBalancerTargetsTask,
 	}
 
+	// Collectors of disabled services are skipped
+	conf := asynqutils.GetConfig(ctx)
+	taskFns = asynqutils.SkipDisabledTasks(ctx, taskFns, ServiceCollectors(conf.AWS.Services))
+
 	return asynqutils.Enqueue(ctx, taskFns, asynq.Queue(queue))
 }
 
+// ServiceCollectors returns a mapping between the names of the AWS collector
+// tasks and whether the AWS service the collector depends on is enabled.
+func ServiceCollectors(services config.AWSServices) map[string]bool {
+	return map[string]bool{
+		TaskCollectRegions:                  services.EC2.Enabled(),
+		TaskCollectAvailabilityZones:        services.EC2.Enabled(),
+		TaskCollectVPCs:                     services.EC2.Enabled(),
+		TaskCollectSubnets:                  services.EC2.Enabled(),
+		TaskCollectInstances:                services.EC2.Enabled(),
+		TaskCollectImages:                   services.EC2.Enabled(),
+		TaskCollectNetworkInterfaces:        services.EC2.Enabled(),
+		TaskCollectDHCPOptionSets:           services.EC2.Enabled(),
+		TaskCollectCapacityReservations:     services.EC2.Enabled(),
+		TaskCollectSpotInstanceRequests:     services.EC2.Enabled(),
+		TaskCollectLoadBalancers:            services.ELB.Enabled() || services.ELBv2.Enabled(),
+		TaskCollectLoadBalancerCertificates: services.ACM.Enabled(),
+		TaskCollectLoadBalancerTargets:      services.ELB.Enabled() || services.ELBv2.Enabled(),
+		TaskCollectBuckets:                  services.S3.Enabled(),
+		TaskCollectHostedZones:              services.Route53.Enabled(),
+		TaskCollectDNSRecords:               services.Route53.Enabled(),
+		TaskCollectServiceQuotas:            services.ServiceQuotas.Enabled(),
+	}
+}
+
 // HandleLinkAllTask is a handler, which establishes links between the various
 // AWS models.
 func HandleLinkAllTask(ctx context.Context, _ *asynq.Task) error {
@@ -66,6 +101,12 @@ func HandleLinkAllTask(ctx context.Context, _ *asynq.Task) error {
 		LinkLoadBalancerWithRegion,
 		LinkNetworkInterfaceWithInstance,
 		LinkNetworkInterfaceWithLoadBalancer,
+		LinkLoadBalancerWithInstance,
+		LinkCapacityReservationWithRegion,
+		LinkCapacityReservationWithAZ,
+		LinkSpotInstanceRequestWithRegion,
+		LinkSpotInstanceRequestWithAZ,
+		LinkServiceQuotaWithRegion,
 	}
 
 	return dbutils.LinkObjects(ctx, db.DB, linkFns)
@@ -86,6 +127,51 @@ func init() {
 	registry.TaskRegistry.MustRegister(TaskCollectDHCPOptionSets, asynq.HandlerFunc(HandleCollectDHCPOptionSetsTask))
 	registry.TaskRegistry.MustRegister(TaskCollectHostedZones, asynq.HandlerFunc(HandleCollectHostedZonesTask))
 	registry.TaskRegistry.MustRegister(TaskCollectDNSRecords, asynq.HandlerFunc(HandleCollectDNSRecordsTask))
+	registry.TaskRegistry.MustRegister(TaskCollectCapacityReservations, asynq.HandlerFunc(HandleCollectCapacityReservationsTask))
+	registry.TaskRegistry.MustRegister(TaskCollectSpotInstanceRequests, asynq.HandlerFunc(HandleCollectSpotInstanceRequestsTask))
+	registry.TaskRegistry.MustRegister(TaskCollectServiceQuotas, asynq.HandlerFunc(HandleCollectServiceQuotasTask))
+	registry.TaskRegistry.MustRegister(TaskCollectLoadBalancerCertificates, asynq.HandlerFunc(HandleCollectLoadBalancerCertificatesTask))
+	registry.TaskRegistry.MustRegister(TaskCollectLoadBalancerTargets, asynq.HandlerFunc(HandleCollectLoadBalancerTargetsTask))
 	registry.TaskRegistry.MustRegister(TaskCollectAll, asynq.HandlerFunc(HandleCollectAllTask))
 	registry.TaskRegistry.MustRegister(TaskLinkAll, asynq.HandlerFunc(HandleLinkAllTask))
+
+	// Payload schemas
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectRegions, schema.For[CollectRegionsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectAvailabilityZones, schema.For[CollectAvailabilityZonesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectVPCs, schema.For[CollectVPCsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectSubnets, schema.For[CollectSubnetsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectInstances, schema.For[CollectInstancesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectImages, schema.For[CollectImagesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectLoadBalancers, schema.For[CollectLoadBalancersPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectBuckets, schema.For[CollectBucketsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectNetworkInterfaces, schema.For[CollectNetworkInterfacesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectDHCPOptionSets, schema.For[CollectDHCPOptionSetsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectHostedZones, schema.For[CollectHostedZonesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectDNSRecords, schema.For[CollectDNSRecordsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectCapacityReservations, schema.For[CollectCapacityReservationsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectSpotInstanceRequests, schema.For[CollectSpotInstanceRequestsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectServiceQuotas, schema.For[CollectServiceQuotasPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectLoadBalancerCertificates, schema.For[CollectLoadBalancerCertificatesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectLoadBalancerTargets, schema.For[CollectLoadBalancerTargetsPayload]())
+
+	// Task descriptions
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectRegions, "Collects AWS regions.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectAvailabilityZones, "Collects AWS AZs.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectVPCs, "Collects AWS VPCs.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectSubnets, "Collects AWS Subnets.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectInstances, "Collects AWS EC2 Instances.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectImages, "Collects AWS AMIs.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectLoadBalancers, "Collects AWS Elastic Load Balancers (ELBs).")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectBuckets, "Collects S3 Buckets.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectNetworkInterfaces, "Collects AWS ENIs.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectDHCPOptionSets, "Collects AWS DHCP option sets.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectHostedZones, "Collects AWS Route 53 hosted zones.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectDNSRecords, "Collects AWS Route 53 DNS records from hosted zones.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectCapacityReservations, "Collects AWS EC2 Capacity Reservations.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectSpotInstanceRequests, "Collects AWS EC2 Spot Instance requests.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectServiceQuotas, "Collects AWS Service Quotas and their current usage.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectLoadBalancerCertificates, "Collects the TLS certificates attached to AWS ELB listeners.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectLoadBalancerTargets, "Collects the listeners, target groups and target health of AWS ELBs.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectAll, "Enqueues all relevant AWS tasks.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskLinkAll, "Creates links between the AWS models.")
 }
@@ -12,11 +12,13 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/aws/smithy-go"
 	"github.com/hibiken/asynq"
 
 	"github.com/gardener/inventory/pkg/aws/models"
 	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/utils/ptr"
 )
 
 const (
@@ -84,3 +86,39 @@ func MaybeSkipRetry(err error) error {
 
 	return err
 }
+
+// IsErrorCode returns true, if the given error is an AWS API error with any of
+// the specified error codes.
+func IsErrorCode(err error, codes ...string) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return slices.Contains(codes, apiErr.ErrorCode())
+	}
+
+	return false
+}
+
+// DedupListenerCertificates returns the given ELB v2 listener certificates
+// without duplicate ARNs. DescribeListenerCertificates returns the default
+// certificate of a listener twice, once with IsDefault set and once without, in
+// which case the default entry is kept. The order of the certificates is
+// preserved.
+func DedupListenerCertificates(certs []elbv2types.Certificate) []elbv2types.Certificate {
+	result := make([]elbv2types.Certificate, 0, len(certs))
+	indexes := make(map[string]int)
+	for _, cert := range certs {
+		arn := ptr.StringFromPointer(cert.CertificateArn)
+		idx, ok := indexes[arn]
+		if !ok {
+			indexes[arn] = len(result)
+			result = append(result, cert)
+
+			continue
+		}
+		if ptr.Value(cert.IsDefault, false) {
+			result[idx] = cert
+		}
+	}
+
+	return result
+}
@@ -5,10 +5,14 @@
 package utils_test
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/smithy-go"
 
 	"github.com/gardener/inventory/pkg/aws/utils"
 	"github.com/gardener/inventory/pkg/utils/ptr"
@@ -60,3 +64,114 @@ func TestFetchTag(t *testing.T) {
 		})
 	}
 }
+
+func TestIsErrorCode(t *testing.T) {
+	apiErr := &smithy.GenericAPIError{Code: "NoSuchLifecycleConfiguration"}
+	testCases := []struct {
+		desc   string
+		err    error
+		codes  []string
+		wanted bool
+	}{
+		{
+			desc:   "nil error",
+			err:    nil,
+			codes:  []string{"NoSuchLifecycleConfiguration"},
+			wanted: false,
+		},
+		{
+			desc:   "non-api error",
+			err:    errors.New("NoSuchLifecycleConfiguration"),
+			codes:  []string{"NoSuchLifecycleConfiguration"},
+			wanted: false,
+		},
+		{
+			desc:   "matching code",
+			err:    apiErr,
+			codes:  []string{"NoSuchPublicAccessBlockConfiguration", "NoSuchLifecycleConfiguration"},
+			wanted: true,
+		},
+		{
+			desc:   "wrapped matching code",
+			err:    fmt.Errorf("operation error: %w", apiErr),
+			codes:  []string{"NoSuchLifecycleConfiguration"},
+			wanted: true,
+		},
+		{
+			desc:   "different code",
+			err:    apiErr,
+			codes:  []string{"NoSuchPublicAccessBlockConfiguration"},
+			wanted: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := utils.IsErrorCode(tc.err, tc.codes...)
+			if got != tc.wanted {
+				t.Fatalf("got %v wanted %v", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestDedupListenerCertificates(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		certs       []elbv2types.Certificate
+		wantARNs    []string
+		wantDefault []bool
+	}{
+		{
+			desc:        "no certificates",
+			certs:       []elbv2types.Certificate{},
+			wantARNs:    []string{},
+			wantDefault: []bool{},
+		},
+		{
+			desc: "default certificate listed twice",
+			certs: []elbv2types.Certificate{
+				{CertificateArn: ptr.To("arn:cert-a"), IsDefault: ptr.To(false)},
+				{CertificateArn: ptr.To("arn:cert-b"), IsDefault: ptr.To(false)},
+				{CertificateArn: ptr.To("arn:cert-a"), IsDefault: ptr.To(true)},
+			},
+			wantARNs:    []string{"arn:cert-a", "arn:cert-b"},
+			wantDefault: []bool{true, false},
+		},
+		{
+			desc: "default certificate listed first",
+			certs: []elbv2types.Certificate{
+				{CertificateArn: ptr.To("arn:cert-a"), IsDefault: ptr.To(true)},
+				{CertificateArn: ptr.To("arn:cert-a"), IsDefault: ptr.To(false)},
+			},
+			wantARNs:    []string{"arn:cert-a"},
+			wantDefault: []bool{true},
+		},
+		{
+			desc: "unique certificates",
+			certs: []elbv2types.Certificate{
+				{CertificateArn: ptr.To("arn:cert-a"), IsDefault: ptr.To(true)},
+				{CertificateArn: ptr.To("arn:cert-b")},
+			},
+			wantARNs:    []string{"arn:cert-a", "arn:cert-b"},
+			wantDefault: []bool{true, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := utils.DedupListenerCertificates(tc.certs)
+			if len(got) != len(tc.wantARNs) {
+				t.Fatalf("got %d certificates, wanted %d", len(got), len(tc.wantARNs))
+			}
+			for i, cert := range got {
+				if arn := ptr.StringFromPointer(cert.CertificateArn); arn != tc.wantARNs[i] {
+					t.Fatalf("got certificate %q at %d, wanted %q", arn, i, tc.wantARNs[i])
+				}
+				if isDefault := ptr.Value(cert.IsDefault, false); isDefault != tc.wantDefault[i] {
+					t.Fatalf("got default %t for %q, wanted %t", isDefault, tc.wantARNs[i], tc.wantDefault[i])
+				}
+			}
+		})
+	}
+}
@@ -29,6 +29,7 @@ const (
 	StorageAccountModelName                = "az:model:storage_account"
 	BlobContainerModelName                 = "az:model:blob_container"
 	UserModelName                          = "az:model:user"
+	AppGatewayCertificateModelName         = "az:model:app_gateway_certificate"
 	ResourceGroupToSubscriptionModelName   = "az:model:link_rg_to_subscription"
 	VirtualMachineToResourceGroupModelName = "az:model:link_vm_to_rg"
 	PublicAddressToResourceGroupModelName  = "az:model:link_public_address_to_rg"
@@ -41,17 +42,18 @@ const (
 // models specifies the mapping between name and model type, which will be
 // registered with [registry.ModelRegistry].
 var models = map[string]any{
-	SubscriptionModelName:     &Subscription{},
-	ResourceGroupModelName:    &ResourceGroup{},
-	VirtualMachineModelName:   &VirtualMachine{},
-	NetworkInterfaceModelName: &NetworkInterface{},
-	PublicAddressModelName:    &PublicAddress{},
-	LoadBalancerModelName:     &LoadBalancer{},
-	VPCModelName:              &VPC{},
-	SubnetModelName:           &Subnet{},
-	StorageAccountModelName:   &StorageAccount{},
-	BlobContainerModelName:    &BlobContainer{},
-	UserModelName:             &User{},
+	SubscriptionModelName:          &Subscription{},
+	ResourceGroupModelName:         &ResourceGroup{},
+	VirtualMachineModelName:        &VirtualMachine{},
+	NetworkInterfaceModelName:      &NetworkInterface{},
+	PublicAddressModelName:         &PublicAddress{},
+	LoadBalancerModelName:          &LoadBalancer{},
+	VPCModelName:                   &VPC{},
+	SubnetModelName:                &Subnet{},
+	StorageAccountModelName:        &StorageAccount{},
+	BlobContainerModelName:         &BlobContainer{},
+	UserModelName:                  &User{},
+	AppGatewayCertificateModelName: &AppGatewayCertificate{},
 
 	// Link models
 	ResourceGroupToSubscriptionModelName:   &ResourceGroupToSubscription{},
@@ -323,9 +325,118 @@ type User struct {
 	Mail     string `bun:"mail,notnull"`
 }
 
+// AppGatewayCertificate represents an SSL certificate of an Azure Application
+// Gateway.
+type AppGatewayCertificate struct {
+	bun.BaseModel `bun:"table:az_app_gateway_certificate"`
+	coremodels.Model
+
+	Name                    string         `bun:"name,notnull,unique:az_app_gateway_certificate_key"`
+	GatewayName             string         `bun:"gateway_name,notnull,unique:az_app_gateway_certificate_key"`
+	SubscriptionID          string         `bun:"subscription_id,notnull,unique:az_app_gateway_certificate_key"`
+	ResourceGroupName       string         `bun:"resource_group,notnull,unique:az_app_gateway_certificate_key"`
+	Location                string         `bun:"location,notnull"`
+	ProvisioningState       string         `bun:"provisioning_state,notnull"`
+	KeyVaultSecretID        string         `bun:"key_vault_secret_id,nullzero"`
+	Subject                 string         `bun:"subject,nullzero"`
+	SubjectAlternativeNames []string       `bun:"subject_alternative_names,array,nullzero"`
+	NotAfter                time.Time      `bun:"not_after,nullzero"`
+	Subscription            *Subscription  `bun:"rel:has-one,join:subscription_id=subscription_id"`
+	ResourceGroup           *ResourceGroup `bun:"rel:has-one,join:resource_group=name,join:subscription_id=subscription_id"`
+}
+
+// accountLinks maps the models, which reference an Azure subscription, to the
+// column holding the reference.
+var accountLinks = map[string]string{
+	SubscriptionModelName:          "subscription_id",
+	ResourceGroupModelName:         "subscription_id",
+	VirtualMachineModelName:        "subscription_id",
+	NetworkInterfaceModelName:      "subscription_id",
+	PublicAddressModelName:         "subscription_id",
+	LoadBalancerModelName:          "subscription_id",
+	VPCModelName:                   "subscription_id",
+	SubnetModelName:                "subscription_id",
+	StorageAccountModelName:        "subscription_id",
+	BlobContainerModelName:         "subscription_id",
+	AppGatewayCertificateModelName: "subscription_id",
+}
+
+// shootResources specifies the resolvers, which map the models to the
+// Gardener Shoots owning them.
+var shootResources = map[string]registry.ShootResource{
+	"az:vm:machine-provider-id": {
+		ModelName:  VirtualMachineModelName,
+		Kind:       registry.ShootResourceKindInstance,
+		Method:     registry.ShootResourceMethodProviderID,
+		Confidence: registry.ShootResourceConfidenceHigh,
+		Query: `SELECT s.id AS shoot_id, vm.id AS resource_id FROM g_machine AS m
+INNER JOIN g_shoot AS s ON s.technical_id = m.namespace AND s.landscape = m.landscape
+INNER JOIN az_vm AS vm ON lower(vm.subscription_id) = lower(split_part(m.provider_id, '/', 5))
+AND lower(vm.resource_group) = lower(split_part(m.provider_id, '/', 7))
+AND vm.name = substring(m.provider_id from '[^/]+$')
+WHERE m.provider_id LIKE 'azure://%'`,
+	},
+	"az:loadbalancer:rg-technical-id": {
+		ModelName:  LoadBalancerModelName,
+		Kind:       registry.ShootResourceKindLoadBalancer,
+		Method:     registry.ShootResourceMethodTechnicalID,
+		Confidence: registry.ShootResourceConfidenceMedium,
+		Query: `SELECT s.id AS shoot_id, lb.id AS resource_id FROM az_lb AS lb
+INNER JOIN aux_account_landscape AS al ON al.provider = 'azure' AND al.account_id = lower(lb.subscription_id)
+INNER JOIN g_shoot AS s ON s.technical_id = lb.resource_group AND s.landscape = al.landscape`,
+	},
+	"az:vpc:rg-technical-id": {
+		ModelName:  VPCModelName,
+		Kind:       registry.ShootResourceKindNetwork,
+		Method:     registry.ShootResourceMethodTechnicalID,
+		Confidence: registry.ShootResourceConfidenceMedium,
+		Query: `SELECT s.id AS shoot_id, v.id AS resource_id FROM az_vpc AS v
+INNER JOIN aux_account_landscape AS al ON al.provider = 'azure' AND al.account_id = lower(v.subscription_id)
+INNER JOIN g_shoot AS s ON s.technical_id = v.resource_group AND s.landscape = al.landscape`,
+	},
+}
+
+// driftChecks specifies the checks, which compare the desired worker
+// configuration of Gardener Machines against the actual Azure resources.
+var driftChecks = map[string]registry.DriftCheck{
+	"az:vm:machine-type": {
+		ModelName: VirtualMachineModelName,
+		Attribute: registry.DriftAttributeMachineType,
+		Query: `SELECT s.id AS shoot_id, vm.id AS resource_id, mc.machine_type AS desired, vm.vm_size AS actual
+FROM g_machine AS m
+INNER JOIN g_shoot AS s ON s.technical_id = m.namespace AND s.landscape = m.landscape
+INNER JOIN g_machine_class AS mc ON mc.name = m.machine_class AND mc.namespace = m.namespace AND mc.landscape = m.landscape
+INNER JOIN az_vm AS vm ON lower(vm.subscription_id) = lower(split_part(m.provider_id, '/', 5))
+AND lower(vm.resource_group) = lower(split_part(m.provider_id, '/', 7))
+AND vm.name = substring(m.provider_id from '[^/]+$')
+WHERE m.provider_id LIKE 'azure://%' AND lower(vm.vm_size) <> lower(mc.machine_type)`,
+	},
+}
+
 // init registers the models with the [registry.ModelRegistry].
 func init() {
 	for k, v := range models {
 		registry.ModelRegistry.MustRegister(k, v)
 	}
+
+	registry.AccountSourceRegistry.MustRegister("az", registry.AccountSource{
+		ModelName:  SubscriptionModelName,
+		IDColumn:   "subscription_id",
+		NameColumn: "name",
+	})
+
+	for k, v := range accountLinks {
+		registry.AccountLinkRegistry.MustRegister(k, registry.AccountLink{
+			Provider: "az",
+			Column:   v,
+		})
+	}
+
+	for k, v := range shootResources {
+		registry.ShootResourceRegistry.MustRegister(k, v)
+	}
+
+	for k, v := range driftChecks {
+		registry.DriftCheckRegistry.MustRegister(k, v)
+	}
 }
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/gardener/inventory/pkg/azure/models"
+	azureclients "github.com/gardener/inventory/pkg/clients/azure"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// newAccountCollectTasks returns the tasks for collecting the Azure resources
+// of the given subscription. Resources are collected from the resource groups
+// of the subscription, which are known in the database. If no resource groups
+// are known yet, then only the resource groups are collected.
+func newAccountCollectTasks(ctx context.Context, subscriptionID string) ([]*asynq.Task, error) {
+	if !azureclients.ResourceGroupsClientset.Exists(subscriptionID) {
+		return nil, nil
+	}
+
+	resourceGroups := make([]models.ResourceGroup, 0)
+	err := db.DB.NewSelect().
+		Model(&resourceGroups).
+		Where("subscription_id = ?", subscriptionID).
+		Scan(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource groups: %w", err)
+	}
+
+	payloads := []struct {
+		name    string
+		payload any
+	}{
+		{TaskCollectResourceGroups, CollectResourceGroupsPayload{SubscriptionID: subscriptionID}},
+	}
+
+	for _, rg := range resourceGroups {
+		payloads = append(payloads, []struct {
+			name    string
+			payload any
+		}{
+			{TaskCollectVirtualMachines, CollectVirtualMachinesPayload{SubscriptionID: subscriptionID, ResourceGroup: rg.Name}},
+			{TaskCollectPublicAddresses, CollectPublicAddressesPayload{SubscriptionID: subscriptionID, ResourceGroup: rg.Name}},
+			{TaskCollectLoadBalancers, CollectLoadBalancersPayload{SubscriptionID: subscriptionID, ResourceGroup: rg.Name}},
+			{TaskCollectAppGatewayCertificates, CollectAppGatewayCertificatesPayload{SubscriptionID: subscriptionID, ResourceGroup: rg.Name}},
+			{TaskCollectVPCs, CollectVPCsPayload{SubscriptionID: subscriptionID, ResourceGroup: rg.Name}},
+			{TaskCollectStorageAccounts, CollectStorageAccountsPayload{SubscriptionID: subscriptionID, ResourceGroup: rg.Name}},
+			{TaskCollectNetworkInterfaces, CollectNetworkInterfacesPayload{SubscriptionID: subscriptionID, ResourceGroup: rg.Name}},
+		}...)
+	}
+
+	items := make([]*asynq.Task, 0, len(payloads))
+	for _, item := range payloads {
+		task, err := asynqutils.NewTask(item.name, item.payload)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, task)
+	}
+
+	return items, nil
+}
+
+func init() {
+	registry.AccountCollectorRegistry.MustRegister("az", registry.AccountCollector{
+		NewTasks:  newAccountCollectTasks,
+		LinkTasks: []string{TaskLinkAll},
+	})
+}
@@ -0,0 +1,295 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+
+	armnetwork "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v6"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gardener/inventory/pkg/azure/models"
+	azureutils "github.com/gardener/inventory/pkg/azure/utils"
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	azureclients "github.com/gardener/inventory/pkg/clients/azure"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/ptr"
+)
+
+// TaskCollectAppGatewayCertificates is the name of the task for collecting the
+// SSL certificates of Azure Application Gateways.
+const TaskCollectAppGatewayCertificates = "az:task:collect-app-gateway-certificates"
+
+// CollectAppGatewayCertificatesPayload is the payload used for collecting the
+// SSL certificates of Azure Application Gateways.
+type CollectAppGatewayCertificatesPayload struct {
+	// SubscriptionID specifies the Azure Subscription ID from which to
+	// collect.
+	SubscriptionID string `json:"subscription_id" yaml:"subscription_id" desc:"The Azure Subscription ID from which to collect" example:"00000000-0000-0000-0000-000000000000"`
+
+	// ResourceGroup specifies from which resource group to collect.
+	ResourceGroup string `json:"resource_group" yaml:"resource_group" desc:"The resource group from which to collect" example:"my-resource-group"`
+}
+
+// NewCollectAppGatewayCertificatesTask creates a new [asynq.Task] for
+// collecting the SSL certificates of Azure Application Gateways, without
+// specifying a payload.
+func NewCollectAppGatewayCertificatesTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectAppGatewayCertificates, nil)
+}
+
+// HandleCollectAppGatewayCertificatesTask is the handler, which collects the
+// SSL certificates of Azure Application Gateways.
+func HandleCollectAppGatewayCertificatesTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we enqueue collection from
+	// all known resource groups.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectAppGatewayCertificates(ctx)
+	}
+
+	var payload CollectAppGatewayCertificatesPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.SubscriptionID == "" {
+		return asynqutils.SkipRetry(ErrNoSubscriptionID)
+	}
+	if payload.ResourceGroup == "" {
+		return asynqutils.SkipRetry(ErrNoResourceGroup)
+	}
+
+	return collectAppGatewayCertificates(ctx, payload)
+}
+
+// enqueueCollectAppGatewayCertificates enqueues tasks for collecting the SSL
+// certificates of Azure Application Gateways for the known Resource Groups.
+func enqueueCollectAppGatewayCertificates(ctx context.Context) error {
+	resourceGroups, err := azureutils.GetResourceGroupsFromDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Enqueue task for each resource group
+	logger := asynqutils.GetLogger(ctx)
+	queue := asynqutils.GetQueueName(ctx)
+	for _, rg := range resourceGroups {
+		if !azureclients.ApplicationGatewaysClientset.Exists(rg.SubscriptionID) {
+			logger.Warn(
+				"Azure Application Gateway client not found",
+				"subscription_id", rg.SubscriptionID,
+				"resource_group", rg.Name,
+			)
+
+			continue
+		}
+
+		payload := CollectAppGatewayCertificatesPayload{
+			SubscriptionID: rg.SubscriptionID,
+			ResourceGroup:  rg.Name,
+		}
+
+		data, err := json.Marshal(payload)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for Azure Application Gateway certificates",
+				"subscription_id", rg.SubscriptionID,
+				"resource_group", rg.Name,
+				"reason", err,
+			)
+
+			continue
+		}
+		task := asynq.NewTask(TaskCollectAppGatewayCertificates, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"subscription_id", rg.SubscriptionID,
+				"resource_group", rg.Name,
+			)
+
+			continue
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"subscription_id", rg.SubscriptionID,
+				"resource_group", rg.Name,
+				"reason", err,
+			)
+
+			continue
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"subscription_id", rg.SubscriptionID,
+			"resource_group", rg.Name,
+		)
+	}
+
+	return nil
+}
+
+// collectAppGatewayCertificates collects the SSL certificates of the Azure
+// Application Gateways from the subscription and resource group specified in
+// the payload.
+func collectAppGatewayCertificates(ctx context.Context, payload CollectAppGatewayCertificatesPayload) error {
+	client, ok := azureclients.ApplicationGatewaysClientset.Get(payload.SubscriptionID)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.SubscriptionID))
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info(
+		"collecting Azure Application Gateway certificates",
+		"subscription_id", payload.SubscriptionID,
+		"resource_group", payload.ResourceGroup,
+	)
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			appGatewayCertificatesDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.SubscriptionID,
+			payload.ResourceGroup,
+		)
+		key := metrics.Key(TaskCollectAppGatewayCertificates, payload.SubscriptionID, payload.ResourceGroup)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	items := make([]models.AppGatewayCertificate, 0)
+	pager := client.Client.NewListPager(
+		payload.ResourceGroup,
+		&armnetwork.ApplicationGatewaysClientListOptions{},
+	)
+
+	for pager.More() {
+		metrics.IncPages(ctx)
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			logger.Error(
+				"failed to get Azure Application Gateways",
+				"subscription_id", payload.SubscriptionID,
+				"resource_group", payload.ResourceGroup,
+				"reason", err,
+			)
+
+			return azureutils.MaybeSkipRetry(err)
+		}
+
+		for _, gw := range page.Value {
+			if gw.Properties == nil {
+				continue
+			}
+
+			for _, cert := range gw.Properties.SSLCertificates {
+				item := models.AppGatewayCertificate{
+					Name:              ptr.Value(cert.Name, ""),
+					GatewayName:       ptr.Value(gw.Name, ""),
+					SubscriptionID:    payload.SubscriptionID,
+					ResourceGroupName: payload.ResourceGroup,
+					Location:          ptr.Value(gw.Location, ""),
+				}
+
+				props := cert.Properties
+				if props == nil {
+					items = append(items, item)
+
+					continue
+				}
+
+				item.ProvisioningState = string(ptr.Value(props.ProvisioningState, armnetwork.ProvisioningState("")))
+				item.KeyVaultSecretID = ptr.Value(props.KeyVaultSecretID, "")
+
+				// Certificates referenced from a Key Vault may not
+				// expose their public data.
+				publicCertData := ptr.Value(props.PublicCertData, "")
+				if publicCertData != "" {
+					certs, err := azureutils.ParsePublicCertData(publicCertData)
+					if err != nil {
+						logger.Warn(
+							"failed to parse Azure Application Gateway certificate",
+							"subscription_id", payload.SubscriptionID,
+							"resource_group", payload.ResourceGroup,
+							"gateway", item.GatewayName,
+							"certificate", item.Name,
+							"reason", err,
+						)
+					}
+					if leaf := leafCertificate(certs); leaf != nil {
+						item.Subject = leaf.Subject.String()
+						item.SubjectAlternativeNames = leaf.DNSNames
+						item.NotAfter = leaf.NotAfter
+					}
+				}
+
+				items = append(items, item)
+			}
+		}
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (subscription_id, resource_group, gateway_name, name) DO UPDATE").
+		Set("location = EXCLUDED.location").
+		Set("provisioning_state = EXCLUDED.provisioning_state").
+		Set("key_vault_secret_id = EXCLUDED.key_vault_secret_id").
+		Set("subject = EXCLUDED.subject").
+		Set("subject_alternative_names = EXCLUDED.subject_alternative_names").
+		Set("not_after = EXCLUDED.not_after").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info("populated azure application gateway certificates", "count", count)
+
+	return nil
+}
+
+// leafCertificate returns the first non-CA certificate from the given chain.
+// If the chain consists of CA certificates only, the first one is returned.
+func leafCertificate(certs []*x509.Certificate) *x509.Certificate {
+	for _, cert := range certs {
+		if !cert.IsCA {
+			return cert
+		}
+	}
+
+	if len(certs) > 0 {
+		return certs[0]
+	}
+
+	return nil
+}
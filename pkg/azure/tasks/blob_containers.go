@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
@@ -31,13 +32,13 @@ const TaskCollectBlobContainers = "az:task:collect-blob-containers"
 type CollectBlobContainersPayload struct {
 	// SubscriptionID specifies the Azure Subscription ID from which to
 	// collect.
-	SubscriptionID string `json:"subscription_id" yaml:"subscription_id"`
+	SubscriptionID string `json:"subscription_id" yaml:"subscription_id" desc:"The Azure Subscription ID from which to collect" example:"00000000-0000-0000-0000-000000000000"`
 
 	// ResourceGroup specifies from which resource group to collect.
-	ResourceGroup string `json:"resource_group" yaml:"resource_group"`
+	ResourceGroup string `json:"resource_group" yaml:"resource_group" desc:"The resource group from which to collect" example:"my-resource-group"`
 
 	// StorageAccount specifies from which storage account to collect.
-	StorageAccount string `json:"storage_account" yaml:"storage_account"`
+	StorageAccount string `json:"storage_account" yaml:"storage_account" desc:"The storage account from which to collect" example:"mystorageaccount"`
 }
 
 // NewCollectBlobContainersTask creates a new [asynq.Task] for collecting Azure
@@ -116,7 +117,19 @@ func enqueueCollectBlobContainers(ctx context.Context) error {
 			continue
 		}
 		task := asynq.NewTask(TaskCollectBlobContainers, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"subscription_id", acc.SubscriptionID,
+				"resource_group", acc.ResourceGroupName,
+				"storage_account", acc.Name,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -187,6 +200,7 @@ func collectBlobContainers(ctx context.Context, payload CollectBlobContainersPay
 	)
 
 	for pager.More() {
+		metrics.IncPages(ctx)
 		page, err := pager.NextPage(ctx)
 		if err != nil {
 			logger.Error(
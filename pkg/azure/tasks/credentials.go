@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"os"
+
+	"github.com/gardener/inventory/pkg/azure/models"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/utils"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// CredentialKindWorkloadIdentityToken is the kind of expiring credentials,
+// which represent the tokens used for Azure Workload Identity Federation.
+const CredentialKindWorkloadIdentityToken = "azure_workload_identity_token"
+
+// CredentialKindAppGatewayCertificate is the kind of expiring credentials,
+// which represent the SSL certificates of Azure Application Gateways.
+const CredentialKindAppGatewayCertificate = "az_app_gateway_certificate"
+
+// workloadIdentityTokenCredentials returns the tokens of the configured Azure
+// named credentials using Workload Identity Federation as
+// [registry.ExpiringCredential] items.
+func workloadIdentityTokenCredentials(ctx context.Context) ([]registry.ExpiringCredential, error) {
+	logger := asynqutils.GetLogger(ctx)
+	conf := asynqutils.GetConfig(ctx)
+	items := make([]registry.ExpiringCredential, 0)
+	for name, creds := range conf.Azure.Credentials {
+		if creds.Authentication != config.AzureAuthenticationMethodWorkloadIdentity {
+			continue
+		}
+
+		data, err := os.ReadFile(creds.WorkloadIdentity.TokenFile)
+		if err != nil {
+			logger.Warn(
+				"cannot read azure workload identity token",
+				"credentials", name,
+				"reason", err,
+			)
+
+			continue
+		}
+
+		expiresAt, err := utils.JWTExpiration(string(data))
+		if err != nil {
+			logger.Warn(
+				"cannot parse azure workload identity token",
+				"credentials", name,
+				"reason", err,
+			)
+
+			continue
+		}
+
+		item := registry.ExpiringCredential{
+			Kind:      CredentialKindWorkloadIdentityToken,
+			Name:      name,
+			Source:    creds.WorkloadIdentity.ClientID,
+			ExpiresAt: expiresAt,
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// appGatewayCertificateCredentials returns the collected SSL certificates of
+// Azure Application Gateways as [registry.ExpiringCredential] items.
+func appGatewayCertificateCredentials(ctx context.Context) ([]registry.ExpiringCredential, error) {
+	var certs []models.AppGatewayCertificate
+	err := db.DB.NewSelect().
+		Model(&certs).
+		Where("not_after IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]registry.ExpiringCredential, 0, len(certs))
+	for _, cert := range certs {
+		item := registry.ExpiringCredential{
+			Kind:      CredentialKindAppGatewayCertificate,
+			Name:      cert.Name,
+			Source:    cert.GatewayName,
+			ExpiresAt: cert.NotAfter,
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func init() {
+	registry.ExpiringCredentialRegistry.MustRegister(CredentialKindWorkloadIdentityToken, workloadIdentityTokenCredentials)
+	registry.ExpiringCredentialRegistry.MustRegister(CredentialKindAppGatewayCertificate, appGatewayCertificateCredentials)
+}
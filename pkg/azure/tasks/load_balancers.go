@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	armnetwork "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v6"
 	"github.com/hibiken/asynq"
@@ -31,10 +32,10 @@ const TaskCollectLoadBalancers = "az:task:collect-loadbalancers"
 type CollectLoadBalancersPayload struct {
 	// SubscriptionID specifies the Azure Subscription ID from which to
 	// collect.
-	SubscriptionID string `json:"subscription_id" yaml:"subscription_id"`
+	SubscriptionID string `json:"subscription_id" yaml:"subscription_id" desc:"The Azure Subscription ID from which to collect" example:"00000000-0000-0000-0000-000000000000"`
 
 	// ResourceGroup specifies from which resource group to collect.
-	ResourceGroup string `json:"resource_group" yaml:"resource_group"`
+	ResourceGroup string `json:"resource_group" yaml:"resource_group" desc:"The resource group from which to collect" example:"my-resource-group"`
 }
 
 // NewCollectLoadBalancersTask creates a new [asynq.Task] for collecting Azure
@@ -107,7 +108,18 @@ func enqueueCollectLoadBalancers(ctx context.Context) error {
 			continue
 		}
 		task := asynq.NewTask(TaskCollectLoadBalancers, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"subscription_id", rg.SubscriptionID,
+				"resource_group", rg.Name,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -168,6 +180,7 @@ func collectLoadBalancers(ctx context.Context, payload CollectLoadBalancersPaylo
 	)
 
 	for pager.More() {
+		metrics.IncPages(ctx)
 		page, err := pager.NextPage(ctx)
 		if err != nil {
 			logger.Error(
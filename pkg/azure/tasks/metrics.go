@@ -47,6 +47,15 @@ var (
 		nil,
 	)
 
+	// appGatewayCertificatesDesc is the descriptor for a metric, which
+	// tracks the number of collected Azure Application Gateway certificates.
+	appGatewayCertificatesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "az_app_gateway_certificates"),
+		"A gauge which tracks the number of collected Azure Application Gateway certificates",
+		[]string{"subscription_id", "resource_group"},
+		nil,
+	)
+
 	// blobContainersDesc is the descriptor for a metric, which tracks the
 	// number of collected Azure Blob Containers.
 	blobContainersDesc = prometheus.NewDesc(
@@ -109,6 +118,7 @@ func init() {
 		vpcsDesc,
 		subnetsDesc,
 		loadBalancersDesc,
+		appGatewayCertificatesDesc,
 		blobContainersDesc,
 		resourceGroupsDesc,
 		publicAddressesDesc,
@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net"
 
 	armnetwork "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v6"
@@ -32,10 +33,10 @@ const TaskCollectPublicAddresses = "az:task:collect-public-addresses"
 type CollectPublicAddressesPayload struct {
 	// SubscriptionID specifies the Azure Subscription ID from which to
 	// collect.
-	SubscriptionID string `json:"subscription_id" yaml:"subscription_id"`
+	SubscriptionID string `json:"subscription_id" yaml:"subscription_id" desc:"The Azure Subscription ID from which to collect" example:"00000000-0000-0000-0000-000000000000"`
 
 	// ResourceGroup specifies from which resource group to collect.
-	ResourceGroup string `json:"resource_group" yaml:"resource_group"`
+	ResourceGroup string `json:"resource_group" yaml:"resource_group" desc:"The resource group from which to collect" example:"my-resource-group"`
 }
 
 // NewCollectPublicAddressesTask creates a new [asynq.Task] for collecting Azure
@@ -108,7 +109,18 @@ func enqueueCollectPublicAddresses(ctx context.Context) error {
 			continue
 		}
 		task := asynq.NewTask(TaskCollectPublicAddresses, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"subscription_id", rg.SubscriptionID,
+				"resource_group", rg.Name,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -169,6 +181,7 @@ func collectPublicAddresses(ctx context.Context, payload CollectPublicAddressesP
 	)
 
 	for pager.More() {
+		metrics.IncPages(ctx)
 		page, err := pager.NextPage(ctx)
 		if err != nil {
 			logger.Error(
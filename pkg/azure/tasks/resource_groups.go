@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/hibiken/asynq"
@@ -32,7 +33,7 @@ const TaskCollectResourceGroups = "az:task:collect-resource-groups"
 type CollectResourceGroupsPayload struct {
 	// SubscriptionID specifies the Azure Subscription ID from which to
 	// collect.
-	SubscriptionID string `json:"subscription_id" yaml:"subscription_id"`
+	SubscriptionID string `json:"subscription_id" yaml:"subscription_id" desc:"The Azure Subscription ID from which to collect" example:"00000000-0000-0000-0000-000000000000"`
 }
 
 // NewCollectResourceGroupsTask creates a new [asynq.Task] for collecting Azure
@@ -89,7 +90,17 @@ func enqueueCollectResourceGroups(ctx context.Context) error {
 			return registry.ErrContinue
 		}
 		task := asynq.NewTask(TaskCollectResourceGroups, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"subscription_id", subscriptionID,
+			)
+
+			return registry.ErrContinue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -141,6 +152,7 @@ func collectResourceGroups(ctx context.Context, payload CollectResourceGroupsPay
 	items := make([]models.ResourceGroup, 0)
 	pager := client.Client.NewListPager(&armresources.ResourceGroupsClientListOptions{})
 	for pager.More() {
+		metrics.IncPages(ctx)
 		page, err := pager.NextPage(ctx)
 		if err != nil {
 			logger.Error(
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v6"
+	"github.com/hibiken/asynq"
+
+	azureclients "github.com/gardener/inventory/pkg/clients/azure"
+	"github.com/gardener/inventory/pkg/core/registry"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// newShootCollectTasks returns the tasks for collecting the Azure resources of
+// the Gardener Shoot described by the given scope. The resources of a Shoot
+// are located in a resource group named after the technical ID of the Shoot.
+// If no subscriptions are known for the Shoot, then the resources are
+// collected from all registered subscriptions.
+func newShootCollectTasks(scope registry.ShootScope) ([]*asynq.Task, error) {
+	subscriptions := scope.Accounts
+	if len(subscriptions) == 0 {
+		_ = azureclients.VirtualMachinesClientset.Range(func(subscriptionID string, _ *azureclients.Client[*armcompute.VirtualMachinesClient]) error {
+			subscriptions = append(subscriptions, subscriptionID)
+
+			return nil
+		})
+	}
+
+	items := make([]*asynq.Task, 0)
+	for _, subscriptionID := range subscriptions {
+		if !azureclients.VirtualMachinesClientset.Exists(subscriptionID) {
+			continue
+		}
+
+		payloads := []struct {
+			name    string
+			payload any
+		}{
+			{TaskCollectResourceGroups, CollectResourceGroupsPayload{SubscriptionID: subscriptionID}},
+			{TaskCollectVPCs, CollectVPCsPayload{SubscriptionID: subscriptionID, ResourceGroup: scope.TechnicalID}},
+			{TaskCollectVirtualMachines, CollectVirtualMachinesPayload{SubscriptionID: subscriptionID, ResourceGroup: scope.TechnicalID}},
+			{TaskCollectNetworkInterfaces, CollectNetworkInterfacesPayload{SubscriptionID: subscriptionID, ResourceGroup: scope.TechnicalID}},
+			{TaskCollectPublicAddresses, CollectPublicAddressesPayload{SubscriptionID: subscriptionID, ResourceGroup: scope.TechnicalID}},
+			{TaskCollectLoadBalancers, CollectLoadBalancersPayload{SubscriptionID: subscriptionID, ResourceGroup: scope.TechnicalID}},
+		}
+
+		for _, item := range payloads {
+			task, err := asynqutils.NewTask(item.name, item.payload)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, task)
+		}
+	}
+
+	return items, nil
+}
+
+func init() {
+	registry.ShootCollectorRegistry.MustRegister("az", registry.ShootCollector{
+		ProviderType: "azure",
+		NewTasks:     newShootCollectTasks,
+		LinkTasks:    []string{TaskLinkAll},
+	})
+}
@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	armnetwork "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v6"
 	"github.com/hibiken/asynq"
@@ -31,13 +32,13 @@ const TaskCollectSubnets = "az:task:collect-subnets"
 type CollectSubnetsPayload struct {
 	// SubscriptionID specifies the Azure Subscription ID from which to
 	// collect.
-	SubscriptionID string `json:"subscription_id" yaml:"subscription_id"`
+	SubscriptionID string `json:"subscription_id" yaml:"subscription_id" desc:"The Azure Subscription ID from which to collect" example:"00000000-0000-0000-0000-000000000000"`
 
 	// ResourceGroup specifies from which resource group to collect.
-	ResourceGroup string `json:"resource_group" yaml:"resource_group"`
+	ResourceGroup string `json:"resource_group" yaml:"resource_group" desc:"The resource group from which to collect" example:"my-resource-group"`
 
 	// VPCName specifies from which VPC to collect.
-	VPCName string `json:"vpc_name" yaml:"vpc_name"`
+	VPCName string `json:"vpc_name" yaml:"vpc_name" desc:"The VPC from which to collect" example:"my-vnet"`
 }
 
 // NewCollectSubnetsTask creates a new [asynq.Task] for collecting Azure
@@ -114,7 +115,19 @@ func enqueueCollectSubnets(ctx context.Context) error {
 			continue
 		}
 		task := asynq.NewTask(TaskCollectSubnets, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"subscription_id", vpc.SubscriptionID,
+				"resource_group", vpc.ResourceGroupName,
+				"vpc", vpc.Name,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -185,6 +198,7 @@ func collectSubnets(ctx context.Context, payload CollectSubnetsPayload) error {
 	)
 
 	for pager.More() {
+		metrics.IncPages(ctx)
 		page, err := pager.NextPage(ctx)
 		if err != nil {
 			logger.Error(
@@ -10,9 +10,11 @@ import (
 	"github.com/hibiken/asynq"
 
 	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/config"
 	"github.com/gardener/inventory/pkg/core/registry"
 	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
 	dbutils "github.com/gardener/inventory/pkg/utils/db"
+	"github.com/gardener/inventory/pkg/utils/schema"
 )
 
 const (
@@ -35,6 +37,7 @@ func HandleCollectAllTask(ctx context.Context, _ *asynq.Task) error {
 		NewCollectVirtualMachinesTask,
 		NewCollectPublicAddressesTask,
 		NewCollectLoadBalancersTask,
+		NewCollectAppGatewayCertificatesTask,
 		NewCollectVPCsTask,
 		NewCollectSubnetsTask,
 		NewCollectStorageAccountsTask,
@@ -42,9 +45,32 @@ func HandleCollectAllTask(ctx context.Context, _ *asynq.Task) error {
 		NewCollectNetworkInterfacesTask,
 	}
 
+	// Collectors of disabled services are skipped
+	conf := asynqutils.GetConfig(ctx)
+	taskFns = asynqutils.SkipDisabledTasks(ctx, taskFns, ServiceCollectors(conf.Azure.Services))
+
 	return asynqutils.Enqueue(ctx, taskFns, asynq.Queue(queue))
 }
 
+// ServiceCollectors returns a mapping between the names of the Azure collector
+// tasks and whether the Azure service the collector depends on is enabled.
+func ServiceCollectors(services config.AzureServices) map[string]bool {
+	return map[string]bool{
+		TaskCollectSubscriptions:          services.ResourceManager.Enabled(),
+		TaskCollectResourceGroups:         services.ResourceManager.Enabled(),
+		TaskCollectVirtualMachines:        services.Compute.Enabled(),
+		TaskCollectVPCs:                   services.Network.Enabled(),
+		TaskCollectSubnets:                services.Network.Enabled(),
+		TaskCollectNetworkInterfaces:      services.Network.Enabled(),
+		TaskCollectPublicAddresses:        services.Network.Enabled(),
+		TaskCollectLoadBalancers:          services.Network.Enabled(),
+		TaskCollectAppGatewayCertificates: services.Network.Enabled(),
+		TaskCollectStorageAccounts:        services.Storage.Enabled(),
+		TaskCollectBlobContainers:         services.Storage.Enabled(),
+		TaskCollectUsers:                  services.Graph.Enabled(),
+	}
+}
+
 // HandleLinkAllTask is a handler, which establishes links between the various
 // Azure models.
 func HandleLinkAllTask(ctx context.Context, _ *asynq.Task) error {
@@ -71,10 +97,40 @@ func init() {
 	registry.TaskRegistry.MustRegister(TaskCollectVirtualMachines, asynq.HandlerFunc(HandleCollectVirtualMachinesTask))
 	registry.TaskRegistry.MustRegister(TaskCollectPublicAddresses, asynq.HandlerFunc(HandleCollectPublicAddressesTask))
 	registry.TaskRegistry.MustRegister(TaskCollectLoadBalancers, asynq.HandlerFunc(HandleCollectLoadBalancersTask))
+	registry.TaskRegistry.MustRegister(TaskCollectAppGatewayCertificates, asynq.HandlerFunc(HandleCollectAppGatewayCertificatesTask))
 	registry.TaskRegistry.MustRegister(TaskCollectVPCs, asynq.HandlerFunc(HandleCollectVPCsTask))
 	registry.TaskRegistry.MustRegister(TaskCollectSubnets, asynq.HandlerFunc(HandleCollectSubnetsTask))
 	registry.TaskRegistry.MustRegister(TaskCollectStorageAccounts, asynq.HandlerFunc(HandleCollectStorageAccountsTask))
 	registry.TaskRegistry.MustRegister(TaskCollectBlobContainers, asynq.HandlerFunc(HandleCollectBlobContainersTask))
 	registry.TaskRegistry.MustRegister(TaskCollectUsers, asynq.HandlerFunc(HandleCollectUsersTask))
 	registry.TaskRegistry.MustRegister(TaskCollectNetworkInterfaces, asynq.HandlerFunc(HandleCollectNetworkInterfacesTask))
+
+	// Payload schemas
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectResourceGroups, schema.For[CollectResourceGroupsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectVirtualMachines, schema.For[CollectVirtualMachinesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectPublicAddresses, schema.For[CollectPublicAddressesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectLoadBalancers, schema.For[CollectLoadBalancersPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectAppGatewayCertificates, schema.For[CollectAppGatewayCertificatesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectVPCs, schema.For[CollectVPCsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectSubnets, schema.For[CollectSubnetsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectStorageAccounts, schema.For[CollectStorageAccountsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectBlobContainers, schema.For[CollectBlobContainersPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectUsers, schema.For[CollectUsersPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectNetworkInterfaces, schema.For[CollectNetworkInterfacesPayload]())
+
+	// Task descriptions
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectAll, "Enqueues all relevant Azure tasks.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskLinkAll, "Establishes links between Azure models.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectSubscriptions, "Collects Azure Subscriptions.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectResourceGroups, "Collects Azure Resource Groups.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectVirtualMachines, "Collects Azure Virtual Machines.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectPublicAddresses, "Collects Azure Public IP Addresses.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectLoadBalancers, "Collects Azure Load Balancers.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectAppGatewayCertificates, "Collects the SSL certificates of Azure Application Gateways.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectVPCs, "Collects Azure VPCs.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectSubnets, "Collects Azure Subnets.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectStorageAccounts, "Collects Azure Storage Accounts.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectBlobContainers, "Collects Azure Blob containers.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectUsers, "Collects Microsoft Entra user accounts.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectNetworkInterfaces, "Collects Azure Network Interfaces.")
 }
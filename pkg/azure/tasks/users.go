@@ -27,11 +27,11 @@ const TaskCollectUsers = "az:task:collect-users"
 type CollectUsersPayload struct {
 	// TenantID specifies the Azure Tenant ID from which to
 	// collect.
-	TenantID string `json:"tenant_id" yaml:"tenant_id"`
+	TenantID string `json:"tenant_id" yaml:"tenant_id" desc:"The Azure Tenant ID from which to collect" example:"00000000-0000-0000-0000-000000000000"`
 
 	// UserPrincipalName specifies the principal name of the user to be
 	// collected.
-	UserPrincipalName string `json:"user_principal_name" yaml:"user_principal_name"`
+	UserPrincipalName string `json:"user_principal_name" yaml:"user_principal_name" desc:"The principal name of the user to be collected" example:"user@example.com"`
 }
 
 // HandleCollectUsersTask is the handler, which collects Microsoft Entra user
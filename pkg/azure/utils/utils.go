@@ -6,6 +6,9 @@ package utils
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/http"
@@ -125,3 +128,48 @@ func ExtractParentResourceNameFromID(resourceID string) string {
 
 	return ""
 }
+
+// pkcs7ContentInfo represents the outer ContentInfo structure of a PKCS #7
+// message as defined in RFC 2315.
+type pkcs7ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+// pkcs7SignedData represents the SignedData structure of a PKCS #7 message as
+// defined in RFC 2315. Only the certificates are of interest to us.
+type pkcs7SignedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	ContentInfo      asn1.RawValue
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue `asn1:"optional,tag:1"`
+	SignerInfos      asn1.RawValue
+}
+
+// ParsePublicCertData parses the base64-encoded public certificate data as
+// returned by the Azure API for Application Gateway SSL certificates. The data
+// is either a DER-encoded certificate, or a PKCS #7 bundle containing the
+// certificate chain.
+func ParsePublicCertData(data string) ([]*x509.Certificate, error) {
+	raw, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if certs, err := x509.ParseCertificates(raw); err == nil {
+		return certs, nil
+	}
+
+	var info pkcs7ContentInfo
+	if _, err := asn1.Unmarshal(raw, &info); err != nil {
+		return nil, fmt.Errorf("invalid pkcs7 content info: %w", err)
+	}
+
+	var signedData pkcs7SignedData
+	if _, err := asn1.Unmarshal(info.Content.Bytes, &signedData); err != nil {
+		return nil, fmt.Errorf("invalid pkcs7 signed data: %w", err)
+	}
+
+	return x509.ParseCertificates(signedData.Certificates.Bytes)
+}
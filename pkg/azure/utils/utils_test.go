@@ -5,10 +5,19 @@
 package utils_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
 	"errors"
+	"math/big"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	armcompute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v6"
@@ -91,3 +100,107 @@ func TestMaybeSkipRetry(t *testing.T) {
 		})
 	}
 }
+
+func TestParsePublicCertData(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notAfter := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com", "www.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Wrap the certificate in a degenerate PKCS #7 SignedData bundle
+	oidData := asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidSignedData := asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	innerContentInfo, err := asn1.Marshal(struct{ ContentType asn1.ObjectIdentifier }{oidData})
+	if err != nil {
+		t.Fatal(err)
+	}
+	signedData, err := asn1.Marshal(struct {
+		Version          int
+		DigestAlgorithms asn1.RawValue
+		ContentInfo      asn1.RawValue
+		Certificates     asn1.RawValue
+		SignerInfos      asn1.RawValue
+	}{
+		Version:          1,
+		DigestAlgorithms: asn1.RawValue{Tag: asn1.TagSet, IsCompound: true},
+		ContentInfo:      asn1.RawValue{FullBytes: innerContentInfo},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: der},
+		SignerInfos:      asn1.RawValue{Tag: asn1.TagSet, IsCompound: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs7, err := asn1.Marshal(struct {
+		ContentType asn1.ObjectIdentifier
+		Content     asn1.RawValue
+	}{
+		ContentType: oidSignedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: signedData},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		desc    string
+		input   string
+		wantErr bool
+	}{
+		{
+			desc:  "DER-encoded certificate",
+			input: base64.StdEncoding.EncodeToString(der),
+		},
+		{
+			desc:  "PKCS #7 bundle",
+			input: base64.StdEncoding.EncodeToString(pkcs7),
+		},
+		{
+			desc:    "invalid base64",
+			input:   "not base64!",
+			wantErr: true,
+		},
+		{
+			desc:    "invalid data",
+			input:   base64.StdEncoding.EncodeToString([]byte("invalid")),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			certs, err := utils.ParsePublicCertData(tc.input)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(certs) != 1 {
+				t.Fatalf("got %d certificates wanted 1", len(certs))
+			}
+			if certs[0].Subject.CommonName != "example.com" {
+				t.Fatalf("got subject %s wanted example.com", certs[0].Subject.CommonName)
+			}
+			if !certs[0].NotAfter.Equal(notAfter) {
+				t.Fatalf("got not after %s wanted %s", certs[0].NotAfter, notAfter)
+			}
+		})
+	}
+}
@@ -0,0 +1,325 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package backstage provides the rendering of the collected Gardener projects,
+// seeds and shoots as Backstage catalog entities, so that the inventory can
+// feed internal developer portals.
+package backstage
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/gardener/models"
+)
+
+// APIVersion is the API version of the rendered entities.
+const APIVersion = "backstage.io/v1alpha1"
+
+// Kinds of the rendered entities
+const (
+	// KindSystem is the kind of the entities for Gardener projects.
+	KindSystem = "System"
+
+	// KindResource is the kind of the entities for Gardener seeds and
+	// shoots.
+	KindResource = "Resource"
+)
+
+// Types of the rendered resources
+const (
+	// ResourceTypeSeed is the type of the resources for Gardener seeds.
+	ResourceTypeSeed = "gardener-seed"
+
+	// ResourceTypeShoot is the type of the resources for Gardener shoots.
+	ResourceTypeShoot = "kubernetes-cluster"
+)
+
+// DefaultOwner is the owner of the entities, which don't have an owner, e.g.
+// seeds, when no owner is specified.
+const DefaultOwner = "group:gardener"
+
+// ContentType is the content type of the rendered catalog.
+const ContentType = "application/yaml"
+
+// annotationPrefix is the prefix of the annotations of the rendered entities.
+const annotationPrefix = "inventory.gardener.cloud/"
+
+// maxNameLength is the max length of the names of Backstage entities.
+const maxNameLength = 63
+
+// Entity represents a Backstage catalog entity.
+type Entity struct {
+	// APIVersion specifies the API version of the entity.
+	APIVersion string `json:"apiVersion" yaml:"apiVersion"`
+
+	// Kind specifies the kind of the entity.
+	Kind string `json:"kind" yaml:"kind"`
+
+	// Metadata specifies the metadata of the entity.
+	Metadata Metadata `json:"metadata" yaml:"metadata"`
+
+	// Spec specifies the spec of the entity.
+	Spec Spec `json:"spec" yaml:"spec"`
+}
+
+// Metadata represents the metadata of a Backstage catalog entity.
+type Metadata struct {
+	// Name specifies the name of the entity.
+	Name string `json:"name" yaml:"name"`
+
+	// Namespace specifies the namespace of the entity, which is derived
+	// from the Gardener landscape.
+	Namespace string `json:"namespace" yaml:"namespace"`
+
+	// Title specifies the display name of the entity.
+	Title string `json:"title,omitempty" yaml:"title,omitempty"`
+
+	// Description specifies the description of the entity.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// Annotations specifies the annotations of the entity.
+	Annotations map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+}
+
+// Spec represents the spec of a Backstage System or Resource entity.
+type Spec struct {
+	// Type specifies the type of a Resource.
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+
+	// Owner specifies the entity reference of the owner.
+	Owner string `json:"owner" yaml:"owner"`
+
+	// System specifies the System, which a Resource belongs to.
+	System string `json:"system,omitempty" yaml:"system,omitempty"`
+
+	// DependsOn specifies the entity references of the Resources, which a
+	// Resource depends on.
+	DependsOn []string `json:"dependsOn,omitempty" yaml:"dependsOn,omitempty"`
+}
+
+// Options specifies the options for rendering the catalog entities.
+type Options struct {
+	// Landscape specifies the Gardener landscape to filter by, if not
+	// empty.
+	Landscape string
+
+	// Owner specifies the entity reference of the owner of entities
+	// without an owner. If empty, [DefaultOwner] is used.
+	Owner string
+}
+
+// ListEntities returns the catalog entities of the Gardener projects, seeds and
+// shoots matching the given options.
+func ListEntities(ctx context.Context, db bun.IDB, opts Options) ([]Entity, error) {
+	projects := make([]models.Project, 0)
+	seeds := make([]models.Seed, 0)
+	shoots := make([]models.Shoot, 0)
+
+	queries := []*bun.SelectQuery{
+		db.NewSelect().Model(&projects).Order("landscape", "name"),
+		db.NewSelect().Model(&seeds).Order("landscape", "name"),
+		db.NewSelect().Model(&shoots).Order("landscape", "project_name", "name"),
+	}
+	for _, query := range queries {
+		if opts.Landscape != "" {
+			query = query.Where("landscape = ?", opts.Landscape)
+		}
+		if err := query.Scan(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return Entities(projects, seeds, shoots, opts.Owner), nil
+}
+
+// Entities returns the catalog entities for the given Gardener projects, seeds
+// and shoots. Projects are rendered as Systems, and seeds and shoots as
+// Resources. The entities are namespaced by the Gardener landscape. The given
+// owner is used for entities without an owner.
+func Entities(projects []models.Project, seeds []models.Seed, shoots []models.Shoot, owner string) []Entity {
+	if owner == "" {
+		owner = DefaultOwner
+	}
+
+	owners := make(map[string]string, len(projects))
+	entities := make([]Entity, 0, len(projects)+len(seeds)+len(shoots))
+	for _, project := range projects {
+		projectOwner := owner
+		if project.Owner != "" {
+			projectOwner = "user:" + EntityName(project.Owner)
+		}
+		owners[project.Landscape+"/"+project.Name] = projectOwner
+
+		entities = append(entities, Entity{
+			APIVersion: APIVersion,
+			Kind:       KindSystem,
+			Metadata: Metadata{
+				Name:        EntityName(project.Name),
+				Namespace:   Namespace(project.Landscape),
+				Title:       project.Name,
+				Description: project.Purpose,
+				Annotations: annotations(
+					"landscape", project.Landscape,
+					"namespace", project.Namespace,
+					"status", project.Status,
+				),
+			},
+			Spec: Spec{
+				Owner: projectOwner,
+			},
+		})
+	}
+
+	for _, seed := range seeds {
+		entities = append(entities, Entity{
+			APIVersion: APIVersion,
+			Kind:       KindResource,
+			Metadata: Metadata{
+				Name:      EntityName(seed.Name),
+				Namespace: Namespace(seed.Landscape),
+				Title:     seed.Name,
+				Annotations: annotations(
+					"landscape", seed.Landscape,
+					"kubernetes-version", seed.KubernetesVersion,
+				),
+			},
+			Spec: Spec{
+				Type:  ResourceTypeSeed,
+				Owner: owner,
+			},
+		})
+	}
+
+	for _, shoot := range shoots {
+		shootOwner, ok := owners[shoot.Landscape+"/"+shoot.ProjectName]
+		if !ok {
+			shootOwner = owner
+		}
+
+		var dependsOn []string
+		if shoot.SeedName != "" {
+			dependsOn = []string{"resource:" + EntityName(shoot.SeedName)}
+		}
+
+		entities = append(entities, Entity{
+			APIVersion: APIVersion,
+			Kind:       KindResource,
+			Metadata: Metadata{
+				Name:      EntityName(shoot.ProjectName + "." + shoot.Name),
+				Namespace: Namespace(shoot.Landscape),
+				Title:     shoot.Name,
+				Annotations: annotations(
+					"landscape", shoot.Landscape,
+					"technical-id", shoot.TechnicalID,
+					"seed", shoot.SeedName,
+					"region", shoot.Region,
+					"cloud-profile", shoot.CloudProfile,
+					"kubernetes-version", shoot.KubernetesVersion,
+					"purpose", shoot.Purpose,
+					"status", shoot.Status,
+					"hibernated", strconv.FormatBool(shoot.IsHibernated),
+				),
+			},
+			Spec: Spec{
+				Type:      ResourceTypeShoot,
+				Owner:     shootOwner,
+				System:    EntityName(shoot.ProjectName),
+				DependsOn: dependsOn,
+			},
+		})
+	}
+
+	return entities
+}
+
+// annotations returns the annotations for the given key/value pairs, skipping
+// the ones with empty values.
+func annotations(kv ...string) map[string]string {
+	result := make(map[string]string, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		if kv[i+1] != "" {
+			result[annotationPrefix+kv[i]] = kv[i+1]
+		}
+	}
+
+	return result
+}
+
+// Encode writes the given entities as a multi-document YAML stream, which may
+// be registered as a catalog-info.yaml location in Backstage.
+func Encode(w io.Writer, entities []Entity) error {
+	for _, entity := range entities {
+		data, err := yaml.Marshal(entity)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, "---\n"); err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EntityName returns a valid Backstage entity name for the given value, i.e.
+// sequences of alphanumeric characters separated by a single dash, underscore
+// or dot, with at most 63 characters. Any other characters are replaced by
+// dashes, e.g. shoot--foo--bar becomes shoot-foo-bar.
+func EntityName(value string) string {
+	return sanitize(value, "-_.", false)
+}
+
+// Namespace returns a valid Backstage namespace for the given Gardener
+// landscape, i.e. lowercase alphanumeric characters separated by a single
+// dash, with at most 63 characters.
+func Namespace(landscape string) string {
+	if ns := sanitize(landscape, "-", true); ns != "" {
+		return ns
+	}
+
+	return "default"
+}
+
+// sanitize replaces the characters of the given value, which are neither
+// alphanumeric nor one of the given separators, by dashes, and collapses
+// consecutive separators into the first one.
+func sanitize(value, separators string, lower bool) string {
+	if lower {
+		value = strings.ToLower(value)
+	}
+
+	var sb strings.Builder
+	isSeparator := true
+	for _, r := range value {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', !lower && r >= 'A' && r <= 'Z':
+			sb.WriteRune(r)
+			isSeparator = false
+		case isSeparator:
+			continue
+		case strings.ContainsRune(separators, r):
+			sb.WriteRune(r)
+			isSeparator = true
+		default:
+			sb.WriteRune('-')
+			isSeparator = true
+		}
+	}
+
+	result := sb.String()
+	if len(result) > maxNameLength {
+		result = result[:maxNameLength]
+	}
+
+	return strings.TrimRight(result, separators)
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backstage_test
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/backstage"
+	"github.com/gardener/inventory/pkg/gardener/models"
+)
+
+func TestEntityName(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		value  string
+		wanted string
+	}{
+		{
+			desc:   "valid name",
+			value:  "my-project.shoot_1",
+			wanted: "my-project.shoot_1",
+		},
+		{
+			desc:   "consecutive separators",
+			value:  "shoot--foo--bar",
+			wanted: "shoot-foo-bar",
+		},
+		{
+			desc:   "invalid characters",
+			value:  "john.doe@example.com",
+			wanted: "john.doe-example.com",
+		},
+		{
+			desc:   "leading and trailing separators",
+			value:  "-foo-",
+			wanted: "foo",
+		},
+		{
+			desc:   "too long",
+			value:  strings.Repeat("a", 62) + "-b",
+			wanted: strings.Repeat("a", 62),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := backstage.EntityName(tc.value)
+			if got != tc.wanted {
+				t.Fatalf("got name %q, wanted %q", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestNamespace(t *testing.T) {
+	testCases := []struct {
+		landscape string
+		wanted    string
+	}{
+		{landscape: "live", wanted: "live"},
+		{landscape: "Canary.EU", wanted: "canary-eu"},
+		{landscape: "", wanted: "default"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.landscape, func(t *testing.T) {
+			got := backstage.Namespace(tc.landscape)
+			if got != tc.wanted {
+				t.Fatalf("got namespace %q, wanted %q", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestEntities(t *testing.T) {
+	projects := []models.Project{
+		{Name: "foo", Landscape: "live", Owner: "john.doe", Purpose: "testing"},
+	}
+	seeds := []models.Seed{
+		{Name: "aws-eu1", Landscape: "live", KubernetesVersion: "1.31.1"},
+	}
+	shoots := []models.Shoot{
+		{Name: "bar", TechnicalID: "shoot--foo--bar", Landscape: "live", ProjectName: "foo", SeedName: "aws-eu1"},
+		{Name: "baz", TechnicalID: "shoot--qux--baz", Landscape: "live", ProjectName: "qux"},
+	}
+
+	entities := backstage.Entities(projects, seeds, shoots, "")
+	if len(entities) != 4 {
+		t.Fatalf("got %d entities, wanted 4", len(entities))
+	}
+
+	project := entities[0]
+	if project.Kind != backstage.KindSystem || project.Metadata.Name != "foo" || project.Spec.Owner != "user:john.doe" {
+		t.Fatalf("got project entity %+v", project)
+	}
+
+	seed := entities[1]
+	if seed.Spec.Type != backstage.ResourceTypeSeed || seed.Spec.Owner != backstage.DefaultOwner {
+		t.Fatalf("got seed entity %+v", seed)
+	}
+
+	shoot := entities[2]
+	if shoot.Metadata.Name != "foo.bar" || shoot.Metadata.Namespace != "live" {
+		t.Fatalf("got shoot name %s/%s, wanted live/foo.bar", shoot.Metadata.Namespace, shoot.Metadata.Name)
+	}
+	if shoot.Spec.Owner != "user:john.doe" || shoot.Spec.System != "foo" {
+		t.Fatalf("got shoot owner %q and system %q, wanted user:john.doe and foo", shoot.Spec.Owner, shoot.Spec.System)
+	}
+	if !slices.Equal(shoot.Spec.DependsOn, []string{"resource:aws-eu1"}) {
+		t.Fatalf("got shoot dependencies %v, wanted [resource:aws-eu1]", shoot.Spec.DependsOn)
+	}
+	if got := shoot.Metadata.Annotations["inventory.gardener.cloud/technical-id"]; got != "shoot--foo--bar" {
+		t.Fatalf("got technical id annotation %q, wanted shoot--foo--bar", got)
+	}
+
+	orphan := entities[3]
+	if orphan.Spec.Owner != backstage.DefaultOwner || orphan.Spec.DependsOn != nil {
+		t.Fatalf("got shoot entity without project %+v", orphan)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	entities := backstage.Entities(
+		[]models.Project{{Name: "foo", Landscape: "live"}},
+		[]models.Seed{{Name: "aws-eu1", Landscape: "live"}},
+		nil,
+		"group:platform",
+	)
+
+	var buf bytes.Buffer
+	if err := backstage.Encode(&buf, entities); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := buf.String()
+	if n := strings.Count(got, "---\n"); n != 2 {
+		t.Fatalf("got %d documents, wanted 2", n)
+	}
+	for _, want := range []string{"apiVersion: backstage.io/v1alpha1", "kind: System", "owner: group:platform", "type: gardener-seed"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("got catalog %q, wanted it to contain %q", got, want)
+		}
+	}
+}
@@ -5,15 +5,24 @@
 package asynq
 
 import (
+	"errors"
+
 	"github.com/hibiken/asynq"
 )
 
+// ErrNoClient is an error, which is returned when enqueueing tasks without a
+// configured [Client], e.g. when running a single task in the foreground.
+var ErrNoClient = errors.New("no asynq client configured")
+
 // Client is the [asynq.Client] used by workers during runtime.
 var Client *asynq.Client
 
 // Inspector is the [asynq.Inspector] used by workers during runtime.
 var Inspector *asynq.Inspector
 
+// defaultOptions are the options applied to tasks enqueued via [Enqueue].
+var defaultOptions []asynq.Option
+
 // SetClient shall be invoked from cli commands to set the asynq client for the workers.
 // Workers will have the ability to enqueue tasks.
 func SetClient(c *asynq.Client) {
@@ -24,3 +33,25 @@ func SetClient(c *asynq.Client) {
 func SetInspector(i *asynq.Inspector) {
 	Inspector = i
 }
+
+// SetDefaultOptions shall be invoked from cli commands to set the options,
+// which are applied to each task enqueued via [Enqueue], e.g. for enqueueing
+// unique tasks only.
+func SetDefaultOptions(opts ...asynq.Option) {
+	defaultOptions = opts
+}
+
+// Enqueue enqueues the given task using [Client]. The default options are
+// applied first, so that they can be overridden by the given options.
+// [ErrNoClient] is returned, if [Client] has not been set.
+func Enqueue(task *asynq.Task, opts ...asynq.Option) (*asynq.TaskInfo, error) {
+	if Client == nil {
+		return nil, ErrNoClient
+	}
+
+	allOpts := make([]asynq.Option, 0, len(defaultOptions)+len(opts))
+	allOpts = append(allOpts, defaultOptions...)
+	allOpts = append(allOpts, opts...)
+
+	return Client.Enqueue(task, allOpts...)
+}
@@ -0,0 +1,14 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// ACMClientset provides the registry of Certificate Manager clients.
+var ACMClientset = registry.New[string, *Client[*acm.Client]]()
@@ -29,3 +29,7 @@ var SubnetsClientset = registry.New[string, *Client[*armnetwork.SubnetsClient]](
 // NetworkInterfacesClientset provides the registry of Azure API clients
 // for interfacing with Network Interfaces.
 var NetworkInterfacesClientset = registry.New[string, *Client[*armnetwork.InterfacesClient]]()
+
+// ApplicationGatewaysClientset provides the registry of Azure API clients
+// for interfacing with Application Gateways.
+var ApplicationGatewaysClientset = registry.New[string, *Client[*armnetwork.ApplicationGatewaysClient]]()
@@ -5,6 +5,7 @@
 package azure
 
 import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 
 	"github.com/gardener/inventory/pkg/core/registry"
@@ -17,3 +18,7 @@ var BlobContainersClientset = registry.New[string, *Client[*armstorage.BlobConta
 // StorageAccountsClientset provides the registry of Azure API clients
 // for interfacing with Storage Accounts.
 var StorageAccountsClientset = registry.New[string, *Client[*armstorage.AccountsClient]]()
+
+// StorageCredentialsClientset provides the registry of Azure token credentials
+// for accessing the data plane of Storage Accounts, e.g. for uploading blobs.
+var StorageCredentialsClientset = registry.New[string, *Client[azcore.TokenCredential]]()
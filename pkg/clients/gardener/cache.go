@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardenerlisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	toolscache "k8s.io/client-go/tools/cache"
+)
+
+// workerPrefixIndex is the name of the index of the cached shoots by the
+// prefixes of the names of their worker machines.
+const workerPrefixIndex = "workerPrefix"
+
+// informerCache provides the synced listers of the shoots and seeds of a
+// Gardener landscape.
+type informerCache struct {
+	shoots       gardenerlisters.ShootLister
+	shootIndexer toolscache.Indexer
+	seeds        gardenerlisters.SeedLister
+}
+
+// shootWorkerPrefixes is a [toolscache.IndexFunc], which indexes the shoots by
+// the prefixes of the names of their worker machines, i.e.
+// `<technical-id>-<worker-group>'.
+func shootWorkerPrefixes(obj any) ([]string, error) {
+	shoot, ok := obj.(*v1beta1.Shoot)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type: %T", obj)
+	}
+
+	if shoot.Status.TechnicalID == "" {
+		return nil, nil
+	}
+
+	prefixes := make([]string, 0, len(shoot.Spec.Provider.Workers))
+	for _, group := range shoot.Spec.Provider.Workers {
+		prefixes = append(prefixes, fmt.Sprintf("%s-%s", shoot.Status.TechnicalID, group.Name))
+	}
+
+	return prefixes, nil
+}
+
+// stripManagedFields is a [toolscache.TransformFunc], which drops the managed
+// fields of the cached objects in order to reduce the memory usage.
+func stripManagedFields(obj any) (any, error) {
+	if accessor, err := meta.Accessor(obj); err == nil {
+		accessor.SetManagedFields(nil)
+	}
+
+	return obj, nil
+}
+
+// RunCache runs an informer-based cache of the shoots and seeds of the Gardener
+// landscape, and blocks until the given context is cancelled. Once the cache
+// has been synced, the shoots and seeds are served from memory by
+// [Client.Shoots], [Client.Seeds] and [Client.CachedShootsByWorkerPrefix]. A
+// positive resync period re-delivers the cached objects periodically.
+func (c *Client) RunCache(ctx context.Context, resync time.Duration) error {
+	factory := gardenerinformers.NewSharedInformerFactoryWithOptions(
+		c.gardenerClient,
+		resync,
+		gardenerinformers.WithTransform(stripManagedFields),
+	)
+	defer factory.Shutdown()
+
+	shootInformer := factory.Core().V1beta1().Shoots()
+	err := shootInformer.Informer().AddIndexers(toolscache.Indexers{
+		workerPrefixIndex: shootWorkerPrefixes,
+	})
+	if err != nil {
+		return err
+	}
+	seedInformer := factory.Core().V1beta1().Seeds()
+	seedInformer.Informer()
+
+	factory.Start(ctx.Done())
+	for informerType, ok := range factory.WaitForCacheSync(ctx.Done()) {
+		if !ok {
+			return fmt.Errorf("cannot sync %s cache: %w", informerType, ctx.Err())
+		}
+	}
+
+	slog.Info("synced gardener cache", "landscape", c.landscape)
+	c.cache.Store(&informerCache{
+		shoots:       shootInformer.Lister(),
+		shootIndexer: shootInformer.Informer().GetIndexer(),
+		seeds:        seedInformer.Lister(),
+	})
+	defer c.cache.Store(nil)
+
+	<-ctx.Done()
+
+	return nil
+}
+
+// IsCacheSynced returns true, if the shoots and seeds are served from the
+// synced cache of the [Client].
+func (c *Client) IsCacheSynced() bool {
+	return c.cache.Load() != nil
+}
+
+// CachedShootsByWorkerPrefix returns the cached shoots, whose worker machines
+// have the given name prefix, i.e. `<technical-id>-<worker-group>'. The second
+// return value is false, if the cache has not been synced.
+func (c *Client) CachedShootsByWorkerPrefix(prefix string) ([]*v1beta1.Shoot, bool) {
+	cache := c.cache.Load()
+	if cache == nil {
+		return nil, false
+	}
+
+	objs, err := cache.shootIndexer.ByIndex(workerPrefixIndex, prefix)
+	if err != nil {
+		return nil, false
+	}
+
+	shoots := make([]*v1beta1.Shoot, 0, len(objs))
+	for _, obj := range objs {
+		if shoot, ok := obj.(*v1beta1.Shoot); ok {
+			shoots = append(shoots, shoot)
+		}
+	}
+
+	return shoots, true
+}
+
+// cachedShoots returns the cached shoots from the given namespace, or from all
+// namespaces, if empty.
+func (cache *informerCache) cachedShoots(namespace string) ([]*v1beta1.Shoot, error) {
+	if namespace == "" {
+		return cache.shoots.List(labels.Everything())
+	}
+
+	return cache.shoots.Shoots(namespace).List(labels.Everything())
+}
@@ -15,7 +15,7 @@ import (
 	"log/slog"
 	"os"
 	"slices"
-	"strings"
+	"sync/atomic"
 	"time"
 
 	"cloud.google.com/go/auth/credentials"
@@ -25,17 +25,20 @@ import (
 	gardenerversioned "github.com/gardener/gardener/pkg/client/core/clientset/versioned"
 	machineversioned "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
-	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/pager"
+	"k8s.io/client-go/transport"
 
 	"github.com/gardener/inventory/pkg/core/registry"
 	"github.com/gardener/inventory/pkg/gardener/constants"
 	gcputils "github.com/gardener/inventory/pkg/gcp/utils"
+	"github.com/gardener/inventory/pkg/metrics"
+	"github.com/gardener/inventory/pkg/utils"
 )
 
 // ErrSeedIsExcluded is an error, which is returned when attempting to get a
@@ -69,6 +72,17 @@ type Client struct {
 
 	// gkeSoilCluster provides the settings for the GKE soil cluster.
 	gkeSoilCluster *GKESoilCluster
+
+	// wrapTransport is an optional function, which wraps the transport of
+	// the Garden and seed cluster API clients.
+	wrapTransport transport.WrapperFunc
+
+	// landscape specifies the name of the Gardener landscape.
+	landscape string
+
+	// cache provides the shoots and seeds, once the cache run via
+	// [Client.RunCache] has been synced.
+	cache atomic.Pointer[informerCache]
 }
 
 // GKESoilCluster provides information about a GKE soil cluster, which is
@@ -100,6 +114,35 @@ func SetDefaultClient(c *Client) {
 	DefaultClient = c
 }
 
+// Clients provides the API clients for the additional Gardener landscapes,
+// keyed by landscape name.
+var Clients = registry.New[string, *Client]()
+
+// GetClient returns the [Client] for the given landscape. The [DefaultClient]
+// is returned for an empty landscape name, or the name of the landscape of the
+// [DefaultClient]. The second return value is false, if no client has been
+// configured for the landscape.
+func GetClient(landscape string) (*Client, bool) {
+	if landscape == "" || (DefaultClient != nil && DefaultClient.landscape == landscape) {
+		return DefaultClient, DefaultClient != nil
+	}
+
+	return Clients.Get(landscape)
+}
+
+// Landscapes returns the sorted names of the additional Gardener landscapes.
+func Landscapes() []string {
+	names := make([]string, 0, Clients.Length())
+	_ = Clients.Range(func(name string, _ *Client) error {
+		names = append(names, name)
+
+		return nil
+	})
+	slices.Sort(names)
+
+	return names
+}
+
 // Option is a function, which configures the [Client].
 type Option func(c *Client)
 
@@ -117,6 +160,10 @@ func New(opts ...Option) (*Client, error) {
 		return nil, ErrNoRestConfig
 	}
 
+	if c.wrapTransport != nil {
+		c.restConfig.Wrap(c.wrapTransport)
+	}
+
 	gardenerClient, err := gardenerversioned.NewForConfig(c.restConfig)
 	if err != nil {
 		return nil, err
@@ -126,6 +173,21 @@ func New(opts ...Option) (*Client, error) {
 	return c, nil
 }
 
+// WithLandscape is an [Option], which configures the [Client] with the name
+// of the Gardener landscape.
+func WithLandscape(name string) Option {
+	opt := func(c *Client) {
+		c.landscape = name
+	}
+
+	return opt
+}
+
+// Landscape returns the name of the Gardener landscape of the [Client].
+func (c *Client) Landscape() string {
+	return c.landscape
+}
+
 // WithRestConfig is an [Option], which configures the [Client] with the
 // specified [rest.Config].
 func WithRestConfig(restConfig *rest.Config) Option {
@@ -166,17 +228,34 @@ func WithUserAgent(userAgent string) Option {
 	return opt
 }
 
+// WithTransportWrapper is an [Option], which configures the [Client] to wrap
+// the transport of the Garden and seed cluster API clients using the given
+// function, e.g. in order to record the responses of the APIs.
+func WithTransportWrapper(fn transport.WrapperFunc) Option {
+	opt := func(c *Client) {
+		c.wrapTransport = fn
+	}
+
+	return opt
+}
+
 // GardenClient returns a [gardenerversioned.Clientset] for interfacing with the
 // Gardener APIs.
 func (c *Client) GardenClient() *gardenerversioned.Clientset {
 	return c.gardenerClient
 }
 
-// Seeds returns the list of seeds registered in the Garden cluster.
+// Seeds returns the list of seeds registered in the Garden cluster. The seeds
+// are served from the cache, if synced, and are listed via the API otherwise.
 func (c *Client) Seeds(ctx context.Context) ([]*v1beta1.Seed, error) {
+	if cache := c.cache.Load(); cache != nil {
+		return cache.seeds.List(labels.Everything())
+	}
+
 	seeds := make([]*v1beta1.Seed, 0)
 	err := pager.New(
 		pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+			metrics.IncPages(ctx)
 			return c.gardenerClient.CoreV1beta1().Seeds().List(ctx, opts)
 		}),
 	).EachListItem(ctx, metav1.ListOptions{Limit: constants.PageSize}, func(obj runtime.Object) error {
@@ -197,6 +276,38 @@ func (c *Client) Seeds(ctx context.Context) ([]*v1beta1.Seed, error) {
 	return seeds, nil
 }
 
+// Shoots returns the list of shoots from the given project namespace, or from
+// all namespaces, if empty. The shoots are served from the cache, if synced,
+// and are listed via the API otherwise.
+func (c *Client) Shoots(ctx context.Context, namespace string) ([]*v1beta1.Shoot, error) {
+	if cache := c.cache.Load(); cache != nil {
+		return cache.cachedShoots(namespace)
+	}
+
+	shoots := make([]*v1beta1.Shoot, 0)
+	err := pager.New(
+		pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+			metrics.IncPages(ctx)
+			return c.gardenerClient.CoreV1beta1().Shoots(namespace).List(ctx, opts)
+		}),
+	).EachListItem(ctx, metav1.ListOptions{Limit: constants.PageSize}, func(obj runtime.Object) error {
+		s, ok := obj.(*v1beta1.Shoot)
+		if !ok {
+			return fmt.Errorf("unexpected object type: %T", obj)
+		}
+
+		shoots = append(shoots, s)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return shoots, nil
+}
+
 // SeedRestConfig returns a [rest.Config] for the given seed cluster name
 func (c *Client) SeedRestConfig(ctx context.Context, name string) (*rest.Config, error) {
 	if slices.Contains(c.excludedSeeds, name) {
@@ -228,6 +339,9 @@ func (c *Client) SeedRestConfig(ctx context.Context, name string) (*rest.Config,
 	}
 
 	restConfig.UserAgent = c.userAgent
+	if c.wrapTransport != nil {
+		restConfig.Wrap(c.wrapTransport)
+	}
 	c.seedRestConfigs.Overwrite(name, restConfig)
 
 	return restConfig, nil
@@ -349,6 +463,10 @@ func (c *Client) getGKESoilClusterRestConfig(ctx context.Context) (*rest.Config,
 		UserAgent: c.userAgent,
 	}
 
+	if c.wrapTransport != nil {
+		config.Wrap(c.wrapTransport)
+	}
+
 	return config, nil
 }
 
@@ -360,82 +478,97 @@ func goneIn60Seconds(config *rest.Config) bool {
 		return false
 	}
 
+	expiresAt, ok, err := restConfigExpiration(config)
+	if err != nil {
+		slog.Error("failed to get credentials expiration", "error", err)
+
+		return true
+	}
+
+	if !ok {
+		return false
+	}
+
+	return (time.Now().UTC().Unix() + 60) > expiresAt.UTC().Unix() // gone in 60 seconds
+}
+
+// restConfigExpiration returns the expiration time of the ClientCertificate
+// or BearerToken of the given [rest.Config]. The returned bool is false, when
+// the config does not contain any credentials with a known expiration time.
+func restConfigExpiration(config *rest.Config) (time.Time, bool, error) {
 	// Check for the presence of client certificate and its expiration
 	if config.TLSClientConfig.CertData != nil { // nolint: staticcheck
-		return certIsAboutToExpire(config.TLSClientConfig.CertData) // nolint: staticcheck
+		t, err := certExpiration(config.TLSClientConfig.CertData) // nolint: staticcheck
+
+		return t, true, err
 	}
 
 	// Check for the presence of file containing a client certificate and its expiration
 	if config.TLSClientConfig.CertFile != "" { // nolint: staticcheck
 		certData, err := os.ReadFile(config.TLSClientConfig.CertFile) // nolint: staticcheck
 		if err != nil {
-			slog.Error(fmt.Sprintf("failed to load certificate file: %s", err))
-
-			return true
+			return time.Time{}, true, fmt.Errorf("failed to load certificate file: %w", err)
 		}
 
-		return certIsAboutToExpire(certData)
+		t, err := certExpiration(certData)
+
+		return t, true, err
 	}
 
 	// Check the presence of BearerToken
 	if config.BearerToken != "" {
-		return tokenIsAboutToExpire(config.BearerToken)
+		t, err := utils.JWTExpiration(config.BearerToken)
+
+		return t, true, err
 	}
 
 	// Check the presence of file containing a BearerToken
 	if config.BearerTokenFile != "" {
 		tokenData, err := os.ReadFile(config.BearerTokenFile)
 		if err != nil {
-			slog.Error(fmt.Sprintf("failed to load token file: %s", err))
-
-			return true
+			return time.Time{}, true, fmt.Errorf("failed to load token file: %w", err)
 		}
 
-		return tokenIsAboutToExpire(string(tokenData))
+		t, err := utils.JWTExpiration(string(tokenData))
+
+		return t, true, err
 	}
 
-	return false
+	return time.Time{}, false, nil
 }
 
-// certIsAboutToExpire checks if the certificate is about to expire
-func certIsAboutToExpire(certData []byte) bool {
+// certExpiration returns the expiration time of the given PEM-encoded
+// certificate.
+func certExpiration(certData []byte) (time.Time, error) {
 	b, _ := pem.Decode(certData)
+	if b == nil {
+		return time.Time{}, errors.New("failed to decode certificate")
+	}
+
 	c, err := x509.ParseCertificate(b.Bytes)
 	if err != nil {
-		slog.Error(fmt.Sprintf("failed to parse certificate: %s", err))
-
-		return true
+		return time.Time{}, fmt.Errorf("failed to parse certificate: %w", err)
 	}
 
-	return (time.Now().UTC().Unix() + 60) > c.NotAfter.UTC().Unix() // gone in 60 seconds
+	return c.NotAfter, nil
 }
 
-// tokenIsAboutToExpire checks if the token is about to expire
-func tokenIsAboutToExpire(token string) bool {
-	splitToken := strings.Split(token, ".")
-	if len(splitToken) != 3 {
-		slog.Error("invalid token format")
-
-		return true
-	}
-	payload, err := base64.RawURLEncoding.DecodeString(splitToken[1])
-	if err != nil {
-		slog.Error("failed to decode token payload", "error", err)
-
-		return true
-	}
-
-	var tokenPayload struct {
-		Iss string `json:"iss"`
-		Exp int64  `json:"exp"`
-	}
-	if err = json.Unmarshal(payload, &tokenPayload); err != nil {
-		slog.Error("failed to unmarshal token payload", "error", err)
+// SeedCredentialExpirations returns the expiration times of the viewer
+// kubeconfigs, which are currently cached for the seed clusters, keyed by
+// seed name.
+func (c *Client) SeedCredentialExpirations() map[string]time.Time {
+	result := make(map[string]time.Time)
+	_ = c.seedRestConfigs.Range(func(name string, config *rest.Config) error {
+		expiresAt, ok, err := restConfigExpiration(config)
+		if err != nil || !ok {
+			return nil
+		}
+		result[name] = expiresAt
 
-		return true
-	}
+		return nil
+	})
 
-	return (time.Now().UTC().Unix() + 60) > tokenPayload.Exp // gone in 60 seconds
+	return result
 }
 
 // RESTConfig exposes the gardener rest config, so custom clientsets can be
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	"slices"
+	"testing"
+
+	"k8s.io/client-go/rest"
+
+	"github.com/gardener/inventory/pkg/clients/gardener"
+)
+
+func TestGetClient(t *testing.T) {
+	newClient := func(landscape string) *gardener.Client {
+		c, err := gardener.New(
+			gardener.WithRestConfig(&rest.Config{Host: "https://" + landscape}),
+			gardener.WithLandscape(landscape),
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return c
+	}
+
+	if _, ok := gardener.GetClient(""); ok {
+		t.Fatal("got client wanted none")
+	}
+
+	live := newClient("live")
+	canary := newClient("canary")
+	dev := newClient("dev")
+	gardener.SetDefaultClient(live)
+	gardener.Clients.Overwrite("dev", dev)
+	gardener.Clients.Overwrite("canary", canary)
+
+	testCases := []struct {
+		desc      string
+		landscape string
+		wanted    *gardener.Client
+	}{
+		{desc: "empty landscape", landscape: "", wanted: live},
+		{desc: "primary landscape", landscape: "live", wanted: live},
+		{desc: "additional landscape", landscape: "canary", wanted: canary},
+		{desc: "unknown landscape", landscape: "unknown", wanted: nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, ok := gardener.GetClient(tc.landscape)
+			if got != tc.wanted || ok != (tc.wanted != nil) {
+				t.Fatalf("got client %v (%t) wanted %v", got, ok, tc.wanted)
+			}
+		})
+	}
+
+	if got, wanted := gardener.Landscapes(), []string{"canary", "dev"}; !slices.Equal(got, wanted) {
+		t.Fatalf("got landscapes %v wanted %v", got, wanted)
+	}
+}
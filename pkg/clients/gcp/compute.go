@@ -41,3 +41,27 @@ var ForwardingRulesClientset = registry.New[string, *Client[*compute.ForwardingR
 // TargetPoolsClientset provides the registry of GCP API clients for interfacing
 // with the Target Pools service.
 var TargetPoolsClientset = registry.New[string, *Client[*compute.TargetPoolsClient]]()
+
+// ReservationsClientset provides the registry of GCP API clients for
+// interfacing with the Compute Engine reservations API.
+var ReservationsClientset = registry.New[string, *Client[*compute.ReservationsClient]]()
+
+// RegionCommitmentsClientset provides the registry of GCP API clients for
+// interfacing with the Compute Engine commitments API.
+var RegionCommitmentsClientset = registry.New[string, *Client[*compute.RegionCommitmentsClient]]()
+
+// RegionsClientset provides the registry of GCP API clients for interfacing
+// with the Compute Engine regions API.
+var RegionsClientset = registry.New[string, *Client[*compute.RegionsClient]]()
+
+// SSLCertificatesClientset provides the registry of GCP API clients for
+// interfacing with the Compute Engine SSL certificates API.
+var SSLCertificatesClientset = registry.New[string, *Client[*compute.SslCertificatesClient]]()
+
+// TargetHTTPSProxiesClientset provides the registry of GCP API clients for
+// interfacing with the Compute Engine target HTTPS proxies API.
+var TargetHTTPSProxiesClientset = registry.New[string, *Client[*compute.TargetHttpsProxiesClient]]()
+
+// TargetSSLProxiesClientset provides the registry of GCP API clients for
+// interfacing with the Compute Engine target SSL proxies API.
+var TargetSSLProxiesClientset = registry.New[string, *Client[*compute.TargetSslProxiesClient]]()
@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+import (
+	iam "google.golang.org/api/iam/v1"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// IAMClientset provides the registry of GCP API clients for interfacing with
+// the IAM API service.
+var IAMClientset = registry.New[string, *Client[*iam.Service]]()
@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+import (
+	sqladmin "google.golang.org/api/sqladmin/v1"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// SQLAdminClientset provides the registry of GCP API clients for interfacing
+// with the Cloud SQL Admin API service.
+var SQLAdminClientset = registry.New[string, *Client[*sqladmin.Service]]()
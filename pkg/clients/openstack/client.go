@@ -9,19 +9,19 @@ package openstack
 type ClientScope struct {
 	// NamedCredentials is the name of the credentials, which were used to
 	// create the API client.
-	NamedCredentials string
+	NamedCredentials string `desc:"The name of the credentials, which were used to create the API client" example:"default"`
 
 	// Project is the project associated with the client.
-	Project string
+	Project string `desc:"The project associated with the client" example:"my-project"`
 
 	// ProjectID is the project ID associated with the client.
-	ProjectID string
+	ProjectID string `desc:"The project ID associated with the client" example:"0123456789abcdef0123456789abcdef"`
 
 	// Domain is the domain associated with the client.
-	Domain string
+	Domain string `desc:"The domain associated with the client" example:"default"`
 
 	// Region is the region associated with the client.
-	Region string
+	Region string `desc:"The region associated with the client" example:"eu-de-1"`
 }
 
 // Client is a wrapper for an OpenStack API client, which comes with additional
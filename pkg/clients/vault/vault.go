@@ -5,10 +5,44 @@
 package vault
 
 import (
+	"context"
+
 	"github.com/gardener/inventory/pkg/core/registry"
 	apiclient "github.com/gardener/inventory/pkg/vault/client"
 )
 
+// CredentialKindToken is the kind of expiring credentials, which represent
+// the auth tokens of the Vault API clients.
+const CredentialKindToken = "vault_token"
+
 // Clientset provides the registry of Vault API clients, which are used by
 // workers during runtime.
 var Clientset = registry.New[string, *apiclient.Client]()
+
+// tokenCredentials returns the auth tokens of the registered Vault API
+// clients as [registry.ExpiringCredential] items.
+func tokenCredentials(_ context.Context) ([]registry.ExpiringCredential, error) {
+	items := make([]registry.ExpiringCredential, 0)
+	err := Clientset.Range(func(name string, client *apiclient.Client) error {
+		expiresAt, ok := client.TokenExpiration()
+		if !ok {
+			return nil
+		}
+
+		item := registry.ExpiringCredential{
+			Kind:      CredentialKindToken,
+			Name:      name,
+			Source:    client.Address(),
+			ExpiresAt: expiresAt,
+		}
+		items = append(items, item)
+
+		return nil
+	})
+
+	return items, err
+}
+
+func init() {
+	registry.ExpiringCredentialRegistry.MustRegister(CredentialKindToken, tokenCredentials)
+}
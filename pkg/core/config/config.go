@@ -7,8 +7,11 @@ package config
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
 	"time"
 
 	"github.com/goccy/go-yaml"
@@ -91,6 +94,51 @@ const (
 	// DefaultWorkerMetricsPath is the default HTTP path at which the worker
 	// is exposing metrics.
 	DefaultWorkerMetricsPath = "/metrics"
+
+	// DefaultUniqueTaskTTL is the default duration for which a task is
+	// considered unique.
+	DefaultUniqueTaskTTL = time.Hour
+
+	// DefaultGroupGracePeriod is the default duration for which the worker
+	// waits for new tasks in a group, before aggregating the group.
+	DefaultGroupGracePeriod = 10 * time.Second
+
+	// DefaultGroupMaxDelay is the default maximum duration for which the
+	// worker waits for new tasks in a group, before aggregating the group.
+	DefaultGroupMaxDelay = 5 * time.Minute
+
+	// DefaultAzureSubscriptionCacheTTL is the default duration for which
+	// the discovered Azure subscriptions are cached.
+	DefaultAzureSubscriptionCacheTTL = time.Hour
+
+	// DefaultAPITimeout is the default maximum duration of a single request
+	// to the provider APIs.
+	DefaultAPITimeout = 2 * time.Minute
+
+	// DefaultCircuitBreakerFailureThreshold is the default number of
+	// consecutive failures, after which the circuit breaker skips the
+	// tasks of an account and region.
+	DefaultCircuitBreakerFailureThreshold = 5
+
+	// DefaultCircuitBreakerCooldown is the default duration for which the
+	// circuit breaker skips the tasks of an account and region.
+	DefaultCircuitBreakerCooldown = 10 * time.Minute
+
+	// DefaultWorkerBatchSize is the default number of collected items,
+	// which are upserted at once by the collectors of large collections.
+	DefaultWorkerBatchSize = 1000
+
+	// DefaultGCPZoneConcurrency is the default number of zones, whose
+	// resources are processed concurrently by the GCP collectors.
+	DefaultGCPZoneConcurrency = 4
+
+	// LeaderElectionBackendRedis is the name of the leader election backend,
+	// which uses Redis.
+	LeaderElectionBackendRedis = "redis"
+
+	// LeaderElectionBackendKubernetes is the name of the leader election
+	// backend, which uses Kubernetes Lease resources.
+	LeaderElectionBackendKubernetes = "kubernetes"
 )
 
 // ErrNoConfigVersion error is returned when the configuration does not specify
@@ -127,6 +175,17 @@ type Config struct {
 	// Scheduler represents the scheduler configuration.
 	Scheduler SchedulerConfig `yaml:"scheduler"`
 
+	// Routes specifies the rules for routing tasks to the workers with
+	// matching labels.
+	Routes []RouteConfig `yaml:"routes"`
+
+	// UniqueTasks specifies the settings for deduplicating tasks.
+	UniqueTasks UniqueTasksConfig `yaml:"unique_tasks"`
+
+	// Replay specifies the settings for recording the responses of the
+	// provider APIs, and replaying them instead of calling the live APIs.
+	Replay ReplayConfig `yaml:"replay"`
+
 	// Gardener represents the Gardener specific configuration.
 	Gardener GardenerConfig `yaml:"gardener"`
 
@@ -134,6 +193,21 @@ type Config struct {
 	// service.
 	Dashboard DashboardConfig `yaml:"dashboard"`
 
+	// Audit specifies the settings for the audit log of mutating
+	// operations.
+	Audit AuditConfig `yaml:"audit"`
+
+	// Events specifies the settings for publishing change events of the
+	// inventory records.
+	Events EventsConfig `yaml:"events"`
+
+	// ServiceNow specifies the settings for synchronizing resources into
+	// the ServiceNow CMDB.
+	ServiceNow ServiceNowConfig `yaml:"servicenow"`
+
+	// Queries specifies the settings for the named read-only SQL queries.
+	Queries QueriesConfig `yaml:"queries"`
+
 	// AWS represents the AWS specific configuration settings.
 	AWS AWSConfig `yaml:"aws"`
 
@@ -240,6 +314,40 @@ type VaultJWTAuthMethodConfig struct {
 	TokenEnv string `yaml:"token_env"`
 }
 
+// FilterConfig provides include and exclude lists of identifiers, e.g. account
+// ids or projects, which are used to limit the scope of collection.
+type FilterConfig struct {
+	// Include specifies the identifiers to include. When empty, all
+	// identifiers are included, unless excluded explicitly.
+	Include []string `yaml:"include"`
+
+	// Exclude specifies the identifiers to exclude. Exclusion takes
+	// precedence over inclusion.
+	Exclude []string `yaml:"exclude"`
+}
+
+// Allows returns true, if the filter allows a resource, which is known by the
+// given identifiers, e.g. both by name and by id.
+func (f FilterConfig) Allows(ids ...string) bool {
+	for _, id := range ids {
+		if slices.Contains(f.Exclude, id) {
+			return false
+		}
+	}
+
+	if len(f.Include) == 0 {
+		return true
+	}
+
+	for _, id := range ids {
+		if slices.Contains(f.Include, id) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // OpenStackConfig provides the OpenStack-related configuration.
 type OpenStackConfig struct {
 	// IsEnabled specifies whether the OpenStack collection is enabled or not.
@@ -249,9 +357,18 @@ type OpenStackConfig struct {
 	// Services provides the OpenStack service-specific configuration.
 	Services OpenStackServices `yaml:"services"`
 
+	// Projects specifies the OpenStack projects, either by name or by id,
+	// from which to collect.
+	Projects FilterConfig `yaml:"projects"`
+
 	// Credentials specifies the OpenStack named credentials configuration,
 	// which is used by the various OpenStack services.
 	Credentials map[string]OpenStackCredentialsConfig `yaml:"credentials"`
+
+	// APITimeout specifies the maximum duration of a single request to the
+	// OpenStack APIs, e.g. fetching a single page of resources. Defaults to
+	// [DefaultAPITimeout].
+	APITimeout time.Duration `yaml:"api_timeout"`
 }
 
 // OpenStackServices repsesents the known OpenStack services and their config.
@@ -277,10 +394,19 @@ type OpenStackServices struct {
 
 // OpenStackServiceCredentials specifies which credentials a service can use.
 type OpenStackServiceCredentials struct {
+	// IsEnabled specifies whether the collection from the service is
+	// enabled or not. Services are enabled by default.
+	IsEnabled *bool `yaml:"is_enabled"`
+
 	// UseCredentials specifies a list of named credentials to use.
 	UseCredentials []string `yaml:"use_credentials"`
 }
 
+// Enabled returns true, if the OpenStack service is enabled.
+func (c OpenStackServiceCredentials) Enabled() bool {
+	return isServiceEnabled(c.IsEnabled)
+}
+
 // OpenStackCredentialsConfig provides named credentials configuration for the OpenStack
 // API clients.
 type OpenStackCredentialsConfig struct {
@@ -356,9 +482,23 @@ type AzureConfig struct {
 	// Services provides the Azure service-specific configuration.
 	Services AzureServices `yaml:"services"`
 
+	// Subscriptions specifies the Azure subscriptions, either by name or
+	// by id, from which to collect. By default all subscriptions accessible
+	// by the named credentials are collected.
+	Subscriptions FilterConfig `yaml:"subscriptions"`
+
+	// SubscriptionCache specifies the settings for caching the
+	// subscriptions, which were discovered for the named credentials.
+	SubscriptionCache AzureSubscriptionCacheConfig `yaml:"subscription_cache"`
+
 	// Credentials specifies the Azure named credentials configuration,
 	// which is used by the various Azure services.
 	Credentials map[string]AzureCredentialsConfig `yaml:"credentials"`
+
+	// APITimeout specifies the maximum duration of a single request to the
+	// Azure APIs, e.g. fetching a single page of resources. Defaults to
+	// [DefaultAPITimeout].
+	APITimeout time.Duration `yaml:"api_timeout"`
 }
 
 // AzureServices repsesents the known Azure services and their config.
@@ -381,10 +521,19 @@ type AzureServices struct {
 
 // AzureServiceConfig provides configuration specific for an Azure service.
 type AzureServiceConfig struct {
+	// IsEnabled specifies whether the collection from the service is
+	// enabled or not. Services are enabled by default.
+	IsEnabled *bool `yaml:"is_enabled"`
+
 	// UseCredentials specifies the name of the credentials to use.
 	UseCredentials []string `yaml:"use_credentials"`
 }
 
+// Enabled returns true, if the Azure service is enabled.
+func (c AzureServiceConfig) Enabled() bool {
+	return isServiceEnabled(c.IsEnabled)
+}
+
 // AzureCredentialsConfig provides named credentials configuration for the Azure
 // API clients.
 type AzureCredentialsConfig struct {
@@ -409,6 +558,22 @@ type AzureCredentialsConfig struct {
 	// WorkloadIdentity provides the config settings for authentication
 	// using Workload Identity Federation.
 	WorkloadIdentity AzureWorkloadIdentityConfig `yaml:"workload_identity"`
+
+	// Subscriptions specifies an explicit list of subscription ids, for
+	// which API clients are created. When specified, the subscriptions
+	// accessible by the named credentials are not discovered.
+	Subscriptions []string `yaml:"subscriptions"`
+}
+
+// AzureSubscriptionCacheConfig provides the config settings for caching the
+// discovered Azure subscriptions in Redis.
+type AzureSubscriptionCacheConfig struct {
+	// IsEnabled specifies whether the discovered subscriptions are cached
+	// or not.
+	IsEnabled bool `yaml:"is_enabled"`
+
+	// TTL specifies for how long the discovered subscriptions are cached.
+	TTL time.Duration `yaml:"ttl"`
 }
 
 // AzureWorkloadIdentityConfig provides the config settings for Azure Workload
@@ -437,6 +602,9 @@ type GCPConfig struct {
 	// Services provides the GCP service-specific configuration.
 	Services GCPServices `yaml:"services"`
 
+	// Projects specifies the GCP projects from which to collect.
+	Projects FilterConfig `yaml:"projects"`
+
 	// Credentials specifies the GCP named credentials configuration, which
 	// is used by the various GCP services.
 	Credentials map[string]GCPCredentialsConfig `yaml:"credentials"`
@@ -444,6 +612,17 @@ type GCPConfig struct {
 	// SoilCluster specifies the configuration settings for the GKE Regional
 	// Soil cluster.
 	SoilCluster GCPSoilClusterConfig `yaml:"soil_cluster"`
+
+	// APITimeout specifies the maximum duration of a single request to the
+	// GCP APIs, e.g. fetching a single page of resources. Defaults to
+	// [DefaultAPITimeout].
+	APITimeout time.Duration `yaml:"api_timeout"`
+
+	// ZoneConcurrency specifies the maximum number of zones of a project,
+	// whose resources are processed concurrently by the GCP collectors,
+	// e.g. when looking up the source images of the instances. Defaults
+	// to [DefaultGCPZoneConcurrency].
+	ZoneConcurrency int `yaml:"zone_concurrency"`
 }
 
 // GCPSoilClusterConfig provides config settings specific to the GKE Regional
@@ -470,14 +649,33 @@ type GCPServices struct {
 
 	// GKE contains the GKE service configuration.
 	GKE GCPServiceConfig `yaml:"gke"`
+
+	// CloudSQL contains the Cloud SQL service configuration. Cloud SQL
+	// collection is optional, and is disabled when no named credentials
+	// are specified.
+	CloudSQL GCPServiceConfig `yaml:"cloud_sql"`
+
+	// IAM contains the IAM service configuration, which is used for
+	// collecting service accounts and their keys. IAM collection is
+	// optional, and is disabled when no named credentials are specified.
+	IAM GCPServiceConfig `yaml:"iam"`
 }
 
 // GCPServiceConfig provides service-specific configuration for a GCP service.
 type GCPServiceConfig struct {
+	// IsEnabled specifies whether the collection from the service is
+	// enabled or not. Services are enabled by default.
+	IsEnabled *bool `yaml:"is_enabled"`
+
 	// UseCredentials specifies the name of the credentials to use.
 	UseCredentials []string `yaml:"use_credentials"`
 }
 
+// Enabled returns true, if the GCP service is enabled.
+func (c GCPServiceConfig) Enabled() bool {
+	return isServiceEnabled(c.IsEnabled)
+}
+
 // GCPCredentialsConfig provides named credentials configuration for the GCP API
 // clients.
 type GCPCredentialsConfig struct {
@@ -540,9 +738,17 @@ type AWSConfig struct {
 	// e.g. credentials to use when accessing a given AWS service.
 	Services AWSServices `yaml:"services"`
 
+	// Accounts specifies the AWS account ids from which to collect.
+	Accounts FilterConfig `yaml:"accounts"`
+
 	// Credentials specifies the AWS credentials configuration, which is
 	// used by the various AWS services.
 	Credentials map[string]AWSCredentialsConfig `yaml:"credentials"`
+
+	// APITimeout specifies the maximum duration of a single request to the
+	// AWS APIs, e.g. fetching a single page of resources. Defaults to
+	// [DefaultAPITimeout].
+	APITimeout time.Duration `yaml:"api_timeout"`
 }
 
 // AWSServices provides service-specific configuration for the AWS services.
@@ -561,15 +767,35 @@ type AWSServices struct {
 
 	// Route53 provides Route 53-specific service configuration
 	Route53 AWSServiceConfig `yaml:"route53"`
+
+	// ServiceQuotas provides Service Quotas-specific service configuration.
+	// The named credentials are also used for the CloudWatch clients,
+	// which fetch the current usage of the quotas. This service is
+	// optional.
+	ServiceQuotas AWSServiceConfig `yaml:"service_quotas"`
+
+	// ACM provides Certificate Manager-specific service configuration.
+	// The clients are used for getting the details of the certificates
+	// attached to load balancer listeners. This service is optional.
+	ACM AWSServiceConfig `yaml:"acm"`
 }
 
 // AWSServiceConfig prvides service-specific configuration for an AWS service.
 type AWSServiceConfig struct {
+	// IsEnabled specifies whether the collection from the service is
+	// enabled or not. Services are enabled by default.
+	IsEnabled *bool `yaml:"is_enabled"`
+
 	// UseCredentials specifies the name of the credentials to use for a
 	// given AWS Service.
 	UseCredentials []string `yaml:"use_credentials"`
 }
 
+// Enabled returns true, if the AWS service is enabled.
+func (c AWSServiceConfig) Enabled() bool {
+	return isServiceEnabled(c.IsEnabled)
+}
+
 // AWSCredentialsConfig provides credentials specific configuration for the AWS
 // client.
 type AWSCredentialsConfig struct {
@@ -661,6 +887,41 @@ type AWSTokenFileRetrieverConfig struct {
 type RedisConfig struct {
 	// Endpoint is the endpoint of the Redis service.
 	Endpoint string `yaml:"endpoint"`
+
+	// Username specifies the username to use for authenticating against
+	// Redis, when using Redis ACLs.
+	Username string `yaml:"username"`
+
+	// PasswordFile specifies the path to a file, which contains the
+	// password to use for authenticating against Redis.
+	PasswordFile string `yaml:"password_file"`
+
+	// TLS specifies the TLS settings for connecting to Redis.
+	TLS RedisTLSConfig `yaml:"tls"`
+}
+
+// RedisTLSConfig provides the TLS settings for connecting to Redis.
+type RedisTLSConfig struct {
+	// IsEnabled specifies whether TLS is enabled or not.
+	IsEnabled bool `yaml:"is_enabled"`
+
+	// CACert is the path to a PEM-encoded CA cert file to use to verify the
+	// Redis server certificate. If it is not specified, then the system
+	// cert pool is used.
+	CACert string `yaml:"ca_cert"`
+
+	// ClientCert is the path to the client certificate for mutual TLS.
+	ClientCert string `yaml:"client_cert"`
+
+	// ClientKey is the path to the private key of the client certificate.
+	ClientKey string `yaml:"client_key"`
+
+	// ServerName, if set, is used to set the SNI host when connecting via
+	// TLS.
+	ServerName string `yaml:"server_name"`
+
+	// Insecure disables the verification of the server certificate.
+	Insecure bool `yaml:"insecure"`
 }
 
 // DatabaseConfig provides database specific configuration settings.
@@ -668,9 +929,46 @@ type DatabaseConfig struct {
 	// DSN is the Data Source Name to connect to.
 	DSN string `yaml:"dsn"`
 
+	// ReadOnlyDSN is an optional Data Source Name of a read replica, which
+	// is used for read-only query workloads such as reports and model
+	// queries. When not specified, DSN is used instead.
+	ReadOnlyDSN string `yaml:"read_only_dsn"`
+
 	// MigrationDirectory specifies an alternate location with migration
 	// files.
 	MigrationDirectory string `yaml:"migration_dir"`
+
+	// MaxOpenConns specifies the maximum number of open connections to the
+	// database. If it is not specified, then the number of open
+	// connections is unlimited.
+	MaxOpenConns int `yaml:"max_open_conns"`
+
+	// MaxIdleConns specifies the maximum number of connections in the idle
+	// connection pool.
+	MaxIdleConns int `yaml:"max_idle_conns"`
+
+	// ConnMaxLifetime specifies the maximum amount of time a connection
+	// may be reused.
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+
+	// ConnMaxIdleTime specifies the maximum amount of time a connection
+	// may be idle before being closed.
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"`
+
+	// DialTimeout specifies the timeout for establishing new connections.
+	DialTimeout time.Duration `yaml:"dial_timeout"`
+
+	// ReadTimeout specifies the timeout for socket reads.
+	ReadTimeout time.Duration `yaml:"read_timeout"`
+
+	// WriteTimeout specifies the timeout for socket writes.
+	WriteTimeout time.Duration `yaml:"write_timeout"`
+
+	// StatementTimeout specifies the maximum amount of time a statement
+	// may run, before it is aborted by the database server. A
+	// `statement_timeout' connection param specified in the DSN takes
+	// precedence.
+	StatementTimeout time.Duration `yaml:"statement_timeout"`
 }
 
 // WorkerConfig provides worker specific configuration settings.
@@ -694,6 +992,113 @@ type WorkerConfig struct {
 	// always processed first, and tasks from queues with lower priority are
 	// processed only after higher priority queues are empty.
 	StrictPriority bool `yaml:"strict_priority"`
+
+	// Groups specifies the settings for aggregating grouped tasks.
+	Groups WorkerGroupsConfig `yaml:"groups"`
+
+	// Labels specifies the capabilities of the worker, e.g. `aws' or
+	// `openstack-region-x'. The worker processes tasks from the queues
+	// named after its labels, in addition to the configured queues.
+	Labels []string `yaml:"labels"`
+
+	// CircuitBreaker specifies the settings for skipping the tasks of
+	// provider accounts and regions, which fail repeatedly.
+	CircuitBreaker CircuitBreakerConfig `yaml:"circuit_breaker"`
+
+	// BatchSize specifies the maximum number of collected items, which
+	// are kept in memory by the collectors of large collections, e.g.
+	// AWS ENIs, before they are upserted into the database. If it is not
+	// specified, then [DefaultWorkerBatchSize] is used.
+	BatchSize int `yaml:"batch_size"`
+}
+
+// ProcessedQueues returns the queues and their priorities, which are processed
+// by the workers. Workers process the queues named after their labels in
+// addition to the configured queues. If neither queues nor labels are
+// configured, then the workers process the [DefaultQueueName] queue only.
+func (c WorkerConfig) ProcessedQueues() map[string]int {
+	queues := maps.Clone(c.Queues)
+	if queues == nil {
+		queues = make(map[string]int)
+	}
+	for _, label := range c.Labels {
+		if _, ok := queues[label]; !ok {
+			queues[label] = 1
+		}
+	}
+
+	if len(queues) == 0 {
+		queues[DefaultQueueName] = 1
+	}
+
+	return queues
+}
+
+// CircuitBreakerConfig provides the settings for the circuit breaker of the
+// workers, which temporarily skips the tasks of a provider account and region
+// after repeated failures.
+type CircuitBreakerConfig struct {
+	// IsEnabled specifies whether the circuit breaker is enabled or not.
+	IsEnabled bool `yaml:"is_enabled"`
+
+	// FailureThreshold specifies the number of consecutive failures of
+	// the tasks of an account and region, after which its tasks are
+	// skipped. If it is not specified, then
+	// [DefaultCircuitBreakerFailureThreshold] is used.
+	FailureThreshold int `yaml:"failure_threshold"`
+
+	// Cooldown specifies the duration for which the tasks are skipped,
+	// before a single task is processed again to probe the account and
+	// region. If it is not specified, then [DefaultCircuitBreakerCooldown]
+	// is used.
+	Cooldown time.Duration `yaml:"cooldown"`
+}
+
+// RouteConfig provides a rule for routing tasks to the workers with a given
+// label.
+type RouteConfig struct {
+	// Pattern specifies a pattern of task names, e.g. `aws:task:*'. See
+	// [path.Match] for details about the pattern syntax.
+	Pattern string `yaml:"pattern"`
+
+	// Label specifies the worker label. Matching tasks are enqueued in the
+	// queue named after the label.
+	Label string `yaml:"label"`
+}
+
+// RouteQueue returns the queue for the task with the given name according to
+// the first matching route, and true. If no route matches the task, then it
+// returns an empty string and false.
+func (c *Config) RouteQueue(taskName string) (string, bool) {
+	for _, route := range c.Routes {
+		if ok, _ := path.Match(route.Pattern, taskName); ok {
+			return route.Label, true
+		}
+	}
+
+	return "", false
+}
+
+// WorkerGroupsConfig provides the settings for aggregating grouped tasks into a
+// single task.
+//
+// See [1] for more details about how task aggregation works.
+//
+// [1]: https://github.com/hibiken/asynq/wiki/Task-aggregation
+type WorkerGroupsConfig struct {
+	// GracePeriod specifies how long the worker waits for new tasks in a
+	// group, before aggregating the group. If it is not specified, then
+	// [DefaultGroupGracePeriod] is used.
+	GracePeriod time.Duration `yaml:"grace_period"`
+
+	// MaxDelay specifies the maximum duration the worker waits for new
+	// tasks in a group, before aggregating the group. If it is not
+	// specified, then [DefaultGroupMaxDelay] is used.
+	MaxDelay time.Duration `yaml:"max_delay"`
+
+	// MaxSize specifies the maximum number of tasks, which are aggregated
+	// into a single task. Zero means no limit.
+	MaxSize int `yaml:"max_size"`
 }
 
 // WorkerMetricsConfig provides settings for exposing worker-related metrics
@@ -704,6 +1109,12 @@ type WorkerMetricsConfig struct {
 	// Address specifies the TCP network address for the HTTP server, which
 	// serves the metrics.
 	Address string `yaml:"address"`
+
+	// Queues specifies whether to expose the number of tasks per queue and
+	// state as reported by the asynq inspector, e.g. for autoscaling the
+	// workers based on the queue backlog. The queues are inspected on each
+	// scrape of the metrics.
+	Queues bool `yaml:"queues"`
 }
 
 // SchedulerConfig provides scheduler specific configuration settings.
@@ -714,6 +1125,95 @@ type SchedulerConfig struct {
 
 	// Jobs represents the periodic jobs managed by the scheduler
 	Jobs []*PeriodicJob `yaml:"jobs"`
+
+	// LeaderElection specifies the leader election settings, which allow
+	// for running multiple scheduler replicas, where only the leader
+	// enqueues periodic jobs.
+	LeaderElection LeaderElectionConfig `yaml:"leader_election"`
+}
+
+// LeaderElectionConfig provides the leader election settings.
+type LeaderElectionConfig struct {
+	// IsEnabled specifies whether leader election is enabled or not.
+	IsEnabled bool `yaml:"is_enabled"`
+
+	// Backend specifies the backend used for leader election. The
+	// currently supported backends are `redis' and `kubernetes'.
+	Backend string `yaml:"backend"`
+
+	// Identity specifies the identity of the candidate. If it is not
+	// specified, then the hostname is used.
+	Identity string `yaml:"identity"`
+
+	// LeaseDuration specifies the duration for which a lease is held by
+	// the leader. Non-leader candidates wait for this duration before
+	// taking over an expired lease.
+	LeaseDuration time.Duration `yaml:"lease_duration"`
+
+	// RenewDeadline specifies the duration for which the leader keeps
+	// retrying to renew the lease, before giving up leadership.
+	RenewDeadline time.Duration `yaml:"renew_deadline"`
+
+	// RetryPeriod specifies the duration candidates wait between attempts
+	// to acquire or renew the lease.
+	RetryPeriod time.Duration `yaml:"retry_period"`
+
+	// Redis specifies the settings for the `redis' backend.
+	Redis RedisLeaderElectionConfig `yaml:"redis"`
+
+	// Kubernetes specifies the settings for the `kubernetes' backend.
+	Kubernetes KubernetesLeaderElectionConfig `yaml:"kubernetes"`
+}
+
+// RedisLeaderElectionConfig provides the settings for leader election, which
+// uses Redis as the backend. The Redis connection settings are taken from
+// [RedisConfig].
+type RedisLeaderElectionConfig struct {
+	// Key specifies the Redis key used for the lease.
+	Key string `yaml:"key"`
+}
+
+// KubernetesLeaderElectionConfig provides the settings for leader election,
+// which uses Kubernetes Lease resources as the backend.
+type KubernetesLeaderElectionConfig struct {
+	// Kubeconfig specifies the path to a kubeconfig file. If it is not
+	// specified, then in-cluster configuration is used.
+	Kubeconfig string `yaml:"kubeconfig"`
+
+	// Namespace specifies the namespace of the Lease resource.
+	Namespace string `yaml:"namespace"`
+
+	// Name specifies the name of the Lease resource.
+	Name string `yaml:"name"`
+}
+
+// UniqueTasksConfig provides the settings for deduplicating tasks.
+//
+// When enabled, tasks enqueued by the scheduler, by the workers and via the CLI
+// are unique per task type, payload and queue. A task, which is identical to a
+// task that is still pending or being processed is not enqueued again, so that
+// the same collection is not run concurrently.
+type UniqueTasksConfig struct {
+	// IsEnabled specifies whether tasks are deduplicated or not.
+	IsEnabled bool `yaml:"is_enabled"`
+
+	// TTL specifies the maximum duration for which a task is considered
+	// unique. The uniqueness lock is released earlier, once the task has
+	// been processed successfully. If it is not specified, then
+	// [DefaultUniqueTaskTTL] is used.
+	TTL time.Duration `yaml:"ttl"`
+}
+
+// ReplayConfig provides the settings for recording the responses of the
+// provider APIs to files, and replaying the recorded responses instead of
+// calling the live APIs, e.g. for deterministic integration tests.
+type ReplayConfig struct {
+	// Mode specifies the mode, which is either record or replay. Recording
+	// and replaying is disabled, if it is empty.
+	Mode string `yaml:"mode"`
+
+	// Dir specifies the directory of the recordings.
+	Dir string `yaml:"dir"`
 }
 
 // PeriodicJob is a job, which is enqueued by the scheduler on regular basis and
@@ -735,6 +1235,23 @@ type PeriodicJob struct {
 	// submitted. If it is not specified, then the task will be submitted to
 	// the [DefaultQueueName] queue.
 	Queue string `yaml:"queue"`
+
+	// Timeout specifies how long the task can run, before it is
+	// cancelled. If it is not specified, then the asynq default is used.
+	Timeout time.Duration `yaml:"timeout"`
+
+	// MaxRetry specifies the maximum number of times the task will be
+	// retried. If it is not specified, then the asynq default is used.
+	MaxRetry *int `yaml:"max_retry"`
+
+	// Retention specifies how long the task will be kept in the queue
+	// after it has been successfully processed.
+	Retention time.Duration `yaml:"retention"`
+
+	// Deadline specifies the duration relative to the time the task was
+	// enqueued, after which the task will be cancelled, if it hasn't
+	// completed yet.
+	Deadline time.Duration `yaml:"deadline"`
 }
 
 // GardenerConfig represents the Gardener specific configuration.
@@ -746,6 +1263,10 @@ type GardenerConfig struct {
 	// UserAgent is the User-Agent header to configure for the API client.
 	UserAgent string `yaml:"user_agent"`
 
+	// Landscape specifies the name of the Gardener landscape, e.g. `live',
+	// which is recorded along with the collected resources.
+	Landscape string `yaml:"landscape"`
+
 	// Endpoint specifies the endpoint of the Gardener APIs.
 	Endpoint string `yaml:"endpoint"`
 
@@ -783,6 +1304,62 @@ type GardenerConfig struct {
 	// SoilClusters provides a mapping between Gardener seed clusters and
 	// soils.
 	SoilClusters GardenerSoilClustersConfig `yaml:"soil_clusters"`
+
+	// Landscapes specifies additional Gardener landscapes, e.g. `dev' and
+	// `canary', from which resources are collected into the same database.
+	Landscapes []GardenerLandscapeConfig `yaml:"landscapes"`
+
+	// Cache specifies the settings for caching the shoots and seeds of the
+	// Gardener landscapes in the workers.
+	Cache GardenerCacheConfig `yaml:"cache"`
+
+	// APITimeout specifies the maximum duration of a single request to the
+	// Gardener APIs, e.g. fetching a single page of resources. Defaults to
+	// [DefaultAPITimeout].
+	APITimeout time.Duration `yaml:"api_timeout"`
+}
+
+// GardenerCacheConfig provides the settings for the informer-based cache of the
+// shoots and seeds of the Gardener landscapes, which is run by the workers.
+type GardenerCacheConfig struct {
+	// IsEnabled specifies whether the cache is enabled or not. When
+	// enabled, the shoots and seeds are watched via the Gardener APIs, and
+	// are served from memory to the collectors, instead of listing them
+	// on each collection.
+	IsEnabled bool `yaml:"is_enabled"`
+
+	// ResyncPeriod specifies the period, after which the cached objects
+	// are re-delivered to the cache. No periodic resync is done, if it is
+	// not specified.
+	ResyncPeriod time.Duration `yaml:"resync_period"`
+}
+
+// GardenerLandscapeConfig provides the settings for an additional Gardener
+// landscape.
+type GardenerLandscapeConfig struct {
+	// Name specifies the name of the landscape, which is recorded along
+	// with the collected resources.
+	Name string `yaml:"name"`
+
+	// Endpoint specifies the endpoint of the Gardener APIs.
+	Endpoint string `yaml:"endpoint"`
+
+	// Authentication specifies the mechanism for authentication when
+	// interfacing with the Gardener APIs. See
+	// [GardenerConfig.Authentication] for the supported mechanisms.
+	Authentication string `yaml:"authentication"`
+
+	// TokenPath represents a path to a token file, which will be used to
+	// authenticate against the Gardener APIs.
+	TokenPath string `yaml:"token_path"`
+
+	// Kubeconfig represents a path to a kubeconfig file, which will be used
+	// to authenticate against Gardener APIs.
+	Kubeconfig string `yaml:"kubeconfig"`
+
+	// ExcludedSeeds is a list of seed cluster names, from which collection
+	// will be skipped.
+	ExcludedSeeds []string `yaml:"excluded_seeds"`
 }
 
 // GardenerSoilClustersConfig provides a mapping between Gardener seed clusters
@@ -803,6 +1380,173 @@ type DashboardConfig struct {
 	// PrometheusEndpoint specifies the Prometheus endpoint from which the
 	// Dashboard UI will read metrics.
 	PrometheusEndpoint string `yaml:"prometheus_endpoint"`
+
+	// API specifies whether to serve the read-only API for searching the
+	// collected resources. The API requires access to the database.
+	API bool `yaml:"api"`
+
+	// APIAuth specifies whether the API requests must be authenticated via
+	// API tokens. When enabled, the API also serves the endpoint for
+	// enqueueing tasks, which requires the trigger:tasks scope.
+	APIAuth bool `yaml:"api_auth"`
+
+	// APIIngest specifies whether to serve the endpoint for ingesting
+	// records pushed by external systems, which requires the write:ingest
+	// scope. The endpoint is served only, if APIAuth is enabled.
+	APIIngest bool `yaml:"api_ingest"`
+
+	// Scaler specifies whether to serve the endpoints reporting the backlog
+	// of the queues, which may be used for autoscaling the workers, e.g.
+	// via the KEDA Metrics API scaler.
+	Scaler bool `yaml:"scaler"`
+
+	// Pages specifies whether to serve the HTML pages under /shoots/ and
+	// /accounts/, which render the resources owned by the Gardener Shoots
+	// and the overviews of the provider accounts. The pages require access
+	// to the database and are not authenticated via API tokens, similar to
+	// the Dashboard UI.
+	Pages bool `yaml:"pages"`
+}
+
+// AuditConfig provides the settings for the audit log. When enabled, the
+// mutating operations performed via the CLI and the Dashboard are recorded in
+// the database.
+type AuditConfig struct {
+	// IsEnabled specifies whether the audit log is enabled or not.
+	IsEnabled bool `yaml:"is_enabled"`
+
+	// Identity specifies the identity recorded for the operations
+	// performed via the CLI. If not set, the name of the current OS user is
+	// used.
+	Identity string `yaml:"identity"`
+
+	// IdentityHeader specifies the HTTP header, which carries the identity
+	// of the Dashboard user, e.g. X-Forwarded-User when running behind an
+	// authenticating proxy. If not set, or missing in the request, the
+	// remote address of the request is used.
+	IdentityHeader string `yaml:"identity_header"`
+}
+
+// EventsConfig provides the settings for publishing change events of the
+// inventory records to a message broker.
+type EventsConfig struct {
+	// IsEnabled specifies whether publishing of change events is enabled
+	// or not.
+	IsEnabled bool `yaml:"is_enabled"`
+
+	// Backend specifies the message broker, i.e. kafka or nats.
+	Backend string `yaml:"backend"`
+
+	// Prefix specifies the prefix of the Kafka topics or NATS subjects, to
+	// which the events are published. The name of the model is appended to
+	// the prefix, e.g. inventory.aws.model.instance.
+	Prefix string `yaml:"prefix"`
+
+	// Format specifies the format of the events, i.e. json or
+	// cloudevents.
+	Format string `yaml:"format"`
+
+	// CloudEvents specifies the settings for events in the cloudevents
+	// format.
+	CloudEvents CloudEventsConfig `yaml:"cloudevents"`
+
+	// Kafka specifies the Kafka settings.
+	Kafka KafkaConfig `yaml:"kafka"`
+
+	// NATS specifies the NATS settings.
+	NATS NATSConfig `yaml:"nats"`
+}
+
+// CloudEventsConfig provides the settings for events in the CloudEvents
+// format.
+type CloudEventsConfig struct {
+	// Source specifies the source attribute of the events, e.g.
+	// /inventory/prod.
+	Source string `yaml:"source"`
+
+	// TypePrefix specifies the prefix of the type attribute of the events.
+	// The model name and the operation are appended to the prefix, e.g.
+	// io.gardener.inventory.aws.model.instance.update.
+	TypePrefix string `yaml:"type_prefix"`
+}
+
+// KafkaConfig provides the settings for publishing events to Kafka.
+type KafkaConfig struct {
+	// Brokers specifies the addresses of the Kafka brokers.
+	Brokers []string `yaml:"brokers"`
+}
+
+// NATSConfig provides the settings for publishing events to NATS.
+type NATSConfig struct {
+	// URL specifies the URL of the NATS server.
+	URL string `yaml:"url"`
+
+	// CredentialsFile specifies the path to a NATS credentials file, if
+	// any.
+	CredentialsFile string `yaml:"credentials_file"`
+}
+
+// ServiceNowConfig provides the settings for synchronizing resources into the
+// ServiceNow CMDB via the Identification and Reconciliation API.
+type ServiceNowConfig struct {
+	// IsEnabled specifies whether the ServiceNow client is configured or
+	// not.
+	IsEnabled bool `yaml:"is_enabled"`
+
+	// URL specifies the URL of the ServiceNow instance, e.g.
+	// https://example.service-now.com.
+	URL string `yaml:"url"`
+
+	// Username specifies the username of the ServiceNow integration user.
+	Username string `yaml:"username"`
+
+	// PasswordFile specifies the path to the file containing the password
+	// of the ServiceNow integration user.
+	PasswordFile string `yaml:"password_file"`
+
+	// DataSource specifies the discovery source of the synchronized
+	// configuration items, if any.
+	DataSource string `yaml:"data_source"`
+
+	// Mappings specifies how the records of the models are mapped to
+	// configuration items.
+	Mappings []ServiceNowMapping `yaml:"mappings"`
+}
+
+// ServiceNowMapping specifies how the records of a model are mapped to
+// configuration items of a CMDB class.
+type ServiceNowMapping struct {
+	// Model specifies the name of the model.
+	Model string `yaml:"model"`
+
+	// ClassName specifies the CMDB class of the configuration items, e.g.
+	// cmdb_ci_kubernetes_cluster.
+	ClassName string `yaml:"class_name"`
+
+	// Fields maps the attributes of the configuration items to the columns
+	// of the model.
+	Fields map[string]string `yaml:"fields"`
+
+	// Values specifies attributes of the configuration items with static
+	// values.
+	Values map[string]string `yaml:"values"`
+}
+
+// QueriesConfig provides the settings for the named read-only SQL queries,
+// which may be run via the CLI and the API.
+type QueriesConfig struct {
+	// IsEnabled specifies whether the named queries are enabled or not.
+	IsEnabled bool `yaml:"is_enabled"`
+
+	// Directory specifies the directory containing the query files.
+	Directory string `yaml:"directory"`
+
+	// StatementTimeout specifies the max duration of the queries, after
+	// which they are aborted.
+	StatementTimeout time.Duration `yaml:"statement_timeout"`
+
+	// MaxRows specifies the max number of rows returned by the queries.
+	MaxRows int `yaml:"max_rows"`
 }
 
 // LoggingConfig provides the logging-specific settings.
@@ -820,12 +1564,137 @@ type LoggingConfig struct {
 	// Attributes provides a default set of key/value pairs to be added to
 	// each log event.
 	Attributes map[string]string `yaml:"attributes"`
+
+	// TaskLevels provides per-task log level overrides, keyed by task
+	// name. The levels are folded into the rules of [LoggingConfig.Loggers]
+	// for the exact task names, when parsing the config.
+	//
+	// Deprecated: Use [LoggingConfig.Loggers] instead.
+	TaskLevels map[string]string `yaml:"task_levels"`
+
+	// Sampling specifies the log sampling settings for task handlers.
+	Sampling LogSamplingConfig `yaml:"sampling"`
+
+	// RateLimit specifies the log rate limiting settings for task
+	// handlers.
+	RateLimit LogRateLimitConfig `yaml:"rate_limit"`
+
+	// Loggers provides log level, sampling and rate limiting overrides for
+	// task handlers, keyed by task name pattern, e.g. `openstack:task:*'.
+	// The patterns use the syntax of [path.Match]. A single rule applies to
+	// each task: the rule for the exact task name, if any, or else the
+	// longest matching pattern. Settings, which are not specified by the
+	// rule are inherited from the global settings.
+	Loggers map[string]LoggerConfig `yaml:"loggers"`
+
+	// File specifies the settings for logging to a file.
+	File LogFileConfig `yaml:"file"`
+
+	// Syslog specifies the settings for logging to syslog.
+	Syslog LogSyslogConfig `yaml:"syslog"`
+}
+
+// LogFileConfig provides the settings for logging to a file with rotation.
+type LogFileConfig struct {
+	// IsEnabled specifies whether logging to a file is enabled or not.
+	IsEnabled bool `yaml:"is_enabled"`
+
+	// Path specifies the path to the log file.
+	Path string `yaml:"path"`
+
+	// MaxSize specifies the maximum size in megabytes of the log file
+	// before it gets rotated. If it is not specified, then the log file is
+	// not rotated based on size.
+	MaxSize int `yaml:"max_size"`
+
+	// MaxAge specifies the maximum amount of time to retain rotated log
+	// files. If it is not specified, then rotated log files are not removed
+	// based on age.
+	MaxAge time.Duration `yaml:"max_age"`
+
+	// MaxBackups specifies the maximum number of rotated log files to
+	// retain. If it is not specified, then all rotated log files are
+	// retained.
+	MaxBackups int `yaml:"max_backups"`
+}
+
+// LogSyslogConfig provides the settings for logging to syslog. Log events
+// sent to the local syslog daemon are also picked up by journald.
+type LogSyslogConfig struct {
+	// IsEnabled specifies whether logging to syslog is enabled or not.
+	IsEnabled bool `yaml:"is_enabled"`
+
+	// Network specifies the network of the syslog daemon, e.g. `udp' or
+	// `tcp'. If it is not specified, then the local syslog daemon is used.
+	Network string `yaml:"network"`
+
+	// Address specifies the address of the syslog daemon.
+	Address string `yaml:"address"`
+
+	// Tag specifies the tag of the log events. If it is not specified, then
+	// the name of the program is used.
+	Tag string `yaml:"tag"`
+}
+
+// LogSamplingConfig provides the log sampling settings for task handlers.
+//
+// Sampling is applied to each task execution separately, and log events are
+// grouped by their message. The first Initial events with the same message are
+// logged, and after that only every Thereafter-th event is logged. Events
+// with level WARN or above are never sampled.
+type LogSamplingConfig struct {
+	// IsEnabled specifies whether log sampling is enabled or not.
+	IsEnabled bool `yaml:"is_enabled"`
+
+	// Initial specifies the number of events with the same message, which
+	// are logged before sampling kicks in.
+	Initial int `yaml:"initial"`
+
+	// Thereafter specifies that every Nth event with the same message is
+	// logged, once Initial has been reached. Setting it to zero drops all
+	// events after Initial.
+	Thereafter int `yaml:"thereafter"`
+}
+
+// LogRateLimitConfig provides the log rate limiting settings for task
+// handlers.
+//
+// Rate limiting is applied to each task execution separately and caps the
+// number of log events per second, regardless of their message. Events with
+// level WARN or above are never dropped.
+type LogRateLimitConfig struct {
+	// IsEnabled specifies whether log rate limiting is enabled or not.
+	IsEnabled bool `yaml:"is_enabled"`
+
+	// EventsPerSecond specifies the maximum number of log events per
+	// second. Setting it to zero drops all events below WARN.
+	EventsPerSecond int `yaml:"events_per_second"`
+}
+
+// LoggerConfig provides the log settings for the task handlers matching a
+// pattern. Settings, which are not specified are inherited from the
+// [LoggingConfig].
+type LoggerConfig struct {
+	// Level specifies the logging level.
+	Level string `yaml:"level"`
+
+	// Sampling specifies the log sampling settings.
+	Sampling *LogSamplingConfig `yaml:"sampling"`
+
+	// RateLimit specifies the log rate limiting settings.
+	RateLimit *LogRateLimitConfig `yaml:"rate_limit"`
 }
 
 // ParseFileInto parses the configuration from the given path and unmarshals it
-// into the specified out value.
+// into the specified out value. Environment variable references in the string
+// values of the configuration are expanded, see [ExpandEnv] for details.
 func ParseFileInto(path string, out any) error {
-	data, err := os.ReadFile(filepath.Clean(path))
+	item, err := readFile(path)
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("%s: %w", path, err)
 	}
@@ -837,11 +1706,32 @@ func ParseFileInto(path string, out any) error {
 	return nil
 }
 
-// Parse parses the configs from the given paths in-order. Configuration
-// settings provided later in the sequence of paths will override settings from
-// previous config paths.
+// readFile reads the configuration from the given path and expands the
+// environment variable references in its string values.
+func readFile(path string) (map[string]any, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	var item map[string]any
+	if err := yaml.Unmarshal(data, &item); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if _, err := ExpandEnv(item); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return item, nil
+}
+
+// Parse parses the configs from the given paths in-order. The configs are
+// deep-merged with each other, where settings provided later in the sequence of
+// paths take precedence over settings from previous config paths. See [Merge]
+// for details about how the settings are merged.
 func Parse(paths ...string) (*Config, error) {
-	var conf Config
+	merged := make(map[string]any)
 
 	for _, path := range paths {
 		// Ignore empty paths
@@ -849,25 +1739,119 @@ func Parse(paths ...string) (*Config, error) {
 			continue
 		}
 
-		if err := ParseFileInto(path, &conf); err != nil {
+		item, err := readFile(path)
+		if err != nil {
 			return nil, err
 		}
 
-		if conf.Version == "" {
+		// The config format version is required for the base config
+		// only. Subsequent configs may omit it, but must not specify
+		// a different version.
+		version, _ := item["version"].(string)
+		if version == "" && len(merged) == 0 {
 			return nil, fmt.Errorf("%w: %s", ErrNoConfigVersion, path)
 		}
 
-		if conf.Version != ConfigFormatVersion {
-			return nil, fmt.Errorf("%w: %s (%s)", ErrUnsupportedVersion, conf.Version, path)
+		if version != "" && version != ConfigFormatVersion {
+			return nil, fmt.Errorf("%w: %s (%s)", ErrUnsupportedVersion, version, path)
 		}
+
+		merged = Merge(merged, item)
 	}
 
+	data, err := yaml.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+
+	var conf Config
+	if err := yaml.Unmarshal(data, &conf); err != nil {
+		return nil, err
+	}
+
+	setDefaults(&conf)
+
+	return &conf, nil
+}
+
+// setDefaults sets the default values for settings, which were not specified
+// in the config.
+func setDefaults(conf *Config) {
 	// AWS defaults
 	if conf.AWS.AppID == "" {
 		conf.AWS.AppID = DefaultAWSAppID
 	}
 
-	return &conf, nil
+	// Azure defaults
+	if conf.Azure.SubscriptionCache.TTL == 0 {
+		conf.Azure.SubscriptionCache.TTL = DefaultAzureSubscriptionCacheTTL
+	}
+
+	// Provider API timeout defaults
+	for _, apiTimeout := range []*time.Duration{
+		&conf.AWS.APITimeout,
+		&conf.GCP.APITimeout,
+		&conf.Azure.APITimeout,
+		&conf.OpenStack.APITimeout,
+		&conf.Gardener.APITimeout,
+	} {
+		if *apiTimeout == 0 {
+			*apiTimeout = DefaultAPITimeout
+		}
+	}
+
+	// Scheduler defaults
+	if conf.Scheduler.DefaultQueue == "" {
+		conf.Scheduler.DefaultQueue = DefaultQueueName
+	}
+
+	// Unique tasks defaults
+	if conf.UniqueTasks.TTL == 0 {
+		conf.UniqueTasks.TTL = DefaultUniqueTaskTTL
+	}
+
+	// Per-task log levels are folded into the logger rules
+	for taskName, level := range conf.Logging.TaskLevels {
+		if conf.Logging.Loggers == nil {
+			conf.Logging.Loggers = make(map[string]LoggerConfig)
+		}
+		loggerConf := conf.Logging.Loggers[taskName]
+		loggerConf.Level = level
+		conf.Logging.Loggers[taskName] = loggerConf
+	}
+	conf.Logging.TaskLevels = nil
+
+	// Worker defaults
+	if conf.Worker.Metrics.Address == "" {
+		conf.Worker.Metrics.Address = DefaultWorkerMetricsAddress
+	}
+	if conf.Worker.Metrics.Path == "" {
+		conf.Worker.Metrics.Path = DefaultWorkerMetricsPath
+	}
+	if conf.Worker.Groups.GracePeriod == 0 {
+		conf.Worker.Groups.GracePeriod = DefaultGroupGracePeriod
+	}
+	if conf.Worker.Groups.MaxDelay == 0 {
+		conf.Worker.Groups.MaxDelay = DefaultGroupMaxDelay
+	}
+	if conf.Worker.CircuitBreaker.FailureThreshold <= 0 {
+		conf.Worker.CircuitBreaker.FailureThreshold = DefaultCircuitBreakerFailureThreshold
+	}
+	if conf.Worker.CircuitBreaker.Cooldown == 0 {
+		conf.Worker.CircuitBreaker.Cooldown = DefaultCircuitBreakerCooldown
+	}
+	if conf.Worker.BatchSize <= 0 {
+		conf.Worker.BatchSize = DefaultWorkerBatchSize
+	}
+	if conf.GCP.ZoneConcurrency <= 0 {
+		conf.GCP.ZoneConcurrency = DefaultGCPZoneConcurrency
+	}
+}
+
+// isServiceEnabled returns true, if the given service setting is either not
+// specified, or is explicitly set to true.
+func isServiceEnabled(v *bool) bool {
+	return v == nil || *v
 }
 
 // MustParse parses the configs from the given paths, or panics in case of
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config_test
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/core/config"
+)
+
+func TestFilterConfigAllows(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		filter config.FilterConfig
+		ids    []string
+		wanted bool
+	}{
+		{
+			desc:   "empty filter",
+			filter: config.FilterConfig{},
+			ids:    []string{"foo"},
+			wanted: true,
+		},
+		{
+			desc:   "included",
+			filter: config.FilterConfig{Include: []string{"foo"}},
+			ids:    []string{"foo"},
+			wanted: true,
+		},
+		{
+			desc:   "not included",
+			filter: config.FilterConfig{Include: []string{"foo"}},
+			ids:    []string{"bar"},
+			wanted: false,
+		},
+		{
+			desc:   "excluded",
+			filter: config.FilterConfig{Exclude: []string{"foo"}},
+			ids:    []string{"foo"},
+			wanted: false,
+		},
+		{
+			desc:   "exclude takes precedence",
+			filter: config.FilterConfig{Include: []string{"foo"}, Exclude: []string{"foo"}},
+			ids:    []string{"foo"},
+			wanted: false,
+		},
+		{
+			desc:   "included by second id",
+			filter: config.FilterConfig{Include: []string{"project-id"}},
+			ids:    []string{"project-name", "project-id"},
+			wanted: true,
+		},
+		{
+			desc:   "excluded by second id",
+			filter: config.FilterConfig{Exclude: []string{"project-id"}},
+			ids:    []string{"project-name", "project-id"},
+			wanted: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := tc.filter.Allows(tc.ids...)
+			if got != tc.wanted {
+				t.Fatalf("want %t got %t", tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestRouteQueue(t *testing.T) {
+	conf := &config.Config{
+		Routes: []config.RouteConfig{
+			{Pattern: "openstack:task:collect-servers", Label: "openstack-servers"},
+			{Pattern: "openstack:task:*", Label: "openstack"},
+			{Pattern: "aws:task:*", Label: "aws"},
+		},
+	}
+
+	testCases := []struct {
+		desc      string
+		taskName  string
+		wantQueue string
+		wantOK    bool
+	}{
+		{
+			desc:      "first matching route wins",
+			taskName:  "openstack:task:collect-servers",
+			wantQueue: "openstack-servers",
+			wantOK:    true,
+		},
+		{
+			desc:      "wildcard route",
+			taskName:  "aws:task:collect-instances",
+			wantQueue: "aws",
+			wantOK:    true,
+		},
+		{
+			desc:      "no matching route",
+			taskName:  "gcp:task:collect-instances",
+			wantQueue: "",
+			wantOK:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			queue, ok := conf.RouteQueue(tc.taskName)
+			if queue != tc.wantQueue || ok != tc.wantOK {
+				t.Fatalf("want %q (%t) got %q (%t)", tc.wantQueue, tc.wantOK, queue, ok)
+			}
+		})
+	}
+}
+
+func TestWorkerProcessedQueues(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		conf       config.WorkerConfig
+		wantQueues map[string]int
+	}{
+		{
+			desc:       "default queue",
+			conf:       config.WorkerConfig{},
+			wantQueues: map[string]int{config.DefaultQueueName: 1},
+		},
+		{
+			desc: "configured queues",
+			conf: config.WorkerConfig{
+				Queues: map[string]int{"critical": 6, "default": 3},
+			},
+			wantQueues: map[string]int{"critical": 6, "default": 3},
+		},
+		{
+			desc: "labels only",
+			conf: config.WorkerConfig{
+				Labels: []string{"aws", "openstack"},
+			},
+			wantQueues: map[string]int{"aws": 1, "openstack": 1},
+		},
+		{
+			desc: "queues and labels",
+			conf: config.WorkerConfig{
+				Queues: map[string]int{"default": 3, "aws": 2},
+				Labels: []string{"aws", "openstack"},
+			},
+			wantQueues: map[string]int{"default": 3, "aws": 2, "openstack": 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			queues := tc.conf.ProcessedQueues()
+			if !maps.Equal(queues, tc.wantQueues) {
+				t.Fatalf("want %v got %v", tc.wantQueues, queues)
+			}
+		})
+	}
+}
+
+func TestParseFoldTaskLevels(t *testing.T) {
+	data := `
+version: v1alpha1
+logging:
+  task_levels:
+    "aws:task:collect-instances": warn
+    "openstack:task:collect-ports": debug
+  loggers:
+    "openstack:task:collect-ports":
+      level: error
+      rate_limit:
+        is_enabled: true
+        events_per_second: 5
+`
+	conf, err := config.Parse(writeConfig(t, "config.yaml", data))
+	if err != nil {
+		t.Fatalf("cannot parse config: %s", err)
+	}
+
+	if conf.Logging.TaskLevels != nil {
+		t.Fatalf("want task levels to be folded got %v", conf.Logging.TaskLevels)
+	}
+
+	instances := conf.Logging.Loggers["aws:task:collect-instances"]
+	if instances.Level != "warn" || instances.RateLimit != nil {
+		t.Fatalf("got logger %+v for aws:task:collect-instances", instances)
+	}
+
+	ports := conf.Logging.Loggers["openstack:task:collect-ports"]
+	if ports.Level != "debug" || ports.RateLimit == nil || ports.RateLimit.EventsPerSecond != 5 {
+		t.Fatalf("got logger %+v for openstack:task:collect-ports", ports)
+	}
+}
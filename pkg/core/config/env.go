@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// ErrEnvVarNotSet is an error, which is returned when a required environment
+// variable referenced via `${VAR:?message}' is not set.
+var ErrEnvVarNotSet = errors.New("environment variable not set")
+
+// envVarRegex matches environment variable references in config files.
+//
+// The following forms are supported.
+//
+//   - `${VAR}' expands to the value of VAR, or an empty string, if VAR is unset
+//   - `${VAR:-default}' expands to the value of VAR, or to default, if VAR is
+//     unset or empty
+//   - `${VAR:?message}' expands to the value of VAR, or fails with the given
+//     message, if VAR is unset or empty
+//   - `$${VAR}' is an escape sequence, which expands to the literal `${VAR}'
+var envVarRegex = regexp.MustCompile(`\$?\$\{([A-Za-z_][A-Za-z0-9_]*)(?:(:[-?])([^}]*))?\}`)
+
+// ExpandEnv expands the environment variable references in the string values
+// of the given unmarshaled config using the [os.LookupEnv] function. See
+// [envVarRegex] for the supported forms of references.
+func ExpandEnv(v any) (any, error) {
+	return ExpandEnvValues(v, os.LookupEnv)
+}
+
+// ExpandEnvValues expands the environment variable references in the string
+// values of the given unmarshaled config using the specified lookup function.
+//
+// Since the references are expanded after unmarshaling, the values of
+// environment variables cannot alter the structure of the config. Expanded
+// values are kept as strings, which are converted to numbers when unmarshaling
+// the config into numeric fields. Strings, which consist of a single reference
+// only and expand to `true' or `false' are converted to a boolean, so that
+// they can be used for boolean settings. Any other value, e.g. a password with
+// a leading zero, is never re-interpreted.
+func ExpandEnvValues(v any, lookup func(key string) (string, bool)) (any, error) {
+	switch val := v.(type) {
+	case map[string]any:
+		for key, item := range val {
+			expanded, err := ExpandEnvValues(item, lookup)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			val[key] = expanded
+		}
+
+		return val, nil
+	case []any:
+		for i, item := range val {
+			expanded, err := ExpandEnvValues(item, lookup)
+			if err != nil {
+				return nil, err
+			}
+			val[i] = expanded
+		}
+
+		return val, nil
+	case string:
+		data, err := ExpandEnvFunc([]byte(val), lookup)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isSingleReference(val) {
+			return string(data), nil
+		}
+
+		return boolValue(string(data)), nil
+	default:
+		return v, nil
+	}
+}
+
+// isSingleReference returns true, if the given value consists of a single
+// environment variable reference only.
+func isSingleReference(value string) bool {
+	loc := envVarRegex.FindStringIndex(value)
+	if loc == nil || loc[0] != 0 || loc[1] != len(value) {
+		return false
+	}
+
+	// Escaped reference
+	return !strings.HasPrefix(value, "$$")
+}
+
+// boolValue returns the boolean represented by the given value, or the value
+// itself otherwise. Only the canonical `true' and `false' values are
+// converted, since these are unmarshaled back into the same string, when used
+// for string settings.
+func boolValue(value string) any {
+	switch value {
+	case "true":
+		return true
+	case "false":
+		return false
+	default:
+		return value
+	}
+}
+
+// ExpandEnvFunc expands the environment variable references in the given data
+// using the specified lookup function.
+func ExpandEnvFunc(data []byte, lookup func(key string) (string, bool)) ([]byte, error) {
+	var expandErr error
+	result := envVarRegex.ReplaceAllFunc(data, func(match []byte) []byte {
+		// Escaped reference
+		if match[1] == '$' {
+			return match[1:]
+		}
+
+		groups := envVarRegex.FindSubmatch(match)
+		name, op, arg := string(groups[1]), string(groups[2]), string(groups[3])
+		value, _ := lookup(name)
+
+		switch op {
+		case ":-":
+			if value == "" {
+				value = arg
+			}
+		case ":?":
+			if value == "" && expandErr == nil {
+				expandErr = fmt.Errorf("%w: %s: %s", ErrEnvVarNotSet, name, arg)
+			}
+		}
+
+		return []byte(value)
+	})
+
+	if expandErr != nil {
+		return nil, expandErr
+	}
+
+	return result, nil
+}
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/core/config"
+)
+
+func TestExpandEnvFunc(t *testing.T) {
+	env := map[string]string{
+		"FOO":   "foo",
+		"EMPTY": "",
+	}
+	lookup := func(key string) (string, bool) {
+		value, ok := env[key]
+
+		return value, ok
+	}
+
+	testCases := []struct {
+		desc    string
+		input   string
+		wanted  string
+		wantErr error
+	}{
+		{
+			desc:   "no references",
+			input:  "dsn: postgresql://localhost/inventory",
+			wanted: "dsn: postgresql://localhost/inventory",
+		},
+		{
+			desc:   "set variable",
+			input:  "endpoint: ${FOO}:6379",
+			wanted: "endpoint: foo:6379",
+		},
+		{
+			desc:   "unset variable",
+			input:  "endpoint: ${BAR}",
+			wanted: "endpoint: ",
+		},
+		{
+			desc:   "default for unset variable",
+			input:  "endpoint: ${BAR:-localhost}",
+			wanted: "endpoint: localhost",
+		},
+		{
+			desc:   "default for empty variable",
+			input:  "endpoint: ${EMPTY:-localhost}",
+			wanted: "endpoint: localhost",
+		},
+		{
+			desc:   "default for set variable",
+			input:  "endpoint: ${FOO:-localhost}",
+			wanted: "endpoint: foo",
+		},
+		{
+			desc:   "escaped reference",
+			input:  "value: $${FOO} ${FOO}",
+			wanted: "value: ${FOO} foo",
+		},
+		{
+			desc:   "lone dollar signs are preserved",
+			input:  "password: $ecret$FOO",
+			wanted: "password: $ecret$FOO",
+		},
+		{
+			desc:   "required variable is set",
+			input:  "endpoint: ${FOO:?endpoint is required}",
+			wanted: "endpoint: foo",
+		},
+		{
+			desc:    "required variable is not set",
+			input:   "endpoint: ${BAR:?endpoint is required}",
+			wantErr: config.ErrEnvVarNotSet,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			output, err := config.ExpandEnvFunc([]byte(tc.input), lookup)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("want error %v got %v", tc.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(output) != tc.wanted {
+				t.Fatalf("want %q got %q", tc.wanted, string(output))
+			}
+		})
+	}
+}
+
+func TestExpandEnvValues(t *testing.T) {
+	env := map[string]string{
+		"HOST":     "localhost",
+		"PORT":     "6379",
+		"ENABLED":  "true",
+		"INJECTED": "foo\nis_enabled: true",
+		"COMMENT":  "p4ss#w0rd",
+	}
+	lookup := func(key string) (string, bool) {
+		value, ok := env[key]
+
+		return value, ok
+	}
+
+	input := map[string]any{
+		"endpoint":   "${HOST}:${PORT}",
+		"port":       "${PORT}",
+		"is_enabled": "${ENABLED}",
+		"name":       "${INJECTED}",
+		"password":   "${COMMENT}",
+		"escaped":    "$${PORT}",
+		"items":      []any{"${HOST}", 42, map[string]any{"host": "${HOST}"}},
+		"timeout":    30,
+	}
+	wanted := map[string]any{
+		"endpoint":   "localhost:6379",
+		"port":       "6379",
+		"is_enabled": true,
+		"name":       "foo\nis_enabled: true",
+		"password":   "p4ss#w0rd",
+		"escaped":    "${PORT}",
+		"items":      []any{"localhost", 42, map[string]any{"host": "localhost"}},
+		"timeout":    30,
+	}
+
+	got, err := config.ExpandEnvValues(input, lookup)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, wanted) {
+		t.Fatalf("want %v got %v", wanted, got)
+	}
+
+	_, err = config.ExpandEnvValues(map[string]any{"dsn": "${DSN:?dsn is required}"}, lookup)
+	if !errors.Is(err, config.ErrEnvVarNotSet) {
+		t.Fatalf("want error %v got %v", config.ErrEnvVarNotSet, err)
+	}
+}
+
+func TestParseExpandEnv(t *testing.T) {
+	t.Setenv("INVENTORY_TEST_DEBUG", "true")
+	t.Setenv("INVENTORY_TEST_REGION", "eu-west-1\ndebug: false")
+	t.Setenv("INVENTORY_TEST_CONCURRENCY", "0100")
+
+	data := `
+version: v1alpha1
+debug: ${INVENTORY_TEST_DEBUG}
+worker:
+  concurrency: ${INVENTORY_TEST_CONCURRENCY}
+aws:
+  # ${INVENTORY_TEST_UNSET:?must not be expanded in comments}
+  region: "${INVENTORY_TEST_REGION}"
+`
+	conf, err := config.Parse(writeConfig(t, "config.yaml", data))
+	if err != nil {
+		t.Fatalf("cannot parse config: %s", err)
+	}
+
+	if !conf.Debug {
+		t.Fatal("want debug to be enabled")
+	}
+
+	if conf.Worker.Concurrency != 100 {
+		t.Fatalf("want concurrency 100 got %d", conf.Worker.Concurrency)
+	}
+
+	if conf.AWS.Region != "eu-west-1\ndebug: false" {
+		t.Fatalf("want region to be expanded verbatim got %q", conf.AWS.Region)
+	}
+}
+
+func TestParseExpandEnvString(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		value string
+	}{
+		{desc: "leading zero", value: "0123"},
+		{desc: "hex", value: "0x1F"},
+		{desc: "octal", value: "0o17"},
+		{desc: "underscore", value: "1_000"},
+		{desc: "leading dot", value: ".5"},
+		{desc: "exponent", value: "1e3"},
+		{desc: "yes", value: "yes"},
+		{desc: "null", value: "null"},
+		{desc: "true", value: "true"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Setenv("INVENTORY_TEST_DSN", tc.value)
+			t.Setenv("INVENTORY_TEST_REGION", tc.value)
+
+			data := `
+version: v1alpha1
+database:
+  dsn: ${INVENTORY_TEST_DSN}
+aws:
+  region: "${INVENTORY_TEST_REGION}"
+`
+			conf, err := config.Parse(writeConfig(t, "config.yaml", data))
+			if err != nil {
+				t.Fatalf("cannot parse config: %s", err)
+			}
+
+			if conf.Database.DSN != tc.value {
+				t.Fatalf("want dsn %q got %q", tc.value, conf.Database.DSN)
+			}
+
+			if conf.AWS.Region != tc.value {
+				t.Fatalf("want region %q got %q", tc.value, conf.AWS.Region)
+			}
+		})
+	}
+}
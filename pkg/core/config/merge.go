@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"slices"
+)
+
+// Merge deep-merges the src map into the dst map and returns the result.
+//
+// The following rules are applied when merging the values.
+//
+//   - Maps are merged recursively, e.g. named credentials from src are added to
+//     the named credentials in dst
+//   - Lists of maps, where each item has a `name' key, e.g. scheduler jobs, are
+//     merged by name. Items from src are merged into the items with the same
+//     name in dst, and the remaining items from src are appended
+//   - Any other list from src replaces the list in dst
+//   - Any other value from src overrides the value in dst
+//   - Null values in src are ignored
+func Merge(dst, src map[string]any) map[string]any {
+	if dst == nil {
+		dst = make(map[string]any, len(src))
+	}
+
+	for key, srcVal := range src {
+		if srcVal == nil {
+			continue
+		}
+
+		dstVal, ok := dst[key]
+		if !ok || dstVal == nil {
+			dst[key] = srcVal
+
+			continue
+		}
+
+		switch s := srcVal.(type) {
+		case map[string]any:
+			d, ok := dstVal.(map[string]any)
+			if !ok {
+				dst[key] = s
+
+				continue
+			}
+			dst[key] = Merge(d, s)
+		case []any:
+			d, ok := dstVal.([]any)
+			if !ok || !isKeyedList(d) || !isKeyedList(s) {
+				dst[key] = s
+
+				continue
+			}
+			dst[key] = mergeKeyedLists(d, s)
+		default:
+			dst[key] = s
+		}
+	}
+
+	return dst
+}
+
+// isKeyedList returns true, if each item of the given list is a map with a
+// non-empty `name' key.
+func isKeyedList(items []any) bool {
+	if len(items) == 0 {
+		return false
+	}
+
+	for _, item := range items {
+		if itemName(item) == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// itemName returns the value of the `name' key of the given list item, or an
+// empty string, if the item is not a map or has no name.
+func itemName(item any) string {
+	m, ok := item.(map[string]any)
+	if !ok {
+		return ""
+	}
+	name, _ := m["name"].(string)
+
+	return name
+}
+
+// mergeKeyedLists merges the items of the src list into the items with the same
+// name in the dst list. Items of src, which are not present in dst are
+// appended.
+func mergeKeyedLists(dst, src []any) []any {
+	for _, item := range src {
+		name := itemName(item)
+		idx := slices.IndexFunc(dst, func(v any) bool {
+			return itemName(v) == name
+		})
+		if idx == -1 {
+			dst = append(dst, item)
+
+			continue
+		}
+		dst[idx] = Merge(dst[idx].(map[string]any), item.(map[string]any))
+	}
+
+	return dst
+}
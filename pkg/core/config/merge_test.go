@@ -0,0 +1,264 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/core/config"
+)
+
+const baseConfig = `
+version: v1alpha1
+debug: false
+aws:
+  is_enabled: true
+  region: eu-central-1
+  credentials:
+    default:
+      token_retriever: none
+  services:
+    ec2:
+      use_credentials:
+        - default
+scheduler:
+  jobs:
+    - name: "aws:task:collect-regions"
+      spec: "@every 1h"
+`
+
+const overrideConfig = `
+debug: true
+aws:
+  credentials:
+    other:
+      token_retriever: token_file
+  services:
+    ec2:
+      use_credentials:
+        - other
+    s3:
+      is_enabled: false
+scheduler:
+  default_queue: aws
+  jobs:
+    - name: "aws:task:collect-regions"
+      spec: "@every 6h"
+    - name: "aws:task:collect-vpcs"
+      spec: "@every 1h"
+`
+
+func writeConfig(t *testing.T, name, data string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatalf("cannot write config: %s", err)
+	}
+
+	return path
+}
+
+func TestParseMerge(t *testing.T) {
+	base := writeConfig(t, "base.yaml", baseConfig)
+	override := writeConfig(t, "override.yaml", overrideConfig)
+
+	conf, err := config.Parse(base, override)
+	if err != nil {
+		t.Fatalf("cannot parse config: %s", err)
+	}
+
+	if !conf.Debug {
+		t.Fatal("want debug to be overridden")
+	}
+
+	if conf.AWS.Region != "eu-central-1" {
+		t.Fatalf("want region eu-central-1 got %s", conf.AWS.Region)
+	}
+
+	if !conf.AWS.IsEnabled {
+		t.Fatal("want AWS to remain enabled")
+	}
+
+	for _, name := range []string{"default", "other"} {
+		if _, ok := conf.AWS.Credentials[name]; !ok {
+			t.Fatalf("want named credentials %s to be present", name)
+		}
+	}
+
+	wantCreds := []string{"other"}
+	if !slices.Equal(conf.AWS.Services.EC2.UseCredentials, wantCreds) {
+		t.Fatalf("want %v got %v", wantCreds, conf.AWS.Services.EC2.UseCredentials)
+	}
+
+	if !conf.AWS.Services.EC2.Enabled() {
+		t.Fatal("want EC2 to be enabled by default")
+	}
+
+	if conf.AWS.Services.S3.Enabled() {
+		t.Fatal("want S3 to be disabled")
+	}
+
+	if len(conf.Scheduler.Jobs) != 2 {
+		t.Fatalf("want 2 jobs got %d", len(conf.Scheduler.Jobs))
+	}
+
+	if conf.Scheduler.Jobs[0].Spec != "@every 6h" {
+		t.Fatalf("want job spec @every 6h got %s", conf.Scheduler.Jobs[0].Spec)
+	}
+
+	if conf.Scheduler.DefaultQueue != "aws" {
+		t.Fatalf("want default queue aws got %s", conf.Scheduler.DefaultQueue)
+	}
+
+	if conf.AWS.AppID != config.DefaultAWSAppID {
+		t.Fatalf("want default app id %s got %s", config.DefaultAWSAppID, conf.AWS.AppID)
+	}
+
+	if conf.Azure.SubscriptionCache.TTL != config.DefaultAzureSubscriptionCacheTTL {
+		t.Fatalf("want default subscription cache ttl %s got %s", config.DefaultAzureSubscriptionCacheTTL, conf.Azure.SubscriptionCache.TTL)
+	}
+
+	if conf.GCP.APITimeout != config.DefaultAPITimeout {
+		t.Fatalf("want default api timeout %s got %s", config.DefaultAPITimeout, conf.GCP.APITimeout)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	dst := map[string]any{
+		"a": map[string]any{"b": 1, "c": []any{"x"}},
+		"d": "foo",
+	}
+	src := map[string]any{
+		"a": map[string]any{"c": []any{"x", "y"}, "e": true},
+		"d": nil,
+	}
+
+	result := config.Merge(dst, src)
+	a, ok := result["a"].(map[string]any)
+	if !ok {
+		t.Fatalf("want map got %T", result["a"])
+	}
+
+	if a["b"] != 1 || a["e"] != true {
+		t.Fatalf("unexpected merged map: %v", a)
+	}
+
+	if c, _ := a["c"].([]any); !slices.Equal(c, []any{"x", "y"}) {
+		t.Fatalf("want list to be replaced got %v", a["c"])
+	}
+
+	if result["d"] != "foo" {
+		t.Fatalf("want null value to be ignored, got %v", result["d"])
+	}
+}
+
+func TestMergeLists(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		dst    []any
+		src    []any
+		wanted []any
+	}{
+		{
+			desc:   "plain lists are replaced",
+			dst:    []any{"a", "b"},
+			src:    []any{"c"},
+			wanted: []any{"c"},
+		},
+		{
+			desc: "keyed lists are merged by name",
+			dst: []any{
+				map[string]any{"name": "a", "spec": "@every 1h", "queue": "aws"},
+				map[string]any{"name": "b", "spec": "@every 1h"},
+			},
+			src: []any{
+				map[string]any{"name": "a", "spec": "@every 6h"},
+				map[string]any{"name": "c", "spec": "@every 1h"},
+			},
+			wanted: []any{
+				map[string]any{"name": "a", "spec": "@every 6h", "queue": "aws"},
+				map[string]any{"name": "b", "spec": "@every 1h"},
+				map[string]any{"name": "c", "spec": "@every 1h"},
+			},
+		},
+		{
+			desc: "lists with unnamed items are replaced",
+			dst: []any{
+				map[string]any{"name": "a"},
+			},
+			src: []any{
+				map[string]any{"spec": "@every 1h"},
+			},
+			wanted: []any{
+				map[string]any{"spec": "@every 1h"},
+			},
+		},
+		{
+			desc: "empty list replaces keyed list",
+			dst: []any{
+				map[string]any{"name": "a"},
+			},
+			src:    []any{},
+			wanted: []any{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result := config.Merge(map[string]any{"items": tc.dst}, map[string]any{"items": tc.src})
+			if !reflect.DeepEqual(result["items"], tc.wanted) {
+				t.Fatalf("want %v got %v", tc.wanted, result["items"])
+			}
+		})
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		configs []string
+		wantErr error
+	}{
+		{
+			desc:    "version in base config only",
+			configs: []string{"version: v1alpha1\ndebug: false\n", "debug: true\n"},
+		},
+		{
+			desc:    "version in all configs",
+			configs: []string{"version: v1alpha1\ndebug: false\n", "version: v1alpha1\ndebug: true\n"},
+		},
+		{
+			desc:    "missing version in base config",
+			configs: []string{"debug: false\n", "version: v1alpha1\ndebug: true\n"},
+			wantErr: config.ErrNoConfigVersion,
+		},
+		{
+			desc:    "unsupported version in override config",
+			configs: []string{"version: v1alpha1\ndebug: false\n", "version: v2\ndebug: true\n"},
+			wantErr: config.ErrUnsupportedVersion,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			paths := make([]string, 0, len(tc.configs))
+			for i, data := range tc.configs {
+				paths = append(paths, writeConfig(t, fmt.Sprintf("config-%d.yaml", i), data))
+			}
+
+			_, err := config.Parse(paths...)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("want error %v got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
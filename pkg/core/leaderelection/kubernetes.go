@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package leaderelection
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// KubernetesElector is an implementation of [Elector], which uses a Kubernetes
+// Lease resource as the lease.
+type KubernetesElector struct {
+	settings
+	client    kubernetes.Interface
+	namespace string
+	name      string
+}
+
+var _ Elector = &KubernetesElector{}
+
+// NewKubernetesElector creates a new [KubernetesElector], which uses the Lease
+// resource with the given namespace and name.
+func NewKubernetesElector(client kubernetes.Interface, namespace, name string, opts ...Option) (*KubernetesElector, error) {
+	s, err := newSettings(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &KubernetesElector{
+		settings:  s,
+		client:    client,
+		namespace: namespace,
+		name:      name,
+	}
+
+	return e, nil
+}
+
+// Run implements the [Elector] interface.
+func (e *KubernetesElector) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Namespace: e.namespace,
+			Name:      e.name,
+		},
+		Client: e.client.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: e.identity,
+		},
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// The leader elector calls OnStartedLeading in a separate goroutine,
+	// and may return before the callback finishes. The function is
+	// therefore called from this goroutine, so that subsequent terms
+	// never overlap.
+	leaderCh := make(chan context.Context)
+	callbacks := leaderelection.LeaderCallbacks{
+		OnStartedLeading: func(ctx context.Context) {
+			e.logger.Info("acquired leadership", "lease", e.name, "identity", e.identity)
+			select {
+			case leaderCh <- ctx:
+			case <-runCtx.Done():
+			}
+		},
+		OnStoppedLeading: func() {
+			e.logger.Info("stopped leading", "lease", e.name, "identity", e.identity)
+		},
+	}
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   e.leaseDuration,
+		RenewDeadline:   e.renewDeadline,
+		RetryPeriod:     e.retryPeriod,
+		ReleaseOnCancel: true,
+		Callbacks:       callbacks,
+		Name:            e.name,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The leader elector returns, once leadership is lost, so keep on
+	// campaigning until the context is cancelled.
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for runCtx.Err() == nil {
+			elector.Run(runCtx)
+		}
+	}()
+
+	for {
+		select {
+		case <-runCtx.Done():
+			<-stopped
+
+			return nil
+		case leaderCtx := <-leaderCh:
+			if err := fn(leaderCtx); err != nil {
+				cancel()
+				<-stopped
+
+				return err
+			}
+		}
+	}
+}
+
+// Close implements the [Elector] interface.
+func (e *KubernetesElector) Close() error {
+	return nil
+}
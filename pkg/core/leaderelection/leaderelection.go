@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package leaderelection provides leader election between multiple replicas of
+// a service, so that only one of them is active at a time, e.g. the scheduler
+// enqueueing periodic jobs.
+package leaderelection
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"time"
+)
+
+// DefaultLeaseDuration is the default duration for which a lease is held by
+// the leader.
+const DefaultLeaseDuration = 15 * time.Second
+
+// DefaultRenewDeadline is the default duration for which the leader keeps
+// retrying to renew the lease, before giving up leadership.
+const DefaultRenewDeadline = 10 * time.Second
+
+// DefaultRetryPeriod is the default duration candidates wait between attempts
+// to acquire or renew the lease.
+const DefaultRetryPeriod = 2 * time.Second
+
+// ErrInvalidDurations is an error, which is returned when the configured
+// durations are inconsistent with each other.
+var ErrInvalidDurations = errors.New("lease duration must be greater than renew deadline, which must be greater than retry period")
+
+// Elector elects a leader among multiple candidates.
+type Elector interface {
+	// Run campaigns for leadership until the given context is cancelled.
+	// Whenever leadership is acquired, the given function is called with a
+	// context, which is cancelled when leadership is lost. Run returns
+	// the error returned by the function, if any.
+	Run(ctx context.Context, fn func(ctx context.Context) error) error
+
+	// Close releases the resources held by the elector. It must be called
+	// once Run has returned.
+	Close() error
+}
+
+// Option is a function, which configures an [Elector].
+type Option func(s *settings)
+
+// settings provides the settings shared by the [Elector] implementations.
+type settings struct {
+	identity      string
+	leaseDuration time.Duration
+	renewDeadline time.Duration
+	retryPeriod   time.Duration
+	logger        *slog.Logger
+}
+
+// newSettings returns the settings with the given options applied.
+func newSettings(opts ...Option) (settings, error) {
+	s := settings{
+		leaseDuration: DefaultLeaseDuration,
+		renewDeadline: DefaultRenewDeadline,
+		retryPeriod:   DefaultRetryPeriod,
+		logger:        slog.Default(),
+	}
+
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	if s.identity == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return settings{}, err
+		}
+		s.identity = hostname
+	}
+
+	if s.leaseDuration <= s.renewDeadline || s.renewDeadline <= s.retryPeriod {
+		return settings{}, ErrInvalidDurations
+	}
+
+	return s, nil
+}
+
+// WithIdentity is an [Option], which configures the identity of the candidate.
+// If it is not specified, then the hostname is used.
+func WithIdentity(identity string) Option {
+	opt := func(s *settings) {
+		s.identity = identity
+	}
+
+	return opt
+}
+
+// WithLeaseDuration is an [Option], which configures the duration for which a
+// lease is held by the leader.
+func WithLeaseDuration(d time.Duration) Option {
+	opt := func(s *settings) {
+		if d > 0 {
+			s.leaseDuration = d
+		}
+	}
+
+	return opt
+}
+
+// WithRenewDeadline is an [Option], which configures the duration for which the
+// leader keeps retrying to renew the lease, before giving up leadership.
+func WithRenewDeadline(d time.Duration) Option {
+	opt := func(s *settings) {
+		if d > 0 {
+			s.renewDeadline = d
+		}
+	}
+
+	return opt
+}
+
+// WithRetryPeriod is an [Option], which configures the duration candidates wait
+// between attempts to acquire or renew the lease.
+func WithRetryPeriod(d time.Duration) Option {
+	opt := func(s *settings) {
+		if d > 0 {
+			s.retryPeriod = d
+		}
+	}
+
+	return opt
+}
+
+// WithLogger is an [Option], which configures the [Elector] to use the given
+// [slog.Logger].
+func WithLogger(logger *slog.Logger) Option {
+	opt := func(s *settings) {
+		s.logger = logger
+	}
+
+	return opt
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package leaderelection
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// renewScript extends the expiry of the lease, if it is still held by the
+// given identity.
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseScript deletes the lease, if it is still held by the given identity.
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// RedisElector is an implementation of [Elector], which uses a Redis key with
+// an expiry as the lease.
+type RedisElector struct {
+	settings
+	client redis.UniversalClient
+	key    string
+}
+
+var _ Elector = &RedisElector{}
+
+// NewRedisElector creates a new [RedisElector], which uses the given Redis key
+// as the lease. The elector takes ownership of the given client, which is
+// closed by [RedisElector.Close].
+func NewRedisElector(client redis.UniversalClient, key string, opts ...Option) (*RedisElector, error) {
+	s, err := newSettings(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &RedisElector{
+		settings: s,
+		client:   client,
+		key:      key,
+	}
+
+	return e, nil
+}
+
+// Run implements the [Elector] interface.
+func (e *RedisElector) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	for {
+		acquired, err := e.client.SetNX(ctx, e.key, e.identity, e.leaseDuration).Result()
+		switch {
+		case err != nil && ctx.Err() == nil:
+			e.logger.Error("failed to acquire lease", "key", e.key, "reason", err)
+		case acquired:
+			if err := e.lead(ctx, fn); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(e.retryPeriod):
+		}
+	}
+}
+
+// Close implements the [Elector] interface.
+func (e *RedisElector) Close() error {
+	return e.client.Close()
+}
+
+// lead calls the given function, while periodically renewing the lease. The
+// context passed to the function is cancelled, when the lease can no longer be
+// renewed.
+func (e *RedisElector) lead(ctx context.Context, fn func(ctx context.Context) error) error {
+	e.logger.Info("acquired leadership", "key", e.key, "identity", e.identity)
+
+	leadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- fn(leadCtx)
+	}()
+
+	ticker := time.NewTicker(e.retryPeriod)
+	defer ticker.Stop()
+	lastRenew := time.Now()
+
+	for {
+		select {
+		case err := <-errCh:
+			e.release()
+
+			return err
+		case <-ticker.C:
+			renewed, err := renewScript.Run(ctx, e.client, []string{e.key}, e.identity, e.leaseDuration.Milliseconds()).Int()
+			switch {
+			case err == nil && renewed == 1:
+				lastRenew = time.Now()
+
+				continue
+			case err == nil:
+				e.logger.Warn("lease is held by another candidate", "key", e.key)
+			case time.Since(lastRenew) < e.renewDeadline:
+				e.logger.Warn("failed to renew lease", "key", e.key, "reason", err)
+
+				continue
+			default:
+				e.logger.Error("renew deadline exceeded", "key", e.key, "reason", err)
+			}
+
+			e.logger.Info("lost leadership", "key", e.key, "identity", e.identity)
+			cancel()
+
+			return <-errCh
+		}
+	}
+}
+
+// release releases the lease, so that other candidates may take over without
+// waiting for the lease to expire.
+func (e *RedisElector) release() {
+	ctx, cancel := context.WithTimeout(context.Background(), e.retryPeriod)
+	defer cancel()
+
+	if err := releaseScript.Run(ctx, e.client, []string{e.key}, e.identity).Err(); err != nil {
+		e.logger.Warn("failed to release lease", "key", e.key, "reason", err)
+	}
+}
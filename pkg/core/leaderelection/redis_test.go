@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package leaderelection_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/gardener/inventory/pkg/core/leaderelection"
+)
+
+const (
+	testKey           = "inventory:test:leader"
+	testLeaseDuration = 300 * time.Millisecond
+	testRenewDeadline = 200 * time.Millisecond
+	testRetryPeriod   = 20 * time.Millisecond
+)
+
+var errDone = errors.New("done")
+
+// fakeRedisClient is a [redis.UniversalClient], which keeps the lease in
+// memory. Only the commands used by the [leaderelection.RedisElector] are
+// implemented, any other command panics.
+type fakeRedisClient struct {
+	redis.UniversalClient
+
+	mu     sync.Mutex
+	values map[string]string
+	renews int
+	closed bool
+}
+
+func newFakeRedisClient() *fakeRedisClient {
+	return &fakeRedisClient{values: make(map[string]string)}
+}
+
+func (c *fakeRedisClient) SetNX(ctx context.Context, key string, value any, _ time.Duration) *redis.BoolCmd {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cmd := redis.NewBoolCmd(ctx)
+	if _, ok := c.values[key]; ok {
+		cmd.SetVal(false)
+
+		return cmd
+	}
+	c.values[key] = value.(string)
+	cmd.SetVal(true)
+
+	return cmd
+}
+
+// EvalSha runs the renew script, which is called with the identity and the
+// lease duration, or the release script, which is called with the identity
+// only.
+func (c *fakeRedisClient) EvalSha(ctx context.Context, _ string, keys []string, args ...any) *redis.Cmd {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cmd := redis.NewCmd(ctx)
+	if c.values[keys[0]] != args[0] {
+		cmd.SetVal(int64(0))
+
+		return cmd
+	}
+
+	if len(args) == 2 {
+		c.renews++
+	} else {
+		delete(c.values, keys[0])
+	}
+	cmd.SetVal(int64(1))
+
+	return cmd
+}
+
+func (c *fakeRedisClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+
+	return nil
+}
+
+func (c *fakeRedisClient) get(key string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.values[key]
+
+	return value, ok
+}
+
+func (c *fakeRedisClient) set(key, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[key] = value
+}
+
+func (c *fakeRedisClient) renewCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.renews
+}
+
+func newTestElector(t *testing.T, client redis.UniversalClient) *leaderelection.RedisElector {
+	t.Helper()
+
+	elector, err := leaderelection.NewRedisElector(
+		client,
+		testKey,
+		leaderelection.WithIdentity("candidate-1"),
+		leaderelection.WithLeaseDuration(testLeaseDuration),
+		leaderelection.WithRenewDeadline(testRenewDeadline),
+		leaderelection.WithRetryPeriod(testRetryPeriod),
+	)
+	if err != nil {
+		t.Fatalf("cannot create elector: %s", err)
+	}
+
+	return elector
+}
+
+func TestRedisElectorAcquire(t *testing.T) {
+	client := newFakeRedisClient()
+	elector := newTestElector(t, client)
+
+	err := elector.Run(context.Background(), func(_ context.Context) error {
+		if value, _ := client.get(testKey); value != "candidate-1" {
+			t.Errorf("got lease holder %q, wanted %q", value, "candidate-1")
+		}
+
+		return errDone
+	})
+
+	if !errors.Is(err, errDone) {
+		t.Fatalf("got error %v, wanted %v", err, errDone)
+	}
+
+	if _, ok := client.get(testKey); ok {
+		t.Fatal("got lease, wanted it to be released")
+	}
+}
+
+func TestRedisElectorRenew(t *testing.T) {
+	client := newFakeRedisClient()
+	elector := newTestElector(t, client)
+
+	err := elector.Run(context.Background(), func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			t.Error("lost leadership while renewing the lease")
+		case <-time.After(testLeaseDuration):
+		}
+
+		return errDone
+	})
+
+	if !errors.Is(err, errDone) {
+		t.Fatalf("got error %v, wanted %v", err, errDone)
+	}
+
+	if client.renewCount() < 2 {
+		t.Fatalf("got %d renewals, wanted at least 2", client.renewCount())
+	}
+}
+
+func TestRedisElectorLose(t *testing.T) {
+	client := newFakeRedisClient()
+	elector := newTestElector(t, client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	terms := 0
+	err := elector.Run(ctx, func(leaderCtx context.Context) error {
+		terms++
+
+		// Another candidate takes over the lease
+		client.set(testKey, "candidate-2")
+
+		select {
+		case <-leaderCtx.Done():
+		case <-ctx.Done():
+			t.Error("leadership was not lost")
+		}
+
+		// Stop campaigning, once leadership has been lost
+		cancel()
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("got error %v, wanted nil", err)
+	}
+
+	if terms != 1 {
+		t.Fatalf("got %d terms, wanted 1", terms)
+	}
+
+	if value, _ := client.get(testKey); value != "candidate-2" {
+		t.Fatalf("got lease holder %q, wanted %q", value, "candidate-2")
+	}
+}
+
+func TestRedisElectorHeldByOther(t *testing.T) {
+	client := newFakeRedisClient()
+	client.set(testKey, "candidate-2")
+	elector := newTestElector(t, client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*testRetryPeriod)
+	defer cancel()
+
+	err := elector.Run(ctx, func(_ context.Context) error {
+		t.Error("acquired leadership held by another candidate")
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("got error %v, wanted nil", err)
+	}
+}
+
+func TestRedisElectorClose(t *testing.T) {
+	client := newFakeRedisClient()
+	elector := newTestElector(t, client)
+
+	if err := elector.Close(); err != nil {
+		t.Fatalf("got error %v, wanted nil", err)
+	}
+
+	if !client.closed {
+		t.Fatal("got open client, wanted it to be closed")
+	}
+}
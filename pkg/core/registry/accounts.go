@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+)
+
+// AccountSource describes the model, from which the accounts of a provider
+// are collected.
+type AccountSource struct {
+	// ModelName specifies the name of the model providing the accounts.
+	ModelName string
+
+	// IDColumn specifies the column, which provides the account ID.
+	IDColumn string
+
+	// NameColumn specifies the column, which provides the display name of
+	// the account.
+	NameColumn string
+}
+
+// AccountLink describes a model, which references a provider account.
+type AccountLink struct {
+	// Provider specifies the provider of the referenced account.
+	Provider string
+
+	// Column specifies the column of the model, which references the
+	// account ID.
+	Column string
+}
+
+// AccountSourceRegistry is the default registry for account sources, keyed by
+// provider name.
+var AccountSourceRegistry = New[string, AccountSource]()
+
+// AccountLinkRegistry is the default registry for account links, keyed by
+// model name.
+var AccountLinkRegistry = New[string, AccountLink]()
+
+// AccountCollector describes how the resources of a single provider account
+// are collected.
+type AccountCollector struct {
+	// NewTasks returns the tasks, which collect the resources of the
+	// account with the given ID.
+	NewTasks func(ctx context.Context, accountID string) ([]*asynq.Task, error)
+
+	// LinkTasks specifies the names of the tasks, which link the collected
+	// resources with each other.
+	LinkTasks []string
+}
+
+// AccountCollectorRegistry is the default registry for account collectors,
+// keyed by provider name.
+var AccountCollectorRegistry = New[string, AccountCollector]()
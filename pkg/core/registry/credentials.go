@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+	"time"
+)
+
+// ExpiringCredential describes a credential with a limited lifetime, e.g. a
+// kubeconfig, token or service account key.
+type ExpiringCredential struct {
+	// Kind specifies the kind of the credential.
+	Kind string
+
+	// Name specifies the name of the credential.
+	Name string
+
+	// Source specifies where the credential originates from, e.g. a seed
+	// cluster, a Vault server or a GCP service account.
+	Source string
+
+	// ExpiresAt specifies when the credential expires.
+	ExpiresAt time.Time
+}
+
+// ExpiringCredentialSource is a function, which returns the expiring
+// credentials of a specific kind.
+type ExpiringCredentialSource func(ctx context.Context) ([]ExpiringCredential, error)
+
+// ExpiringCredentialRegistry is the default registry for expiring credential
+// sources, keyed by credential kind.
+var ExpiringCredentialRegistry = New[string, ExpiringCredentialSource]()
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+// Attributes of the worker configuration, which are checked for drift.
+const (
+	DriftAttributeMachineType = "machine_type"
+	DriftAttributeImage       = "image"
+	DriftAttributeVolumeSize  = "volume_size"
+)
+
+// DriftCheck describes how an attribute of the desired worker configuration,
+// as specified by the MachineClass of Gardener Machines, is compared against
+// the actual cloud resources.
+type DriftCheck struct {
+	// ModelName specifies the name of the model of the actual cloud
+	// resources.
+	ModelName string
+
+	// Attribute specifies the checked attribute, e.g. machine_type.
+	Attribute string
+
+	// Query specifies the SELECT statement, which returns the drifted
+	// records of the model. The statement must return the `shoot_id',
+	// `resource_id', `desired' and `actual' columns.
+	Query string
+}
+
+// DriftCheckRegistry is the default registry for drift checks, keyed by check
+// name.
+var DriftCheckRegistry = New[string, DriftCheck]()
@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+// IngestModel describes a model, whose records may be pushed by external
+// systems via the ingestion API.
+type IngestModel struct {
+	// ConflictColumns specifies the columns, which identify a record.
+	// Pushed records matching an existing record by these columns replace
+	// the existing record.
+	ConflictColumns []string
+}
+
+// IngestModelRegistry is the default registry for models accepting records
+// pushed by external systems, keyed by model name.
+var IngestModelRegistry = New[string, IngestModel]()
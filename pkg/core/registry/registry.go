@@ -78,6 +78,19 @@ func (r *Registry[K, V]) Overwrite(key K, val V) {
 	r.items[key] = val
 }
 
+// Swap replaces the key specified by K with the value V in the registry, and
+// returns the previous value along with a boolean indicating whether the key
+// was present in the registry.
+func (r *Registry[K, V]) Swap(key K, val V) (V, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	prev, exists := r.items[key]
+	r.items[key] = val
+
+	return prev, exists
+}
+
 // Get returns the value associated with the given key and a boolean indicating
 // whether the key is present in the registry.
 func (r *Registry[K, V]) Get(key K) (V, bool) {
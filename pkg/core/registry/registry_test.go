@@ -204,3 +204,28 @@ func TestRegistryOverwrite(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistrySwap(t *testing.T) {
+	r := registry.New[string, string]()
+
+	prev, ok := r.Swap("foo", "bar")
+	if ok {
+		t.Fatalf("got previous value %q, want none", prev)
+	}
+
+	prev, ok = r.Swap("foo", "qux")
+	if !ok {
+		t.Fatal("previous value not found")
+	}
+	if prev != "bar" {
+		t.Fatalf("got previous value %q, want %q", prev, "bar")
+	}
+
+	got, ok := r.Get("foo")
+	if !ok {
+		t.Fatal("value for key \"foo\" not found")
+	}
+	if got != "qux" {
+		t.Fatalf("got value %q for \"foo\", want %q", got, "qux")
+	}
+}
@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+// SensitiveModel describes a model, whose records hold credentials or audit
+// data, and are therefore excluded from generic lookups, e.g. the resource
+// search and the IP lookup.
+type SensitiveModel struct {
+	// Reason specifies why the records of the model are sensitive.
+	Reason string
+}
+
+// SensitiveModelRegistry is the default registry for sensitive models, keyed
+// by model name.
+var SensitiveModelRegistry = New[string, SensitiveModel]()
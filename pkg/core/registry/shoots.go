@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import "github.com/hibiken/asynq"
+
+// Kinds of cloud resources, which are resolved for Gardener Shoots.
+const (
+	ShootResourceKindInstance     = "instance"
+	ShootResourceKindLoadBalancer = "load_balancer"
+	ShootResourceKindDisk         = "disk"
+	ShootResourceKindNetwork      = "network"
+)
+
+// Methods used for resolving cloud resources for Gardener Shoots.
+const (
+	// ShootResourceMethodProviderID resolves resources via the provider
+	// ID of Gardener Machines.
+	ShootResourceMethodProviderID = "machine_provider_id"
+
+	// ShootResourceMethodTechnicalID resolves resources via naming
+	// patterns, which contain the technical ID of the Shoot.
+	ShootResourceMethodTechnicalID = "technical_id"
+
+	// ShootResourceMethodDiskRef resolves resources via the disk
+	// reference of Persistent Volumes.
+	ShootResourceMethodDiskRef = "pv_disk_ref"
+
+	// ShootResourceMethodInstanceName resolves resources via the names
+	// of their member instances.
+	ShootResourceMethodInstanceName = "instance_name"
+)
+
+// Confidence levels of a resolved cloud resource.
+const (
+	// ShootResourceConfidenceHigh is used when the resource is resolved
+	// via an exact reference, e.g. a provider ID.
+	ShootResourceConfidenceHigh = "high"
+
+	// ShootResourceConfidenceMedium is used when the resource is resolved
+	// via a well-known naming convention.
+	ShootResourceConfidenceMedium = "medium"
+
+	// ShootResourceConfidenceLow is used when the resource is resolved
+	// via heuristics.
+	ShootResourceConfidenceLow = "low"
+)
+
+// ShootResource describes how the records of a model are resolved to the
+// Gardener Shoots, which own them.
+type ShootResource struct {
+	// ModelName specifies the name of the resolved model.
+	ModelName string
+
+	// Kind specifies the kind of the resolved resource, e.g. instance.
+	Kind string
+
+	// Method specifies the method used for resolving the resource.
+	Method string
+
+	// Confidence specifies the confidence level of the resolved mapping.
+	Confidence string
+
+	// Query specifies the SELECT statement, which resolves the records of
+	// the model. The statement must return the `shoot_id' and
+	// `resource_id' columns.
+	Query string
+}
+
+// ShootResourceRegistry is the default registry for shoot resource
+// resolvers, keyed by resolver name.
+var ShootResourceRegistry = New[string, ShootResource]()
+
+// ShootScope describes a single Gardener Shoot, for which the resources are
+// collected.
+type ShootScope struct {
+	// Name specifies the name of the Shoot.
+	Name string
+
+	// Namespace specifies the project namespace of the Shoot.
+	Namespace string
+
+	// ProjectName specifies the name of the project of the Shoot.
+	ProjectName string
+
+	// TechnicalID specifies the technical ID of the Shoot.
+	TechnicalID string
+
+	// SeedName specifies the name of the Seed hosting the control plane
+	// of the Shoot.
+	SeedName string
+
+	// Landscape specifies the name of the Gardener landscape of the Shoot.
+	Landscape string
+
+	// ProviderType specifies the type of the Cloud Profile of the Shoot,
+	// e.g. aws.
+	ProviderType string
+
+	// Region specifies the region of the Shoot.
+	Region string
+
+	// Accounts specifies the IDs of the provider accounts, in which cloud
+	// resources of the Shoot have been resolved. It is empty, if no cloud
+	// resources have been resolved for the Shoot yet.
+	Accounts []string
+}
+
+// ShootCollector describes how the resources of a single Gardener Shoot are
+// collected from a provider.
+type ShootCollector struct {
+	// ProviderType specifies the type of Cloud Profiles, for which the
+	// collector is used. If it is empty, then the collector is used for
+	// all Shoots.
+	ProviderType string
+
+	// NewTasks returns the tasks, which collect the resources of the
+	// Shoot described by the given scope.
+	NewTasks func(scope ShootScope) ([]*asynq.Task, error)
+
+	// LinkTasks specifies the names of the tasks, which link the collected
+	// resources with each other.
+	LinkTasks []string
+}
+
+// ShootCollectorRegistry is the default registry for shoot collectors, keyed
+// by provider name.
+var ShootCollectorRegistry = New[string, ShootCollector]()
@@ -4,10 +4,26 @@
 
 package registry
 
-import "github.com/hibiken/asynq"
+import (
+	"github.com/hibiken/asynq"
+
+	"github.com/gardener/inventory/pkg/utils/schema"
+)
 
 // TaskRegistry is the default registry for tasks.
 var TaskRegistry = New[string, asynq.Handler]()
 
 // ScheduledTaskRegistry is the default registry for scheduled tasks.
 var ScheduledTaskRegistry = New[string, *asynq.Task]()
+
+// GroupAggregatorRegistry is the default registry for task group aggregators.
+// The aggregators are registered by the type of the grouped tasks.
+var GroupAggregatorRegistry = New[string, asynq.GroupAggregator]()
+
+// PayloadSchemaRegistry is the default registry for task payload schemas. The
+// schemas are registered by the name of the task.
+var PayloadSchemaRegistry = New[string, *schema.Schema]()
+
+// TaskDescriptionRegistry is the default registry for task descriptions. The
+// descriptions are registered by the name of the task.
+var TaskDescriptionRegistry = New[string, string]()
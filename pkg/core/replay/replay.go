@@ -0,0 +1,296 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package replay provides a provider-agnostic [http.RoundTripper], which
+// records the responses of provider APIs to files, and replays the recorded
+// responses instead of calling the live APIs.
+package replay
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"unicode/utf8"
+)
+
+// Supported modes
+const (
+	// ModeRecord records the responses of the live APIs.
+	ModeRecord = "record"
+
+	// ModeReplay replays the recorded responses.
+	ModeReplay = "replay"
+)
+
+// ErrUnknownMode is an error, which is returned when an unknown mode was
+// specified.
+var ErrUnknownMode = errors.New("unknown replay mode")
+
+// ErrNoDir is an error, which is returned when no directory for the recordings
+// was specified.
+var ErrNoDir = errors.New("no replay directory specified")
+
+// ErrNoRecording is an error, which is returned when no recording exists for a
+// request in replay mode.
+var ErrNoRecording = errors.New("no recording found")
+
+// bodyEncodingBase64 is the encoding of recorded bodies, which are not valid
+// UTF-8.
+const bodyEncodingBase64 = "base64"
+
+// excludedHeaders specifies the response headers, which are not recorded.
+var excludedHeaders = []string{
+	"Set-Cookie",
+	"Date",
+}
+
+// Recording represents a recorded request and response.
+type Recording struct {
+	// Method specifies the HTTP method of the request.
+	Method string `json:"method"`
+
+	// URL specifies the URL of the request.
+	URL string `json:"url"`
+
+	// StatusCode specifies the HTTP status code of the response.
+	StatusCode int `json:"status_code"`
+
+	// Header specifies the headers of the response.
+	Header http.Header `json:"header,omitempty"`
+
+	// Body specifies the body of the response.
+	Body string `json:"body"`
+
+	// BodyEncoding specifies the encoding of the body. It is empty for
+	// bodies, which are stored as-is.
+	BodyEncoding string `json:"body_encoding,omitempty"`
+}
+
+// Transport is an [http.RoundTripper], which records or replays the responses
+// for requests.
+//
+// Recordings are stored as JSON files in a sub-directory named after the host
+// of the request. The name of a recording is derived from the method, URL and
+// body of the request. In replay mode the recording of a request with the same
+// method and URL, but a different body is used, if there is no exact match,
+// since request bodies may contain non-deterministic values such as
+// timestamps or nonces, e.g. when authenticating.
+type Transport struct {
+	mode string
+	dir  string
+	next http.RoundTripper
+}
+
+var _ http.RoundTripper = &Transport{}
+
+// NewTransport creates a new [Transport] for the given mode, which stores the
+// recordings in the given directory. In record mode the requests are sent
+// using the next [http.RoundTripper], or [http.DefaultTransport], if nil.
+func NewTransport(mode string, dir string, next http.RoundTripper) (*Transport, error) {
+	if mode != ModeRecord && mode != ModeReplay {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownMode, mode)
+	}
+
+	if dir == "" {
+		return nil, ErrNoDir
+	}
+
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	t := &Transport{
+		mode: mode,
+		dir:  dir,
+		next: next,
+	}
+
+	return t, nil
+}
+
+// NewClient creates a new [http.Client], which uses a [Transport] for the
+// given mode and directory.
+func NewClient(mode string, dir string) (*http.Client, error) {
+	t, err := NewTransport(mode, dir, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Client{Transport: t}, nil
+}
+
+// RoundTrip implements the [http.RoundTripper] interface.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		if err := req.Body.Close(); err != nil {
+			return nil, err
+		}
+		body = data
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	prefix, suffix := recordingKey(req, body)
+	dir := filepath.Join(t.dir, filepath.Clean("/"+req.URL.Host))
+	path := filepath.Join(dir, prefix+"-"+suffix+".json")
+
+	switch t.mode {
+	case ModeRecord:
+		return t.record(req, path)
+	default:
+		return t.replay(req, dir, prefix, path)
+	}
+}
+
+// record sends the request and records the response to the given path.
+func (t *Transport) record(req *http.Request, path string) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.Body.Close(); err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	rec := Recording{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       string(body),
+	}
+
+	for _, name := range excludedHeaders {
+		rec.Header.Del(name)
+	}
+
+	if !utf8.Valid(body) {
+		rec.Body = base64.StdEncoding.EncodeToString(body)
+		rec.BodyEncoding = bodyEncodingBase64
+	}
+
+	if err := writeRecording(path, rec); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// replay returns the recorded response for the request.
+func (t *Transport) replay(req *http.Request, dir string, prefix string, path string) (*http.Response, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		// Fall back to a recording of the same method and URL
+		matches, _ := filepath.Glob(filepath.Join(dir, prefix+"-*.json"))
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("%w: %s %s", ErrNoRecording, req.Method, req.URL)
+		}
+		slices.Sort(matches)
+		data, err = os.ReadFile(matches[0])
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var rec Recording
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, fmt.Errorf("invalid recording for %s %s: %w", req.Method, req.URL, err)
+	}
+
+	body := []byte(rec.Body)
+	if rec.BodyEncoding == bodyEncodingBase64 {
+		body, err = base64.StdEncoding.DecodeString(rec.Body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recording for %s %s: %w", req.Method, req.URL, err)
+		}
+	}
+
+	header := rec.Header
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	resp := &http.Response{
+		Status:        fmt.Sprintf("%d %s", rec.StatusCode, http.StatusText(rec.StatusCode)),
+		StatusCode:    rec.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+
+	return resp, nil
+}
+
+// recordingKey returns the key of the recording for the given request. The key
+// consists of a prefix derived from the method and URL, and a suffix derived
+// from the body of the request.
+func recordingKey(req *http.Request, body []byte) (string, string) {
+	// Query parameters are encoded in sorted order
+	u := *req.URL
+	u.RawQuery = u.Query().Encode()
+	u.Fragment = ""
+
+	prefix := sha256.Sum256([]byte(req.Method + " " + u.String()))
+	suffix := sha256.Sum256(body)
+
+	return hex.EncodeToString(prefix[:12]), hex.EncodeToString(suffix[:4])
+}
+
+// writeRecording writes the recording to the given path.
+func writeRecording(path string, rec Recording) error {
+	data, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so that concurrent requests never
+	// observe partially written recordings.
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".recording-*")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
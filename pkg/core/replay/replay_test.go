@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package replay_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/core/replay"
+)
+
+func TestNewTransport(t *testing.T) {
+	if _, err := replay.NewTransport("foo", t.TempDir(), nil); !errors.Is(err, replay.ErrUnknownMode) {
+		t.Fatalf("want ErrUnknownMode got %v", err)
+	}
+
+	if _, err := replay.NewTransport(replay.ModeReplay, "", nil); !errors.Is(err, replay.ErrNoDir) {
+		t.Fatalf("want ErrNoDir got %v", err)
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("X-Foo", "bar")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, "%s %s %s", r.Method, r.URL.Query().Get("page"), body)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	recorder, err := replay.NewClient(replay.ModeRecord, dir)
+	if err != nil {
+		t.Fatalf("cannot create recorder: %s", err)
+	}
+
+	resp, err := recorder.Post(server.URL+"/items?page=1&size=10", "text/plain", strings.NewReader("nonce-1"))
+	if err != nil {
+		t.Fatalf("cannot record request: %s", err)
+	}
+	recorded, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	replayer, err := replay.NewClient(replay.ModeReplay, dir)
+	if err != nil {
+		t.Fatalf("cannot create replayer: %s", err)
+	}
+
+	testCases := []struct {
+		desc    string
+		url     string
+		body    string
+		wantErr bool
+	}{
+		{
+			desc: "same request",
+			url:  server.URL + "/items?page=1&size=10",
+			body: "nonce-1",
+		},
+		{
+			desc: "reordered query",
+			url:  server.URL + "/items?size=10&page=1",
+			body: "nonce-1",
+		},
+		{
+			desc: "different body",
+			url:  server.URL + "/items?page=1&size=10",
+			body: "nonce-2",
+		},
+		{
+			desc:    "unknown request",
+			url:     server.URL + "/items?page=2&size=10",
+			body:    "nonce-1",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := replayer.Post(tc.url, "text/plain", strings.NewReader(tc.body))
+			if tc.wantErr {
+				if !errors.Is(err, replay.ErrNoRecording) {
+					t.Fatalf("want ErrNoRecording got %v", err)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("cannot replay request: %s", err)
+			}
+			defer resp.Body.Close() // nolint: errcheck
+
+			got, _ := io.ReadAll(resp.Body)
+			if string(got) != string(recorded) {
+				t.Fatalf("want body %q got %q", recorded, got)
+			}
+			if resp.StatusCode != http.StatusCreated {
+				t.Fatalf("want status %d got %d", http.StatusCreated, resp.StatusCode)
+			}
+			if resp.Header.Get("X-Foo") != "bar" {
+				t.Fatalf("want header X-Foo got %v", resp.Header)
+			}
+		})
+	}
+
+	if calls != 1 {
+		t.Fatalf("want 1 call to the server got %d", calls)
+	}
+}
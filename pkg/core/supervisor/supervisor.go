@@ -0,0 +1,375 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package supervisor provides a supervisor, which manages the startup,
+// shutdown, restarts and health of the long-running components of a service,
+// e.g. the task server and metrics server of a worker.
+package supervisor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"slices"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultShutdownTimeout is the default amount of time to wait for components
+// to shut down.
+const DefaultShutdownTimeout = 30 * time.Second
+
+// DefaultRestartDelay is the default amount of time to wait before restarting
+// a component.
+const DefaultRestartDelay = 5 * time.Second
+
+// RestartPolicy specifies when a stopped component is restarted.
+type RestartPolicy string
+
+const (
+	// RestartNever specifies that a component is never restarted. A
+	// component which stops with an error causes all other components to
+	// be shut down.
+	RestartNever RestartPolicy = "never"
+
+	// RestartOnFailure specifies that a component is restarted only when
+	// it stops with an error.
+	RestartOnFailure RestartPolicy = "on-failure"
+
+	// RestartAlways specifies that a component is always restarted when it
+	// stops, until the supervisor is shut down.
+	RestartAlways RestartPolicy = "always"
+)
+
+// Status represents the status of a component.
+type Status string
+
+const (
+	// StatusPending specifies that the component has not been started yet.
+	StatusPending Status = "pending"
+
+	// StatusRunning specifies that the component is running.
+	StatusRunning Status = "running"
+
+	// StatusRestarting specifies that the component stopped and is about
+	// to be restarted.
+	StatusRestarting Status = "restarting"
+
+	// StatusStopped specifies that the component stopped.
+	StatusStopped Status = "stopped"
+
+	// StatusFailed specifies that the component stopped with an error.
+	StatusFailed Status = "failed"
+)
+
+// ErrNoComponents is an error, which is returned when the supervisor is
+// started without any components.
+var ErrNoComponents = errors.New("no components to supervise")
+
+// ErrMaxRestartsExceeded is an error, which is returned when a component
+// exceeds its maximum number of restarts.
+var ErrMaxRestartsExceeded = errors.New("max restarts exceeded")
+
+// Component is a long-running component managed by the [Supervisor].
+type Component struct {
+	// Name specifies the name of the component.
+	Name string
+
+	// Run runs the component and blocks until the component stops, or the
+	// given context is cancelled.
+	Run func(ctx context.Context) error
+
+	// Shutdown is an optional function, which gracefully shuts down the
+	// component, causing Run to return.
+	Shutdown func(ctx context.Context) error
+
+	// RestartPolicy specifies the restart policy of the component. If it
+	// is not specified, then [RestartNever] is used.
+	RestartPolicy RestartPolicy
+
+	// MaxRestarts specifies the maximum number of restarts. A value of
+	// zero means no limit.
+	MaxRestarts int
+
+	// RestartDelay specifies the amount of time to wait before restarting
+	// the component. If it is not specified, then [DefaultRestartDelay] is
+	// used.
+	RestartDelay time.Duration
+}
+
+// ComponentHealth represents the health of a component.
+type ComponentHealth struct {
+	// Status specifies the status of the component.
+	Status Status `json:"status"`
+
+	// Restarts specifies the number of times the component was restarted.
+	Restarts int `json:"restarts"`
+
+	// LastError specifies the last error returned by the component.
+	LastError string `json:"last_error,omitempty"`
+}
+
+// HTTPServerComponent returns a [Component], which runs the given
+// [http.Server] and gracefully shuts it down when the [Supervisor] stops. The
+// component is restarted, if the server fails to start up, e.g. because the
+// address is temporarily in use.
+func HTTPServerComponent(name string, srv *http.Server) Component {
+	run := func(_ context.Context) error {
+		err := srv.ListenAndServe()
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+
+		return err
+	}
+
+	c := Component{
+		Name:          name,
+		Run:           run,
+		Shutdown:      srv.Shutdown,
+		RestartPolicy: RestartOnFailure,
+		MaxRestarts:   3,
+	}
+
+	return c
+}
+
+// Option is a function, which configures the [Supervisor].
+type Option func(s *Supervisor)
+
+// WithShutdownTimeout is an [Option], which configures the amount of time to
+// wait for components to shut down.
+func WithShutdownTimeout(timeout time.Duration) Option {
+	opt := func(s *Supervisor) {
+		s.shutdownTimeout = timeout
+	}
+
+	return opt
+}
+
+// WithLogger is an [Option], which configures the [Supervisor] to use the given
+// [slog.Logger].
+func WithLogger(logger *slog.Logger) Option {
+	opt := func(s *Supervisor) {
+		s.logger = logger
+	}
+
+	return opt
+}
+
+// Supervisor manages a set of components. Components are started concurrently,
+// without waiting for any other component to become ready, and are shut down
+// in reverse order of their addition. When a component stops and is not
+// restarted, all other components are shut down as well.
+type Supervisor struct {
+	mu              sync.Mutex
+	components      []Component
+	health          map[string]*ComponentHealth
+	shutdownTimeout time.Duration
+	logger          *slog.Logger
+}
+
+// New creates a new [Supervisor] with the given options.
+func New(opts ...Option) *Supervisor {
+	s := &Supervisor{
+		components:      make([]Component, 0),
+		health:          make(map[string]*ComponentHealth),
+		shutdownTimeout: DefaultShutdownTimeout,
+		logger:          slog.Default(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Add adds the given components to the [Supervisor].
+func (s *Supervisor) Add(components ...Component) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, c := range components {
+		s.components = append(s.components, c)
+		s.health[c.Name] = &ComponentHealth{Status: StatusPending}
+	}
+}
+
+// Health returns the health of all components.
+func (s *Supervisor) Health() map[string]ComponentHealth {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make(map[string]ComponentHealth, len(s.health))
+	for name, h := range s.health {
+		result[name] = *h
+	}
+
+	return result
+}
+
+// IsHealthy returns true, if all components are running.
+func (s *Supervisor) IsHealthy() bool {
+	for _, h := range s.Health() {
+		if h.Status != StatusRunning {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HealthHandler returns an [http.Handler], which reports the aggregated health
+// of all components as JSON. It responds with [http.StatusServiceUnavailable]
+// if any of the components is not running.
+func (s *Supervisor) HealthHandler() http.Handler {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		code := http.StatusOK
+		if !s.IsHealthy() {
+			code = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(s.Health())
+	}
+
+	return http.HandlerFunc(handler)
+}
+
+// setHealth updates the health of the given component.
+func (s *Supervisor) setHealth(name string, status Status, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h := s.health[name]
+	h.Status = status
+	if status == StatusRestarting {
+		h.Restarts++
+	}
+	if err != nil {
+		h.LastError = err.Error()
+	}
+}
+
+// Run starts all components concurrently and blocks until the given context is
+// cancelled, an OS signal is received, or a component stops without being
+// restarted. It then shuts down all components in reverse order.
+func (s *Supervisor) Run(ctx context.Context) error {
+	s.mu.Lock()
+	components := slices.Clone(s.components)
+	s.mu.Unlock()
+
+	if len(components) == 0 {
+		return ErrNoComponents
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	for _, c := range components {
+		s.logger.Info("starting component", "name", c.Name)
+		group.Go(func() error {
+			return s.supervise(groupCtx, c)
+		})
+	}
+
+	// Shut down the components in reverse order once we are done
+	group.Go(func() error {
+		<-groupCtx.Done()
+		s.shutdown(components)
+
+		return nil
+	})
+
+	return group.Wait()
+}
+
+// supervise runs the component and restarts it according to its restart
+// policy.
+func (s *Supervisor) supervise(ctx context.Context, c Component) error {
+	restarts := 0
+	delay := c.RestartDelay
+	if delay <= 0 {
+		delay = DefaultRestartDelay
+	}
+
+	for {
+		s.setHealth(c.Name, StatusRunning, nil)
+		err := c.Run(ctx)
+
+		// The supervisor is shutting down
+		if ctx.Err() != nil {
+			s.setHealth(c.Name, StatusStopped, err)
+
+			return nil
+		}
+
+		restart := false
+		switch c.RestartPolicy {
+		case RestartAlways:
+			restart = true
+		case RestartOnFailure:
+			restart = err != nil
+		}
+
+		if !restart {
+			if err != nil {
+				s.setHealth(c.Name, StatusFailed, err)
+
+				return fmt.Errorf("%s: %w", c.Name, err)
+			}
+			s.setHealth(c.Name, StatusStopped, nil)
+
+			// A component which completed without errors, and is
+			// not restarted causes the supervisor to shut down.
+			return fmt.Errorf("%s: component stopped", c.Name)
+		}
+
+		if c.MaxRestarts > 0 && restarts >= c.MaxRestarts {
+			s.setHealth(c.Name, StatusFailed, err)
+
+			return fmt.Errorf("%w: %s (%d)", ErrMaxRestartsExceeded, c.Name, c.MaxRestarts)
+		}
+
+		restarts++
+		s.setHealth(c.Name, StatusRestarting, err)
+		s.logger.Warn("restarting component", "name", c.Name, "restarts", restarts, "reason", err)
+
+		select {
+		case <-ctx.Done():
+			s.setHealth(c.Name, StatusStopped, nil)
+
+			return nil
+		case <-time.After(delay):
+		}
+	}
+}
+
+// shutdown shuts down the components in reverse order.
+func (s *Supervisor) shutdown(components []Component) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+
+	for _, c := range slices.Backward(components) {
+		if c.Shutdown == nil {
+			continue
+		}
+
+		s.logger.Info("shutting down component", "name", c.Name)
+		if err := c.Shutdown(ctx); err != nil {
+			s.logger.Error("failed to shut down component", "name", c.Name, "reason", err)
+		}
+	}
+}
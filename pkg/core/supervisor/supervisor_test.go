@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisor_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gardener/inventory/pkg/core/supervisor"
+)
+
+func TestSupervisorNoComponents(t *testing.T) {
+	s := supervisor.New()
+	if err := s.Run(context.Background()); !errors.Is(err, supervisor.ErrNoComponents) {
+		t.Fatalf("want error %v, got %v", supervisor.ErrNoComponents, err)
+	}
+}
+
+func TestSupervisorShutdownOrder(t *testing.T) {
+	var mu sync.Mutex
+	order := make([]string, 0)
+
+	newComponent := func(name string) supervisor.Component {
+		c := supervisor.Component{
+			Name: name,
+			Run: func(ctx context.Context) error {
+				<-ctx.Done()
+
+				return nil
+			},
+			Shutdown: func(_ context.Context) error {
+				mu.Lock()
+				defer mu.Unlock()
+				order = append(order, name)
+
+				return nil
+			},
+		}
+
+		return c
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := supervisor.New()
+	s.Add(newComponent("first"), newComponent("second"), newComponent("third"))
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	if err := s.Run(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"third", "second", "first"}
+	if !slices.Equal(order, want) {
+		t.Fatalf("want shutdown order %v, got %v", want, order)
+	}
+
+	for name, h := range s.Health() {
+		if h.Status != supervisor.StatusStopped {
+			t.Fatalf("want component %s status %s, got %s", name, supervisor.StatusStopped, h.Status)
+		}
+	}
+}
+
+func TestSupervisorErrorPropagation(t *testing.T) {
+	errBoom := errors.New("boom")
+	s := supervisor.New()
+	s.Add(
+		supervisor.Component{
+			Name: "long-running",
+			Run: func(ctx context.Context) error {
+				<-ctx.Done()
+
+				return nil
+			},
+		},
+		supervisor.Component{
+			Name: "failing",
+			Run: func(_ context.Context) error {
+				return errBoom
+			},
+		},
+	)
+
+	if err := s.Run(context.Background()); !errors.Is(err, errBoom) {
+		t.Fatalf("want error %v, got %v", errBoom, err)
+	}
+
+	health := s.Health()
+	if health["failing"].Status != supervisor.StatusFailed {
+		t.Fatalf("want status %s, got %s", supervisor.StatusFailed, health["failing"].Status)
+	}
+}
+
+func TestSupervisorRestartPolicy(t *testing.T) {
+	errBoom := errors.New("boom")
+	runs := 0
+	s := supervisor.New()
+	s.Add(supervisor.Component{
+		Name: "flaky",
+		Run: func(_ context.Context) error {
+			runs++
+
+			return errBoom
+		},
+		RestartPolicy: supervisor.RestartOnFailure,
+		MaxRestarts:   2,
+		RestartDelay:  time.Millisecond,
+	})
+
+	if err := s.Run(context.Background()); !errors.Is(err, supervisor.ErrMaxRestartsExceeded) {
+		t.Fatalf("want error %v, got %v", supervisor.ErrMaxRestartsExceeded, err)
+	}
+
+	if runs != 3 {
+		t.Fatalf("want 3 runs, got %d", runs)
+	}
+
+	if restarts := s.Health()["flaky"].Restarts; restarts != 2 {
+		t.Fatalf("want 2 restarts, got %d", restarts)
+	}
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package timeout provides a provider-agnostic [http.RoundTripper], which
+// limits the duration of each request sent to the provider APIs, so that a
+// single hanging request does not consume the whole timeout of a task.
+package timeout
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Transport is an [http.RoundTripper], which cancels requests, including
+// reading their response bodies, after the configured timeout.
+type Transport struct {
+	timeout time.Duration
+	next    http.RoundTripper
+}
+
+var _ http.RoundTripper = &Transport{}
+
+// NewTransport returns a new [Transport], which sends the requests using the
+// next [http.RoundTripper], or [http.DefaultTransport], if nil. Requests are
+// not limited, if the given timeout is not positive. Watch requests of the
+// Kubernetes APIs are never limited.
+func NewTransport(timeout time.Duration, next http.RoundTripper) *Transport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &Transport{
+		timeout: timeout,
+		next:    next,
+	}
+}
+
+// RoundTrip implements the [http.RoundTripper] interface.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Watch requests of the Kubernetes APIs are long-lived by design
+	if t.timeout <= 0 || req.URL.Query().Get("watch") == "true" {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	// The context is canceled once the body has been read and closed
+	// by the caller.
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+// cancelBody is an [io.ReadCloser], which cancels the context of the request
+// when the response body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close implements the [io.Closer] interface.
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+
+	return b.ReadCloser.Close()
+}
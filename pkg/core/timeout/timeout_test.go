@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package timeout_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gardener/inventory/pkg/core/timeout"
+)
+
+func TestTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("hang") != "" {
+			<-r.Context().Done()
+
+			return
+		}
+		if r.URL.Query().Get("slow") != "" {
+			time.Sleep(100 * time.Millisecond)
+		}
+		_, _ = io.WriteString(w, "ok")
+	}))
+	defer server.Close()
+
+	testCases := []struct {
+		desc    string
+		timeout time.Duration
+		url     string
+		wantErr error
+	}{
+		{
+			desc:    "fast request",
+			timeout: time.Second,
+			url:     server.URL,
+			wantErr: nil,
+		},
+		{
+			desc:    "hanging request",
+			timeout: 50 * time.Millisecond,
+			url:     server.URL + "?hang=1",
+			wantErr: context.DeadlineExceeded,
+		},
+		{
+			desc:    "watch request",
+			timeout: 50 * time.Millisecond,
+			url:     server.URL + "?watch=true&slow=1",
+			wantErr: nil,
+		},
+		{
+			desc:    "no timeout",
+			timeout: 0,
+			url:     server.URL,
+			wantErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			client := &http.Client{Transport: timeout.NewTransport(tc.timeout, nil)}
+			resp, err := client.Get(tc.url)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got error %v, wanted %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer resp.Body.Close() // nolint: errcheck
+
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("cannot read body: %s", err)
+			}
+			if string(body) != "ok" {
+				t.Fatalf("got body %q, wanted %q", body, "ok")
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gardener/inventory/pkg/core/config"
+)
+
+// Supported event formats
+const (
+	// FormatJSON encodes the events as plain JSON objects.
+	FormatJSON = "json"
+
+	// FormatCloudEvents encodes the events as CloudEvents 1.0 in the
+	// structured content mode, i.e. as JSON envelopes carrying the event
+	// as data.
+	FormatCloudEvents = "cloudevents"
+)
+
+// DefaultFormat is the format used, when no format is configured.
+const DefaultFormat = FormatJSON
+
+const (
+	// DefaultCloudEventsSource is the source of the CloudEvents, when no
+	// source is configured.
+	DefaultCloudEventsSource = "/inventory"
+
+	// DefaultCloudEventsTypePrefix is the prefix of the types of the
+	// CloudEvents, when no prefix is configured.
+	DefaultCloudEventsTypePrefix = "io.gardener.inventory"
+)
+
+// Content types of the encoded events
+const (
+	contentTypeJSON        = "application/json"
+	contentTypeCloudEvents = "application/cloudevents+json"
+)
+
+// cloudEventsSpecVersion is the version of the CloudEvents specification.
+const cloudEventsSpecVersion = "1.0"
+
+// CloudEvent represents the JSON envelope of a CloudEvent in the structured
+// content mode.
+type CloudEvent struct {
+	// SpecVersion specifies the version of the CloudEvents specification.
+	SpecVersion string `json:"specversion"`
+
+	// ID specifies the unique ID of the event.
+	ID string `json:"id"`
+
+	// Source specifies the context, in which the event happened.
+	Source string `json:"source"`
+
+	// Type specifies the type of the event, e.g.
+	// io.gardener.inventory.aws.model.instance.update.
+	Type string `json:"type"`
+
+	// Subject specifies the ID of the changed record.
+	Subject string `json:"subject"`
+
+	// Time specifies when the change happened.
+	Time time.Time `json:"time"`
+
+	// DataContentType specifies the content type of the data.
+	DataContentType string `json:"datacontenttype"`
+
+	// Data specifies the change event.
+	Data Event `json:"data"`
+}
+
+// Encoder encodes the events in the configured format.
+type Encoder struct {
+	format     string
+	source     string
+	typePrefix string
+}
+
+// NewEncoder returns a new [Encoder] for the format specified in the given
+// [config.EventsConfig].
+func NewEncoder(conf config.EventsConfig) *Encoder {
+	e := &Encoder{
+		format:     conf.Format,
+		source:     conf.CloudEvents.Source,
+		typePrefix: conf.CloudEvents.TypePrefix,
+	}
+
+	if e.format == "" {
+		e.format = DefaultFormat
+	}
+	if e.source == "" {
+		e.source = DefaultCloudEventsSource
+	}
+	if e.typePrefix == "" {
+		e.typePrefix = DefaultCloudEventsTypePrefix
+	}
+
+	return e
+}
+
+// Encode encodes the given event, and returns the encoded event along with its
+// content type.
+func (e *Encoder) Encode(event Event) ([]byte, string, error) {
+	if e.format != FormatCloudEvents {
+		data, err := json.Marshal(event)
+
+		return data, contentTypeJSON, err
+	}
+
+	ce := CloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              event.ID.String(),
+		Source:          e.source,
+		Type:            Subject(e.typePrefix, event.Model) + "." + event.Operation,
+		Subject:         event.RecordID.String(),
+		Time:            event.Time,
+		DataContentType: contentTypeJSON,
+		Data:            event,
+	}
+	data, err := json.Marshal(ce)
+
+	return data, contentTypeCloudEvents, err
+}
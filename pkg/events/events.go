@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package events provides the publishing of change events of the inventory
+// records to message brokers, e.g. Kafka or NATS, allowing downstream systems
+// to react to infrastructure changes in near real time.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gardener/inventory/pkg/core/config"
+)
+
+// Kinds of changes
+const (
+	// OperationCreate is the operation of events for created records.
+	OperationCreate = "create"
+
+	// OperationUpdate is the operation of events for updated records.
+	OperationUpdate = "update"
+
+	// OperationDelete is the operation of events for deleted records.
+	OperationDelete = "delete"
+)
+
+// Supported message brokers
+const (
+	// BackendKafka publishes the events to Kafka topics.
+	BackendKafka = "kafka"
+
+	// BackendNATS publishes the events to NATS subjects.
+	BackendNATS = "nats"
+)
+
+// DefaultPrefix is the prefix of the topics and subjects, when no prefix is
+// configured.
+const DefaultPrefix = "inventory"
+
+// ErrUnsupportedBackend is an error, which is returned when an unsupported
+// message broker is configured.
+var ErrUnsupportedBackend = errors.New("unsupported events backend")
+
+// ErrInvalidConfig is an error, which is returned when the configuration of
+// the message broker is missing required settings.
+var ErrInvalidConfig = errors.New("invalid events config")
+
+// Event represents a change of a record.
+type Event struct {
+	// ID specifies the unique ID of the event.
+	ID uuid.UUID `json:"id"`
+
+	// Time specifies when the change happened.
+	Time time.Time `json:"time"`
+
+	// Model specifies the name of the model of the changed record.
+	Model string `json:"model"`
+
+	// Operation specifies the kind of change, i.e. create, update or
+	// delete.
+	Operation string `json:"operation"`
+
+	// RecordID specifies the ID of the changed record.
+	RecordID uuid.UUID `json:"record_id"`
+
+	// Diff specifies the old and new values of the changed columns of
+	// updated records, e.g. {"state": {"old": "running", "new": "stopped"}}.
+	Diff json.RawMessage `json:"diff,omitempty"`
+
+	// Data specifies the record after the change, or before the change
+	// for deleted records.
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// Publisher publishes change events to a message broker.
+type Publisher interface {
+	// Publish publishes the given events. The events are published in
+	// order, and Publish returns after the broker has acknowledged them.
+	Publish(ctx context.Context, events ...Event) error
+
+	// Close releases the resources of the publisher.
+	Close() error
+}
+
+// DefaultPublisher is the [Publisher] used by the workers for publishing the
+// change events. It is nil, unless publishing of change events is enabled.
+var DefaultPublisher Publisher
+
+// SetPublisher sets the [Publisher] to be used by the workers.
+func SetPublisher(p Publisher) {
+	DefaultPublisher = p
+}
+
+// Subject returns the Kafka topic or NATS subject, to which the events of the
+// given model are published, e.g. inventory.aws.model.instance for the
+// aws:model:instance model.
+func Subject(prefix, model string) string {
+	if prefix == "" {
+		prefix = DefaultPrefix
+	}
+
+	return strings.Trim(prefix, ".") + "." + strings.ReplaceAll(model, ":", ".")
+}
+
+// ValidateConfig validates the given [config.EventsConfig].
+func ValidateConfig(conf config.EventsConfig) error {
+	switch conf.Format {
+	case "", FormatJSON, FormatCloudEvents:
+	default:
+		return fmt.Errorf("%w: unsupported format %q", ErrInvalidConfig, conf.Format)
+	}
+
+	switch conf.Backend {
+	case BackendKafka:
+		if len(conf.Kafka.Brokers) == 0 {
+			return fmt.Errorf("%w: no kafka brokers specified", ErrInvalidConfig)
+		}
+	case BackendNATS:
+		if conf.NATS.URL == "" {
+			return fmt.Errorf("%w: no nats url specified", ErrInvalidConfig)
+		}
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedBackend, conf.Backend)
+	}
+
+	return nil
+}
+
+// NewPublisher returns a [Publisher] for the message broker specified in the
+// given [config.EventsConfig].
+func NewPublisher(conf config.EventsConfig) (Publisher, error) {
+	if err := ValidateConfig(conf); err != nil {
+		return nil, err
+	}
+
+	switch conf.Backend {
+	case BackendKafka:
+		return newKafkaPublisher(conf), nil
+	default:
+		return newNATSPublisher(conf)
+	}
+}
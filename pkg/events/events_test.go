@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package events_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/events"
+)
+
+func TestSubject(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		prefix string
+		model  string
+		wanted string
+	}{
+		{
+			desc:   "default prefix",
+			prefix: "",
+			model:  "aws:model:instance",
+			wanted: "inventory.aws.model.instance",
+		},
+		{
+			desc:   "custom prefix",
+			prefix: "infra.changes",
+			model:  "g:model:shoot",
+			wanted: "infra.changes.g.model.shoot",
+		},
+		{
+			desc:   "prefix with trailing dot",
+			prefix: "infra.",
+			model:  "az:model:vm",
+			wanted: "infra.az.model.vm",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := events.Subject(tc.prefix, tc.model)
+			if got != tc.wanted {
+				t.Fatalf("got subject %q, wanted %q", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		conf    config.EventsConfig
+		wantErr error
+	}{
+		{
+			desc: "kafka",
+			conf: config.EventsConfig{
+				Backend: events.BackendKafka,
+				Kafka:   config.KafkaConfig{Brokers: []string{"localhost:9092"}},
+			},
+		},
+		{
+			desc:    "kafka without brokers",
+			conf:    config.EventsConfig{Backend: events.BackendKafka},
+			wantErr: events.ErrInvalidConfig,
+		},
+		{
+			desc: "nats",
+			conf: config.EventsConfig{
+				Backend: events.BackendNATS,
+				NATS:    config.NATSConfig{URL: "nats://localhost:4222"},
+			},
+		},
+		{
+			desc:    "nats without url",
+			conf:    config.EventsConfig{Backend: events.BackendNATS},
+			wantErr: events.ErrInvalidConfig,
+		},
+		{
+			desc: "unsupported format",
+			conf: config.EventsConfig{
+				Backend: events.BackendKafka,
+				Format:  "avro",
+				Kafka:   config.KafkaConfig{Brokers: []string{"localhost:9092"}},
+			},
+			wantErr: events.ErrInvalidConfig,
+		},
+		{
+			desc:    "unsupported backend",
+			conf:    config.EventsConfig{Backend: "amqp"},
+			wantErr: events.ErrUnsupportedBackend,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := events.ValidateConfig(tc.conf)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got error %v, wanted %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	event := events.Event{
+		ID:        uuid.MustParse("0b6a0f0e-2f5c-4d3c-9a0b-5d5c3f1e2a11"),
+		Time:      time.Date(2025, 12, 1, 12, 0, 0, 0, time.UTC),
+		Model:     "aws:model:instance",
+		Operation: events.OperationUpdate,
+		RecordID:  uuid.MustParse("5f4d9c0a-7f3e-4a55-8d0c-2a0e8c6b9f21"),
+		Diff:      json.RawMessage(`{"state":{"old":"running","new":"stopped"}}`),
+	}
+
+	testCases := []struct {
+		desc            string
+		conf            config.EventsConfig
+		wantContentType string
+		wantSource      string
+		wantType        string
+	}{
+		{
+			desc:            "json",
+			conf:            config.EventsConfig{},
+			wantContentType: "application/json",
+		},
+		{
+			desc:            "cloudevents with defaults",
+			conf:            config.EventsConfig{Format: events.FormatCloudEvents},
+			wantContentType: "application/cloudevents+json",
+			wantSource:      events.DefaultCloudEventsSource,
+			wantType:        "io.gardener.inventory.aws.model.instance.update",
+		},
+		{
+			desc: "cloudevents with custom source and type prefix",
+			conf: config.EventsConfig{
+				Format: events.FormatCloudEvents,
+				CloudEvents: config.CloudEventsConfig{
+					Source:     "/inventory/prod",
+					TypePrefix: "com.example",
+				},
+			},
+			wantContentType: "application/cloudevents+json",
+			wantSource:      "/inventory/prod",
+			wantType:        "com.example.aws.model.instance.update",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			data, contentType, err := events.NewEncoder(tc.conf).Encode(event)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if contentType != tc.wantContentType {
+				t.Fatalf("got content type %q, wanted %q", contentType, tc.wantContentType)
+			}
+
+			got := event
+			if tc.conf.Format == events.FormatCloudEvents {
+				var ce events.CloudEvent
+				if err := json.Unmarshal(data, &ce); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if ce.SpecVersion != "1.0" || ce.Source != tc.wantSource || ce.Type != tc.wantType {
+					t.Fatalf("got envelope %s/%s/%s, wanted 1.0/%s/%s", ce.SpecVersion, ce.Source, ce.Type, tc.wantSource, tc.wantType)
+				}
+				if ce.ID != event.ID.String() || ce.Subject != event.RecordID.String() {
+					t.Fatalf("got id %s and subject %s, wanted %s and %s", ce.ID, ce.Subject, event.ID, event.RecordID)
+				}
+				got = ce.Data
+			} else if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.ID != event.ID || got.Model != event.Model || !got.Time.Equal(event.Time) || string(got.Diff) != string(event.Diff) {
+				t.Fatalf("got event %+v, wanted %+v", got, event)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/gardener/inventory/pkg/core/config"
+)
+
+// kafkaPublisher publishes the events to Kafka topics. The events are keyed by
+// the ID of the changed record, so that the events of a record are written to
+// the same partition and consumed in order.
+type kafkaPublisher struct {
+	writer  *kafka.Writer
+	encoder *Encoder
+	prefix  string
+}
+
+var _ Publisher = &kafkaPublisher{}
+
+// newKafkaPublisher returns a [Publisher] for Kafka.
+func newKafkaPublisher(conf config.EventsConfig) *kafkaPublisher {
+	writer := &kafka.Writer{
+		Addr:                   kafka.TCP(conf.Kafka.Brokers...),
+		Balancer:               &kafka.Hash{},
+		RequiredAcks:           kafka.RequireAll,
+		BatchTimeout:           10 * time.Millisecond,
+		AllowAutoTopicCreation: true,
+	}
+
+	return &kafkaPublisher{
+		writer:  writer,
+		encoder: NewEncoder(conf),
+		prefix:  conf.Prefix,
+	}
+}
+
+// Publish implements the [Publisher] interface.
+func (p *kafkaPublisher) Publish(ctx context.Context, events ...Event) error {
+	messages := make([]kafka.Message, 0, len(events))
+	for _, event := range events {
+		data, contentType, err := p.encoder.Encode(event)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, kafka.Message{
+			Topic: Subject(p.prefix, event.Model),
+			Key:   []byte(event.RecordID.String()),
+			Value: data,
+			Headers: []kafka.Header{
+				{Key: "content-type", Value: []byte(contentType)},
+			},
+		})
+	}
+
+	return p.writer.WriteMessages(ctx, messages...)
+}
+
+// Close implements the [Publisher] interface.
+func (p *kafkaPublisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/gardener/inventory/pkg/core/config"
+)
+
+// natsFlushTimeout specifies the max duration to wait for the NATS server to
+// acknowledge the published events.
+const natsFlushTimeout = 30 * time.Second
+
+// natsPublisher publishes the events to NATS subjects.
+type natsPublisher struct {
+	conn    *nats.Conn
+	encoder *Encoder
+	prefix  string
+}
+
+var _ Publisher = &natsPublisher{}
+
+// newNATSPublisher returns a [Publisher] for NATS.
+func newNATSPublisher(conf config.EventsConfig) (*natsPublisher, error) {
+	opts := []nats.Option{
+		nats.Name("inventory"),
+	}
+	if conf.NATS.CredentialsFile != "" {
+		opts = append(opts, nats.UserCredentials(conf.NATS.CredentialsFile))
+	}
+
+	conn, err := nats.Connect(conf.NATS.URL, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &natsPublisher{
+		conn:    conn,
+		encoder: NewEncoder(conf),
+		prefix:  conf.Prefix,
+	}
+
+	return p, nil
+}
+
+// Publish implements the [Publisher] interface.
+func (p *natsPublisher) Publish(ctx context.Context, events ...Event) error {
+	for _, event := range events {
+		data, contentType, err := p.encoder.Encode(event)
+		if err != nil {
+			return err
+		}
+		msg := nats.NewMsg(Subject(p.prefix, event.Model))
+		msg.Header.Set("Content-Type", contentType)
+		msg.Data = data
+		if err := p.conn.PublishMsg(msg); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, natsFlushTimeout)
+	defer cancel()
+
+	return p.conn.FlushWithContext(ctx)
+}
+
+// Close implements the [Publisher] interface.
+func (p *natsPublisher) Close() error {
+	return p.conn.Drain()
+}
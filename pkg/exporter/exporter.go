@@ -0,0 +1,296 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package exporter provides the encoding of model records and the upload of
+// the encoded records to object store buckets, e.g. for downstream analytics
+// in BigQuery or Athena.
+package exporter
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/uptrace/bun/schema"
+)
+
+// Supported export formats
+const (
+	// FormatNDJSON encodes each record as a JSON object on a separate
+	// line.
+	FormatNDJSON = "ndjson"
+
+	// FormatCSV encodes the records as CSV with a header row.
+	FormatCSV = "csv"
+
+	// FormatParquet encodes the records as Apache Parquet.
+	FormatParquet = "parquet"
+)
+
+// Formats provides the list of supported export formats.
+var Formats = []string{
+	FormatNDJSON,
+	FormatCSV,
+	FormatParquet,
+}
+
+// Types of the exported columns
+const (
+	ColumnTypeString    = "string"
+	ColumnTypeInt       = "int"
+	ColumnTypeUint      = "uint"
+	ColumnTypeFloat     = "float"
+	ColumnTypeBool      = "bool"
+	ColumnTypeTimestamp = "timestamp"
+	ColumnTypeJSON      = "json"
+)
+
+// Column describes an exported column.
+type Column struct {
+	// Name specifies the name of the column.
+	Name string
+
+	// Type specifies the type of the column, which determines how the
+	// values are encoded in typed formats, e.g. Parquet.
+	Type string
+}
+
+// ColumnsFor returns the exported columns of the model described by the given
+// table. Values, which are encoded as text, e.g. UUIDs and IP addresses, are
+// exported as strings. Maps, slices and structs are exported as JSON.
+func ColumnsFor(table *schema.Table) []Column {
+	columns := make([]Column, 0, len(table.Fields))
+	for _, field := range table.Fields {
+		columns = append(columns, Column{
+			Name: field.Name,
+			Type: columnType(field.IndirectType),
+		})
+	}
+
+	return columns
+}
+
+// columnType returns the column type for the given Go type.
+func columnType(t reflect.Type) string {
+	switch {
+	case t == reflect.TypeFor[time.Time]():
+		return ColumnTypeTimestamp
+	case t.Implements(reflect.TypeFor[encoding.TextMarshaler]()):
+		return ColumnTypeString
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return ColumnTypeString
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return ColumnTypeInt
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return ColumnTypeUint
+	case reflect.Float32, reflect.Float64:
+		return ColumnTypeFloat
+	case reflect.Bool:
+		return ColumnTypeBool
+	default:
+		return ColumnTypeJSON
+	}
+}
+
+// DefaultFormat is the format used, when no format is specified.
+const DefaultFormat = FormatNDJSON
+
+// ErrUnsupportedFormat is an error, which is returned when an unsupported
+// export format is specified.
+var ErrUnsupportedFormat = errors.New("unsupported export format")
+
+// ContentType returns the content type of the given export format.
+func ContentType(format string) (string, error) {
+	switch format {
+	case FormatNDJSON:
+		return "application/x-ndjson", nil
+	case FormatCSV:
+		return "text/csv", nil
+	case FormatParquet:
+		return "application/vnd.apache.parquet", nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+}
+
+// Encoder writes records in one of the supported formats. Each record is a
+// JSON object, e.g. as returned by the `to_jsonb' function of PostgreSQL.
+type Encoder interface {
+	// Encode writes the given record.
+	Encode(record string) error
+
+	// Close flushes any buffered data, and writes the footer of the
+	// format, if any. Close does not close the underlying writer.
+	Close() error
+}
+
+// NewEncoder returns an [Encoder], which writes records in the given format to
+// w. The columns specify the exported columns of CSV and Parquet, and are
+// ignored for NDJSON.
+func NewEncoder(w io.Writer, format string, columns []Column) (Encoder, error) {
+	switch format {
+	case FormatNDJSON:
+		return &ndjsonEncoder{w: w}, nil
+	case FormatCSV:
+		return newCSVEncoder(w, columns)
+	case FormatParquet:
+		return newParquetEncoder(w, columns), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+}
+
+// Encode writes the given records in the given format. See [NewEncoder] for
+// details.
+func Encode(w io.Writer, format string, columns []Column, records []string) error {
+	enc, err := NewEncoder(w, format, columns)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if err := enc.Encode(record); err != nil {
+			return err
+		}
+	}
+
+	return enc.Close()
+}
+
+// EncodeRecords writes the records of the given iterator in the given format to
+// w, and returns the number of written records. Unlike [Encode], the records
+// are not kept in memory.
+func EncodeRecords(w io.Writer, format string, columns []Column, records Records) (int, error) {
+	enc, err := NewEncoder(w, format, columns)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for records.Next() {
+		var record string
+		if err := records.Scan(&record); err != nil {
+			return count, err
+		}
+		if err := enc.Encode(record); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	if err := records.Err(); err != nil {
+		return count, err
+	}
+
+	return count, enc.Close()
+}
+
+// ndjsonEncoder writes records as NDJSON.
+type ndjsonEncoder struct {
+	w   io.Writer
+	buf bytes.Buffer
+}
+
+// Encode implements the [Encoder] interface.
+func (e *ndjsonEncoder) Encode(record string) error {
+	e.buf.Reset()
+	if err := json.Compact(&e.buf, []byte(record)); err != nil {
+		return err
+	}
+	e.buf.WriteByte('\n')
+	_, err := e.w.Write(e.buf.Bytes())
+
+	return err
+}
+
+// Close implements the [Encoder] interface.
+func (e *ndjsonEncoder) Close() error {
+	return nil
+}
+
+// csvEncoder writes records as CSV. Strings are written as is, missing and
+// null values as empty fields, and any other values as JSON.
+type csvEncoder struct {
+	writer  *csv.Writer
+	columns []Column
+	row     []string
+}
+
+// newCSVEncoder returns a new CSV encoder, which has already written the
+// header row.
+func newCSVEncoder(w io.Writer, columns []Column) (*csvEncoder, error) {
+	writer := csv.NewWriter(w)
+	header := make([]string, 0, len(columns))
+	for _, column := range columns {
+		header = append(header, column.Name)
+	}
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+
+	enc := &csvEncoder{
+		writer:  writer,
+		columns: columns,
+		row:     make([]string, len(columns)),
+	}
+
+	return enc, nil
+}
+
+// Encode implements the [Encoder] interface.
+func (e *csvEncoder) Encode(record string) error {
+	var item map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(record), &item); err != nil {
+		return err
+	}
+
+	for i, column := range e.columns {
+		e.row[i] = ""
+		value, ok := item[column.Name]
+		if !ok || string(value) == "null" {
+			continue
+		}
+
+		var s string
+		if err := json.Unmarshal(value, &s); err == nil {
+			e.row[i] = s
+
+			continue
+		}
+		e.row[i] = string(value)
+	}
+
+	return e.writer.Write(e.row)
+}
+
+// Close implements the [Encoder] interface.
+func (e *csvEncoder) Close() error {
+	e.writer.Flush()
+
+	return e.writer.Error()
+}
+
+// ObjectKey returns the key of the object, which holds the records of the
+// given table exported at the given time. The keys are partitioned by date
+// using the `dt=YYYY-MM-DD' convention, which is understood by BigQuery and
+// Athena, e.g.
+//
+//	inventory/aws_instance/dt=2025-01-02/aws_instance-20250102T150405Z.ndjson
+func ObjectKey(prefix, table, format string, ts time.Time) string {
+	ts = ts.UTC()
+	name := fmt.Sprintf("%s-%s.%s", table, ts.Format("20060102T150405Z"), format)
+
+	return path.Join(strings.Trim(prefix, "/"), table, "dt="+ts.Format(time.DateOnly), name)
+}
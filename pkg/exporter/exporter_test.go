@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter_test
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/uptrace/bun/dialect/pgdialect"
+
+	"github.com/gardener/inventory/pkg/exporter"
+)
+
+func TestEncode(t *testing.T) {
+	columns := []exporter.Column{
+		{Name: "name", Type: exporter.ColumnTypeString},
+		{Name: "count", Type: exporter.ColumnTypeInt},
+		{Name: "labels", Type: exporter.ColumnTypeJSON},
+		{Name: "region", Type: exporter.ColumnTypeString},
+	}
+	records := []string{
+		`{"name": "foo", "count": 1, "labels": {"a": "b"}, "region": null}`,
+		`{"name": "bar, baz", "count": 2}`,
+	}
+
+	testCases := []struct {
+		desc    string
+		format  string
+		wanted  string
+		wantErr error
+	}{
+		{
+			desc:   "ndjson",
+			format: exporter.FormatNDJSON,
+			wanted: "{\"name\":\"foo\",\"count\":1,\"labels\":{\"a\":\"b\"},\"region\":null}\n{\"name\":\"bar, baz\",\"count\":2}\n",
+		},
+		{
+			desc:   "csv",
+			format: exporter.FormatCSV,
+			wanted: "name,count,labels,region\nfoo,1,\"{\"\"a\"\": \"\"b\"\"}\",\n\"bar, baz\",2,,\n",
+		},
+		{
+			desc:    "unsupported format",
+			format:  "avro",
+			wantErr: exporter.ErrUnsupportedFormat,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := exporter.Encode(&buf, tc.format, columns, records)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got error %v wanted %v", err, tc.wantErr)
+			}
+			if got := buf.String(); got != tc.wanted {
+				t.Fatalf("got %q wanted %q", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestObjectKey(t *testing.T) {
+	ts := time.Date(2025, time.January, 2, 15, 4, 5, 0, time.UTC)
+
+	testCases := []struct {
+		desc   string
+		prefix string
+		wanted string
+	}{
+		{
+			desc:   "with prefix",
+			prefix: "/inventory/",
+			wanted: "inventory/aws_instance/dt=2025-01-02/aws_instance-20250102T150405Z.ndjson",
+		},
+		{
+			desc:   "without prefix",
+			prefix: "",
+			wanted: "aws_instance/dt=2025-01-02/aws_instance-20250102T150405Z.ndjson",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := exporter.ObjectKey(tc.prefix, "aws_instance", exporter.FormatNDJSON, ts)
+			if got != tc.wanted {
+				t.Fatalf("got %q wanted %q", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestEncodeParquet(t *testing.T) {
+	columns := []exporter.Column{
+		{Name: "name", Type: exporter.ColumnTypeString},
+		{Name: "count", Type: exporter.ColumnTypeInt},
+		{Name: "size", Type: exporter.ColumnTypeUint},
+		{Name: "ratio", Type: exporter.ColumnTypeFloat},
+		{Name: "enabled", Type: exporter.ColumnTypeBool},
+		{Name: "seen_at", Type: exporter.ColumnTypeTimestamp},
+		{Name: "labels", Type: exporter.ColumnTypeJSON},
+	}
+	records := []string{
+		`{"name": "foo", "count": -1, "size": 18446744073709551615, "ratio": 0.5, "enabled": true, "seen_at": "2025-01-02T15:04:05.123456+00:00", "labels": {"a": "b"}}`,
+		`{"name": "bar", "count": null}`,
+	}
+
+	var buf bytes.Buffer
+	if err := exporter.Encode(&buf, exporter.FormatParquet, columns, records); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	type row struct {
+		Name    *string    `parquet:"name,optional"`
+		Count   *int64     `parquet:"count,optional"`
+		Size    *uint64    `parquet:"size,optional"`
+		Ratio   *float64   `parquet:"ratio,optional"`
+		Enabled *bool      `parquet:"enabled,optional"`
+		SeenAt  *time.Time `parquet:"seen_at,optional,timestamp(microsecond)"`
+		Labels  *string    `parquet:"labels,optional,json"`
+	}
+
+	rows, err := parquet.Read[row](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows wanted 2", len(rows))
+	}
+
+	got := rows[0]
+	seenAt := time.Date(2025, time.January, 2, 15, 4, 5, 123456000, time.UTC)
+	if *got.Name != "foo" || *got.Count != -1 || *got.Size != 18446744073709551615 || *got.Ratio != 0.5 || !*got.Enabled {
+		t.Fatalf("unexpected row %+v", got)
+	}
+	if !got.SeenAt.Equal(seenAt) {
+		t.Fatalf("got seen_at %s wanted %s", got.SeenAt, seenAt)
+	}
+	if *got.Labels != `{"a":"b"}` {
+		t.Fatalf("got labels %s wanted {\"a\":\"b\"}", *got.Labels)
+	}
+	if rows[1].Name == nil || *rows[1].Name != "bar" || rows[1].Count != nil || rows[1].Labels != nil {
+		t.Fatalf("unexpected row %+v", rows[1])
+	}
+}
+
+func TestColumnsFor(t *testing.T) {
+	type model struct {
+		ID        int64             `bun:"id,pk"`
+		Name      string            `bun:"name"`
+		Size      uint64            `bun:"size"`
+		Enabled   bool              `bun:"enabled"`
+		Address   net.IP            `bun:"address,type:inet"`
+		Labels    map[string]string `bun:"labels,type:jsonb"`
+		Tags      []string          `bun:"tags,array"`
+		CreatedAt time.Time         `bun:"created_at"`
+	}
+
+	table := pgdialect.New().Tables().Get(reflect.TypeFor[model]())
+	wanted := []exporter.Column{
+		{Name: "id", Type: exporter.ColumnTypeInt},
+		{Name: "name", Type: exporter.ColumnTypeString},
+		{Name: "size", Type: exporter.ColumnTypeUint},
+		{Name: "enabled", Type: exporter.ColumnTypeBool},
+		{Name: "address", Type: exporter.ColumnTypeString},
+		{Name: "labels", Type: exporter.ColumnTypeJSON},
+		{Name: "tags", Type: exporter.ColumnTypeJSON},
+		{Name: "created_at", Type: exporter.ColumnTypeTimestamp},
+	}
+
+	got := exporter.ColumnsFor(table)
+	if !slices.Equal(got, wanted) {
+		t.Fatalf("got %v wanted %v", got, wanted)
+	}
+}
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// parquetRowGroupSize specifies the max number of rows per row group. Row
+// groups are buffered in memory, until they are written out.
+const parquetRowGroupSize = 10000
+
+// parquetEncoder writes records as Apache Parquet using Snappy compression.
+// All columns are optional, and missing or null values are written as nulls.
+type parquetEncoder struct {
+	writer *parquet.Writer
+	types  map[string]string
+	paths  [][]string
+	row    parquet.Row
+}
+
+// newParquetEncoder returns a new Parquet encoder for the given columns.
+func newParquetEncoder(w io.Writer, columns []Column) *parquetEncoder {
+	group := make(parquet.Group, len(columns))
+	types := make(map[string]string, len(columns))
+	for _, column := range columns {
+		group[column.Name] = parquet.Optional(parquetNode(column.Type))
+		types[column.Name] = column.Type
+	}
+
+	schema := parquet.NewSchema("record", group)
+	writer := parquet.NewWriter(
+		w,
+		schema,
+		parquet.Compression(&parquet.Snappy),
+		parquet.MaxRowsPerRowGroup(parquetRowGroupSize),
+	)
+
+	// The leaf columns of the schema are ordered by name, which
+	// determines the order of the values in each row.
+	paths := schema.Columns()
+	enc := &parquetEncoder{
+		writer: writer,
+		types:  types,
+		paths:  paths,
+		row:    make(parquet.Row, len(paths)),
+	}
+
+	return enc
+}
+
+// Encode implements the [Encoder] interface.
+func (e *parquetEncoder) Encode(record string) error {
+	var item map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(record), &item); err != nil {
+		return err
+	}
+
+	for i, path := range e.paths {
+		name := path[0]
+		raw, ok := item[name]
+		if !ok || bytes.Equal(raw, []byte("null")) {
+			e.row[i] = parquet.NullValue().Level(0, 0, i)
+
+			continue
+		}
+
+		value, err := parquetValue(e.types[name], raw)
+		if err != nil {
+			return fmt.Errorf("column %s: %w", name, err)
+		}
+		e.row[i] = value.Level(0, 1, i)
+	}
+
+	_, err := e.writer.WriteRows([]parquet.Row{e.row})
+
+	return err
+}
+
+// Close implements the [Encoder] interface.
+func (e *parquetEncoder) Close() error {
+	return e.writer.Close()
+}
+
+// parquetNode returns the Parquet node for the given column type.
+func parquetNode(columnType string) parquet.Node {
+	switch columnType {
+	case ColumnTypeInt:
+		return parquet.Int(64)
+	case ColumnTypeUint:
+		return parquet.Uint(64)
+	case ColumnTypeFloat:
+		return parquet.Leaf(parquet.DoubleType)
+	case ColumnTypeBool:
+		return parquet.Leaf(parquet.BooleanType)
+	case ColumnTypeTimestamp:
+		return parquet.Timestamp(parquet.Microsecond)
+	case ColumnTypeJSON:
+		return parquet.JSON()
+	default:
+		return parquet.String()
+	}
+}
+
+// parquetValue returns the Parquet value of the given JSON value for the given
+// column type.
+func parquetValue(columnType string, raw json.RawMessage) (parquet.Value, error) {
+	switch columnType {
+	case ColumnTypeInt:
+		v, err := strconv.ParseInt(string(raw), 10, 64)
+		if err != nil {
+			return parquet.Value{}, err
+		}
+
+		return parquet.Int64Value(v), nil
+	case ColumnTypeUint:
+		v, err := strconv.ParseUint(string(raw), 10, 64)
+		if err != nil {
+			return parquet.Value{}, err
+		}
+
+		return parquet.Int64Value(int64(v)), nil // nolint: gosec
+	case ColumnTypeFloat:
+		v, err := strconv.ParseFloat(string(raw), 64)
+		if err != nil {
+			return parquet.Value{}, err
+		}
+
+		return parquet.DoubleValue(v), nil
+	case ColumnTypeBool:
+		var v bool
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return parquet.Value{}, err
+		}
+
+		return parquet.BooleanValue(v), nil
+	case ColumnTypeTimestamp:
+		var v time.Time
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return parquet.Value{}, err
+		}
+
+		return parquet.Int64Value(v.UnixMicro()), nil
+	case ColumnTypeJSON:
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, raw); err != nil {
+			return parquet.Value{}, err
+		}
+
+		return parquet.ByteArrayValue(buf.Bytes()), nil
+	default:
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			// Non-string values are written as JSON
+			return parquet.ByteArrayValue(raw), nil
+		}
+
+		return parquet.ByteArrayValue([]byte(v)), nil
+	}
+}
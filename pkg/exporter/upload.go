@@ -0,0 +1,348 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	awsclients "github.com/gardener/inventory/pkg/clients/aws"
+	azureclients "github.com/gardener/inventory/pkg/clients/azure"
+	gcpclients "github.com/gardener/inventory/pkg/clients/gcp"
+)
+
+// Supported object store providers
+const (
+	// ProviderAWS uploads the objects to an AWS S3 bucket.
+	ProviderAWS = "aws"
+
+	// ProviderGCP uploads the objects to a GCS bucket.
+	ProviderGCP = "gcp"
+
+	// ProviderAzure uploads the objects to an Azure Blob container.
+	ProviderAzure = "azure"
+)
+
+// ErrUnsupportedProvider is an error, which is returned when an unsupported
+// object store provider is specified.
+var ErrUnsupportedProvider = errors.New("unsupported object store provider")
+
+// ErrClientNotFound is an error, which is returned when no client is
+// configured for the account of a [Destination].
+var ErrClientNotFound = errors.New("client not found")
+
+// ErrInvalidDestination is an error, which is returned when a [Destination]
+// is missing required settings.
+var ErrInvalidDestination = errors.New("invalid destination")
+
+// Destination specifies the object store bucket, to which the exported records
+// are uploaded. The clients configured for the respective provider are used
+// for uploading the objects.
+type Destination struct {
+	// Provider specifies the object store provider, i.e. aws, gcp or
+	// azure.
+	Provider string `yaml:"provider" json:"provider" desc:"The object store provider, i.e. aws, gcp or azure" example:"aws"`
+
+	// Account specifies the AWS account ID, GCP project ID or Azure
+	// subscription ID, whose configured client is used for uploading the
+	// objects.
+	Account string `yaml:"account" json:"account" desc:"The AWS account, GCP project or Azure subscription, whose configured client is used" example:"123456789012"`
+
+	// Bucket specifies the name of the S3 or GCS bucket, or the name of the
+	// Azure Blob container.
+	Bucket string `yaml:"bucket" json:"bucket" desc:"The name of the bucket or Azure Blob container" example:"inventory-exports"`
+
+	// Region specifies the region of the S3 bucket. If not specified, the
+	// region of the configured client is used.
+	Region string `yaml:"region" json:"region" desc:"The region of the S3 bucket" example:"eu-central-1"`
+
+	// StorageAccount specifies the name of the Azure Storage Account of
+	// the Blob container.
+	StorageAccount string `yaml:"storage_account" json:"storage_account" desc:"The name of the Azure Storage Account" example:"inventoryexports"`
+
+	// Prefix specifies the prefix of the object keys.
+	Prefix string `yaml:"prefix" json:"prefix" desc:"The prefix of the object keys" example:"inventory"`
+}
+
+// s3PartSize specifies the size of the parts of multipart uploads to S3.
+// Objects, which are smaller than a single part, are uploaded at once.
+const s3PartSize = 16 * 1024 * 1024
+
+// Uploader uploads objects to an object store bucket.
+type Uploader interface {
+	// Upload uploads the data read from body until EOF as an object with
+	// the given key and content type. The data is streamed to the bucket,
+	// so that the object does not have to be kept in memory.
+	Upload(ctx context.Context, key string, body io.Reader, contentType string) error
+}
+
+// UploaderFunc is an adapter, which allows the use of ordinary functions as
+// an [Uploader].
+type UploaderFunc func(ctx context.Context, key string, body io.Reader, contentType string) error
+
+// Upload implements the [Uploader] interface.
+func (f UploaderFunc) Upload(ctx context.Context, key string, body io.Reader, contentType string) error {
+	return f(ctx, key, body, contentType)
+}
+
+// Records is an iterator over records, which are JSON objects, e.g. as
+// returned by the `to_jsonb' function of PostgreSQL. It is implemented by
+// [database/sql.Rows].
+type Records interface {
+	// Next prepares the next record for reading with Scan, and returns
+	// false, if there are no more records.
+	Next() bool
+
+	// Scan copies the current record into dest.
+	Scan(dest ...any) error
+
+	// Err returns the error, if any, which was encountered while
+	// iterating.
+	Err() error
+}
+
+// Export encodes the given records in the given format, and uploads them as a
+// single object with the given key. The records are encoded and uploaded
+// incrementally, so that only the current chunk of the object is kept in
+// memory. Export returns the number of exported records.
+func Export(ctx context.Context, uploader Uploader, key, format string, columns []Column, records Records) (int, error) {
+	contentType, err := ContentType(format)
+	if err != nil {
+		return 0, err
+	}
+
+	pr, pw := io.Pipe()
+	type result struct {
+		count int
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		count, err := EncodeRecords(pw, format, columns, records)
+		// Closing the pipe with a nil error signals EOF to the uploader
+		pw.CloseWithError(err)
+		done <- result{count: count, err: err}
+	}()
+
+	uploadErr := uploader.Upload(ctx, key, pr, contentType)
+	// Unblock the encoder, if the upload stopped reading early
+	pr.CloseWithError(cmp.Or(uploadErr, io.ErrClosedPipe))
+	res := <-done
+
+	// Errors from the encoder are reported by the uploader as well, so
+	// prefer the original error.
+	if res.err != nil {
+		return res.count, res.err
+	}
+
+	return res.count, uploadErr
+}
+
+// NewUploader returns an [Uploader] for the given [Destination].
+func NewUploader(dest Destination) (Uploader, error) {
+	if dest.Account == "" || dest.Bucket == "" {
+		return nil, fmt.Errorf("%w: account and bucket must be specified", ErrInvalidDestination)
+	}
+
+	switch dest.Provider {
+	case ProviderAWS:
+		return newS3Uploader(dest)
+	case ProviderGCP:
+		return newGCSUploader(dest)
+	case ProviderAzure:
+		return newBlobUploader(dest)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedProvider, dest.Provider)
+	}
+}
+
+// newS3Uploader returns an [Uploader] for AWS S3 buckets.
+func newS3Uploader(dest Destination) (Uploader, error) {
+	client, ok := awsclients.S3Clientset.Get(dest.Account)
+	if !ok {
+		return nil, fmt.Errorf("%w: aws account %s", ErrClientNotFound, dest.Account)
+	}
+
+	withRegion := func(o *s3.Options) {
+		if dest.Region != "" {
+			o.Region = dest.Region
+		}
+	}
+
+	fn := func(ctx context.Context, key string, body io.Reader, contentType string) error {
+		part := make([]byte, s3PartSize)
+		n, err := io.ReadFull(body, part)
+		switch {
+		case errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF):
+			// The object fits into a single part
+			_, err := client.Client.PutObject(
+				ctx,
+				&s3.PutObjectInput{
+					Bucket:      aws.String(dest.Bucket),
+					Key:         aws.String(key),
+					Body:        bytes.NewReader(part[:n]),
+					ContentType: aws.String(contentType),
+				},
+				withRegion,
+			)
+
+			return err
+		case err != nil:
+			return err
+		}
+
+		out, err := client.Client.CreateMultipartUpload(
+			ctx,
+			&s3.CreateMultipartUploadInput{
+				Bucket:      aws.String(dest.Bucket),
+				Key:         aws.String(key),
+				ContentType: aws.String(contentType),
+			},
+			withRegion,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := uploadS3Parts(ctx, client.Client, out, body, part, withRegion); err != nil {
+			_, abortErr := client.Client.AbortMultipartUpload(
+				context.WithoutCancel(ctx),
+				&s3.AbortMultipartUploadInput{
+					Bucket:   out.Bucket,
+					Key:      out.Key,
+					UploadId: out.UploadId,
+				},
+				withRegion,
+			)
+
+			return errors.Join(err, abortErr)
+		}
+
+		return nil
+	}
+
+	return UploaderFunc(fn), nil
+}
+
+// uploadS3Parts uploads the given first part and the remaining data read from
+// body as the parts of the given multipart upload, and completes the upload.
+func uploadS3Parts(
+	ctx context.Context,
+	client *s3.Client,
+	upload *s3.CreateMultipartUploadOutput,
+	body io.Reader,
+	part []byte,
+	optFns ...func(*s3.Options),
+) error {
+	completed := make([]types.CompletedPart, 0)
+	n := len(part)
+	for partNumber := int32(1); n > 0; partNumber++ {
+		out, err := client.UploadPart(
+			ctx,
+			&s3.UploadPartInput{
+				Bucket:     upload.Bucket,
+				Key:        upload.Key,
+				UploadId:   upload.UploadId,
+				PartNumber: aws.Int32(partNumber),
+				Body:       bytes.NewReader(part[:n]),
+			},
+			optFns...,
+		)
+		if err != nil {
+			return fmt.Errorf("part %d: %w", partNumber, err)
+		}
+		completed = append(completed, types.CompletedPart{
+			ETag:       out.ETag,
+			PartNumber: aws.Int32(partNumber),
+		})
+
+		n, err = io.ReadFull(body, part)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return err
+		}
+	}
+
+	_, err := client.CompleteMultipartUpload(
+		ctx,
+		&s3.CompleteMultipartUploadInput{
+			Bucket:          upload.Bucket,
+			Key:             upload.Key,
+			UploadId:        upload.UploadId,
+			MultipartUpload: &types.CompletedMultipartUpload{Parts: completed},
+		},
+		optFns...,
+	)
+
+	return err
+}
+
+// newGCSUploader returns an [Uploader] for GCS buckets.
+func newGCSUploader(dest Destination) (Uploader, error) {
+	client, ok := gcpclients.StorageClientset.Get(dest.Account)
+	if !ok {
+		return nil, fmt.Errorf("%w: gcp project %s", ErrClientNotFound, dest.Account)
+	}
+
+	fn := func(ctx context.Context, key string, body io.Reader, contentType string) error {
+		// Cancelling the context of the writer aborts the upload, so
+		// that partial objects are not created on errors.
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		w := client.Client.Bucket(dest.Bucket).Object(key).NewWriter(ctx)
+		w.ContentType = contentType
+		if _, err := io.Copy(w, body); err != nil {
+			cancel()
+			_ = w.Close()
+
+			return err
+		}
+
+		return w.Close()
+	}
+
+	return UploaderFunc(fn), nil
+}
+
+// newBlobUploader returns an [Uploader] for Azure Blob containers.
+func newBlobUploader(dest Destination) (Uploader, error) {
+	if dest.StorageAccount == "" {
+		return nil, fmt.Errorf("%w: storage account must be specified", ErrInvalidDestination)
+	}
+
+	creds, ok := azureclients.StorageCredentialsClientset.Get(dest.Account)
+	if !ok {
+		return nil, fmt.Errorf("%w: azure subscription %s", ErrClientNotFound, dest.Account)
+	}
+
+	serviceURL := fmt.Sprintf("https://%s.blob.core.windows.net/", dest.StorageAccount)
+	client, err := azblob.NewClient(serviceURL, creds.Client, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	fn := func(ctx context.Context, key string, body io.Reader, contentType string) error {
+		opts := &azblob.UploadStreamOptions{
+			HTTPHeaders: &blob.HTTPHeaders{
+				BlobContentType: &contentType,
+			},
+		}
+		_, err := client.UploadStream(ctx, dest.Bucket, key, body, opts)
+
+		return err
+	}
+
+	return UploaderFunc(fn), nil
+}
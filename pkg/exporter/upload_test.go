@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/exporter"
+)
+
+// fakeRecords is an [exporter.Records] iterator over a slice of records, which
+// fails with the given error once the records are exhausted.
+type fakeRecords struct {
+	records []string
+	current string
+	err     error
+}
+
+func (r *fakeRecords) Next() bool {
+	if len(r.records) == 0 {
+		return false
+	}
+	r.current, r.records = r.records[0], r.records[1:]
+
+	return true
+}
+
+func (r *fakeRecords) Scan(dest ...any) error {
+	*(dest[0].(*string)) = r.current
+
+	return nil
+}
+
+func (r *fakeRecords) Err() error {
+	return r.err
+}
+
+func TestExport(t *testing.T) {
+	columns := []exporter.Column{
+		{Name: "name", Type: exporter.ColumnTypeString},
+	}
+	errRows := errors.New("connection reset")
+	errUpload := errors.New("upload failed")
+
+	testCases := []struct {
+		desc            string
+		format          string
+		records         []string
+		rowsErr         error
+		uploadErr       error
+		wantData        string
+		wantContentType string
+		wantCount       int
+		wantErr         error
+	}{
+		{
+			desc:            "ndjson",
+			format:          exporter.FormatNDJSON,
+			records:         []string{`{"name": "foo"}`, `{"name": "bar"}`},
+			wantData:        "{\"name\":\"foo\"}\n{\"name\":\"bar\"}\n",
+			wantContentType: "application/x-ndjson",
+			wantCount:       2,
+		},
+		{
+			desc:            "csv without records",
+			format:          exporter.FormatCSV,
+			records:         []string{},
+			wantData:        "name\n",
+			wantContentType: "text/csv",
+			wantCount:       0,
+		},
+		{
+			desc:      "rows error",
+			format:    exporter.FormatNDJSON,
+			records:   []string{`{"name": "foo"}`},
+			rowsErr:   errRows,
+			wantCount: 1,
+			wantErr:   errRows,
+		},
+		{
+			desc:      "upload error",
+			format:    exporter.FormatNDJSON,
+			records:   []string{`{"name": "foo"}`},
+			uploadErr: errUpload,
+			wantErr:   errUpload,
+		},
+		{
+			desc:    "unsupported format",
+			format:  "avro",
+			wantErr: exporter.ErrUnsupportedFormat,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var gotKey, gotData, gotContentType string
+			upload := func(_ context.Context, key string, body io.Reader, contentType string) error {
+				if tc.uploadErr != nil {
+					return tc.uploadErr
+				}
+
+				data, err := io.ReadAll(body)
+				if err != nil {
+					return err
+				}
+				gotKey, gotData, gotContentType = key, string(data), contentType
+
+				return nil
+			}
+
+			records := &fakeRecords{records: tc.records, err: tc.rowsErr}
+			count, err := exporter.Export(context.Background(), exporter.UploaderFunc(upload), "key", tc.format, columns, records)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("got error %v wanted %v", err, tc.wantErr)
+				}
+				if tc.uploadErr == nil && count != tc.wantCount {
+					t.Fatalf("got count %d wanted %d", count, tc.wantCount)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if count != tc.wantCount {
+				t.Fatalf("got count %d wanted %d", count, tc.wantCount)
+			}
+			if gotKey != "key" {
+				t.Fatalf("got key %q wanted %q", gotKey, "key")
+			}
+			if gotData != tc.wantData {
+				t.Fatalf("got %q wanted %q", gotData, tc.wantData)
+			}
+			if gotContentType != tc.wantContentType {
+				t.Fatalf("got content type %q wanted %q", gotContentType, tc.wantContentType)
+			}
+		})
+	}
+}
+
+func TestExportInvalidRecord(t *testing.T) {
+	upload := func(_ context.Context, _ string, body io.Reader, _ string) error {
+		_, err := io.ReadAll(body)
+
+		return err
+	}
+
+	records := &fakeRecords{records: []string{`{"name": "foo"}`, `{"name":`}}
+	count, err := exporter.Export(context.Background(), exporter.UploaderFunc(upload), "key", exporter.FormatNDJSON, nil, records)
+
+	var syntaxErr *json.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Fatalf("got error %v wanted a syntax error", err)
+	}
+	if count != 1 {
+		t.Fatalf("got count %d wanted 1", count)
+	}
+}
+
+func TestEncodeRecords(t *testing.T) {
+	records := &fakeRecords{records: []string{`{"name": "foo"}`, `{"name": "bar"}`}}
+
+	var buf bytes.Buffer
+	count, err := exporter.EncodeRecords(&buf, exporter.FormatNDJSON, nil, records)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if count != 2 {
+		t.Fatalf("got %d records, wanted 2", count)
+	}
+
+	want := "{\"name\":\"foo\"}\n{\"name\":\"bar\"}\n"
+	if buf.String() != want {
+		t.Fatalf("got output %q, wanted %q", buf.String(), want)
+	}
+}
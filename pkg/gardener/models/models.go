@@ -5,6 +5,7 @@
 package models
 
 import (
+	"encoding/json"
 	"net"
 	"time"
 
@@ -28,11 +29,21 @@ const (
 	CloudProfileGCPImageModelName       = "g:model:cloud_profile_gcp_image"
 	CloudProfileAzureImageModelName     = "g:model:cloud_profile_azure_image"
 	CloudProfileOpenStackImageModelName = "g:model:cloud_profile_openstack_image"
+	CloudProfileImageVersionModelName   = "g:model:cloud_profile_image_version"
+	CloudProfileK8sVersionModelName     = "g:model:cloud_profile_k8s_version"
+	CloudProfileMachineTypeModelName    = "g:model:cloud_profile_machine_type"
+	CloudProfileVolumeTypeModelName     = "g:model:cloud_profile_volume_type"
+	CloudProfileZoneModelName           = "g:model:cloud_profile_zone"
 	PersistentVolumeModelName           = "g:model:persistent_volume"
 	ProjectMemberModelName              = "g:model:project_member"
 	DNSRecordModelName                  = "g:model:dns_record"
 	DNSEntryModelName                   = "g:model:dns_entry"
 	BastionModelName                    = "g:model:bastion"
+	ResourceQuotaModelName              = "g:model:resource_quota"
+	ShootHibernationScheduleModelName   = "g:model:shoot_hibernation_schedule"
+	ShootAdvertisedAddressModelName     = "g:model:shoot_advertised_address"
+	MachineClassModelName               = "g:model:machine_class"
+	BackupEntryModelName                = "g:model:backup_entry"
 	ShootToProjectModelName             = "g:model:link_shoot_to_project"
 	ShootToSeedModelName                = "g:model:link_shoot_to_seed"
 	MachineToShootModelName             = "g:model:link_machine_to_shoot"
@@ -55,11 +66,21 @@ var models = map[string]any{
 	CloudProfileGCPImageModelName:       &CloudProfileGCPImage{},
 	CloudProfileAzureImageModelName:     &CloudProfileAzureImage{},
 	CloudProfileOpenStackImageModelName: &CloudProfileOpenStackImage{},
+	CloudProfileImageVersionModelName:   &CloudProfileImageVersion{},
+	CloudProfileK8sVersionModelName:     &CloudProfileK8sVersion{},
+	CloudProfileMachineTypeModelName:    &CloudProfileMachineType{},
+	CloudProfileVolumeTypeModelName:     &CloudProfileVolumeType{},
+	CloudProfileZoneModelName:           &CloudProfileZone{},
 	PersistentVolumeModelName:           &PersistentVolume{},
 	ProjectMemberModelName:              &ProjectMember{},
 	DNSRecordModelName:                  &DNSRecord{},
 	DNSEntryModelName:                   &DNSEntry{},
 	BastionModelName:                    &Bastion{},
+	ResourceQuotaModelName:              &ResourceQuota{},
+	ShootHibernationScheduleModelName:   &ShootHibernationSchedule{},
+	ShootAdvertisedAddressModelName:     &ShootAdvertisedAddress{},
+	MachineClassModelName:               &MachineClass{},
+	BackupEntryModelName:                &BackupEntry{},
 
 	// Link models
 	ShootToProjectModelName:           &ShootToProject{},
@@ -103,14 +124,16 @@ type Project struct {
 	bun.BaseModel `bun:"table:g_project"`
 	coremodels.Model
 
-	Name              string           `bun:"name,notnull,unique"`
+	Name              string           `bun:"name,notnull,unique:g_project_name_key"`
+	Landscape         string           `bun:"landscape,notnull,unique:g_project_name_key"`
 	Namespace         string           `bun:"namespace,notnull"`
 	Status            string           `bun:"status,notnull"`
 	Purpose           string           `bun:"purpose,notnull"`
 	Owner             string           `bun:"owner,notnull"`
 	CreationTimestamp time.Time        `bun:"creation_timestamp,nullzero"`
-	Shoots            []*Shoot         `bun:"rel:has-many,join:name=project_name"`
-	Members           []*ProjectMember `bun:"rel:has-many,join:name=project_name"`
+	Shoots            []*Shoot         `bun:"rel:has-many,join:name=project_name,join:landscape=landscape"`
+	Members           []*ProjectMember `bun:"rel:has-many,join:name=project_name,join:landscape=landscape"`
+	ResourceQuotas    []*ResourceQuota `bun:"rel:has-many,join:name=project_name,join:landscape=landscape"`
 }
 
 // ProjectMember represents a member of a Gardener Project
@@ -120,9 +143,10 @@ type ProjectMember struct {
 
 	Name        string   `bun:"name,notnull,unique:g_project_member_key"`
 	ProjectName string   `bun:"project_name,notnull,unique:g_project_member_key"`
+	Landscape   string   `bun:"landscape,notnull,unique:g_project_member_key"`
 	Kind        string   `bun:"kind,notnull"`
 	Role        string   `bun:"role,notnull"`
-	Project     *Project `bun:"rel:has-one,join:project_name=name"`
+	Project     *Project `bun:"rel:has-one,join:project_name=name,join:landscape=landscape"`
 }
 
 // ProjectToMember represents a link table connecting the [Project] and
@@ -140,11 +164,12 @@ type Seed struct {
 	bun.BaseModel `bun:"table:g_seed"`
 	coremodels.Model
 
-	Name              string     `bun:"name,notnull,unique"`
+	Name              string     `bun:"name,notnull,unique:g_seed_name_key"`
+	Landscape         string     `bun:"landscape,notnull,unique:g_seed_name_key"`
 	KubernetesVersion string     `bun:"kubernetes_version,notnull"`
 	CreationTimestamp time.Time  `bun:"creation_timestamp,nullzero"`
-	Machines          []*Machine `bun:"rel:has-many,join:name=seed_name"`
-	Shoots            []*Shoot   `bun:"rel:has-many,join:name=seed_name"`
+	Machines          []*Machine `bun:"rel:has-many,join:name=seed_name,join:landscape=landscape"`
+	Shoots            []*Shoot   `bun:"rel:has-many,join:name=seed_name,join:landscape=landscape"`
 }
 
 // Shoot represents a Gardener shoot
@@ -153,7 +178,8 @@ type Shoot struct {
 	coremodels.Model
 
 	Name              string     `bun:"name,notnull"`
-	TechnicalID       string     `bun:"technical_id,notnull,unique"`
+	TechnicalID       string     `bun:"technical_id,notnull,unique:g_shoot_technical_id_key"`
+	Landscape         string     `bun:"landscape,notnull,unique:g_shoot_technical_id_key"`
 	Namespace         string     `bun:"namespace,notnull"`
 	ProjectName       string     `bun:"project_name,notnull"`
 	CloudProfile      string     `bun:"cloud_profile,notnull"`
@@ -167,9 +193,66 @@ type Shoot struct {
 	CreationTimestamp time.Time  `bun:"creation_timestamp,nullzero"`
 	WorkerGroups      []string   `bun:"worker_groups,array,nullzero"`
 	WorkerPrefixes    []string   `bun:"worker_prefixes,array,nullzero"`
-	Seed              *Seed      `bun:"rel:has-one,join:seed_name=name"`
-	Project           *Project   `bun:"rel:has-one,join:project_name=name"`
-	Machines          []*Machine `bun:"rel:has-many,join:technical_id=namespace"`
+	Seed              *Seed      `bun:"rel:has-one,join:seed_name=name,join:landscape=landscape"`
+	Project           *Project   `bun:"rel:has-one,join:project_name=name,join:landscape=landscape"`
+	Machines          []*Machine `bun:"rel:has-many,join:technical_id=namespace,join:landscape=landscape"`
+
+	// HibernationEnabled specifies whether hibernation is enabled in the
+	// Shoot spec.
+	HibernationEnabled bool `bun:"hibernation_enabled,notnull"`
+
+	// NextHibernationAt and NextWakeUpAt specify the earliest next times
+	// across all hibernation schedules of the Shoot, at which it will be
+	// hibernated and woken up respectively, as computed at collection
+	// time.
+	NextHibernationAt    time.Time                   `bun:"next_hibernation_at,nullzero"`
+	NextWakeUpAt         time.Time                   `bun:"next_wake_up_at,nullzero"`
+	HibernationSchedules []*ShootHibernationSchedule `bun:"rel:has-many,join:technical_id=technical_id,join:landscape=landscape"`
+
+	// ExposureClass specifies the name of the ExposureClass of the Shoot,
+	// if any.
+	ExposureClass string `bun:"exposure_class,nullzero"`
+
+	// DNSDomain specifies the external domain of the Shoot.
+	DNSDomain           string                    `bun:"dns_domain,nullzero"`
+	AdvertisedAddresses []*ShootAdvertisedAddress `bun:"rel:has-many,join:technical_id=technical_id,join:landscape=landscape"`
+}
+
+// ShootAdvertisedAddress represents an address of the kube-apiserver of a
+// Gardener Shoot, as advertised in the Shoot status.
+type ShootAdvertisedAddress struct {
+	bun.BaseModel `bun:"table:g_shoot_advertised_address"`
+	coremodels.Model
+
+	TechnicalID string `bun:"technical_id,notnull,unique:g_shoot_advertised_address_key"`
+	Landscape   string `bun:"landscape,notnull,unique:g_shoot_advertised_address_key"`
+	Name        string `bun:"name,notnull,unique:g_shoot_advertised_address_key"`
+	URL         string `bun:"url,notnull"`
+	Host        string `bun:"host,notnull"`
+
+	// IP is set, if the host of the address is an IP address.
+	IP    net.IP `bun:"ip,nullzero"`
+	Shoot *Shoot `bun:"rel:has-one,join:technical_id=technical_id,join:landscape=landscape"`
+}
+
+// ShootHibernationSchedule represents a hibernation schedule from the spec of
+// a Gardener Shoot.
+type ShootHibernationSchedule struct {
+	bun.BaseModel `bun:"table:g_shoot_hibernation_schedule"`
+	coremodels.Model
+
+	TechnicalID string `bun:"technical_id,notnull,unique:g_shoot_hibernation_schedule_key"`
+	Landscape   string `bun:"landscape,notnull,unique:g_shoot_hibernation_schedule_key"`
+	Start       string `bun:"start_spec,notnull,unique:g_shoot_hibernation_schedule_key"`
+	End         string `bun:"end_spec,notnull,unique:g_shoot_hibernation_schedule_key"`
+	Location    string `bun:"location,notnull,unique:g_shoot_hibernation_schedule_key"`
+
+	// NextHibernationAt and NextWakeUpAt are the next times computed from
+	// the Start and End Cron specs. They are NULL, if the respective spec
+	// is not set, or cannot be evaluated.
+	NextHibernationAt time.Time `bun:"next_hibernation_at,nullzero"`
+	NextWakeUpAt      time.Time `bun:"next_wake_up_at,nullzero"`
+	Shoot             *Shoot    `bun:"rel:has-one,join:technical_id=technical_id,join:landscape=landscape"`
 }
 
 // Machine represents a Gardener machine
@@ -177,15 +260,45 @@ type Machine struct {
 	bun.BaseModel `bun:"table:g_machine"`
 	coremodels.Model
 
-	Name              string    `bun:"name,notnull,unique:g_machine_name_namespace_key"`
-	Namespace         string    `bun:"namespace,notnull,unique:g_machine_name_namespace_key"`
-	ProviderID        string    `bun:"provider_id,notnull"`
-	Status            string    `bun:"status,notnull"`
-	Node              string    `bun:"node,nullzero"`
-	SeedName          string    `bun:"seed_name,notnull"`
-	CreationTimestamp time.Time `bun:"creation_timestamp,nullzero"`
-	Seed              *Seed     `bun:"rel:has-one,join:seed_name=name"`
-	Shoot             *Shoot    `bun:"rel:has-one,join:namespace=technical_id"`
+	Name              string        `bun:"name,notnull,unique:g_machine_name_namespace_key"`
+	Namespace         string        `bun:"namespace,notnull,unique:g_machine_name_namespace_key"`
+	Landscape         string        `bun:"landscape,notnull,unique:g_machine_name_namespace_key"`
+	ProviderID        string        `bun:"provider_id,notnull"`
+	Status            string        `bun:"status,notnull"`
+	Node              string        `bun:"node,nullzero"`
+	SeedName          string        `bun:"seed_name,notnull"`
+	CreationTimestamp time.Time     `bun:"creation_timestamp,nullzero"`
+	MachineClassName  string        `bun:"machine_class,nullzero"`
+	Seed              *Seed         `bun:"rel:has-one,join:seed_name=name,join:landscape=landscape"`
+	Shoot             *Shoot        `bun:"rel:has-one,join:namespace=technical_id,join:landscape=landscape"`
+	MachineClass      *MachineClass `bun:"rel:has-one,join:machine_class=name,join:namespace=namespace,join:landscape=landscape"`
+}
+
+// MachineClass represents a MachineClass, which is referenced by a
+// MachineDeployment of a Gardener Shoot, and specifies the desired
+// configuration of the worker machines.
+type MachineClass struct {
+	bun.BaseModel `bun:"table:g_machine_class"`
+	coremodels.Model
+
+	Name              string          `bun:"name,notnull,unique:g_machine_class_key"`
+	Namespace         string          `bun:"namespace,notnull,unique:g_machine_class_key"`
+	Landscape         string          `bun:"landscape,notnull,unique:g_machine_class_key"`
+	SeedName          string          `bun:"seed_name,notnull"`
+	MachineDeployment string          `bun:"machine_deployment,notnull"`
+	Provider          string          `bun:"provider,nullzero"`
+	Region            string          `bun:"region,nullzero"`
+	Zone              string          `bun:"zone,nullzero"`
+	Architecture      string          `bun:"architecture,nullzero"`
+	MachineType       string          `bun:"machine_type,nullzero"`
+	Image             string          `bun:"image,nullzero"`
+	VolumeSize        int64           `bun:"volume_size,nullzero"`
+	VolumeType        string          `bun:"volume_type,nullzero"`
+	Network           string          `bun:"network,nullzero"`
+	ProviderSpec      json.RawMessage `bun:"provider_spec,type:jsonb,nullzero"`
+	CreationTimestamp time.Time       `bun:"creation_timestamp,nullzero"`
+	Seed              *Seed           `bun:"rel:has-one,join:seed_name=name,join:landscape=landscape"`
+	Shoot             *Shoot          `bun:"rel:has-one,join:namespace=technical_id,join:landscape=landscape"`
 }
 
 // BackupBucket represents a Gardener BackupBucket resource
@@ -193,14 +306,43 @@ type BackupBucket struct {
 	bun.BaseModel `bun:"table:g_backup_bucket"`
 	coremodels.Model
 
-	Name              string    `bun:"name,notnull,unique"`
-	ProviderType      string    `bun:"provider_type,notnull"`
-	RegionName        string    `bun:"region_name,notnull"`
-	State             string    `bun:"state,nullzero"`
-	StateProgress     int       `bun:"state_progress,nullzero"`
-	SeedName          string    `bun:"seed_name,notnull"`
-	CreationTimestamp time.Time `bun:"creation_timestamp,nullzero"`
-	Seed              *Seed     `bun:"rel:has-one,join:seed_name=name"`
+	Name               string         `bun:"name,notnull,unique:g_backup_bucket_name_key"`
+	Landscape          string         `bun:"landscape,notnull,unique:g_backup_bucket_name_key"`
+	ProviderType       string         `bun:"provider_type,notnull"`
+	RegionName         string         `bun:"region_name,notnull"`
+	State              string         `bun:"state,nullzero"`
+	StateProgress      int            `bun:"state_progress,nullzero"`
+	SeedName           string         `bun:"seed_name,notnull"`
+	Generation         int64          `bun:"generation,notnull"`
+	ObservedGeneration int64          `bun:"observed_generation,notnull"`
+	LastOperationAt    time.Time      `bun:"last_operation_at,nullzero"`
+	LastError          string         `bun:"last_error,nullzero"`
+	CreationTimestamp  time.Time      `bun:"creation_timestamp,nullzero"`
+	Seed               *Seed          `bun:"rel:has-one,join:seed_name=name,join:landscape=landscape"`
+	BackupEntries      []*BackupEntry `bun:"rel:has-many,join:name=bucket_name,join:landscape=landscape"`
+}
+
+// BackupEntry represents a Gardener BackupEntry resource, which tracks the etcd
+// backups of a shoot within a BackupBucket.
+type BackupEntry struct {
+	bun.BaseModel `bun:"table:g_backup_entry"`
+	coremodels.Model
+
+	Name               string        `bun:"name,notnull,unique:g_backup_entry_key"`
+	Namespace          string        `bun:"namespace,notnull,unique:g_backup_entry_key"`
+	Landscape          string        `bun:"landscape,notnull,unique:g_backup_entry_key"`
+	BucketName         string        `bun:"bucket_name,notnull"`
+	SeedName           string        `bun:"seed_name,nullzero"`
+	ShootName          string        `bun:"shoot_name,nullzero"`
+	Generation         int64         `bun:"generation,notnull"`
+	ObservedGeneration int64         `bun:"observed_generation,notnull"`
+	State              string        `bun:"state,nullzero"`
+	LastOperationType  string        `bun:"last_operation_type,nullzero"`
+	LastOperationAt    time.Time     `bun:"last_operation_at,nullzero"`
+	LastError          string        `bun:"last_error,nullzero"`
+	CreationTimestamp  time.Time     `bun:"creation_timestamp,nullzero"`
+	BackupBucket       *BackupBucket `bun:"rel:has-one,join:bucket_name=name,join:landscape=landscape"`
+	Shoot              *Shoot        `bun:"rel:has-one,join:namespace=namespace,join:shoot_name=name,join:landscape=landscape"`
 }
 
 // CloudProfile represents a Gardener CloudProfile resource
@@ -208,7 +350,8 @@ type CloudProfile struct {
 	bun.BaseModel `bun:"table:g_cloud_profile"`
 	coremodels.Model
 
-	Name              string    `bun:"name,notnull,unique"`
+	Name              string    `bun:"name,notnull,unique:g_cloud_profile_name_key"`
+	Landscape         string    `bun:"landscape,notnull,unique:g_cloud_profile_name_key"`
 	Type              string    `bun:"type,notnull"`
 	CreationTimestamp time.Time `bun:"creation_timestamp,nullzero"`
 }
@@ -226,7 +369,8 @@ type CloudProfileAWSImage struct {
 	AMI              string        `bun:"ami,notnull,unique:g_cloud_profile_aws_image_key"`
 	Architecture     string        `bun:"architecture,notnull"`
 	CloudProfileName string        `bun:"cloud_profile_name,notnull,unique:g_cloud_profile_aws_image_key"`
-	CloudProfile     *CloudProfile `bun:"rel:has-one,join:cloud_profile_name=name"`
+	Landscape        string        `bun:"landscape,notnull,unique:g_cloud_profile_aws_image_key"`
+	CloudProfile     *CloudProfile `bun:"rel:has-one,join:cloud_profile_name=name,join:landscape=landscape"`
 }
 
 // AWSImageToCloudProfile represents a link table connecting the CloudProfileAWSImage with CloudProfile.
@@ -248,7 +392,8 @@ type CloudProfileGCPImage struct {
 	Image            string        `bun:"image,notnull,unique:g_cloud_profile_gcp_image_key"`
 	Architecture     string        `bun:"architecture,notnull"`
 	CloudProfileName string        `bun:"cloud_profile_name,notnull,unique:g_cloud_profile_gcp_image_key"`
-	CloudProfile     *CloudProfile `bun:"rel:has-one,join:cloud_profile_name=name"`
+	Landscape        string        `bun:"landscape,notnull,unique:g_cloud_profile_gcp_image_key"`
+	CloudProfile     *CloudProfile `bun:"rel:has-one,join:cloud_profile_name=name,join:landscape=landscape"`
 }
 
 // GCPImageToCloudProfile represents a link table connecting the CloudProfileGCPImage with CloudProfile.
@@ -270,7 +415,8 @@ type CloudProfileAzureImage struct {
 	Architecture     string        `bun:"architecture,notnull,unique:g_cloud_profile_azure_image_key"`
 	CloudProfileName string        `bun:"cloud_profile_name,notnull,unique:g_cloud_profile_azure_image_key"`
 	ImageID          string        `bun:"image_id,notnull,unique:g_cloud_profile_azure_image_key"`
-	CloudProfile     *CloudProfile `bun:"rel:has-one,join:cloud_profile_name=name"`
+	Landscape        string        `bun:"landscape,notnull,unique:g_cloud_profile_azure_image_key"`
+	CloudProfile     *CloudProfile `bun:"rel:has-one,join:cloud_profile_name=name,join:landscape=landscape"`
 }
 
 // AzureImageToCloudProfile represents a link table connecting the CloudProfileAzureImage with CloudProfile.
@@ -293,7 +439,8 @@ type CloudProfileOpenStackImage struct {
 	ImageID          string        `bun:"image_id,notnull,unique:g_cloud_profile_openstack_image_key"`
 	Architecture     string        `bun:"architecture,notnull"`
 	CloudProfileName string        `bun:"cloud_profile_name,notnull,unique:g_cloud_profile_openstack_image_key"`
-	CloudProfile     *CloudProfile `bun:"rel:has-one,join:cloud_profile_name=name"`
+	Landscape        string        `bun:"landscape,notnull,unique:g_cloud_profile_openstack_image_key"`
+	CloudProfile     *CloudProfile `bun:"rel:has-one,join:cloud_profile_name=name,join:landscape=landscape"`
 }
 
 // OpenStackImageToCloudProfile represents a link table connecting the CloudProfileOpenStackImage with CloudProfile.
@@ -305,6 +452,85 @@ type OpenStackImageToCloudProfile struct {
 	CloudProfileID   uuid.UUID `bun:"cloud_profile_id,notnull,type:uuid,unique:l_g_openstack_image_to_cloud_profile_key"`
 }
 
+// CloudProfileImageVersion represents a version of a Machine Image listed in
+// a CloudProfile, along with its lifecycle classification.
+type CloudProfileImageVersion struct {
+	bun.BaseModel `bun:"table:g_cloud_profile_image_version"`
+	coremodels.Model
+
+	Name             string        `bun:"name,notnull,unique:g_cloud_profile_image_version_key"`
+	Version          string        `bun:"version,notnull,unique:g_cloud_profile_image_version_key"`
+	CloudProfileName string        `bun:"cloud_profile_name,notnull,unique:g_cloud_profile_image_version_key"`
+	Landscape        string        `bun:"landscape,notnull,unique:g_cloud_profile_image_version_key"`
+	Classification   string        `bun:"classification,nullzero"`
+	ExpirationDate   time.Time     `bun:"expiration_date,nullzero"`
+	CloudProfile     *CloudProfile `bun:"rel:has-one,join:cloud_profile_name=name,join:landscape=landscape"`
+}
+
+// CloudProfileK8sVersion represents a Kubernetes version offered by a
+// CloudProfile, along with its lifecycle classification.
+type CloudProfileK8sVersion struct {
+	bun.BaseModel `bun:"table:g_cloud_profile_k8s_version"`
+	coremodels.Model
+
+	Version          string        `bun:"version,notnull,unique:g_cloud_profile_k8s_version_key"`
+	CloudProfileName string        `bun:"cloud_profile_name,notnull,unique:g_cloud_profile_k8s_version_key"`
+	Landscape        string        `bun:"landscape,notnull,unique:g_cloud_profile_k8s_version_key"`
+	Classification   string        `bun:"classification,nullzero"`
+	ExpirationDate   time.Time     `bun:"expiration_date,nullzero"`
+	CloudProfile     *CloudProfile `bun:"rel:has-one,join:cloud_profile_name=name,join:landscape=landscape"`
+}
+
+// CloudProfileMachineType represents a machine type offered by a
+// CloudProfile.
+type CloudProfileMachineType struct {
+	bun.BaseModel `bun:"table:g_cloud_profile_machine_type"`
+	coremodels.Model
+
+	Name             string        `bun:"name,notnull,unique:g_cloud_profile_machine_type_key"`
+	CloudProfileName string        `bun:"cloud_profile_name,notnull,unique:g_cloud_profile_machine_type_key"`
+	Landscape        string        `bun:"landscape,notnull,unique:g_cloud_profile_machine_type_key"`
+	CPU              float64       `bun:"cpu,notnull"`
+	GPU              int64         `bun:"gpu,notnull"`
+	Memory           int64         `bun:"memory,notnull"`
+	Architecture     string        `bun:"architecture,nullzero"`
+	StorageClass     string        `bun:"storage_class,nullzero"`
+	StorageType      string        `bun:"storage_type,nullzero"`
+	StorageSize      int64         `bun:"storage_size,nullzero"`
+	Usable           bool          `bun:"usable,notnull"`
+	CloudProfile     *CloudProfile `bun:"rel:has-one,join:cloud_profile_name=name,join:landscape=landscape"`
+}
+
+// CloudProfileVolumeType represents a volume type offered by a CloudProfile.
+type CloudProfileVolumeType struct {
+	bun.BaseModel `bun:"table:g_cloud_profile_volume_type"`
+	coremodels.Model
+
+	Name             string        `bun:"name,notnull,unique:g_cloud_profile_volume_type_key"`
+	CloudProfileName string        `bun:"cloud_profile_name,notnull,unique:g_cloud_profile_volume_type_key"`
+	Landscape        string        `bun:"landscape,notnull,unique:g_cloud_profile_volume_type_key"`
+	Class            string        `bun:"class,nullzero"`
+	MinSize          int64         `bun:"min_size,nullzero"`
+	Usable           bool          `bun:"usable,notnull"`
+	CloudProfile     *CloudProfile `bun:"rel:has-one,join:cloud_profile_name=name,join:landscape=landscape"`
+}
+
+// CloudProfileZone represents an availability zone of a region offered by a
+// CloudProfile. Regions without zones are represented by a single item with an
+// empty zone.
+type CloudProfileZone struct {
+	bun.BaseModel `bun:"table:g_cloud_profile_zone"`
+	coremodels.Model
+
+	RegionName              string        `bun:"region_name,notnull,unique:g_cloud_profile_zone_key"`
+	Zone                    string        `bun:"zone,notnull,unique:g_cloud_profile_zone_key"`
+	CloudProfileName        string        `bun:"cloud_profile_name,notnull,unique:g_cloud_profile_zone_key"`
+	Landscape               string        `bun:"landscape,notnull,unique:g_cloud_profile_zone_key"`
+	UnavailableMachineTypes []string      `bun:"unavailable_machine_types,array,nullzero"`
+	UnavailableVolumeTypes  []string      `bun:"unavailable_volume_types,array,nullzero"`
+	CloudProfile            *CloudProfile `bun:"rel:has-one,join:cloud_profile_name=name,join:landscape=landscape"`
+}
+
 // PersistentVolume represents a Kubernetes PV in Gardener
 type PersistentVolume struct {
 	bun.BaseModel `bun:"table:g_persistent_volume"`
@@ -312,14 +538,16 @@ type PersistentVolume struct {
 
 	Name              string    `bun:"name,notnull,unique:g_persistent_volume_key"`
 	SeedName          string    `bun:"seed_name,notnull,unique:g_persistent_volume_key"`
+	Landscape         string    `bun:"landscape,notnull,unique:g_persistent_volume_key"`
 	Provider          string    `bun:"provider,nullzero"`
 	DiskRef           string    `bun:"disk_ref,nullzero"`
 	Status            string    `bun:"status,notnull"`
 	Capacity          string    `bun:"capacity,notnull"`
 	StorageClass      string    `bun:"storage_class,notnull"`
 	VolumeMode        string    `bun:"volume_mode,nullzero"`
+	ClaimNamespace    string    `bun:"claim_namespace,nullzero"`
 	CreationTimestamp time.Time `bun:"creation_timestamp,nullzero"`
-	Seed              *Seed     `bun:"rel:has-one,join:seed_name=name"`
+	Seed              *Seed     `bun:"rel:has-one,join:seed_name=name,join:landscape=landscape"`
 }
 
 // DNSRecord represents a Gardener DNSRecord resource
@@ -331,6 +559,7 @@ type DNSRecord struct {
 	Namespace         string    `bun:"namespace,notnull,unique:g_dns_record_key"`
 	SeedName          string    `bun:"seed_name,notnull,unique:g_dns_record_key"`
 	Value             string    `bun:"value,notnull,unique:g_dns_record_key"`
+	Landscape         string    `bun:"landscape,notnull,unique:g_dns_record_key"`
 	RecordType        string    `bun:"record_type,notnull"`
 	ProviderType      string    `bun:"provider_type"`
 	FQDN              string    `bun:"fqdn,notnull"`
@@ -338,7 +567,7 @@ type DNSRecord struct {
 	Region            string    `bun:"region,nullzero"`
 	DNSZone           string    `bun:"dns_zone,notnull"`
 	CreationTimestamp time.Time `bun:"creation_timestamp,nullzero"`
-	Seed              *Seed     `bun:"rel:has-one,join:seed_name=name"`
+	Seed              *Seed     `bun:"rel:has-one,join:seed_name=name,join:landscape=landscape"`
 }
 
 // DNSEntry represents a Gardener DNSEntry resource
@@ -350,13 +579,14 @@ type DNSEntry struct {
 	Namespace         string    `bun:"namespace,notnull,unique:g_dns_entry_key"`
 	SeedName          string    `bun:"seed_name,notnull,unique:g_dns_entry_key"`
 	Value             string    `bun:"value,notnull,unique:g_dns_entry_key"`
+	Landscape         string    `bun:"landscape,notnull,unique:g_dns_entry_key"`
 	FQDN              string    `bun:"fqdn,notnull"`
 	TTL               *int64    `bun:"ttl"`
 	DNSZone           string    `bun:"dns_zone,notnull"`
 	ProviderType      string    `bun:"provider_type,notnull"`
 	Provider          string    `bun:"provider,notnull"`
 	CreationTimestamp time.Time `bun:"creation_timestamp,nullzero"`
-	Seed              *Seed     `bun:"rel:has-one,join:seed_name=name"`
+	Seed              *Seed     `bun:"rel:has-one,join:seed_name=name,join:landscape=landscape"`
 }
 
 // Bastion represents a Gardener Bastion instance
@@ -367,9 +597,36 @@ type Bastion struct {
 	Name      string `bun:"name,notnull,unique:g_bastion_key"`
 	Namespace string `bun:"namespace,notnull,unique:g_bastion_key"`
 	SeedName  string `bun:"seed_name,notnull,unique:g_bastion_key"`
+	Landscape string `bun:"landscape,notnull,unique:g_bastion_key"`
 	IP        net.IP `bun:"ip,nullzero"`
 	Hostname  string `bun:"hostname,nullzero"`
-	Seed      *Seed  `bun:"rel:has-one,join:seed_name=name"`
+	Seed      *Seed  `bun:"rel:has-one,join:seed_name=name,join:landscape=landscape"`
+}
+
+// ResourceQuota represents the limit and usage of a single resource from a
+// Kubernetes ResourceQuota in a Gardener project namespace, e.g. the number of
+// shoots, which may be created in the project.
+type ResourceQuota struct {
+	bun.BaseModel `bun:"table:g_resource_quota"`
+	coremodels.Model
+
+	Name        string   `bun:"name,notnull,unique:g_resource_quota_key"`
+	Namespace   string   `bun:"namespace,notnull,unique:g_resource_quota_key"`
+	Resource    string   `bun:"resource,notnull,unique:g_resource_quota_key"`
+	Landscape   string   `bun:"landscape,notnull,unique:g_resource_quota_key"`
+	ProjectName string   `bun:"project_name,notnull"`
+	Limit       float64  `bun:"quota_limit,notnull"`
+	Usage       float64  `bun:"usage,notnull"`
+	Project     *Project `bun:"rel:has-one,join:project_name=name,join:landscape=landscape"`
+}
+
+// accountLinks maps the models, which reference a Gardener project, to the
+// column holding the reference.
+var accountLinks = map[string]string{
+	ProjectModelName:       "name",
+	ProjectMemberModelName: "project_name",
+	ShootModelName:         "project_name",
+	ResourceQuotaModelName: "project_name",
 }
 
 // init registers the models with the [registry.ModelRegistry]
@@ -377,4 +634,17 @@ func init() {
 	for k, v := range models {
 		registry.ModelRegistry.MustRegister(k, v)
 	}
+
+	registry.AccountSourceRegistry.MustRegister("g", registry.AccountSource{
+		ModelName:  ProjectModelName,
+		IDColumn:   "name",
+		NameColumn: "name",
+	})
+
+	for k, v := range accountLinks {
+		registry.AccountLinkRegistry.MustRegister(k, registry.AccountLink{
+			Provider: "g",
+			Column:   v,
+		})
+	}
 }
@@ -61,7 +61,7 @@ func collectAWSMachineImages(ctx context.Context, payload CollectCPMachineImages
 	}
 
 	logger := asynqutils.GetLogger(ctx)
-	logger.Info("collecting machine images", "cloud_profile", payload.CloudProfileName)
+	logger.Info("collecting machine images", "cloud_profile", payload.CloudProfileName, "landscape", payload.Landscape)
 	items := make([]models.CloudProfileAWSImage, 0)
 
 	for _, image := range images {
@@ -74,6 +74,7 @@ func collectAWSMachineImages(ctx context.Context, payload CollectCPMachineImages
 					AMI:              region.AMI,
 					Architecture:     ptr.Value(region.Architecture, ""),
 					CloudProfileName: payload.CloudProfileName,
+					Landscape:        payload.Landscape,
 				}
 
 				items = append(items, item)
@@ -89,7 +90,7 @@ func collectAWSMachineImages(ctx context.Context, payload CollectCPMachineImages
 
 	out, err := db.DB.NewInsert().
 		Model(&items).
-		On("CONFLICT (name, ami, version, region_name, cloud_profile_name) DO UPDATE").
+		On("CONFLICT (name, ami, version, region_name, cloud_profile_name, landscape) DO UPDATE").
 		Set("architecture = EXCLUDED.architecture").
 		Set("updated_at = EXCLUDED.updated_at").
 		Returning("id").
@@ -61,7 +61,7 @@ func collectAzureMachineImages(ctx context.Context, payload CollectCPMachineImag
 	}
 
 	logger := asynqutils.GetLogger(ctx)
-	logger.Info("collecting machine images", "cloud_profile", payload.CloudProfileName)
+	logger.Info("collecting machine images", "cloud_profile", payload.CloudProfileName, "landscape", payload.Landscape)
 	items := make([]models.CloudProfileAzureImage, 0)
 
 	for _, image := range images {
@@ -82,6 +82,7 @@ func collectAzureMachineImages(ctx context.Context, payload CollectCPMachineImag
 				ImageID:          imageID,
 				Architecture:     ptr.Value(version.Architecture, ""),
 				CloudProfileName: payload.CloudProfileName,
+				Landscape:        payload.Landscape,
 			}
 
 			items = append(items, item)
@@ -96,7 +97,7 @@ func collectAzureMachineImages(ctx context.Context, payload CollectCPMachineImag
 
 	out, err := db.DB.NewInsert().
 		Model(&items).
-		On("CONFLICT (name, architecture, version, cloud_profile_name, image_id) DO UPDATE").
+		On("CONFLICT (name, architecture, version, cloud_profile_name, image_id, landscape) DO UPDATE").
 		Set("updated_at = EXCLUDED.updated_at").
 		Returning("id").
 		Exec(ctx)
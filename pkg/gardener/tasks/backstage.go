@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"strings"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/gardener/inventory/pkg/backstage"
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/exporter"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// TaskExportBackstageCatalog is the name of the task for exporting the
+// projects, seeds and shoots as Backstage catalog entities.
+const TaskExportBackstageCatalog = "g:task:export-backstage-catalog"
+
+// backstageCatalogObject is the name of the object, which holds the exported
+// catalog entities.
+const backstageCatalogObject = "catalog-info.yaml"
+
+// ExportBackstageCatalogPayload is the payload used for exporting the
+// Backstage catalog entities.
+type ExportBackstageCatalogPayload struct {
+	// Landscape specifies the Gardener landscape to export. If not
+	// specified, all landscapes are exported.
+	Landscape string `yaml:"landscape" json:"landscape" desc:"The Gardener landscape to export, or all landscapes if not specified" example:"live"`
+
+	// Owner specifies the entity reference of the owner of entities
+	// without an owner, e.g. seeds. If not specified,
+	// [backstage.DefaultOwner] is used.
+	Owner string `yaml:"owner" json:"owner" desc:"The owner of entities without an owner, e.g. seeds" example:"group:gardener"`
+
+	// Destination specifies the bucket, to which the catalog is uploaded.
+	Destination exporter.Destination `yaml:"destination" json:"destination" desc:"The bucket, to which the catalog is uploaded"`
+}
+
+// HandleExportBackstageCatalogTask is the handler, which renders the Gardener
+// projects, seeds and shoots as Backstage catalog entities, and uploads them
+// as a single catalog-info.yaml object to the configured bucket. The object
+// is replaced on each run, so that it may be registered as a location in
+// Backstage.
+func HandleExportBackstageCatalogTask(ctx context.Context, task *asynq.Task) error {
+	var payload ExportBackstageCatalogPayload
+	if err := asynqutils.Unmarshal(task.Payload(), &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	uploader, err := exporter.NewUploader(payload.Destination)
+	if err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	opts := backstage.Options{
+		Landscape: payload.Landscape,
+		Owner:     payload.Owner,
+	}
+	entities, err := backstage.ListEntities(ctx, db.DB, opts)
+	if err != nil {
+		logger.Error("failed to list backstage entities", "reason", err)
+
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := backstage.Encode(&buf, entities); err != nil {
+		return err
+	}
+
+	key := path.Join(strings.Trim(payload.Destination.Prefix, "/"), backstageCatalogObject)
+	if err := uploader.Upload(ctx, key, &buf, backstage.ContentType); err != nil {
+		logger.Error("failed to upload backstage catalog", "key", key, "reason", err)
+
+		return err
+	}
+
+	logger.Info("exported backstage catalog", "key", key, "count", len(entities))
+
+	return nil
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// TaskReportBackupFreshness is the name of the task for reporting the shoots,
+// whose etcd backups are stale.
+const TaskReportBackupFreshness = "g:task:report-backup-freshness"
+
+// DefaultBackupMaxAge is the default duration since the last operation of a
+// BackupEntry, after which the etcd backups of its shoot are considered stale.
+const DefaultBackupMaxAge = 48 * time.Hour
+
+// Reasons for which a shoot is reported by the [TaskReportBackupFreshness]
+// task.
+const (
+	backupReasonMissing  = "missing"
+	backupReasonFailed   = "failed"
+	backupReasonPending  = "pending"
+	backupReasonOutdated = "outdated"
+)
+
+// ReportBackupFreshnessPayload is the payload used for reporting the freshness
+// of etcd backups.
+type ReportBackupFreshnessPayload struct {
+	// MaxAge specifies the duration since the last operation of a
+	// BackupEntry, after which the etcd backups of its shoot are
+	// considered stale.
+	MaxAge time.Duration `yaml:"max_age" json:"max_age" desc:"The duration since the last operation of a BackupEntry, after which the etcd backups of its shoot are considered stale" example:"48h"`
+}
+
+// NewReportBackupFreshnessTask creates a new [asynq.Task] for reporting the
+// freshness of etcd backups, without specifying a payload.
+func NewReportBackupFreshnessTask() *asynq.Task {
+	return asynq.NewTask(TaskReportBackupFreshness, nil)
+}
+
+// HandleReportBackupFreshnessTask is the handler, which reports the number of
+// shoots per seed, whose etcd backups are stale. A shoot is reported when it
+// has no BackupEntry, when the last operation of its BackupEntry failed, when
+// its BackupEntry has not been reconciled since its last change, or when the
+// last operation is older than the configured max age.
+//
+// The BackupEntry of each shoot is provided by the g_shoot_backup_freshness
+// view.
+func HandleReportBackupFreshnessTask(ctx context.Context, task *asynq.Task) error {
+	payload := ReportBackupFreshnessPayload{
+		MaxAge: DefaultBackupMaxAge,
+	}
+	if data := task.Payload(); data != nil {
+		if err := asynqutils.Unmarshal(data, &payload); err != nil {
+			return asynqutils.SkipRetry(err)
+		}
+	}
+
+	if payload.MaxAge <= 0 {
+		payload.MaxAge = DefaultBackupMaxAge
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+
+	var rows []struct {
+		Landscape string `bun:"landscape"`
+		SeedName  string `bun:"seed_name"`
+		Reason    string `bun:"reason"`
+		Count     int64  `bun:"count"`
+	}
+
+	query := `SELECT landscape, seed_name, reason, count(*) AS count FROM (
+SELECT landscape, seed_name, CASE
+WHEN backup_entry IS NULL THEN ?
+WHEN state = 'Failed' OR last_error IS NOT NULL THEN ?
+WHEN observed_generation < generation THEN ?
+WHEN last_operation_at IS NULL OR last_operation_at < ? THEN ?
+END AS reason
+FROM g_shoot_backup_freshness
+) AS b WHERE reason IS NOT NULL GROUP BY landscape, seed_name, reason`
+
+	err := db.DB.NewRaw(
+		query,
+		backupReasonMissing,
+		backupReasonFailed,
+		backupReasonPending,
+		time.Now().Add(-payload.MaxAge),
+		backupReasonOutdated,
+	).Scan(ctx, &rows)
+
+	if err != nil {
+		logger.Error("failed to report backup freshness", "reason", err)
+
+		return err
+	}
+
+	for _, row := range rows {
+		metric := prometheus.MustNewConstMetric(
+			staleBackupsDesc,
+			prometheus.GaugeValue,
+			float64(row.Count),
+			row.Landscape,
+			row.SeedName,
+			row.Reason,
+		)
+		key := metrics.Key(TaskReportBackupFreshness, row.Landscape, row.SeedName, row.Reason)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}
+
+	logger.Info(
+		"reported backup freshness",
+		"max_age", payload.MaxAge,
+		"count", len(rows),
+	)
+
+	return nil
+}
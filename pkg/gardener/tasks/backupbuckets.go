@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/hibiken/asynq"
@@ -37,10 +38,18 @@ func NewCollectBackupBucketsTask() *asynq.Task {
 }
 
 // HandleCollectBackupBucketsTask is the handler for collecting BackupBuckets.
-func HandleCollectBackupBucketsTask(ctx context.Context, _ *asynq.Task) error {
+// The BackupBuckets are collected from the primary Gardener landscape, unless a
+// landscape is specified via the [LandscapePayload].
+func HandleCollectBackupBucketsTask(ctx context.Context, t *asynq.Task) error {
+	payload, err := getLandscapePayload(t)
+	if err != nil {
+		return err
+	}
+
 	logger := asynqutils.GetLogger(ctx)
-	if !gardenerclient.IsDefaultClientSet() {
-		logger.Warn("gardener client not configured")
+	gardenClient, ok := gardenerclient.GetClient(payload.Landscape)
+	if !ok {
+		logger.Warn("gardener client not configured", "landscape", payload.Landscape)
 
 		return nil
 	}
@@ -51,20 +60,23 @@ func HandleCollectBackupBucketsTask(ctx context.Context, _ *asynq.Task) error {
 			backupBucketsDesc,
 			prometheus.GaugeValue,
 			float64(count),
+			payload.Landscape,
 		)
-		metrics.DefaultCollector.AddMetric(TaskCollectBackupBuckets, metric)
+		key := metrics.Key(TaskCollectBackupBuckets, payload.Landscape)
+		metrics.DefaultCollector.AddMetric(key, metric)
 	}()
 
-	client := gardenerclient.DefaultClient.GardenClient()
-	logger.Info("Collecting Gardener backup buckets")
+	client := gardenClient.GardenClient()
+	logger.Info("Collecting Gardener backup buckets", "landscape", payload.Landscape)
 	buckets := make([]models.BackupBucket, 0)
 	p := pager.New(
 		pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+			metrics.IncPages(ctx)
 			return client.CoreV1beta1().BackupBuckets().List(ctx, opts)
 		}),
 	)
 	opts := metav1.ListOptions{Limit: constants.PageSize}
-	err := p.EachListItem(ctx, opts, func(obj runtime.Object) error {
+	err = p.EachListItem(ctx, opts, func(obj runtime.Object) error {
 		b, ok := obj.(*v1beta1.BackupBucket)
 		if !ok {
 			return fmt.Errorf("unexpected object type: %T", obj)
@@ -72,19 +84,31 @@ func HandleCollectBackupBucketsTask(ctx context.Context, _ *asynq.Task) error {
 
 		var state string
 		var stateProgress int
+		var lastOperationAt time.Time
 		if b.Status.LastOperation != nil {
 			state = string(b.Status.LastOperation.State)
 			stateProgress = int(b.Status.LastOperation.Progress)
+			lastOperationAt = b.Status.LastOperation.LastUpdateTime.Time
+		}
+
+		var lastError string
+		if b.Status.LastError != nil {
+			lastError = b.Status.LastError.Description
 		}
 
 		item := models.BackupBucket{
-			Name:              b.GetName(),
-			SeedName:          ptr.StringFromPointer(b.Spec.SeedName),
-			ProviderType:      b.Spec.Provider.Type,
-			RegionName:        b.Spec.Provider.Region,
-			State:             state,
-			StateProgress:     stateProgress,
-			CreationTimestamp: b.CreationTimestamp.Time,
+			Name:               b.GetName(),
+			Landscape:          gardenClient.Landscape(),
+			SeedName:           ptr.StringFromPointer(b.Spec.SeedName),
+			ProviderType:       b.Spec.Provider.Type,
+			RegionName:         b.Spec.Provider.Region,
+			State:              state,
+			StateProgress:      stateProgress,
+			Generation:         b.Generation,
+			ObservedGeneration: b.Status.ObservedGeneration,
+			LastOperationAt:    lastOperationAt,
+			LastError:          lastError,
+			CreationTimestamp:  b.CreationTimestamp.Time,
 		}
 		buckets = append(buckets, item)
 
@@ -101,12 +125,16 @@ func HandleCollectBackupBucketsTask(ctx context.Context, _ *asynq.Task) error {
 
 	out, err := db.DB.NewInsert().
 		Model(&buckets).
-		On("CONFLICT (name) DO UPDATE").
+		On("CONFLICT (name, landscape) DO UPDATE").
 		Set("provider_type = EXCLUDED.provider_type").
 		Set("region_name = EXCLUDED.region_name").
 		Set("seed_name = EXCLUDED.seed_name").
 		Set("state = EXCLUDED.state").
 		Set("state_progress = EXCLUDED.state_progress").
+		Set("generation = EXCLUDED.generation").
+		Set("observed_generation = EXCLUDED.observed_generation").
+		Set("last_operation_at = EXCLUDED.last_operation_at").
+		Set("last_error = EXCLUDED.last_error").
 		Set("creation_timestamp = EXCLUDED.creation_timestamp").
 		Set("updated_at = EXCLUDED.updated_at").
 		Returning("id").
@@ -126,7 +154,7 @@ func HandleCollectBackupBucketsTask(ctx context.Context, _ *asynq.Task) error {
 		return err
 	}
 
-	logger.Info("populated gardener backup buckets", "count", count)
+	logger.Info("populated gardener backup buckets", "landscape", payload.Landscape, "count", count)
 
 	return nil
 }
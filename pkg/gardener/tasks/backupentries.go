@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/pager"
+
+	"github.com/gardener/inventory/pkg/clients/db"
+	gardenerclient "github.com/gardener/inventory/pkg/clients/gardener"
+	"github.com/gardener/inventory/pkg/gardener/constants"
+	"github.com/gardener/inventory/pkg/gardener/models"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/ptr"
+)
+
+const (
+	// TaskCollectBackupEntries is the name of the task for collecting
+	// Gardener BackupEntries resources.
+	TaskCollectBackupEntries = "g:task:collect-backup-entries"
+)
+
+// NewCollectBackupEntriesTask creates a new [asynq.Task] for collecting
+// Gardener BackupEntries, without specifying a payload.
+func NewCollectBackupEntriesTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectBackupEntries, nil)
+}
+
+// HandleCollectBackupEntriesTask is the handler for collecting BackupEntries.
+// The BackupEntries are collected from the primary Gardener landscape, unless
+// a landscape is specified via the [LandscapePayload].
+func HandleCollectBackupEntriesTask(ctx context.Context, t *asynq.Task) error {
+	payload, err := getLandscapePayload(t)
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	gardenClient, ok := gardenerclient.GetClient(payload.Landscape)
+	if !ok {
+		logger.Warn("gardener client not configured", "landscape", payload.Landscape)
+
+		return nil
+	}
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			backupEntriesDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.Landscape,
+		)
+		key := metrics.Key(TaskCollectBackupEntries, payload.Landscape)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	client := gardenClient.GardenClient()
+	logger.Info("Collecting Gardener backup entries", "landscape", payload.Landscape)
+	entries := make([]models.BackupEntry, 0)
+	p := pager.New(
+		pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+			metrics.IncPages(ctx)
+			return client.CoreV1beta1().BackupEntries("").List(ctx, opts)
+		}),
+	)
+	opts := metav1.ListOptions{Limit: constants.PageSize}
+	err = p.EachListItem(ctx, opts, func(obj runtime.Object) error {
+		e, ok := obj.(*v1beta1.BackupEntry)
+		if !ok {
+			return fmt.Errorf("unexpected object type: %T", obj)
+		}
+
+		var state, operationType string
+		var lastOperationAt time.Time
+		if e.Status.LastOperation != nil {
+			state = string(e.Status.LastOperation.State)
+			operationType = string(e.Status.LastOperation.Type)
+			lastOperationAt = e.Status.LastOperation.LastUpdateTime.Time
+		}
+
+		var lastError string
+		if e.Status.LastError != nil {
+			lastError = e.Status.LastError.Description
+		}
+
+		// BackupEntries are owned by the Shoot, whose etcd backups
+		// they track.
+		var shootName string
+		for _, ref := range e.OwnerReferences {
+			if ref.Kind == "Shoot" {
+				shootName = ref.Name
+
+				break
+			}
+		}
+
+		item := models.BackupEntry{
+			Name:               e.Name,
+			Namespace:          e.Namespace,
+			Landscape:          gardenClient.Landscape(),
+			BucketName:         e.Spec.BucketName,
+			SeedName:           ptr.StringFromPointer(e.Spec.SeedName),
+			ShootName:          shootName,
+			Generation:         e.Generation,
+			ObservedGeneration: e.Status.ObservedGeneration,
+			State:              state,
+			LastOperationType:  operationType,
+			LastOperationAt:    lastOperationAt,
+			LastError:          lastError,
+			CreationTimestamp:  e.CreationTimestamp.Time,
+		}
+		entries = append(entries, item)
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not list backup entries: %w", err)
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&entries).
+		On("CONFLICT (name, namespace, landscape) DO UPDATE").
+		Set("bucket_name = EXCLUDED.bucket_name").
+		Set("seed_name = EXCLUDED.seed_name").
+		Set("shoot_name = EXCLUDED.shoot_name").
+		Set("generation = EXCLUDED.generation").
+		Set("observed_generation = EXCLUDED.observed_generation").
+		Set("state = EXCLUDED.state").
+		Set("last_operation_type = EXCLUDED.last_operation_type").
+		Set("last_operation_at = EXCLUDED.last_operation_at").
+		Set("last_error = EXCLUDED.last_error").
+		Set("creation_timestamp = EXCLUDED.creation_timestamp").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert gardener backup entries into db",
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info("populated gardener backup entries", "landscape", payload.Landscape, "count", count)
+
+	return nil
+}
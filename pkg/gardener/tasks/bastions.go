@@ -41,7 +41,12 @@ const (
 type CollectBastionsPayload struct {
 	// Seed is the name of the seed cluster from which to collect Gardener
 	// Bastions.
-	Seed string `json:"seed" yaml:"seed"`
+	Seed string `json:"seed" yaml:"seed" desc:"The name of the seed cluster from which to collect Gardener Bastions" example:"aws-ha"`
+
+	// Landscape specifies the name of the Gardener landscape of the seed
+	// cluster. If no seed is specified, tasks for collecting from all
+	// known seeds of the landscape are enqueued.
+	Landscape string `json:"landscape" yaml:"landscape" desc:"The name of the Gardener landscape of the seed cluster" example:"canary"`
 }
 
 // NewCollectBastionsTask creates a new [asynq.Task] for collecting Gardener
@@ -56,7 +61,7 @@ func HandleCollectBastionsTask(ctx context.Context, t *asynq.Task) error {
 	// collecting Bastions from all known Gardener Seed clusters.
 	data := t.Payload()
 	if data == nil {
-		return enqueueCollectBastions(ctx)
+		return enqueueCollectBastions(ctx, "")
 	}
 
 	var payload CollectBastionsPayload
@@ -65,6 +70,10 @@ func HandleCollectBastionsTask(ctx context.Context, t *asynq.Task) error {
 	}
 
 	if payload.Seed == "" {
+		if payload.Landscape != "" {
+			return enqueueCollectBastions(ctx, payload.Landscape)
+		}
+
 		return asynqutils.SkipRetry(ErrNoSeedCluster)
 	}
 
@@ -73,8 +82,8 @@ func HandleCollectBastionsTask(ctx context.Context, t *asynq.Task) error {
 
 // enqueueCollectBastions enqueues tasks for collecting Gardener Bastions from
 // all known seed clusters.
-func enqueueCollectBastions(ctx context.Context) error {
-	seeds, err := gutils.GetSeedsFromDB(ctx)
+func enqueueCollectBastions(ctx context.Context, landscape string) error {
+	seeds, err := gutils.GetSeedsFromDB(ctx, landscape)
 	if err != nil {
 		return fmt.Errorf("failed to get seeds from db: %w", err)
 	}
@@ -85,7 +94,8 @@ func enqueueCollectBastions(ctx context.Context) error {
 	// Create a task for each known seed cluster
 	for _, s := range seeds {
 		payload := CollectBastionsPayload{
-			Seed: s.Name,
+			Seed:      s.Name,
+			Landscape: landscape,
 		}
 		data, err := json.Marshal(payload)
 		if err != nil {
@@ -99,7 +109,17 @@ func enqueueCollectBastions(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectBastions, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"seed", s.Name,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -128,8 +148,9 @@ func enqueueCollectBastions(ctx context.Context) error {
 func collectBastions(ctx context.Context, payload CollectBastionsPayload) error {
 	logger := asynqutils.GetLogger(ctx)
 
-	if !gardenerclient.IsDefaultClientSet() {
-		logger.Warn("gardener client not configured")
+	gardenClient, ok := gardenerclient.GetClient(payload.Landscape)
+	if !ok {
+		logger.Warn("gardener client not configured", "landscape", payload.Landscape)
 
 		return nil
 	}
@@ -140,15 +161,16 @@ func collectBastions(ctx context.Context, payload CollectBastionsPayload) error
 			bastionsDesc,
 			prometheus.GaugeValue,
 			float64(count),
+			payload.Landscape,
 			payload.Seed,
 		)
-		key := metrics.Key(TaskCollectBastions, payload.Seed)
+		key := metrics.Key(TaskCollectBastions, payload.Landscape, payload.Seed)
 		metrics.DefaultCollector.AddMetric(key, metric)
 	}()
 
 	logger.Info("collecting Gardener bastions", "seed", payload.Seed)
 
-	restConfig, err := gardenerclient.DefaultClient.SeedRestConfig(ctx, payload.Seed)
+	restConfig, err := gardenClient.SeedRestConfig(ctx, payload.Seed)
 	if err != nil {
 		if errors.Is(err, gardenerclient.ErrSeedIsExcluded) {
 			// Don't treat excluded seeds as errors, in order to
@@ -175,6 +197,7 @@ func collectBastions(ctx context.Context, payload CollectBastionsPayload) error
 	}
 
 	p := pager.New(func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		metrics.IncPages(ctx)
 		var result extensionsv1alpha1.BastionList
 
 		listOpts := crtclient.ListOptions{
@@ -218,6 +241,7 @@ func collectBastions(ctx context.Context, payload CollectBastionsPayload) error
 			Name:      b.Name,
 			Namespace: b.Namespace,
 			SeedName:  payload.Seed,
+			Landscape: gardenClient.Landscape(),
 			IP:        ip,
 			Hostname:  hostname,
 		}
@@ -242,7 +266,7 @@ func collectBastions(ctx context.Context, payload CollectBastionsPayload) error
 
 	out, err := db.DB.NewInsert().
 		Model(&items).
-		On("CONFLICT (name, namespace, seed_name) DO UPDATE").
+		On("CONFLICT (name, namespace, seed_name, landscape) DO UPDATE").
 		Set("ip = EXCLUDED.ip").
 		Set("hostname = EXCLUDED.hostname").
 		Set("updated_at = EXCLUDED.updated_at").
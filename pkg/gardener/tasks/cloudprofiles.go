@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	gardenerv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -23,6 +24,7 @@ import (
 	"github.com/gardener/inventory/pkg/gardener/models"
 	"github.com/gardener/inventory/pkg/metrics"
 	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/ptr"
 )
 
 const (
@@ -45,10 +47,14 @@ const (
 type CollectCPMachineImagesPayload struct {
 	// ProviderConfig is the raw config, which is specific for each Cloud
 	// Profile.
-	ProviderConfig []byte `json:"provider_config" yaml:"provider_config"`
+	ProviderConfig []byte `json:"provider_config" yaml:"provider_config" desc:"The raw config, which is specific for each Cloud Profile"`
 
 	// CloudProfileName is the name of the Cloud Profile.
-	CloudProfileName string `json:"cloud_profile_name" yaml:"cloud_profile_name"`
+	CloudProfileName string `json:"cloud_profile_name" yaml:"cloud_profile_name" desc:"The name of the Cloud Profile" example:"aws"`
+
+	// Landscape is the name of the Gardener landscape of the Cloud
+	// Profile.
+	Landscape string `json:"landscape" yaml:"landscape" desc:"The name of the Gardener landscape of the Cloud Profile" example:"canary"`
 }
 
 // NewCollectCloudProfilesTask creates a new [asynq.Task] for collecting
@@ -59,11 +65,20 @@ func NewCollectCloudProfilesTask() *asynq.Task {
 
 // HandleCollectCloudProfilesTask is the handler for collecting Gardener Cloud
 // Profiles. This handler will also enqueue tasks for collecting and persisting
-// the machine images for each supported Cloud Profile type.
-func HandleCollectCloudProfilesTask(ctx context.Context, _ *asynq.Task) error {
+// the machine images for each supported Cloud Profile type. The Cloud Profiles
+// are collected from the primary Gardener landscape, unless a landscape is
+// specified via the [LandscapePayload].
+func HandleCollectCloudProfilesTask(ctx context.Context, t *asynq.Task) error {
+	landscapePayload, err := getLandscapePayload(t)
+	if err != nil {
+		return err
+	}
+
+	landscape := landscapePayload.Landscape
 	logger := asynqutils.GetLogger(ctx)
-	if !gardenerclient.IsDefaultClientSet() {
-		logger.Warn("gardener client not configured")
+	gardenClient, ok := gardenerclient.GetClient(landscape)
+	if !ok {
+		logger.Warn("gardener client not configured", "landscape", landscape)
 
 		return nil
 	}
@@ -74,8 +89,10 @@ func HandleCollectCloudProfilesTask(ctx context.Context, _ *asynq.Task) error {
 			cloudProfilesDesc,
 			prometheus.GaugeValue,
 			float64(count),
+			landscape,
 		)
-		metrics.DefaultCollector.AddMetric(TaskCollectCloudProfiles, metric)
+		key := metrics.Key(TaskCollectCloudProfiles, landscape)
+		metrics.DefaultCollector.AddMetric(key, metric)
 	}()
 
 	// After collecting the Cloud Profiles we will enqueue a separate task
@@ -90,17 +107,23 @@ func HandleCollectCloudProfilesTask(ctx context.Context, _ *asynq.Task) error {
 		cpProviderTypeOpenStack: TaskCollectOpenStackMachineImages,
 	}
 
-	client := gardenerclient.DefaultClient.GardenClient()
-	logger.Info("collecting Gardener cloud profiles")
+	client := gardenClient.GardenClient()
+	logger.Info("collecting Gardener cloud profiles", "landscape", landscape)
 	cloudProfiles := make([]models.CloudProfile, 0)
+	imageVersions := make([]models.CloudProfileImageVersion, 0)
+	k8sVersions := make([]models.CloudProfileK8sVersion, 0)
+	machineTypes := make([]models.CloudProfileMachineType, 0)
+	volumeTypes := make([]models.CloudProfileVolumeType, 0)
+	zones := make([]models.CloudProfileZone, 0)
 	p := pager.New(
 		pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+			metrics.IncPages(ctx)
 			return client.CoreV1beta1().CloudProfiles().List(ctx, opts)
 		}),
 	)
 	opts := metav1.ListOptions{Limit: constants.PageSize}
 	queue := asynqutils.GetQueueName(ctx)
-	err := p.EachListItem(ctx, opts, func(obj runtime.Object) error {
+	err = p.EachListItem(ctx, opts, func(obj runtime.Object) error {
 		cp, ok := obj.(*gardenerv1beta1.CloudProfile)
 		if !ok {
 			return fmt.Errorf("unexpected object type: %T", obj)
@@ -110,11 +133,101 @@ func HandleCollectCloudProfilesTask(ctx context.Context, _ *asynq.Task) error {
 		providerConfig := cp.Spec.ProviderConfig
 		item := models.CloudProfile{
 			Name:              cp.Name,
+			Landscape:         gardenClient.Landscape(),
 			Type:              providerType,
 			CreationTimestamp: cp.CreationTimestamp.Time,
 		}
 		cloudProfiles = append(cloudProfiles, item)
 
+		for _, image := range cp.Spec.MachineImages {
+			for _, version := range image.Versions {
+				iv := models.CloudProfileImageVersion{
+					Name:             image.Name,
+					Version:          version.Version,
+					CloudProfileName: cp.Name,
+					Landscape:        gardenClient.Landscape(),
+				}
+				if version.Classification != nil {
+					iv.Classification = string(*version.Classification)
+				}
+				if version.ExpirationDate != nil {
+					iv.ExpirationDate = version.ExpirationDate.Time
+				}
+				imageVersions = append(imageVersions, iv)
+			}
+		}
+
+		for _, version := range cp.Spec.Kubernetes.Versions {
+			kv := models.CloudProfileK8sVersion{
+				Version:          version.Version,
+				CloudProfileName: cp.Name,
+				Landscape:        gardenClient.Landscape(),
+			}
+			if version.Classification != nil {
+				kv.Classification = string(*version.Classification)
+			}
+			if version.ExpirationDate != nil {
+				kv.ExpirationDate = version.ExpirationDate.Time
+			}
+			k8sVersions = append(k8sVersions, kv)
+		}
+
+		for _, mt := range cp.Spec.MachineTypes {
+			item := models.CloudProfileMachineType{
+				Name:             mt.Name,
+				CloudProfileName: cp.Name,
+				Landscape:        gardenClient.Landscape(),
+				CPU:              mt.CPU.AsApproximateFloat64(),
+				GPU:              mt.GPU.Value(),
+				Memory:           mt.Memory.Value(),
+				Architecture:     mt.GetArchitecture(cp.Spec.MachineCapabilities),
+				Usable:           ptr.Value(mt.Usable, true),
+			}
+			if mt.Storage != nil {
+				item.StorageClass = mt.Storage.Class
+				item.StorageType = mt.Storage.Type
+				if mt.Storage.StorageSize != nil {
+					item.StorageSize = mt.Storage.StorageSize.Value()
+				}
+			}
+			machineTypes = append(machineTypes, item)
+		}
+
+		for _, vt := range cp.Spec.VolumeTypes {
+			item := models.CloudProfileVolumeType{
+				Name:             vt.Name,
+				CloudProfileName: cp.Name,
+				Landscape:        gardenClient.Landscape(),
+				Class:            vt.Class,
+				Usable:           ptr.Value(vt.Usable, true),
+			}
+			if vt.MinSize != nil {
+				item.MinSize = vt.MinSize.Value()
+			}
+			volumeTypes = append(volumeTypes, item)
+		}
+
+		for _, region := range cp.Spec.Regions {
+			// Regions without zones are represented by a
+			// single item with an empty zone.
+			regionZones := region.Zones
+			if len(regionZones) == 0 {
+				regionZones = []gardenerv1beta1.AvailabilityZone{{}}
+			}
+
+			for _, zone := range regionZones {
+				item := models.CloudProfileZone{
+					RegionName:              region.Name,
+					Zone:                    zone.Name,
+					CloudProfileName:        cp.Name,
+					Landscape:               gardenClient.Landscape(),
+					UnavailableMachineTypes: zone.UnavailableMachineTypes,
+					UnavailableVolumeTypes:  zone.UnavailableVolumeTypes,
+				}
+				zones = append(zones, item)
+			}
+		}
+
 		// Enqueue a task for persisting the Cloud Profile Machine
 		// Images, only if we have any provider data.
 		if providerConfig == nil {
@@ -141,6 +254,7 @@ func HandleCollectCloudProfilesTask(ctx context.Context, _ *asynq.Task) error {
 		payload := CollectCPMachineImagesPayload{
 			CloudProfileName: cp.Name,
 			ProviderConfig:   providerConfig.Raw,
+			Landscape:        gardenClient.Landscape(),
 		}
 		data, err := json.Marshal(payload)
 		if err != nil {
@@ -155,7 +269,18 @@ func HandleCollectCloudProfilesTask(ctx context.Context, _ *asynq.Task) error {
 		}
 
 		task := asynq.NewTask(miTaskName, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"cloud_profile", cp.Name,
+				"provider_type", providerType,
+			)
+
+			return nil
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -190,7 +315,7 @@ func HandleCollectCloudProfilesTask(ctx context.Context, _ *asynq.Task) error {
 
 	out, err := db.DB.NewInsert().
 		Model(&cloudProfiles).
-		On("CONFLICT (name) DO UPDATE").
+		On("CONFLICT (name, landscape) DO UPDATE").
 		Set("type = EXCLUDED.type").
 		Set("creation_timestamp = EXCLUDED.creation_timestamp").
 		Set("updated_at = EXCLUDED.updated_at").
@@ -211,7 +336,210 @@ func HandleCollectCloudProfilesTask(ctx context.Context, _ *asynq.Task) error {
 		return err
 	}
 
-	logger.Info("populated gardener cloud profiles", "count", count)
+	logger.Info("populated gardener cloud profiles", "landscape", landscape, "count", count)
+
+	if err := persistCloudProfileImageVersions(ctx, imageVersions); err != nil {
+		return err
+	}
+
+	if err := persistCloudProfileK8sVersions(ctx, k8sVersions); err != nil {
+		return err
+	}
+
+	if err := persistCloudProfileMachineTypes(ctx, machineTypes); err != nil {
+		return err
+	}
+
+	if err := persistCloudProfileVolumeTypes(ctx, volumeTypes); err != nil {
+		return err
+	}
+
+	return persistCloudProfileZones(ctx, zones)
+}
+
+// persistCloudProfileImageVersions persists the given machine image versions
+// collected from the CloudProfiles.
+func persistCloudProfileImageVersions(ctx context.Context, items []models.CloudProfileImageVersion) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (name, version, cloud_profile_name, landscape) DO UPDATE").
+		Set("classification = EXCLUDED.classification").
+		Set("expiration_date = EXCLUDED.expiration_date").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert gardener cloud profile image versions into db",
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info("populated gardener cloud profile image versions", "count", count)
+
+	return nil
+}
+
+// persistCloudProfileK8sVersions persists the given Kubernetes versions
+// collected from the CloudProfiles.
+func persistCloudProfileK8sVersions(ctx context.Context, items []models.CloudProfileK8sVersion) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (version, cloud_profile_name, landscape) DO UPDATE").
+		Set("classification = EXCLUDED.classification").
+		Set("expiration_date = EXCLUDED.expiration_date").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert gardener cloud profile kubernetes versions into db",
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info("populated gardener cloud profile kubernetes versions", "count", count)
+
+	return nil
+}
+
+// persistCloudProfileMachineTypes persists the given machine types
+// collected from the CloudProfiles.
+func persistCloudProfileMachineTypes(ctx context.Context, items []models.CloudProfileMachineType) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (name, cloud_profile_name, landscape) DO UPDATE").
+		Set("cpu = EXCLUDED.cpu").
+		Set("gpu = EXCLUDED.gpu").
+		Set("memory = EXCLUDED.memory").
+		Set("architecture = EXCLUDED.architecture").
+		Set("storage_class = EXCLUDED.storage_class").
+		Set("storage_type = EXCLUDED.storage_type").
+		Set("storage_size = EXCLUDED.storage_size").
+		Set("usable = EXCLUDED.usable").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert gardener cloud profile machine types into db",
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info("populated gardener cloud profile machine types", "count", count)
+
+	return nil
+}
+
+// persistCloudProfileVolumeTypes persists the given volume types
+// collected from the CloudProfiles.
+func persistCloudProfileVolumeTypes(ctx context.Context, items []models.CloudProfileVolumeType) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (name, cloud_profile_name, landscape) DO UPDATE").
+		Set("class = EXCLUDED.class").
+		Set("min_size = EXCLUDED.min_size").
+		Set("usable = EXCLUDED.usable").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert gardener cloud profile volume types into db",
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info("populated gardener cloud profile volume types", "count", count)
+
+	return nil
+}
+
+// persistCloudProfileZones persists the given regions and zones
+// collected from the CloudProfiles.
+func persistCloudProfileZones(ctx context.Context, items []models.CloudProfileZone) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (region_name, zone, cloud_profile_name, landscape) DO UPDATE").
+		Set("unavailable_machine_types = EXCLUDED.unavailable_machine_types").
+		Set("unavailable_volume_types = EXCLUDED.unavailable_volume_types").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert gardener cloud profile zones into db",
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info("populated gardener cloud profile zones", "count", count)
 
 	return nil
 }
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	gardenerclient "github.com/gardener/inventory/pkg/clients/gardener"
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// CredentialKindViewerKubeconfig is the kind of expiring credentials, which
+// represent the viewer kubeconfigs used to access the seed clusters.
+const CredentialKindViewerKubeconfig = "gardener_viewer_kubeconfig"
+
+// viewerKubeconfigCredentials returns the viewer kubeconfigs of the seed
+// clusters, which are currently in use, as [registry.ExpiringCredential]
+// items. The seeds of the additional Gardener landscapes are prefixed with the
+// name of their landscape.
+func viewerKubeconfigCredentials(_ context.Context) ([]registry.ExpiringCredential, error) {
+	clients := make([]*gardenerclient.Client, 0)
+	if gardenerclient.IsDefaultClientSet() {
+		clients = append(clients, gardenerclient.DefaultClient)
+	}
+	for _, landscape := range gardenerclient.Landscapes() {
+		client, _ := gardenerclient.Clients.Get(landscape)
+		clients = append(clients, client)
+	}
+
+	items := make([]registry.ExpiringCredential, 0)
+	for _, client := range clients {
+		for seed, expiresAt := range client.SeedCredentialExpirations() {
+			name := seed
+			if client != gardenerclient.DefaultClient {
+				name = client.Landscape() + "/" + seed
+			}
+			item := registry.ExpiringCredential{
+				Kind:      CredentialKindViewerKubeconfig,
+				Name:      name,
+				Source:    seed,
+				ExpiresAt: expiresAt,
+			}
+			items = append(items, item)
+		}
+	}
+
+	return items, nil
+}
+
+func init() {
+	registry.ExpiringCredentialRegistry.MustRegister(CredentialKindViewerKubeconfig, viewerKubeconfigCredentials)
+}
@@ -47,11 +47,16 @@ const (
 type CollectDNSEntriesPayload struct {
 	// Seed is the name of the seed cluster from which to collect Gardener
 	// DNSEntry resources.
-	Seed string `json:"seed" yaml:"seed"`
+	Seed string `json:"seed" yaml:"seed" desc:"The name of the seed cluster from which to collect Gardener DNSEntry resources" example:"aws-ha"`
 
 	// TargetGarden is the flag responsible for collecting from the garden
 	// cluster instead of a seed.
-	TargetGarden bool `json:"target_garden" yaml:"target_garden"`
+	TargetGarden bool `json:"target_garden" yaml:"target_garden" desc:"Whether to enable collecting from the garden cluster instead of a seed" example:"false"`
+
+	// Landscape specifies the name of the Gardener landscape of the seed
+	// cluster. If no seed is specified, tasks for collecting from all
+	// known seeds of the landscape are enqueued.
+	Landscape string `json:"landscape" yaml:"landscape" desc:"The name of the Gardener landscape of the seed cluster" example:"canary"`
 }
 
 // NewCollectDNSEntriesTask creates a new [asynq.Task] for collecting Gardener
@@ -68,7 +73,7 @@ func HandleCollectDNSEntriesTask(ctx context.Context, t *asynq.Task) error {
 	// Gardener Seed clusters.
 	data := t.Payload()
 	if data == nil {
-		return enqueueCollectDNSEntries(ctx)
+		return enqueueCollectDNSEntries(ctx, "")
 	}
 
 	var payload CollectDNSEntriesPayload
@@ -77,6 +82,10 @@ func HandleCollectDNSEntriesTask(ctx context.Context, t *asynq.Task) error {
 	}
 
 	if !payload.TargetGarden && payload.Seed == "" {
+		if payload.Landscape != "" {
+			return enqueueCollectDNSEntries(ctx, payload.Landscape)
+		}
+
 		return asynqutils.SkipRetry(ErrNoSeedCluster)
 	}
 
@@ -85,8 +94,8 @@ func HandleCollectDNSEntriesTask(ctx context.Context, t *asynq.Task) error {
 
 // enqueueCollectDNSEntries enqueues tasks for collecting Gardener DNSentry
 // resources from all known Seed Clusters.
-func enqueueCollectDNSEntries(ctx context.Context) error {
-	seeds, err := gutils.GetSeedsFromDB(ctx)
+func enqueueCollectDNSEntries(ctx context.Context, landscape string) error {
+	seeds, err := gutils.GetSeedsFromDB(ctx, landscape)
 	if err != nil {
 		return fmt.Errorf("failed to get seeds from db: %w", err)
 	}
@@ -96,7 +105,8 @@ func enqueueCollectDNSEntries(ctx context.Context) error {
 
 	for _, s := range seeds {
 		payload := CollectDNSEntriesPayload{
-			Seed: s.Name,
+			Seed:      s.Name,
+			Landscape: landscape,
 		}
 		data, err := json.Marshal(payload)
 		if err != nil {
@@ -110,7 +120,17 @@ func enqueueCollectDNSEntries(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectDNSEntries, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"seed", s.Name,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -135,6 +155,7 @@ func enqueueCollectDNSEntries(ctx context.Context) error {
 	payload := CollectDNSEntriesPayload{
 		Seed:         "",
 		TargetGarden: true,
+		Landscape:    landscape,
 	}
 
 	data, err := json.Marshal(payload)
@@ -148,7 +169,16 @@ func enqueueCollectDNSEntries(ctx context.Context) error {
 	}
 
 	task := asynq.NewTask(TaskCollectDNSEntries, data)
-	info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+	info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+	if errors.Is(err, asynq.ErrDuplicateTask) {
+		logger.Info(
+			"skipping duplicate task",
+			"type", task.Type(),
+		)
+
+		return nil
+	}
+
 	if err != nil {
 		logger.Error(
 			"failed to enqueue task for garden cluster",
@@ -181,8 +211,9 @@ func collectDNSEntries(ctx context.Context, payload CollectDNSEntriesPayload) er
 		clusterIdentifier = payload.Seed
 	}
 
-	if !gardenerclient.IsDefaultClientSet() {
-		logger.Warn("gardener client not configured")
+	gardenClient, ok := gardenerclient.GetClient(payload.Landscape)
+	if !ok {
+		logger.Warn("gardener client not configured", "landscape", payload.Landscape)
 
 		return nil
 	}
@@ -193,20 +224,21 @@ func collectDNSEntries(ctx context.Context, payload CollectDNSEntriesPayload) er
 			dnsEntriesDesc,
 			prometheus.GaugeValue,
 			float64(count),
+			payload.Landscape,
 			clusterIdentifier,
 		)
-		key := metrics.Key(TaskCollectDNSEntries, clusterIdentifier)
+		key := metrics.Key(TaskCollectDNSEntries, payload.Landscape, clusterIdentifier)
 		metrics.DefaultCollector.AddMetric(key, metric)
 	}()
 
-	logger.Info("collecting Gardener DNS entries", "cluster", clusterIdentifier)
+	logger.Info("collecting Gardener DNS entries", "cluster", clusterIdentifier, "landscape", payload.Landscape)
 
 	var restConfig *rest.Config
 	var err error
 	if payload.TargetGarden {
-		restConfig = gardenerclient.DefaultClient.RESTConfig()
+		restConfig = gardenClient.RESTConfig()
 	} else {
-		restConfig, err = gardenerclient.DefaultClient.SeedRestConfig(ctx, clusterIdentifier)
+		restConfig, err = gardenClient.SeedRestConfig(ctx, clusterIdentifier)
 		if err != nil {
 			if errors.Is(err, gardenerclient.ErrSeedIsExcluded) {
 				// Don't treat excluded seeds as errors, in order to
@@ -228,6 +260,7 @@ func collectDNSEntries(ctx context.Context, payload CollectDNSEntriesPayload) er
 	dnsEntries := make([]models.DNSEntry, 0)
 	p := pager.New(
 		pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+			metrics.IncPages(ctx)
 			return client.DnsV1alpha1().DNSEntries("").List(ctx, opts)
 		}),
 	)
@@ -268,6 +301,7 @@ func collectDNSEntries(ctx context.Context, payload CollectDNSEntriesPayload) er
 				ProviderType:      providerType,
 				Provider:          provider,
 				SeedName:          clusterIdentifier,
+				Landscape:         gardenClient.Landscape(),
 				CreationTimestamp: creationTimestamp,
 			}
 			dnsEntries = append(dnsEntries, item)
@@ -286,7 +320,7 @@ func collectDNSEntries(ctx context.Context, payload CollectDNSEntriesPayload) er
 
 	out, err := db.DB.NewInsert().
 		Model(&dnsEntries).
-		On("CONFLICT (name, namespace, seed_name, value) DO UPDATE").
+		On("CONFLICT (name, namespace, seed_name, value, landscape) DO UPDATE").
 		Set("fqdn = EXCLUDED.fqdn").
 		Set("ttl = EXCLUDED.ttl").
 		Set("dns_zone = EXCLUDED.dns_zone").
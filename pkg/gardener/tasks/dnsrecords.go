@@ -37,7 +37,12 @@ const (
 type CollectDNSRecordsPayload struct {
 	// Seed is the name of the seed cluster from which to collect Gardener
 	// DNSRecords.
-	Seed string `json:"seed" yaml:"seed"`
+	Seed string `json:"seed" yaml:"seed" desc:"The name of the seed cluster from which to collect Gardener DNSRecords" example:"aws-ha"`
+
+	// Landscape specifies the name of the Gardener landscape of the seed
+	// cluster. If no seed is specified, tasks for collecting from all
+	// known seeds of the landscape are enqueued.
+	Landscape string `json:"landscape" yaml:"landscape" desc:"The name of the Gardener landscape of the seed cluster" example:"canary"`
 }
 
 // NewCollectDNSRecordsTask creates a new [asynq.Task] for collecting Gardener
@@ -52,7 +57,7 @@ func HandleCollectDNSRecordsTask(ctx context.Context, t *asynq.Task) error {
 	// collecting DNSRecords from all known Gardener Seed clusters.
 	data := t.Payload()
 	if data == nil {
-		return enqueueCollectDNSRecords(ctx)
+		return enqueueCollectDNSRecords(ctx, "")
 	}
 
 	var payload CollectDNSRecordsPayload
@@ -61,6 +66,10 @@ func HandleCollectDNSRecordsTask(ctx context.Context, t *asynq.Task) error {
 	}
 
 	if payload.Seed == "" {
+		if payload.Landscape != "" {
+			return enqueueCollectDNSRecords(ctx, payload.Landscape)
+		}
+
 		return asynqutils.SkipRetry(ErrNoSeedCluster)
 	}
 
@@ -69,8 +78,8 @@ func HandleCollectDNSRecordsTask(ctx context.Context, t *asynq.Task) error {
 
 // enqueueCollectDNSRecords enqueues tasks for collecting Gardener DNSRecords from
 // all known Seed Clusters.
-func enqueueCollectDNSRecords(ctx context.Context) error {
-	seeds, err := gutils.GetSeedsFromDB(ctx)
+func enqueueCollectDNSRecords(ctx context.Context, landscape string) error {
+	seeds, err := gutils.GetSeedsFromDB(ctx, landscape)
 	if err != nil {
 		return fmt.Errorf("failed to get seeds from db: %w", err)
 	}
@@ -81,7 +90,8 @@ func enqueueCollectDNSRecords(ctx context.Context) error {
 	// Create a task for each known seed cluster
 	for _, s := range seeds {
 		payload := CollectDNSRecordsPayload{
-			Seed: s.Name,
+			Seed:      s.Name,
+			Landscape: landscape,
 		}
 
 		data, err := json.Marshal(payload)
@@ -96,7 +106,17 @@ func enqueueCollectDNSRecords(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectDNSRecords, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"seed", s.Name,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -124,8 +144,9 @@ func enqueueCollectDNSRecords(ctx context.Context) error {
 // specified in the payload.
 func collectDNSRecords(ctx context.Context, payload CollectDNSRecordsPayload) error {
 	logger := asynqutils.GetLogger(ctx)
-	if !gardenerclient.IsDefaultClientSet() {
-		logger.Warn("gardener client not configured")
+	gardenClient, ok := gardenerclient.GetClient(payload.Landscape)
+	if !ok {
+		logger.Warn("gardener client not configured", "landscape", payload.Landscape)
 
 		return nil
 	}
@@ -136,14 +157,15 @@ func collectDNSRecords(ctx context.Context, payload CollectDNSRecordsPayload) er
 			dnsRecordsDesc,
 			prometheus.GaugeValue,
 			float64(count),
+			payload.Landscape,
 			payload.Seed,
 		)
-		key := metrics.Key(TaskCollectDNSRecords, payload.Seed)
+		key := metrics.Key(TaskCollectDNSRecords, payload.Landscape, payload.Seed)
 		metrics.DefaultCollector.AddMetric(key, metric)
 	}()
 
 	logger.Info("collecting Gardener DNS records", "seed", payload.Seed)
-	restConfig, err := gardenerclient.DefaultClient.SeedRestConfig(ctx, payload.Seed)
+	restConfig, err := gardenClient.SeedRestConfig(ctx, payload.Seed)
 	if err != nil {
 		if errors.Is(err, gardenerclient.ErrSeedIsExcluded) {
 			// Don't treat excluded seeds as errors, in order to
@@ -205,6 +227,7 @@ func collectDNSRecords(ctx context.Context, payload CollectDNSRecordsPayload) er
 				Region:            region,
 				DNSZone:           dnsZone,
 				SeedName:          payload.Seed,
+				Landscape:         gardenClient.Landscape(),
 				CreationTimestamp: creationTimestamp,
 			}
 			dnsRecords = append(dnsRecords, record)
@@ -221,7 +244,7 @@ func collectDNSRecords(ctx context.Context, payload CollectDNSRecordsPayload) er
 
 	out, err := db.DB.NewInsert().
 		Model(&dnsRecords).
-		On("CONFLICT (name, namespace, seed_name, value) DO UPDATE").
+		On("CONFLICT (name, namespace, seed_name, value, landscape) DO UPDATE").
 		Set("fqdn = EXCLUDED.fqdn").
 		Set("record_type = EXCLUDED.record_type").
 		Set("provider_type = EXCLUDED.provider_type").
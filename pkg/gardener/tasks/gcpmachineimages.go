@@ -62,7 +62,7 @@ func collectGCPMachineImages(ctx context.Context, payload CollectCPMachineImages
 	}
 
 	logger := asynqutils.GetLogger(ctx)
-	logger.Info("collecting machine images", "cloud_profile", payload.CloudProfileName)
+	logger.Info("collecting machine images", "cloud_profile", payload.CloudProfileName, "landscape", payload.Landscape)
 	items := make([]models.CloudProfileGCPImage, 0)
 
 	for _, image := range images {
@@ -73,6 +73,7 @@ func collectGCPMachineImages(ctx context.Context, payload CollectCPMachineImages
 				Image:            utils.ResourceNameFromURL(version.Image),
 				Architecture:     ptr.Value(version.Architecture, ""),
 				CloudProfileName: payload.CloudProfileName,
+				Landscape:        payload.Landscape,
 			}
 
 			items = append(items, item)
@@ -87,7 +88,7 @@ func collectGCPMachineImages(ctx context.Context, payload CollectCPMachineImages
 
 	out, err := db.DB.NewInsert().
 		Model(&items).
-		On("CONFLICT (name, image, version, cloud_profile_name) DO UPDATE").
+		On("CONFLICT (name, image, version, cloud_profile_name, landscape) DO UPDATE").
 		Set("architecture = EXCLUDED.architecture").
 		Set("updated_at = EXCLUDED.updated_at").
 		Returning("id").
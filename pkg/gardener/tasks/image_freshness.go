@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// TaskReportMachineImageFreshness is the name of the task for reporting the
+// freshness of machine images used by the Gardener machines.
+const TaskReportMachineImageFreshness = "g:task:report-machine-image-freshness"
+
+// NewReportMachineImageFreshnessTask creates a new [asynq.Task] for reporting
+// the freshness of machine images, without specifying a payload.
+func NewReportMachineImageFreshnessTask() *asynq.Task {
+	return asynq.NewTask(TaskReportMachineImageFreshness, nil)
+}
+
+// HandleReportMachineImageFreshnessTask is the handler, which reports the
+// number of machines running images, which are current, deprecated, expired
+// or not present in the CloudProfile of their shoot.
+//
+// The status of each machine is provided by the g_machine_image_freshness
+// view, which joins the images of the instances, VMs and servers backing the
+// machines with the images listed in the CloudProfiles.
+func HandleReportMachineImageFreshnessTask(ctx context.Context, _ *asynq.Task) error {
+	logger := asynqutils.GetLogger(ctx)
+
+	var rows []struct {
+		Landscape    string `bun:"landscape"`
+		CloudProfile string `bun:"cloud_profile"`
+		Status       string `bun:"status"`
+		Count        int64  `bun:"count"`
+	}
+
+	err := db.DB.NewSelect().
+		TableExpr("g_machine_image_freshness").
+		Column("landscape", "cloud_profile", "status").
+		ColumnExpr("count(*) AS count").
+		Group("landscape", "cloud_profile", "status").
+		Scan(ctx, &rows)
+
+	if err != nil {
+		logger.Error("failed to report machine image freshness", "reason", err)
+
+		return err
+	}
+
+	for _, row := range rows {
+		metric := prometheus.MustNewConstMetric(
+			machineImageFreshnessDesc,
+			prometheus.GaugeValue,
+			float64(row.Count),
+			row.Landscape,
+			row.CloudProfile,
+			row.Status,
+		)
+		key := metrics.Key(TaskReportMachineImageFreshness, row.Landscape, row.CloudProfile, row.Status)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}
+
+	logger.Info("reported machine image freshness", "count", len(rows))
+
+	return nil
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// TaskReportK8sVersionSkew is the name of the task for reporting the
+// distribution of Kubernetes versions across seeds and shoots, and the shoots
+// which are lagging behind.
+const TaskReportK8sVersionSkew = "g:task:report-k8s-version-skew"
+
+// DefaultMaxMinorSkew is the default number of minor versions a shoot may be
+// behind its seed or the latest supported version of its CloudProfile.
+const DefaultMaxMinorSkew = 2
+
+// Reasons for which a shoot is reported by the [TaskReportK8sVersionSkew] task.
+const (
+	k8sSkewReasonBehindSeed         = "behind_seed"
+	k8sSkewReasonBehindCloudProfile = "behind_cloud_profile"
+	k8sSkewReasonDeprecated         = "deprecated"
+	k8sSkewReasonExpired            = "expired"
+	k8sSkewReasonNotInCloudProfile  = "not_in_cloud_profile"
+)
+
+// ReportK8sVersionSkewPayload is the payload used for reporting the
+// Kubernetes version skew.
+type ReportK8sVersionSkewPayload struct {
+	// MaxMinorSkew specifies the number of minor versions a shoot may be
+	// behind its seed or the latest supported version in its CloudProfile,
+	// before being reported.
+	MaxMinorSkew int `json:"max_minor_skew" yaml:"max_minor_skew" desc:"The number of minor versions a shoot may be behind its seed or the latest supported version in its CloudProfile, before being reported" example:"2"`
+}
+
+// NewReportK8sVersionSkewTask creates a new [asynq.Task] for reporting the
+// Kubernetes version skew, without specifying a payload.
+func NewReportK8sVersionSkewTask() *asynq.Task {
+	return asynq.NewTask(TaskReportK8sVersionSkew, nil)
+}
+
+// HandleReportK8sVersionSkewTask is the handler, which reports the
+// distribution of Kubernetes versions across seeds and shoots, along with the
+// number of shoots which are more than the configured number of minor
+// versions behind their seed or the supported range of their CloudProfile.
+//
+// The skew of each shoot is provided by the g_shoot_k8s_version_skew view.
+func HandleReportK8sVersionSkewTask(ctx context.Context, task *asynq.Task) error {
+	payload := ReportK8sVersionSkewPayload{
+		MaxMinorSkew: DefaultMaxMinorSkew,
+	}
+	if data := task.Payload(); data != nil {
+		if err := asynqutils.Unmarshal(data, &payload); err != nil {
+			return asynqutils.SkipRetry(err)
+		}
+	}
+
+	if payload.MaxMinorSkew <= 0 {
+		payload.MaxMinorSkew = DefaultMaxMinorSkew
+	}
+
+	return errors.Join(
+		reportK8sVersions(ctx),
+		reportK8sVersionSkew(ctx, payload.MaxMinorSkew),
+	)
+}
+
+// reportK8sVersions reports the number of seeds and shoots per Kubernetes
+// minor version.
+func reportK8sVersions(ctx context.Context) error {
+	var rows []struct {
+		Landscape string `bun:"landscape"`
+		Kind      string `bun:"kind"`
+		Version   string `bun:"version"`
+		Count     int64  `bun:"count"`
+	}
+
+	query := `SELECT landscape, kind, version, count(*) AS count FROM (
+SELECT landscape, 'seed' AS kind, substring(kubernetes_version from '^v*([0-9]+\.[0-9]+)') AS version FROM g_seed
+UNION ALL
+SELECT landscape, 'shoot' AS kind, substring(k8s_version from '^v*([0-9]+\.[0-9]+)') AS version FROM g_shoot
+) AS v WHERE version IS NOT NULL GROUP BY landscape, kind, version`
+
+	if err := db.DB.NewRaw(query).Scan(ctx, &rows); err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		metric := prometheus.MustNewConstMetric(
+			k8sVersionsDesc,
+			prometheus.GaugeValue,
+			float64(row.Count),
+			row.Landscape,
+			row.Kind,
+			row.Version,
+		)
+		key := metrics.Key(TaskReportK8sVersionSkew, row.Landscape, row.Kind, row.Version)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}
+
+	return nil
+}
+
+// reportK8sVersionSkew reports the number of shoots per CloudProfile, which
+// are lagging behind for each of the known reasons.
+func reportK8sVersionSkew(ctx context.Context, maxMinorSkew int) error {
+	logger := asynqutils.GetLogger(ctx)
+
+	var rows []struct {
+		Landscape    string `bun:"landscape"`
+		CloudProfile string `bun:"cloud_profile"`
+		Reason       string `bun:"reason"`
+		Count        int64  `bun:"count"`
+	}
+
+	query := `SELECT landscape, cloud_profile, reason, count(*) AS count FROM (
+SELECT landscape, cloud_profile, ? AS reason FROM g_shoot_k8s_version_skew WHERE seed_minor_skew > ?
+UNION ALL
+SELECT landscape, cloud_profile, ? AS reason FROM g_shoot_k8s_version_skew WHERE cloud_profile_minor_skew > ?
+UNION ALL
+SELECT landscape, cloud_profile, status AS reason FROM g_shoot_k8s_version_skew WHERE status IN (?, ?, ?)
+) AS s GROUP BY landscape, cloud_profile, reason`
+
+	err := db.DB.NewRaw(
+		query,
+		k8sSkewReasonBehindSeed,
+		maxMinorSkew,
+		k8sSkewReasonBehindCloudProfile,
+		maxMinorSkew,
+		k8sSkewReasonDeprecated,
+		k8sSkewReasonExpired,
+		k8sSkewReasonNotInCloudProfile,
+	).Scan(ctx, &rows)
+
+	if err != nil {
+		logger.Error("failed to report kubernetes version skew", "reason", err)
+
+		return err
+	}
+
+	for _, row := range rows {
+		metric := prometheus.MustNewConstMetric(
+			k8sVersionSkewDesc,
+			prometheus.GaugeValue,
+			float64(row.Count),
+			row.Landscape,
+			row.CloudProfile,
+			row.Reason,
+		)
+		key := metrics.Key(TaskReportK8sVersionSkew, row.Landscape, row.CloudProfile, row.Reason)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}
+
+	logger.Info(
+		"reported kubernetes version skew",
+		"max_minor_skew", maxMinorSkew,
+		"count", len(rows),
+	)
+
+	return nil
+}
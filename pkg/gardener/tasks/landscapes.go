@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"github.com/hibiken/asynq"
+
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// LandscapePayload is the payload, which is used by the tasks collecting
+// cluster-scoped Gardener resources, e.g. Seeds and Cloud Profiles.
+type LandscapePayload struct {
+	// Landscape specifies the name of the Gardener landscape from which to
+	// collect the resources. The primary landscape is used, if empty.
+	Landscape string `json:"landscape" yaml:"landscape" desc:"The name of the Gardener landscape from which to collect the resources" example:"canary"`
+}
+
+// getLandscapePayload decodes the [LandscapePayload] from the given task
+// payload. A task without a payload refers to the primary landscape.
+func getLandscapePayload(t *asynq.Task) (LandscapePayload, error) {
+	var payload LandscapePayload
+	data := t.Payload()
+	if data == nil {
+		return payload, nil
+	}
+
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return payload, asynqutils.SkipRetry(err)
+	}
+
+	return payload, nil
+}
+
+// newLandscapeTasks returns the [asynqutils.TaskConstructor] items for the tasks
+// with the given names, which collect the resources from the given Gardener
+// landscape.
+func newLandscapeTasks(landscape string, names ...string) ([]asynqutils.TaskConstructor, error) {
+	payload := LandscapePayload{Landscape: landscape}
+	items := make([]asynqutils.TaskConstructor, 0, len(names))
+	for _, name := range names {
+		task, err := asynqutils.NewTask(name, payload)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, func() *asynq.Task { return task })
+	}
+
+	return items, nil
+}
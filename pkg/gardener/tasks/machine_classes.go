@@ -0,0 +1,317 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/pager"
+
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	"github.com/gardener/inventory/pkg/clients/db"
+	gardenerclient "github.com/gardener/inventory/pkg/clients/gardener"
+	"github.com/gardener/inventory/pkg/gardener/constants"
+	"github.com/gardener/inventory/pkg/gardener/models"
+	gutils "github.com/gardener/inventory/pkg/gardener/utils"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/gardener/inventory/pkg/utils/ptr"
+)
+
+const (
+	// TaskCollectMachineClasses is the name of the task for collecting the
+	// MachineClasses referenced by Gardener MachineDeployments.
+	TaskCollectMachineClasses = "g:task:collect-machine-classes"
+)
+
+// CollectMachineClassesPayload is the payload, which is used for collecting
+// MachineClasses.
+type CollectMachineClassesPayload struct {
+	// Seed is the name of the seed cluster from which to collect
+	// MachineClasses.
+	Seed string `json:"seed" yaml:"seed" desc:"The name of the seed cluster from which to collect MachineClasses" example:"aws-ha"`
+
+	// Landscape specifies the name of the Gardener landscape of the seed
+	// cluster. If no seed is specified, tasks for collecting from all
+	// known seeds of the landscape are enqueued.
+	Landscape string `json:"landscape" yaml:"landscape" desc:"The name of the Gardener landscape of the seed cluster" example:"canary"`
+}
+
+// NewCollectMachineClassesTask creates a new [asynq.Task] for collecting
+// MachineClasses, without specifying a payload.
+func NewCollectMachineClassesTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectMachineClasses, nil)
+}
+
+// HandleCollectMachineClassesTask is the handler for collecting
+// MachineClasses.
+func HandleCollectMachineClassesTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we enqueue tasks for
+	// collecting MachineClasses from all known Gardener Seed clusters.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectMachineClasses(ctx, "")
+	}
+
+	var payload CollectMachineClassesPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.Seed == "" {
+		if payload.Landscape != "" {
+			return enqueueCollectMachineClasses(ctx, payload.Landscape)
+		}
+
+		return asynqutils.SkipRetry(ErrNoSeedCluster)
+	}
+
+	return collectMachineClasses(ctx, payload)
+}
+
+// enqueueCollectMachineClasses enqueues tasks for collecting MachineClasses
+// from all known Seed Clusters.
+func enqueueCollectMachineClasses(ctx context.Context, landscape string) error {
+	seeds, err := gutils.GetSeedsFromDB(ctx, landscape)
+	if err != nil {
+		return fmt.Errorf("failed to get seeds from db: %w", err)
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	queue := asynqutils.GetQueueName(ctx)
+
+	// Create a task for each known seed cluster
+	for _, s := range seeds {
+		payload := CollectMachineClassesPayload{
+			Seed:      s.Name,
+			Landscape: landscape,
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for MachineClasses",
+				"seed", s.Name,
+				"reason", err,
+			)
+
+			continue
+		}
+
+		task := asynq.NewTask(TaskCollectMachineClasses, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"seed", s.Name,
+			)
+
+			continue
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"seed", s.Name,
+				"reason", err,
+			)
+
+			continue
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"seed", s.Name,
+		)
+	}
+
+	return nil
+}
+
+// collectMachineClasses collects the MachineClasses, which are referenced by
+// the MachineDeployments in the Seed Cluster specified in the payload.
+func collectMachineClasses(ctx context.Context, payload CollectMachineClassesPayload) error {
+	logger := asynqutils.GetLogger(ctx)
+	gardenClient, ok := gardenerclient.GetClient(payload.Landscape)
+	if !ok {
+		logger.Warn("gardener client not configured", "landscape", payload.Landscape)
+
+		return nil
+	}
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			machineClassesDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.Landscape,
+			payload.Seed,
+		)
+		key := metrics.Key(TaskCollectMachineClasses, payload.Landscape, payload.Seed)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	logger.Info("collecting machine classes", "seed", payload.Seed)
+	client, err := gardenClient.MCMClient(ctx, payload.Seed)
+	if err != nil {
+		if errors.Is(err, gardenerclient.ErrSeedIsExcluded) {
+			// Don't treat excluded seeds as errors, in order to
+			// avoid accumulating archived tasks
+			logger.Warn("seed is excluded", "seed", payload.Seed)
+
+			return nil
+		}
+
+		return asynqutils.SkipRetry(fmt.Errorf("cannot get garden client for %q: %s", payload.Seed, err))
+	}
+
+	// Map the referenced MachineClasses to their MachineDeployments
+	deployments := make(map[string]string)
+	deploymentPager := pager.New(
+		pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+			metrics.IncPages(ctx)
+			return client.MachineV1alpha1().MachineDeployments("").List(ctx, opts)
+		}),
+	)
+	opts := metav1.ListOptions{Limit: constants.PageSize}
+	err = deploymentPager.EachListItem(ctx, opts, func(obj runtime.Object) error {
+		md, ok := obj.(*v1alpha1.MachineDeployment)
+		if !ok {
+			return fmt.Errorf("unexpected object type: %T", obj)
+		}
+		if name := md.Spec.Template.Spec.Class.Name; name != "" {
+			deployments[md.Namespace+"/"+name] = md.Name
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not list machine deployments for seed %q: %w", payload.Seed, err)
+	}
+
+	items := make([]models.MachineClass, 0)
+	classPager := pager.New(
+		pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+			metrics.IncPages(ctx)
+			return client.MachineV1alpha1().MachineClasses("").List(ctx, opts)
+		}),
+	)
+	err = classPager.EachListItem(ctx, opts, func(obj runtime.Object) error {
+		mc, ok := obj.(*v1alpha1.MachineClass)
+		if !ok {
+			return fmt.Errorf("unexpected object type: %T", obj)
+		}
+
+		deployment, ok := deployments[mc.Namespace+"/"+mc.Name]
+		if !ok {
+			return nil
+		}
+
+		item := models.MachineClass{
+			Name:              mc.Name,
+			Namespace:         mc.Namespace,
+			Landscape:         gardenClient.Landscape(),
+			SeedName:          payload.Seed,
+			MachineDeployment: deployment,
+			Provider:          mc.Provider,
+			ProviderSpec:      json.RawMessage(mc.ProviderSpec.Raw),
+			CreationTimestamp: mc.CreationTimestamp.Time,
+		}
+
+		if mc.NodeTemplate != nil {
+			item.Region = mc.NodeTemplate.Region
+			item.Zone = mc.NodeTemplate.Zone
+			item.Architecture = ptr.StringFromPointer(mc.NodeTemplate.Architecture)
+			item.MachineType = mc.NodeTemplate.InstanceType
+		}
+
+		spec, err := gutils.ParseMachineClassProviderSpec(mc.Provider, mc.ProviderSpec.Raw)
+		if err != nil {
+			logger.Warn(
+				"cannot parse machine class provider spec",
+				"seed", payload.Seed,
+				"namespace", mc.Namespace,
+				"name", mc.Name,
+				"reason", err,
+			)
+		}
+
+		if spec.MachineType != "" {
+			item.MachineType = spec.MachineType
+		}
+		item.Image = spec.Image
+		item.VolumeSize = spec.VolumeSize
+		item.VolumeType = spec.VolumeType
+		item.Network = spec.Network
+		items = append(items, item)
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not list machine classes for seed %q: %w", payload.Seed, err)
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (name, namespace, landscape) DO UPDATE").
+		Set("seed_name = EXCLUDED.seed_name").
+		Set("machine_deployment = EXCLUDED.machine_deployment").
+		Set("provider = EXCLUDED.provider").
+		Set("region = EXCLUDED.region").
+		Set("zone = EXCLUDED.zone").
+		Set("architecture = EXCLUDED.architecture").
+		Set("machine_type = EXCLUDED.machine_type").
+		Set("image = EXCLUDED.image").
+		Set("volume_size = EXCLUDED.volume_size").
+		Set("volume_type = EXCLUDED.volume_type").
+		Set("network = EXCLUDED.network").
+		Set("provider_spec = EXCLUDED.provider_spec").
+		Set("creation_timestamp = EXCLUDED.creation_timestamp").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert machine classes into db",
+			"seed", payload.Seed,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated machine classes",
+		"seed", payload.Seed,
+		"count", count,
+	)
+
+	return nil
+}
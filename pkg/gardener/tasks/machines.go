@@ -38,7 +38,12 @@ const (
 type CollectMachinesPayload struct {
 	// Seed is the name of the seed cluster from which to collect Gardener
 	// Machines.
-	Seed string `json:"seed" yaml:"seed"`
+	Seed string `json:"seed" yaml:"seed" desc:"The name of the seed cluster from which to collect Gardener Machines" example:"aws-ha"`
+
+	// Landscape specifies the name of the Gardener landscape of the seed
+	// cluster. If no seed is specified, tasks for collecting from all
+	// known seeds of the landscape are enqueued.
+	Landscape string `json:"landscape" yaml:"landscape" desc:"The name of the Gardener landscape of the seed cluster" example:"canary"`
 }
 
 // NewCollectMachinesTask creates a new [asynq.Task] for collecting Gardener
@@ -53,7 +58,7 @@ func HandleCollectMachinesTask(ctx context.Context, t *asynq.Task) error {
 	// collecting Machines from all known Gardener Seed clusters.
 	data := t.Payload()
 	if data == nil {
-		return enqueueCollectMachines(ctx)
+		return enqueueCollectMachines(ctx, "")
 	}
 
 	var payload CollectMachinesPayload
@@ -62,6 +67,10 @@ func HandleCollectMachinesTask(ctx context.Context, t *asynq.Task) error {
 	}
 
 	if payload.Seed == "" {
+		if payload.Landscape != "" {
+			return enqueueCollectMachines(ctx, payload.Landscape)
+		}
+
 		return asynqutils.SkipRetry(ErrNoSeedCluster)
 	}
 
@@ -70,8 +79,8 @@ func HandleCollectMachinesTask(ctx context.Context, t *asynq.Task) error {
 
 // enqueueCollectMachines enqueues tasks for collecting Gardener Machines from
 // all known Seed Clusters.
-func enqueueCollectMachines(ctx context.Context) error {
-	seeds, err := gutils.GetSeedsFromDB(ctx)
+func enqueueCollectMachines(ctx context.Context, landscape string) error {
+	seeds, err := gutils.GetSeedsFromDB(ctx, landscape)
 	if err != nil {
 		return fmt.Errorf("failed to get seeds from db: %w", err)
 	}
@@ -82,7 +91,8 @@ func enqueueCollectMachines(ctx context.Context) error {
 	// Create a task for each known seed cluster
 	for _, s := range seeds {
 		payload := CollectMachinesPayload{
-			Seed: s.Name,
+			Seed:      s.Name,
+			Landscape: landscape,
 		}
 		data, err := json.Marshal(payload)
 		if err != nil {
@@ -96,7 +106,17 @@ func enqueueCollectMachines(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectMachines, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"seed", s.Name,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -124,8 +144,9 @@ func enqueueCollectMachines(ctx context.Context) error {
 // specified in the payload.
 func collectMachines(ctx context.Context, payload CollectMachinesPayload) error {
 	logger := asynqutils.GetLogger(ctx)
-	if !gardenerclient.IsDefaultClientSet() {
-		logger.Warn("gardener client not configured")
+	gardenClient, ok := gardenerclient.GetClient(payload.Landscape)
+	if !ok {
+		logger.Warn("gardener client not configured", "landscape", payload.Landscape)
 
 		return nil
 	}
@@ -136,14 +157,15 @@ func collectMachines(ctx context.Context, payload CollectMachinesPayload) error
 			machinesDesc,
 			prometheus.GaugeValue,
 			float64(count),
+			payload.Landscape,
 			payload.Seed,
 		)
-		key := metrics.Key(TaskCollectMachines, payload.Seed)
+		key := metrics.Key(TaskCollectMachines, payload.Landscape, payload.Seed)
 		metrics.DefaultCollector.AddMetric(key, metric)
 	}()
 
 	logger.Info("collecting Gardener machines", "seed", payload.Seed)
-	client, err := gardenerclient.DefaultClient.MCMClient(ctx, payload.Seed)
+	client, err := gardenClient.MCMClient(ctx, payload.Seed)
 	if err != nil {
 		if errors.Is(err, gardenerclient.ErrSeedIsExcluded) {
 			// Don't treat excluded seeds as errors, in order to
@@ -159,6 +181,7 @@ func collectMachines(ctx context.Context, payload CollectMachinesPayload) error
 	machines := make([]models.Machine, 0)
 	p := pager.New(
 		pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+			metrics.IncPages(ctx)
 			return client.MachineV1alpha1().Machines("").List(ctx, opts)
 		}),
 	)
@@ -175,7 +198,9 @@ func collectMachines(ctx context.Context, payload CollectMachinesPayload) error
 			Status:            string(m.Status.CurrentStatus.Phase),
 			Node:              m.Labels["node"],
 			SeedName:          payload.Seed,
+			Landscape:         gardenClient.Landscape(),
 			CreationTimestamp: m.CreationTimestamp.Time,
+			MachineClassName:  m.Spec.Class.Name,
 		}
 		machines = append(machines, item)
 
@@ -192,11 +217,12 @@ func collectMachines(ctx context.Context, payload CollectMachinesPayload) error
 
 	out, err := db.DB.NewInsert().
 		Model(&machines).
-		On("CONFLICT (name, namespace) DO UPDATE").
+		On("CONFLICT (name, namespace, landscape) DO UPDATE").
 		Set("status = EXCLUDED.status").
 		Set("node = EXCLUDED.node").
 		Set("seed_name = EXCLUDED.seed_name").
 		Set("creation_timestamp = EXCLUDED.creation_timestamp").
+		Set("machine_class = EXCLUDED.machine_class").
 		Set("updated_at = EXCLUDED.updated_at").
 		Returning("id").
 		Exec(ctx)
@@ -16,7 +16,7 @@ var (
 	projectsDesc = prometheus.NewDesc(
 		prometheus.BuildFQName(metrics.Namespace, "", "g_projects"),
 		"A gauge which tracks the number of collected Gardener projects",
-		nil,
+		[]string{"landscape"},
 		nil,
 	)
 
@@ -25,7 +25,7 @@ var (
 	projectMembersDesc = prometheus.NewDesc(
 		prometheus.BuildFQName(metrics.Namespace, "", "g_project_members"),
 		"A gauge which tracks the number of collected Gardener project members",
-		[]string{"project_name"},
+		[]string{"landscape", "project_name"},
 		nil,
 	)
 
@@ -34,7 +34,7 @@ var (
 	shootsDesc = prometheus.NewDesc(
 		prometheus.BuildFQName(metrics.Namespace, "", "g_shoots"),
 		"A gauge which tracks the number of collected Gardener shoots",
-		[]string{"project_name"},
+		[]string{"landscape", "project_name"},
 		nil,
 	)
 
@@ -43,7 +43,7 @@ var (
 	seedsDesc = prometheus.NewDesc(
 		prometheus.BuildFQName(metrics.Namespace, "", "g_seeds"),
 		"A gauge which tracks the number of collected Gardener seeds",
-		nil,
+		[]string{"landscape"},
 		nil,
 	)
 
@@ -52,7 +52,16 @@ var (
 	machinesDesc = prometheus.NewDesc(
 		prometheus.BuildFQName(metrics.Namespace, "", "g_machines"),
 		"A gauge which tracks the number of collected Gardener machines",
-		[]string{"seed"},
+		[]string{"landscape", "seed"},
+		nil,
+	)
+
+	// machineClassesDesc is the descriptor for a metric, which tracks the
+	// number of collected MachineClasses from seeds.
+	machineClassesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "g_machine_classes"),
+		"A gauge which tracks the number of collected machine classes",
+		[]string{"landscape", "seed"},
 		nil,
 	)
 
@@ -61,7 +70,16 @@ var (
 	backupBucketsDesc = prometheus.NewDesc(
 		prometheus.BuildFQName(metrics.Namespace, "", "g_backup_buckets"),
 		"A gauge which tracks the number of collected Gardener backup buckets",
+		[]string{"landscape"},
 		nil,
+	)
+
+	// backupEntriesDesc is the descriptor for a metric, which tracks the
+	// number of collected Gardener Backup Entries.
+	backupEntriesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "g_backup_entries"),
+		"A gauge which tracks the number of collected Gardener backup entries",
+		[]string{"landscape"},
 		nil,
 	)
 
@@ -70,7 +88,7 @@ var (
 	cloudProfilesDesc = prometheus.NewDesc(
 		prometheus.BuildFQName(metrics.Namespace, "", "g_cloud_profiles"),
 		"A gauge which tracks the number of collected Gardener Cloud Profiles",
-		nil,
+		[]string{"landscape"},
 		nil,
 	)
 
@@ -79,7 +97,7 @@ var (
 	seedVolumesDesc = prometheus.NewDesc(
 		prometheus.BuildFQName(metrics.Namespace, "", "g_seed_volumes"),
 		"A gauge which tracks the number of collected persistent volumes from seeds",
-		[]string{"seed"},
+		[]string{"landscape", "seed"},
 		nil,
 	)
 
@@ -88,7 +106,7 @@ var (
 	dnsRecordsDesc = prometheus.NewDesc(
 		prometheus.BuildFQName(metrics.Namespace, "", "g_dns_records"),
 		"A gauge which tracks the number of collected Gardener DNSRecords from seeds",
-		[]string{"seed"},
+		[]string{"landscape", "seed"},
 		nil,
 	)
 
@@ -98,7 +116,7 @@ var (
 		prometheus.BuildFQName(metrics.Namespace, "", "g_dns_entries"),
 		`A gauge which tracks the number of collected Gardener DNSEntry
 		resources from seeds`,
-		[]string{"seed"},
+		[]string{"landscape", "seed"},
 		nil,
 	)
 
@@ -107,7 +125,53 @@ var (
 	bastionsDesc = prometheus.NewDesc(
 		prometheus.BuildFQName(metrics.Namespace, "", "g_bastions"),
 		"A gauge which tracks the number of collected Gardener Bastions",
-		[]string{"seed"},
+		[]string{"landscape", "seed"},
+		nil,
+	)
+
+	// resourceQuotasDesc is the descriptor for a metric, which tracks the
+	// number of collected resource limits from the ResourceQuotas of
+	// Gardener project namespaces.
+	resourceQuotasDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "g_resource_quotas"),
+		"A gauge which tracks the number of collected resource quota limits per project",
+		[]string{"landscape", "project_name"},
+		nil,
+	)
+
+	// machineImageFreshnessDesc is the descriptor for a metric, which
+	// tracks the number of machines per cloud profile and image status.
+	machineImageFreshnessDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "g_machine_image_freshness"),
+		"A gauge which tracks the number of machines by the status of their machine image",
+		[]string{"landscape", "cloud_profile", "status"},
+		nil,
+	)
+
+	// k8sVersionsDesc is the descriptor for a metric, which tracks the
+	// number of seeds and shoots per Kubernetes minor version.
+	k8sVersionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "g_k8s_versions"),
+		"A gauge which tracks the number of seeds and shoots per Kubernetes minor version",
+		[]string{"landscape", "kind", "version"},
+		nil,
+	)
+
+	// k8sVersionSkewDesc is the descriptor for a metric, which tracks the
+	// number of shoots lagging behind with their Kubernetes version.
+	k8sVersionSkewDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "g_k8s_version_skew"),
+		"A gauge which tracks the number of shoots lagging behind with their Kubernetes version",
+		[]string{"landscape", "cloud_profile", "reason"},
+		nil,
+	)
+
+	// staleBackupsDesc is the descriptor for a metric, which tracks the
+	// number of shoots with stale etcd backups.
+	staleBackupsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "g_stale_backups"),
+		"A gauge which tracks the number of shoots whose etcd backups are stale",
+		[]string{"landscape", "seed", "reason"},
 		nil,
 	)
 )
@@ -120,11 +184,18 @@ func init() {
 		shootsDesc,
 		seedsDesc,
 		machinesDesc,
+		machineClassesDesc,
 		backupBucketsDesc,
+		backupEntriesDesc,
 		cloudProfilesDesc,
 		seedVolumesDesc,
 		dnsRecordsDesc,
 		dnsEntriesDesc,
 		bastionsDesc,
+		resourceQuotasDesc,
+		machineImageFreshnessDesc,
+		k8sVersionsDesc,
+		k8sVersionSkewDesc,
+		staleBackupsDesc,
 	)
 }
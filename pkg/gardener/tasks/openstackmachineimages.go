@@ -61,7 +61,7 @@ func collectOpenStackMachineImages(ctx context.Context, payload CollectCPMachine
 	}
 
 	logger := asynqutils.GetLogger(ctx)
-	logger.Info("collecting machine images", "cloud_profile", payload.CloudProfileName)
+	logger.Info("collecting machine images", "cloud_profile", payload.CloudProfileName, "landscape", payload.Landscape)
 	items := make([]models.CloudProfileOpenStackImage, 0)
 	for _, image := range images {
 		for _, version := range image.Versions {
@@ -73,6 +73,7 @@ func collectOpenStackMachineImages(ctx context.Context, payload CollectCPMachine
 					ImageID:          region.ID,
 					Architecture:     ptr.Value(region.Architecture, ""),
 					CloudProfileName: payload.CloudProfileName,
+					Landscape:        payload.Landscape,
 				}
 
 				items = append(items, item)
@@ -88,7 +89,7 @@ func collectOpenStackMachineImages(ctx context.Context, payload CollectCPMachine
 
 	out, err := db.DB.NewInsert().
 		Model(&items).
-		On("CONFLICT (name, version, region_name, image_id, cloud_profile_name) DO UPDATE").
+		On("CONFLICT (name, version, region_name, image_id, cloud_profile_name, landscape) DO UPDATE").
 		Set("architecture = EXCLUDED.architecture").
 		Set("updated_at = EXCLUDED.updated_at").
 		Returning("id").
@@ -47,7 +47,12 @@ func DriverNotSupported(driver string) error {
 type CollectPersistentVolumesPayload struct {
 	// Seed is the name of the seed cluster from which to collect Gardener
 	// PVs.
-	Seed string `json:"seed" yaml:"seed"`
+	Seed string `json:"seed" yaml:"seed" desc:"The name of the seed cluster from which to collect Gardener PVs" example:"aws-ha"`
+
+	// Landscape specifies the name of the Gardener landscape of the seed
+	// cluster. If no seed is specified, tasks for collecting from all
+	// known seeds of the landscape are enqueued.
+	Landscape string `json:"landscape" yaml:"landscape" desc:"The name of the Gardener landscape of the seed cluster" example:"canary"`
 }
 
 // NewCollectPersistentVolumesTask creates a new [asynq.Task] for collecting Gardener
@@ -62,7 +67,7 @@ func HandleCollectPersistentVolumesTask(ctx context.Context, t *asynq.Task) erro
 	// collecting PVs from all known Gardener Seed clusters and the Virtual Garden.
 	data := t.Payload()
 	if data == nil {
-		return enqueueCollectPersistentVolumes(ctx)
+		return enqueueCollectPersistentVolumes(ctx, "")
 	}
 
 	var payload CollectPersistentVolumesPayload
@@ -71,6 +76,10 @@ func HandleCollectPersistentVolumesTask(ctx context.Context, t *asynq.Task) erro
 	}
 
 	if payload.Seed == "" {
+		if payload.Landscape != "" {
+			return enqueueCollectPersistentVolumes(ctx, payload.Landscape)
+		}
+
 		return asynqutils.SkipRetry(ErrNoSeedCluster)
 	}
 
@@ -79,8 +88,8 @@ func HandleCollectPersistentVolumesTask(ctx context.Context, t *asynq.Task) erro
 
 // enqueueCollectPersistentVolumes enqueues tasks for collecting Gardener Volumes from
 // all known Seed Clusters and the Virtual Garden.
-func enqueueCollectPersistentVolumes(ctx context.Context) error {
-	seeds, err := gutils.GetSeedsFromDB(ctx)
+func enqueueCollectPersistentVolumes(ctx context.Context, landscape string) error {
+	seeds, err := gutils.GetSeedsFromDB(ctx, landscape)
 	if err != nil {
 		return fmt.Errorf("failed to get seeds from db: %w", err)
 	}
@@ -91,7 +100,8 @@ func enqueueCollectPersistentVolumes(ctx context.Context) error {
 	// Create a task for each known seed cluster
 	for _, s := range seeds {
 		payload := CollectPersistentVolumesPayload{
-			Seed: s.Name,
+			Seed:      s.Name,
+			Landscape: landscape,
 		}
 		data, err := json.Marshal(payload)
 		if err != nil {
@@ -105,7 +115,17 @@ func enqueueCollectPersistentVolumes(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectPersistentVolumes, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"seed", s.Name,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -133,8 +153,9 @@ func enqueueCollectPersistentVolumes(ctx context.Context) error {
 // specified in the payload.
 func collectPersistentVolumes(ctx context.Context, payload CollectPersistentVolumesPayload) error {
 	logger := asynqutils.GetLogger(ctx)
-	if !gardenerclient.IsDefaultClientSet() {
-		logger.Warn("gardener client not configured")
+	gardenClient, ok := gardenerclient.GetClient(payload.Landscape)
+	if !ok {
+		logger.Warn("gardener client not configured", "landscape", payload.Landscape)
 
 		return nil
 	}
@@ -145,14 +166,15 @@ func collectPersistentVolumes(ctx context.Context, payload CollectPersistentVolu
 			seedVolumesDesc,
 			prometheus.GaugeValue,
 			float64(count),
+			payload.Landscape,
 			payload.Seed,
 		)
-		key := metrics.Key(TaskCollectPersistentVolumes, payload.Seed)
+		key := metrics.Key(TaskCollectPersistentVolumes, payload.Landscape, payload.Seed)
 		metrics.DefaultCollector.AddMetric(key, metric)
 	}()
 
 	logger.Info("collecting Gardener Persistent Volumes", "seed", payload.Seed)
-	client, err := gardenerclient.DefaultClient.SeedClient(ctx, payload.Seed)
+	client, err := gardenClient.SeedClient(ctx, payload.Seed)
 	if err != nil {
 		if errors.Is(err, gardenerclient.ErrSeedIsExcluded) {
 			// Don't treat excluded seeds as errors, in order to
@@ -168,6 +190,7 @@ func collectPersistentVolumes(ctx context.Context, payload CollectPersistentVolu
 	pvs := make([]models.PersistentVolume, 0)
 	p := pager.New(
 		pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+			metrics.IncPages(ctx)
 			return client.CoreV1().PersistentVolumes().List(ctx, opts)
 		}),
 	)
@@ -231,15 +254,22 @@ func collectPersistentVolumes(ctx context.Context, payload CollectPersistentVolu
 		if pv.Spec.VolumeMode != nil {
 			volumeMode = string(*pv.Spec.VolumeMode)
 		}
+
+		var claimNamespace string
+		if pv.Spec.ClaimRef != nil {
+			claimNamespace = pv.Spec.ClaimRef.Namespace
+		}
 		item := models.PersistentVolume{
 			Name:              pv.GetName(),
 			SeedName:          payload.Seed,
+			Landscape:         gardenClient.Landscape(),
 			Provider:          sourceName,
 			DiskRef:           diskRef,
 			Status:            string(pv.Status.Phase),
 			Capacity:          pv.Spec.Capacity.Storage().String(),
 			StorageClass:      pv.Spec.StorageClassName,
 			VolumeMode:        volumeMode,
+			ClaimNamespace:    claimNamespace,
 			CreationTimestamp: pv.CreationTimestamp.Time,
 		}
 		pvs = append(pvs, item)
@@ -257,13 +287,14 @@ func collectPersistentVolumes(ctx context.Context, payload CollectPersistentVolu
 
 	out, err := db.DB.NewInsert().
 		Model(&pvs).
-		On("CONFLICT (name, seed_name) DO UPDATE").
+		On("CONFLICT (name, seed_name, landscape) DO UPDATE").
 		Set("provider = EXCLUDED.provider").
 		Set("disk_ref = EXCLUDED.disk_ref").
 		Set("status = EXCLUDED.status").
 		Set("capacity = EXCLUDED.capacity").
 		Set("storage_class = EXCLUDED.storage_class").
 		Set("volume_mode = EXCLUDED.volume_mode").
+		Set("claim_namespace = EXCLUDED.claim_namespace").
 		Set("creation_timestamp = EXCLUDED.creation_timestamp").
 		Set("updated_at = EXCLUDED.updated_at").
 		Returning("id").
@@ -36,7 +36,12 @@ const (
 // Projects.
 type CollectProjectsPayload struct {
 	// ProjectName specifies name of the Gardener Project to be collected.
-	ProjectName string `json:"project_name" yaml:"project_name"`
+	ProjectName string `json:"project_name" yaml:"project_name" desc:"Name of the Gardener Project to be collected" example:"my-project"`
+
+	// Landscape specifies the name of the Gardener landscape. If no
+	// project name is specified, all projects of the landscape are
+	// collected.
+	Landscape string `json:"landscape" yaml:"landscape" desc:"Name of the Gardener landscape" example:"canary"`
 }
 
 // NewCollectProjectsTask creates a new [asynq.Task] for collecting Gardener
@@ -50,7 +55,7 @@ func HandleCollectProjectsTask(ctx context.Context, t *asynq.Task) error {
 	// If we were called without a payload then we collect all projects.
 	data := t.Payload()
 	if data == nil {
-		return collectAllProjects(ctx)
+		return collectAllProjects(ctx, "")
 	}
 
 	var payload CollectProjectsPayload
@@ -59,6 +64,10 @@ func HandleCollectProjectsTask(ctx context.Context, t *asynq.Task) error {
 	}
 
 	if payload.ProjectName == "" {
+		if payload.Landscape != "" {
+			return collectAllProjects(ctx, payload.Landscape)
+		}
+
 		return asynqutils.SkipRetry(ErrNoProjectName)
 	}
 
@@ -68,43 +77,46 @@ func HandleCollectProjectsTask(ctx context.Context, t *asynq.Task) error {
 // collectProject collects a single Gardener Project.
 func collectProject(ctx context.Context, payload CollectProjectsPayload) error {
 	logger := asynqutils.GetLogger(ctx)
-	if !gardenerclient.IsDefaultClientSet() {
-		logger.Warn("gardener client not configured")
+	gardenClient, ok := gardenerclient.GetClient(payload.Landscape)
+	if !ok {
+		logger.Warn("gardener client not configured", "landscape", payload.Landscape)
 
 		return nil
 	}
 
-	client := gardenerclient.DefaultClient.GardenClient()
-	logger.Info("collecting Gardener project", "project", payload.ProjectName)
+	client := gardenClient.GardenClient()
+	logger.Info("collecting Gardener project", "project", payload.ProjectName, "landscape", payload.Landscape)
 
 	result, err := client.CoreV1beta1().Projects().Get(ctx, payload.ProjectName, metav1.GetOptions{})
 	if err != nil {
 		return err
 	}
 
-	projects, members := toProjectModels([]*v1beta1.Project{result})
-	if err := persistProjects(ctx, projects); err != nil {
+	projects, members := toProjectModels(gardenClient.Landscape(), []*v1beta1.Project{result})
+	if err := persistProjects(ctx, gardenClient.Landscape(), projects); err != nil {
 		return err
 	}
 
-	return persistProjectMembers(ctx, members)
+	return persistProjectMembers(ctx, gardenClient.Landscape(), members)
 }
 
-// collectAllProjects collects all projects from Gardener.
-func collectAllProjects(ctx context.Context) error {
+// collectAllProjects collects all projects from the given Gardener landscape.
+func collectAllProjects(ctx context.Context, landscape string) error {
 	logger := asynqutils.GetLogger(ctx)
-	if !gardenerclient.IsDefaultClientSet() {
-		logger.Warn("gardener client not configured")
+	gardenClient, ok := gardenerclient.GetClient(landscape)
+	if !ok {
+		logger.Warn("gardener client not configured", "landscape", landscape)
 
 		return nil
 	}
 
-	client := gardenerclient.DefaultClient.GardenClient()
-	logger.Info("collecting Gardener projects")
+	client := gardenClient.GardenClient()
+	logger.Info("collecting Gardener projects", "landscape", landscape)
 	items := make([]*v1beta1.Project, 0)
 
 	p := pager.New(
 		pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+			metrics.IncPages(ctx)
 			return client.CoreV1beta1().Projects().List(ctx, opts)
 		}),
 	)
@@ -123,18 +135,18 @@ func collectAllProjects(ctx context.Context) error {
 		return err
 	}
 
-	projects, members := toProjectModels(items)
-	if err := persistProjects(ctx, projects); err != nil {
+	projects, members := toProjectModels(gardenClient.Landscape(), items)
+	if err := persistProjects(ctx, gardenClient.Landscape(), projects); err != nil {
 		return err
 	}
 
-	return persistProjectMembers(ctx, members)
+	return persistProjectMembers(ctx, gardenClient.Landscape(), members)
 }
 
-// toProjectModels converts the given slice of [v1beta1.Project] items into
-// [models.Projects] and [models.ProjectMember] slices, suitable for persisting
-// into the database.
-func toProjectModels(items []*v1beta1.Project) ([]models.Project, []models.ProjectMember) {
+// toProjectModels converts the given slice of [v1beta1.Project] items from the
+// given landscape into [models.Projects] and [models.ProjectMember] slices,
+// suitable for persisting into the database.
+func toProjectModels(landscape string, items []*v1beta1.Project) ([]models.Project, []models.ProjectMember) {
 	projects := make([]models.Project, 0)
 	members := make([]models.ProjectMember, 0)
 
@@ -142,6 +154,7 @@ func toProjectModels(items []*v1beta1.Project) ([]models.Project, []models.Proje
 		// Collect projects
 		projectItem := models.Project{
 			Name:              p.Name,
+			Landscape:         landscape,
 			Namespace:         ptr.StringFromPointer(p.Spec.Namespace),
 			Status:            string(p.Status.Phase),
 			Purpose:           ptr.StringFromPointer(p.Spec.Purpose),
@@ -161,6 +174,7 @@ func toProjectModels(items []*v1beta1.Project) ([]models.Project, []models.Proje
 				Kind:        member.Kind,
 				Role:        member.Role,
 				ProjectName: p.Name,
+				Landscape:   landscape,
 			}
 			members = append(members, memberItem)
 		}
@@ -169,16 +183,19 @@ func toProjectModels(items []*v1beta1.Project) ([]models.Project, []models.Proje
 	return projects, members
 }
 
-// persistProjects persists the provided projects into the database.
-func persistProjects(ctx context.Context, items []models.Project) error {
+// persistProjects persists the provided projects from the given landscape into
+// the database.
+func persistProjects(ctx context.Context, landscape string, items []models.Project) error {
 	var count int64
 	defer func() {
 		metric := prometheus.MustNewConstMetric(
 			projectsDesc,
 			prometheus.GaugeValue,
 			float64(count),
+			landscape,
 		)
-		metrics.DefaultCollector.AddMetric(TaskCollectProjects, metric)
+		key := metrics.Key(TaskCollectProjects, landscape)
+		metrics.DefaultCollector.AddMetric(key, metric)
 	}()
 
 	if len(items) == 0 {
@@ -187,7 +204,7 @@ func persistProjects(ctx context.Context, items []models.Project) error {
 
 	out, err := db.DB.NewInsert().
 		Model(&items).
-		On("CONFLICT (name) DO UPDATE").
+		On("CONFLICT (name, landscape) DO UPDATE").
 		Set("namespace = EXCLUDED.namespace").
 		Set("status = EXCLUDED.status").
 		Set("purpose = EXCLUDED.purpose").
@@ -212,8 +229,9 @@ func persistProjects(ctx context.Context, items []models.Project) error {
 	return nil
 }
 
-// persistProjectMembers persists the given project members into the database.
-func persistProjectMembers(ctx context.Context, items []models.ProjectMember) error {
+// persistProjectMembers persists the given project members from the given
+// landscape into the database.
+func persistProjectMembers(ctx context.Context, landscape string, items []models.ProjectMember) error {
 	var err error
 
 	// Group members by project and emit metrics
@@ -226,9 +244,10 @@ func persistProjectMembers(ctx context.Context, items []models.ProjectMember) er
 				projectMembersDesc,
 				prometheus.GaugeValue,
 				float64(len(members)),
+				landscape,
 				projectName,
 			)
-			key := metrics.Key(TaskCollectProjects, "members", projectName)
+			key := metrics.Key(TaskCollectProjects, "members", landscape, projectName)
 			metrics.DefaultCollector.AddMetric(key, metric)
 		}
 	}()
@@ -239,7 +258,7 @@ func persistProjectMembers(ctx context.Context, items []models.ProjectMember) er
 
 	out, err := db.DB.NewInsert().
 		Model(&items).
-		On("CONFLICT (name, project_name) DO UPDATE").
+		On("CONFLICT (name, project_name, landscape) DO UPDATE").
 		Set("kind = EXCLUDED.kind").
 		Set("role = EXCLUDED.role").
 		Set("updated_at = EXCLUDED.updated_at").
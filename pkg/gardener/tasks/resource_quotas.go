@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/pager"
+
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	"github.com/gardener/inventory/pkg/clients/db"
+	gardenerclient "github.com/gardener/inventory/pkg/clients/gardener"
+	"github.com/gardener/inventory/pkg/gardener/constants"
+	"github.com/gardener/inventory/pkg/gardener/models"
+	gutils "github.com/gardener/inventory/pkg/gardener/utils"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+const (
+	// TaskCollectResourceQuotas is the name of the task for collecting the
+	// ResourceQuotas of Gardener project namespaces.
+	TaskCollectResourceQuotas = "g:task:collect-resource-quotas"
+)
+
+// CollectResourceQuotasPayload is the payload, which is used for collecting
+// the ResourceQuotas of a Gardener project namespace.
+type CollectResourceQuotasPayload struct {
+	// ProjectName is the name of the project from which to collect the
+	// ResourceQuotas.
+	ProjectName string `json:"project_name" yaml:"project_name" desc:"The name of the project from which to collect the ResourceQuotas" example:"my-project"`
+
+	// ProjectNamespace is the namespace associated with the project.
+	ProjectNamespace string `json:"project_namespace" yaml:"project_namespace" desc:"The namespace associated with the project" example:"garden-my-project"`
+
+	// Landscape specifies the name of the Gardener landscape. If no
+	// project name is specified, tasks for collecting the ResourceQuotas
+	// of all known projects of the landscape are enqueued.
+	Landscape string `json:"landscape" yaml:"landscape" desc:"The name of the Gardener landscape" example:"canary"`
+}
+
+// NewCollectResourceQuotasTask creates a new [asynq.Task] for collecting the
+// ResourceQuotas of Gardener project namespaces, without specifying a payload.
+func NewCollectResourceQuotasTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectResourceQuotas, nil)
+}
+
+// HandleCollectResourceQuotasTask is the handler, which collects the
+// ResourceQuotas of Gardener project namespaces.
+func HandleCollectResourceQuotasTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we enqueue tasks for
+	// collecting the ResourceQuotas of all known projects.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectResourceQuotas(ctx, "")
+	}
+
+	var payload CollectResourceQuotasPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.ProjectName == "" {
+		if payload.Landscape != "" {
+			return enqueueCollectResourceQuotas(ctx, payload.Landscape)
+		}
+
+		return asynqutils.SkipRetry(ErrNoProjectName)
+	}
+
+	if payload.ProjectNamespace == "" {
+		return asynqutils.SkipRetry(ErrNoProjectNamespace)
+	}
+
+	return collectResourceQuotas(ctx, payload)
+}
+
+// enqueueCollectResourceQuotas enqueues tasks for collecting the ResourceQuotas
+// of all locally known projects of the given landscape.
+func enqueueCollectResourceQuotas(ctx context.Context, landscape string) error {
+	projects, err := gutils.GetProjectsFromDB(ctx, landscape)
+	if err != nil {
+		return fmt.Errorf("failed to get projects from db: %w", err)
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	queue := asynqutils.GetQueueName(ctx)
+
+	// Create a task for each known project
+	for _, p := range projects {
+		// Projects, which are not yet fully reconciled, may not have
+		// a namespace.
+		if p.Namespace == "" {
+			continue
+		}
+
+		payload := CollectResourceQuotasPayload{
+			ProjectName:      p.Name,
+			ProjectNamespace: p.Namespace,
+			Landscape:        landscape,
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for Gardener ResourceQuotas",
+				"project", p.Name,
+				"namespace", p.Namespace,
+				"reason", err,
+			)
+
+			continue
+		}
+
+		task := asynq.NewTask(TaskCollectResourceQuotas, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", p.Name,
+				"namespace", p.Namespace,
+			)
+
+			continue
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"project", p.Name,
+				"namespace", p.Namespace,
+				"reason", err,
+			)
+
+			continue
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"project", p.Name,
+			"namespace", p.Namespace,
+			"landscape", landscape,
+		)
+	}
+
+	return nil
+}
+
+// collectResourceQuotas collects the ResourceQuotas from the namespace of the
+// project specified in the payload.
+func collectResourceQuotas(ctx context.Context, payload CollectResourceQuotasPayload) error {
+	logger := asynqutils.GetLogger(ctx)
+	gardenClient, ok := gardenerclient.GetClient(payload.Landscape)
+	if !ok {
+		logger.Warn("gardener client not configured", "landscape", payload.Landscape)
+
+		return nil
+	}
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			resourceQuotasDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.Landscape,
+			payload.ProjectName,
+		)
+		key := metrics.Key(TaskCollectResourceQuotas, payload.Landscape, payload.ProjectName)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	logger.Info(
+		"collecting Gardener resource quotas",
+		"project", payload.ProjectName,
+		"namespace", payload.ProjectNamespace,
+		"landscape", payload.Landscape,
+	)
+
+	client, err := kubernetes.NewForConfig(gardenClient.RESTConfig())
+	if err != nil {
+		return asynqutils.SkipRetry(fmt.Errorf("cannot create client for resource quotas: %w", err))
+	}
+
+	p := pager.New(
+		pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+			metrics.IncPages(ctx)
+			return client.CoreV1().ResourceQuotas(payload.ProjectNamespace).List(ctx, opts)
+		}),
+	)
+
+	items := make([]models.ResourceQuota, 0)
+	opts := metav1.ListOptions{Limit: constants.PageSize}
+	err = p.EachListItem(ctx, opts, func(obj runtime.Object) error {
+		rq, ok := obj.(*corev1.ResourceQuota)
+		if !ok {
+			return fmt.Errorf("unexpected object type: %T", obj)
+		}
+
+		for resource, limit := range rq.Spec.Hard {
+			var usage float64
+			if used, ok := rq.Status.Used[resource]; ok {
+				usage = used.AsApproximateFloat64()
+			}
+
+			item := models.ResourceQuota{
+				Name:        rq.Name,
+				Namespace:   rq.Namespace,
+				Resource:    string(resource),
+				Landscape:   gardenClient.Landscape(),
+				ProjectName: payload.ProjectName,
+				Limit:       limit.AsApproximateFloat64(),
+				Usage:       usage,
+			}
+			items = append(items, item)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		logger.Error(
+			"cannot list resource quotas",
+			"project", payload.ProjectName,
+			"namespace", payload.ProjectNamespace,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (name, namespace, resource, landscape) DO UPDATE").
+		Set("project_name = EXCLUDED.project_name").
+		Set("quota_limit = EXCLUDED.quota_limit").
+		Set("usage = EXCLUDED.usage").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert gardener resource quotas into db",
+			"project", payload.ProjectName,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated gardener resource quotas",
+		"project", payload.ProjectName,
+		"count", count,
+	)
+
+	return nil
+}
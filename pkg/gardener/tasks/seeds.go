@@ -8,16 +8,11 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/hibiken/asynq"
 	"github.com/prometheus/client_golang/prometheus"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/client-go/tools/pager"
 
 	"github.com/gardener/inventory/pkg/clients/db"
 	gardenerclient "github.com/gardener/inventory/pkg/clients/gardener"
-	"github.com/gardener/inventory/pkg/gardener/constants"
 	"github.com/gardener/inventory/pkg/gardener/models"
 	"github.com/gardener/inventory/pkg/metrics"
 	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
@@ -36,11 +31,19 @@ func NewCollectSeedsTask() *asynq.Task {
 	return asynq.NewTask(TaskCollectSeeds, nil)
 }
 
-// HandleCollectSeedsTask is the handler for collecting Gardener Seeds.
-func HandleCollectSeedsTask(ctx context.Context, _ *asynq.Task) error {
+// HandleCollectSeedsTask is the handler for collecting Gardener Seeds. The
+// Seeds are collected from the primary Gardener landscape, unless a landscape is
+// specified via the [LandscapePayload].
+func HandleCollectSeedsTask(ctx context.Context, t *asynq.Task) error {
+	payload, err := getLandscapePayload(t)
+	if err != nil {
+		return err
+	}
+
 	logger := asynqutils.GetLogger(ctx)
-	if !gardenerclient.IsDefaultClientSet() {
-		logger.Warn("gardener client not configured")
+	gardenClient, ok := gardenerclient.GetClient(payload.Landscape)
+	if !ok {
+		logger.Warn("gardener client not configured", "landscape", payload.Landscape)
 
 		return nil
 	}
@@ -51,36 +54,31 @@ func HandleCollectSeedsTask(ctx context.Context, _ *asynq.Task) error {
 			seedsDesc,
 			prometheus.GaugeValue,
 			float64(count),
+			payload.Landscape,
 		)
-		metrics.DefaultCollector.AddMetric(TaskCollectSeeds, metric)
+		key := metrics.Key(TaskCollectSeeds, payload.Landscape)
+		metrics.DefaultCollector.AddMetric(key, metric)
 	}()
 
-	client := gardenerclient.DefaultClient.GardenClient()
-	logger.Info("collecting Gardener seeds")
-	seeds := make([]models.Seed, 0)
-	p := pager.New(
-		pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
-			return client.CoreV1beta1().Seeds().List(ctx, opts)
-		}),
+	logger.Info(
+		"collecting Gardener seeds",
+		"landscape", payload.Landscape,
+		"cached", gardenClient.IsCacheSynced(),
 	)
-	opts := metav1.ListOptions{Limit: constants.PageSize}
-	err := p.EachListItem(ctx, opts, func(obj runtime.Object) error {
-		s, ok := obj.(*v1beta1.Seed)
-		if !ok {
-			return fmt.Errorf("unexpected object type: %T", obj)
-		}
+	items, err := gardenClient.Seeds(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list seeds: %w", err)
+	}
+
+	seeds := make([]models.Seed, 0, len(items))
+	for _, s := range items {
 		item := models.Seed{
 			Name:              s.Name,
+			Landscape:         gardenClient.Landscape(),
 			KubernetesVersion: ptr.StringFromPointer(s.Status.KubernetesVersion),
 			CreationTimestamp: s.CreationTimestamp.Time,
 		}
 		seeds = append(seeds, item)
-
-		return nil
-	})
-
-	if err != nil {
-		return fmt.Errorf("could not list seeds: %w", err)
 	}
 
 	if len(seeds) == 0 {
@@ -89,7 +87,7 @@ func HandleCollectSeedsTask(ctx context.Context, _ *asynq.Task) error {
 
 	out, err := db.DB.NewInsert().
 		Model(&seeds).
-		On("CONFLICT (name) DO UPDATE").
+		On("CONFLICT (name, landscape) DO UPDATE").
 		Set("kubernetes_version = EXCLUDED.kubernetes_version").
 		Set("creation_timestamp = EXCLUDED.creation_timestamp").
 		Set("updated_at = EXCLUDED.updated_at").
@@ -110,7 +108,7 @@ func HandleCollectSeedsTask(ctx context.Context, _ *asynq.Task) error {
 		return err
 	}
 
-	logger.Info("populated gardener seeds", "count", count)
+	logger.Info("populated gardener seeds", "landscape", payload.Landscape, "count", count)
 
 	return nil
 }
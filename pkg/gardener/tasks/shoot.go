@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"github.com/hibiken/asynq"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// newShootCollectTasks returns the tasks for collecting the Gardener resources
+// of the Shoot described by the given scope, i.e. the Shoot itself along with
+// the Machines and Persistent Volumes from the Seed hosting its control plane.
+func newShootCollectTasks(scope registry.ShootScope) ([]*asynq.Task, error) {
+	payloads := []struct {
+		name    string
+		payload any
+	}{
+		{TaskCollectShoots, CollectShootsPayload{ProjectName: scope.ProjectName, ProjectNamespace: scope.Namespace, Landscape: scope.Landscape}},
+		{TaskCollectMachines, CollectMachinesPayload{Seed: scope.SeedName, Landscape: scope.Landscape}},
+		{TaskCollectPersistentVolumes, CollectPersistentVolumesPayload{Seed: scope.SeedName, Landscape: scope.Landscape}},
+	}
+
+	items := make([]*asynq.Task, 0, len(payloads))
+	for _, item := range payloads {
+		task, err := asynqutils.NewTask(item.name, item.payload)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, task)
+	}
+
+	return items, nil
+}
+
+func init() {
+	registry.ShootCollectorRegistry.MustRegister("g", registry.ShootCollector{
+		NewTasks:  newShootCollectTasks,
+		LinkTasks: []string{TaskLinkAll},
+	})
+}
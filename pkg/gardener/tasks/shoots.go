@@ -7,20 +7,21 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"net/url"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/hibiken/asynq"
 	"github.com/prometheus/client_golang/prometheus"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/client-go/tools/pager"
 
 	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
 	"github.com/gardener/inventory/pkg/clients/db"
 	gardenerclient "github.com/gardener/inventory/pkg/clients/gardener"
-	"github.com/gardener/inventory/pkg/gardener/constants"
 	"github.com/gardener/inventory/pkg/gardener/models"
 	gutils "github.com/gardener/inventory/pkg/gardener/utils"
 	"github.com/gardener/inventory/pkg/metrics"
@@ -41,7 +42,7 @@ const (
 type CollectShootsPayload struct {
 	// ProjectName specifies the name of the project from which to collect
 	// shoots.
-	ProjectName string `yaml:"project_name" json:"project_name"`
+	ProjectName string `yaml:"project_name" json:"project_name" desc:"The name of the project from which to collect shoots" example:"my-project"`
 
 	// ProjectNamespace represents the namespace associated with the
 	// project.
@@ -58,7 +59,12 @@ type CollectShootsPayload struct {
 	//
 	// In order to collect all shoots via the cluster-scoped API an empty
 	// project namespace may be used.
-	ProjectNamespace string `yaml:"project_namespace" json:"project_namespace"`
+	ProjectNamespace string `yaml:"project_namespace" json:"project_namespace" desc:"The namespace associated with the project" example:"garden-my-project"`
+
+	// Landscape specifies the name of the Gardener landscape. If no
+	// project name is specified, tasks for collecting shoots from all
+	// known projects of the landscape are enqueued.
+	Landscape string `yaml:"landscape" json:"landscape" desc:"The name of the Gardener landscape" example:"canary"`
 }
 
 func getCloudProfileName(s v1beta1.Shoot) (string, error) {
@@ -85,7 +91,7 @@ func HandleCollectShootsTask(ctx context.Context, t *asynq.Task) error {
 	// collecting shoots from all known projects.
 	data := t.Payload()
 	if data == nil {
-		return enqueueCollectShoots(ctx)
+		return enqueueCollectShoots(ctx, "")
 	}
 
 	var payload CollectShootsPayload
@@ -94,6 +100,10 @@ func HandleCollectShootsTask(ctx context.Context, t *asynq.Task) error {
 	}
 
 	if payload.ProjectName == "" {
+		if payload.Landscape != "" {
+			return enqueueCollectShoots(ctx, payload.Landscape)
+		}
+
 		return asynqutils.SkipRetry(ErrNoProjectName)
 	}
 
@@ -101,9 +111,9 @@ func HandleCollectShootsTask(ctx context.Context, t *asynq.Task) error {
 }
 
 // enqueueCollectShoots enqueues tasks for collecting Gardener shoots from all
-// locally known projects.
-func enqueueCollectShoots(ctx context.Context) error {
-	projects, err := gutils.GetProjectsFromDB(ctx)
+// locally known projects of the given landscape.
+func enqueueCollectShoots(ctx context.Context, landscape string) error {
+	projects, err := gutils.GetProjectsFromDB(ctx, landscape)
 	if err != nil {
 		return err
 	}
@@ -116,6 +126,7 @@ func enqueueCollectShoots(ctx context.Context) error {
 		payload := CollectShootsPayload{
 			ProjectName:      p.Name,
 			ProjectNamespace: p.Namespace,
+			Landscape:        landscape,
 		}
 		data, err := json.Marshal(payload)
 		if err != nil {
@@ -130,7 +141,18 @@ func enqueueCollectShoots(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectShoots, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", p.Name,
+				"namespace", p.Namespace,
+			)
+
+			continue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -150,6 +172,7 @@ func enqueueCollectShoots(ctx context.Context) error {
 			"queue", info.Queue,
 			"project", p.Name,
 			"namespace", p.Namespace,
+			"landscape", landscape,
 		)
 	}
 
@@ -160,8 +183,9 @@ func enqueueCollectShoots(ctx context.Context) error {
 // the payload.
 func collectShoots(ctx context.Context, payload CollectShootsPayload) error {
 	logger := asynqutils.GetLogger(ctx)
-	if !gardenerclient.IsDefaultClientSet() {
-		logger.Warn("gardener client not configured")
+	gardenClient, ok := gardenerclient.GetClient(payload.Landscape)
+	if !ok {
+		logger.Warn("gardener client not configured", "landscape", payload.Landscape)
 
 		return nil
 	}
@@ -172,32 +196,31 @@ func collectShoots(ctx context.Context, payload CollectShootsPayload) error {
 			shootsDesc,
 			prometheus.GaugeValue,
 			float64(count),
+			payload.Landscape,
 			payload.ProjectName,
 		)
-		key := metrics.Key(TaskCollectShoots, payload.ProjectName)
+		key := metrics.Key(TaskCollectShoots, payload.Landscape, payload.ProjectName)
 		metrics.DefaultCollector.AddMetric(key, metric)
 	}()
 
-	client := gardenerclient.DefaultClient.GardenClient()
 	logger.Info(
 		"collecting Gardener shoots",
 		"project", payload.ProjectName,
 		"namespace", payload.ProjectNamespace,
+		"landscape", payload.Landscape,
+		"cached", gardenClient.IsCacheSynced(),
 	)
 
-	shoots := make([]models.Shoot, 0)
-	p := pager.New(
-		pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
-			return client.CoreV1beta1().Shoots(payload.ProjectNamespace).List(ctx, opts)
-		}),
-	)
-	opts := metav1.ListOptions{Limit: constants.PageSize}
-	err := p.EachListItem(ctx, opts, func(obj runtime.Object) error {
-		s, ok := obj.(*v1beta1.Shoot)
-		if !ok {
-			return fmt.Errorf("unexpected object type: %T", obj)
-		}
+	items, err := gardenClient.Shoots(ctx, payload.ProjectNamespace)
+	if err != nil {
+		return fmt.Errorf("could not list shoots: %w", err)
+	}
 
+	shoots := make([]models.Shoot, 0, len(items))
+	schedules := make([]models.ShootHibernationSchedule, 0)
+	addresses := make([]models.ShootAdvertisedAddress, 0)
+	now := time.Now()
+	for _, s := range items {
 		projectName, _ := strings.CutPrefix(s.Namespace, shootProjectPrefix)
 		// Skip shoots which don't have a technical id yet.
 		if s.Status.TechnicalID == "" {
@@ -208,7 +231,7 @@ func collectShoots(ctx context.Context, payload CollectShootsPayload) error {
 				"reason", "missing technical id",
 			)
 
-			return nil
+			continue
 		}
 
 		cloudProfileName, err := getCloudProfileName(*s)
@@ -230,6 +253,7 @@ func collectShoots(ctx context.Context, payload CollectShootsPayload) error {
 		item := models.Shoot{
 			Name:              s.Name,
 			TechnicalID:       s.Status.TechnicalID,
+			Landscape:         gardenClient.Landscape(),
 			Namespace:         s.Namespace,
 			ProjectName:       projectName,
 			CloudProfile:      cloudProfileName,
@@ -243,14 +267,37 @@ func collectShoots(ctx context.Context, payload CollectShootsPayload) error {
 			CreationTimestamp: s.CreationTimestamp.Time,
 			WorkerGroups:      workerGroups,
 			WorkerPrefixes:    workerPrefixes,
+			ExposureClass:     ptr.StringFromPointer(s.Spec.ExposureClassName),
 		}
-		shoots = append(shoots, item)
 
-		return nil
-	})
+		if s.Spec.DNS != nil {
+			item.DNSDomain = ptr.StringFromPointer(s.Spec.DNS.Domain)
+		}
 
-	if err != nil {
-		return fmt.Errorf("could not list shoots: %w", err)
+		for _, address := range s.Status.AdvertisedAddresses {
+			addresses = append(addresses, toShootAdvertisedAddressModel(item, address))
+		}
+
+		if s.Spec.Hibernation != nil {
+			item.HibernationEnabled = ptr.Value(s.Spec.Hibernation.Enabled, false)
+			shootSchedules := make([]models.ShootHibernationSchedule, 0)
+			for _, hs := range s.Spec.Hibernation.Schedules {
+				schedule := toShootHibernationScheduleModel(ctx, item, hs, now)
+				// Skip duplicate schedules, since they would
+				// conflict with each other on insert.
+				if slices.ContainsFunc(shootSchedules, func(other models.ShootHibernationSchedule) bool {
+					return other.Start == schedule.Start && other.End == schedule.End && other.Location == schedule.Location
+				}) {
+					continue
+				}
+				item.NextHibernationAt = earliestTime(item.NextHibernationAt, schedule.NextHibernationAt)
+				item.NextWakeUpAt = earliestTime(item.NextWakeUpAt, schedule.NextWakeUpAt)
+				shootSchedules = append(shootSchedules, schedule)
+			}
+			schedules = append(schedules, shootSchedules...)
+		}
+
+		shoots = append(shoots, item)
 	}
 
 	if len(shoots) == 0 {
@@ -259,7 +306,7 @@ func collectShoots(ctx context.Context, payload CollectShootsPayload) error {
 
 	out, err := db.DB.NewInsert().
 		Model(&shoots).
-		On("CONFLICT (technical_id) DO UPDATE").
+		On("CONFLICT (technical_id, landscape) DO UPDATE").
 		Set("name = EXCLUDED.name").
 		Set("namespace = EXCLUDED.namespace").
 		Set("project_name = EXCLUDED.project_name").
@@ -274,6 +321,11 @@ func collectShoots(ctx context.Context, payload CollectShootsPayload) error {
 		Set("creation_timestamp = EXCLUDED.creation_timestamp").
 		Set("worker_groups = EXCLUDED.worker_groups").
 		Set("worker_prefixes = EXCLUDED.worker_prefixes").
+		Set("hibernation_enabled = EXCLUDED.hibernation_enabled").
+		Set("next_hibernation_at = EXCLUDED.next_hibernation_at").
+		Set("next_wake_up_at = EXCLUDED.next_wake_up_at").
+		Set("exposure_class = EXCLUDED.exposure_class").
+		Set("dns_domain = EXCLUDED.dns_domain").
 		Set("updated_at = EXCLUDED.updated_at").
 		Returning("id").
 		Exec(ctx)
@@ -297,7 +349,150 @@ func collectShoots(ctx context.Context, payload CollectShootsPayload) error {
 		"count", count,
 		"project_name", payload.ProjectName,
 		"project_namespace", payload.ProjectNamespace,
+		"landscape", payload.Landscape,
 	)
 
+	if err := persistShootHibernationSchedules(ctx, schedules); err != nil {
+		return err
+	}
+
+	return persistShootAdvertisedAddresses(ctx, addresses)
+}
+
+// toShootAdvertisedAddressModel converts the given advertised address of the
+// shoot into a [models.ShootAdvertisedAddress].
+func toShootAdvertisedAddressModel(shoot models.Shoot, address v1beta1.ShootAdvertisedAddress) models.ShootAdvertisedAddress {
+	item := models.ShootAdvertisedAddress{
+		TechnicalID: shoot.TechnicalID,
+		Landscape:   shoot.Landscape,
+		Name:        address.Name,
+		URL:         address.URL,
+	}
+
+	if u, err := url.Parse(address.URL); err == nil {
+		item.Host = u.Hostname()
+		item.IP = net.ParseIP(item.Host)
+	}
+
+	return item
+}
+
+// toShootHibernationScheduleModel converts the given hibernation schedule of
+// the shoot into a [models.ShootHibernationSchedule], while computing the next
+// hibernation and wake up times after the given time.
+func toShootHibernationScheduleModel(ctx context.Context, shoot models.Shoot, hs v1beta1.HibernationSchedule, now time.Time) models.ShootHibernationSchedule {
+	logger := asynqutils.GetLogger(ctx)
+	item := models.ShootHibernationSchedule{
+		TechnicalID: shoot.TechnicalID,
+		Landscape:   shoot.Landscape,
+		Start:       ptr.StringFromPointer(hs.Start),
+		End:         ptr.StringFromPointer(hs.End),
+		Location:    ptr.StringFromPointer(hs.Location),
+	}
+
+	next, err := gutils.NextScheduleTime(item.Start, item.Location, now)
+	if err != nil {
+		logger.Warn(
+			"cannot evaluate hibernation schedule",
+			"shoot", shoot.Name,
+			"project", shoot.ProjectName,
+			"spec", item.Start,
+			"location", item.Location,
+			"reason", err,
+		)
+	}
+	item.NextHibernationAt = next
+
+	next, err = gutils.NextScheduleTime(item.End, item.Location, now)
+	if err != nil {
+		logger.Warn(
+			"cannot evaluate hibernation schedule",
+			"shoot", shoot.Name,
+			"project", shoot.ProjectName,
+			"spec", item.End,
+			"location", item.Location,
+			"reason", err,
+		)
+	}
+	item.NextWakeUpAt = next
+
+	return item
+}
+
+// earliestTime returns the earlier of the given times, while ignoring zero
+// times.
+func earliestTime(a, b time.Time) time.Time {
+	switch {
+	case a.IsZero():
+		return b
+	case b.IsZero():
+		return a
+	case b.Before(a):
+		return b
+	default:
+		return a
+	}
+}
+
+// persistShootHibernationSchedules persists the given shoot hibernation
+// schedules into the database.
+func persistShootHibernationSchedules(ctx context.Context, items []models.ShootHibernationSchedule) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (technical_id, landscape, start_spec, end_spec, location) DO UPDATE").
+		Set("next_hibernation_at = EXCLUDED.next_hibernation_at").
+		Set("next_wake_up_at = EXCLUDED.next_wake_up_at").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("populated gardener shoot hibernation schedules", "count", count)
+
+	return nil
+}
+
+// persistShootAdvertisedAddresses persists the given advertised addresses of
+// shoots into the database.
+func persistShootAdvertisedAddresses(ctx context.Context, items []models.ShootAdvertisedAddress) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (technical_id, landscape, name) DO UPDATE").
+		Set("url = EXCLUDED.url").
+		Set("host = EXCLUDED.host").
+		Set("ip = EXCLUDED.ip").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("populated gardener shoot advertised addresses", "count", count)
+
 	return nil
 }
@@ -10,9 +10,11 @@ import (
 	"github.com/hibiken/asynq"
 
 	"github.com/gardener/inventory/pkg/clients/db"
+	gardenerclient "github.com/gardener/inventory/pkg/clients/gardener"
 	"github.com/gardener/inventory/pkg/core/registry"
 	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
 	dbutils "github.com/gardener/inventory/pkg/utils/db"
+	"github.com/gardener/inventory/pkg/utils/schema"
 )
 
 const (
@@ -26,8 +28,15 @@ const (
 )
 
 // HandleCollectAllTask is the handler, which enqueues tasks for collecting all
-// known Gardener resources.
-func HandleCollectAllTask(ctx context.Context, _ *asynq.Task) error {
+// known Gardener resources. If no landscape is specified via the
+// [LandscapePayload], tasks are enqueued for the primary and each of the
+// additional Gardener landscapes.
+func HandleCollectAllTask(ctx context.Context, t *asynq.Task) error {
+	payload, err := getLandscapePayload(t)
+	if err != nil {
+		return err
+	}
+
 	queue := asynqutils.GetQueueName(ctx)
 
 	// Task constructors
@@ -36,15 +45,42 @@ func HandleCollectAllTask(ctx context.Context, _ *asynq.Task) error {
 		NewCollectSeedsTask,
 		NewCollectShootsTask,
 		NewCollectMachinesTask,
+		NewCollectMachineClassesTask,
 		NewCollectBackupBucketsTask,
+		NewCollectBackupEntriesTask,
 		NewCollectCloudProfilesTask,
 		NewCollectPersistentVolumesTask,
 		NewCollectDNSRecordsTask,
 		NewCollectDNSEntriesTask,
 		NewCollectBastionsTask,
+		NewCollectResourceQuotasTask,
+	}
+
+	landscapes := []string{payload.Landscape}
+	if payload.Landscape == "" {
+		if err := asynqutils.Enqueue(ctx, taskFns, asynq.Queue(queue)); err != nil {
+			return err
+		}
+		landscapes = gardenerclient.Landscapes()
+	}
+
+	names := make([]string, 0, len(taskFns))
+	for _, fn := range taskFns {
+		names = append(names, fn().Type())
 	}
 
-	return asynqutils.Enqueue(ctx, taskFns, asynq.Queue(queue))
+	for _, landscape := range landscapes {
+		landscapeTaskFns, err := newLandscapeTasks(landscape, names...)
+		if err != nil {
+			return err
+		}
+
+		if err := asynqutils.Enqueue(ctx, landscapeTaskFns, asynq.Queue(queue)); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // HandleLinkAllTask is the handler, which establishes relationships between the
@@ -70,7 +106,9 @@ func init() {
 	registry.TaskRegistry.MustRegister(TaskCollectSeeds, asynq.HandlerFunc(HandleCollectSeedsTask))
 	registry.TaskRegistry.MustRegister(TaskCollectShoots, asynq.HandlerFunc(HandleCollectShootsTask))
 	registry.TaskRegistry.MustRegister(TaskCollectMachines, asynq.HandlerFunc(HandleCollectMachinesTask))
+	registry.TaskRegistry.MustRegister(TaskCollectMachineClasses, asynq.HandlerFunc(HandleCollectMachineClassesTask))
 	registry.TaskRegistry.MustRegister(TaskCollectBackupBuckets, asynq.HandlerFunc(HandleCollectBackupBucketsTask))
+	registry.TaskRegistry.MustRegister(TaskCollectBackupEntries, asynq.HandlerFunc(HandleCollectBackupEntriesTask))
 	registry.TaskRegistry.MustRegister(TaskCollectCloudProfiles, asynq.HandlerFunc(HandleCollectCloudProfilesTask))
 	registry.TaskRegistry.MustRegister(TaskCollectAWSMachineImages, asynq.HandlerFunc(HandleCollectAWSMachineImagesTask))
 	registry.TaskRegistry.MustRegister(TaskCollectGCPMachineImages, asynq.HandlerFunc(HandleCollectGCPMachineImagesTask))
@@ -80,6 +118,59 @@ func init() {
 	registry.TaskRegistry.MustRegister(TaskCollectDNSRecords, asynq.HandlerFunc(HandleCollectDNSRecordsTask))
 	registry.TaskRegistry.MustRegister(TaskCollectDNSEntries, asynq.HandlerFunc(HandleCollectDNSEntriesTask))
 	registry.TaskRegistry.MustRegister(TaskCollectBastions, asynq.HandlerFunc(HandleCollectBastionsTask))
+	registry.TaskRegistry.MustRegister(TaskCollectResourceQuotas, asynq.HandlerFunc(HandleCollectResourceQuotasTask))
+	registry.TaskRegistry.MustRegister(TaskReportMachineImageFreshness, asynq.HandlerFunc(HandleReportMachineImageFreshnessTask))
+	registry.TaskRegistry.MustRegister(TaskReportK8sVersionSkew, asynq.HandlerFunc(HandleReportK8sVersionSkewTask))
+	registry.TaskRegistry.MustRegister(TaskReportBackupFreshness, asynq.HandlerFunc(HandleReportBackupFreshnessTask))
+	registry.TaskRegistry.MustRegister(TaskExportBackstageCatalog, asynq.HandlerFunc(HandleExportBackstageCatalogTask))
 	registry.TaskRegistry.MustRegister(TaskCollectAll, asynq.HandlerFunc(HandleCollectAllTask))
 	registry.TaskRegistry.MustRegister(TaskLinkAll, asynq.HandlerFunc(HandleLinkAllTask))
+
+	// Payload schemas
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectProjects, schema.For[CollectProjectsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectSeeds, schema.For[LandscapePayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectShoots, schema.For[CollectShootsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectMachines, schema.For[CollectMachinesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectMachineClasses, schema.For[CollectMachineClassesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectBackupBuckets, schema.For[LandscapePayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectBackupEntries, schema.For[LandscapePayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectCloudProfiles, schema.For[LandscapePayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectAWSMachineImages, schema.For[CollectCPMachineImagesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectGCPMachineImages, schema.For[CollectCPMachineImagesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectAzureMachineImages, schema.For[CollectCPMachineImagesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectOpenStackMachineImages, schema.For[CollectCPMachineImagesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectPersistentVolumes, schema.For[CollectPersistentVolumesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectDNSRecords, schema.For[CollectDNSRecordsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectDNSEntries, schema.For[CollectDNSEntriesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectBastions, schema.For[CollectBastionsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectResourceQuotas, schema.For[CollectResourceQuotasPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskReportK8sVersionSkew, schema.For[ReportK8sVersionSkewPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskReportBackupFreshness, schema.For[ReportBackupFreshnessPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskExportBackstageCatalog, schema.For[ExportBackstageCatalogPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectAll, schema.For[LandscapePayload]())
+
+	// Task descriptions
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectProjects, "Collects Gardener Projects.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectSeeds, "Collects Gardener Seeds.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectShoots, "Collects Shoots.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectMachines, "Collects Gardener Machines.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectMachineClasses, "Collects the MachineClasses referenced by MachineDeployments.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectBackupBuckets, "Collects Gardener BackupBuckets resources.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectBackupEntries, "Collects Gardener BackupEntries resources.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectCloudProfiles, "Collects Gardener Cloud Profiles.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectAWSMachineImages, "Collects Machine Images for AWS Cloud Profile type.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectGCPMachineImages, "Collects Machine Images for GCP Cloud Profile type.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectAzureMachineImages, "Collects Machine Images for Azure Cloud Profile type.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectOpenStackMachineImages, "Collects Machine Images for OpenStack Cloud Profile type.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectPersistentVolumes, "Collects Gardener PVs.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectDNSRecords, "Collects Gardener DNSRecords.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectDNSEntries, "Collects Gardener DNSEntry resources.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectBastions, "Collects Gardener Bastions.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectResourceQuotas, "Collects the ResourceQuotas of Gardener project namespaces.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskReportMachineImageFreshness, "Reports the freshness of machine images used by the Gardener machines.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskReportK8sVersionSkew, "Reports the distribution of Kubernetes versions across seeds and shoots, and the shoots which are lagging behind.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskReportBackupFreshness, "Reports the shoots whose etcd backups are stale.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskExportBackstageCatalog, "Exports the projects, seeds and shoots as Backstage catalog entities.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectAll, "Enqueues all relevant Gardener tasks.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskLinkAll, "Links all Gardener related objects.")
 }
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/gardener/models"
+)
+
+// instanceNameSuffix matches the remainder of a Virtual Machine instance name
+// after the worker prefix, i.e. `-z<zone-index>-<pool-hash>-<vm-hash>'.
+var instanceNameSuffix = regexp.MustCompile("^-z.-.{5}-.{5}$")
+
+// trieNode is a node of the prefix trie over the worker prefixes of the shoots.
+type trieNode struct {
+	children map[byte]*trieNode
+
+	// shoots specifies the shoots, whose worker prefix ends at this node.
+	shoots []*models.Shoot
+}
+
+// ShootInferrer infers the shoots from Virtual Machine instance names in
+// memory, using a prefix trie over the worker prefixes of the shoots, which
+// are loaded once.
+//
+// A [ShootInferrer] should be used by collectors, which infer the shoots of
+// many instances, instead of calling [InferShootFromInstanceName] per instance.
+type ShootInferrer struct {
+	root *trieNode
+}
+
+// NewShootInferrer returns a new [ShootInferrer] for the given shoots. The
+// worker prefixes of the shoots must be set.
+func NewShootInferrer(shoots []models.Shoot) *ShootInferrer {
+	si := &ShootInferrer{root: &trieNode{}}
+	for i := range shoots {
+		for _, prefix := range shoots[i].WorkerPrefixes {
+			si.insert(prefix, &shoots[i])
+		}
+	}
+
+	return si
+}
+
+// LoadShootInferrer returns a new [ShootInferrer] for the shoots from the
+// caches of the Gardener API clients, if all of them have been synced, or from
+// the database otherwise.
+func LoadShootInferrer(ctx context.Context) (*ShootInferrer, error) {
+	shoots, ok, err := getAllShootsFromCache(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		shoots = make([]models.Shoot, 0)
+		err := db.DB.NewSelect().
+			Model(&shoots).
+			Column("name", "technical_id", "namespace", "project_name", "landscape", "worker_prefixes").
+			Where("worker_prefixes IS NOT NULL").
+			Scan(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewShootInferrer(shoots), nil
+}
+
+// insert adds the given shoot to the trie under the given worker prefix.
+func (si *ShootInferrer) insert(prefix string, shoot *models.Shoot) {
+	node := si.root
+	for i := range len(prefix) {
+		if node.children == nil {
+			node.children = make(map[byte]*trieNode)
+		}
+		child, ok := node.children[prefix[i]]
+		if !ok {
+			child = &trieNode{}
+			node.children[prefix[i]] = child
+		}
+		node = child
+	}
+	node.shoots = append(node.shoots, shoot)
+}
+
+// Infer infers the shoot from the given Virtual Machine instance name, by
+// looking up the longest worker prefix of the name, which is followed by the
+// zone index and hashes of the instance. See [InferShootFromInstanceName] for
+// details about the naming convention.
+func (si *ShootInferrer) Infer(name string) (*models.Shoot, error) {
+	var found []*models.Shoot
+	node := si.root
+	for i := range len(name) {
+		child, ok := node.children[name[i]]
+		if !ok {
+			break
+		}
+		node = child
+		if len(node.shoots) > 0 && instanceNameSuffix.MatchString(name[i+1:]) {
+			found = node.shoots
+		}
+	}
+
+	switch {
+	case len(found) == 0:
+		return nil, ErrCannotInferShoot
+	case len(found) > 1:
+		return nil, fmt.Errorf("%w: multiple shoots match", ErrCannotInferShoot)
+	default:
+		return found[0], nil
+	}
+}
+
+// getAllShootsFromCache returns the shoots from the caches of the Gardener API
+// clients of all landscapes. Only the identifying fields and the worker
+// prefixes of the returned shoots are set. The second return value is false,
+// if no client is configured, or the cache of any client has not been synced.
+func getAllShootsFromCache(ctx context.Context) ([]models.Shoot, bool, error) {
+	clients := gardenerClients()
+	if len(clients) == 0 {
+		return nil, false, nil
+	}
+
+	for _, client := range clients {
+		if !client.IsCacheSynced() {
+			return nil, false, nil
+		}
+	}
+
+	items := make([]models.Shoot, 0)
+	for _, client := range clients {
+		shoots, err := client.Shoots(ctx, "")
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, shoot := range shoots {
+			if shoot.Status.TechnicalID == "" {
+				continue
+			}
+			workerPrefixes := make([]string, 0, len(shoot.Spec.Provider.Workers))
+			for _, group := range shoot.Spec.Provider.Workers {
+				workerPrefixes = append(workerPrefixes, fmt.Sprintf("%s-%s", shoot.Status.TechnicalID, group.Name))
+			}
+			items = append(items, models.Shoot{
+				Name:           shoot.Name,
+				TechnicalID:    shoot.Status.TechnicalID,
+				Landscape:      client.Landscape(),
+				Namespace:      shoot.Namespace,
+				ProjectName:    strings.TrimPrefix(shoot.Namespace, "garden-"),
+				WorkerPrefixes: workerPrefixes,
+			})
+		}
+	}
+
+	return items, true, nil
+}
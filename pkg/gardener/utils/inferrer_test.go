@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/gardener/models"
+	"github.com/gardener/inventory/pkg/gardener/utils"
+)
+
+func TestShootInferrer(t *testing.T) {
+	inferrer := utils.NewShootInferrer([]models.Shoot{
+		{
+			Name:           "foo",
+			TechnicalID:    "shoot--myproject--foo",
+			WorkerPrefixes: []string{"shoot--myproject--foo-pool", "shoot--myproject--foo-pool-big"},
+		},
+		{
+			Name:           "foo-pool",
+			TechnicalID:    "shoot--myproject--foo-pool",
+			WorkerPrefixes: []string{"shoot--myproject--foo-pool-small"},
+		},
+		{
+			Name:           "bar",
+			TechnicalID:    "shoot--myproject--bar",
+			WorkerPrefixes: []string{"shoot--myproject--bar-pool"},
+		},
+		{
+			Name:           "bar",
+			TechnicalID:    "shoot--myproject--bar",
+			Landscape:      "canary",
+			WorkerPrefixes: []string{"shoot--myproject--bar-pool"},
+		},
+		{
+			Name:        "baz",
+			TechnicalID: "shoot--myproject--baz",
+		},
+	})
+
+	testCases := []struct {
+		desc    string
+		name    string
+		wanted  string
+		wantErr error
+	}{
+		{
+			desc:   "matching worker prefix",
+			name:   "shoot--myproject--foo-pool-z1-abcde-12345",
+			wanted: "shoot--myproject--foo",
+		},
+		{
+			desc:   "longest matching worker prefix",
+			name:   "shoot--myproject--foo-pool-big-z2-abcde-12345",
+			wanted: "shoot--myproject--foo",
+		},
+		{
+			desc:   "worker prefix of another shoot",
+			name:   "shoot--myproject--foo-pool-small-z1-abcde-12345",
+			wanted: "shoot--myproject--foo-pool",
+		},
+		{
+			desc:    "unknown worker prefix",
+			name:    "shoot--myproject--foo-other-z1-abcde-12345",
+			wantErr: utils.ErrCannotInferShoot,
+		},
+		{
+			desc:    "invalid instance name",
+			name:    "shoot--myproject--foo-pool-abcde",
+			wantErr: utils.ErrCannotInferShoot,
+		},
+		{
+			desc:    "multiple matching shoots",
+			name:    "shoot--myproject--bar-pool-z1-abcde-12345",
+			wantErr: utils.ErrCannotInferShoot,
+		},
+		{
+			desc:    "shoot without worker prefixes",
+			name:    "shoot--myproject--baz-z1-abcde-12345",
+			wantErr: utils.ErrCannotInferShoot,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			shoot, err := inferrer.Infer(tc.name)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got error %v, wanted %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if shoot.TechnicalID != tc.wanted {
+				t.Fatalf("got shoot %q, wanted %q", shoot.TechnicalID, tc.wanted)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnsupportedMachineClassProvider is an error, which is returned when the
+// provider spec of a MachineClass is for a provider, which is not supported.
+var ErrUnsupportedMachineClassProvider = errors.New("unsupported machine class provider")
+
+// MachineClassSpec provides the provider-agnostic details of the desired worker
+// configuration from the provider spec of a MachineClass.
+type MachineClassSpec struct {
+	// MachineType specifies the machine type, e.g. `m5.large'.
+	MachineType string
+
+	// Image specifies the machine image, e.g. an AMI ID, or an image URN.
+	Image string
+
+	// VolumeSize specifies the size of the root volume in GiB.
+	VolumeSize int64
+
+	// VolumeType specifies the type of the root volume.
+	VolumeType string
+
+	// Network specifies the network, or subnet, in which machines are
+	// created.
+	Network string
+}
+
+// awsProviderSpec represents the relevant fields of the AWS MachineClass
+// provider spec.
+type awsProviderSpec struct {
+	AMI          string `json:"ami"`
+	MachineType  string `json:"machineType"`
+	BlockDevices []struct {
+		EBS struct {
+			VolumeSize int64  `json:"volumeSize"`
+			VolumeType string `json:"volumeType"`
+		} `json:"ebs"`
+	} `json:"blockDevices"`
+	NetworkInterfaces []struct {
+		SubnetID string `json:"subnetID"`
+	} `json:"networkInterfaces"`
+}
+
+// gcpProviderSpec represents the relevant fields of the GCP MachineClass
+// provider spec.
+type gcpProviderSpec struct {
+	MachineType string `json:"machineType"`
+	Disks       []struct {
+		Boot   bool   `json:"boot"`
+		Image  string `json:"image"`
+		SizeGb int64  `json:"sizeGb"`
+		Type   string `json:"type"`
+	} `json:"disks"`
+	NetworkInterfaces []struct {
+		Network    string `json:"network"`
+		Subnetwork string `json:"subnetwork"`
+	} `json:"networkInterfaces"`
+}
+
+// azureProviderSpec represents the relevant fields of the Azure MachineClass
+// provider spec.
+type azureProviderSpec struct {
+	Properties struct {
+		HardwareProfile struct {
+			VMSize string `json:"vmSize"`
+		} `json:"hardwareProfile"`
+		StorageProfile struct {
+			ImageReference struct {
+				ID                      string `json:"id"`
+				URN                     string `json:"urn"`
+				CommunityGalleryImageID string `json:"communityGalleryImageID"`
+				SharedGalleryImageID    string `json:"sharedGalleryImageID"`
+			} `json:"imageReference"`
+			OSDisk struct {
+				DiskSizeGB  int64 `json:"diskSizeGB"`
+				ManagedDisk struct {
+					StorageAccountType string `json:"storageAccountType"`
+				} `json:"managedDisk"`
+			} `json:"osDisk"`
+		} `json:"storageProfile"`
+	} `json:"properties"`
+	SubnetInfo struct {
+		VnetName   string `json:"vnetName"`
+		SubnetName string `json:"subnetName"`
+	} `json:"subnetInfo"`
+}
+
+// openStackProviderSpec represents the relevant fields of the OpenStack
+// MachineClass provider spec.
+type openStackProviderSpec struct {
+	FlavorName   string `json:"flavorName"`
+	ImageID      string `json:"imageID"`
+	ImageName    string `json:"imageName"`
+	RootDiskSize int64  `json:"rootDiskSize"`
+	RootDiskType string `json:"rootDiskType"`
+	NetworkID    string `json:"networkID"`
+	SubnetID     string `json:"subnetID"`
+}
+
+// ParseMachineClassProviderSpec parses the given provider spec of a
+// MachineClass for the given provider, e.g. `AWS', into a [MachineClassSpec].
+func ParseMachineClassProviderSpec(provider string, data []byte) (MachineClassSpec, error) {
+	var result MachineClassSpec
+	if len(data) == 0 {
+		return result, nil
+	}
+
+	switch strings.ToLower(provider) {
+	case "aws":
+		var spec awsProviderSpec
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return result, err
+		}
+		result.MachineType = spec.MachineType
+		result.Image = spec.AMI
+		if len(spec.BlockDevices) > 0 {
+			result.VolumeSize = spec.BlockDevices[0].EBS.VolumeSize
+			result.VolumeType = spec.BlockDevices[0].EBS.VolumeType
+		}
+		if len(spec.NetworkInterfaces) > 0 {
+			result.Network = spec.NetworkInterfaces[0].SubnetID
+		}
+	case "gcp":
+		var spec gcpProviderSpec
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return result, err
+		}
+		result.MachineType = spec.MachineType
+		for _, disk := range spec.Disks {
+			if disk.Boot {
+				result.Image = disk.Image
+				result.VolumeSize = disk.SizeGb
+				result.VolumeType = disk.Type
+
+				break
+			}
+		}
+		if len(spec.NetworkInterfaces) > 0 {
+			result.Network = spec.NetworkInterfaces[0].Subnetwork
+			if result.Network == "" {
+				result.Network = spec.NetworkInterfaces[0].Network
+			}
+		}
+	case "azure":
+		var spec azureProviderSpec
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return result, err
+		}
+		storage := spec.Properties.StorageProfile
+		image := storage.ImageReference
+		result.MachineType = spec.Properties.HardwareProfile.VMSize
+		for _, v := range []string{image.URN, image.ID, image.CommunityGalleryImageID, image.SharedGalleryImageID} {
+			if v != "" {
+				result.Image = v
+
+				break
+			}
+		}
+		result.VolumeSize = storage.OSDisk.DiskSizeGB
+		result.VolumeType = storage.OSDisk.ManagedDisk.StorageAccountType
+		if spec.SubnetInfo.VnetName != "" {
+			result.Network = spec.SubnetInfo.VnetName + "/" + spec.SubnetInfo.SubnetName
+		}
+	case "openstack":
+		var spec openStackProviderSpec
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return result, err
+		}
+		result.MachineType = spec.FlavorName
+		result.Image = spec.ImageID
+		if result.Image == "" {
+			result.Image = spec.ImageName
+		}
+		result.VolumeSize = spec.RootDiskSize
+		result.VolumeType = spec.RootDiskType
+		result.Network = spec.SubnetID
+		if result.Network == "" {
+			result.Network = spec.NetworkID
+		}
+	default:
+		return result, fmt.Errorf("%w: %s", ErrUnsupportedMachineClassProvider, provider)
+	}
+
+	return result, nil
+}
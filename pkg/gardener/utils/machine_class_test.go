@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/gardener/utils"
+)
+
+func TestParseMachineClassProviderSpec(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		provider string
+		spec     string
+		wanted   utils.MachineClassSpec
+		wantErr  error
+	}{
+		{
+			desc:     "empty spec",
+			provider: "AWS",
+			spec:     "",
+			wanted:   utils.MachineClassSpec{},
+		},
+		{
+			desc:     "aws",
+			provider: "AWS",
+			spec:     `{"ami":"ami-123","machineType":"m5.large","blockDevices":[{"ebs":{"volumeSize":50,"volumeType":"gp3"}}],"networkInterfaces":[{"subnetID":"subnet-123"}]}`,
+			wanted: utils.MachineClassSpec{
+				MachineType: "m5.large",
+				Image:       "ami-123",
+				VolumeSize:  50,
+				VolumeType:  "gp3",
+				Network:     "subnet-123",
+			},
+		},
+		{
+			desc:     "gcp",
+			provider: "GCP",
+			spec:     `{"machineType":"n1-standard-4","disks":[{"boot":false,"sizeGb":100},{"boot":true,"image":"projects/p/global/images/gardenlinux","sizeGb":50,"type":"pd-balanced"}],"networkInterfaces":[{"network":"vpc","subnetwork":"nodes"}]}`,
+			wanted: utils.MachineClassSpec{
+				MachineType: "n1-standard-4",
+				Image:       "projects/p/global/images/gardenlinux",
+				VolumeSize:  50,
+				VolumeType:  "pd-balanced",
+				Network:     "nodes",
+			},
+		},
+		{
+			desc:     "azure",
+			provider: "Azure",
+			spec:     `{"properties":{"hardwareProfile":{"vmSize":"Standard_D4s_v3"},"storageProfile":{"imageReference":{"urn":"sap:gardenlinux:greatest:1.0.0"},"osDisk":{"diskSizeGB":64,"managedDisk":{"storageAccountType":"Premium_LRS"}}}},"subnetInfo":{"vnetName":"vnet","subnetName":"nodes"}}`,
+			wanted: utils.MachineClassSpec{
+				MachineType: "Standard_D4s_v3",
+				Image:       "sap:gardenlinux:greatest:1.0.0",
+				VolumeSize:  64,
+				VolumeType:  "Premium_LRS",
+				Network:     "vnet/nodes",
+			},
+		},
+		{
+			desc:     "openstack",
+			provider: "OpenStack",
+			spec:     `{"flavorName":"g_c4_m16","imageName":"gardenlinux","rootDiskSize":50,"networkID":"net-123"}`,
+			wanted: utils.MachineClassSpec{
+				MachineType: "g_c4_m16",
+				Image:       "gardenlinux",
+				VolumeSize:  50,
+				Network:     "net-123",
+			},
+		},
+		{
+			desc:     "unsupported provider",
+			provider: "Alicloud",
+			spec:     `{}`,
+			wantErr:  utils.ErrUnsupportedMachineClassProvider,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := utils.ParseMachineClassProviderSpec(tc.provider, []byte(tc.spec))
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got error %v wanted %v", err, tc.wantErr)
+			}
+
+			if got != tc.wanted {
+				t.Fatalf("got %+v wanted %+v", got, tc.wanted)
+			}
+		})
+	}
+}
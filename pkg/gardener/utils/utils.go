@@ -10,25 +10,37 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"strings"
+	"time"
 
+	"github.com/robfig/cron/v3"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/gardener/inventory/pkg/clients/db"
+	gardenerclient "github.com/gardener/inventory/pkg/clients/gardener"
 	"github.com/gardener/inventory/pkg/gardener/models"
 )
 
-// GetSeedsFromDB fetches the [models.Seed] items from the database.
-func GetSeedsFromDB(ctx context.Context) ([]models.Seed, error) {
+// GetSeedsFromDB fetches the [models.Seed] items of the given Gardener
+// landscape from the database.
+func GetSeedsFromDB(ctx context.Context, landscape string) ([]models.Seed, error) {
 	items := make([]models.Seed, 0)
-	err := db.DB.NewSelect().Model(&items).Scan(ctx)
+	err := db.DB.NewSelect().
+		Model(&items).
+		Where("landscape = ?", landscape).
+		Scan(ctx)
 
 	return items, err
 }
 
-// GetProjectsFromDB fetches the [models.Project] items from the database.
-func GetProjectsFromDB(ctx context.Context) ([]models.Project, error) {
+// GetProjectsFromDB fetches the [models.Project] items of the given Gardener
+// landscape from the database.
+func GetProjectsFromDB(ctx context.Context, landscape string) ([]models.Project, error) {
 	items := make([]models.Project, 0)
-	err := db.DB.NewSelect().Model(&items).Scan(ctx)
+	err := db.DB.NewSelect().
+		Model(&items).
+		Where("landscape = ?", landscape).
+		Scan(ctx)
 
 	return items, err
 }
@@ -69,14 +81,19 @@ func InferShootFromInstanceName(ctx context.Context, name string) (*models.Shoot
 	shootAndWorkerPool := matches[pattern.SubexpIndex("shoot_and_workerpool")]
 	workerPrefix := fmt.Sprintf("shoot--%s--%s", project, shootAndWorkerPool)
 
-	items := make([]models.Shoot, 0)
-	err := db.DB.NewSelect().
-		Model(&items).
-		Where("project_name = ? AND array_position(worker_prefixes, ?) > 0", project, workerPrefix).
-		Scan(ctx)
-
-	if err != nil {
-		return nil, err
+	// The shoots are looked up in memory, if the caches of the Gardener
+	// API clients have been synced, and in the database otherwise.
+	items, ok := getShootsFromCache(project, workerPrefix)
+	if !ok {
+		items = make([]models.Shoot, 0)
+		err := db.DB.NewSelect().
+			Model(&items).
+			Where("project_name = ? AND array_position(worker_prefixes, ?) > 0", project, workerPrefix).
+			Scan(ctx)
+
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	switch {
@@ -89,6 +106,58 @@ func InferShootFromInstanceName(ctx context.Context, name string) (*models.Shoot
 	}
 }
 
+// getShootsFromCache returns the shoots of the given project with the given
+// worker prefix from the caches of the Gardener API clients of all landscapes.
+// Only the identifying fields of the returned shoots are set. The second return
+// value is false, if no client is configured, or the cache of any client has
+// not been synced.
+func getShootsFromCache(project, workerPrefix string) ([]models.Shoot, bool) {
+	clients := gardenerClients()
+	if len(clients) == 0 {
+		return nil, false
+	}
+
+	items := make([]models.Shoot, 0)
+	for _, client := range clients {
+		shoots, ok := client.CachedShootsByWorkerPrefix(workerPrefix)
+		if !ok {
+			return nil, false
+		}
+
+		for _, shoot := range shoots {
+			// Project namespaces are named `garden-<project>'
+			if strings.TrimPrefix(shoot.Namespace, "garden-") != project {
+				continue
+			}
+			items = append(items, models.Shoot{
+				Name:        shoot.Name,
+				TechnicalID: shoot.Status.TechnicalID,
+				Landscape:   client.Landscape(),
+				Namespace:   shoot.Namespace,
+				ProjectName: project,
+			})
+		}
+	}
+
+	return items, true
+}
+
+// gardenerClients returns the Gardener API clients of the primary and the
+// additional landscapes.
+func gardenerClients() []*gardenerclient.Client {
+	clients := make([]*gardenerclient.Client, 0)
+	if gardenerclient.IsDefaultClientSet() {
+		clients = append(clients, gardenerclient.DefaultClient)
+	}
+	for _, landscape := range gardenerclient.Landscapes() {
+		if client, ok := gardenerclient.GetClient(landscape); ok {
+			clients = append(clients, client)
+		}
+	}
+
+	return clients
+}
+
 // Decode takes a `decoder` and decodes the provided `data` into the provided object.
 // The underlying `into` address is used to assign the decoded object.
 func Decode(decoder runtime.Decoder, data []byte, into runtime.Object) error {
@@ -110,3 +179,29 @@ func Decode(decoder runtime.Decoder, data []byte, into runtime.Object) error {
 
 	return fmt.Errorf("is not of type %s", intoType)
 }
+
+// NextScheduleTime returns the next time after the given time, at which the
+// given Cron spec of a Shoot hibernation schedule fires. The spec is evaluated
+// in the given time location, which defaults to UTC, if empty. A zero time is
+// returned for an empty spec.
+func NextScheduleTime(spec string, location string, now time.Time) (time.Time, error) {
+	if spec == "" {
+		return time.Time{}, nil
+	}
+
+	loc := time.UTC
+	if location != "" {
+		l, err := time.LoadLocation(location)
+		if err != nil {
+			return time.Time{}, err
+		}
+		loc = l
+	}
+
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return schedule.Next(now.In(loc)).UTC(), nil
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gardener/inventory/pkg/gardener/utils"
+)
+
+func TestNextScheduleTime(t *testing.T) {
+	// Thursday
+	now := time.Date(2025, time.January, 2, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		desc     string
+		spec     string
+		location string
+		wanted   time.Time
+		wantErr  bool
+	}{
+		{
+			desc:   "empty spec",
+			spec:   "",
+			wanted: time.Time{},
+		},
+		{
+			desc:   "same day in utc",
+			spec:   "00 17 * * 1,2,3,4,5",
+			wanted: time.Date(2025, time.January, 2, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			desc:   "next week day in utc",
+			spec:   "00 08 * * 1",
+			wanted: time.Date(2025, time.January, 6, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			desc:     "with location",
+			spec:     "00 17 * * *",
+			location: "Europe/Berlin",
+			wanted:   time.Date(2025, time.January, 2, 16, 0, 0, 0, time.UTC),
+		},
+		{
+			desc:    "invalid spec",
+			spec:    "every day",
+			wantErr: true,
+		},
+		{
+			desc:     "invalid location",
+			spec:     "00 17 * * *",
+			location: "Nowhere/Special",
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := utils.NextScheduleTime(tc.spec, tc.location, now)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("got no error wanted error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("got error %s wanted no error", err)
+			}
+
+			if !got.Equal(tc.wanted) {
+				t.Fatalf("got %s wanted %s", got, tc.wanted)
+			}
+		})
+	}
+}
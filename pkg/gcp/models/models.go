@@ -34,6 +34,14 @@ const (
 	IAMPolicyModelName                  = "gcp:model:iam_policy"
 	IAMBindingModelName                 = "gcp:model:iam_binding"
 	IAMRoleMemberModelName              = "gcp:model:iam_role_member"
+	CloudSQLInstanceModelName           = "gcp:model:cloud_sql_instance"
+	ServiceAccountModelName             = "gcp:model:service_account"
+	ServiceAccountKeyModelName          = "gcp:model:service_account_key"
+	ReservationModelName                = "gcp:model:reservation"
+	CommitmentModelName                 = "gcp:model:commitment"
+	RegionQuotaModelName                = "gcp:model:region_quota"
+	SSLCertificateModelName             = "gcp:model:ssl_certificate"
+	TargetProxyCertificateModelName     = "gcp:model:target_proxy_certificate"
 	InstanceToProjectModelName          = "gcp:model:link_instance_to_project"
 	VPCToProjectModelName               = "gcp:model:link_vpc_to_project"
 	AddressToProjectModelName           = "gcp:model:link_addr_to_project"
@@ -45,27 +53,40 @@ const (
 	GKEClusterToProjectModelName        = "gcp:model:link_gke_cluster_to_project"
 	TargetPoolToInstanceModelName       = "gcp:model:link_target_pool_to_instance"
 	TargetPoolToProjectModelName        = "gcp:model:link_target_pool_to_project"
+	CloudSQLInstanceToProjectModelName  = "gcp:model:link_cloud_sql_instance_to_project"
+	ServiceAccountToProjectModelName    = "gcp:model:link_service_account_to_project"
+	ServiceAccountKeyToAccountModelName = "gcp:model:link_service_account_key_to_service_account"
+	ReservationToProjectModelName       = "gcp:model:link_reservation_to_project"
+	CommitmentToProjectModelName        = "gcp:model:link_commitment_to_project"
 )
 
 // models specifies the mapping between name and model type, which will be
 // registered with [registry.ModelRegistry].
 var models = map[string]any{
-	ProjectModelName:            &Project{},
-	InstanceModelName:           &Instance{},
-	VPCModelName:                &VPC{},
-	AddressModelName:            &Address{},
-	NetworkInterfaceModelName:   &NetworkInterface{},
-	SubnetModelName:             &Subnet{},
-	BucketModelName:             &Bucket{},
-	ForwardingRuleModelName:     &ForwardingRule{},
-	DiskModelName:               &Disk{},
-	AttachedDiskModelName:       &AttachedDisk{},
-	GKEClusterModelName:         &GKECluster{},
-	TargetPoolModelName:         &TargetPool{},
-	TargetPoolInstanceModelName: &TargetPoolInstance{},
-	IAMPolicyModelName:          &IAMPolicy{},
-	IAMBindingModelName:         &IAMBinding{},
-	IAMRoleMemberModelName:      &IAMRoleMember{},
+	ProjectModelName:                &Project{},
+	InstanceModelName:               &Instance{},
+	VPCModelName:                    &VPC{},
+	AddressModelName:                &Address{},
+	NetworkInterfaceModelName:       &NetworkInterface{},
+	SubnetModelName:                 &Subnet{},
+	BucketModelName:                 &Bucket{},
+	ForwardingRuleModelName:         &ForwardingRule{},
+	DiskModelName:                   &Disk{},
+	AttachedDiskModelName:           &AttachedDisk{},
+	GKEClusterModelName:             &GKECluster{},
+	TargetPoolModelName:             &TargetPool{},
+	TargetPoolInstanceModelName:     &TargetPoolInstance{},
+	IAMPolicyModelName:              &IAMPolicy{},
+	IAMBindingModelName:             &IAMBinding{},
+	IAMRoleMemberModelName:          &IAMRoleMember{},
+	CloudSQLInstanceModelName:       &CloudSQLInstance{},
+	ServiceAccountModelName:         &ServiceAccount{},
+	ServiceAccountKeyModelName:      &ServiceAccountKey{},
+	ReservationModelName:            &Reservation{},
+	CommitmentModelName:             &Commitment{},
+	RegionQuotaModelName:            &RegionQuota{},
+	SSLCertificateModelName:         &SSLCertificate{},
+	TargetProxyCertificateModelName: &TargetProxyCertificate{},
 
 	// Link models
 	InstanceToProjectModelName:          &InstanceToProject{},
@@ -79,6 +100,11 @@ var models = map[string]any{
 	GKEClusterToProjectModelName:        &GKEClusterToProject{},
 	TargetPoolToInstanceModelName:       &TargetPoolToInstance{},
 	TargetPoolToProjectModelName:        &TargetPoolToProject{},
+	CloudSQLInstanceToProjectModelName:  &CloudSQLInstanceToProject{},
+	ServiceAccountToProjectModelName:    &ServiceAccountToProject{},
+	ServiceAccountKeyToAccountModelName: &ServiceAccountKeyToAccount{},
+	ReservationToProjectModelName:       &ReservationToProject{},
+	CommitmentToProjectModelName:        &CommitmentToProject{},
 }
 
 // Project represents a GCP Project.
@@ -353,6 +379,7 @@ type Disk struct {
 	SizeGB              int64    `bun:"size_gb,notnull"`
 	Status              string   `bun:"status,nullzero"`
 	KubeClusterName     string   `bun:"k8s_cluster_name,nullzero"`
+	SourceImage         string   `bun:"source_image,nullzero"`
 	Project             *Project `bun:"rel:has-one,join:project_id=project_id"`
 }
 
@@ -501,9 +528,362 @@ type IAMRoleMember struct {
 	Binding      *IAMBinding `bun:"rel:has-one,join:resource_name=resource_name,join:resource_type=resource_type,join:role=role"`
 }
 
+// CloudSQLInstance represents a GCP Cloud SQL instance.
+type CloudSQLInstance struct {
+	bun.BaseModel `bun:"table:gcp_cloud_sql_instance"`
+	coremodels.Model
+
+	Name               string   `bun:"name,notnull,unique:gcp_cloud_sql_instance_key"`
+	ProjectID          string   `bun:"project_id,notnull,unique:gcp_cloud_sql_instance_key"`
+	Region             string   `bun:"region,notnull"`
+	Zone               string   `bun:"zone,nullzero"`
+	DatabaseVersion    string   `bun:"database_version,notnull"`
+	State              string   `bun:"state,notnull"`
+	InstanceType       string   `bun:"instance_type,notnull"`
+	BackendType        string   `bun:"backend_type,notnull"`
+	Edition            string   `bun:"edition,nullzero"`
+	Tier               string   `bun:"tier,nullzero"`
+	AvailabilityType   string   `bun:"availability_type,nullzero"`
+	DataDiskSizeGB     int64    `bun:"data_disk_size_gb,notnull"`
+	DataDiskType       string   `bun:"data_disk_type,nullzero"`
+	ConnectionName     string   `bun:"connection_name,notnull"`
+	PrivateNetwork     string   `bun:"private_network,nullzero"`
+	PrimaryIP          net.IP   `bun:"primary_ip,nullzero,type:inet"`
+	PrivateIP          net.IP   `bun:"private_ip,nullzero,type:inet"`
+	MasterInstanceName string   `bun:"master_instance_name,nullzero"`
+	CreationTimestamp  string   `bun:"creation_timestamp,nullzero"`
+	Project            *Project `bun:"rel:has-one,join:project_id=project_id"`
+}
+
+// CloudSQLInstanceToProject represents a link table connecting the
+// [CloudSQLInstance] with [Project] models.
+type CloudSQLInstanceToProject struct {
+	bun.BaseModel `bun:"table:l_gcp_cloud_sql_instance_to_project"`
+	coremodels.Model
+
+	InstanceID uuid.UUID `bun:"instance_id,notnull,type:uuid,unique:l_gcp_cloud_sql_instance_to_project_key"`
+	ProjectID  uuid.UUID `bun:"project_id,notnull,type:uuid,unique:l_gcp_cloud_sql_instance_to_project_key"`
+}
+
+// ServiceAccount represents a GCP IAM service account.
+type ServiceAccount struct {
+	bun.BaseModel `bun:"table:gcp_service_account"`
+	coremodels.Model
+
+	Email       string               `bun:"email,notnull,unique:gcp_service_account_key"`
+	ProjectID   string               `bun:"project_id,notnull,unique:gcp_service_account_key"`
+	UniqueID    string               `bun:"unique_id,notnull"`
+	Name        string               `bun:"name,notnull"`
+	DisplayName string               `bun:"display_name,nullzero"`
+	Description string               `bun:"description,nullzero"`
+	Disabled    bool                 `bun:"disabled,notnull"`
+	Project     *Project             `bun:"rel:has-one,join:project_id=project_id"`
+	Keys        []*ServiceAccountKey `bun:"rel:has-many,join:project_id=project_id,join:email=service_account_email"`
+}
+
+// ServiceAccountKey represents a user-managed key of a GCP IAM service account.
+type ServiceAccountKey struct {
+	bun.BaseModel `bun:"table:gcp_service_account_key"`
+	coremodels.Model
+
+	KeyID               string          `bun:"key_id,notnull,unique:gcp_service_account_key_key"`
+	ServiceAccountEmail string          `bun:"service_account_email,notnull,unique:gcp_service_account_key_key"`
+	ProjectID           string          `bun:"project_id,notnull,unique:gcp_service_account_key_key"`
+	KeyType             string          `bun:"key_type,notnull"`
+	KeyOrigin           string          `bun:"key_origin,nullzero"`
+	KeyAlgorithm        string          `bun:"key_algorithm,nullzero"`
+	Disabled            bool            `bun:"disabled,notnull"`
+	ValidAfter          time.Time       `bun:"valid_after,nullzero"`
+	ValidBefore         time.Time       `bun:"valid_before,nullzero"`
+	ServiceAccount      *ServiceAccount `bun:"rel:has-one,join:project_id=project_id,join:service_account_email=email"`
+}
+
+// ServiceAccountToProject represents a link table connecting the
+// [ServiceAccount] with [Project] models.
+type ServiceAccountToProject struct {
+	bun.BaseModel `bun:"table:l_gcp_service_account_to_project"`
+	coremodels.Model
+
+	ServiceAccountID uuid.UUID `bun:"service_account_id,notnull,type:uuid,unique:l_gcp_service_account_to_project_key"`
+	ProjectID        uuid.UUID `bun:"project_id,notnull,type:uuid,unique:l_gcp_service_account_to_project_key"`
+}
+
+// ServiceAccountKeyToAccount represents a link table connecting the
+// [ServiceAccountKey] with [ServiceAccount] models.
+type ServiceAccountKeyToAccount struct {
+	bun.BaseModel `bun:"table:l_gcp_service_account_key_to_service_account"`
+	coremodels.Model
+
+	KeyID            uuid.UUID `bun:"key_id,notnull,type:uuid,unique:l_gcp_service_account_key_to_service_account_key"`
+	ServiceAccountID uuid.UUID `bun:"service_account_id,notnull,type:uuid,unique:l_gcp_service_account_key_to_service_account_key"`
+}
+
+// Reservation represents a GCP Compute Engine zonal reservation.
+type Reservation struct {
+	bun.BaseModel `bun:"table:gcp_reservation"`
+	coremodels.Model
+
+	ReservationID     uint64   `bun:"reservation_id,notnull,unique:gcp_reservation_key"`
+	ProjectID         string   `bun:"project_id,notnull,unique:gcp_reservation_key"`
+	Name              string   `bun:"name,notnull"`
+	Description       string   `bun:"description,notnull"`
+	Zone              string   `bun:"zone,notnull"`
+	Region            string   `bun:"region,notnull"`
+	Status            string   `bun:"status,notnull"`
+	MachineType       string   `bun:"machine_type,nullzero"`
+	Count             int64    `bun:"count,notnull"`
+	InUseCount        int64    `bun:"in_use_count,notnull"`
+	SpecificRequired  bool     `bun:"specific_required,notnull"`
+	Commitment        string   `bun:"commitment,nullzero"`
+	CreationTimestamp string   `bun:"creation_timestamp,nullzero"`
+	Project           *Project `bun:"rel:has-one,join:project_id=project_id"`
+}
+
+// Commitment represents a GCP Compute Engine committed use discount.
+type Commitment struct {
+	bun.BaseModel `bun:"table:gcp_commitment"`
+	coremodels.Model
+
+	CommitmentID      uint64   `bun:"commitment_id,notnull,unique:gcp_commitment_key"`
+	ProjectID         string   `bun:"project_id,notnull,unique:gcp_commitment_key"`
+	Name              string   `bun:"name,notnull"`
+	Description       string   `bun:"description,notnull"`
+	Region            string   `bun:"region,notnull"`
+	Status            string   `bun:"status,notnull"`
+	Plan              string   `bun:"plan,notnull"`
+	Type              string   `bun:"type,nullzero"`
+	Category          string   `bun:"category,nullzero"`
+	AutoRenew         bool     `bun:"auto_renew,notnull"`
+	VCPUs             int64    `bun:"vcpus,notnull"`
+	MemoryMB          int64    `bun:"memory_mb,notnull"`
+	StartTimestamp    string   `bun:"start_timestamp,nullzero"`
+	EndTimestamp      string   `bun:"end_timestamp,nullzero"`
+	CreationTimestamp string   `bun:"creation_timestamp,nullzero"`
+	Project           *Project `bun:"rel:has-one,join:project_id=project_id"`
+}
+
+// RegionQuota represents the limit and current usage of a regional GCP Compute
+// Engine quota metric.
+type RegionQuota struct {
+	bun.BaseModel `bun:"table:gcp_region_quota"`
+	coremodels.Model
+
+	ProjectID string   `bun:"project_id,notnull,unique:gcp_region_quota_key"`
+	Region    string   `bun:"region,notnull,unique:gcp_region_quota_key"`
+	Metric    string   `bun:"metric,notnull,unique:gcp_region_quota_key"`
+	Limit     float64  `bun:"quota_limit,notnull"`
+	Usage     float64  `bun:"usage,notnull"`
+	Project   *Project `bun:"rel:has-one,join:project_id=project_id"`
+}
+
+// SSLCertificate represents a GCP SSL certificate, which is used by the
+// target HTTPS and SSL proxies of load balancers.
+type SSLCertificate struct {
+	bun.BaseModel `bun:"table:gcp_ssl_certificate"`
+	coremodels.Model
+
+	CertificateID           uint64    `bun:"certificate_id,notnull,unique:gcp_ssl_certificate_key"`
+	ProjectID               string    `bun:"project_id,notnull,unique:gcp_ssl_certificate_key"`
+	Name                    string    `bun:"name,notnull"`
+	Region                  string    `bun:"region,notnull"`
+	Type                    string    `bun:"type,notnull"`
+	Subject                 string    `bun:"subject,nullzero"`
+	SubjectAlternativeNames []string  `bun:"subject_alternative_names,array,nullzero"`
+	NotAfter                time.Time `bun:"not_after,nullzero"`
+	SelfLink                string    `bun:"self_link,notnull"`
+	CreationTimestamp       string    `bun:"creation_timestamp,nullzero"`
+	Project                 *Project  `bun:"rel:has-one,join:project_id=project_id"`
+}
+
+// TargetProxyCertificate represents a reference from a GCP target HTTPS or SSL
+// proxy to an [SSLCertificate]. The proxy is referenced by the target of the
+// forwarding rules.
+type TargetProxyCertificate struct {
+	bun.BaseModel `bun:"table:gcp_target_proxy_certificate"`
+	coremodels.Model
+
+	ProxySelfLink       string          `bun:"proxy_self_link,notnull,unique:gcp_target_proxy_certificate_key"`
+	CertificateSelfLink string          `bun:"certificate_self_link,notnull,unique:gcp_target_proxy_certificate_key"`
+	ProjectID           string          `bun:"project_id,notnull"`
+	ProxyName           string          `bun:"proxy_name,notnull"`
+	ProxyType           string          `bun:"proxy_type,notnull"`
+	Region              string          `bun:"region,notnull"`
+	CertificateName     string          `bun:"certificate_name,notnull"`
+	Certificate         *SSLCertificate `bun:"rel:has-one,join:certificate_self_link=self_link"`
+	Project             *Project        `bun:"rel:has-one,join:project_id=project_id"`
+}
+
+// ReservationToProject represents a link table connecting the [Reservation]
+// with [Project] models.
+type ReservationToProject struct {
+	bun.BaseModel `bun:"table:l_gcp_reservation_to_project"`
+	coremodels.Model
+
+	ReservationID uuid.UUID `bun:"reservation_id,notnull,type:uuid,unique:l_gcp_reservation_to_project_key"`
+	ProjectID     uuid.UUID `bun:"project_id,notnull,type:uuid,unique:l_gcp_reservation_to_project_key"`
+}
+
+// CommitmentToProject represents a link table connecting the [Commitment] with
+// [Project] models.
+type CommitmentToProject struct {
+	bun.BaseModel `bun:"table:l_gcp_commitment_to_project"`
+	coremodels.Model
+
+	CommitmentID uuid.UUID `bun:"commitment_id,notnull,type:uuid,unique:l_gcp_commitment_to_project_key"`
+	ProjectID    uuid.UUID `bun:"project_id,notnull,type:uuid,unique:l_gcp_commitment_to_project_key"`
+}
+
+// accountLinks maps the models, which reference a GCP project, to the
+// column holding the reference.
+var accountLinks = map[string]string{
+	ProjectModelName:                "project_id",
+	InstanceModelName:               "project_id",
+	NetworkInterfaceModelName:       "project_id",
+	VPCModelName:                    "project_id",
+	AddressModelName:                "project_id",
+	SubnetModelName:                 "project_id",
+	BucketModelName:                 "project_id",
+	ForwardingRuleModelName:         "project_id",
+	DiskModelName:                   "project_id",
+	AttachedDiskModelName:           "project_id",
+	GKEClusterModelName:             "project_id",
+	TargetPoolModelName:             "project_id",
+	TargetPoolInstanceModelName:     "project_id",
+	CloudSQLInstanceModelName:       "project_id",
+	ServiceAccountModelName:         "project_id",
+	ServiceAccountKeyModelName:      "project_id",
+	ReservationModelName:            "project_id",
+	CommitmentModelName:             "project_id",
+	RegionQuotaModelName:            "project_id",
+	SSLCertificateModelName:         "project_id",
+	TargetProxyCertificateModelName: "project_id",
+}
+
+// shootResources specifies the resolvers, which map the models to the
+// Gardener Shoots owning them.
+var shootResources = map[string]registry.ShootResource{
+	"gcp:instance:machine-provider-id": {
+		ModelName:  InstanceModelName,
+		Kind:       registry.ShootResourceKindInstance,
+		Method:     registry.ShootResourceMethodProviderID,
+		Confidence: registry.ShootResourceConfidenceHigh,
+		Query: `SELECT s.id AS shoot_id, i.id AS resource_id FROM g_machine AS m
+INNER JOIN g_shoot AS s ON s.technical_id = m.namespace AND s.landscape = m.landscape
+INNER JOIN gcp_instance AS i ON i.project_id = split_part(m.provider_id, '/', 3)
+AND i.name = substring(m.provider_id from '[^/]+$')
+WHERE m.provider_id LIKE 'gce://%'`,
+	},
+	"gcp:forwarding-rule:vpc-technical-id": {
+		ModelName:  ForwardingRuleModelName,
+		Kind:       registry.ShootResourceKindLoadBalancer,
+		Method:     registry.ShootResourceMethodTechnicalID,
+		Confidence: registry.ShootResourceConfidenceMedium,
+		Query: `SELECT s.id AS shoot_id, fr.id AS resource_id FROM gcp_forwarding_rule AS fr
+INNER JOIN aux_account_landscape AS al ON al.provider = 'gcp' AND al.account_id = fr.project_id
+INNER JOIN g_shoot AS s ON s.technical_id = fr.network AND s.landscape = al.landscape`,
+	},
+	// Target pools created for Services of type LoadBalancer are not
+	// named after the technical ID of the Shoot. The Shoot is inferred
+	// from the names of the instances in the pool during collection, which
+	// is the only reference available, hence the low confidence.
+	"gcp:target-pool:instance-name": {
+		ModelName:  TargetPoolModelName,
+		Kind:       registry.ShootResourceKindLoadBalancer,
+		Method:     registry.ShootResourceMethodInstanceName,
+		Confidence: registry.ShootResourceConfidenceLow,
+		Query: `SELECT s.id AS shoot_id, tp.id AS resource_id FROM gcp_target_pool AS tp
+INNER JOIN gcp_target_pool_instance AS tpi ON tpi.target_pool_id = tp.target_pool_id AND tpi.project_id = tp.project_id
+INNER JOIN aux_account_landscape AS al ON al.provider = 'gcp' AND al.account_id = tp.project_id
+INNER JOIN g_shoot AS s ON s.technical_id = tpi.inferred_g_shoot AND s.landscape = al.landscape`,
+	},
+	"gcp:disk:pv-disk-ref": {
+		ModelName:  DiskModelName,
+		Kind:       registry.ShootResourceKindDisk,
+		Method:     registry.ShootResourceMethodDiskRef,
+		Confidence: registry.ShootResourceConfidenceHigh,
+		Query: `SELECT s.id AS shoot_id, d.id AS resource_id FROM g_persistent_volume AS pv
+INNER JOIN g_shoot AS s ON s.technical_id = pv.claim_namespace AND s.landscape = pv.landscape
+INNER JOIN gcp_disk AS d ON d.name = substring(pv.disk_ref from '[^/]+$')
+AND (pv.disk_ref NOT LIKE 'projects/%' OR d.project_id = split_part(pv.disk_ref, '/', 2))
+WHERE pv.provider IN ('in-tree:gce-pd', 'csi:pd.csi.storage.gke.io')`,
+	},
+	"gcp:vpc:name-technical-id": {
+		ModelName:  VPCModelName,
+		Kind:       registry.ShootResourceKindNetwork,
+		Method:     registry.ShootResourceMethodTechnicalID,
+		Confidence: registry.ShootResourceConfidenceMedium,
+		Query: `SELECT s.id AS shoot_id, v.id AS resource_id FROM gcp_vpc AS v
+INNER JOIN aux_account_landscape AS al ON al.provider = 'gcp' AND al.account_id = v.project_id
+INNER JOIN g_shoot AS s ON s.technical_id = v.name AND s.landscape = al.landscape`,
+	},
+}
+
+// driftChecks specifies the checks, which compare the desired worker
+// configuration of Gardener Machines against the actual GCP resources.
+var driftChecks = map[string]registry.DriftCheck{
+	"gcp:instance:machine-type": {
+		ModelName: InstanceModelName,
+		Attribute: registry.DriftAttributeMachineType,
+		Query: `SELECT s.id AS shoot_id, i.id AS resource_id, mc.machine_type AS desired, substring(i.machine_type from '[^/]+$') AS actual
+FROM g_machine AS m
+INNER JOIN g_shoot AS s ON s.technical_id = m.namespace AND s.landscape = m.landscape
+INNER JOIN g_machine_class AS mc ON mc.name = m.machine_class AND mc.namespace = m.namespace AND mc.landscape = m.landscape
+INNER JOIN gcp_instance AS i ON i.project_id = split_part(m.provider_id, '/', 3)
+AND i.name = substring(m.provider_id from '[^/]+$')
+WHERE m.provider_id LIKE 'gce://%' AND substring(i.machine_type from '[^/]+$') <> mc.machine_type`,
+	},
+	"gcp:disk:image": {
+		ModelName: DiskModelName,
+		Attribute: registry.DriftAttributeImage,
+		Query: `SELECT s.id AS shoot_id, d.id AS resource_id, mc.image AS desired, d.source_image AS actual
+FROM g_machine AS m
+INNER JOIN g_shoot AS s ON s.technical_id = m.namespace AND s.landscape = m.landscape
+INNER JOIN g_machine_class AS mc ON mc.name = m.machine_class AND mc.namespace = m.namespace AND mc.landscape = m.landscape
+INNER JOIN gcp_instance AS i ON i.project_id = split_part(m.provider_id, '/', 3)
+AND i.name = substring(m.provider_id from '[^/]+$')
+INNER JOIN gcp_attached_disk AS ad ON ad.project_id = i.project_id AND ad.instance_name = i.name
+INNER JOIN gcp_disk AS d ON d.project_id = ad.project_id AND d.name = ad.disk_name AND d.zone = ad.zone
+WHERE m.provider_id LIKE 'gce://%' AND d.source_image IS NOT NULL AND d.source_image NOT LIKE '%' || mc.image`,
+	},
+	"gcp:disk:volume-size": {
+		ModelName: DiskModelName,
+		Attribute: registry.DriftAttributeVolumeSize,
+		Query: `SELECT s.id AS shoot_id, d.id AS resource_id, mc.volume_size::text AS desired, d.size_gb::text AS actual
+FROM g_machine AS m
+INNER JOIN g_shoot AS s ON s.technical_id = m.namespace AND s.landscape = m.landscape
+INNER JOIN g_machine_class AS mc ON mc.name = m.machine_class AND mc.namespace = m.namespace AND mc.landscape = m.landscape
+INNER JOIN gcp_instance AS i ON i.project_id = split_part(m.provider_id, '/', 3)
+AND i.name = substring(m.provider_id from '[^/]+$')
+INNER JOIN gcp_attached_disk AS ad ON ad.project_id = i.project_id AND ad.instance_name = i.name
+INNER JOIN gcp_disk AS d ON d.project_id = ad.project_id AND d.name = ad.disk_name AND d.zone = ad.zone
+WHERE m.provider_id LIKE 'gce://%' AND d.source_image IS NOT NULL AND d.size_gb <> mc.volume_size`,
+	},
+}
+
 // init registers the models with the [registry.ModelRegistry]
 func init() {
 	for k, v := range models {
 		registry.ModelRegistry.MustRegister(k, v)
 	}
+
+	registry.AccountSourceRegistry.MustRegister("gcp", registry.AccountSource{
+		ModelName:  ProjectModelName,
+		IDColumn:   "project_id",
+		NameColumn: "display_name",
+	})
+
+	for k, v := range accountLinks {
+		registry.AccountLinkRegistry.MustRegister(k, registry.AccountLink{
+			Provider: "gcp",
+			Column:   v,
+		})
+	}
+
+	for k, v := range shootResources {
+		registry.ShootResourceRegistry.MustRegister(k, v)
+	}
+
+	for k, v := range driftChecks {
+		registry.DriftCheckRegistry.MustRegister(k, v)
+	}
 }
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models_test
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	_ "github.com/gardener/inventory/pkg/gcp/models"
+)
+
+// providerIDPartRe matches the expressions, which extract the project from
+// the provider ID of a Gardener Machine.
+var providerIDPartRe = regexp.MustCompile(`i\.project_id = split_part\(m\.provider_id, '/', (\d+)\)`)
+
+// splitPart mimics the split_part() function of PostgreSQL, which returns the
+// 1-based n-th field of s.
+func splitPart(s, delim string, n int) string {
+	parts := strings.Split(s, delim)
+	if n < 1 || n > len(parts) {
+		return ""
+	}
+
+	return parts[n-1]
+}
+
+// projectFromQuery returns the project, which the given query extracts from
+// the given provider ID.
+func projectFromQuery(t *testing.T, query, providerID string) string {
+	t.Helper()
+
+	match := providerIDPartRe.FindStringSubmatch(query)
+	if match == nil {
+		t.Fatalf("query does not extract the project from the provider id: %s", query)
+	}
+	n, err := strconv.Atoi(match[1])
+	if err != nil {
+		t.Fatalf("invalid split_part index %q: %s", match[1], err)
+	}
+
+	return splitPart(providerID, "/", n)
+}
+
+func TestShootResourceProviderID(t *testing.T) {
+	resource, ok := registry.ShootResourceRegistry.Get("gcp:instance:machine-provider-id")
+	if !ok {
+		t.Fatal("shoot resource gcp:instance:machine-provider-id is not registered")
+	}
+
+	testCases := []struct {
+		desc       string
+		providerID string
+		wanted     string
+	}{
+		{
+			desc:       "regional project",
+			providerID: "gce://my-project/europe-west1-b/shoot--dev--foo-worker-z1-abcde-12345",
+			wanted:     "my-project",
+		},
+		{
+			desc:       "project with digits",
+			providerID: "gce://sap-gcp-k8s-canary-1/us-east1-c/instance-b",
+			wanted:     "sap-gcp-k8s-canary-1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := projectFromQuery(t, resource.Query, tc.providerID)
+			if got != tc.wanted {
+				t.Fatalf("got project %q, wanted %q", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestDriftCheckProviderID(t *testing.T) {
+	providerID := "gce://my-project/europe-west1-b/shoot--dev--foo-worker-z1-abcde-12345"
+	checks := []string{
+		"gcp:instance:machine-type",
+		"gcp:disk:image",
+		"gcp:disk:volume-size",
+	}
+
+	for _, name := range checks {
+		t.Run(name, func(t *testing.T) {
+			check, ok := registry.DriftCheckRegistry.Get(name)
+			if !ok {
+				t.Fatalf("drift check %s is not registered", name)
+			}
+			got := projectFromQuery(t, check.Query, providerID)
+			if got != "my-project" {
+				t.Fatalf("got project %q, wanted %q", got, "my-project")
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+
+	gcpclients "github.com/gardener/inventory/pkg/clients/gcp"
+	"github.com/gardener/inventory/pkg/core/registry"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// newAccountCollectTasks returns the tasks for collecting the GCP resources of
+// the given project.
+func newAccountCollectTasks(_ context.Context, projectID string) ([]*asynq.Task, error) {
+	if !gcpclients.InstancesClientset.Exists(projectID) {
+		return nil, nil
+	}
+
+	payloads := []struct {
+		name    string
+		payload any
+	}{
+		{TaskCollectInstances, CollectInstancesPayload{ProjectID: projectID}},
+		{TaskCollectVPCs, CollectVPCsPayload{ProjectID: projectID}},
+		{TaskCollectAddresses, CollectAddressesPayload{ProjectID: projectID}},
+		{TaskCollectSubnets, CollectSubnetsPayload{ProjectID: projectID}},
+		{TaskCollectBuckets, CollectBucketsPayload{ProjectID: projectID}},
+		{TaskCollectForwardingRules, CollectForwardingRulesPayload{ProjectID: projectID}},
+		{TaskCollectDisks, CollectDisksPayload{ProjectID: projectID}},
+		{TaskCollectGKEClusters, CollectGKEClustersPayload{ProjectID: projectID}},
+		{TaskCollectTargetPools, CollectTargetPoolsPayload{ProjectID: projectID}},
+		{TaskCollectIAMPolicies, CollectIAMPoliciesPayload{ProjectID: projectID}},
+		{TaskCollectCloudSQLInstances, CollectCloudSQLInstancesPayload{ProjectID: projectID}},
+		{TaskCollectServiceAccounts, CollectServiceAccountsPayload{ProjectID: projectID}},
+		{TaskCollectReservations, CollectReservationsPayload{ProjectID: projectID}},
+		{TaskCollectCommitments, CollectCommitmentsPayload{ProjectID: projectID}},
+		{TaskCollectRegionQuotas, CollectRegionQuotasPayload{ProjectID: projectID}},
+		{TaskCollectSSLCertificates, CollectSSLCertificatesPayload{ProjectID: projectID}},
+	}
+
+	items := make([]*asynq.Task, 0, len(payloads))
+	for _, item := range payloads {
+		task, err := asynqutils.NewTask(item.name, item.payload)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, task)
+	}
+
+	return items, nil
+}
+
+func init() {
+	registry.AccountCollectorRegistry.MustRegister("gcp", registry.AccountCollector{
+		NewTasks:  newAccountCollectTasks,
+		LinkTasks: []string{TaskLinkAll},
+	})
+}
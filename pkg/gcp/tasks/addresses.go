@@ -36,7 +36,7 @@ const TaskCollectAddresses = "gcp:task:collect-addresses"
 type CollectAddressesPayload struct {
 	// ProjectID specifies the globally unique project id from which to
 	// collect.
-	ProjectID string `json:"project_id" yaml:"project_id"`
+	ProjectID string `json:"project_id" yaml:"project_id" desc:"The globally unique project id from which to collect" example:"my-project"`
 }
 
 // NewCollectAddressesTask creates a new [asynq.Task] for collecting global and
@@ -94,7 +94,17 @@ func enqueueCollectAddresses(ctx context.Context) error {
 			return registry.ErrContinue
 		}
 		task := asynq.NewTask(TaskCollectAddresses, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", projectID,
+			)
+
+			return registry.ErrContinue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -39,7 +39,7 @@ func NewCollectBucketsTask() *asynq.Task {
 type CollectBucketsPayload struct {
 	// ProjectID specifies the GCP project ID, which is associated with a
 	// registered client.
-	ProjectID string `json:"project_id" yaml:"project_id"`
+	ProjectID string `json:"project_id" yaml:"project_id" desc:"The GCP project ID, which is associated with a registered client" example:"my-project"`
 }
 
 // HandleCollectBucketsTask is the handler, which collects GCP Buckets.
@@ -85,7 +85,17 @@ func enqueueCollectBuckets(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectBuckets, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", projectID,
+			)
+
+			return registry.ErrContinue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	sqladmin "google.golang.org/api/sqladmin/v1"
+
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	"github.com/gardener/inventory/pkg/clients/db"
+	gcpclients "github.com/gardener/inventory/pkg/clients/gcp"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/gcp/models"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// TaskCollectCloudSQLInstances is the name of the task for collecting GCP
+// Cloud SQL instances.
+const TaskCollectCloudSQLInstances = "gcp:task:collect-cloud-sql-instances"
+
+// Types of IP addresses assigned to a Cloud SQL instance.
+const (
+	cloudSQLIPTypePrimary = "PRIMARY"
+	cloudSQLIPTypePrivate = "PRIVATE"
+)
+
+// CollectCloudSQLInstancesPayload is the payload used for collecting GCP Cloud
+// SQL instances.
+type CollectCloudSQLInstancesPayload struct {
+	// ProjectID specifies the globally unique project id from which to
+	// collect.
+	ProjectID string `json:"project_id" yaml:"project_id" desc:"The globally unique project id from which to collect" example:"my-project"`
+}
+
+// NewCollectCloudSQLInstancesTask creates a new [asynq.Task] for collecting GCP
+// Cloud SQL instances, without specifying a payload.
+func NewCollectCloudSQLInstancesTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectCloudSQLInstances, nil)
+}
+
+// HandleCollectCloudSQLInstancesTask is the handler, which collects GCP Cloud
+// SQL instances.
+func HandleCollectCloudSQLInstancesTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we enqueue tasks for
+	// collecting Cloud SQL instances from all registered projects.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectCloudSQLInstances(ctx)
+	}
+
+	var payload CollectCloudSQLInstancesPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.ProjectID == "" {
+		return asynqutils.SkipRetry(ErrNoProjectID)
+	}
+
+	return collectCloudSQLInstances(ctx, payload)
+}
+
+// enqueueCollectCloudSQLInstances enqueues tasks for collecting GCP Cloud SQL
+// instances.
+func enqueueCollectCloudSQLInstances(ctx context.Context) error {
+	logger := asynqutils.GetLogger(ctx)
+	if gcpclients.SQLAdminClientset.Length() == 0 {
+		logger.Warn("no GCP Cloud SQL Admin clients found")
+
+		return nil
+	}
+
+	// Enqueue tasks for all registered GCP Projects
+	queue := asynqutils.GetQueueName(ctx)
+	err := gcpclients.SQLAdminClientset.Range(func(projectID string, _ *gcpclients.Client[*sqladmin.Service]) error {
+		payload := CollectCloudSQLInstancesPayload{
+			ProjectID: projectID,
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for GCP Cloud SQL instances",
+				"project", projectID,
+				"reason", err,
+			)
+
+			return registry.ErrContinue
+		}
+		task := asynq.NewTask(TaskCollectCloudSQLInstances, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", projectID,
+			)
+
+			return registry.ErrContinue
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"project", projectID,
+				"reason", err,
+			)
+
+			return registry.ErrContinue
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"project", projectID,
+		)
+
+		return nil
+	})
+
+	return err
+}
+
+// collectCloudSQLInstances collects the GCP Cloud SQL instances from the
+// project specified in the payload.
+func collectCloudSQLInstances(ctx context.Context, payload CollectCloudSQLInstancesPayload) error {
+	client, ok := gcpclients.SQLAdminClientset.Get(payload.ProjectID)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.ProjectID))
+	}
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			cloudSQLInstancesDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.ProjectID,
+		)
+		key := metrics.Key(TaskCollectCloudSQLInstances, payload.ProjectID)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("collecting GCP Cloud SQL instances", "project", payload.ProjectID)
+
+	items := make([]models.CloudSQLInstance, 0)
+	err := client.Client.Instances.List(payload.ProjectID).
+		Pages(ctx, func(page *sqladmin.InstancesListResponse) error {
+			for _, instance := range page.Items {
+				items = append(items, toCloudSQLInstanceModel(payload.ProjectID, instance))
+			}
+
+			return nil
+		})
+
+	if err != nil {
+		logger.Error(
+			"failed to get cloud sql instances",
+			"project", payload.ProjectID,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (name, project_id) DO UPDATE").
+		Set("region = EXCLUDED.region").
+		Set("zone = EXCLUDED.zone").
+		Set("database_version = EXCLUDED.database_version").
+		Set("state = EXCLUDED.state").
+		Set("instance_type = EXCLUDED.instance_type").
+		Set("backend_type = EXCLUDED.backend_type").
+		Set("edition = EXCLUDED.edition").
+		Set("tier = EXCLUDED.tier").
+		Set("availability_type = EXCLUDED.availability_type").
+		Set("data_disk_size_gb = EXCLUDED.data_disk_size_gb").
+		Set("data_disk_type = EXCLUDED.data_disk_type").
+		Set("connection_name = EXCLUDED.connection_name").
+		Set("private_network = EXCLUDED.private_network").
+		Set("primary_ip = EXCLUDED.primary_ip").
+		Set("private_ip = EXCLUDED.private_ip").
+		Set("master_instance_name = EXCLUDED.master_instance_name").
+		Set("creation_timestamp = EXCLUDED.creation_timestamp").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert cloud sql instances into db",
+			"project", payload.ProjectID,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated gcp cloud sql instances",
+		"project", payload.ProjectID,
+		"count", count,
+	)
+
+	return nil
+}
+
+// toCloudSQLInstanceModel converts the given [sqladmin.DatabaseInstance] into a
+// [models.CloudSQLInstance] model.
+func toCloudSQLInstanceModel(projectID string, instance *sqladmin.DatabaseInstance) models.CloudSQLInstance {
+	item := models.CloudSQLInstance{
+		Name:               instance.Name,
+		ProjectID:          projectID,
+		Region:             instance.Region,
+		Zone:               instance.GceZone,
+		DatabaseVersion:    instance.DatabaseVersion,
+		State:              instance.State,
+		InstanceType:       instance.InstanceType,
+		BackendType:        instance.BackendType,
+		ConnectionName:     instance.ConnectionName,
+		MasterInstanceName: instance.MasterInstanceName,
+		CreationTimestamp:  instance.CreateTime,
+	}
+
+	if settings := instance.Settings; settings != nil {
+		item.Edition = settings.Edition
+		item.Tier = settings.Tier
+		item.AvailabilityType = settings.AvailabilityType
+		item.DataDiskSizeGB = settings.DataDiskSizeGb
+		item.DataDiskType = settings.DataDiskType
+		if settings.IpConfiguration != nil {
+			item.PrivateNetwork = settings.IpConfiguration.PrivateNetwork
+		}
+	}
+
+	for _, addr := range instance.IpAddresses {
+		switch addr.Type {
+		case cloudSQLIPTypePrimary:
+			item.PrimaryIP = net.ParseIP(addr.IpAddress)
+		case cloudSQLIPTypePrivate:
+			item.PrivateIP = net.ParseIP(addr.IpAddress)
+		}
+	}
+
+	return item
+}
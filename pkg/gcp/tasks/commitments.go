@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/api/iterator"
+
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	"github.com/gardener/inventory/pkg/clients/db"
+	gcpclients "github.com/gardener/inventory/pkg/clients/gcp"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/gcp/constants"
+	"github.com/gardener/inventory/pkg/gcp/models"
+	"github.com/gardener/inventory/pkg/gcp/utils"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// TaskCollectCommitments is the name of the task for collecting GCP Compute
+// Engine committed use discounts.
+const TaskCollectCommitments = "gcp:task:collect-commitments"
+
+// CollectCommitmentsPayload is the payload, which is used to collect GCP
+// commitments.
+type CollectCommitmentsPayload struct {
+	// ProjectID specifies the GCP project ID, which is associated with a
+	// registered client.
+	ProjectID string `json:"project_id" yaml:"project_id" desc:"The GCP project ID, which is associated with a registered client" example:"my-project"`
+}
+
+// NewCollectCommitmentsTask creates a new [asynq.Task] task for collecting GCP
+// commitments without specifying a payload.
+func NewCollectCommitmentsTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectCommitments, nil)
+}
+
+// HandleCollectCommitmentsTask is the handler, which collects GCP commitments.
+func HandleCollectCommitmentsTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we will enqueue tasks for
+	// collecting commitments for all configured clients.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectCommitments(ctx)
+	}
+
+	var payload CollectCommitmentsPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.ProjectID == "" {
+		return asynqutils.SkipRetry(ErrNoProjectID)
+	}
+
+	return collectCommitments(ctx, payload)
+}
+
+// enqueueCollectCommitments enqueues tasks for collecting GCP commitments
+// for all collected GCP projects.
+func enqueueCollectCommitments(ctx context.Context) error {
+	logger := asynqutils.GetLogger(ctx)
+
+	queue := asynqutils.GetQueueName(ctx)
+	err := gcpclients.RegionCommitmentsClientset.Range(func(projectID string, _ *gcpclients.Client[*compute.RegionCommitmentsClient]) error {
+		p := &CollectCommitmentsPayload{ProjectID: projectID}
+		data, err := json.Marshal(p)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for GCP commitments",
+				"project", projectID,
+				"reason", err,
+			)
+
+			return registry.ErrContinue
+		}
+
+		task := asynq.NewTask(TaskCollectCommitments, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", projectID,
+			)
+
+			return registry.ErrContinue
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"project", projectID,
+				"reason", err,
+			)
+
+			return registry.ErrContinue
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"project", projectID,
+		)
+
+		return nil
+	})
+
+	return err
+}
+
+// collectCommitments collects the GCP commitments using the client
+// configuration specified in the payload.
+func collectCommitments(ctx context.Context, payload CollectCommitmentsPayload) error {
+	client, ok := gcpclients.RegionCommitmentsClientset.Get(payload.ProjectID)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.ProjectID))
+	}
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			commitmentsDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.ProjectID,
+		)
+		key := metrics.Key(TaskCollectCommitments, payload.ProjectID)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("collecting GCP commitments", "project", payload.ProjectID)
+
+	pageSize := uint32(constants.PageSize)
+	partialSuccess := bool(true)
+	req := computepb.AggregatedListRegionCommitmentsRequest{
+		Project:              payload.ProjectID,
+		MaxResults:           &pageSize,
+		ReturnPartialSuccess: &partialSuccess,
+	}
+	iter := client.Client.AggregatedList(ctx, &req)
+
+	items := make([]models.Commitment, 0)
+	for {
+		pair, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			logger.Error("failed to get commitments",
+				"project", payload.ProjectID,
+				"reason", err,
+			)
+
+			return err
+		}
+
+		for _, c := range pair.Value.Commitments {
+			if c == nil {
+				continue
+			}
+
+			item := models.Commitment{
+				CommitmentID:      c.GetId(),
+				ProjectID:         payload.ProjectID,
+				Name:              c.GetName(),
+				Description:       c.GetDescription(),
+				Region:            utils.ResourceNameFromURL(c.GetRegion()),
+				Status:            c.GetStatus(),
+				Plan:              c.GetPlan(),
+				Type:              c.GetType(),
+				Category:          c.GetCategory(),
+				AutoRenew:         c.GetAutoRenew(),
+				StartTimestamp:    c.GetStartTimestamp(),
+				EndTimestamp:      c.GetEndTimestamp(),
+				CreationTimestamp: c.GetCreationTimestamp(),
+			}
+
+			for _, r := range c.GetResources() {
+				switch r.GetType() {
+				case computepb.ResourceCommitment_VCPU.String():
+					item.VCPUs += r.GetAmount()
+				case computepb.ResourceCommitment_MEMORY.String():
+					item.MemoryMB += r.GetAmount()
+				}
+			}
+			items = append(items, item)
+		}
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (commitment_id, project_id) DO UPDATE").
+		Set("name = EXCLUDED.name").
+		Set("description = EXCLUDED.description").
+		Set("region = EXCLUDED.region").
+		Set("status = EXCLUDED.status").
+		Set("plan = EXCLUDED.plan").
+		Set("type = EXCLUDED.type").
+		Set("category = EXCLUDED.category").
+		Set("auto_renew = EXCLUDED.auto_renew").
+		Set("vcpus = EXCLUDED.vcpus").
+		Set("memory_mb = EXCLUDED.memory_mb").
+		Set("start_timestamp = EXCLUDED.start_timestamp").
+		Set("end_timestamp = EXCLUDED.end_timestamp").
+		Set("creation_timestamp = EXCLUDED.creation_timestamp").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert commitments into db",
+			"project", payload.ProjectID,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated gcp commitments",
+		"project", payload.ProjectID,
+		"count", count,
+	)
+
+	return nil
+}
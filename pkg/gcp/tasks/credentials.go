@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/gcp/models"
+)
+
+// CredentialKindServiceAccountKey is the kind of expiring credentials, which
+// represent the user-managed keys of GCP IAM service accounts.
+const CredentialKindServiceAccountKey = "gcp_service_account_key"
+
+// CredentialKindSSLCertificate is the kind of expiring credentials, which
+// represent the GCP SSL certificates used by load balancers.
+const CredentialKindSSLCertificate = "gcp_ssl_certificate"
+
+// serviceAccountKeyCredentials returns the collected user-managed GCP service
+// account keys, which are enabled, as [registry.ExpiringCredential] items.
+func serviceAccountKeyCredentials(ctx context.Context) ([]registry.ExpiringCredential, error) {
+	var keys []models.ServiceAccountKey
+	err := db.DB.NewSelect().
+		Model(&keys).
+		Where("disabled = ?", false).
+		Where("valid_before IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]registry.ExpiringCredential, 0, len(keys))
+	for _, key := range keys {
+		item := registry.ExpiringCredential{
+			Kind:      CredentialKindServiceAccountKey,
+			Name:      key.KeyID,
+			Source:    key.ServiceAccountEmail,
+			ExpiresAt: key.ValidBefore,
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// sslCertificateCredentials returns the collected GCP SSL certificates as
+// [registry.ExpiringCredential] items.
+func sslCertificateCredentials(ctx context.Context) ([]registry.ExpiringCredential, error) {
+	var certs []models.SSLCertificate
+	err := db.DB.NewSelect().
+		Model(&certs).
+		Where("not_after IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]registry.ExpiringCredential, 0, len(certs))
+	for _, cert := range certs {
+		item := registry.ExpiringCredential{
+			Kind:      CredentialKindSSLCertificate,
+			Name:      cert.Name,
+			Source:    cert.ProjectID,
+			ExpiresAt: cert.NotAfter,
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func init() {
+	registry.ExpiringCredentialRegistry.MustRegister(CredentialKindServiceAccountKey, serviceAccountKeyCredentials)
+	registry.ExpiringCredentialRegistry.MustRegister(CredentialKindSSLCertificate, sslCertificateCredentials)
+}
@@ -42,7 +42,7 @@ func NewCollectDisksTask() *asynq.Task {
 type CollectDisksPayload struct {
 	// ProjectID specifies the GCP project ID, which is associated with a
 	// registered client.
-	ProjectID string `json:"project_id" yaml:"project_id"`
+	ProjectID string `json:"project_id" yaml:"project_id" desc:"The GCP project ID, which is associated with a registered client" example:"my-project"`
 }
 
 // HandleCollectDisksTask is the handler, which collects GCP disks.
@@ -88,7 +88,17 @@ func enqueueCollectDisks(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectDisks, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", projectID,
+			)
+
+			return registry.ErrContinue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -212,6 +222,7 @@ func collectDisks(ctx context.Context, payload CollectDisksPayload) error {
 				Status:              i.GetStatus(),
 				SizeGB:              i.GetSizeGb(),
 				KubeClusterName:     kubeClusterName,
+				SourceImage:         utils.ResourceNameFromURL(i.GetSourceImage()),
 			}
 
 			disks = append(disks, disk)
@@ -235,6 +246,7 @@ func collectDisks(ctx context.Context, payload CollectDisksPayload) error {
 		Set("status = EXCLUDED.status").
 		Set("size_gb = EXCLUDED.size_gb").
 		Set("k8s_cluster_name = EXCLUDED.k8s_cluster_name").
+		Set("source_image = EXCLUDED.source_image").
 		Set("updated_at = EXCLUDED.updated_at").
 		Returning("id").
 		Exec(ctx)
@@ -41,7 +41,7 @@ const TaskCollectForwardingRules = "gcp:task:collect-forwarding-rules"
 type CollectForwardingRulesPayload struct {
 	// ProjectID specifies the globally unique project id from which to
 	// collect GCP Forwarding Rules.
-	ProjectID string `json:"project_id" yaml:"project_id"`
+	ProjectID string `json:"project_id" yaml:"project_id" desc:"The globally unique project id from which to collect GCP Forwarding Rules" example:"my-project"`
 }
 
 // NewCollectForwardingRulesTask creates a new [asynq.Task] for collecting GCP
@@ -99,7 +99,17 @@ func enqueueCollectForwardingRules(ctx context.Context) error {
 			return registry.ErrContinue
 		}
 		task := asynq.NewTask(TaskCollectForwardingRules, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", projectID,
+			)
+
+			return registry.ErrContinue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	container "cloud.google.com/go/container/apiv1"
@@ -30,7 +31,7 @@ const TaskCollectGKEClusters = "gcp:task:collect-gke-clusters"
 type CollectGKEClustersPayload struct {
 	// ProjectID specifies the globally unique project id from which to
 	// collect.
-	ProjectID string `json:"project_id" yaml:"project_id"`
+	ProjectID string `json:"project_id" yaml:"project_id" desc:"The globally unique project id from which to collect" example:"my-project"`
 }
 
 // NewCollectGKEClustersTask creates a new [asynq.Task] for collecting GKE
@@ -86,7 +87,17 @@ func enqueueCollectGKEClusters(ctx context.Context) error {
 			return registry.ErrContinue
 		}
 		task := asynq.NewTask(TaskCollectGKEClusters, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", projectID,
+			)
+
+			return registry.ErrContinue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	iamv1 "cloud.google.com/go/iam/apiv1/iampb"
 	resourcemanager "cloud.google.com/go/resourcemanager/apiv3"
@@ -42,7 +43,7 @@ func NewCollectIAMPoliciesTask() *asynq.Task {
 type CollectIAMPoliciesPayload struct {
 	// ProjectID specifies the GCP project ID, which is associated with a
 	// registered client.
-	ProjectID string `json:"project_id" yaml:"project_id"`
+	ProjectID string `json:"project_id" yaml:"project_id" desc:"The GCP project ID, which is associated with a registered client" example:"my-project"`
 }
 
 // HandleCollectIAMPoliciesTask is the handler, which collects GCP IAM Policies.
@@ -88,7 +89,17 @@ func enqueueCollectIAMPolicies(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectIAMPolicies, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", projectID,
+			)
+
+			return registry.ErrContinue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
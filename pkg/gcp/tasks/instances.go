@@ -8,12 +8,15 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net"
+	"sync"
 
 	compute "cloud.google.com/go/compute/apiv1"
 	"cloud.google.com/go/compute/apiv1/computepb"
 	"github.com/hibiken/asynq"
 	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/api/iterator"
 
 	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
@@ -25,6 +28,7 @@ import (
 	gcputils "github.com/gardener/inventory/pkg/gcp/utils"
 	"github.com/gardener/inventory/pkg/metrics"
 	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
 )
 
 const (
@@ -41,7 +45,7 @@ const TaskCollectInstances = "gcp:task:collect-instances"
 type CollectInstancesPayload struct {
 	// ProjectID specifies the globally unique project id from which to
 	// collect GCP Compute Engine Instances.
-	ProjectID string `json:"project_id" yaml:"project_id"`
+	ProjectID string `json:"project_id" yaml:"project_id" desc:"The globally unique project id from which to collect GCP Compute Engine Instances" example:"my-project"`
 }
 
 // ErrNoSourceImage is an error returned when a
@@ -102,7 +106,17 @@ func enqueueCollectInstances(ctx context.Context) error {
 			return registry.ErrContinue
 		}
 		task := asynq.NewTask(TaskCollectInstances, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", projectID,
+			)
+
+			return registry.ErrContinue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -159,8 +173,23 @@ func collectInstances(ctx context.Context, payload CollectInstancesPayload) erro
 		ReturnPartialSuccess: &partialSuccess,
 	}
 
-	instances := make([]models.Instance, 0)
-	nics := make([]models.NetworkInterface, 0)
+	// The instances and their NICs are upserted in batches while
+	// iterating, so that large projects are not kept in memory at once.
+	conf := asynqutils.GetConfig(ctx)
+	instances := dbutils.NewBatcher(conf.Worker.BatchSize, upsertInstances)
+	nics := dbutils.NewBatcher(conf.Worker.BatchSize, upsertInstanceNICs)
+
+	// The instances of the zones are processed concurrently, since looking
+	// up their source images requires a request per instance. The mutex
+	// guards the batchers and the errors of the zones, which are reported
+	// at once after all zones have been processed.
+	var (
+		mu   sync.Mutex
+		errs []error
+	)
+	group := new(errgroup.Group)
+	group.SetLimit(gcputils.ZoneConcurrency(conf))
+
 	it := client.Client.AggregatedList(ctx, req)
 	for {
 		// The iterator returns a k/v pair, where the key represents a
@@ -176,105 +205,169 @@ func collectInstances(ctx context.Context, payload CollectInstancesPayload) erro
 				"project", payload.ProjectID,
 				"reason", err,
 			)
+			mu.Lock()
+			errs = append(errs, err)
+			mu.Unlock()
 
-			return err
+			break
 		}
 
 		zone := gcputils.UnqualifyZone(pair.Key)
-		region := gcputils.RegionFromZone(zone)
-		for _, inst := range pair.Value.Instances {
-			sourceMachineImage, err := getSourceMachineImageFromDisks(ctx, payload.ProjectID, zone, inst.GetDisks())
-			if err != nil {
-				logger.Error(
-					"could not get source machine image",
-					"reason",
-					err,
-				)
-			}
+		items := pair.Value.Instances
+		group.Go(func() error {
+			zoneInstances, zoneNICs := toInstanceModels(ctx, payload.ProjectID, zone, items)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err := instances.Add(ctx, zoneInstances...); err != nil {
+				errs = append(errs, fmt.Errorf("zone %s: %w", zone, err))
 
-			// Collect instance
-			labels := inst.GetLabels()
-			gkeClusterName := labels[gkeClusterNameLabel]
-			gkeClusterPoolName := labels[gkeClusterPoolNameLabel]
-			instance := models.Instance{
-				Name:                 inst.GetName(),
-				Hostname:             inst.GetHostname(),
-				InstanceID:           inst.GetId(),
-				ProjectID:            payload.ProjectID,
-				Zone:                 zone,
-				Region:               region,
-				CanIPForward:         inst.GetCanIpForward(),
-				CPUPlatform:          inst.GetCpuPlatform(),
-				CreationTimestamp:    inst.GetCreationTimestamp(),
-				Description:          inst.GetDescription(),
-				LastStartTimestamp:   inst.GetLastStartTimestamp(),
-				LastStopTimestamp:    inst.GetLastStopTimestamp(),
-				LastSuspendTimestamp: inst.GetLastSuspendedTimestamp(),
-				MachineType:          gcputils.ResourceNameFromURL(inst.GetMachineType()),
-				MinCPUPlatform:       inst.GetMinCpuPlatform(),
-				SelfLink:             inst.GetSelfLink(),
-				SourceMachineImage:   sourceMachineImage,
-				Status:               inst.GetStatus(),
-				StatusMessage:        inst.GetStatusMessage(),
-				GKEClusterName:       gkeClusterName,
-				GKEPoolName:          gkeClusterPoolName,
+				return nil
 			}
-			instances = append(instances, instance)
+			if err := nics.Add(ctx, zoneNICs...); err != nil {
+				errs = append(errs, fmt.Errorf("zone %s: %w", zone, err))
+			}
+
+			return nil
+		})
+	}
+
+	// The errors of the zones are recorded in errs instead
+	_ = group.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	if err := instances.Flush(ctx); err != nil {
+		return err
+	}
+	if err := nics.Flush(ctx); err != nil {
+		return err
+	}
+
+	count = instances.Count()
+	if count == 0 {
+		return nil
+	}
+
+	logger.Info(
+		"populated gcp instances",
+		"project", payload.ProjectID,
+		"count", count,
+	)
 
-			// Collect NICs
-			for _, ni := range inst.GetNetworkInterfaces() {
-				accessConfigCount := 0
+	if nics.Count() == 0 {
+		return nil
+	}
 
-				var natIP string
+	logger.Info(
+		"populated gcp network interfaces",
+		"project", payload.ProjectID,
+		"count", nics.Count(),
+	)
 
-				accessConfig := ni.GetAccessConfigs()
-				for _, conf := range accessConfig {
-					accessConfigCount++
-					if conf == nil {
-						continue
-					}
+	return nil
+}
 
-					if ip := conf.GetNatIP(); ip != "" {
-						natIP = ip
-					}
-				}
+// toInstanceModels converts the given GCP instances of a zone to
+// [models.Instance] and [models.NetworkInterface] items. The source images of
+// the instances are looked up via their boot disks.
+func toInstanceModels(ctx context.Context, projectID string, zone string, items []*computepb.Instance) ([]models.Instance, []models.NetworkInterface) {
+	logger := asynqutils.GetLogger(ctx)
+	instances := make([]models.Instance, 0, len(items))
+	nics := make([]models.NetworkInterface, 0)
+	for _, inst := range items {
+		sourceMachineImage, err := getSourceMachineImageFromDisks(ctx, projectID, zone, inst.GetDisks())
+		if err != nil {
+			logger.Error(
+				"could not get source machine image",
+				"reason",
+				err,
+			)
+		}
 
-				if accessConfigCount > 1 {
-					logger.Warn(
-						"too many access configs for instance NIC",
-						"nic_id", ni.GetName(),
-						"instance_id", inst.GetId(),
-						"project_id", payload.ProjectID,
-					)
+		// Collect instance
+		labels := inst.GetLabels()
+		gkeClusterName := labels[gkeClusterNameLabel]
+		gkeClusterPoolName := labels[gkeClusterPoolNameLabel]
+		instance := models.Instance{
+			Name:                 inst.GetName(),
+			Hostname:             inst.GetHostname(),
+			InstanceID:           inst.GetId(),
+			ProjectID:            projectID,
+			Zone:                 zone,
+			Region:               gcputils.RegionFromZone(zone),
+			CanIPForward:         inst.GetCanIpForward(),
+			CPUPlatform:          inst.GetCpuPlatform(),
+			CreationTimestamp:    inst.GetCreationTimestamp(),
+			Description:          inst.GetDescription(),
+			LastStartTimestamp:   inst.GetLastStartTimestamp(),
+			LastStopTimestamp:    inst.GetLastStopTimestamp(),
+			LastSuspendTimestamp: inst.GetLastSuspendedTimestamp(),
+			MachineType:          gcputils.ResourceNameFromURL(inst.GetMachineType()),
+			MinCPUPlatform:       inst.GetMinCpuPlatform(),
+			SelfLink:             inst.GetSelfLink(),
+			SourceMachineImage:   sourceMachineImage,
+			Status:               inst.GetStatus(),
+			StatusMessage:        inst.GetStatusMessage(),
+			GKEClusterName:       gkeClusterName,
+			GKEPoolName:          gkeClusterPoolName,
+		}
+		instances = append(instances, instance)
 
+		// Collect NICs
+		for _, ni := range inst.GetNetworkInterfaces() {
+			accessConfigCount := 0
+
+			var natIP string
+
+			accessConfig := ni.GetAccessConfigs()
+			for _, conf := range accessConfig {
+				accessConfigCount++
+				if conf == nil {
 					continue
 				}
 
-				nic := models.NetworkInterface{
-					Name:           ni.GetName(),
-					ProjectID:      payload.ProjectID,
-					InstanceID:     inst.GetId(),
-					Network:        gcputils.ResourceNameFromURL(ni.GetNetwork()),
-					Subnetwork:     gcputils.ResourceNameFromURL(ni.GetSubnetwork()),
-					IPv4:           net.ParseIP(ni.GetNetworkIP()),
-					IPv6:           net.ParseIP(ni.GetIpv6Address()),
-					IPv6AccessType: ni.GetIpv6AccessType(),
-					NICType:        ni.GetNicType(),
-					StackType:      ni.GetStackType(),
-					NATIP:          net.ParseIP(natIP),
+				if ip := conf.GetNatIP(); ip != "" {
+					natIP = ip
 				}
-				nics = append(nics, nic)
 			}
+
+			if accessConfigCount > 1 {
+				logger.Warn(
+					"too many access configs for instance NIC",
+					"nic_id", ni.GetName(),
+					"instance_id", inst.GetId(),
+					"project_id", projectID,
+				)
+
+				continue
+			}
+
+			nic := models.NetworkInterface{
+				Name:           ni.GetName(),
+				ProjectID:      projectID,
+				InstanceID:     inst.GetId(),
+				Network:        gcputils.ResourceNameFromURL(ni.GetNetwork()),
+				Subnetwork:     gcputils.ResourceNameFromURL(ni.GetSubnetwork()),
+				IPv4:           net.ParseIP(ni.GetNetworkIP()),
+				IPv6:           net.ParseIP(ni.GetIpv6Address()),
+				IPv6AccessType: ni.GetIpv6AccessType(),
+				NICType:        ni.GetNicType(),
+				StackType:      ni.GetStackType(),
+				NATIP:          net.ParseIP(natIP),
+			}
+			nics = append(nics, nic)
 		}
 	}
 
-	// Upsert instances
-	if len(instances) == 0 {
-		return nil
-	}
+	return instances, nics
+}
 
+// upsertInstances upserts the given GCP instances into the database.
+func upsertInstances(ctx context.Context, items []models.Instance) (int64, error) {
 	out, err := db.DB.NewInsert().
-		Model(&instances).
+		Model(&items).
 		On("CONFLICT (project_id, instance_id) DO UPDATE").
 		Set("name = EXCLUDED.name").
 		Set("hostname = EXCLUDED.hostname").
@@ -300,27 +393,17 @@ func collectInstances(ctx context.Context, payload CollectInstancesPayload) erro
 		Exec(ctx)
 
 	if err != nil {
-		return err
-	}
-
-	count, err = out.RowsAffected()
-	if err != nil {
-		return err
+		return 0, err
 	}
 
-	logger.Info(
-		"populated gcp instances",
-		"project", payload.ProjectID,
-		"count", count,
-	)
-
-	// Upsert NICs
-	if len(nics) == 0 {
-		return nil
-	}
+	return out.RowsAffected()
+}
 
-	out, err = db.DB.NewInsert().
-		Model(&nics).
+// upsertInstanceNICs upserts the given NICs of GCP instances into the
+// database.
+func upsertInstanceNICs(ctx context.Context, items []models.NetworkInterface) (int64, error) {
+	out, err := db.DB.NewInsert().
+		Model(&items).
 		On("CONFLICT (project_id, instance_id, name) DO UPDATE").
 		Set("network = EXCLUDED.network").
 		Set("subnetwork = EXCLUDED.subnetwork").
@@ -335,21 +418,10 @@ func collectInstances(ctx context.Context, payload CollectInstancesPayload) erro
 		Exec(ctx)
 
 	if err != nil {
-		return err
-	}
-
-	count, err = out.RowsAffected()
-	if err != nil {
-		return err
+		return 0, err
 	}
 
-	logger.Info(
-		"populated gcp network interfaces",
-		"project", payload.ProjectID,
-		"count", count,
-	)
-
-	return nil
+	return out.RowsAffected()
 }
 
 func getSourceMachineImageFromDisks(
@@ -552,3 +552,248 @@ func LinkTargetPoolWithProject(ctx context.Context, db *bun.DB) error {
 
 	return nil
 }
+
+// LinkCloudSQLInstanceWithProject creates links between the
+// [models.CloudSQLInstance] and [models.Project] models.
+func LinkCloudSQLInstanceWithProject(ctx context.Context, db *bun.DB) error {
+	var items []models.CloudSQLInstance
+	err := db.NewSelect().
+		Model(&items).
+		Relation("Project").
+		Where("project.id IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	links := make([]models.CloudSQLInstanceToProject, 0)
+	for _, item := range items {
+		link := models.CloudSQLInstanceToProject{
+			InstanceID: item.ID,
+			ProjectID:  item.Project.ID,
+		}
+		links = append(links, link)
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	out, err := db.NewInsert().
+		Model(&links).
+		On("CONFLICT (instance_id, project_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("linked cloud sql instance with project", "count", count)
+
+	return nil
+}
+
+// LinkServiceAccountWithProject creates links between the
+// [models.ServiceAccount] and [models.Project] models.
+func LinkServiceAccountWithProject(ctx context.Context, db *bun.DB) error {
+	var items []models.ServiceAccount
+	err := db.NewSelect().
+		Model(&items).
+		Relation("Project").
+		Where("project.id IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	links := make([]models.ServiceAccountToProject, 0)
+	for _, item := range items {
+		link := models.ServiceAccountToProject{
+			ServiceAccountID: item.ID,
+			ProjectID:        item.Project.ID,
+		}
+		links = append(links, link)
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	out, err := db.NewInsert().
+		Model(&links).
+		On("CONFLICT (service_account_id, project_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("linked service account with project", "count", count)
+
+	return nil
+}
+
+// LinkServiceAccountKeyWithServiceAccount creates links between the
+// [models.ServiceAccountKey] and [models.ServiceAccount] models.
+func LinkServiceAccountKeyWithServiceAccount(ctx context.Context, db *bun.DB) error {
+	var items []models.ServiceAccountKey
+	err := db.NewSelect().
+		Model(&items).
+		Relation("ServiceAccount").
+		Where("service_account.id IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	links := make([]models.ServiceAccountKeyToAccount, 0)
+	for _, item := range items {
+		link := models.ServiceAccountKeyToAccount{
+			KeyID:            item.ID,
+			ServiceAccountID: item.ServiceAccount.ID,
+		}
+		links = append(links, link)
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	out, err := db.NewInsert().
+		Model(&links).
+		On("CONFLICT (key_id, service_account_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("linked service account key with service account", "count", count)
+
+	return nil
+}
+
+// LinkReservationWithProject creates links between the
+// [models.Reservation] and [models.Project] models.
+func LinkReservationWithProject(ctx context.Context, db *bun.DB) error {
+	var items []models.Reservation
+	err := db.NewSelect().
+		Model(&items).
+		Relation("Project").
+		Where("project.id IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	links := make([]models.ReservationToProject, 0)
+	for _, item := range items {
+		link := models.ReservationToProject{
+			ReservationID: item.ID,
+			ProjectID:     item.Project.ID,
+		}
+		links = append(links, link)
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	out, err := db.NewInsert().
+		Model(&links).
+		On("CONFLICT (reservation_id, project_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("linked reservation with project", "count", count)
+
+	return nil
+}
+
+// LinkCommitmentWithProject creates links between the
+// [models.Commitment] and [models.Project] models.
+func LinkCommitmentWithProject(ctx context.Context, db *bun.DB) error {
+	var items []models.Commitment
+	err := db.NewSelect().
+		Model(&items).
+		Relation("Project").
+		Where("project.id IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	links := make([]models.CommitmentToProject, 0)
+	for _, item := range items {
+		link := models.CommitmentToProject{
+			CommitmentID: item.ID,
+			ProjectID:    item.Project.ID,
+		}
+		links = append(links, link)
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	out, err := db.NewInsert().
+		Model(&links).
+		On("CONFLICT (commitment_id, project_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("linked commitment with project", "count", count)
+
+	return nil
+}
@@ -118,6 +118,69 @@ var (
 		[]string{"project_id"},
 		nil,
 	)
+
+	// cloudSQLInstancesDesc is the descriptor for a metric, which tracks
+	// the number of collected GCP Cloud SQL instances.
+	cloudSQLInstancesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "gcp_cloud_sql_instances"),
+		"A gauge which tracks the number of collected GCP Cloud SQL instances",
+		[]string{"project_id"},
+		nil,
+	)
+
+	// serviceAccountsDesc is the descriptor for a metric, which tracks the
+	// number of collected GCP IAM service accounts.
+	serviceAccountsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "gcp_service_accounts"),
+		"A gauge which tracks the number of collected GCP service accounts",
+		[]string{"project_id"},
+		nil,
+	)
+
+	// serviceAccountKeysDesc is the descriptor for a metric, which tracks
+	// the number of collected user-managed GCP service account keys.
+	serviceAccountKeysDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "gcp_service_account_keys"),
+		"A gauge which tracks the number of collected GCP service account keys",
+		[]string{"project_id"},
+		nil,
+	)
+
+	// reservationsDesc is the descriptor for a metric, which tracks the
+	// number of collected GCP Compute Engine reservations.
+	reservationsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "gcp_reservations"),
+		"A gauge which tracks the number of collected GCP reservations",
+		[]string{"project_id"},
+		nil,
+	)
+
+	// commitmentsDesc is the descriptor for a metric, which tracks the
+	// number of collected GCP Compute Engine commitments.
+	commitmentsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "gcp_commitments"),
+		"A gauge which tracks the number of collected GCP commitments",
+		[]string{"project_id"},
+		nil,
+	)
+
+	// regionQuotasDesc is the descriptor for a metric, which tracks the
+	// number of collected regional GCP Compute Engine quotas.
+	regionQuotasDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "gcp_region_quotas"),
+		"A gauge which tracks the number of collected GCP region quotas",
+		[]string{"project_id"},
+		nil,
+	)
+
+	// sslCertificatesDesc is the descriptor for a metric, which tracks the
+	// number of collected GCP SSL certificates.
+	sslCertificatesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "gcp_ssl_certificates"),
+		"A gauge which tracks the number of collected GCP SSL certificates",
+		[]string{"project_id"},
+		nil,
+	)
 )
 
 // init registers the metrics with the [metrics.DefaultCollector].
@@ -135,5 +198,12 @@ func init() {
 		forwardingRulesDesc,
 		iamPoliciesDesc,
 		iamBindingsDesc,
+		cloudSQLInstancesDesc,
+		serviceAccountsDesc,
+		serviceAccountKeysDesc,
+		reservationsDesc,
+		commitmentsDesc,
+		regionQuotasDesc,
+		sslCertificatesDesc,
 	)
 }
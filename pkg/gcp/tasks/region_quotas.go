@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/api/iterator"
+
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	"github.com/gardener/inventory/pkg/clients/db"
+	gcpclients "github.com/gardener/inventory/pkg/clients/gcp"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/gcp/constants"
+	"github.com/gardener/inventory/pkg/gcp/models"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// TaskCollectRegionQuotas is the name of the task for collecting the regional
+// GCP Compute Engine quotas and their usage.
+const TaskCollectRegionQuotas = "gcp:task:collect-region-quotas"
+
+// CollectRegionQuotasPayload is the payload, which is used to collect GCP
+// region quotas.
+type CollectRegionQuotasPayload struct {
+	// ProjectID specifies the GCP project ID, which is associated with a
+	// registered client.
+	ProjectID string `json:"project_id" yaml:"project_id" desc:"The GCP project ID, which is associated with a registered client" example:"my-project"`
+}
+
+// NewCollectRegionQuotasTask creates a new [asynq.Task] task for collecting
+// GCP region quotas without specifying a payload.
+func NewCollectRegionQuotasTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectRegionQuotas, nil)
+}
+
+// HandleCollectRegionQuotasTask is the handler, which collects GCP
+// region quotas.
+func HandleCollectRegionQuotasTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we will enqueue tasks for
+	// collecting region quotas for all configured clients.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectRegionQuotas(ctx)
+	}
+
+	var payload CollectRegionQuotasPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.ProjectID == "" {
+		return asynqutils.SkipRetry(ErrNoProjectID)
+	}
+
+	return collectRegionQuotas(ctx, payload)
+}
+
+// enqueueCollectRegionQuotas enqueues tasks for collecting GCP region quotas
+// for all collected GCP projects.
+func enqueueCollectRegionQuotas(ctx context.Context) error {
+	logger := asynqutils.GetLogger(ctx)
+
+	queue := asynqutils.GetQueueName(ctx)
+	err := gcpclients.RegionsClientset.Range(func(projectID string, _ *gcpclients.Client[*compute.RegionsClient]) error {
+		p := &CollectRegionQuotasPayload{ProjectID: projectID}
+		data, err := json.Marshal(p)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for GCP region quotas",
+				"project", projectID,
+				"reason", err,
+			)
+
+			return registry.ErrContinue
+		}
+
+		task := asynq.NewTask(TaskCollectRegionQuotas, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", projectID,
+			)
+
+			return registry.ErrContinue
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"project", projectID,
+				"reason", err,
+			)
+
+			return registry.ErrContinue
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"project", projectID,
+		)
+
+		return nil
+	})
+
+	return err
+}
+
+// collectRegionQuotas collects the regional GCP quotas using the client
+// configuration specified in the payload.
+func collectRegionQuotas(ctx context.Context, payload CollectRegionQuotasPayload) error {
+	client, ok := gcpclients.RegionsClientset.Get(payload.ProjectID)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.ProjectID))
+	}
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			regionQuotasDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.ProjectID,
+		)
+		key := metrics.Key(TaskCollectRegionQuotas, payload.ProjectID)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("collecting GCP region quotas", "project", payload.ProjectID)
+
+	pageSize := uint32(constants.PageSize)
+	partialSuccess := bool(true)
+	req := computepb.ListRegionsRequest{
+		Project:              payload.ProjectID,
+		MaxResults:           &pageSize,
+		ReturnPartialSuccess: &partialSuccess,
+	}
+	iter := client.Client.List(ctx, &req)
+
+	items := make([]models.RegionQuota, 0)
+	for {
+		region, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			logger.Error("failed to get regions",
+				"project", payload.ProjectID,
+				"reason", err,
+			)
+
+			return err
+		}
+
+		for _, q := range region.GetQuotas() {
+			if q == nil {
+				continue
+			}
+
+			item := models.RegionQuota{
+				ProjectID: payload.ProjectID,
+				Region:    region.GetName(),
+				Metric:    q.GetMetric(),
+				Limit:     q.GetLimit(),
+				Usage:     q.GetUsage(),
+			}
+			items = append(items, item)
+		}
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (project_id, region, metric) DO UPDATE").
+		Set("quota_limit = EXCLUDED.quota_limit").
+		Set("usage = EXCLUDED.usage").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert region quotas into db",
+			"project", payload.ProjectID,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated gcp region quotas",
+		"project", payload.ProjectID,
+		"count", count,
+	)
+
+	return nil
+}
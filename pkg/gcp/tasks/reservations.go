@@ -0,0 +1,243 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/api/iterator"
+
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	"github.com/gardener/inventory/pkg/clients/db"
+	gcpclients "github.com/gardener/inventory/pkg/clients/gcp"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/gcp/constants"
+	"github.com/gardener/inventory/pkg/gcp/models"
+	"github.com/gardener/inventory/pkg/gcp/utils"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// TaskCollectReservations is the name of the task for collecting GCP Compute
+// Engine reservations.
+const TaskCollectReservations = "gcp:task:collect-reservations"
+
+// CollectReservationsPayload is the payload, which is used to collect GCP
+// reservations.
+type CollectReservationsPayload struct {
+	// ProjectID specifies the GCP project ID, which is associated with a
+	// registered client.
+	ProjectID string `json:"project_id" yaml:"project_id" desc:"The GCP project ID, which is associated with a registered client" example:"my-project"`
+}
+
+// NewCollectReservationsTask creates a new [asynq.Task] task for collecting
+// GCP reservations without specifying a payload.
+func NewCollectReservationsTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectReservations, nil)
+}
+
+// HandleCollectReservationsTask is the handler, which collects GCP
+// reservations.
+func HandleCollectReservationsTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we will enqueue tasks for
+	// collecting reservations for all configured clients.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectReservations(ctx)
+	}
+
+	var payload CollectReservationsPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.ProjectID == "" {
+		return asynqutils.SkipRetry(ErrNoProjectID)
+	}
+
+	return collectReservations(ctx, payload)
+}
+
+// enqueueCollectReservations enqueues tasks for collecting GCP reservations
+// for all collected GCP projects.
+func enqueueCollectReservations(ctx context.Context) error {
+	logger := asynqutils.GetLogger(ctx)
+
+	queue := asynqutils.GetQueueName(ctx)
+	err := gcpclients.ReservationsClientset.Range(func(projectID string, _ *gcpclients.Client[*compute.ReservationsClient]) error {
+		p := &CollectReservationsPayload{ProjectID: projectID}
+		data, err := json.Marshal(p)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for GCP reservations",
+				"project", projectID,
+				"reason", err,
+			)
+
+			return registry.ErrContinue
+		}
+
+		task := asynq.NewTask(TaskCollectReservations, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", projectID,
+			)
+
+			return registry.ErrContinue
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"project", projectID,
+				"reason", err,
+			)
+
+			return registry.ErrContinue
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"project", projectID,
+		)
+
+		return nil
+	})
+
+	return err
+}
+
+// collectReservations collects the GCP reservations using the client
+// configuration specified in the payload.
+func collectReservations(ctx context.Context, payload CollectReservationsPayload) error {
+	client, ok := gcpclients.ReservationsClientset.Get(payload.ProjectID)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.ProjectID))
+	}
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			reservationsDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.ProjectID,
+		)
+		key := metrics.Key(TaskCollectReservations, payload.ProjectID)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("collecting GCP reservations", "project", payload.ProjectID)
+
+	pageSize := uint32(constants.PageSize)
+	partialSuccess := bool(true)
+	req := computepb.AggregatedListReservationsRequest{
+		Project:              payload.ProjectID,
+		MaxResults:           &pageSize,
+		ReturnPartialSuccess: &partialSuccess,
+	}
+	iter := client.Client.AggregatedList(ctx, &req)
+
+	items := make([]models.Reservation, 0)
+	for {
+		pair, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			logger.Error("failed to get reservations",
+				"project", payload.ProjectID,
+				"reason", err,
+			)
+
+			return err
+		}
+
+		for _, r := range pair.Value.Reservations {
+			if r == nil {
+				continue
+			}
+
+			zone := utils.ResourceNameFromURL(r.GetZone())
+			specific := r.GetSpecificReservation()
+			item := models.Reservation{
+				ReservationID:     r.GetId(),
+				ProjectID:         payload.ProjectID,
+				Name:              r.GetName(),
+				Description:       r.GetDescription(),
+				Zone:              zone,
+				Region:            utils.RegionFromZone(zone),
+				Status:            r.GetStatus(),
+				MachineType:       specific.GetInstanceProperties().GetMachineType(),
+				Count:             specific.GetCount(),
+				InUseCount:        specific.GetInUseCount(),
+				SpecificRequired:  r.GetSpecificReservationRequired(),
+				Commitment:        utils.ResourceNameFromURL(r.GetCommitment()),
+				CreationTimestamp: r.GetCreationTimestamp(),
+			}
+			items = append(items, item)
+		}
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (reservation_id, project_id) DO UPDATE").
+		Set("name = EXCLUDED.name").
+		Set("description = EXCLUDED.description").
+		Set("zone = EXCLUDED.zone").
+		Set("region = EXCLUDED.region").
+		Set("status = EXCLUDED.status").
+		Set("machine_type = EXCLUDED.machine_type").
+		Set("count = EXCLUDED.count").
+		Set("in_use_count = EXCLUDED.in_use_count").
+		Set("specific_required = EXCLUDED.specific_required").
+		Set("commitment = EXCLUDED.commitment").
+		Set("creation_timestamp = EXCLUDED.creation_timestamp").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert reservations into db",
+			"project", payload.ProjectID,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated gcp reservations",
+		"project", payload.ProjectID,
+		"count", count,
+	)
+
+	return nil
+}
@@ -0,0 +1,313 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	iam "google.golang.org/api/iam/v1"
+
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	"github.com/gardener/inventory/pkg/clients/db"
+	gcpclients "github.com/gardener/inventory/pkg/clients/gcp"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/gcp/models"
+	gcputils "github.com/gardener/inventory/pkg/gcp/utils"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// TaskCollectServiceAccounts is the name of the task for collecting GCP IAM
+// service accounts and their user-managed keys.
+const TaskCollectServiceAccounts = "gcp:task:collect-service-accounts"
+
+// serviceAccountKeyTypeUserManaged is the type of service account keys, which
+// are created and managed by users. System-managed keys are rotated by GCP
+// automatically and are not collected.
+const serviceAccountKeyTypeUserManaged = "USER_MANAGED"
+
+// CollectServiceAccountsPayload is the payload used for collecting GCP IAM
+// service accounts.
+type CollectServiceAccountsPayload struct {
+	// ProjectID specifies the globally unique project id from which to
+	// collect.
+	ProjectID string `json:"project_id" yaml:"project_id" desc:"The globally unique project id from which to collect" example:"my-project"`
+}
+
+// NewCollectServiceAccountsTask creates a new [asynq.Task] for collecting GCP
+// IAM service accounts, without specifying a payload.
+func NewCollectServiceAccountsTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectServiceAccounts, nil)
+}
+
+// HandleCollectServiceAccountsTask is the handler, which collects GCP IAM
+// service accounts and their user-managed keys.
+func HandleCollectServiceAccountsTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we enqueue tasks for
+	// collecting service accounts from all registered projects.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectServiceAccounts(ctx)
+	}
+
+	var payload CollectServiceAccountsPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.ProjectID == "" {
+		return asynqutils.SkipRetry(ErrNoProjectID)
+	}
+
+	return collectServiceAccounts(ctx, payload)
+}
+
+// enqueueCollectServiceAccounts enqueues tasks for collecting GCP IAM service
+// accounts.
+func enqueueCollectServiceAccounts(ctx context.Context) error {
+	logger := asynqutils.GetLogger(ctx)
+	if gcpclients.IAMClientset.Length() == 0 {
+		logger.Warn("no GCP IAM clients found")
+
+		return nil
+	}
+
+	// Enqueue tasks for all registered GCP Projects
+	queue := asynqutils.GetQueueName(ctx)
+	err := gcpclients.IAMClientset.Range(func(projectID string, _ *gcpclients.Client[*iam.Service]) error {
+		payload := CollectServiceAccountsPayload{
+			ProjectID: projectID,
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for GCP service accounts",
+				"project", projectID,
+				"reason", err,
+			)
+
+			return registry.ErrContinue
+		}
+		task := asynq.NewTask(TaskCollectServiceAccounts, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", projectID,
+			)
+
+			return registry.ErrContinue
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"project", projectID,
+				"reason", err,
+			)
+
+			return registry.ErrContinue
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"project", projectID,
+		)
+
+		return nil
+	})
+
+	return err
+}
+
+// collectServiceAccounts collects the GCP IAM service accounts and their
+// user-managed keys from the project specified in the payload.
+func collectServiceAccounts(ctx context.Context, payload CollectServiceAccountsPayload) error {
+	client, ok := gcpclients.IAMClientset.Get(payload.ProjectID)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.ProjectID))
+	}
+
+	var count, keyCount int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			serviceAccountsDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.ProjectID,
+		)
+		key := metrics.Key(TaskCollectServiceAccounts, payload.ProjectID)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			serviceAccountKeysDesc,
+			prometheus.GaugeValue,
+			float64(keyCount),
+			payload.ProjectID,
+		)
+		key := metrics.Key(TaskCollectServiceAccounts, "keys", payload.ProjectID)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("collecting GCP service accounts", "project", payload.ProjectID)
+
+	items := make([]models.ServiceAccount, 0)
+	err := client.Client.Projects.ServiceAccounts.List(gcputils.ProjectFQN(payload.ProjectID)).
+		Pages(ctx, func(page *iam.ListServiceAccountsResponse) error {
+			for _, sa := range page.Accounts {
+				item := models.ServiceAccount{
+					Email:       sa.Email,
+					ProjectID:   payload.ProjectID,
+					UniqueID:    sa.UniqueId,
+					Name:        sa.Name,
+					DisplayName: sa.DisplayName,
+					Description: sa.Description,
+					Disabled:    sa.Disabled,
+				}
+				items = append(items, item)
+			}
+
+			return nil
+		})
+
+	if err != nil {
+		logger.Error(
+			"failed to get service accounts",
+			"project", payload.ProjectID,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	keys := make([]models.ServiceAccountKey, 0)
+	for _, sa := range items {
+		resp, err := client.Client.Projects.ServiceAccounts.Keys.List(sa.Name).
+			KeyTypes(serviceAccountKeyTypeUserManaged).
+			Context(ctx).
+			Do()
+
+		if err != nil {
+			logger.Error(
+				"failed to get service account keys",
+				"project", payload.ProjectID,
+				"service_account", sa.Email,
+				"reason", err,
+			)
+
+			return err
+		}
+
+		for _, k := range resp.Keys {
+			item := models.ServiceAccountKey{
+				KeyID:               gcputils.ResourceNameFromURL(k.Name),
+				ServiceAccountEmail: sa.Email,
+				ProjectID:           payload.ProjectID,
+				KeyType:             k.KeyType,
+				KeyOrigin:           k.KeyOrigin,
+				KeyAlgorithm:        k.KeyAlgorithm,
+				Disabled:            k.Disabled,
+				ValidAfter:          parseServiceAccountKeyTime(k.ValidAfterTime),
+				ValidBefore:         parseServiceAccountKeyTime(k.ValidBeforeTime),
+			}
+			keys = append(keys, item)
+		}
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (email, project_id) DO UPDATE").
+		Set("unique_id = EXCLUDED.unique_id").
+		Set("name = EXCLUDED.name").
+		Set("display_name = EXCLUDED.display_name").
+		Set("description = EXCLUDED.description").
+		Set("disabled = EXCLUDED.disabled").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert service accounts into db",
+			"project", payload.ProjectID,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if len(keys) > 0 {
+		out, err = db.DB.NewInsert().
+			Model(&keys).
+			On("CONFLICT (key_id, service_account_email, project_id) DO UPDATE").
+			Set("key_type = EXCLUDED.key_type").
+			Set("key_origin = EXCLUDED.key_origin").
+			Set("key_algorithm = EXCLUDED.key_algorithm").
+			Set("disabled = EXCLUDED.disabled").
+			Set("valid_after = EXCLUDED.valid_after").
+			Set("valid_before = EXCLUDED.valid_before").
+			Set("updated_at = EXCLUDED.updated_at").
+			Returning("id").
+			Exec(ctx)
+
+		if err != nil {
+			logger.Error(
+				"could not insert service account keys into db",
+				"project", payload.ProjectID,
+				"reason", err,
+			)
+
+			return err
+		}
+
+		keyCount, err = out.RowsAffected()
+		if err != nil {
+			return err
+		}
+	}
+
+	logger.Info(
+		"populated gcp service accounts",
+		"project", payload.ProjectID,
+		"count", count,
+		"keys", keyCount,
+	)
+
+	return nil
+}
+
+// parseServiceAccountKeyTime parses the given RFC 3339 timestamp of a service
+// account key. It returns the zero [time.Time], if the timestamp is empty or
+// invalid.
+func parseServiceAccountKeyTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
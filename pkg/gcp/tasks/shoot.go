@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	compute "cloud.google.com/go/compute/apiv1"
+	"github.com/hibiken/asynq"
+
+	gcpclients "github.com/gardener/inventory/pkg/clients/gcp"
+	"github.com/gardener/inventory/pkg/core/registry"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// newShootCollectTasks returns the tasks for collecting the GCP resources of
+// the Gardener Shoot described by the given scope. If no projects are known
+// for the Shoot, then the resources are collected from all registered
+// projects.
+func newShootCollectTasks(scope registry.ShootScope) ([]*asynq.Task, error) {
+	projects := scope.Accounts
+	if len(projects) == 0 {
+		_ = gcpclients.InstancesClientset.Range(func(projectID string, _ *gcpclients.Client[*compute.InstancesClient]) error {
+			projects = append(projects, projectID)
+
+			return nil
+		})
+	}
+
+	items := make([]*asynq.Task, 0)
+	for _, projectID := range projects {
+		if !gcpclients.InstancesClientset.Exists(projectID) {
+			continue
+		}
+
+		payloads := []struct {
+			name    string
+			payload any
+		}{
+			{TaskCollectVPCs, CollectVPCsPayload{ProjectID: projectID}},
+			{TaskCollectSubnets, CollectSubnetsPayload{ProjectID: projectID}},
+			{TaskCollectInstances, CollectInstancesPayload{ProjectID: projectID}},
+			{TaskCollectDisks, CollectDisksPayload{ProjectID: projectID}},
+			{TaskCollectAddresses, CollectAddressesPayload{ProjectID: projectID}},
+			{TaskCollectForwardingRules, CollectForwardingRulesPayload{ProjectID: projectID}},
+			{TaskCollectTargetPools, CollectTargetPoolsPayload{ProjectID: projectID}},
+		}
+
+		for _, item := range payloads {
+			task, err := asynqutils.NewTask(item.name, item.payload)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, task)
+		}
+	}
+
+	return items, nil
+}
+
+func init() {
+	registry.ShootCollectorRegistry.MustRegister("gcp", registry.ShootCollector{
+		ProviderType: "gcp",
+		NewTasks:     newShootCollectTasks,
+		LinkTasks:    []string{TaskLinkAll},
+	})
+}
@@ -0,0 +1,394 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"time"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/api/iterator"
+
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	"github.com/gardener/inventory/pkg/clients/db"
+	gcpclients "github.com/gardener/inventory/pkg/clients/gcp"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/gcp/constants"
+	"github.com/gardener/inventory/pkg/gcp/models"
+	gcputils "github.com/gardener/inventory/pkg/gcp/utils"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// TaskCollectSSLCertificates is the name of the task for collecting GCP SSL
+// certificates and the target proxies using them.
+const TaskCollectSSLCertificates = "gcp:task:collect-ssl-certificates"
+
+// Types of target proxies, which reference SSL certificates.
+const (
+	targetProxyTypeHTTPS = "https"
+	targetProxyTypeSSL   = "ssl"
+)
+
+// regionGlobal is the key used by the aggregated list APIs for global
+// resources.
+const regionGlobal = "global"
+
+// CollectSSLCertificatesPayload is the payload, which is used to collect GCP
+// SSL certificates.
+type CollectSSLCertificatesPayload struct {
+	// ProjectID specifies the globally unique project id from which to
+	// collect.
+	ProjectID string `json:"project_id" yaml:"project_id" desc:"The globally unique project id from which to collect" example:"my-project"`
+}
+
+// NewCollectSSLCertificatesTask creates a new [asynq.Task] for collecting GCP
+// SSL certificates, without specifying a payload.
+func NewCollectSSLCertificatesTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectSSLCertificates, nil)
+}
+
+// HandleCollectSSLCertificatesTask is the handler, which collects GCP SSL
+// certificates and the target HTTPS and SSL proxies referencing them.
+func HandleCollectSSLCertificatesTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we enqueue tasks for
+	// collecting SSL certificates from all registered projects.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectSSLCertificates(ctx)
+	}
+
+	var payload CollectSSLCertificatesPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.ProjectID == "" {
+		return asynqutils.SkipRetry(ErrNoProjectID)
+	}
+
+	return collectSSLCertificates(ctx, payload)
+}
+
+// enqueueCollectSSLCertificates enqueues tasks for collecting GCP SSL
+// certificates for all registered GCP projects.
+func enqueueCollectSSLCertificates(ctx context.Context) error {
+	logger := asynqutils.GetLogger(ctx)
+	if gcpclients.SSLCertificatesClientset.Length() == 0 {
+		logger.Warn("no GCP SSL certificates clients found")
+
+		return nil
+	}
+
+	queue := asynqutils.GetQueueName(ctx)
+	err := gcpclients.SSLCertificatesClientset.Range(func(projectID string, _ *gcpclients.Client[*compute.SslCertificatesClient]) error {
+		p := &CollectSSLCertificatesPayload{ProjectID: projectID}
+		data, err := json.Marshal(p)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for GCP SSL certificates",
+				"project", projectID,
+				"reason", err,
+			)
+
+			return registry.ErrContinue
+		}
+
+		task := asynq.NewTask(TaskCollectSSLCertificates, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", projectID,
+			)
+
+			return registry.ErrContinue
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"project", projectID,
+				"reason", err,
+			)
+
+			return registry.ErrContinue
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"project", projectID,
+		)
+
+		return nil
+	})
+
+	return err
+}
+
+// collectSSLCertificates collects the GCP SSL certificates and the target
+// proxies referencing them from the project specified in the payload.
+func collectSSLCertificates(ctx context.Context, payload CollectSSLCertificatesPayload) error {
+	client, ok := gcpclients.SSLCertificatesClientset.Get(payload.ProjectID)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.ProjectID))
+	}
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			sslCertificatesDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.ProjectID,
+		)
+		key := metrics.Key(TaskCollectSSLCertificates, payload.ProjectID)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("collecting GCP SSL certificates", "project", payload.ProjectID)
+
+	pageSize := uint32(constants.PageSize)
+	partialSuccess := true
+	req := &computepb.AggregatedListSslCertificatesRequest{
+		Project:              payload.ProjectID,
+		MaxResults:           &pageSize,
+		ReturnPartialSuccess: &partialSuccess,
+	}
+
+	items := make([]models.SSLCertificate, 0)
+	it := client.Client.AggregatedList(ctx, req)
+	for {
+		// The key of each pair represents a specific GCP Region, or
+		// `global' for the global SSL certificates.
+		pair, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			logger.Error(
+				"failed to get GCP SSL certificates",
+				"project", payload.ProjectID,
+				"reason", err,
+			)
+
+			return err
+		}
+
+		region := gcputils.UnqualifyRegion(pair.Key)
+		for _, cert := range pair.Value.SslCertificates {
+			items = append(items, toSSLCertificateModel(payload.ProjectID, region, cert))
+		}
+	}
+
+	proxies, err := getTargetProxyCertificates(ctx, payload)
+	if err != nil {
+		logger.Error(
+			"failed to get GCP target proxies",
+			"project", payload.ProjectID,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (certificate_id, project_id) DO UPDATE").
+		Set("name = EXCLUDED.name").
+		Set("region = EXCLUDED.region").
+		Set("type = EXCLUDED.type").
+		Set("subject = EXCLUDED.subject").
+		Set("subject_alternative_names = EXCLUDED.subject_alternative_names").
+		Set("not_after = EXCLUDED.not_after").
+		Set("self_link = EXCLUDED.self_link").
+		Set("creation_timestamp = EXCLUDED.creation_timestamp").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert ssl certificates into db",
+			"project", payload.ProjectID,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated gcp ssl certificates",
+		"project", payload.ProjectID,
+		"count", count,
+	)
+
+	if len(proxies) == 0 {
+		return nil
+	}
+
+	out, err = db.DB.NewInsert().
+		Model(&proxies).
+		On("CONFLICT (proxy_self_link, certificate_self_link) DO UPDATE").
+		Set("project_id = EXCLUDED.project_id").
+		Set("proxy_name = EXCLUDED.proxy_name").
+		Set("proxy_type = EXCLUDED.proxy_type").
+		Set("region = EXCLUDED.region").
+		Set("certificate_name = EXCLUDED.certificate_name").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert target proxy certificates into db",
+			"project", payload.ProjectID,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	proxyCount, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated gcp target proxy certificates",
+		"project", payload.ProjectID,
+		"count", proxyCount,
+	)
+
+	return nil
+}
+
+// getTargetProxyCertificates returns the references from the target HTTPS and
+// SSL proxies to their SSL certificates.
+func getTargetProxyCertificates(ctx context.Context, payload CollectSSLCertificatesPayload) ([]models.TargetProxyCertificate, error) {
+	pageSize := uint32(constants.PageSize)
+	partialSuccess := true
+	items := make([]models.TargetProxyCertificate, 0)
+
+	if client, ok := gcpclients.TargetHTTPSProxiesClientset.Get(payload.ProjectID); ok {
+		req := &computepb.AggregatedListTargetHttpsProxiesRequest{
+			Project:              payload.ProjectID,
+			MaxResults:           &pageSize,
+			ReturnPartialSuccess: &partialSuccess,
+		}
+		it := client.Client.AggregatedList(ctx, req)
+		for {
+			pair, err := it.Next()
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			region := gcputils.UnqualifyRegion(pair.Key)
+			for _, proxy := range pair.Value.TargetHttpsProxies {
+				for _, cert := range proxy.GetSslCertificates() {
+					item := models.TargetProxyCertificate{
+						ProxySelfLink:       proxy.GetSelfLink(),
+						CertificateSelfLink: cert,
+						ProjectID:           payload.ProjectID,
+						ProxyName:           proxy.GetName(),
+						ProxyType:           targetProxyTypeHTTPS,
+						Region:              region,
+						CertificateName:     gcputils.ResourceNameFromURL(cert),
+					}
+					items = append(items, item)
+				}
+			}
+		}
+	}
+
+	// Target SSL proxies are global only
+	if client, ok := gcpclients.TargetSSLProxiesClientset.Get(payload.ProjectID); ok {
+		req := &computepb.ListTargetSslProxiesRequest{
+			Project:              payload.ProjectID,
+			MaxResults:           &pageSize,
+			ReturnPartialSuccess: &partialSuccess,
+		}
+		it := client.Client.List(ctx, req)
+		for {
+			proxy, err := it.Next()
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			for _, cert := range proxy.GetSslCertificates() {
+				item := models.TargetProxyCertificate{
+					ProxySelfLink:       proxy.GetSelfLink(),
+					CertificateSelfLink: cert,
+					ProjectID:           payload.ProjectID,
+					ProxyName:           proxy.GetName(),
+					ProxyType:           targetProxyTypeSSL,
+					Region:              regionGlobal,
+					CertificateName:     gcputils.ResourceNameFromURL(cert),
+				}
+				items = append(items, item)
+			}
+		}
+	}
+
+	return items, nil
+}
+
+// toSSLCertificateModel converts the given [computepb.SslCertificate] into a
+// [models.SSLCertificate] model.
+func toSSLCertificateModel(projectID, region string, cert *computepb.SslCertificate) models.SSLCertificate {
+	item := models.SSLCertificate{
+		CertificateID:           cert.GetId(),
+		ProjectID:               projectID,
+		Name:                    cert.GetName(),
+		Region:                  region,
+		Type:                    cert.GetType(),
+		SubjectAlternativeNames: cert.GetSubjectAlternativeNames(),
+		SelfLink:                cert.GetSelfLink(),
+		CreationTimestamp:       cert.GetCreationTimestamp(),
+	}
+
+	if t, err := time.Parse(time.RFC3339, cert.GetExpireTime()); err == nil {
+		item.NotAfter = t
+	}
+
+	// The PEM-encoded certificate is present for self-managed
+	// certificates, and for managed certificates once provisioned.
+	if b, _ := pem.Decode([]byte(cert.GetCertificate())); b != nil {
+		if c, err := x509.ParseCertificate(b.Bytes); err == nil {
+			item.Subject = c.Subject.String()
+			item.NotAfter = c.NotAfter
+		}
+	}
+
+	return item
+}
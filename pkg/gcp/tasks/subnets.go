@@ -43,7 +43,7 @@ func NewCollectSubnetsTask() *asynq.Task {
 type CollectSubnetsPayload struct {
 	// ProjectID specifies the GCP project ID, which is associated with a
 	// registered client.
-	ProjectID string `json:"project_id" yaml:"project_id"`
+	ProjectID string `json:"project_id" yaml:"project_id" desc:"The GCP project ID, which is associated with a registered client" example:"my-project"`
 }
 
 // HandleCollectSubnetsTask is the handler, which collects GCP subnets.
@@ -94,7 +94,17 @@ func enqueueCollectSubnets(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectSubnets, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", projectID,
+			)
+
+			return registry.ErrContinue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -41,7 +41,7 @@ const TaskCollectTargetPools = "gcp:task:collect-target-pools"
 type CollectTargetPoolsPayload struct {
 	// ProjectID specifies the globally unique project id from which to
 	// collect resources.
-	ProjectID string `json:"project_id" yaml:"project_id"`
+	ProjectID string `json:"project_id" yaml:"project_id" desc:"The globally unique project id from which to collect resources" example:"my-project"`
 }
 
 // NewCollectTargetPoolsTask creates a new [asynq.Task] for collecting GCP
@@ -98,7 +98,17 @@ func enqueueCollectTargetPools(ctx context.Context) error {
 			return registry.ErrContinue
 		}
 		task := asynq.NewTask(TaskCollectTargetPools, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", projectID,
+			)
+
+			return registry.ErrContinue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -147,6 +157,11 @@ func collectTargetPools(ctx context.Context, payload CollectTargetPoolsPayload)
 	logger := asynqutils.GetLogger(ctx)
 	logger.Info("collecting GCP target pools", "project", payload.ProjectID)
 
+	inferrer, err := gardenerutils.LoadShootInferrer(ctx)
+	if err != nil {
+		return err
+	}
+
 	pageSize := uint32(constants.PageSize)
 	partialSuccess := true
 	req := &computepb.AggregatedListTargetPoolsRequest{
@@ -197,7 +212,7 @@ func collectTargetPools(ctx context.Context, payload CollectTargetPoolsPayload)
 			for _, tpi := range tp.GetInstances() {
 				instanceName := gcputils.ResourceNameFromURL(tpi)
 				var inferredShoot string
-				shoot, err := gardenerutils.InferShootFromInstanceName(ctx, instanceName)
+				shoot, err := inferrer.Infer(instanceName)
 				if err == nil {
 					inferredShoot = shoot.TechnicalID
 				}
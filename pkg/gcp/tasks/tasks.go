@@ -10,9 +10,11 @@ import (
 	"github.com/hibiken/asynq"
 
 	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/config"
 	"github.com/gardener/inventory/pkg/core/registry"
 	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
 	dbutils "github.com/gardener/inventory/pkg/utils/db"
+	"github.com/gardener/inventory/pkg/utils/schema"
 )
 
 const (
@@ -41,11 +43,45 @@ func HandleCollectAllTask(ctx context.Context, _ *asynq.Task) error {
 		NewCollectGKEClustersTask,
 		NewCollectTargetPoolsTask,
 		NewCollectIAMPoliciesTask,
+		NewCollectCloudSQLInstancesTask,
+		NewCollectServiceAccountsTask,
+		NewCollectReservationsTask,
+		NewCollectCommitmentsTask,
+		NewCollectRegionQuotasTask,
+		NewCollectSSLCertificatesTask,
 	}
 
+	// Collectors of disabled services are skipped
+	conf := asynqutils.GetConfig(ctx)
+	taskFns = asynqutils.SkipDisabledTasks(ctx, taskFns, ServiceCollectors(conf.GCP.Services))
+
 	return asynqutils.Enqueue(ctx, taskFns, asynq.Queue(queue))
 }
 
+// ServiceCollectors returns a mapping between the names of the GCP collector
+// tasks and whether the GCP service the collector depends on is enabled.
+func ServiceCollectors(services config.GCPServices) map[string]bool {
+	return map[string]bool{
+		TaskCollectProjects:          services.ResourceManager.Enabled(),
+		TaskCollectIAMPolicies:       services.ResourceManager.Enabled(),
+		TaskCollectInstances:         services.Compute.Enabled(),
+		TaskCollectVPCs:              services.Compute.Enabled(),
+		TaskCollectSubnets:           services.Compute.Enabled(),
+		TaskCollectAddresses:         services.Compute.Enabled(),
+		TaskCollectDisks:             services.Compute.Enabled(),
+		TaskCollectForwardingRules:   services.Compute.Enabled(),
+		TaskCollectTargetPools:       services.Compute.Enabled(),
+		TaskCollectSSLCertificates:   services.Compute.Enabled(),
+		TaskCollectRegionQuotas:      services.Compute.Enabled(),
+		TaskCollectCommitments:       services.Compute.Enabled(),
+		TaskCollectReservations:      services.Compute.Enabled(),
+		TaskCollectBuckets:           services.Storage.Enabled(),
+		TaskCollectGKEClusters:       services.GKE.Enabled(),
+		TaskCollectCloudSQLInstances: services.CloudSQL.Enabled(),
+		TaskCollectServiceAccounts:   services.IAM.Enabled(),
+	}
+}
+
 // HandleLinkAllTask is a handler, which establishes links between the various
 // GCP models.
 func HandleLinkAllTask(ctx context.Context, _ *asynq.Task) error {
@@ -61,6 +97,11 @@ func HandleLinkAllTask(ctx context.Context, _ *asynq.Task) error {
 		LinkGKEClusterWithProject,
 		LinkTargetPoolWithInstance,
 		LinkTargetPoolWithProject,
+		LinkCloudSQLInstanceWithProject,
+		LinkServiceAccountWithProject,
+		LinkServiceAccountKeyWithServiceAccount,
+		LinkReservationWithProject,
+		LinkCommitmentWithProject,
 	}
 
 	return dbutils.LinkObjects(ctx, db.DB, linkFns)
@@ -82,4 +123,49 @@ func init() {
 	registry.TaskRegistry.MustRegister(TaskCollectGKEClusters, asynq.HandlerFunc(HandleCollectGKEClusters))
 	registry.TaskRegistry.MustRegister(TaskCollectTargetPools, asynq.HandlerFunc(HandleCollectTargetPools))
 	registry.TaskRegistry.MustRegister(TaskCollectIAMPolicies, asynq.HandlerFunc(HandleCollectIAMPoliciesTask))
+	registry.TaskRegistry.MustRegister(TaskCollectCloudSQLInstances, asynq.HandlerFunc(HandleCollectCloudSQLInstancesTask))
+	registry.TaskRegistry.MustRegister(TaskCollectServiceAccounts, asynq.HandlerFunc(HandleCollectServiceAccountsTask))
+	registry.TaskRegistry.MustRegister(TaskCollectReservations, asynq.HandlerFunc(HandleCollectReservationsTask))
+	registry.TaskRegistry.MustRegister(TaskCollectCommitments, asynq.HandlerFunc(HandleCollectCommitmentsTask))
+	registry.TaskRegistry.MustRegister(TaskCollectRegionQuotas, asynq.HandlerFunc(HandleCollectRegionQuotasTask))
+	registry.TaskRegistry.MustRegister(TaskCollectSSLCertificates, asynq.HandlerFunc(HandleCollectSSLCertificatesTask))
+
+	// Payload schemas
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectInstances, schema.For[CollectInstancesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectVPCs, schema.For[CollectVPCsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectAddresses, schema.For[CollectAddressesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectSubnets, schema.For[CollectSubnetsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectBuckets, schema.For[CollectBucketsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectForwardingRules, schema.For[CollectForwardingRulesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectDisks, schema.For[CollectDisksPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectGKEClusters, schema.For[CollectGKEClustersPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectTargetPools, schema.For[CollectTargetPoolsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectIAMPolicies, schema.For[CollectIAMPoliciesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectCloudSQLInstances, schema.For[CollectCloudSQLInstancesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectServiceAccounts, schema.For[CollectServiceAccountsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectReservations, schema.For[CollectReservationsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectCommitments, schema.For[CollectCommitmentsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectRegionQuotas, schema.For[CollectRegionQuotasPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectSSLCertificates, schema.For[CollectSSLCertificatesPayload]())
+
+	// Task descriptions
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectAll, "Enqueues all relevant GCP tasks.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskLinkAll, "Establishes links between GCP models.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectProjects, "Collects GCP Projects.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectInstances, "Collects GCP Instances.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectVPCs, "Collects GCP VPCs.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectAddresses, "Collects global and regional static IP addresses.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectSubnets, "Collects GCP subnets.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectBuckets, "Collects GCP Buckets.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectForwardingRules, "Collects GCP Forwarding Rules.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectDisks, "Collects GCP disks.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectGKEClusters, "Collects GKE clusters.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectTargetPools, "Collects GCP Target Pools.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectIAMPolicies, "Collects GCP IAM Policies.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectCloudSQLInstances, "Collects GCP Cloud SQL instances.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectServiceAccounts, "Collects GCP IAM service accounts and their user-managed keys.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectReservations, "Collects GCP Compute Engine reservations.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectCommitments, "Collects GCP Compute Engine committed use discounts.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectRegionQuotas, "Collects the regional GCP Compute Engine quotas and their usage.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectSSLCertificates, "Collects GCP SSL certificates and the target proxies using them.")
 }
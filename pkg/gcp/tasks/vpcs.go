@@ -42,7 +42,7 @@ func NewCollectVPCsTask() *asynq.Task {
 type CollectVPCsPayload struct {
 	// ProjectID specifies the GCP project ID, which is associated with a
 	// registered client.
-	ProjectID string `json:"project_id" yaml:"project_id"`
+	ProjectID string `json:"project_id" yaml:"project_id" desc:"The GCP project ID, which is associated with a registered client" example:"my-project"`
 }
 
 // HandleCollectVPCsTask is the handler, which collects GCP VPCs.
@@ -96,7 +96,17 @@ func enqueueCollectVPCs(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectVPCs, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", projectID,
+			)
+
+			return registry.ErrContinue
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/config"
 	"github.com/gardener/inventory/pkg/gcp/constants"
 	"github.com/gardener/inventory/pkg/gcp/models"
 )
@@ -77,6 +78,18 @@ func ResourceNameFromURL(s string) string {
 	return parts[len(parts)-1]
 }
 
+// ZoneConcurrency returns the maximum number of zones of a project, whose
+// resources are processed concurrently. It falls back to
+// [config.DefaultGCPZoneConcurrency], if the given config does not specify a
+// positive value, e.g. when the defaults have not been applied to it.
+func ZoneConcurrency(conf *config.Config) int {
+	if conf.GCP.ZoneConcurrency <= 0 {
+		return config.DefaultGCPZoneConcurrency
+	}
+
+	return conf.GCP.ZoneConcurrency
+}
+
 // GetGKEClusterFromDB returns the [models.GKECluster] with the given name by
 // looking up the database.
 func GetGKEClusterFromDB(ctx context.Context, name string) (models.GKECluster, error) {
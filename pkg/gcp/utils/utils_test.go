@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gardener/inventory/pkg/core/config"
 	"github.com/gardener/inventory/pkg/gcp/constants"
 	"github.com/gardener/inventory/pkg/gcp/utils"
 )
@@ -194,3 +195,36 @@ func TestResourceNameFromURL(t *testing.T) {
 		})
 	}
 }
+
+func TestZoneConcurrency(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		conf   *config.Config
+		wanted int
+	}{
+		{
+			desc:   "zero-value config",
+			conf:   &config.Config{},
+			wanted: config.DefaultGCPZoneConcurrency,
+		},
+		{
+			desc:   "negative concurrency",
+			conf:   &config.Config{GCP: config.GCPConfig{ZoneConcurrency: -1}},
+			wanted: config.DefaultGCPZoneConcurrency,
+		},
+		{
+			desc:   "configured concurrency",
+			conf:   &config.Config{GCP: config.GCPConfig{ZoneConcurrency: 8}},
+			wanted: 8,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			output := utils.ZoneConcurrency(tc.conf)
+			if output != tc.wanted {
+				t.Fatalf("wanted %d got %d", tc.wanted, output)
+			}
+		})
+	}
+}
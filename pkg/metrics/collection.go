@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+)
+
+// collectionStatsKey is the key used to store [CollectionStats] in a
+// [context.Context].
+type collectionStatsKey struct{}
+
+// CollectionStats tracks the number of provider API calls and pages fetched
+// during the execution of a single task.
+type CollectionStats struct {
+	apiCalls atomic.Int64
+	pages    atomic.Int64
+}
+
+// APICalls returns the number of provider API calls.
+func (s *CollectionStats) APICalls() int64 {
+	return s.apiCalls.Load()
+}
+
+// Pages returns the number of fetched pages.
+func (s *CollectionStats) Pages() int64 {
+	return s.pages.Load()
+}
+
+// WithCollectionStats returns a copy of the given context, which embeds a new
+// [CollectionStats].
+func WithCollectionStats(ctx context.Context) (context.Context, *CollectionStats) {
+	stats := &CollectionStats{}
+
+	return context.WithValue(ctx, collectionStatsKey{}, stats), stats
+}
+
+// GetCollectionStats returns the [CollectionStats] embedded in the given
+// context, or nil if the context does not have any.
+func GetCollectionStats(ctx context.Context) *CollectionStats {
+	stats, ok := ctx.Value(collectionStatsKey{}).(*CollectionStats)
+	if !ok {
+		return nil
+	}
+
+	return stats
+}
+
+// IncAPICalls increments the number of provider API calls tracked by the
+// [CollectionStats] embedded in the given context, if any.
+func IncAPICalls(ctx context.Context) {
+	if stats := GetCollectionStats(ctx); stats != nil {
+		stats.apiCalls.Add(1)
+	}
+}
+
+// IncPages increments the number of fetched pages tracked by the
+// [CollectionStats] embedded in the given context, if any. Tasks should call
+// it for each page returned by the paginators of the provider API clients.
+func IncPages(ctx context.Context) {
+	if stats := GetCollectionStats(ctx); stats != nil {
+		stats.pages.Add(1)
+	}
+}
+
+// APICallTransport is an [http.RoundTripper], which counts the requests sent
+// to the provider APIs in the [CollectionStats] embedded in the context of the
+// requests.
+type APICallTransport struct {
+	next http.RoundTripper
+}
+
+var _ http.RoundTripper = &APICallTransport{}
+
+// NewAPICallTransport returns a new [APICallTransport], which sends the
+// requests using the next [http.RoundTripper], or [http.DefaultTransport], if
+// nil.
+func NewAPICallTransport(next http.RoundTripper) *APICallTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &APICallTransport{next: next}
+}
+
+// RoundTrip implements the [http.RoundTripper] interface.
+func (t *APICallTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	IncAPICalls(req.Context())
+
+	return t.next.RoundTrip(req)
+}
@@ -55,6 +55,40 @@ var (
 		[]string{"task_name", "task_queue"},
 	)
 
+	// TaskErrorsTotal is a metric, which gets incremented each time a task
+	// has failed, partitioned by the class of the error, e.g. throttled.
+	TaskErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "task_errors_total",
+			Help:      "Total number of task errors by error class",
+		},
+		[]string{"task_name", "task_queue", "error_class"},
+	)
+
+	// TaskCircuitOpenTotal is a metric, which gets incremented each time a
+	// task has been skipped, because the circuit breaker of its account and
+	// region is open.
+	TaskCircuitOpenTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "task_circuit_open_total",
+			Help:      "Total number of times a task has been skipped by an open circuit breaker",
+		},
+		[]string{"task_name", "task_queue"},
+	)
+
+	// CircuitBreakerOpen is a metric, which reports whether the circuit
+	// breaker of an account and region is open.
+	CircuitBreakerOpen = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "circuit_breaker_open",
+			Help:      "Whether the circuit breaker of an account and region is open",
+		},
+		[]string{"provider", "account", "region"},
+	)
+
 	// TaskDurationSeconds is a metric, which tracks the duration of task
 	// execution in seconds.
 	TaskDurationSeconds = prometheus.NewHistogramVec(
@@ -66,16 +100,69 @@ var (
 		},
 		[]string{"task_name", "task_queue"},
 	)
+
+	// CollectionDurationSeconds is a metric, which tracks the duration of
+	// successful task executions in seconds per task and account.
+	CollectionDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "collection_duration_seconds",
+			Help:      "Duration of successful task executions in seconds per task and account",
+			Buckets:   []float64{1.0, 10.0, 30.0, 60.0, 120.0, 300.0, 600.0},
+		},
+		[]string{"task", "account"},
+	)
+
+	// CollectionAPICalls is a metric, which tracks the number of provider
+	// API calls of successful task executions per task and account.
+	CollectionAPICalls = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "collection_api_calls",
+			Help:      "Number of provider API calls of successful task executions per task and account",
+			Buckets:   []float64{1.0, 5.0, 10.0, 50.0, 100.0, 500.0, 1000.0},
+		},
+		[]string{"task", "account"},
+	)
+
+	// CollectionPages is a metric, which tracks the number of pages
+	// fetched by successful task executions per task and account.
+	CollectionPages = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "collection_pages",
+			Help:      "Number of pages fetched by successful task executions per task and account",
+			Buckets:   []float64{1.0, 2.0, 5.0, 10.0, 50.0, 100.0, 500.0},
+		},
+		[]string{"task", "account"},
+	)
+
+	// ClientConfigurationFailed is a metric, which reports whether
+	// configuring the API clients of a provider has failed.
+	ClientConfigurationFailed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "client_configuration_failed",
+			Help:      "Whether configuring the API clients of a provider has failed",
+		},
+		[]string{"provider"},
+	)
 )
 
 // NewServer returns a new [http.Server] which can serve the metrics from
-// [DefaultRegistry] on the specified network address and HTTP path. Callers
-// are responsible for starting up and shutting down the HTTP server.
-func NewServer(ctx context.Context, addr, path string) *http.Server {
+// [DefaultRegistry] on the specified network address and HTTP path. Any
+// additional collectors are served along with the metrics from the
+// [DefaultRegistry]. Callers are responsible for starting up and shutting down
+// the HTTP server.
+func NewServer(ctx context.Context, addr, path string, extra ...prometheus.Collector) *http.Server {
+	extraRegistry := prometheus.NewPedanticRegistry()
+	extraRegistry.MustRegister(extra...)
+	gatherers := prometheus.Gatherers{DefaultRegistry, extraRegistry}
+
 	mux := http.NewServeMux()
 	mux.Handle(
 		path,
-		promhttp.HandlerFor(DefaultRegistry, promhttp.HandlerOpts{}),
+		promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{}),
 	)
 
 	server := &http.Server{
@@ -95,7 +182,14 @@ func init() {
 		TaskSuccessfulTotal,
 		TaskFailedTotal,
 		TaskSkippedTotal,
+		TaskErrorsTotal,
+		TaskCircuitOpenTotal,
+		CircuitBreakerOpen,
 		TaskDurationSeconds,
+		CollectionDurationSeconds,
+		CollectionAPICalls,
+		CollectionPages,
+		ClientConfigurationFailed,
 		DefaultCollector,
 
 		// Standard Go metrics
@@ -19,6 +19,7 @@ import (
 // used for registering models with [registry.ModelRegistry]
 const (
 	ServerModelName               = "openstack:model:server"
+	ServerMetadataModelName       = "openstack:model:server_metadata"
 	NetworkModelName              = "openstack:model:network"
 	LoadBalancerModelName         = "openstack:model:loadbalancer"
 	LoadBalancerWithPoolModelName = "openstack:model:loadbalancer_with_pool"
@@ -35,6 +36,12 @@ const (
 	ObjectModelName               = "openstack:model:object"
 	VolumeModelName               = "openstack:model:volume"
 	VolumeAttachmentModelName     = "openstack:model:volume_attachment"
+	FlavorModelName               = "openstack:model:flavor"
+	QuotaModelName                = "openstack:model:quota"
+	AvailabilityZoneModelName     = "openstack:model:availability_zone"
+	HostAggregateModelName        = "openstack:model:host_aggregate"
+	ServerGroupModelName          = "openstack:model:server_group"
+	ServerGroupMemberModelName    = "openstack:model:server_group_member"
 
 	SubnetToNetworkModelName       = "openstack:model:link_subnet_to_network"
 	SubnetToProjectModelName       = "openstack:model:link_subnet_to_project"
@@ -45,12 +52,15 @@ const (
 	LoadBalancerToProjectModelName = "openstack:model:link_loadbalancer_to_project"
 	NetworkToProjectModelName      = "openstack:model:link_network_to_project"
 	PortToServerModelName          = "openstack:model:link_server_to_port"
+	ServerToAZModelName            = "openstack:model:link_server_to_az"
+	ServerToServerGroupModelName   = "openstack:model:link_server_to_server_group"
 )
 
 // models specifies the mapping between name and model type, which will be
 // registered with [registry.ModelRegistry].
 var models = map[string]any{
 	ServerModelName:               &Server{},
+	ServerMetadataModelName:       &ServerMetadata{},
 	NetworkModelName:              &Network{},
 	LoadBalancerModelName:         &LoadBalancer{},
 	LoadBalancerWithPoolModelName: &LoadBalancerWithPool{},
@@ -67,6 +77,12 @@ var models = map[string]any{
 	ObjectModelName:               &Object{},
 	VolumeModelName:               &Volume{},
 	VolumeAttachmentModelName:     &VolumeAttachment{},
+	FlavorModelName:               &Flavor{},
+	QuotaModelName:                &Quota{},
+	AvailabilityZoneModelName:     &AvailabilityZone{},
+	HostAggregateModelName:        &HostAggregate{},
+	ServerGroupModelName:          &ServerGroup{},
+	ServerGroupMemberModelName:    &ServerGroupMember{},
 
 	// Link models
 	SubnetToNetworkModelName:       &SubnetToNetwork{},
@@ -78,6 +94,8 @@ var models = map[string]any{
 	LoadBalancerToProjectModelName: &LoadBalancerToProject{},
 	NetworkToProjectModelName:      &NetworkToProject{},
 	PortToServerModelName:          &PortToServer{},
+	ServerToAZModelName:            &ServerToAZ{},
+	ServerToServerGroupModelName:   &ServerToServerGroup{},
 }
 
 // Server represents an OpenStack Server.
@@ -85,18 +103,32 @@ type Server struct {
 	bun.BaseModel `bun:"table:openstack_server"`
 	coremodels.Model
 
-	ServerID         string    `bun:"server_id,notnull,unique:openstack_server_key"`
-	Name             string    `bun:"name,notnull"`
-	ProjectID        string    `bun:"project_id,notnull,unique:openstack_server_key"`
-	Domain           string    `bun:"domain,notnull"`
-	Region           string    `bun:"region,notnull"`
-	UserID           string    `bun:"user_id,notnull"`
-	AvailabilityZone string    `bun:"availability_zone,notnull"`
-	Status           string    `bun:"status,notnull"`
-	ImageID          string    `bun:"image_id,notnull"`
-	TimeCreated      time.Time `bun:"server_created_at,notnull"`
-	TimeUpdated      time.Time `bun:"server_updated_at,notnull"`
-	Project          *Project  `bun:"rel:has-one,join:project_id=project_id"`
+	ServerID         string            `bun:"server_id,notnull,unique:openstack_server_key"`
+	Name             string            `bun:"name,notnull"`
+	ProjectID        string            `bun:"project_id,notnull,unique:openstack_server_key"`
+	Domain           string            `bun:"domain,notnull"`
+	Region           string            `bun:"region,notnull"`
+	UserID           string            `bun:"user_id,notnull"`
+	AvailabilityZone string            `bun:"availability_zone,notnull"`
+	Status           string            `bun:"status,notnull"`
+	ImageID          string            `bun:"image_id,notnull"`
+	KeyName          string            `bun:"key_name,notnull"`
+	TimeCreated      time.Time         `bun:"server_created_at,notnull"`
+	TimeUpdated      time.Time         `bun:"server_updated_at,notnull"`
+	Project          *Project          `bun:"rel:has-one,join:project_id=project_id"`
+	Zone             *AvailabilityZone `bun:"rel:has-one,join:availability_zone=name,join:project_id=project_id,join:domain=domain,join:region=region"`
+}
+
+// ServerMetadata represents a metadata item of an OpenStack Server.
+type ServerMetadata struct {
+	bun.BaseModel `bun:"table:openstack_server_metadata"`
+	coremodels.Model
+
+	ServerID  string  `bun:"server_id,notnull,unique:openstack_server_metadata_key"`
+	ProjectID string  `bun:"project_id,notnull,unique:openstack_server_metadata_key"`
+	Key       string  `bun:"key,notnull,unique:openstack_server_metadata_key"`
+	Value     string  `bun:"value,notnull"`
+	Server    *Server `bun:"rel:has-one,join:server_id=server_id,join:project_id=project_id"`
 }
 
 // Network represents an OpenStack Network.
@@ -245,6 +277,26 @@ type PortToServer struct {
 	ServerID uuid.UUID `bun:"server_id,notnull"`
 }
 
+// ServerToAZ represents a link table connecting Servers with Availability
+// Zones.
+type ServerToAZ struct {
+	bun.BaseModel `bun:"table:l_openstack_server_to_az"`
+	coremodels.Model
+
+	ServerID uuid.UUID `bun:"server_id,notnull"`
+	AZID     uuid.UUID `bun:"az_id,notnull"`
+}
+
+// ServerToServerGroup represents a link table connecting Servers with the
+// Server Groups they are members of.
+type ServerToServerGroup struct {
+	bun.BaseModel `bun:"table:l_openstack_server_to_server_group"`
+	coremodels.Model
+
+	ServerID      uuid.UUID `bun:"server_id,notnull"`
+	ServerGroupID uuid.UUID `bun:"server_group_id,notnull"`
+}
+
 // ServerToNetwork represents a link table connecting Servers with Networks.
 type ServerToNetwork struct {
 	bun.BaseModel `bun:"table:l_openstack_server_to_network"`
@@ -347,10 +399,15 @@ type Container struct {
 	bun.BaseModel `bun:"table:openstack_container"`
 	coremodels.Model
 
-	Name        string `bun:"name,notnull,unique:openstack_container_key"`
-	ProjectID   string `bun:"project_id,notnull,unique:openstack_container_key"`
-	Bytes       int64  `bun:"bytes,notnull"`
-	ObjectCount int64  `bun:"object_count,notnull"`
+	Name          string   `bun:"name,notnull,unique:openstack_container_key"`
+	ProjectID     string   `bun:"project_id,notnull,unique:openstack_container_key"`
+	Bytes         int64    `bun:"bytes,notnull"`
+	ObjectCount   int64    `bun:"object_count,notnull"`
+	ReadACL       []string `bun:"read_acl,array,nullzero"`
+	WriteACL      []string `bun:"write_acl,array,nullzero"`
+	PublicRead    bool     `bun:"public_read,notnull"`
+	PublicListing bool     `bun:"public_listing,notnull"`
+	HasTempURLKey bool     `bun:"has_temp_url_key,notnull"`
 }
 
 // Object represents an OpenStack Object.
@@ -449,10 +506,234 @@ type VolumeAttachment struct {
 	ServerID     string    `bun:"server_id,notnull"`
 }
 
+// Flavor represents an OpenStack Compute Flavor.
+type Flavor struct {
+	bun.BaseModel `bun:"table:openstack_flavor"`
+	coremodels.Model
+
+	FlavorID    string  `bun:"flavor_id,notnull,unique:openstack_flavor_key"`
+	ProjectID   string  `bun:"project_id,notnull,unique:openstack_flavor_key"`
+	Domain      string  `bun:"domain,notnull,unique:openstack_flavor_key"`
+	Region      string  `bun:"region,notnull,unique:openstack_flavor_key"`
+	Name        string  `bun:"name,notnull"`
+	Description string  `bun:"description,notnull"`
+	VCPUs       int     `bun:"vcpus,notnull"`
+	RAM         int     `bun:"ram,notnull"`
+	Disk        int     `bun:"disk,notnull"`
+	Ephemeral   int     `bun:"ephemeral,notnull"`
+	Swap        int     `bun:"swap,notnull"`
+	RxTxFactor  float64 `bun:"rxtx_factor,notnull"`
+	IsPublic    bool    `bun:"is_public,notnull"`
+}
+
+// Quota represents the quota and usage of a single resource within an
+// OpenStack Project.
+type Quota struct {
+	bun.BaseModel `bun:"table:openstack_quota"`
+	coremodels.Model
+
+	ProjectID string `bun:"project_id,notnull,unique:openstack_quota_key"`
+	Domain    string `bun:"domain,notnull,unique:openstack_quota_key"`
+	Region    string `bun:"region,notnull,unique:openstack_quota_key"`
+	Service   string `bun:"service,notnull,unique:openstack_quota_key"`
+	Resource  string `bun:"resource,notnull,unique:openstack_quota_key"`
+
+	// Limit specifies the max number of resources, which can be allocated.
+	// A value of -1 means unlimited.
+	Limit    int `bun:"quota_limit,notnull"`
+	InUse    int `bun:"in_use,notnull"`
+	Reserved int `bun:"reserved,notnull"`
+}
+
+// AvailabilityZone represents an OpenStack Compute Availability Zone.
+type AvailabilityZone struct {
+	bun.BaseModel `bun:"table:openstack_availability_zone"`
+	coremodels.Model
+
+	Name      string `bun:"name,notnull,unique:openstack_availability_zone_key"`
+	ProjectID string `bun:"project_id,notnull,unique:openstack_availability_zone_key"`
+	Domain    string `bun:"domain,notnull,unique:openstack_availability_zone_key"`
+	Region    string `bun:"region,notnull,unique:openstack_availability_zone_key"`
+	Available bool   `bun:"available,notnull"`
+}
+
+// HostAggregate represents an OpenStack Compute Host Aggregate. Listing host
+// aggregates requires admin privileges by default.
+type HostAggregate struct {
+	bun.BaseModel `bun:"table:openstack_host_aggregate"`
+	coremodels.Model
+
+	AggregateID      int      `bun:"aggregate_id,notnull,unique:openstack_host_aggregate_key"`
+	ProjectID        string   `bun:"project_id,notnull,unique:openstack_host_aggregate_key"`
+	Domain           string   `bun:"domain,notnull,unique:openstack_host_aggregate_key"`
+	Region           string   `bun:"region,notnull,unique:openstack_host_aggregate_key"`
+	UUID             string   `bun:"aggregate_uuid,notnull"`
+	Name             string   `bun:"name,notnull"`
+	AvailabilityZone string   `bun:"availability_zone,notnull"`
+	Hosts            []string `bun:"hosts,array,nullzero"`
+}
+
+// ServerGroup represents an OpenStack Compute Server Group, which specifies
+// the placement policy of its member servers.
+type ServerGroup struct {
+	bun.BaseModel `bun:"table:openstack_server_group"`
+	coremodels.Model
+
+	ServerGroupID string `bun:"server_group_id,notnull,unique:openstack_server_group_key"`
+	ProjectID     string `bun:"project_id,notnull,unique:openstack_server_group_key"`
+	Domain        string `bun:"domain,notnull"`
+	Region        string `bun:"region,notnull"`
+	Name          string `bun:"name,notnull"`
+	UserID        string `bun:"user_id,notnull"`
+
+	// Policy specifies the placement policy of the group, e.g.
+	// anti-affinity or soft-anti-affinity.
+	Policy string `bun:"policy,notnull"`
+
+	// MaxServerPerHost specifies how many member servers may reside on a
+	// single compute host. Only set for anti-affinity groups.
+	MaxServerPerHost int `bun:"max_server_per_host,notnull"`
+}
+
+// ServerGroupMember represents the membership of an OpenStack Server in a
+// Server Group.
+type ServerGroupMember struct {
+	bun.BaseModel `bun:"table:openstack_server_group_member"`
+	coremodels.Model
+
+	ServerGroupID string       `bun:"server_group_id,notnull,unique:openstack_server_group_member_key"`
+	ServerID      string       `bun:"server_id,notnull,unique:openstack_server_group_member_key"`
+	ProjectID     string       `bun:"project_id,notnull,unique:openstack_server_group_member_key"`
+	ServerGroup   *ServerGroup `bun:"rel:has-one,join:server_group_id=server_group_id,join:project_id=project_id"`
+	Server        *Server      `bun:"rel:has-one,join:server_id=server_id,join:project_id=project_id"`
+}
+
+// accountLinks maps the models, which reference an OpenStack project, to the
+// column holding the reference.
+var accountLinks = map[string]string{
+	ServerModelName:               "project_id",
+	ServerMetadataModelName:       "project_id",
+	NetworkModelName:              "project_id",
+	LoadBalancerModelName:         "project_id",
+	SubnetModelName:               "project_id",
+	FloatingIPModelName:           "project_id",
+	ProjectModelName:              "project_id",
+	PortModelName:                 "project_id",
+	PortIPModelName:               "project_id",
+	RouterModelName:               "project_id",
+	RouterExternalIPModelName:     "project_id",
+	ContainerModelName:            "project_id",
+	ObjectModelName:               "project_id",
+	PoolModelName:                 "project_id",
+	PoolMemberModelName:           "project_id",
+	LoadBalancerWithPoolModelName: "project_id",
+	VolumeModelName:               "project_id",
+	FlavorModelName:               "project_id",
+	QuotaModelName:                "project_id",
+	AvailabilityZoneModelName:     "project_id",
+	HostAggregateModelName:        "project_id",
+	ServerGroupModelName:          "project_id",
+	ServerGroupMemberModelName:    "project_id",
+}
+
+// shootResources specifies the resolvers, which map the models to the
+// Gardener Shoots owning them.
+var shootResources = map[string]registry.ShootResource{
+	"openstack:server:machine-provider-id": {
+		ModelName:  ServerModelName,
+		Kind:       registry.ShootResourceKindInstance,
+		Method:     registry.ShootResourceMethodProviderID,
+		Confidence: registry.ShootResourceConfidenceHigh,
+		Query: `SELECT s.id AS shoot_id, srv.id AS resource_id FROM g_machine AS m
+INNER JOIN g_shoot AS s ON s.technical_id = m.namespace AND s.landscape = m.landscape
+INNER JOIN openstack_server AS srv ON srv.server_id = substring(m.provider_id from '[^/]+$')
+WHERE m.provider_id LIKE 'openstack://%'`,
+	},
+	"openstack:loadbalancer:name-technical-id": {
+		ModelName:  LoadBalancerModelName,
+		Kind:       registry.ShootResourceKindLoadBalancer,
+		Method:     registry.ShootResourceMethodTechnicalID,
+		Confidence: registry.ShootResourceConfidenceMedium,
+		Query: `SELECT s.id AS shoot_id, lb.id AS resource_id FROM openstack_loadbalancer AS lb
+INNER JOIN aux_account_landscape AS al ON al.provider = 'openstack' AND al.account_id = lb.project_id
+INNER JOIN g_shoot AS s ON lb.name LIKE 'kube_service_' || s.technical_id || '_%' AND s.landscape = al.landscape`,
+	},
+	// Load balancers created by older versions of the cloud controller
+	// manager are not named after the technical ID of the Shoot. The Shoot
+	// is inferred from the names of the pool members during collection,
+	// which is the only reference available, hence the low confidence.
+	"openstack:loadbalancer:instance-name": {
+		ModelName:  LoadBalancerModelName,
+		Kind:       registry.ShootResourceKindLoadBalancer,
+		Method:     registry.ShootResourceMethodInstanceName,
+		Confidence: registry.ShootResourceConfidenceLow,
+		Query: `SELECT s.id AS shoot_id, lb.id AS resource_id FROM openstack_loadbalancer AS lb
+INNER JOIN openstack_loadbalancer_with_pool AS lbp ON lbp.loadbalancer_id = lb.loadbalancer_id AND lbp.project_id = lb.project_id
+INNER JOIN openstack_pool_member AS pm ON pm.pool_id = lbp.pool_id AND pm.project_id = lbp.project_id
+INNER JOIN aux_account_landscape AS al ON al.provider = 'openstack' AND al.account_id = lb.project_id
+INNER JOIN g_shoot AS s ON s.technical_id = pm.inferred_gardener_shoot AND s.landscape = al.landscape`,
+	},
+	"openstack:volume:pv-disk-ref": {
+		ModelName:  VolumeModelName,
+		Kind:       registry.ShootResourceKindDisk,
+		Method:     registry.ShootResourceMethodDiskRef,
+		Confidence: registry.ShootResourceConfidenceHigh,
+		Query: `SELECT s.id AS shoot_id, v.id AS resource_id FROM g_persistent_volume AS pv
+INNER JOIN g_shoot AS s ON s.technical_id = pv.claim_namespace AND s.landscape = pv.landscape
+INNER JOIN openstack_volume AS v ON v.volume_id = pv.disk_ref
+WHERE pv.provider IN ('in-tree:cinder', 'csi:cinder.csi.openstack.org')`,
+	},
+	"openstack:network:name-technical-id": {
+		ModelName:  NetworkModelName,
+		Kind:       registry.ShootResourceKindNetwork,
+		Method:     registry.ShootResourceMethodTechnicalID,
+		Confidence: registry.ShootResourceConfidenceMedium,
+		Query: `SELECT s.id AS shoot_id, n.id AS resource_id FROM openstack_network AS n
+INNER JOIN aux_account_landscape AS al ON al.provider = 'openstack' AND al.account_id = n.project_id
+INNER JOIN g_shoot AS s ON s.technical_id = n.name AND s.landscape = al.landscape`,
+	},
+}
+
+// driftChecks specifies the checks, which compare the desired worker
+// configuration of Gardener Machines against the actual OpenStack resources.
+var driftChecks = map[string]registry.DriftCheck{
+	"openstack:server:image": {
+		ModelName: ServerModelName,
+		Attribute: registry.DriftAttributeImage,
+		Query: `SELECT s.id AS shoot_id, srv.id AS resource_id, mc.provider_spec->>'imageID' AS desired, srv.image_id AS actual
+FROM g_machine AS m
+INNER JOIN g_shoot AS s ON s.technical_id = m.namespace AND s.landscape = m.landscape
+INNER JOIN g_machine_class AS mc ON mc.name = m.machine_class AND mc.namespace = m.namespace AND mc.landscape = m.landscape
+INNER JOIN openstack_server AS srv ON srv.server_id = substring(m.provider_id from '[^/]+$')
+WHERE m.provider_id LIKE 'openstack://%' AND srv.image_id <> mc.provider_spec->>'imageID'`,
+	},
+}
+
 func init() {
 	// Register the models with the default registry
 
 	for k, v := range models {
 		registry.ModelRegistry.MustRegister(k, v)
 	}
+
+	registry.AccountSourceRegistry.MustRegister("openstack", registry.AccountSource{
+		ModelName:  ProjectModelName,
+		IDColumn:   "project_id",
+		NameColumn: "name",
+	})
+
+	for k, v := range accountLinks {
+		registry.AccountLinkRegistry.MustRegister(k, registry.AccountLink{
+			Provider: "openstack",
+			Column:   v,
+		})
+	}
+
+	for k, v := range shootResources {
+		registry.ShootResourceRegistry.MustRegister(k, v)
+	}
+
+	for k, v := range driftChecks {
+		registry.DriftCheckRegistry.MustRegister(k, v)
+	}
 }
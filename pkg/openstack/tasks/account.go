@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/hibiken/asynq"
+
+	openstackclients "github.com/gardener/inventory/pkg/clients/openstack"
+	"github.com/gardener/inventory/pkg/core/registry"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// newAccountCollectTasks returns the tasks for collecting the OpenStack
+// resources of the given project from each region and domain, for which
+// clients are configured.
+func newAccountCollectTasks(_ context.Context, projectID string) ([]*asynq.Task, error) {
+	clientsets := []struct {
+		clientset *registry.Registry[openstackclients.ClientScope, openstackclients.Client[*gophercloud.ServiceClient]]
+		payloadFn func(scope openstackclients.ClientScope) map[string]any
+	}{
+		{
+			clientset: openstackclients.ComputeClientset,
+			payloadFn: func(scope openstackclients.ClientScope) map[string]any {
+				return map[string]any{
+					TaskCollectServers:           CollectServersPayload{Scope: scope},
+					TaskCollectFlavors:           CollectFlavorsPayload{Scope: scope},
+					TaskCollectAvailabilityZones: CollectAvailabilityZonesPayload{Scope: scope},
+					TaskCollectHostAggregates:    CollectHostAggregatesPayload{Scope: scope},
+					TaskCollectServerGroups:      CollectServerGroupsPayload{Scope: scope},
+				}
+			},
+		},
+		{
+			clientset: openstackclients.NetworkClientset,
+			payloadFn: func(scope openstackclients.ClientScope) map[string]any {
+				return map[string]any{
+					TaskCollectNetworks:    CollectNetworksPayload{Scope: scope},
+					TaskCollectSubnets:     CollectSubnetsPayload{Scope: scope},
+					TaskCollectPorts:       CollectPortsPayload{Scope: scope},
+					TaskCollectFloatingIPs: CollectFloatingIPsPayload{Scope: scope},
+					TaskCollectRouters:     CollectRoutersPayload{Scope: scope},
+				}
+			},
+		},
+		{
+			clientset: openstackclients.LoadBalancerClientset,
+			payloadFn: func(scope openstackclients.ClientScope) map[string]any {
+				return map[string]any{
+					TaskCollectLoadBalancers: CollectLoadBalancersPayload{Scope: scope},
+					TaskCollectPools:         CollectPoolsPayload{Scope: scope},
+				}
+			},
+		},
+		{
+			clientset: openstackclients.BlockStorageClientset,
+			payloadFn: func(scope openstackclients.ClientScope) map[string]any {
+				return map[string]any{
+					TaskCollectVolumes: CollectVolumesPayload{Scope: scope},
+				}
+			},
+		},
+		{
+			clientset: openstackclients.ObjectStorageClientset,
+			payloadFn: func(scope openstackclients.ClientScope) map[string]any {
+				return map[string]any{
+					TaskCollectContainers: CollectContainersPayload{Scope: scope},
+					TaskCollectObjects:    CollectObjectsPayload{Scope: scope},
+				}
+			},
+		},
+		{
+			clientset: openstackclients.IdentityClientset,
+			payloadFn: func(scope openstackclients.ClientScope) map[string]any {
+				return map[string]any{
+					TaskCollectProjects: CollectProjectsPayload{Scope: scope},
+				}
+			},
+		},
+	}
+
+	items := make([]*asynq.Task, 0)
+	addTask := func(name string, payload any) error {
+		task, err := asynqutils.NewTask(name, payload)
+		if err != nil {
+			return err
+		}
+		items = append(items, task)
+
+		return nil
+	}
+
+	for _, item := range clientsets {
+		err := item.clientset.Range(func(scope openstackclients.ClientScope, _ openstackclients.Client[*gophercloud.ServiceClient]) error {
+			if scope.ProjectID != projectID {
+				return nil
+			}
+
+			for name, payload := range item.payloadFn(scope) {
+				if err := addTask(name, payload); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for service, clientset := range quotaClientsets {
+		err := clientset.Range(func(scope openstackclients.ClientScope, _ openstackclients.Client[*gophercloud.ServiceClient]) error {
+			if scope.ProjectID != projectID {
+				return nil
+			}
+
+			return addTask(TaskCollectQuotas, CollectQuotasPayload{Scope: scope, Service: service})
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
+
+func init() {
+	registry.AccountCollectorRegistry.MustRegister("openstack", registry.AccountCollector{
+		NewTasks:  newAccountCollectTasks,
+		LinkTasks: []string{TaskLinkAll},
+	})
+}
@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/availabilityzones"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	"github.com/gardener/inventory/pkg/clients/db"
+	openstackclients "github.com/gardener/inventory/pkg/clients/openstack"
+	"github.com/gardener/inventory/pkg/metrics"
+	"github.com/gardener/inventory/pkg/openstack/models"
+	openstackutils "github.com/gardener/inventory/pkg/openstack/utils"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+const (
+	// TaskCollectAvailabilityZones is the name of the task for collecting
+	// OpenStack availability zones.
+	TaskCollectAvailabilityZones = "openstack:task:collect-availability-zones"
+)
+
+// CollectAvailabilityZonesPayload represents the payload, which specifies
+// where to collect OpenStack availability zones from.
+type CollectAvailabilityZonesPayload struct {
+	// Scope specifies the client scope for which to collect.
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The client scope for which to collect"`
+}
+
+// NewCollectAvailabilityZonesTask creates a new [asynq.Task] for collecting
+// OpenStack availability zones, without specifying a payload.
+func NewCollectAvailabilityZonesTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectAvailabilityZones, nil)
+}
+
+// HandleCollectAvailabilityZonesTask handles the task for collecting OpenStack
+// availability zones.
+func HandleCollectAvailabilityZonesTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we enqueue tasks for
+	// collecting OpenStack availability zones from all configured compute
+	// clients.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectAvailabilityZones(ctx)
+	}
+
+	var payload CollectAvailabilityZonesPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if err := openstackutils.IsValidProjectScope(payload.Scope); err != nil {
+		return asynqutils.SkipRetry(ErrInvalidScope)
+	}
+
+	return collectAvailabilityZones(ctx, payload)
+}
+
+// enqueueCollectAvailabilityZones enqueues tasks for collecting OpenStack
+// availability zones from all configured OpenStack compute clients by creating a
+// payload with the respective client scope.
+func enqueueCollectAvailabilityZones(ctx context.Context) error {
+	logger := asynqutils.GetLogger(ctx)
+
+	if openstackclients.ComputeClientset.Length() == 0 {
+		logger.Warn("no OpenStack compute clients found")
+
+		return nil
+	}
+
+	queue := asynqutils.GetQueueName(ctx)
+
+	return openstackclients.ComputeClientset.Range(func(scope openstackclients.ClientScope, _ openstackclients.Client[*gophercloud.ServiceClient]) error {
+		payload := CollectAvailabilityZonesPayload{
+			Scope: scope,
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for OpenStack availability zones",
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+				"reason", err,
+			)
+
+			return err
+		}
+
+		task := asynq.NewTask(TaskCollectAvailabilityZones, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+			)
+
+			return nil
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+				"reason", err,
+			)
+
+			return err
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"project", scope.Project,
+			"domain", scope.Domain,
+			"region", scope.Region,
+		)
+
+		return nil
+	})
+}
+
+// collectAvailabilityZones collects the OpenStack Compute availability zones,
+// which are visible from the project of the client scope in the given
+// payload.
+func collectAvailabilityZones(ctx context.Context, payload CollectAvailabilityZonesPayload) error {
+	logger := asynqutils.GetLogger(ctx)
+
+	client, ok := openstackclients.ComputeClientset.Get(payload.Scope)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.Scope.Project))
+	}
+
+	logger.Info(
+		"collecting OpenStack availability zones",
+		"project", payload.Scope.Project,
+		"domain", payload.Scope.Domain,
+		"region", payload.Scope.Region,
+	)
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			availabilityZonesDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.Scope.Project,
+			payload.Scope.Domain,
+			payload.Scope.Region,
+		)
+		key := metrics.Key(
+			TaskCollectAvailabilityZones,
+			payload.Scope.Project,
+			payload.Scope.Domain,
+			payload.Scope.Region,
+		)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	items := make([]models.AvailabilityZone, 0)
+	err := availabilityzones.List(client.Client).
+		EachPage(ctx,
+			func(_ context.Context, page pagination.Page) (bool, error) {
+				metrics.IncPages(ctx)
+				zoneList, err := availabilityzones.ExtractAvailabilityZones(page)
+
+				if err != nil {
+					logger.Error(
+						"could not extract availability zone pages",
+						"reason", err,
+					)
+
+					return false, err
+				}
+
+				for _, z := range zoneList {
+					item := models.AvailabilityZone{
+						Name:      z.ZoneName,
+						ProjectID: client.ProjectID,
+						Domain:    client.Domain,
+						Region:    client.Region,
+						Available: z.ZoneState.Available,
+					}
+					items = append(items, item)
+				}
+
+				return true, nil
+			})
+
+	if err != nil {
+		logger.Error(
+			"could not extract availability zone pages",
+			"reason", err,
+		)
+
+		return err
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (name, project_id, domain, region) DO UPDATE").
+		Set("available = EXCLUDED.available").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert availability zones into db",
+			"project", payload.Scope.Project,
+			"domain", payload.Scope.Domain,
+			"region", payload.Scope.Region,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated openstack availability zones",
+		"project", payload.Scope.Project,
+		"domain", payload.Scope.Domain,
+		"region", payload.Scope.Region,
+		"count", count,
+	)
+
+	return nil
+}
@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
@@ -34,7 +35,7 @@ const (
 // where to collect OpenStack Containers from.
 type CollectContainersPayload struct {
 	// Scope specifies the client scope for which to collect.
-	Scope openstackclients.ClientScope `json:"scope" yaml:"scope"`
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The client scope for which to collect"`
 }
 
 // NewCollectContainersTask creates a new [asynq.Task] for collecting OpenStack
@@ -97,7 +98,19 @@ func enqueueCollectContainers(ctx context.Context) error {
 			}
 
 			task := asynq.NewTask(TaskCollectContainers, data)
-			info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+			info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+			if errors.Is(err, asynq.ErrDuplicateTask) {
+				logger.Info(
+					"skipping duplicate task",
+					"type", task.Type(),
+					"project", scope.Project,
+					"domain", scope.Domain,
+					"region", scope.Region,
+				)
+
+				return nil
+			}
+
 			if err != nil {
 				logger.Error(
 					"failed to enqueue task",
@@ -177,6 +190,7 @@ func collectContainers(ctx context.Context, payload CollectContainersPayload) er
 	err = containers.List(client.Client, nil).
 		EachPage(ctx,
 			func(_ context.Context, page pagination.Page) (bool, error) {
+				metrics.IncPages(ctx)
 				extractedContainers, err := containers.ExtractInfo(page)
 				if err != nil {
 					logger.Error(
@@ -203,6 +217,24 @@ func collectContainers(ctx context.Context, payload CollectContainersPayload) er
 						ObjectCount: container.Count,
 					}
 
+					// The ACLs and temp URL keys are provided
+					// via the container metadata only.
+					header, err := containers.Get(ctx, client.Client, container.Name, nil).Extract()
+					if err != nil {
+						logger.Warn(
+							"could not get container metadata",
+							"container", container.Name,
+							"reason", err,
+						)
+					} else {
+						readACL := openstackutils.ParseContainerACL(header.Read)
+						item.ReadACL = readACL.Entries
+						item.WriteACL = openstackutils.ParseContainerACL(header.Write).Entries
+						item.PublicRead = readACL.PublicRead
+						item.PublicListing = readACL.PublicListing
+						item.HasTempURLKey = header.TempURLKey != "" || header.TempURLKey2 != ""
+					}
+
 					items = append(items, item)
 				}
 
@@ -227,6 +259,11 @@ func collectContainers(ctx context.Context, payload CollectContainersPayload) er
 		On("CONFLICT (name, project_id) DO UPDATE").
 		Set("bytes = EXCLUDED.bytes").
 		Set("object_count = EXCLUDED.object_count").
+		Set("read_acl = EXCLUDED.read_acl").
+		Set("write_acl = EXCLUDED.write_acl").
+		Set("public_read = EXCLUDED.public_read").
+		Set("public_listing = EXCLUDED.public_listing").
+		Set("has_temp_url_key = EXCLUDED.has_temp_url_key").
 		Set("updated_at = EXCLUDED.updated_at").
 		Returning("id").
 		Exec(ctx)
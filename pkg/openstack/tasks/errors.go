@@ -17,6 +17,10 @@ var ErrClientNotFound = errors.New("client not found")
 // specified in a task payload.
 var ErrInvalidScope = errors.New("invalid scope specified")
 
+// ErrInvalidQuotaService is an error which is returned when an unknown quota
+// service was specified in a task payload.
+var ErrInvalidQuotaService = errors.New("invalid quota service specified")
+
 // ClientNotFound wraps [ErrClientNotFound] with the given name.
 func ClientNotFound(name string) error {
 	return fmt.Errorf("%w: %s", ErrClientNotFound, name)
@@ -0,0 +1,274 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	"github.com/gardener/inventory/pkg/clients/db"
+	openstackclients "github.com/gardener/inventory/pkg/clients/openstack"
+	"github.com/gardener/inventory/pkg/metrics"
+	"github.com/gardener/inventory/pkg/openstack/models"
+	openstackutils "github.com/gardener/inventory/pkg/openstack/utils"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+const (
+	// TaskCollectFlavors is the name of the task for collecting OpenStack
+	// flavors.
+	TaskCollectFlavors = "openstack:task:collect-flavors"
+)
+
+// CollectFlavorsPayload represents the payload, which specifies
+// where to collect OpenStack Flavors from.
+type CollectFlavorsPayload struct {
+	// Scope specifies the client scope for which to collect.
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The client scope for which to collect"`
+}
+
+// NewCollectFlavorsTask creates a new [asynq.Task] for collecting OpenStack
+// flavors, without specifying a payload.
+func NewCollectFlavorsTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectFlavors, nil)
+}
+
+// HandleCollectFlavorsTask handles the task for collecting OpenStack Flavors.
+func HandleCollectFlavorsTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we enqueue tasks for
+	// collecting OpenStack Flavors from all configured compute clients.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectFlavors(ctx)
+	}
+
+	var payload CollectFlavorsPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if err := openstackutils.IsValidProjectScope(payload.Scope); err != nil {
+		return asynqutils.SkipRetry(ErrInvalidScope)
+	}
+
+	return collectFlavors(ctx, payload)
+}
+
+// enqueueCollectFlavors enqueues tasks for collecting OpenStack Flavors from
+// all configured OpenStack compute clients by creating a payload with the respective
+// client scope.
+func enqueueCollectFlavors(ctx context.Context) error {
+	logger := asynqutils.GetLogger(ctx)
+
+	if openstackclients.ComputeClientset.Length() == 0 {
+		logger.Warn("no OpenStack compute clients found")
+
+		return nil
+	}
+
+	queue := asynqutils.GetQueueName(ctx)
+
+	return openstackclients.ComputeClientset.Range(func(scope openstackclients.ClientScope, _ openstackclients.Client[*gophercloud.ServiceClient]) error {
+		payload := CollectFlavorsPayload{
+			Scope: scope,
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for OpenStack flavors",
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+				"reason", err,
+			)
+
+			return err
+		}
+
+		task := asynq.NewTask(TaskCollectFlavors, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+			)
+
+			return nil
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+				"reason", err,
+			)
+
+			return err
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"project", scope.Project,
+			"domain", scope.Domain,
+			"region", scope.Region,
+		)
+
+		return nil
+	})
+}
+
+// collectFlavors collects the OpenStack flavors, which are accessible from
+// the project of the client scope in the given payload.
+func collectFlavors(ctx context.Context, payload CollectFlavorsPayload) error {
+	logger := asynqutils.GetLogger(ctx)
+
+	client, ok := openstackclients.ComputeClientset.Get(payload.Scope)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.Scope.Project))
+	}
+
+	logger.Info(
+		"collecting OpenStack flavors",
+		"project", payload.Scope.Project,
+		"domain", payload.Scope.Domain,
+		"region", payload.Scope.Region,
+	)
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			flavorsDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.Scope.Project,
+			payload.Scope.Domain,
+			payload.Scope.Region,
+		)
+		key := metrics.Key(
+			TaskCollectFlavors,
+			payload.Scope.Project,
+			payload.Scope.Domain,
+			payload.Scope.Region,
+		)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	items := make([]models.Flavor, 0)
+
+	// Both public flavors and the private flavors the project has access
+	// to are collected.
+	opts := flavors.ListOpts{
+		AccessType: flavors.AllAccess,
+	}
+	err := flavors.ListDetail(client.Client, opts).
+		EachPage(ctx,
+			func(_ context.Context, page pagination.Page) (bool, error) {
+				metrics.IncPages(ctx)
+				flavorList, err := flavors.ExtractFlavors(page)
+
+				if err != nil {
+					logger.Error(
+						"could not extract flavor pages",
+						"reason", err,
+					)
+
+					return false, err
+				}
+
+				for _, f := range flavorList {
+					item := models.Flavor{
+						FlavorID:    f.ID,
+						ProjectID:   client.ProjectID,
+						Domain:      client.Domain,
+						Region:      client.Region,
+						Name:        f.Name,
+						Description: f.Description,
+						VCPUs:       f.VCPUs,
+						RAM:         f.RAM,
+						Disk:        f.Disk,
+						Ephemeral:   f.Ephemeral,
+						Swap:        f.Swap,
+						RxTxFactor:  f.RxTxFactor,
+						IsPublic:    f.IsPublic,
+					}
+					items = append(items, item)
+				}
+
+				return true, nil
+			})
+
+	if err != nil {
+		logger.Error(
+			"could not extract flavor pages",
+			"reason", err,
+		)
+
+		return err
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (flavor_id, project_id, domain, region) DO UPDATE").
+		Set("name = EXCLUDED.name").
+		Set("description = EXCLUDED.description").
+		Set("vcpus = EXCLUDED.vcpus").
+		Set("ram = EXCLUDED.ram").
+		Set("disk = EXCLUDED.disk").
+		Set("ephemeral = EXCLUDED.ephemeral").
+		Set("swap = EXCLUDED.swap").
+		Set("rxtx_factor = EXCLUDED.rxtx_factor").
+		Set("is_public = EXCLUDED.is_public").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert flavors into db",
+			"project", payload.Scope.Project,
+			"domain", payload.Scope.Domain,
+			"region", payload.Scope.Region,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated openstack flavors",
+		"project", payload.Scope.Project,
+		"domain", payload.Scope.Domain,
+		"region", payload.Scope.Region,
+		"count", count,
+	)
+
+	return nil
+}
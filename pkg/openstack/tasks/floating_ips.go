@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net"
 
 	"github.com/gophercloud/gophercloud/v2"
@@ -34,7 +35,7 @@ const (
 // the scope for collecting OpenStack Floating IPs.
 type CollectFloatingIPsPayload struct {
 	// Scope specifies the client scope to use for collection.
-	Scope openstackclients.ClientScope `json:"scope" yaml:"scope"`
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The client scope to use for collection"`
 }
 
 // NewCollectFloatingIPsTask creates a new [asynq.Task] for collecting OpenStack
@@ -96,7 +97,19 @@ func enqueueCollectFloatingIPs(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectFloatingIPs, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+			)
+
+			return nil
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -168,6 +181,7 @@ func collectFloatingIPs(ctx context.Context, payload CollectFloatingIPsPayload)
 	err := floatingips.List(client.Client, opts).
 		EachPage(ctx,
 			func(_ context.Context, page pagination.Page) (bool, error) {
+				metrics.IncPages(ctx)
 				floatingIPList, err := floatingips.ExtractFloatingIPs(page)
 
 				if err != nil {
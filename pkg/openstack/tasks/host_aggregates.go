@@ -0,0 +1,274 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/aggregates"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	"github.com/gardener/inventory/pkg/clients/db"
+	openstackclients "github.com/gardener/inventory/pkg/clients/openstack"
+	"github.com/gardener/inventory/pkg/metrics"
+	"github.com/gardener/inventory/pkg/openstack/models"
+	openstackutils "github.com/gardener/inventory/pkg/openstack/utils"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+const (
+	// TaskCollectHostAggregates is the name of the task for collecting
+	// OpenStack host aggregates.
+	TaskCollectHostAggregates = "openstack:task:collect-host-aggregates"
+)
+
+// CollectHostAggregatesPayload represents the payload, which specifies
+// where to collect OpenStack host aggregates from.
+type CollectHostAggregatesPayload struct {
+	// Scope specifies the client scope for which to collect.
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The client scope for which to collect"`
+}
+
+// NewCollectHostAggregatesTask creates a new [asynq.Task] for collecting
+// OpenStack host aggregates, without specifying a payload.
+func NewCollectHostAggregatesTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectHostAggregates, nil)
+}
+
+// HandleCollectHostAggregatesTask handles the task for collecting OpenStack
+// host aggregates.
+func HandleCollectHostAggregatesTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we enqueue tasks for
+	// collecting OpenStack host aggregates from all configured compute
+	// clients.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectHostAggregates(ctx)
+	}
+
+	var payload CollectHostAggregatesPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if err := openstackutils.IsValidProjectScope(payload.Scope); err != nil {
+		return asynqutils.SkipRetry(ErrInvalidScope)
+	}
+
+	return collectHostAggregates(ctx, payload)
+}
+
+// enqueueCollectHostAggregates enqueues tasks for collecting OpenStack
+// host aggregates from all configured OpenStack compute clients by creating a
+// payload with the respective client scope.
+func enqueueCollectHostAggregates(ctx context.Context) error {
+	logger := asynqutils.GetLogger(ctx)
+
+	if openstackclients.ComputeClientset.Length() == 0 {
+		logger.Warn("no OpenStack compute clients found")
+
+		return nil
+	}
+
+	queue := asynqutils.GetQueueName(ctx)
+
+	return openstackclients.ComputeClientset.Range(func(scope openstackclients.ClientScope, _ openstackclients.Client[*gophercloud.ServiceClient]) error {
+		payload := CollectHostAggregatesPayload{
+			Scope: scope,
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for OpenStack host aggregates",
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+				"reason", err,
+			)
+
+			return err
+		}
+
+		task := asynq.NewTask(TaskCollectHostAggregates, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+			)
+
+			return nil
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+				"reason", err,
+			)
+
+			return err
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"project", scope.Project,
+			"domain", scope.Domain,
+			"region", scope.Region,
+		)
+
+		return nil
+	})
+}
+
+// collectHostAggregates collects the OpenStack Compute host aggregates using
+// the client scope in the given payload. Listing host aggregates is
+// restricted to admins by default, so a forbidden response is not treated as
+// an error.
+func collectHostAggregates(ctx context.Context, payload CollectHostAggregatesPayload) error {
+	logger := asynqutils.GetLogger(ctx)
+
+	client, ok := openstackclients.ComputeClientset.Get(payload.Scope)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.Scope.Project))
+	}
+
+	logger.Info(
+		"collecting OpenStack host aggregates",
+		"project", payload.Scope.Project,
+		"domain", payload.Scope.Domain,
+		"region", payload.Scope.Region,
+	)
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			hostAggregatesDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.Scope.Project,
+			payload.Scope.Domain,
+			payload.Scope.Region,
+		)
+		key := metrics.Key(
+			TaskCollectHostAggregates,
+			payload.Scope.Project,
+			payload.Scope.Domain,
+			payload.Scope.Region,
+		)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	items := make([]models.HostAggregate, 0)
+	err := aggregates.List(client.Client).
+		EachPage(ctx,
+			func(_ context.Context, page pagination.Page) (bool, error) {
+				metrics.IncPages(ctx)
+				aggregateList, err := aggregates.ExtractAggregates(page)
+
+				if err != nil {
+					logger.Error(
+						"could not extract host aggregate pages",
+						"reason", err,
+					)
+
+					return false, err
+				}
+
+				for _, a := range aggregateList {
+					item := models.HostAggregate{
+						AggregateID:      a.ID,
+						ProjectID:        client.ProjectID,
+						Domain:           client.Domain,
+						Region:           client.Region,
+						UUID:             a.UUID,
+						Name:             a.Name,
+						AvailabilityZone: a.AvailabilityZone,
+						Hosts:            a.Hosts,
+					}
+					items = append(items, item)
+				}
+
+				return true, nil
+			})
+
+	if gophercloud.ResponseCodeIs(err, http.StatusForbidden) {
+		logger.Warn(
+			"not permitted to list host aggregates",
+			"project", payload.Scope.Project,
+			"domain", payload.Scope.Domain,
+			"region", payload.Scope.Region,
+		)
+
+		return nil
+	}
+
+	if err != nil {
+		logger.Error(
+			"could not extract host aggregate pages",
+			"reason", err,
+		)
+
+		return err
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (aggregate_id, project_id, domain, region) DO UPDATE").
+		Set("aggregate_uuid = EXCLUDED.aggregate_uuid").
+		Set("name = EXCLUDED.name").
+		Set("availability_zone = EXCLUDED.availability_zone").
+		Set("hosts = EXCLUDED.hosts").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert host aggregates into db",
+			"project", payload.Scope.Project,
+			"domain", payload.Scope.Domain,
+			"region", payload.Scope.Region,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated openstack host aggregates",
+		"project", payload.Scope.Project,
+		"domain", payload.Scope.Domain,
+		"region", payload.Scope.Region,
+		"count", count,
+	)
+
+	return nil
+}
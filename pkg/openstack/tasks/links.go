@@ -438,3 +438,101 @@ func LinkServersWithNetworks(ctx context.Context, db *bun.DB) error {
 
 	return nil
 }
+
+// LinkServersWithAvailabilityZones creates links between the OpenStack Servers
+// and Availability Zones.
+func LinkServersWithAvailabilityZones(ctx context.Context, db *bun.DB) error {
+	var servers []models.Server
+	err := db.NewSelect().
+		Model(&servers).
+		Relation("Zone").
+		Where("zone.id IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	links := make([]models.ServerToAZ, 0, len(servers))
+	for _, server := range servers {
+		links = append(links, models.ServerToAZ{
+			ServerID: server.ID,
+			AZID:     server.Zone.ID,
+		})
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	out, err := db.NewInsert().
+		Model(&links).
+		On("CONFLICT (server_id, az_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("linked openstack servers with availability zones", "count", count)
+
+	return nil
+}
+
+// LinkServersWithServerGroups creates links between the OpenStack Servers and
+// the Server Groups they are members of.
+func LinkServersWithServerGroups(ctx context.Context, db *bun.DB) error {
+	var members []models.ServerGroupMember
+	err := db.NewSelect().
+		Model(&members).
+		Relation("Server").
+		Relation("ServerGroup").
+		Where("server.id IS NOT NULL").
+		Where("server_group.id IS NOT NULL").
+		Scan(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	links := make([]models.ServerToServerGroup, 0, len(members))
+	for _, member := range members {
+		links = append(links, models.ServerToServerGroup{
+			ServerID:      member.Server.ID,
+			ServerGroupID: member.ServerGroup.ID,
+		})
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	out, err := db.NewInsert().
+		Model(&links).
+		On("CONFLICT (server_id, server_group_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger := asynqutils.GetLogger(ctx)
+	logger.Info("linked openstack servers with server groups", "count", count)
+
+	return nil
+}
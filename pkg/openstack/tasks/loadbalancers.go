@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
@@ -33,7 +34,7 @@ const (
 // where to collect OpenStack LoadBalancers from.
 type CollectLoadBalancersPayload struct {
 	// Scope specifies the project scope to use for collection.
-	Scope openstackclients.ClientScope `json:"scope" yaml:"scope"`
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The project scope to use for collection"`
 }
 
 // NewCollectLoadBalancersTask creates a new [asynq.Task] for collecting OpenStack
@@ -75,6 +76,8 @@ func enqueueCollectLoadBalancers(ctx context.Context) error {
 		return nil
 	}
 
+	queue := asynqutils.GetQueueName(ctx)
+
 	return openstackclients.LoadBalancerClientset.
 		Range(func(scope openstackclients.ClientScope, _ openstackclients.Client[*gophercloud.ServiceClient]) error {
 			payload := CollectLoadBalancersPayload{
@@ -94,7 +97,19 @@ func enqueueCollectLoadBalancers(ctx context.Context) error {
 			}
 
 			task := asynq.NewTask(TaskCollectLoadBalancers, data)
-			info, err := asynqclient.Client.Enqueue(task)
+			info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+			if errors.Is(err, asynq.ErrDuplicateTask) {
+				logger.Info(
+					"skipping duplicate task",
+					"type", task.Type(),
+					"project", scope.Project,
+					"domain", scope.Domain,
+					"region", scope.Region,
+				)
+
+				return nil
+			}
+
 			if err != nil {
 				logger.Error(
 					"failed to enqueue task",
@@ -167,6 +182,7 @@ func collectLoadBalancers(ctx context.Context, payload CollectLoadBalancersPaylo
 	err := loadbalancers.List(client.Client, opts).
 		EachPage(ctx,
 			func(_ context.Context, page pagination.Page) (bool, error) {
+				metrics.IncPages(ctx)
 				lbList, err := loadbalancers.ExtractLoadBalancers(page)
 
 				if err != nil {
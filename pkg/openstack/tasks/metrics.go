@@ -127,6 +127,51 @@ var (
 		[]string{"project", "domain", "region"},
 		nil,
 	)
+
+	// flavorsDesc is the descriptor for a metric,
+	// which tracks the number of collected OpenStack Flavors
+	flavorsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "openstack_flavors"),
+		"A gauge which tracks the number of collected OpenStack Flavors",
+		[]string{"project", "domain", "region"},
+		nil,
+	)
+
+	// quotasDesc is the descriptor for a metric,
+	// which tracks the number of collected OpenStack quotas
+	quotasDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "openstack_quotas"),
+		"A gauge which tracks the number of collected OpenStack quotas",
+		[]string{"project", "domain", "region", "service"},
+		nil,
+	)
+
+	// availabilityZonesDesc is the descriptor for a metric,
+	// which tracks the number of collected OpenStack availability zones
+	availabilityZonesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "openstack_availability_zones"),
+		"A gauge which tracks the number of collected OpenStack availability zones",
+		[]string{"project", "domain", "region"},
+		nil,
+	)
+
+	// hostAggregatesDesc is the descriptor for a metric,
+	// which tracks the number of collected OpenStack host aggregates
+	hostAggregatesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "openstack_host_aggregates"),
+		"A gauge which tracks the number of collected OpenStack host aggregates",
+		[]string{"project", "domain", "region"},
+		nil,
+	)
+
+	// serverGroupsDesc is the descriptor for a metric,
+	// which tracks the number of collected OpenStack server groups
+	serverGroupsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "openstack_server_groups"),
+		"A gauge which tracks the number of collected OpenStack server groups",
+		[]string{"project", "domain", "region"},
+		nil,
+	)
 )
 
 func init() {
@@ -144,5 +189,10 @@ func init() {
 		poolMembersDesc,
 		containersDesc,
 		volumesDesc,
+		flavorsDesc,
+		quotasDesc,
+		availabilityZonesDesc,
+		hostAggregatesDesc,
+		serverGroupsDesc,
 	)
 }
@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
@@ -33,7 +34,7 @@ const (
 // which client to collect OpenStack Networks with.
 type CollectNetworksPayload struct {
 	// Scope specifies the client scope for which to collect.
-	Scope openstackclients.ClientScope `json:"scope" yaml:"scope"`
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The client scope for which to collect"`
 }
 
 // NewCollectNetworksTask creates a new [asynq.Task] for collecting OpenStack
@@ -95,7 +96,19 @@ func enqueueCollectNetworks(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectNetworks, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+			)
+
+			return nil
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -167,6 +180,7 @@ func collectNetworks(ctx context.Context, payload CollectNetworksPayload) error
 	err := networks.List(client.Client, opts).
 		EachPage(ctx,
 			func(_ context.Context, page pagination.Page) (bool, error) {
+				metrics.IncPages(ctx)
 				networkList, err := networks.ExtractNetworks(page)
 
 				if err != nil {
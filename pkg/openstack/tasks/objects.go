@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
@@ -34,7 +35,7 @@ const (
 // where to collect OpenStack Objects from.
 type CollectObjectsPayload struct {
 	// Scope specifies the client scope for which to collect.
-	Scope openstackclients.ClientScope `json:"scope" yaml:"scope"`
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The client scope for which to collect"`
 }
 
 // NewCollectObjectsTask creates a new [asynq.Task] for collecting OpenStack
@@ -97,7 +98,19 @@ func enqueueCollectObjects(ctx context.Context) error {
 			}
 
 			task := asynq.NewTask(TaskCollectObjects, data)
-			info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+			info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+			if errors.Is(err, asynq.ErrDuplicateTask) {
+				logger.Info(
+					"skipping duplicate task",
+					"type", task.Type(),
+					"project", scope.Project,
+					"domain", scope.Domain,
+					"region", scope.Region,
+				)
+
+				return nil
+			}
+
 			if err != nil {
 				logger.Error(
 					"failed to enqueue task",
@@ -168,6 +181,7 @@ func collectObjects(ctx context.Context, payload CollectObjectsPayload) error {
 	err := containers.List(client.Client, nil).
 		EachPage(ctx,
 			func(_ context.Context, page pagination.Page) (bool, error) {
+				metrics.IncPages(ctx)
 				containerNameList, err := containers.ExtractNames(page)
 
 				if err != nil {
@@ -196,6 +210,7 @@ func collectObjects(ctx context.Context, payload CollectObjectsPayload) error {
 		err = objects.List(client.Client, name, nil).
 			EachPage(ctx,
 				func(_ context.Context, page pagination.Page) (bool, error) {
+					metrics.IncPages(ctx)
 					objectList, err := objects.ExtractInfo(page)
 
 					if err != nil {
@@ -8,12 +8,16 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
 	"github.com/gophercloud/gophercloud/v2/pagination"
 	"github.com/hibiken/asynq"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/uptrace/bun"
 
 	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
 	"github.com/gardener/inventory/pkg/clients/db"
@@ -32,22 +36,36 @@ const (
 	// TaskCollectPoolMembers is the name of the task for collecting OpenStack
 	// Pool Members for a specific pool.
 	TaskCollectPoolMembers = "openstack:task:collect-pool-members"
+	// TaskAggregatePoolMembers is the name of the task for collecting
+	// OpenStack Pool Members for multiple pools at once. It is the result
+	// of aggregating the grouped [TaskCollectPoolMembers] tasks enqueued
+	// by [TaskCollectPools].
+	TaskAggregatePoolMembers = "openstack:task:aggregate-pool-members"
 )
 
 // CollectPoolsPayload represents the payload, which specifies
 // where to collect OpenStack Pools from.
 type CollectPoolsPayload struct {
 	// Scope specifies the project scope to use for collection.
-	Scope openstackclients.ClientScope `json:"scope" yaml:"scope"`
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The project scope to use for collection"`
 }
 
 // CollectPoolMembersPayload represents the payload for collecting pool members
 // for a specific pool.
 type CollectPoolMembersPayload struct {
 	// Scope specifies the project scope to use for collection.
-	Scope openstackclients.ClientScope `json:"scope" yaml:"scope"`
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The project scope to use for collection"`
 	// PoolID is the ID of the pool to collect members for.
-	PoolID string `json:"pool_id" yaml:"pool_id"`
+	PoolID string `json:"pool_id" yaml:"pool_id" desc:"The ID of the pool to collect members for" example:"0d4f6e7a-1b2c-3d4e-5f60-718293a4b5c6"`
+}
+
+// AggregatePoolMembersPayload represents the payload for collecting pool members
+// for multiple pools from the same project scope.
+type AggregatePoolMembersPayload struct {
+	// Scope specifies the project scope to use for collection.
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The project scope to use for collection"`
+	// PoolIDs are the IDs of the pools to collect members for.
+	PoolIDs []string `json:"pool_ids" yaml:"pool_ids" desc:"The IDs of the pools to collect members for" example:"0d4f6e7a-1b2c-3d4e-5f60-718293a4b5c6"`
 }
 
 // NewCollectPoolsTask creates a new [asynq.Task] for collecting OpenStack
@@ -107,6 +125,77 @@ func HandleCollectPoolMembersTask(ctx context.Context, t *asynq.Task) error {
 	return collectPoolMembers(ctx, payload)
 }
 
+// HandleAggregatePoolMembersTask handles the task for collecting OpenStack Pool
+// Members for multiple pools at once.
+func HandleAggregatePoolMembersTask(ctx context.Context, t *asynq.Task) error {
+	var payload AggregatePoolMembersPayload
+	if err := asynqutils.Unmarshal(t.Payload(), &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if err := openstackutils.IsValidProjectScope(payload.Scope); err != nil {
+		return asynqutils.SkipRetry(ErrInvalidScope)
+	}
+
+	if len(payload.PoolIDs) == 0 {
+		return asynqutils.SkipRetry(errors.New("no pool IDs specified"))
+	}
+
+	return aggregatePoolMembers(ctx, payload)
+}
+
+// AggregatePoolMembersTasks is an [asynq.GroupAggregatorFunc], which aggregates
+// the grouped [TaskCollectPoolMembers] tasks into a single
+// [TaskAggregatePoolMembers] task. The tasks are grouped by project scope, so
+// all of them share the same scope.
+func AggregatePoolMembersTasks(group string, tasks []*asynq.Task) *asynq.Task {
+	payload := AggregatePoolMembersPayload{
+		PoolIDs: make([]string, 0, len(tasks)),
+	}
+
+	for _, t := range tasks {
+		var item CollectPoolMembersPayload
+		if err := asynqutils.Unmarshal(t.Payload(), &item); err != nil {
+			slog.Error(
+				"failed to unmarshal pool member payload",
+				"group", group,
+				"reason", err,
+			)
+
+			continue
+		}
+		payload.Scope = item.Scope
+		if !slices.Contains(payload.PoolIDs, item.PoolID) {
+			payload.PoolIDs = append(payload.PoolIDs, item.PoolID)
+		}
+	}
+
+	// If the aggregated payload cannot be marshaled, the task is enqueued
+	// without a payload, and is skipped by the handler.
+	data, err := json.Marshal(payload)
+	if err != nil {
+		slog.Error(
+			"failed to marshal aggregated pool member payload",
+			"group", group,
+			"reason", err,
+		)
+	}
+
+	return asynq.NewTask(TaskAggregatePoolMembers, data)
+}
+
+// poolMembersGroup returns the name of the task group for collecting OpenStack
+// Pool Members from the given project scope.
+func poolMembersGroup(scope openstackclients.ClientScope) string {
+	return fmt.Sprintf(
+		"%s:%s:%s:%s",
+		TaskCollectPoolMembers,
+		scope.Project,
+		scope.Domain,
+		scope.Region,
+	)
+}
+
 // enqueueCollectPools enqueues tasks for collecting OpenStack Pools from
 // all configured OpenStack clients by creating a payload with the respective
 // client scope.
@@ -119,6 +208,8 @@ func enqueueCollectPools(ctx context.Context) error {
 		return nil
 	}
 
+	queue := asynqutils.GetQueueName(ctx)
+
 	return openstackclients.LoadBalancerClientset.
 		Range(func(scope openstackclients.ClientScope, _ openstackclients.Client[*gophercloud.ServiceClient]) error {
 			payload := CollectPoolsPayload{
@@ -138,7 +229,19 @@ func enqueueCollectPools(ctx context.Context) error {
 			}
 
 			task := asynq.NewTask(TaskCollectPools, data)
-			info, err := asynqclient.Client.Enqueue(task)
+			info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+			if errors.Is(err, asynq.ErrDuplicateTask) {
+				logger.Info(
+					"skipping duplicate task",
+					"type", task.Type(),
+					"project", scope.Project,
+					"domain", scope.Domain,
+					"region", scope.Region,
+				)
+
+				return nil
+			}
+
 			if err != nil {
 				logger.Error(
 					"failed to enqueue task",
@@ -168,7 +271,9 @@ func enqueueCollectPools(ctx context.Context) error {
 
 // collectPools collects the OpenStack Pools,
 // using the client associated with the client scope in the given payload.
-// For each pool found, it enqueues a separate task to collect pool members.
+// For each pool found, it enqueues a separate task to collect pool members. The
+// pool member tasks are grouped by project scope, so that they are aggregated
+// into a single task by the worker.
 func collectPools(ctx context.Context, payload CollectPoolsPayload) error {
 	logger := asynqutils.GetLogger(ctx)
 
@@ -211,6 +316,7 @@ func collectPools(ctx context.Context, payload CollectPoolsPayload) error {
 	err := pools.List(client.Client, opts).
 		EachPage(ctx,
 			func(_ context.Context, page pagination.Page) (bool, error) {
+				metrics.IncPages(ctx)
 				extractedPools, err := pools.ExtractPools(page)
 
 				if err != nil {
@@ -255,7 +361,23 @@ func collectPools(ctx context.Context, payload CollectPoolsPayload) error {
 					}
 
 					task := asynq.NewTask(TaskCollectPoolMembers, data)
-					info, err := asynqclient.Client.Enqueue(task)
+					info, err := asynqclient.Enqueue(
+						task,
+						asynq.Queue(asynqutils.GetQueueName(ctx)),
+						asynq.Group(poolMembersGroup(payload.Scope)),
+					)
+					if errors.Is(err, asynq.ErrDuplicateTask) {
+						logger.Info(
+							"skipping duplicate task",
+							"type", task.Type(),
+							"pool_id", pool.ID,
+							"pool_name", pool.Name,
+							"project", payload.Scope.Project,
+						)
+
+						continue
+					}
+
 					if err != nil {
 						logger.Error(
 							"failed to enqueue pool member collection task",
@@ -337,6 +459,30 @@ func collectPools(ctx context.Context, payload CollectPoolsPayload) error {
 // collectPoolMembers collects the OpenStack Pool Members for a specific pool,
 // using the client associated with the client scope in the given payload.
 func collectPoolMembers(ctx context.Context, payload CollectPoolMembersPayload) error {
+	client, ok := openstackclients.LoadBalancerClientset.Get(payload.Scope)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.Scope.Project))
+	}
+
+	inferrer, err := gardenerutils.LoadShootInferrer(ctx)
+	if err != nil {
+		return err
+	}
+
+	items, err := listPoolMembers(ctx, client, inferrer, payload.Scope, payload.PoolID)
+	if err != nil {
+		return err
+	}
+
+	return persistPoolMembers(ctx, payload.Scope, client.ProjectID, []string{payload.PoolID}, items)
+}
+
+// aggregatePoolMembers collects the OpenStack Pool Members for multiple pools,
+// using the client associated with the client scope in the given payload. The
+// pool members of all pools are persisted at once, after all of them have been
+// collected. Pools, whose members cannot be listed, are skipped and reported
+// in the returned error, without affecting the remaining pools.
+func aggregatePoolMembers(ctx context.Context, payload AggregatePoolMembersPayload) error {
 	logger := asynqutils.GetLogger(ctx)
 
 	client, ok := openstackclients.LoadBalancerClientset.Get(payload.Scope)
@@ -345,51 +491,95 @@ func collectPoolMembers(ctx context.Context, payload CollectPoolMembersPayload)
 	}
 
 	logger.Info(
-		"collecting OpenStack pool members",
-		"pool_id", payload.PoolID,
+		"collecting aggregated OpenStack pool members",
+		"pools", len(payload.PoolIDs),
 		"project", payload.Scope.Project,
 		"domain", payload.Scope.Domain,
 		"region", payload.Scope.Region,
 	)
 
-	var memberCount int64
+	// The shoots are loaded once for all pools
+	inferrer, err := gardenerutils.LoadShootInferrer(ctx)
+	if err != nil {
+		return err
+	}
+
+	items := make([]models.PoolMember, 0)
+	poolIDs := make([]string, 0, len(payload.PoolIDs))
+	errs := make([]error, 0)
+	for _, poolID := range payload.PoolIDs {
+		members, err := listPoolMembers(ctx, client, inferrer, payload.Scope, poolID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("pool %s: %w", poolID, err))
+
+			continue
+		}
+		poolIDs = append(poolIDs, poolID)
+		items = append(items, members...)
+	}
+
+	if err := persistPoolMembers(ctx, payload.Scope, client.ProjectID, poolIDs, items); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// listPoolMembers returns the OpenStack Pool Members for a specific pool. The
+// shoots of the members are inferred using the given [gardenerutils.ShootInferrer].
+func listPoolMembers(
+	ctx context.Context,
+	client openstackclients.Client[*gophercloud.ServiceClient],
+	inferrer *gardenerutils.ShootInferrer,
+	scope openstackclients.ClientScope,
+	poolID string) ([]models.PoolMember, error) {
+	logger := asynqutils.GetLogger(ctx)
+
+	logger.Info(
+		"collecting OpenStack pool members",
+		"pool_id", poolID,
+		"project", scope.Project,
+		"domain", scope.Domain,
+		"region", scope.Region,
+	)
+
+	memberItems := make([]models.PoolMember, 0)
 	defer func() {
 		metric := prometheus.MustNewConstMetric(
 			poolMembersDesc,
 			prometheus.GaugeValue,
-			float64(memberCount),
-			payload.Scope.Project,
-			payload.Scope.Domain,
-			payload.Scope.Region,
-			payload.PoolID,
+			float64(len(memberItems)),
+			scope.Project,
+			scope.Domain,
+			scope.Region,
+			poolID,
 		)
 		key := metrics.Key(
 			TaskCollectPoolMembers,
-			payload.Scope.Project,
-			payload.Scope.Domain,
-			payload.Scope.Region,
-			payload.PoolID,
+			scope.Project,
+			scope.Domain,
+			scope.Region,
+			poolID,
 		)
 		metrics.DefaultCollector.AddMetric(key, metric)
 	}()
 
-	memberItems := make([]models.PoolMember, 0)
-
 	memberOpts := pools.ListMembersOpts{
 		ProjectID: client.ProjectID,
 	}
-	err := pools.ListMembers(client.Client, payload.PoolID, memberOpts).
+	err := pools.ListMembers(client.Client, poolID, memberOpts).
 		EachPage(ctx,
 			func(ctx context.Context, page pagination.Page) (bool, error) {
+				metrics.IncPages(ctx)
 				extractedMembers, err := pools.ExtractMembers(page)
 
 				if err != nil {
 					logger.Error(
 						"could not extract pool member pages",
-						"pool_id", payload.PoolID,
-						"project", payload.Scope.Project,
-						"domain", payload.Scope.Domain,
-						"region", payload.Scope.Region,
+						"pool_id", poolID,
+						"project", scope.Project,
+						"domain", scope.Domain,
+						"region", scope.Region,
 						"reason", err,
 					)
 
@@ -398,14 +588,14 @@ func collectPoolMembers(ctx context.Context, payload CollectPoolMembersPayload)
 
 				for _, member := range extractedMembers {
 					var inferredGardenerShoot string
-					shoot, err := gardenerutils.InferShootFromInstanceName(ctx, member.Name)
+					shoot, err := inferrer.Infer(member.Name)
 					if err == nil {
 						inferredGardenerShoot = shoot.TechnicalID
 					}
 
 					item := models.PoolMember{
 						MemberID:              member.ID,
-						PoolID:                payload.PoolID,
+						PoolID:                poolID,
 						ProjectID:             member.ProjectID,
 						Name:                  member.Name,
 						InferredGardenerShoot: inferredGardenerShoot,
@@ -424,58 +614,97 @@ func collectPoolMembers(ctx context.Context, payload CollectPoolMembersPayload)
 	if err != nil {
 		logger.Error(
 			"could not extract pool member pages",
-			"pool_id", payload.PoolID,
-			"project", payload.Scope.Project,
-			"domain", payload.Scope.Domain,
-			"region", payload.Scope.Region,
+			"pool_id", poolID,
+			"project", scope.Project,
+			"domain", scope.Domain,
+			"region", scope.Region,
 			"reason", err,
 		)
 
-		return err
+		return nil, err
 	}
 
-	if len(memberItems) == 0 {
+	return memberItems, nil
+}
+
+// persistPoolMembers persists the given OpenStack Pool Members, which were
+// collected from the pools with the given IDs. Once the members are upserted,
+// the members of these pools, which were not collected, are removed within
+// the same transaction, so that readers never see a partially updated pool.
+func persistPoolMembers(
+	ctx context.Context,
+	scope openstackclients.ClientScope,
+	projectID string,
+	poolIDs []string,
+	memberItems []models.PoolMember) error {
+	logger := asynqutils.GetLogger(ctx)
+
+	if len(poolIDs) == 0 {
 		return nil
 	}
 
-	out, err := db.DB.NewInsert().
-		Model(&memberItems).
-		On("CONFLICT (member_id, pool_id, project_id) DO UPDATE").
-		Set("name = EXCLUDED.name").
-		Set("subnet_id = EXCLUDED.subnet_id").
-		Set("protocol_port = EXCLUDED.protocol_port").
-		Set("inferred_gardener_shoot = EXCLUDED.inferred_gardener_shoot").
-		Set("member_created_at = EXCLUDED.member_created_at").
-		Set("member_updated_at = EXCLUDED.member_updated_at").
-		Set("updated_at = EXCLUDED.updated_at").
-		Returning("id").
-		Exec(ctx)
+	var count, staleCount int64
+	err := db.DB.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if len(memberItems) > 0 {
+			out, err := tx.NewInsert().
+				Model(&memberItems).
+				On("CONFLICT (member_id, pool_id, project_id) DO UPDATE").
+				Set("name = EXCLUDED.name").
+				Set("subnet_id = EXCLUDED.subnet_id").
+				Set("protocol_port = EXCLUDED.protocol_port").
+				Set("inferred_gardener_shoot = EXCLUDED.inferred_gardener_shoot").
+				Set("member_created_at = EXCLUDED.member_created_at").
+				Set("member_updated_at = EXCLUDED.member_updated_at").
+				Set("updated_at = EXCLUDED.updated_at").
+				Returning("id").
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+
+			count, err = out.RowsAffected()
+			if err != nil {
+				return err
+			}
+		}
+
+		// Members, which were not upserted within the current
+		// transaction, have been removed from their pools.
+		out, err := tx.NewDelete().
+			Model((*models.PoolMember)(nil)).
+			Where("project_id = ?", projectID).
+			Where("pool_id IN (?)", bun.In(poolIDs)).
+			Where("updated_at < now()").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		staleCount, err = out.RowsAffected()
+
+		return err
+	})
 
 	if err != nil {
 		logger.Error(
 			"could not insert pool members into db",
-			"pool_id", payload.PoolID,
-			"project", payload.Scope.Project,
-			"domain", payload.Scope.Domain,
-			"region", payload.Scope.Region,
+			"project", scope.Project,
+			"domain", scope.Domain,
+			"region", scope.Region,
 			"reason", err,
 		)
 
 		return err
 	}
 
-	memberCount, err = out.RowsAffected()
-	if err != nil {
-		return err
-	}
-
 	logger.Info(
 		"populated openstack pool members",
-		"pool_id", payload.PoolID,
-		"project", payload.Scope.Project,
-		"domain", payload.Scope.Domain,
-		"region", payload.Scope.Region,
-		"count", memberCount,
+		"project", scope.Project,
+		"domain", scope.Domain,
+		"region", scope.Region,
+		"pools", len(poolIDs),
+		"count", count,
+		"stale", staleCount,
 	)
 
 	return nil
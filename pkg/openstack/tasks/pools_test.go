@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/hibiken/asynq"
+
+	openstackclients "github.com/gardener/inventory/pkg/clients/openstack"
+	"github.com/gardener/inventory/pkg/openstack/tasks"
+)
+
+func TestAggregatePoolMembersTasks(t *testing.T) {
+	scope := openstackclients.ClientScope{
+		Project: "my-project",
+		Domain:  "my-domain",
+		Region:  "eu-de-1",
+	}
+
+	newTask := func(poolID string) *asynq.Task {
+		data, err := json.Marshal(tasks.CollectPoolMembersPayload{Scope: scope, PoolID: poolID})
+		if err != nil {
+			t.Fatalf("unable to marshal payload: %s", err)
+		}
+
+		return asynq.NewTask(tasks.TaskCollectPoolMembers, data)
+	}
+
+	grouped := []*asynq.Task{
+		newTask("pool-1"),
+		newTask("pool-2"),
+		newTask("pool-1"),
+		asynq.NewTask(tasks.TaskCollectPoolMembers, []byte("invalid")),
+	}
+
+	task := tasks.AggregatePoolMembersTasks("my-group", grouped)
+	if task == nil {
+		t.Fatal("got nil task")
+	}
+	if task.Type() != tasks.TaskAggregatePoolMembers {
+		t.Fatalf("got task type %s, wanted %s", task.Type(), tasks.TaskAggregatePoolMembers)
+	}
+
+	var payload tasks.AggregatePoolMembersPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		t.Fatalf("unable to unmarshal payload: %s", err)
+	}
+
+	if payload.Scope != scope {
+		t.Fatalf("got scope %+v, wanted %+v", payload.Scope, scope)
+	}
+
+	wantPoolIDs := []string{"pool-1", "pool-2"}
+	if !slices.Equal(payload.PoolIDs, wantPoolIDs) {
+		t.Fatalf("got pool ids %v, wanted %v", payload.PoolIDs, wantPoolIDs)
+	}
+}
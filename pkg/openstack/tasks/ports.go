@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net"
 
 	"github.com/gophercloud/gophercloud/v2"
@@ -22,6 +23,7 @@ import (
 	"github.com/gardener/inventory/pkg/openstack/models"
 	openstackutils "github.com/gardener/inventory/pkg/openstack/utils"
 	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
 )
 
 const (
@@ -33,7 +35,7 @@ const (
 // where to collect OpenStack Ports from.
 type CollectPortsPayload struct {
 	// Scope specifies the client scope for which to collect.
-	Scope openstackclients.ClientScope `json:"scope" yaml:"scope"`
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The client scope for which to collect"`
 }
 
 // NewCollectPortsTask creates a new [asynq.Task] for collecting OpenStack
@@ -95,7 +97,19 @@ func enqueueCollectPorts(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectPorts, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+			)
+
+			return nil
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -159,15 +173,74 @@ func collectPorts(ctx context.Context, payload CollectPortsPayload) error {
 		metrics.DefaultCollector.AddMetric(key, metric)
 	}()
 
-	items := make([]models.Port, 0)
-	portIPs := make([]models.PortIP, 0)
+	// The ports and their IPs are upserted in batches while paginating,
+	// so that large projects are not kept in memory at once.
+	upsertPorts := func(ctx context.Context, items []models.Port) (int64, error) {
+		out, err := db.DB.NewInsert().
+			Model(&items).
+			On("CONFLICT (port_id, project_id, network_id, region) DO UPDATE").
+			Set("name = EXCLUDED.name").
+			Set("domain = EXCLUDED.domain").
+			Set("device_id = EXCLUDED.device_id").
+			Set("device_owner = EXCLUDED.device_owner").
+			Set("mac_address = EXCLUDED.mac_address").
+			Set("status = EXCLUDED.status").
+			Set("description = EXCLUDED.description").
+			Set("port_created_at = EXCLUDED.port_created_at").
+			Set("port_updated_at = EXCLUDED.port_updated_at").
+			Set("updated_at = EXCLUDED.updated_at").
+			Returning("id").
+			Exec(ctx)
+
+		if err != nil {
+			logger.Error(
+				"could not insert ports into db",
+				"project", payload.Scope.Project,
+				"domain", payload.Scope.Domain,
+				"region", payload.Scope.Region,
+				"reason", err,
+			)
+
+			return 0, err
+		}
+
+		return out.RowsAffected()
+	}
+
+	upsertPortIPs := func(ctx context.Context, items []models.PortIP) (int64, error) {
+		out, err := db.DB.NewInsert().
+			Model(&items).
+			On("CONFLICT (port_id, ip_address, subnet_id, project_id) DO UPDATE").
+			Set("updated_at = EXCLUDED.updated_at").
+			Returning("id").
+			Exec(ctx)
+
+		if err != nil {
+			logger.Error(
+				"could not insert port IPs into db",
+				"project", payload.Scope.Project,
+				"domain", payload.Scope.Domain,
+				"region", payload.Scope.Region,
+				"reason", err,
+			)
+
+			return 0, err
+		}
+
+		return out.RowsAffected()
+	}
+
+	batchSize := asynqutils.GetConfig(ctx).Worker.BatchSize
+	items := dbutils.NewBatcher(batchSize, upsertPorts)
+	portIPs := dbutils.NewBatcher(batchSize, upsertPortIPs)
 
 	opts := ports.ListOpts{
 		ProjectID: client.ProjectID,
 	}
 	err := ports.List(client.Client, opts).
 		EachPage(ctx,
-			func(_ context.Context, page pagination.Page) (bool, error) {
+			func(ctx context.Context, page pagination.Page) (bool, error) {
+				metrics.IncPages(ctx)
 				portList, err := ports.ExtractPorts(page)
 				if err != nil {
 					logger.Error("failed to extract ports", "reason", err)
@@ -176,7 +249,7 @@ func collectPorts(ctx context.Context, payload CollectPortsPayload) error {
 				}
 
 				for _, port := range portList {
-					items = append(items, models.Port{
+					err := items.Add(ctx, models.Port{
 						PortID:      port.ID,
 						Name:        port.Name,
 						ProjectID:   port.ProjectID,
@@ -191,6 +264,9 @@ func collectPorts(ctx context.Context, payload CollectPortsPayload) error {
 						TimeCreated: port.CreatedAt,
 						TimeUpdated: port.UpdatedAt,
 					})
+					if err != nil {
+						return false, err
+					}
 
 					for _, fixedIP := range port.FixedIPs {
 						parsedIP := net.ParseIP(fixedIP.IPAddress)
@@ -209,7 +285,9 @@ func collectPorts(ctx context.Context, payload CollectPortsPayload) error {
 							IPAddress: parsedIP,
 							SubnetID:  fixedIP.SubnetID,
 						}
-						portIPs = append(portIPs, ip)
+						if err := portIPs.Add(ctx, ip); err != nil {
+							return false, err
+						}
 					}
 				}
 
@@ -227,41 +305,16 @@ func collectPorts(ctx context.Context, payload CollectPortsPayload) error {
 		return err
 	}
 
-	if len(items) == 0 {
-		return nil
+	if err := items.Flush(ctx); err != nil {
+		return err
 	}
-
-	out, err := db.DB.NewInsert().
-		Model(&items).
-		On("CONFLICT (port_id, project_id, network_id, region) DO UPDATE").
-		Set("name = EXCLUDED.name").
-		Set("domain = EXCLUDED.domain").
-		Set("device_id = EXCLUDED.device_id").
-		Set("device_owner = EXCLUDED.device_owner").
-		Set("mac_address = EXCLUDED.mac_address").
-		Set("status = EXCLUDED.status").
-		Set("description = EXCLUDED.description").
-		Set("port_created_at = EXCLUDED.port_created_at").
-		Set("port_updated_at = EXCLUDED.port_updated_at").
-		Set("updated_at = EXCLUDED.updated_at").
-		Returning("id").
-		Exec(ctx)
-
-	if err != nil {
-		logger.Error(
-			"could not insert ports into db",
-			"project", payload.Scope.Project,
-			"domain", payload.Scope.Domain,
-			"region", payload.Scope.Region,
-			"reason", err,
-		)
-
+	if err := portIPs.Flush(ctx); err != nil {
 		return err
 	}
 
-	portCount, err = out.RowsAffected()
-	if err != nil {
-		return err
+	portCount = items.Count()
+	if portCount == 0 {
+		return nil
 	}
 
 	logger.Info(
@@ -272,34 +325,11 @@ func collectPorts(ctx context.Context, payload CollectPortsPayload) error {
 		"count", portCount,
 	)
 
-	if len(portIPs) == 0 {
+	ipCount := portIPs.Count()
+	if ipCount == 0 {
 		return nil
 	}
 
-	out, err = db.DB.NewInsert().
-		Model(&portIPs).
-		On("CONFLICT (port_id, ip_address, subnet_id, project_id) DO UPDATE").
-		Set("updated_at = EXCLUDED.updated_at").
-		Returning("id").
-		Exec(ctx)
-
-	if err != nil {
-		logger.Error(
-			"could not insert port IPs into db",
-			"project", payload.Scope.Project,
-			"domain", payload.Scope.Domain,
-			"region", payload.Scope.Region,
-			"reason", err,
-		)
-
-		return err
-	}
-
-	ipCount, err := out.RowsAffected()
-	if err != nil {
-		return err
-	}
-
 	logger.Info(
 		"populated openstack port IPs",
 		"project", payload.Scope.Project,
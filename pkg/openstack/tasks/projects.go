@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/projects"
@@ -33,7 +34,7 @@ const (
 // where to collect OpenStack Projects from.
 type CollectProjectsPayload struct {
 	// Scope specifies the scope of the client to be used.
-	Scope openstackclients.ClientScope `json:"scope" yaml:"scope"`
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The scope of the client to be used"`
 }
 
 // NewCollectProjectsTask creates a new [asynq.Task] for collecting OpenStack
@@ -95,7 +96,19 @@ func enqueueCollectProjects(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectProjects, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+			)
+
+			return nil
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -164,6 +177,7 @@ func collectProjects(ctx context.Context, payload CollectProjectsPayload) error
 	err := projects.ListAvailable(client.Client).
 		EachPage(ctx,
 			func(_ context.Context, page pagination.Page) (bool, error) {
+				metrics.IncPages(ctx)
 				projectList, err := projects.ExtractProjects(page)
 
 				if err != nil {
@@ -0,0 +1,375 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/gophercloud/gophercloud/v2"
+	blockstoragequotas "github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/quotasets"
+	computequotas "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/quotasets"
+	networkquotas "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/quotas"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	"github.com/gardener/inventory/pkg/clients/db"
+	openstackclients "github.com/gardener/inventory/pkg/clients/openstack"
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/metrics"
+	"github.com/gardener/inventory/pkg/openstack/models"
+	openstackutils "github.com/gardener/inventory/pkg/openstack/utils"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+const (
+	// TaskCollectQuotas is the name of the task for collecting OpenStack
+	// project quotas and usage.
+	TaskCollectQuotas = "openstack:task:collect-quotas"
+)
+
+// Names of the OpenStack services from which quotas are collected. The names
+// match the service names used in the OpenStack configuration.
+const (
+	QuotaServiceCompute      = "compute"
+	QuotaServiceBlockStorage = "block_storage"
+	QuotaServiceNetwork      = "network"
+)
+
+// quotaClientsets maps the quota services to the clientsets used for fetching
+// the quotas of the respective service.
+var quotaClientsets = map[string]*registry.Registry[openstackclients.ClientScope, openstackclients.Client[*gophercloud.ServiceClient]]{
+	QuotaServiceCompute:      openstackclients.ComputeClientset,
+	QuotaServiceBlockStorage: openstackclients.BlockStorageClientset,
+	QuotaServiceNetwork:      openstackclients.NetworkClientset,
+}
+
+// CollectQuotasPayload represents the payload, which specifies where to
+// collect OpenStack quotas from.
+type CollectQuotasPayload struct {
+	// Scope specifies the client scope for which to collect.
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The client scope for which to collect"`
+
+	// Service specifies the OpenStack service for which to collect quotas.
+	Service string `json:"service" yaml:"service" desc:"The OpenStack service for which to collect quotas" example:"compute"`
+}
+
+// NewCollectQuotasTask creates a new [asynq.Task] for collecting OpenStack
+// quotas, without specifying a payload.
+func NewCollectQuotasTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectQuotas, nil)
+}
+
+// HandleCollectQuotasTask handles the task for collecting OpenStack quotas.
+func HandleCollectQuotasTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we enqueue tasks for
+	// collecting OpenStack quotas from all configured clients.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectQuotas(ctx)
+	}
+
+	var payload CollectQuotasPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if err := openstackutils.IsValidProjectScope(payload.Scope); err != nil {
+		return asynqutils.SkipRetry(ErrInvalidScope)
+	}
+
+	if _, ok := quotaClientsets[payload.Service]; !ok {
+		return asynqutils.SkipRetry(ErrInvalidQuotaService)
+	}
+
+	return collectQuotas(ctx, payload)
+}
+
+// enqueueCollectQuotas enqueues tasks for collecting OpenStack quotas from all
+// configured OpenStack compute, block storage and network clients by creating
+// a payload with the respective client scope and service.
+func enqueueCollectQuotas(ctx context.Context) error {
+	logger := asynqutils.GetLogger(ctx)
+	queue := asynqutils.GetQueueName(ctx)
+
+	for service, clientset := range quotaClientsets {
+		if clientset.Length() == 0 {
+			logger.Warn("no OpenStack clients found", "service", service)
+
+			continue
+		}
+
+		err := clientset.Range(func(scope openstackclients.ClientScope, _ openstackclients.Client[*gophercloud.ServiceClient]) error {
+			payload := CollectQuotasPayload{
+				Scope:   scope,
+				Service: service,
+			}
+			data, err := json.Marshal(payload)
+			if err != nil {
+				logger.Error(
+					"failed to marshal payload for OpenStack quotas",
+					"service", service,
+					"project", scope.Project,
+					"domain", scope.Domain,
+					"region", scope.Region,
+					"reason", err,
+				)
+
+				return err
+			}
+
+			task := asynq.NewTask(TaskCollectQuotas, data)
+			info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+			if errors.Is(err, asynq.ErrDuplicateTask) {
+				logger.Info(
+					"skipping duplicate task",
+					"type", task.Type(),
+					"service", service,
+					"project", scope.Project,
+					"domain", scope.Domain,
+					"region", scope.Region,
+				)
+
+				return nil
+			}
+
+			if err != nil {
+				logger.Error(
+					"failed to enqueue task",
+					"type", task.Type(),
+					"service", service,
+					"project", scope.Project,
+					"domain", scope.Domain,
+					"region", scope.Region,
+					"reason", err,
+				)
+
+				return err
+			}
+
+			logger.Info(
+				"enqueued task",
+				"type", task.Type(),
+				"id", info.ID,
+				"queue", info.Queue,
+				"service", service,
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+			)
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectQuotas collects the OpenStack quotas and usage for the service
+// specified in the payload, using the client associated with the client scope
+// in the given payload.
+func collectQuotas(ctx context.Context, payload CollectQuotasPayload) error {
+	logger := asynqutils.GetLogger(ctx)
+
+	client, ok := quotaClientsets[payload.Service].Get(payload.Scope)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.Scope.Project))
+	}
+
+	logger.Info(
+		"collecting OpenStack quotas",
+		"service", payload.Service,
+		"project", payload.Scope.Project,
+		"domain", payload.Scope.Domain,
+		"region", payload.Scope.Region,
+	)
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			quotasDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.Scope.Project,
+			payload.Scope.Domain,
+			payload.Scope.Region,
+			payload.Service,
+		)
+		key := metrics.Key(
+			TaskCollectQuotas,
+			payload.Service,
+			payload.Scope.Project,
+			payload.Scope.Domain,
+			payload.Scope.Region,
+		)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	var (
+		items []models.Quota
+		err   error
+	)
+
+	switch payload.Service {
+	case QuotaServiceCompute:
+		items, err = getComputeQuotas(ctx, client)
+	case QuotaServiceBlockStorage:
+		items, err = getBlockStorageQuotas(ctx, client)
+	case QuotaServiceNetwork:
+		items, err = getNetworkQuotas(ctx, client)
+	}
+
+	if err != nil {
+		logger.Error(
+			"could not get quotas",
+			"service", payload.Service,
+			"project", payload.Scope.Project,
+			"domain", payload.Scope.Domain,
+			"region", payload.Scope.Region,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (project_id, domain, region, service, resource) DO UPDATE").
+		Set("quota_limit = EXCLUDED.quota_limit").
+		Set("in_use = EXCLUDED.in_use").
+		Set("reserved = EXCLUDED.reserved").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert quotas into db",
+			"service", payload.Service,
+			"project", payload.Scope.Project,
+			"domain", payload.Scope.Domain,
+			"region", payload.Scope.Region,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated openstack quotas",
+		"service", payload.Service,
+		"project", payload.Scope.Project,
+		"domain", payload.Scope.Domain,
+		"region", payload.Scope.Region,
+		"count", count,
+	)
+
+	return nil
+}
+
+// newQuota returns a new [models.Quota] for the given client, service and
+// resource.
+func newQuota(client openstackclients.Client[*gophercloud.ServiceClient], service, resource string, limit, inUse, reserved int) models.Quota {
+	return models.Quota{
+		ProjectID: client.ProjectID,
+		Domain:    client.Domain,
+		Region:    client.Region,
+		Service:   service,
+		Resource:  resource,
+		Limit:     limit,
+		InUse:     inUse,
+		Reserved:  reserved,
+	}
+}
+
+// getComputeQuotas returns the quotas and usage of the Nova resources for the
+// project of the given client.
+func getComputeQuotas(ctx context.Context, client openstackclients.Client[*gophercloud.ServiceClient]) ([]models.Quota, error) {
+	qs, err := computequotas.GetDetail(ctx, client.Client, client.ProjectID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	details := map[string]computequotas.QuotaDetail{
+		"instances":            qs.Instances,
+		"cores":                qs.Cores,
+		"ram":                  qs.RAM,
+		"key_pairs":            qs.KeyPairs,
+		"server_groups":        qs.ServerGroups,
+		"server_group_members": qs.ServerGroupMembers,
+	}
+
+	items := make([]models.Quota, 0, len(details))
+	for resource, d := range details {
+		items = append(items, newQuota(client, QuotaServiceCompute, resource, d.Limit, d.InUse, d.Reserved))
+	}
+
+	return items, nil
+}
+
+// getBlockStorageQuotas returns the quotas and usage of the Cinder resources
+// for the project of the given client.
+func getBlockStorageQuotas(ctx context.Context, client openstackclients.Client[*gophercloud.ServiceClient]) ([]models.Quota, error) {
+	qs, err := blockstoragequotas.GetUsage(ctx, client.Client, client.ProjectID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	details := map[string]blockstoragequotas.QuotaUsage{
+		"volumes":          qs.Volumes,
+		"gigabytes":        qs.Gigabytes,
+		"snapshots":        qs.Snapshots,
+		"backups":          qs.Backups,
+		"backup_gigabytes": qs.BackupGigabytes,
+	}
+
+	items := make([]models.Quota, 0, len(details))
+	for resource, d := range details {
+		items = append(items, newQuota(client, QuotaServiceBlockStorage, resource, d.Limit, d.InUse, d.Reserved))
+	}
+
+	return items, nil
+}
+
+// getNetworkQuotas returns the quotas and usage of the Neutron resources for
+// the project of the given client.
+func getNetworkQuotas(ctx context.Context, client openstackclients.Client[*gophercloud.ServiceClient]) ([]models.Quota, error) {
+	qs, err := networkquotas.GetDetail(ctx, client.Client, client.ProjectID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	details := map[string]networkquotas.QuotaDetail{
+		"network":             qs.Network,
+		"subnet":              qs.Subnet,
+		"port":                qs.Port,
+		"router":              qs.Router,
+		"floatingip":          qs.FloatingIP,
+		"security_group":      qs.SecurityGroup,
+		"security_group_rule": qs.SecurityGroupRule,
+	}
+
+	items := make([]models.Quota, 0, len(details))
+	for resource, d := range details {
+		items = append(items, newQuota(client, QuotaServiceNetwork, resource, d.Limit, d.Used, d.Reserved))
+	}
+
+	return items, nil
+}
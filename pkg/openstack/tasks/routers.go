@@ -3,6 +3,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net"
 
 	"github.com/gophercloud/gophercloud/v2"
@@ -30,7 +31,7 @@ const (
 // where to collect OpenStack Routers from.
 type CollectRoutersPayload struct {
 	// Scope specifies the client scope for which to collect.
-	Scope openstackclients.ClientScope `json:"scope" yaml:"scope"`
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The client scope for which to collect"`
 }
 
 // NewCollectRoutersTask creates a new [asynq.Task] for collecting OpenStack
@@ -70,6 +71,8 @@ func enqueueCollectRouters(ctx context.Context) error {
 		return nil
 	}
 
+	queue := asynqutils.GetQueueName(ctx)
+
 	return openstackclients.NetworkClientset.Range(func(scope openstackclients.ClientScope, _ openstackclients.Client[*gophercloud.ServiceClient]) error {
 		payload := CollectRoutersPayload{
 			Scope: scope,
@@ -88,7 +91,19 @@ func enqueueCollectRouters(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectRouters, data)
-		info, err := asynqclient.Client.Enqueue(task)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+			)
+
+			return nil
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -162,6 +177,7 @@ func collectRouters(ctx context.Context, payload CollectRoutersPayload) error {
 	err := routers.List(client.Client, opts).
 		EachPage(ctx,
 			func(_ context.Context, page pagination.Page) (bool, error) {
+				metrics.IncPages(ctx)
 				routerList, err := routers.ExtractRouters(page)
 				if err != nil {
 					logger.Error(
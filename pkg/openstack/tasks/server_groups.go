@@ -0,0 +1,320 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servergroups"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
+	"github.com/gardener/inventory/pkg/clients/db"
+	openstackclients "github.com/gardener/inventory/pkg/clients/openstack"
+	"github.com/gardener/inventory/pkg/metrics"
+	"github.com/gardener/inventory/pkg/openstack/models"
+	openstackutils "github.com/gardener/inventory/pkg/openstack/utils"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+const (
+	// TaskCollectServerGroups is the name of the task for collecting
+	// OpenStack server groups.
+	TaskCollectServerGroups = "openstack:task:collect-server-groups"
+)
+
+// CollectServerGroupsPayload represents the payload, which specifies
+// where to collect OpenStack server groups from.
+type CollectServerGroupsPayload struct {
+	// Scope specifies the client scope for which to collect.
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The client scope for which to collect"`
+}
+
+// NewCollectServerGroupsTask creates a new [asynq.Task] for collecting
+// OpenStack server groups, without specifying a payload.
+func NewCollectServerGroupsTask() *asynq.Task {
+	return asynq.NewTask(TaskCollectServerGroups, nil)
+}
+
+// HandleCollectServerGroupsTask handles the task for collecting OpenStack
+// server groups.
+func HandleCollectServerGroupsTask(ctx context.Context, t *asynq.Task) error {
+	// If we were called without a payload, then we enqueue tasks for
+	// collecting OpenStack server groups from all configured compute
+	// clients.
+	data := t.Payload()
+	if data == nil {
+		return enqueueCollectServerGroups(ctx)
+	}
+
+	var payload CollectServerGroupsPayload
+	if err := asynqutils.Unmarshal(data, &payload); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if err := openstackutils.IsValidProjectScope(payload.Scope); err != nil {
+		return asynqutils.SkipRetry(ErrInvalidScope)
+	}
+
+	return collectServerGroups(ctx, payload)
+}
+
+// enqueueCollectServerGroups enqueues tasks for collecting OpenStack
+// server groups from all configured OpenStack compute clients by creating a
+// payload with the respective client scope.
+func enqueueCollectServerGroups(ctx context.Context) error {
+	logger := asynqutils.GetLogger(ctx)
+
+	if openstackclients.ComputeClientset.Length() == 0 {
+		logger.Warn("no OpenStack compute clients found")
+
+		return nil
+	}
+
+	queue := asynqutils.GetQueueName(ctx)
+
+	return openstackclients.ComputeClientset.Range(func(scope openstackclients.ClientScope, _ openstackclients.Client[*gophercloud.ServiceClient]) error {
+		payload := CollectServerGroupsPayload{
+			Scope: scope,
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			logger.Error(
+				"failed to marshal payload for OpenStack server groups",
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+				"reason", err,
+			)
+
+			return err
+		}
+
+		task := asynq.NewTask(TaskCollectServerGroups, data)
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+			)
+
+			return nil
+		}
+
+		if err != nil {
+			logger.Error(
+				"failed to enqueue task",
+				"type", task.Type(),
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+				"reason", err,
+			)
+
+			return err
+		}
+
+		logger.Info(
+			"enqueued task",
+			"type", task.Type(),
+			"id", info.ID,
+			"queue", info.Queue,
+			"project", scope.Project,
+			"domain", scope.Domain,
+			"region", scope.Region,
+		)
+
+		return nil
+	})
+}
+
+// collectServerGroups collects the OpenStack Compute server groups and their
+// members from the project of the client scope in the given payload.
+func collectServerGroups(ctx context.Context, payload CollectServerGroupsPayload) error {
+	logger := asynqutils.GetLogger(ctx)
+
+	client, ok := openstackclients.ComputeClientset.Get(payload.Scope)
+	if !ok {
+		return asynqutils.SkipRetry(ClientNotFound(payload.Scope.Project))
+	}
+
+	logger.Info(
+		"collecting OpenStack server groups",
+		"project", payload.Scope.Project,
+		"domain", payload.Scope.Domain,
+		"region", payload.Scope.Region,
+	)
+
+	var count int64
+	defer func() {
+		metric := prometheus.MustNewConstMetric(
+			serverGroupsDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			payload.Scope.Project,
+			payload.Scope.Domain,
+			payload.Scope.Region,
+		)
+		key := metrics.Key(
+			TaskCollectServerGroups,
+			payload.Scope.Project,
+			payload.Scope.Domain,
+			payload.Scope.Region,
+		)
+		metrics.DefaultCollector.AddMetric(key, metric)
+	}()
+
+	items := make([]models.ServerGroup, 0)
+	members := make([]models.ServerGroupMember, 0)
+	err := servergroups.List(client.Client, servergroups.ListOpts{}).
+		EachPage(ctx,
+			func(_ context.Context, page pagination.Page) (bool, error) {
+				metrics.IncPages(ctx)
+				groupList, err := servergroups.ExtractServerGroups(page)
+
+				if err != nil {
+					logger.Error(
+						"could not extract server group pages",
+						"reason", err,
+					)
+
+					return false, err
+				}
+
+				for _, g := range groupList {
+					item := models.ServerGroup{
+						ServerGroupID: g.ID,
+						ProjectID:     client.ProjectID,
+						Domain:        client.Domain,
+						Region:        client.Region,
+						Name:          g.Name,
+						UserID:        g.UserID,
+					}
+
+					// The single policy field is only returned
+					// with microversion 2.64 or later. Older
+					// versions return a list of policies instead.
+					switch {
+					case g.Policy != nil:
+						item.Policy = *g.Policy
+					case len(g.Policies) > 0:
+						item.Policy = g.Policies[0]
+					}
+
+					if g.Rules != nil {
+						item.MaxServerPerHost = g.Rules.MaxServerPerHost
+					}
+					items = append(items, item)
+
+					for _, serverID := range g.Members {
+						member := models.ServerGroupMember{
+							ServerGroupID: g.ID,
+							ServerID:      serverID,
+							ProjectID:     client.ProjectID,
+						}
+						members = append(members, member)
+					}
+				}
+
+				return true, nil
+			})
+
+	if err != nil {
+		logger.Error(
+			"could not extract server group pages",
+			"reason", err,
+		)
+
+		return err
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	out, err := db.DB.NewInsert().
+		Model(&items).
+		On("CONFLICT (server_group_id, project_id) DO UPDATE").
+		Set("domain = EXCLUDED.domain").
+		Set("region = EXCLUDED.region").
+		Set("name = EXCLUDED.name").
+		Set("user_id = EXCLUDED.user_id").
+		Set("policy = EXCLUDED.policy").
+		Set("max_server_per_host = EXCLUDED.max_server_per_host").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert server groups into db",
+			"project", payload.Scope.Project,
+			"domain", payload.Scope.Domain,
+			"region", payload.Scope.Region,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	count, err = out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated openstack server groups",
+		"project", payload.Scope.Project,
+		"domain", payload.Scope.Domain,
+		"region", payload.Scope.Region,
+		"count", count,
+	)
+
+	if len(members) == 0 {
+		return nil
+	}
+
+	out, err = db.DB.NewInsert().
+		Model(&members).
+		On("CONFLICT (server_group_id, server_id, project_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert server group members into db",
+			"project", payload.Scope.Project,
+			"domain", payload.Scope.Domain,
+			"region", payload.Scope.Region,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	memberCount, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated openstack server group members",
+		"project", payload.Scope.Project,
+		"domain", payload.Scope.Domain,
+		"region", payload.Scope.Region,
+		"count", memberCount,
+	)
+
+	return nil
+}
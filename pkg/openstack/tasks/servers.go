@@ -7,6 +7,7 @@ package tasks
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
@@ -33,7 +34,7 @@ const (
 // where to collect OpenStack Servers from.
 type CollectServersPayload struct {
 	// Scope specifies the client scope for which to collect.
-	Scope openstackclients.ClientScope `json:"scope" yaml:"scope"`
+	Scope openstackclients.ClientScope `json:"scope" yaml:"scope" desc:"The client scope for which to collect"`
 }
 
 // NewCollectServersTask creates a new [asynq.Task] for collecting OpenStack
@@ -95,7 +96,19 @@ func enqueueCollectServers(ctx context.Context) error {
 		}
 
 		task := asynq.NewTask(TaskCollectServers, data)
-		info, err := asynqclient.Client.Enqueue(task, asynq.Queue(queue))
+		info, err := asynqclient.Enqueue(task, asynq.Queue(queue))
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+				"project", scope.Project,
+				"domain", scope.Domain,
+				"region", scope.Region,
+			)
+
+			return nil
+		}
+
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -160,6 +173,7 @@ func collectServers(ctx context.Context, payload CollectServersPayload) error {
 	}()
 
 	items := make([]models.Server, 0)
+	metadata := make([]models.ServerMetadata, 0)
 
 	opts := servers.ListOpts{
 		TenantID: client.ProjectID,
@@ -167,6 +181,7 @@ func collectServers(ctx context.Context, payload CollectServersPayload) error {
 	err := servers.List(client.Client, opts).
 		EachPage(ctx,
 			func(_ context.Context, page pagination.Page) (bool, error) {
+				metrics.IncPages(ctx)
 				serverList, err := servers.ExtractServers(page)
 
 				if err != nil {
@@ -188,6 +203,7 @@ func collectServers(ctx context.Context, payload CollectServersPayload) error {
 						UserID:           s.UserID,
 						AvailabilityZone: s.AvailabilityZone,
 						Status:           s.Status,
+						KeyName:          s.KeyName,
 						TimeCreated:      s.Created,
 						TimeUpdated:      s.Updated,
 					}
@@ -201,6 +217,15 @@ func collectServers(ctx context.Context, payload CollectServersPayload) error {
 					}
 
 					items = append(items, item)
+
+					for k, v := range s.Metadata {
+						metadata = append(metadata, models.ServerMetadata{
+							ServerID:  s.ID,
+							ProjectID: s.TenantID,
+							Key:       k,
+							Value:     v,
+						})
+					}
 				}
 
 				return true, nil
@@ -229,6 +254,7 @@ func collectServers(ctx context.Context, payload CollectServersPayload) error {
 		Set("availability_zone = EXCLUDED.availability_zone").
 		Set("status = EXCLUDED.status").
 		Set("image_id = EXCLUDED.image_id").
+		Set("key_name = EXCLUDED.key_name").
 		Set("server_created_at = EXCLUDED.server_created_at").
 		Set("server_updated_at = EXCLUDED.server_updated_at").
 		Set("updated_at = EXCLUDED.updated_at").
@@ -260,5 +286,42 @@ func collectServers(ctx context.Context, payload CollectServersPayload) error {
 		"count", count,
 	)
 
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	out, err = db.DB.NewInsert().
+		Model(&metadata).
+		On("CONFLICT (server_id, project_id, key) DO UPDATE").
+		Set("value = EXCLUDED.value").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error(
+			"could not insert server metadata into db",
+			"project", payload.Scope.Project,
+			"domain", payload.Scope.Domain,
+			"region", payload.Scope.Region,
+			"reason", err,
+		)
+
+		return err
+	}
+
+	metadataCount, err := out.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"populated openstack server metadata",
+		"project", payload.Scope.Project,
+		"domain", payload.Scope.Domain,
+		"region", payload.Scope.Region,
+		"count", metadataCount,
+	)
+
 	return nil
 }
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"slices"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/hibiken/asynq"
+
+	openstackclients "github.com/gardener/inventory/pkg/clients/openstack"
+	"github.com/gardener/inventory/pkg/core/registry"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+// newShootCollectTasks returns the tasks for collecting the OpenStack
+// resources of the Gardener Shoot described by the given scope. The resources
+// are collected using the clients for the region of the Shoot. If no projects
+// are known for the Shoot, then the resources are collected from all
+// registered projects in the region of the Shoot.
+func newShootCollectTasks(shoot registry.ShootScope) ([]*asynq.Task, error) {
+	matches := func(scope openstackclients.ClientScope) bool {
+		if scope.Region != shoot.Region {
+			return false
+		}
+
+		return len(shoot.Accounts) == 0 || slices.Contains(shoot.Accounts, scope.ProjectID)
+	}
+
+	clientsets := []struct {
+		clientset *registry.Registry[openstackclients.ClientScope, openstackclients.Client[*gophercloud.ServiceClient]]
+		payloadFn func(scope openstackclients.ClientScope) map[string]any
+	}{
+		{
+			clientset: openstackclients.ComputeClientset,
+			payloadFn: func(scope openstackclients.ClientScope) map[string]any {
+				return map[string]any{
+					TaskCollectServers: CollectServersPayload{Scope: scope},
+				}
+			},
+		},
+		{
+			clientset: openstackclients.NetworkClientset,
+			payloadFn: func(scope openstackclients.ClientScope) map[string]any {
+				return map[string]any{
+					TaskCollectNetworks:    CollectNetworksPayload{Scope: scope},
+					TaskCollectSubnets:     CollectSubnetsPayload{Scope: scope},
+					TaskCollectPorts:       CollectPortsPayload{Scope: scope},
+					TaskCollectFloatingIPs: CollectFloatingIPsPayload{Scope: scope},
+				}
+			},
+		},
+		{
+			clientset: openstackclients.LoadBalancerClientset,
+			payloadFn: func(scope openstackclients.ClientScope) map[string]any {
+				return map[string]any{
+					TaskCollectLoadBalancers: CollectLoadBalancersPayload{Scope: scope},
+				}
+			},
+		},
+		{
+			clientset: openstackclients.BlockStorageClientset,
+			payloadFn: func(scope openstackclients.ClientScope) map[string]any {
+				return map[string]any{
+					TaskCollectVolumes: CollectVolumesPayload{Scope: scope},
+				}
+			},
+		},
+	}
+
+	items := make([]*asynq.Task, 0)
+	for _, item := range clientsets {
+		err := item.clientset.Range(func(scope openstackclients.ClientScope, _ openstackclients.Client[*gophercloud.ServiceClient]) error {
+			if !matches(scope) {
+				return nil
+			}
+
+			for name, payload := range item.payloadFn(scope) {
+				task, err := asynqutils.NewTask(name, payload)
+				if err != nil {
+					return err
+				}
+				items = append(items, task)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
+
+func init() {
+	registry.ShootCollectorRegistry.MustRegister("openstack", registry.ShootCollector{
+		ProviderType: "openstack",
+		NewTasks:     newShootCollectTasks,
+		LinkTasks:    []string{TaskLinkAll},
+	})
+}
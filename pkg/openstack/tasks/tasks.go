@@ -10,9 +10,11 @@ import (
 	"github.com/hibiken/asynq"
 
 	"github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/core/config"
 	"github.com/gardener/inventory/pkg/core/registry"
 	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
 	dbutils "github.com/gardener/inventory/pkg/utils/db"
+	"github.com/gardener/inventory/pkg/utils/schema"
 )
 
 const (
@@ -44,11 +46,46 @@ func HandleCollectAllTask(ctx context.Context, _ *asynq.Task) error {
 		NewCollectPoolsTask,
 		NewCollectContainersTask,
 		NewCollectVolumesTask,
+		NewCollectFlavorsTask,
+		NewCollectQuotasTask,
+		NewCollectAvailabilityZonesTask,
+		NewCollectHostAggregatesTask,
+		NewCollectServerGroupsTask,
 	}
 
+	// Collectors of disabled services are skipped
+	conf := asynqutils.GetConfig(ctx)
+	taskFns = asynqutils.SkipDisabledTasks(ctx, taskFns, ServiceCollectors(conf.OpenStack.Services))
+
 	return asynqutils.Enqueue(ctx, taskFns, asynq.Queue(queue))
 }
 
+// ServiceCollectors returns a mapping between the names of the OpenStack
+// collector tasks and whether the OpenStack service the collector depends on is
+// enabled.
+func ServiceCollectors(services config.OpenStackServices) map[string]bool {
+	return map[string]bool{
+		TaskCollectServers:           services.Compute.Enabled(),
+		TaskCollectFlavors:           services.Compute.Enabled(),
+		TaskCollectHostAggregates:    services.Compute.Enabled(),
+		TaskCollectServerGroups:      services.Compute.Enabled(),
+		TaskCollectAvailabilityZones: services.Compute.Enabled(),
+		TaskCollectQuotas:            services.Compute.Enabled(),
+		TaskCollectNetworks:          services.Network.Enabled(),
+		TaskCollectSubnets:           services.Network.Enabled(),
+		TaskCollectPorts:             services.Network.Enabled(),
+		TaskCollectFloatingIPs:       services.Network.Enabled(),
+		TaskCollectRouters:           services.Network.Enabled(),
+		TaskCollectContainers:        services.ObjectStorage.Enabled(),
+		TaskCollectObjects:           services.ObjectStorage.Enabled(),
+		TaskCollectLoadBalancers:     services.LoadBalancer.Enabled(),
+		TaskCollectPools:             services.LoadBalancer.Enabled(),
+		TaskCollectPoolMembers:       services.LoadBalancer.Enabled(),
+		TaskCollectProjects:          services.Identity.Enabled(),
+		TaskCollectVolumes:           services.BlockStorage.Enabled(),
+	}
+}
+
 // HandleLinkAllTask is a handler, which establishes links between the various
 // OpenStack models.
 func HandleLinkAllTask(ctx context.Context, _ *asynq.Task) error {
@@ -62,6 +99,8 @@ func HandleLinkAllTask(ctx context.Context, _ *asynq.Task) error {
 		LinkLoadBalancersWithNetworks,
 		LinkNetworksWithProjects,
 		LinkSubnetsWithProjects,
+		LinkServersWithAvailabilityZones,
+		LinkServersWithServerGroups,
 	}
 
 	return dbutils.LinkObjects(ctx, db.DB, linkFns)
@@ -81,8 +120,61 @@ func init() {
 	registry.TaskRegistry.MustRegister(TaskCollectObjects, asynq.HandlerFunc(HandleCollectObjectsTask))
 	registry.TaskRegistry.MustRegister(TaskCollectPools, asynq.HandlerFunc(HandleCollectPoolsTask))
 	registry.TaskRegistry.MustRegister(TaskCollectPoolMembers, asynq.HandlerFunc(HandleCollectPoolMembersTask))
+	registry.TaskRegistry.MustRegister(TaskAggregatePoolMembers, asynq.HandlerFunc(HandleAggregatePoolMembersTask))
 	registry.TaskRegistry.MustRegister(TaskCollectContainers, asynq.HandlerFunc(HandleCollectContainersTask))
 	registry.TaskRegistry.MustRegister(TaskCollectVolumes, asynq.HandlerFunc(HandleCollectVolumesTask))
+	registry.TaskRegistry.MustRegister(TaskCollectFlavors, asynq.HandlerFunc(HandleCollectFlavorsTask))
+	registry.TaskRegistry.MustRegister(TaskCollectQuotas, asynq.HandlerFunc(HandleCollectQuotasTask))
+	registry.TaskRegistry.MustRegister(TaskCollectAvailabilityZones, asynq.HandlerFunc(HandleCollectAvailabilityZonesTask))
+	registry.TaskRegistry.MustRegister(TaskCollectHostAggregates, asynq.HandlerFunc(HandleCollectHostAggregatesTask))
+	registry.TaskRegistry.MustRegister(TaskCollectServerGroups, asynq.HandlerFunc(HandleCollectServerGroupsTask))
 	registry.TaskRegistry.MustRegister(TaskCollectAll, asynq.HandlerFunc(HandleCollectAllTask))
 	registry.TaskRegistry.MustRegister(TaskLinkAll, asynq.HandlerFunc(HandleLinkAllTask))
+
+	// Group aggregators
+	registry.GroupAggregatorRegistry.MustRegister(TaskCollectPoolMembers, asynq.GroupAggregatorFunc(AggregatePoolMembersTasks))
+
+	// Payload schemas
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectServers, schema.For[CollectServersPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectNetworks, schema.For[CollectNetworksPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectLoadBalancers, schema.For[CollectLoadBalancersPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectSubnets, schema.For[CollectSubnetsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectFloatingIPs, schema.For[CollectFloatingIPsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectProjects, schema.For[CollectProjectsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectRouters, schema.For[CollectRoutersPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectPorts, schema.For[CollectPortsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectObjects, schema.For[CollectObjectsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectPools, schema.For[CollectPoolsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectPoolMembers, schema.For[CollectPoolMembersPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskAggregatePoolMembers, schema.For[AggregatePoolMembersPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectContainers, schema.For[CollectContainersPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectVolumes, schema.For[CollectVolumesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectFlavors, schema.For[CollectFlavorsPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectQuotas, schema.For[CollectQuotasPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectAvailabilityZones, schema.For[CollectAvailabilityZonesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectHostAggregates, schema.For[CollectHostAggregatesPayload]())
+	registry.PayloadSchemaRegistry.MustRegister(TaskCollectServerGroups, schema.For[CollectServerGroupsPayload]())
+
+	// Task descriptions
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectServers, "Collects OpenStack servers.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectNetworks, "Collects OpenStack Networks.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectLoadBalancers, "Collects OpenStack LoadBalancers.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectSubnets, "Collects OpenStack Subnets.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectFloatingIPs, "Collects OpenStack Floating IPs.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectProjects, "Collects OpenStack Projects.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectRouters, "Collects OpenStack Routers.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectPorts, "Collects OpenStack Ports.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectObjects, "Collects OpenStack Objects.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectPools, "Collects OpenStack Pools.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectPoolMembers, "Collects OpenStack Pool Members for a specific pool.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskAggregatePoolMembers, "Collects OpenStack Pool Members for multiple pools at once.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectContainers, "Collects OpenStack Containers.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectVolumes, "Collects OpenStack Volumes.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectFlavors, "Collects OpenStack flavors.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectQuotas, "Collects OpenStack project quotas and usage.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectAvailabilityZones, "Collects OpenStack availability zones.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectHostAggregates, "Collects OpenStack host aggregates.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectServerGroups, "Collects OpenStack server groups.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskCollectAll, "Enqueues all relevant OpenStack tasks.")
+	registry.TaskDescriptionRegistry.MustRegister(TaskLinkAll, "Creates links between the OpenStack models.")
 }
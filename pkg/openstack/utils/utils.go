@@ -6,6 +6,8 @@ package utils
 
 import (
 	"errors"
+	"slices"
+	"strings"
 
 	openstackclients "github.com/gardener/inventory/pkg/clients/openstack"
 	"github.com/gardener/inventory/pkg/openstack/models"
@@ -72,3 +74,35 @@ func MatchScopeToProject(scope openstackclients.ClientScope, projects []models.P
 
 	return models.Project{}, ErrNoProjectMatchingScope
 }
+
+// ContainerACL represents the parsed read ACL of a Swift container.
+type ContainerACL struct {
+	// Entries specifies the non-empty entries of the ACL.
+	Entries []string
+
+	// PublicRead specifies whether the objects of the container may be
+	// read by anyone, i.e. the ACL contains the `.r:*' referrer.
+	PublicRead bool
+
+	// PublicListing specifies whether the container may be listed by
+	// anyone, i.e. the ACL contains `.rlistings' along with `.r:*'.
+	PublicListing bool
+}
+
+// ParseContainerACL parses the given entries of a Swift container ACL, as
+// returned by the `X-Container-Read', or `X-Container-Write' headers.
+func ParseContainerACL(values []string) ContainerACL {
+	var result ContainerACL
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		result.Entries = append(result.Entries, v)
+	}
+
+	result.PublicRead = slices.Contains(result.Entries, ".r:*")
+	result.PublicListing = result.PublicRead && slices.Contains(result.Entries, ".rlistings")
+
+	return result
+}
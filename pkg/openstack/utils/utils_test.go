@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/openstack/utils"
+)
+
+func TestParseContainerACL(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		values []string
+		wanted utils.ContainerACL
+	}{
+		{
+			desc:   "empty header",
+			values: []string{""},
+			wanted: utils.ContainerACL{},
+		},
+		{
+			desc:   "project acl",
+			values: []string{"project-id:user-id", " project-id:*"},
+			wanted: utils.ContainerACL{
+				Entries: []string{"project-id:user-id", "project-id:*"},
+			},
+		},
+		{
+			desc:   "public read",
+			values: []string{".r:*"},
+			wanted: utils.ContainerACL{
+				Entries:    []string{".r:*"},
+				PublicRead: true,
+			},
+		},
+		{
+			desc:   "public read and listing",
+			values: []string{".r:*", ".rlistings"},
+			wanted: utils.ContainerACL{
+				Entries:       []string{".r:*", ".rlistings"},
+				PublicRead:    true,
+				PublicListing: true,
+			},
+		},
+		{
+			desc:   "listing without public read",
+			values: []string{".r:.example.com", ".rlistings"},
+			wanted: utils.ContainerACL{
+				Entries: []string{".r:.example.com", ".rlistings"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := utils.ParseContainerACL(tc.values)
+			if !slices.Equal(got.Entries, tc.wanted.Entries) {
+				t.Fatalf("got entries %v wanted %v", got.Entries, tc.wanted.Entries)
+			}
+
+			if got.PublicRead != tc.wanted.PublicRead {
+				t.Fatalf("got public read %v wanted %v", got.PublicRead, tc.wanted.PublicRead)
+			}
+
+			if got.PublicListing != tc.wanted.PublicListing {
+				t.Fatalf("got public listing %v wanted %v", got.PublicListing, tc.wanted.PublicListing)
+			}
+		})
+	}
+}
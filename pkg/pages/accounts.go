@@ -0,0 +1,340 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pages
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	auxmodels "github.com/gardener/inventory/pkg/auxiliary/models"
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// ErrAccountNotFound is an error, which is returned when the requested
+// provider account does not exist.
+var ErrAccountNotFound = errors.New("account not found")
+
+// maxTopTypes specifies the max number of resource types listed per account
+// on the account index page.
+const maxTopTypes = 3
+
+// accountProviders specifies the providers, whose accounts are rendered, i.e.
+// AWS accounts, GCP projects, Azure subscriptions and OpenStack projects.
+var accountProviders = []string{"aws", "gcp", "az", "openstack"}
+
+// ResourceCount represents the number of records of a model.
+type ResourceCount struct {
+	// Model specifies the name of the model, or the name of the view for
+	// orphan resources.
+	Model string `bun:"model_name"`
+
+	// Count specifies the number of records.
+	Count int64 `bun:"count"`
+}
+
+// AccountSummary represents the summary of a provider account, which is
+// collected in the `aux_account' table.
+type AccountSummary struct {
+	// Provider specifies the provider of the account.
+	Provider string `bun:"provider"`
+
+	// AccountID specifies the provider-specific ID of the account.
+	AccountID string `bun:"account_id"`
+
+	// DisplayName specifies the human-friendly name of the account.
+	DisplayName string `bun:"display_name"`
+
+	// Resources specifies the number of resources linked with the
+	// account.
+	Resources int64 `bun:"resources"`
+
+	// Orphans specifies the number of orphan resources of the account, as
+	// reported by the orphan views of the provider.
+	Orphans int64 `bun:"-"`
+
+	// LastCollectedAt specifies when a task was last successfully
+	// executed for the account. It is zero, if no task was recorded.
+	LastCollectedAt time.Time `bun:"last_collected_at,nullzero"`
+
+	// TopTypes specifies the resource types with the most records.
+	TopTypes []ResourceCount `bun:"-"`
+}
+
+// ClassifiedCount represents the number of records of a model, which are
+// linked with an account, by classification.
+type ClassifiedCount struct {
+	// Model specifies the name of the model.
+	Model string
+
+	// Total specifies the total number of records.
+	Total int64
+
+	// Classifications specifies the number of records by classification,
+	// e.g. gardener_managed.
+	Classifications map[string]int64
+}
+
+// AccountDetails represents the overview of a single provider account.
+type AccountDetails struct {
+	// Summary specifies the summary of the account.
+	Summary AccountSummary
+
+	// Types specifies the linked resources by type, ordered by number of
+	// records.
+	Types []ClassifiedCount
+
+	// Collections specifies the last successful executions of the tasks
+	// for the account.
+	Collections []auxmodels.CollectionState
+
+	// Orphans specifies the number of orphan resources by view.
+	Orphans []ResourceCount
+}
+
+// orphanView represents a view reporting orphan resources of a provider.
+type orphanView struct {
+	// Provider specifies the provider of the resources.
+	Provider string
+
+	// Name specifies the name of the view.
+	Name string
+
+	// Column specifies the column of the view, which references the
+	// account.
+	Column string
+}
+
+// TopResourceTypes returns at most n of the given counts with the most
+// records, ordered by number of records and model name.
+func TopResourceTypes(counts []ResourceCount, n int) []ResourceCount {
+	counts = slices.Clone(counts)
+	slices.SortFunc(counts, func(a, b ResourceCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Model, b.Model))
+	})
+	if len(counts) > n {
+		counts = counts[:n]
+	}
+
+	return counts
+}
+
+// ListAccountSummaries returns the summaries of the accounts of the AWS, GCP,
+// Azure and OpenStack providers, ordered by provider and name.
+func ListAccountSummaries(ctx context.Context, db bun.IDB) ([]AccountSummary, error) {
+	items := make([]AccountSummary, 0)
+	err := newAccountSummaryQuery(db).
+		Where("a.provider IN (?)", bun.In(accountProviders)).
+		Order("a.provider", "a.display_name", "a.account_id").
+		Scan(ctx, &items)
+	if err != nil {
+		return nil, err
+	}
+
+	type typeCount struct {
+		ResourceCount
+		Provider  string `bun:"provider"`
+		AccountID string `bun:"account_id"`
+	}
+	counts := make([]typeCount, 0)
+	err = db.NewSelect().
+		TableExpr("l_aux_account_to_resource AS l").
+		Join("INNER JOIN aux_account AS a ON a.id = l.account_id").
+		ColumnExpr("a.provider, a.account_id, l.model_name, COUNT(*) AS count").
+		Where("a.provider IN (?)", bun.In(accountProviders)).
+		GroupExpr("1, 2, 3").
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+
+	countsByAccount := make(map[string][]ResourceCount)
+	for _, c := range counts {
+		key := c.Provider + "/" + c.AccountID
+		countsByAccount[key] = append(countsByAccount[key], c.ResourceCount)
+	}
+
+	orphans, err := countOrphans(ctx, db, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for i, item := range items {
+		key := item.Provider + "/" + item.AccountID
+		items[i].TopTypes = TopResourceTypes(countsByAccount[key], maxTopTypes)
+		for _, c := range orphans[key] {
+			items[i].Orphans += c.Count
+		}
+	}
+
+	return items, nil
+}
+
+// LoadAccountDetails returns the overview of the account with the given
+// provider and provider-specific ID.
+func LoadAccountDetails(ctx context.Context, db bun.IDB, provider, accountID string) (*AccountDetails, error) {
+	if !slices.Contains(accountProviders, provider) {
+		return nil, ErrAccountNotFound
+	}
+
+	details := &AccountDetails{
+		Types:       make([]ClassifiedCount, 0),
+		Collections: make([]auxmodels.CollectionState, 0),
+		Orphans:     make([]ResourceCount, 0),
+	}
+	err := newAccountSummaryQuery(db).
+		Where("a.provider = ?", provider).
+		Where("a.account_id = ?", accountID).
+		Scan(ctx, &details.Summary)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, ErrAccountNotFound
+	case err != nil:
+		return nil, err
+	}
+
+	type classifiedCount struct {
+		ResourceCount
+		Classification string `bun:"classification"`
+	}
+	classified := make([]classifiedCount, 0)
+	err = db.NewSelect().
+		TableExpr("l_aux_account_to_resource AS l").
+		Join("INNER JOIN aux_account AS a ON a.id = l.account_id").
+		ColumnExpr("l.model_name, l.classification, COUNT(*) AS count").
+		Where("a.provider = ?", provider).
+		Where("a.account_id = ?", accountID).
+		GroupExpr("1, 2").
+		Scan(ctx, &classified)
+	if err != nil {
+		return nil, err
+	}
+
+	byModel := make(map[string]*ClassifiedCount)
+	for _, c := range classified {
+		item, ok := byModel[c.Model]
+		if !ok {
+			item = &ClassifiedCount{
+				Model:           c.Model,
+				Classifications: make(map[string]int64),
+			}
+			byModel[c.Model] = item
+		}
+		item.Total += c.Count
+		item.Classifications[c.Classification] += c.Count
+	}
+	for _, item := range byModel {
+		details.Types = append(details.Types, *item)
+	}
+	slices.SortFunc(details.Types, func(a, b ClassifiedCount) int {
+		return cmp.Or(cmp.Compare(b.Total, a.Total), cmp.Compare(a.Model, b.Model))
+	})
+
+	err = db.NewSelect().
+		Model(&details.Collections).
+		Where("account_id = ?", accountID).
+		Order("task_name").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orphans, err := countOrphans(ctx, db, accountID)
+	if err != nil {
+		return nil, err
+	}
+	counts := orphans[provider+"/"+accountID]
+	details.Orphans = TopResourceTypes(counts, len(counts))
+	for _, c := range details.Orphans {
+		details.Summary.Orphans += c.Count
+	}
+
+	return details, nil
+}
+
+// newAccountSummaryQuery returns the query for the [AccountSummary] items
+// without the top resource types and the orphan counts.
+func newAccountSummaryQuery(db bun.IDB) *bun.SelectQuery {
+	return db.NewSelect().
+		TableExpr("aux_account AS a").
+		ColumnExpr("a.provider, a.account_id, a.display_name").
+		ColumnExpr("(SELECT COUNT(*) FROM l_aux_account_to_resource AS l WHERE l.account_id = a.id) AS resources").
+		ColumnExpr("(SELECT MAX(s.last_success_at) FROM aux_collection_state AS s WHERE s.account_id = a.account_id) AS last_collected_at")
+}
+
+// listOrphanViews returns the orphan views of the AWS, GCP, Azure and OpenStack
+// providers, i.e. the views named `<provider>_orphan_<resource>', which
+// reference the account via the ID column of the account source of the
+// provider.
+func listOrphanViews(ctx context.Context, db bun.IDB) ([]orphanView, error) {
+	result := make([]orphanView, 0)
+	for _, provider := range accountProviders {
+		src, ok := registry.AccountSourceRegistry.Get(provider)
+		if !ok {
+			continue
+		}
+
+		names := make([]string, 0)
+		err := db.NewSelect().
+			TableExpr("information_schema.columns").
+			ColumnExpr("table_name").
+			Where("table_schema = current_schema()").
+			Where("table_name LIKE ?", strings.ReplaceAll(provider, "_", `\_`)+`\_orphan\_%`).
+			Where("column_name = ?", src.IDColumn).
+			Order("table_name").
+			Scan(ctx, &names)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range names {
+			result = append(result, orphanView{Provider: provider, Name: name, Column: src.IDColumn})
+		}
+	}
+
+	return result, nil
+}
+
+// countOrphans returns the number of orphan resources by view, keyed by
+// provider and account ID. If the account ID is not empty, only the orphan
+// resources of the given account are counted.
+func countOrphans(ctx context.Context, db bun.IDB, accountID string) (map[string][]ResourceCount, error) {
+	views, err := listOrphanViews(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]ResourceCount)
+	for _, view := range views {
+		type orphanCount struct {
+			AccountID string `bun:"account_id"`
+			Count     int64  `bun:"count"`
+		}
+		counts := make([]orphanCount, 0)
+		query := db.NewSelect().
+			TableExpr("?", bun.Ident(view.Name)).
+			ColumnExpr("?::text AS account_id, COUNT(*) AS count", bun.Ident(view.Column)).
+			GroupExpr("1")
+		if accountID != "" {
+			query = query.Where("? = ?", bun.Ident(view.Column), accountID)
+		}
+		if err := query.Scan(ctx, &counts); err != nil {
+			return nil, err
+		}
+
+		for _, c := range counts {
+			key := view.Provider + "/" + c.AccountID
+			result[key] = append(result[key], ResourceCount{Model: view.Name, Count: c.Count})
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pages_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/pages"
+)
+
+func TestTopResourceTypes(t *testing.T) {
+	counts := []pages.ResourceCount{
+		{Model: "aws:model:subnet", Count: 5},
+		{Model: "aws:model:instance", Count: 10},
+		{Model: "aws:model:vpc", Count: 1},
+		{Model: "aws:model:bucket", Count: 5},
+	}
+
+	testCases := []struct {
+		desc string
+		n    int
+		want []string
+	}{
+		{
+			desc: "top two",
+			n:    2,
+			want: []string{"aws:model:instance", "aws:model:bucket"},
+		},
+		{
+			desc: "more than available",
+			n:    10,
+			want: []string{"aws:model:instance", "aws:model:bucket", "aws:model:subnet", "aws:model:vpc"},
+		},
+		{
+			desc: "none",
+			n:    0,
+			want: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := make([]string, 0)
+			for _, c := range pages.TopResourceTypes(counts, tc.n) {
+				got = append(got, c.Model)
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Fatalf("got %v, wanted %v", got, tc.want)
+			}
+		})
+	}
+
+	if counts[0].Model != "aws:model:subnet" {
+		t.Fatalf("got %q as first item, wanted the given counts to be unchanged", counts[0].Model)
+	}
+}
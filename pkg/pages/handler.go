@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pages provides the HTML pages of the Dashboard, which render the
+// collected resources for humans.
+package pages
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/gardener/models"
+)
+
+// ShootsPrefix is the path prefix of the Shoot pages.
+const ShootsPrefix = "/shoots/"
+
+// AccountsPrefix is the path prefix of the provider account pages.
+const AccountsPrefix = "/accounts/"
+
+// maxShoots specifies the max number of Shoots listed on the index page.
+const maxShoots = 500
+
+//go:embed templates/*.html
+var templatesFS embed.FS
+
+// templates contains the parsed page templates.
+var templates = template.Must(template.New("pages").Funcs(funcs).ParseFS(templatesFS, "templates/*.html"))
+
+// funcs contains the functions available to the page templates.
+var funcs = template.FuncMap{
+	"timestamp": func(t time.Time) string {
+		if t.IsZero() {
+			return "N/A"
+		}
+
+		return t.UTC().Format(time.RFC3339)
+	},
+}
+
+// indexPage represents the data of the Shoot index page.
+type indexPage struct {
+	Query     string
+	Shoots    []models.Shoot
+	Truncated bool
+}
+
+// shootPage represents the data of the Shoot detail page.
+type shootPage struct {
+	Tree *Node
+}
+
+// accountsPage represents the data of the account index page.
+type accountsPage struct {
+	Accounts []AccountSummary
+}
+
+// accountPage represents the data of the account detail page.
+type accountPage struct {
+	Details *AccountDetails
+}
+
+// NewHandler returns an [http.Handler], which serves the Shoot and provider
+// account pages using the given database.
+//
+// The following pages are provided.
+//
+//   - GET /shoots/?q={filter}
+//   - GET /shoots/{technical_id}?landscape={landscape}
+//   - GET /accounts/
+//   - GET /accounts/{provider}/{account_id}
+func NewHandler(db bun.IDB) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+ShootsPrefix+"{$}", func(w http.ResponseWriter, r *http.Request) {
+		page := indexPage{
+			Query:  r.URL.Query().Get("q"),
+			Shoots: make([]models.Shoot, 0),
+		}
+		query := db.NewSelect().
+			Model(&page.Shoots).
+			Order("project_name", "name", "landscape").
+			Limit(maxShoots + 1)
+		if page.Query != "" {
+			pattern := "%" + page.Query + "%"
+			query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.Where("name ILIKE ?", pattern).
+					WhereOr("project_name ILIKE ?", pattern).
+					WhereOr("technical_id ILIKE ?", pattern)
+			})
+		}
+
+		if err := query.Scan(r.Context()); err != nil {
+			writeError(w, err)
+
+			return
+		}
+		if len(page.Shoots) > maxShoots {
+			page.Shoots = page.Shoots[:maxShoots]
+			page.Truncated = true
+		}
+
+		writeHTML(w, http.StatusOK, "index.html", page)
+	})
+
+	mux.HandleFunc("GET "+ShootsPrefix+"{technical_id}", func(w http.ResponseWriter, r *http.Request) {
+		tree, err := LoadShootTree(r.Context(), db, r.PathValue("technical_id"), r.URL.Query().Get("landscape"))
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		writeHTML(w, http.StatusOK, "shoot.html", shootPage{Tree: tree})
+	})
+
+	mux.HandleFunc("GET "+AccountsPrefix+"{$}", func(w http.ResponseWriter, r *http.Request) {
+		items, err := ListAccountSummaries(r.Context(), db)
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		writeHTML(w, http.StatusOK, "accounts.html", accountsPage{Accounts: items})
+	})
+
+	mux.HandleFunc("GET "+AccountsPrefix+"{provider}/{account_id}", func(w http.ResponseWriter, r *http.Request) {
+		details, err := LoadAccountDetails(r.Context(), db, r.PathValue("provider"), r.PathValue("account_id"))
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		writeHTML(w, http.StatusOK, "account.html", accountPage{Details: details})
+	})
+
+	return mux
+}
+
+// writeHTML renders the given template with the given data.
+func writeHTML(w http.ResponseWriter, status int, name string, data any) {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, data); err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = buf.WriteTo(w)
+}
+
+// writeError writes the response for the given error. Unexpected errors are
+// logged and not exposed to the client.
+func writeError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrShootNotFound) || errors.Is(err, ErrAccountNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+
+		return
+	}
+
+	slog.Error("failed to render page", "reason", err)
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
@@ -0,0 +1,422 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pages
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/gardener/models"
+)
+
+// Kinds of the nodes of a resource tree, in addition to the kinds of the cloud
+// resources resolved for Gardener Shoots, e.g. [registry.ShootResourceKindInstance].
+const (
+	KindShoot   = "shoot"
+	KindSeed    = "seed"
+	KindMachine = "machine"
+	KindNode    = "node"
+	KindIP      = "ip"
+)
+
+// ErrShootNotFound is an error, which is returned when the requested Gardener
+// Shoot does not exist.
+var ErrShootNotFound = errors.New("shoot not found")
+
+// Attribute represents a named value of a [Node].
+type Attribute struct {
+	// Name specifies the name of the attribute.
+	Name string
+
+	// Value specifies the value of the attribute.
+	Value string
+}
+
+// Node represents a node of the resource tree of a Gardener Shoot.
+type Node struct {
+	// Kind specifies the kind of the node, e.g. machine or instance.
+	Kind string
+
+	// Name specifies the name of the node.
+	Name string
+
+	// Model specifies the name of the model of the underlying record, if
+	// any.
+	Model string
+
+	// ID specifies the ID of the underlying record, if any.
+	ID string
+
+	// Attributes specifies additional details of the node.
+	Attributes []Attribute
+
+	// Children specifies the child nodes.
+	Children []*Node
+}
+
+// Count returns the number of descendant nodes of the given kind.
+func (n *Node) Count(kind string) int {
+	count := 0
+	for _, child := range n.Children {
+		if child.Kind == kind {
+			count++
+		}
+		count += child.Count(kind)
+	}
+
+	return count
+}
+
+// Summary returns the number of descendant nodes by kind, ordered by kind.
+func (n *Node) Summary() []Attribute {
+	kinds := make([]string, 0)
+	var walk func(node *Node)
+	walk = func(node *Node) {
+		for _, child := range node.Children {
+			if !slices.Contains(kinds, child.Kind) {
+				kinds = append(kinds, child.Kind)
+			}
+			walk(child)
+		}
+	}
+	walk(n)
+	slices.Sort(kinds)
+
+	result := make([]Attribute, 0, len(kinds))
+	for _, kind := range kinds {
+		result = append(result, Attribute{Name: kind, Value: strconv.Itoa(n.Count(kind))})
+	}
+
+	return result
+}
+
+// Resource represents a cloud resource, which has been resolved for a Gardener
+// Shoot via the `l_aux_shoot_to_resource' table.
+type Resource struct {
+	// Model specifies the name of the model of the resource.
+	Model string `bun:"model_name"`
+
+	// ID specifies the ID of the record of the resource.
+	ID string `bun:"resource_id"`
+
+	// Kind specifies the kind of the resource, e.g. instance.
+	Kind string `bun:"kind"`
+
+	// Confidence specifies the confidence level of the mapping.
+	Confidence string `bun:"confidence"`
+
+	// Name specifies the name of the resource, if known.
+	Name string `bun:"-"`
+
+	// InstanceID specifies the provider-specific ID of instances, which is
+	// used for matching them with the Gardener Machines.
+	InstanceID string `bun:"-"`
+}
+
+// PublicIP represents a public IP address of a Gardener Shoot, as provided by
+// the `aux_public_exposure' view.
+type PublicIP struct {
+	// Model specifies the name of the model holding the address.
+	Model string `bun:"model_name"`
+
+	// ID specifies the ID of the record holding the address.
+	ID string `bun:"resource_id"`
+
+	// Address specifies the public IP address.
+	Address string `bun:"public_ip"`
+
+	// OwnerModel specifies the name of the model of the resource using
+	// the address, if known.
+	OwnerModel string `bun:"owner_model_name"`
+
+	// OwnerID specifies the ID of the resource using the address, if
+	// known.
+	OwnerID string `bun:"owner_id"`
+}
+
+// LoadShootTree returns the resource tree of the Gardener Shoot with the given
+// technical ID. If the landscape is empty, the Shoot of the first landscape is
+// used.
+func LoadShootTree(ctx context.Context, db bun.IDB, technicalID, landscape string) (*Node, error) {
+	var shoot models.Shoot
+	query := db.NewSelect().
+		Model(&shoot).
+		Where("technical_id = ?", technicalID).
+		Order("landscape").
+		Limit(1)
+	if landscape != "" {
+		query = query.Where("landscape = ?", landscape)
+	}
+
+	err := query.Scan(ctx)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, ErrShootNotFound
+	case err != nil:
+		return nil, err
+	}
+
+	seeds := make([]models.Seed, 0)
+	err = db.NewSelect().
+		Model(&seeds).
+		Join("INNER JOIN l_g_shoot_to_seed AS l ON l.seed_id = ?TableAlias.id").
+		Where("l.shoot_id = ?", shoot.ID).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	machines := make([]models.Machine, 0)
+	err = db.NewSelect().
+		Model(&machines).
+		Join("INNER JOIN l_g_machine_to_shoot AS l ON l.machine_id = ?TableAlias.id").
+		Where("l.shoot_id = ?", shoot.ID).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, 0)
+	err = db.NewSelect().
+		TableExpr("l_aux_shoot_to_resource").
+		ColumnExpr("model_name, resource_id::text AS resource_id, kind, confidence").
+		Where("shoot_id = ?", shoot.ID).
+		Scan(ctx, &resources)
+	if err != nil {
+		return nil, err
+	}
+	if err := resolveResources(ctx, db, resources); err != nil {
+		return nil, err
+	}
+
+	ips := make([]PublicIP, 0)
+	err = db.NewSelect().
+		TableExpr("aux_public_exposure").
+		ColumnExpr("model_name, resource_id::text AS resource_id, host(public_ip) AS public_ip").
+		ColumnExpr("COALESCE(owner_model_name, '') AS owner_model_name, COALESCE(owner_id::text, '') AS owner_id").
+		Where("shoot_id = ?", shoot.ID).
+		Scan(ctx, &ips)
+	if err != nil {
+		return nil, err
+	}
+
+	return ShootTree(shoot, seeds, machines, resources, ips), nil
+}
+
+// resolveResources sets the names of the given resources from the records of
+// their models, and the instance IDs of instances from the
+// `aux_compute_instance' view.
+func resolveResources(ctx context.Context, db bun.IDB, resources []Resource) error {
+	idsByModel := make(map[string][]string)
+	for _, r := range resources {
+		idsByModel[r.Model] = append(idsByModel[r.Model], r.ID)
+	}
+
+	type record struct {
+		ID         string `bun:"id"`
+		Name       string `bun:"name"`
+		InstanceID string `bun:"instance_id"`
+	}
+	records := make(map[string]record)
+	for modelName, ids := range idsByModel {
+		model, ok := registry.ModelRegistry.Get(modelName)
+		if !ok {
+			continue
+		}
+		table := db.Dialect().Tables().Get(reflect.TypeOf(model).Elem())
+		if _, ok := table.FieldMap["name"]; !ok {
+			continue
+		}
+
+		items := make([]record, 0)
+		err := db.NewSelect().
+			TableExpr("? AS t", bun.Ident(table.Name)).
+			ColumnExpr("t.id::text AS id, COALESCE(t.name::text, '') AS name").
+			Where("t.id IN (?)", bun.In(ids)).
+			Scan(ctx, &items)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			records[modelName+"/"+item.ID] = item
+		}
+	}
+
+	instanceIDs := make([]string, 0)
+	for _, r := range resources {
+		if r.Kind == registry.ShootResourceKindInstance {
+			instanceIDs = append(instanceIDs, r.ID)
+		}
+	}
+	instances := make(map[string]string)
+	if len(instanceIDs) > 0 {
+		items := make([]record, 0)
+		err := db.NewSelect().
+			TableExpr("aux_compute_instance").
+			ColumnExpr("id::text AS id, name, instance_id").
+			Where("id IN (?)", bun.In(instanceIDs)).
+			Scan(ctx, &items)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			instances[item.ID] = item.InstanceID
+		}
+	}
+
+	for i, r := range resources {
+		resources[i].Name = records[r.Model+"/"+r.ID].Name
+		resources[i].InstanceID = instances[r.ID]
+	}
+
+	return nil
+}
+
+// ShootTree returns the resource tree of the given Gardener Shoot. The seeds,
+// machines, resources and public IP addresses are the ones linked to the
+// Shoot.
+//
+// Instances are nested below the machines, whose provider ID refers to them,
+// and public IP addresses are nested below the resources using them. Any
+// other resources and addresses are nested below the Shoot.
+func ShootTree(shoot models.Shoot, seeds []models.Seed, machines []models.Machine, resources []Resource, ips []PublicIP) *Node {
+	root := &Node{
+		Kind:  KindShoot,
+		Name:  shoot.Name,
+		Model: models.ShootModelName,
+		ID:    shoot.ID.String(),
+		Attributes: attributes(
+			"project", shoot.ProjectName,
+			"technical id", shoot.TechnicalID,
+			"landscape", shoot.Landscape,
+			"region", shoot.Region,
+			"kubernetes version", shoot.KubernetesVersion,
+			"purpose", shoot.Purpose,
+			"status", shoot.Status,
+			"hibernated", strconv.FormatBool(shoot.IsHibernated),
+		),
+		Children: make([]*Node, 0),
+	}
+
+	for _, seed := range seeds {
+		root.Children = append(root.Children, &Node{
+			Kind:       KindSeed,
+			Name:       seed.Name,
+			Model:      models.SeedModelName,
+			ID:         seed.ID.String(),
+			Attributes: attributes("kubernetes version", seed.KubernetesVersion),
+		})
+	}
+
+	// Nodes of the resources by model and ID, for nesting the public IP
+	// addresses below them.
+	byRef := make(map[string]*Node)
+	resourceNode := func(r Resource) *Node {
+		node := &Node{
+			Kind:       r.Kind,
+			Name:       cmp.Or(r.Name, r.InstanceID, r.ID),
+			Model:      r.Model,
+			ID:         r.ID,
+			Attributes: attributes("confidence", r.Confidence),
+		}
+		byRef[r.Model+"/"+r.ID] = node
+
+		return node
+	}
+
+	machines = slices.Clone(machines)
+	slices.SortFunc(machines, func(a, b models.Machine) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	attached := make(map[string]bool)
+	for _, machine := range machines {
+		node := &Node{
+			Kind:  KindMachine,
+			Name:  machine.Name,
+			Model: models.MachineModelName,
+			ID:    machine.ID.String(),
+			Attributes: attributes(
+				"status", machine.Status,
+				"machine class", machine.MachineClassName,
+				"provider id", machine.ProviderID,
+			),
+		}
+		if machine.Node != "" {
+			node.Children = append(node.Children, &Node{Kind: KindNode, Name: machine.Node})
+		}
+
+		// The last segment of the provider ID is either the ID, or the
+		// name of the instance, depending on the provider.
+		ref := machine.ProviderID[strings.LastIndex(machine.ProviderID, "/")+1:]
+		for _, r := range resources {
+			key := r.Model + "/" + r.ID
+			if ref == "" || attached[key] || r.Kind != registry.ShootResourceKindInstance {
+				continue
+			}
+			if r.InstanceID == ref || r.Name == ref {
+				node.Children = append(node.Children, resourceNode(r))
+				attached[key] = true
+			}
+		}
+		root.Children = append(root.Children, node)
+	}
+
+	resources = slices.Clone(resources)
+	slices.SortFunc(resources, func(a, b Resource) int {
+		return cmp.Or(
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(cmp.Or(a.Name, a.ID), cmp.Or(b.Name, b.ID)),
+		)
+	})
+	for _, r := range resources {
+		if attached[r.Model+"/"+r.ID] {
+			continue
+		}
+		root.Children = append(root.Children, resourceNode(r))
+	}
+
+	ips = slices.Clone(ips)
+	slices.SortFunc(ips, func(a, b PublicIP) int {
+		return cmp.Compare(a.Address, b.Address)
+	})
+	for _, ip := range ips {
+		node := &Node{
+			Kind:  KindIP,
+			Name:  ip.Address,
+			Model: ip.Model,
+			ID:    ip.ID,
+		}
+		parent, ok := byRef[ip.OwnerModel+"/"+ip.OwnerID]
+		if !ok {
+			parent = root
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	return root
+}
+
+// attributes returns the [Attribute] items for the given name and value pairs.
+// Empty values are skipped.
+func attributes(kv ...string) []Attribute {
+	result := make([]Attribute, 0, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		if kv[i+1] == "" {
+			continue
+		}
+		result = append(result, Attribute{Name: kv[i], Value: kv[i+1]})
+	}
+
+	return result
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pages_test
+
+import (
+	"testing"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/gardener/models"
+	"github.com/gardener/inventory/pkg/pages"
+)
+
+func TestShootTree(t *testing.T) {
+	shoot := models.Shoot{Name: "my-shoot", TechnicalID: "shoot--dev--my-shoot"}
+	seeds := []models.Seed{{Name: "aws-eu1"}}
+	machines := []models.Machine{
+		{Name: "machine-b", Node: "node-b", ProviderID: "gce://project/zone/instance-b"},
+		{Name: "machine-a", Node: "node-a", ProviderID: "aws:///eu-west-1a/i-0123"},
+	}
+	resources := []pages.Resource{
+		{Model: "aws:model:instance", ID: "1", Kind: registry.ShootResourceKindInstance, Name: "instance-a", InstanceID: "i-0123"},
+		{Model: "gcp:model:instance", ID: "2", Kind: registry.ShootResourceKindInstance, Name: "instance-b"},
+		{Model: "aws:model:lb", ID: "3", Kind: registry.ShootResourceKindLoadBalancer, Name: "lb-1"},
+		{Model: "aws:model:disk", ID: "4", Kind: registry.ShootResourceKindDisk, Name: "disk-1"},
+	}
+	ips := []pages.PublicIP{
+		{Model: "aws:model:instance", ID: "1", Address: "192.0.2.1", OwnerModel: "aws:model:instance", OwnerID: "1"},
+		{Model: "aws:model:eip", ID: "5", Address: "192.0.2.2"},
+	}
+
+	tree := pages.ShootTree(shoot, seeds, machines, resources, ips)
+
+	wantKinds := []string{
+		pages.KindSeed,
+		pages.KindMachine,
+		pages.KindMachine,
+		registry.ShootResourceKindDisk,
+		registry.ShootResourceKindLoadBalancer,
+		pages.KindIP,
+	}
+	if len(tree.Children) != len(wantKinds) {
+		t.Fatalf("got %d children, wanted %d", len(tree.Children), len(wantKinds))
+	}
+	for i, kind := range wantKinds {
+		if tree.Children[i].Kind != kind {
+			t.Fatalf("got kind %q for child %d, wanted %q", tree.Children[i].Kind, i, kind)
+		}
+	}
+
+	// Instances are nested below their machines, and the public IP
+	// addresses below the instances using them.
+	machineA := tree.Children[1]
+	if machineA.Name != "machine-a" || len(machineA.Children) != 2 {
+		t.Fatalf("got machine %q with %d children", machineA.Name, len(machineA.Children))
+	}
+	if node := machineA.Children[0]; node.Kind != pages.KindNode || node.Name != "node-a" {
+		t.Fatalf("got %s %q, wanted node %q", node.Kind, node.Name, "node-a")
+	}
+	instance := machineA.Children[1]
+	if instance.Name != "instance-a" || len(instance.Children) != 1 || instance.Children[0].Name != "192.0.2.1" {
+		t.Fatalf("got instance %q with children %v", instance.Name, instance.Children)
+	}
+	if machineB := tree.Children[2]; len(machineB.Children) != 2 || machineB.Children[1].Name != "instance-b" {
+		t.Fatalf("got machine %q with children %v", machineB.Name, machineB.Children)
+	}
+
+	testCases := []struct {
+		kind string
+		want int
+	}{
+		{pages.KindMachine, 2},
+		{pages.KindNode, 2},
+		{registry.ShootResourceKindInstance, 2},
+		{pages.KindIP, 2},
+	}
+	for _, tc := range testCases {
+		if got := tree.Count(tc.kind); got != tc.want {
+			t.Fatalf("got %d %s nodes, wanted %d", got, tc.kind, tc.want)
+		}
+	}
+}
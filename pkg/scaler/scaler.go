@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package scaler provides HTTP endpoints, which report the backlog of the
+// queues in a format suitable for autoscaling the workers, e.g. via the KEDA
+// Metrics API scaler.
+package scaler
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/hibiken/asynq"
+)
+
+// Prefix is the path prefix of the scaler endpoints.
+const Prefix = "/scaler/v1/"
+
+// Inspector provides information about the queues. It is implemented by
+// [asynq.Inspector].
+type Inspector interface {
+	// Queues returns the names of the queues.
+	Queues() ([]string, error)
+
+	// GetQueueInfo returns the information about the given queue.
+	GetQueueInfo(queue string) (*asynq.QueueInfo, error)
+}
+
+var _ Inspector = &asynq.Inspector{}
+
+// QueueBacklog represents the backlog of a queue.
+type QueueBacklog struct {
+	// Queue specifies the name of the queue.
+	Queue string `json:"queue"`
+
+	// Pending specifies the number of tasks, which are ready to be
+	// processed.
+	Pending int `json:"pending"`
+
+	// Active specifies the number of tasks, which are being processed.
+	Active int `json:"active"`
+
+	// Scheduled specifies the number of tasks, which are scheduled to be
+	// processed in the future.
+	Scheduled int `json:"scheduled"`
+
+	// Retry specifies the number of tasks, which will be retried in the
+	// future.
+	Retry int `json:"retry"`
+
+	// Archived specifies the number of tasks, which have been archived.
+	Archived int `json:"archived"`
+
+	// Paused specifies whether the queue is paused.
+	Paused bool `json:"paused"`
+
+	// Backlog specifies the number of tasks, which require a worker right
+	// now, i.e. the pending and active tasks. The backlog of paused queues
+	// is zero, since their tasks are not processed.
+	Backlog int `json:"backlog"`
+}
+
+// errorResponse represents the response returned by the endpoints on errors.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// NewHandler returns an [http.Handler], which serves the scaler endpoints
+// using the given [Inspector].
+//
+// The following endpoints are provided.
+//
+//   - GET /scaler/v1/queues
+//   - GET /scaler/v1/queues/{queue}
+//
+// Queues, which don't exist yet are reported with an empty backlog, so that
+// the workers may be scaled to zero until the first task is enqueued.
+func NewHandler(inspector Inspector) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+Prefix+"queues", func(w http.ResponseWriter, _ *http.Request) {
+		queues, err := inspector.Queues()
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		items := make(map[string]QueueBacklog, len(queues))
+		for _, queue := range queues {
+			item, err := getQueueBacklog(inspector, queue)
+			if err != nil {
+				writeError(w, err)
+
+				return
+			}
+			items[queue] = item
+		}
+
+		writeJSON(w, http.StatusOK, items)
+	})
+
+	mux.HandleFunc("GET "+Prefix+"queues/{queue}", func(w http.ResponseWriter, r *http.Request) {
+		item, err := getQueueBacklog(inspector, r.PathValue("queue"))
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		writeJSON(w, http.StatusOK, item)
+	})
+
+	return mux
+}
+
+// getQueueBacklog returns the [QueueBacklog] for the given queue.
+func getQueueBacklog(inspector Inspector, queue string) (QueueBacklog, error) {
+	item := QueueBacklog{Queue: queue}
+	info, err := inspector.GetQueueInfo(queue)
+	switch {
+	case errors.Is(err, asynq.ErrQueueNotFound):
+		return item, nil
+	case err != nil:
+		return item, err
+	}
+
+	item.Pending = info.Pending
+	item.Active = info.Active
+	item.Scheduled = info.Scheduled
+	item.Retry = info.Retry
+	item.Archived = info.Archived
+	item.Paused = info.Paused
+	if !info.Paused {
+		item.Backlog = info.Pending + info.Active
+	}
+
+	return item, nil
+}
+
+// writeJSON writes the given value as JSON with the given status code.
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Error("failed to encode scaler response", "reason", err)
+	}
+}
+
+// writeError logs the given error and writes a generic internal error.
+func writeError(w http.ResponseWriter, err error) {
+	slog.Error("scaler request failed", "reason", err)
+	code := http.StatusInternalServerError
+	writeJSON(w, code, errorResponse{Error: http.StatusText(code)})
+}
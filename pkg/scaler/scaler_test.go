@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scaler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/gardener/inventory/pkg/scaler"
+)
+
+// fakeInspector is a [scaler.Inspector], which returns static queue info.
+type fakeInspector struct {
+	queues map[string]*asynq.QueueInfo
+	err    error
+}
+
+func (f *fakeInspector) Queues() ([]string, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	names := make([]string, 0, len(f.queues))
+	for name := range f.queues {
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+func (f *fakeInspector) GetQueueInfo(queue string) (*asynq.QueueInfo, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	info, ok := f.queues[queue]
+	if !ok {
+		return nil, asynq.ErrQueueNotFound
+	}
+
+	return info, nil
+}
+
+func TestHandler(t *testing.T) {
+	inspector := &fakeInspector{
+		queues: map[string]*asynq.QueueInfo{
+			"default": {Queue: "default", Pending: 10, Active: 2, Retry: 3, Scheduled: 4, Archived: 1},
+			"aws":     {Queue: "aws", Pending: 5, Active: 1, Paused: true},
+		},
+	}
+	handler := scaler.NewHandler(inspector)
+
+	testCases := []struct {
+		desc   string
+		target string
+		wanted scaler.QueueBacklog
+	}{
+		{
+			desc:   "active queue",
+			target: scaler.Prefix + "queues/default",
+			wanted: scaler.QueueBacklog{Queue: "default", Pending: 10, Active: 2, Scheduled: 4, Retry: 3, Archived: 1, Backlog: 12},
+		},
+		{
+			desc:   "paused queue",
+			target: scaler.Prefix + "queues/aws",
+			wanted: scaler.QueueBacklog{Queue: "aws", Pending: 5, Active: 1, Paused: true},
+		},
+		{
+			desc:   "missing queue",
+			target: scaler.Prefix + "queues/gcp",
+			wanted: scaler.QueueBacklog{Queue: "gcp"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.target, nil)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("got status %d wanted %d", rec.Code, http.StatusOK)
+			}
+
+			var got scaler.QueueBacklog
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatalf("cannot decode response: %s", err)
+			}
+			if got != tc.wanted {
+				t.Fatalf("got %+v wanted %+v", got, tc.wanted)
+			}
+		})
+	}
+
+	t.Run("all queues", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, scaler.Prefix+"queues", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		var got map[string]scaler.QueueBacklog
+		if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+			t.Fatalf("cannot decode response: %s", err)
+		}
+		if len(got) != 2 || got["default"].Backlog != 12 || got["aws"].Backlog != 0 {
+			t.Fatalf("unexpected response %+v", got)
+		}
+	})
+}
+
+func TestHandlerErrors(t *testing.T) {
+	handler := scaler.NewHandler(&fakeInspector{err: errors.New("redis is down")})
+	for _, target := range []string{scaler.Prefix + "queues", scaler.Prefix + "queues/default"} {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != http.StatusInternalServerError {
+			t.Fatalf("%s: got status %d wanted %d", target, rec.Code, http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package servicenow provides a client for synchronizing resources into the
+// ServiceNow CMDB via the Identification and Reconciliation API.
+package servicenow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gardener/inventory/pkg/core/config"
+)
+
+// identifyReconcilePath is the path of the Identification and Reconciliation
+// API endpoint.
+const identifyReconcilePath = "/api/now/identifyreconcile"
+
+// requestTimeout specifies the timeout of the requests to the ServiceNow API.
+const requestTimeout = 60 * time.Second
+
+// maxErrorBodySize specifies the max number of bytes of the response body,
+// which are included in errors.
+const maxErrorBodySize = 1024
+
+// ErrInvalidConfig is an error, which is returned when the ServiceNow
+// configuration is missing required settings.
+var ErrInvalidConfig = errors.New("invalid servicenow config")
+
+// ErrRequestFailed is an error, which is returned when the ServiceNow API
+// responds with an unexpected status code.
+var ErrRequestFailed = errors.New("servicenow request failed")
+
+// Item represents a configuration item to be identified and reconciled.
+type Item struct {
+	// ClassName specifies the CMDB class of the item.
+	ClassName string `json:"className"`
+
+	// Values specifies the attributes of the item.
+	Values map[string]string `json:"values"`
+}
+
+// ResultError represents an error reported for an item.
+type ResultError struct {
+	// Error specifies the kind of error.
+	Error string `json:"error"`
+
+	// Message specifies the error message.
+	Message string `json:"message"`
+}
+
+// Result represents the outcome of the reconciliation of an item.
+type Result struct {
+	// ClassName specifies the CMDB class of the item.
+	ClassName string `json:"className"`
+
+	// Operation specifies the performed operation, e.g. INSERT, UPDATE or
+	// NO_CHANGE.
+	Operation string `json:"operation"`
+
+	// SysID specifies the sys_id of the configuration item.
+	SysID string `json:"sysId"`
+
+	// Errors specifies the errors reported for the item, if any.
+	Errors []ResultError `json:"errors"`
+}
+
+// Client is a client for the ServiceNow API.
+type Client struct {
+	url        string
+	username   string
+	password   string
+	dataSource string
+	httpClient *http.Client
+	mappings   []config.ServiceNowMapping
+}
+
+// DefaultClient is the [Client] used by the workers. It is nil, unless the
+// ServiceNow integration is enabled.
+var DefaultClient *Client
+
+// SetClient sets the [Client] to be used by the workers.
+func SetClient(c *Client) {
+	DefaultClient = c
+}
+
+// ValidateConfig validates the given [config.ServiceNowConfig].
+func ValidateConfig(conf config.ServiceNowConfig) error {
+	u, err := url.Parse(conf.URL)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" || u.Host == "" {
+		return fmt.Errorf("%w: invalid url %q", ErrInvalidConfig, conf.URL)
+	}
+	if conf.Username == "" || conf.PasswordFile == "" {
+		return fmt.Errorf("%w: username and password file must be specified", ErrInvalidConfig)
+	}
+
+	seen := make(map[string]bool, len(conf.Mappings))
+	for _, mapping := range conf.Mappings {
+		if mapping.Model == "" || mapping.ClassName == "" {
+			return fmt.Errorf("%w: model and class name of mappings must be specified", ErrInvalidConfig)
+		}
+		if len(mapping.Fields) == 0 {
+			return fmt.Errorf("%w: no fields mapped for model %s", ErrInvalidConfig, mapping.Model)
+		}
+		if seen[mapping.Model] {
+			return fmt.Errorf("%w: duplicate mapping for model %s", ErrInvalidConfig, mapping.Model)
+		}
+		seen[mapping.Model] = true
+	}
+
+	return nil
+}
+
+// NewClient returns a new [Client] for the given [config.ServiceNowConfig].
+func NewClient(conf config.ServiceNowConfig) (*Client, error) {
+	if err := ValidateConfig(conf); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Clean(conf.PasswordFile))
+	if err != nil {
+		return nil, fmt.Errorf("servicenow: unable to read password file: %w", err)
+	}
+
+	c := &Client{
+		url:        strings.TrimRight(conf.URL, "/"),
+		username:   conf.Username,
+		password:   strings.TrimSpace(string(data)),
+		dataSource: conf.DataSource,
+		httpClient: &http.Client{Timeout: requestTimeout},
+		mappings:   conf.Mappings,
+	}
+
+	return c, nil
+}
+
+// Mapping returns the configured mapping for the model with the given name.
+func (c *Client) Mapping(model string) (config.ServiceNowMapping, bool) {
+	for _, mapping := range c.mappings {
+		if mapping.Model == model {
+			return mapping, true
+		}
+	}
+
+	return config.ServiceNowMapping{}, false
+}
+
+// IdentifyReconcile creates or updates the given items in the CMDB, and
+// returns the outcome for each item.
+func (c *Client) IdentifyReconcile(ctx context.Context, items []Item) ([]Result, error) {
+	body, err := json.Marshal(map[string]any{"items": items})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := c.url + identifyReconcilePath
+	if c.dataSource != "" {
+		endpoint += "?" + url.Values{"sysparm_data_source": {c.dataSource}}.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() // nolint: errcheck
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		if len(data) > maxErrorBodySize {
+			data = data[:maxErrorBodySize]
+		}
+
+		return nil, fmt.Errorf("%w: %s: %s", ErrRequestFailed, resp.Status, data)
+	}
+
+	return DecodeResults(data)
+}
+
+// DecodeResults decodes the results from the given response of the
+// Identification and Reconciliation API. Depending on the version of the
+// ServiceNow instance the result is either a JSON object, or a string
+// containing a JSON object.
+func DecodeResults(data []byte) ([]Result, error) {
+	var resp struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+
+	raw := []byte(resp.Result)
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		raw = []byte(s)
+	}
+
+	var result struct {
+		Items []Result `json:"items"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Items, nil
+}
+
+// BuildItem returns the configuration item for the given record, which is a
+// JSON object, e.g. as returned by the `to_jsonb' function of PostgreSQL.
+// String values are used as is, and any other values are encoded as JSON.
+// Missing and null values are skipped.
+func BuildItem(mapping config.ServiceNowMapping, record string) (Item, error) {
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(record), &values); err != nil {
+		return Item{}, err
+	}
+
+	item := Item{
+		ClassName: mapping.ClassName,
+		Values:    make(map[string]string, len(mapping.Fields)+len(mapping.Values)),
+	}
+	for attr, value := range mapping.Values {
+		item.Values[attr] = value
+	}
+	for attr, column := range mapping.Fields {
+		value, ok := values[column]
+		if !ok || string(value) == "null" {
+			continue
+		}
+
+		var s string
+		if err := json.Unmarshal(value, &s); err == nil {
+			item.Values[attr] = s
+
+			continue
+		}
+		item.Values[attr] = string(value)
+	}
+
+	return item, nil
+}
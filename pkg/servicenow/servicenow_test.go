@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package servicenow_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/servicenow"
+)
+
+func TestValidateConfig(t *testing.T) {
+	mapping := config.ServiceNowMapping{
+		Model:     "g:model:shoot",
+		ClassName: "cmdb_ci_kubernetes_cluster",
+		Fields:    map[string]string{"name": "technical_id"},
+	}
+
+	testCases := []struct {
+		desc    string
+		conf    config.ServiceNowConfig
+		wantErr error
+	}{
+		{
+			desc: "valid config",
+			conf: config.ServiceNowConfig{
+				URL:          "https://example.service-now.com",
+				Username:     "inventory",
+				PasswordFile: "/path/to/password",
+				Mappings:     []config.ServiceNowMapping{mapping},
+			},
+		},
+		{
+			desc: "invalid url",
+			conf: config.ServiceNowConfig{
+				URL:          "example.service-now.com",
+				Username:     "inventory",
+				PasswordFile: "/path/to/password",
+			},
+			wantErr: servicenow.ErrInvalidConfig,
+		},
+		{
+			desc: "missing password file",
+			conf: config.ServiceNowConfig{
+				URL:      "https://example.service-now.com",
+				Username: "inventory",
+			},
+			wantErr: servicenow.ErrInvalidConfig,
+		},
+		{
+			desc: "duplicate mapping",
+			conf: config.ServiceNowConfig{
+				URL:          "https://example.service-now.com",
+				Username:     "inventory",
+				PasswordFile: "/path/to/password",
+				Mappings:     []config.ServiceNowMapping{mapping, mapping},
+			},
+			wantErr: servicenow.ErrInvalidConfig,
+		},
+		{
+			desc: "mapping without fields",
+			conf: config.ServiceNowConfig{
+				URL:          "https://example.service-now.com",
+				Username:     "inventory",
+				PasswordFile: "/path/to/password",
+				Mappings: []config.ServiceNowMapping{
+					{Model: "g:model:shoot", ClassName: "cmdb_ci_kubernetes_cluster"},
+				},
+			},
+			wantErr: servicenow.ErrInvalidConfig,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := servicenow.ValidateConfig(tc.conf)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got error %v, wanted %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestBuildItem(t *testing.T) {
+	mapping := config.ServiceNowMapping{
+		Model:     "g:model:shoot",
+		ClassName: "cmdb_ci_kubernetes_cluster",
+		Fields: map[string]string{
+			"name":              "technical_id",
+			"correlation_id":    "id",
+			"short_description": "purpose",
+			"hibernated":        "is_hibernated",
+			"region":            "region",
+		},
+		Values: map[string]string{
+			"operational_status": "1",
+		},
+	}
+	record := `{"id": "5f4d9c0a-7f3e-4a55-8d0c-2a0e8c6b9f21", "technical_id": "shoot--foo--bar", "purpose": "production", "is_hibernated": false, "region": null}`
+
+	item, err := servicenow.BuildItem(mapping, record)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	wanted := map[string]string{
+		"name":               "shoot--foo--bar",
+		"correlation_id":     "5f4d9c0a-7f3e-4a55-8d0c-2a0e8c6b9f21",
+		"short_description":  "production",
+		"hibernated":         "false",
+		"operational_status": "1",
+	}
+	if item.ClassName != mapping.ClassName {
+		t.Fatalf("got class name %q, wanted %q", item.ClassName, mapping.ClassName)
+	}
+	if !maps.Equal(item.Values, wanted) {
+		t.Fatalf("got values %v, wanted %v", item.Values, wanted)
+	}
+}
+
+func TestDecodeResults(t *testing.T) {
+	testCases := []struct {
+		desc string
+		data string
+	}{
+		{
+			desc: "object result",
+			data: `{"result": {"items": [{"className": "cmdb_ci_kubernetes_cluster", "operation": "INSERT", "sysId": "abc"}]}}`,
+		},
+		{
+			desc: "string result",
+			data: `{"result": "{\"items\": [{\"className\": \"cmdb_ci_kubernetes_cluster\", \"operation\": \"INSERT\", \"sysId\": \"abc\"}]}"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			results, err := servicenow.DecodeResults([]byte(tc.data))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(results) != 1 || results[0].Operation != "INSERT" || results[0].SysID != "abc" {
+				t.Fatalf("got results %+v", results)
+			}
+		})
+	}
+}
+
+func TestIdentifyReconcile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "inventory" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+		if r.URL.Path != "/api/now/identifyreconcile" || r.URL.Query().Get("sysparm_data_source") != "Inventory" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		var req struct {
+			Items []servicenow.Item `json:"items"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		results := make([]servicenow.Result, 0, len(req.Items))
+		for _, item := range req.Items {
+			results = append(results, servicenow.Result{ClassName: item.ClassName, Operation: "INSERT"})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": map[string]any{"items": results}})
+	}))
+	defer server.Close()
+
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	if err := os.WriteFile(passwordFile, []byte("secret\n"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	conf := config.ServiceNowConfig{
+		URL:          server.URL,
+		Username:     "inventory",
+		PasswordFile: passwordFile,
+		DataSource:   "Inventory",
+	}
+	client, err := servicenow.NewClient(conf)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	items := []servicenow.Item{
+		{ClassName: "cmdb_ci_kubernetes_cluster", Values: map[string]string{"name": "foo"}},
+		{ClassName: "cmdb_ci_kubernetes_cluster", Values: map[string]string{"name": "bar"}},
+	}
+	results, err := client.IdentifyReconcile(context.Background(), items)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(results) != len(items) {
+		t.Fatalf("got %d results, wanted %d", len(results), len(items))
+	}
+
+	conf.Username = "unknown"
+	client, err = servicenow.NewClient(conf)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.IdentifyReconcile(context.Background(), items); !errors.Is(err, servicenow.ErrRequestFailed) {
+		t.Fatalf("got error %v, wanted %v", err, servicenow.ErrRequestFailed)
+	}
+}
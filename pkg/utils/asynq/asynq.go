@@ -7,15 +7,22 @@ package asynq
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/goccy/go-yaml"
 	"github.com/hibiken/asynq"
 
 	asynqclient "github.com/gardener/inventory/pkg/clients/asynq"
 	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/core/registry"
 )
 
 // SkipRetry wraps the provided error with [asynq.SkipRetry] in order to signal
@@ -70,6 +77,12 @@ func GetConfig(ctx context.Context) *config.Config {
 // task and the reason why it has failed.
 func NewDefaultErrorHandler() asynq.ErrorHandlerFunc {
 	handler := func(ctx context.Context, task *asynq.Task, err error) {
+		// Skipped tasks have been logged by the circuit breaker
+		// already.
+		if errors.Is(err, ErrCircuitOpen) {
+			return
+		}
+
 		// The context we get for the error handler will *not* contain
 		// our embedded logger, since it goes through a different path
 		// than the one used when enqueuing the task. That's why we need
@@ -112,13 +125,89 @@ func GetQueueName(ctx context.Context) string {
 
 // NewRedisClientOptFromConfig returns an [asynq.RedisClientOpt] from the
 // provided [config.RedisConfig] configuration.
-func NewRedisClientOptFromConfig(conf config.RedisConfig) asynq.RedisClientOpt {
-	// TODO: Handle authentication, TLS, etc.
+func NewRedisClientOptFromConfig(conf config.RedisConfig) (asynq.RedisClientOpt, error) {
 	opts := asynq.RedisClientOpt{
-		Addr: conf.Endpoint,
+		Addr:     conf.Endpoint,
+		Username: conf.Username,
+	}
+
+	if conf.PasswordFile != "" {
+		data, err := os.ReadFile(filepath.Clean(conf.PasswordFile))
+		if err != nil {
+			return asynq.RedisClientOpt{}, fmt.Errorf("redis: unable to read password file: %w", err)
+		}
+		opts.Password = strings.TrimSpace(string(data))
+	}
+
+	if conf.TLS.IsEnabled {
+		tlsConfig, err := newRedisTLSConfig(conf.TLS)
+		if err != nil {
+			return asynq.RedisClientOpt{}, fmt.Errorf("redis: %w", err)
+		}
+		opts.TLSConfig = tlsConfig
+	}
+
+	return opts, nil
+}
+
+// newRedisTLSConfig creates a new [tls.Config] from the given
+// [config.RedisTLSConfig] settings.
+func newRedisTLSConfig(conf config.RedisTLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         conf.ServerName,
+		InsecureSkipVerify: conf.Insecure, // nolint: gosec
+	}
+
+	if conf.CACert != "" {
+		data, err := os.ReadFile(filepath.Clean(conf.CACert))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA cert: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no valid certificates found in %s", conf.CACert)
+		}
+		tlsConfig.RootCAs = pool
 	}
 
-	return opts
+	if conf.ClientCert != "" || conf.ClientKey != "" {
+		cert, err := tls.LoadX509KeyPair(conf.ClientCert, conf.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// NewUniqueOptionsFromConfig returns the [asynq.Option] items for enqueueing
+// unique tasks based on the provided [config.UniqueTasksConfig] settings. If
+// unique tasks are not enabled, then no options are returned.
+func NewUniqueOptionsFromConfig(conf config.UniqueTasksConfig) []asynq.Option {
+	if !conf.IsEnabled {
+		return nil
+	}
+
+	ttl := conf.TTL
+	if ttl == 0 {
+		ttl = config.DefaultUniqueTaskTTL
+	}
+
+	return []asynq.Option{asynq.Unique(ttl)}
+}
+
+// NewTask creates a new [asynq.Task] with the given name and the JSON-encoded
+// payload.
+func NewTask(name string, payload any) (*asynq.Task, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload for %s: %w", name, err)
+	}
+
+	return asynq.NewTask(name, data), nil
 }
 
 // TaskConstructor is a function which creates and returns a new [asynq.Task].
@@ -129,7 +218,15 @@ func Enqueue(ctx context.Context, items []TaskConstructor, opts ...asynq.Option)
 	logger := GetLogger(ctx)
 	for _, fn := range items {
 		task := fn()
-		info, err := asynqclient.Client.Enqueue(task, opts...)
+		info, err := asynqclient.Enqueue(task, opts...)
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			logger.Info(
+				"skipping duplicate task",
+				"type", task.Type(),
+			)
+
+			continue
+		}
 		if err != nil {
 			logger.Error(
 				"failed to enqueue task",
@@ -150,3 +247,86 @@ func Enqueue(ctx context.Context, items []TaskConstructor, opts ...asynq.Option)
 
 	return nil
 }
+
+// SkipDisabledTasks returns the task constructors, which produce tasks of
+// enabled collectors according to the given mapping between task names and
+// whether they are enabled. Tasks, which are not part of the mapping, are
+// always considered enabled.
+func SkipDisabledTasks(ctx context.Context, items []TaskConstructor, enabled map[string]bool) []TaskConstructor {
+	logger := GetLogger(ctx)
+	result := make([]TaskConstructor, 0, len(items))
+	for _, fn := range items {
+		name := fn().Type()
+		if isEnabled, ok := enabled[name]; ok && !isEnabled {
+			logger.Info("skipping task of disabled collector", "type", name)
+
+			continue
+		}
+		result = append(result, fn)
+	}
+
+	return result
+}
+
+// DiscardGroupTaskType is the name of the task, which is returned by the
+// aggregator created via [NewGroupAggregator] for groups, which cannot be
+// aggregated. Processing the task drops the grouped tasks.
+const DiscardGroupTaskType = "aux:task:discard-group"
+
+// DiscardGroupPayload represents the payload of the task, which drops the
+// tasks of a group, which cannot be aggregated.
+type DiscardGroupPayload struct {
+	// Group is the name of the group.
+	Group string `yaml:"group" json:"group" desc:"Name of the task group" example:"openstack:task:collect-pool-members:my-project"`
+
+	// Type is the type of the grouped tasks.
+	Type string `yaml:"type" json:"type" desc:"Type of the grouped tasks" example:"openstack:task:collect-pool-members"`
+
+	// Count is the number of grouped tasks, which were dropped.
+	Count int `yaml:"count" json:"count" desc:"Number of dropped tasks" example:"10"`
+}
+
+// NewGroupAggregator returns an [asynq.GroupAggregator], which aggregates the
+// tasks of a group using the aggregator registered for the type of the grouped
+// tasks in the given registry.
+//
+// asynq fails to enqueue a nil aggregated task and keeps the group around, so
+// that it would be retried in each aggregation cycle. Groups, for which no
+// aggregator is registered, are therefore aggregated into a
+// [DiscardGroupTaskType] task instead.
+func NewGroupAggregator(reg *registry.Registry[string, asynq.GroupAggregator]) asynq.GroupAggregatorFunc {
+	aggregator := func(group string, tasks []*asynq.Task) *asynq.Task {
+		payload := DiscardGroupPayload{
+			Group: group,
+			Count: len(tasks),
+		}
+		if len(tasks) > 0 {
+			payload.Type = tasks[0].Type()
+		}
+
+		ga, ok := reg.Get(payload.Type)
+		if !ok {
+			slog.Error(
+				"no aggregator registered for grouped tasks",
+				"group", group,
+				"type", payload.Type,
+				"count", payload.Count,
+			)
+
+			data, _ := json.Marshal(payload)
+
+			return asynq.NewTask(DiscardGroupTaskType, data)
+		}
+
+		slog.Info(
+			"aggregating grouped tasks",
+			"group", group,
+			"type", payload.Type,
+			"count", payload.Count,
+		)
+
+		return ga.Aggregate(group, tasks)
+	}
+
+	return aggregator
+}
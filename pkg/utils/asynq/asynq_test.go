@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package asynq_test
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+func TestNewGroupAggregator(t *testing.T) {
+	reg := registry.New[string, asynq.GroupAggregator]()
+	reg.MustRegister("test:task:grouped", asynq.GroupAggregatorFunc(func(group string, tasks []*asynq.Task) *asynq.Task {
+		return asynq.NewTask("test:task:aggregated", []byte(group))
+	}))
+	aggregator := asynqutils.NewGroupAggregator(reg)
+
+	testCases := []struct {
+		desc        string
+		group       string
+		tasks       []*asynq.Task
+		wantType    string
+		wantPayload *asynqutils.DiscardGroupPayload
+	}{
+		{
+			desc:     "registered aggregator",
+			group:    "group-1",
+			tasks:    []*asynq.Task{asynq.NewTask("test:task:grouped", nil)},
+			wantType: "test:task:aggregated",
+		},
+		{
+			desc:     "unregistered aggregator",
+			group:    "group-2",
+			tasks:    []*asynq.Task{asynq.NewTask("test:task:other", nil), asynq.NewTask("test:task:other", nil)},
+			wantType: asynqutils.DiscardGroupTaskType,
+			wantPayload: &asynqutils.DiscardGroupPayload{
+				Group: "group-2",
+				Type:  "test:task:other",
+				Count: 2,
+			},
+		},
+		{
+			desc:     "empty group",
+			group:    "group-3",
+			tasks:    []*asynq.Task{},
+			wantType: asynqutils.DiscardGroupTaskType,
+			wantPayload: &asynqutils.DiscardGroupPayload{
+				Group: "group-3",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			task := aggregator.Aggregate(tc.group, tc.tasks)
+			if task == nil {
+				t.Fatal("got nil task")
+			}
+			if task.Type() != tc.wantType {
+				t.Fatalf("got task type %s, wanted %s", task.Type(), tc.wantType)
+			}
+			if tc.wantPayload == nil {
+				return
+			}
+
+			var payload asynqutils.DiscardGroupPayload
+			if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+				t.Fatalf("unable to unmarshal payload: %s", err)
+			}
+			if payload != *tc.wantPayload {
+				t.Fatalf("got payload %+v, wanted %+v", payload, *tc.wantPayload)
+			}
+		})
+	}
+}
+
+func TestSkipDisabledTasks(t *testing.T) {
+	newConstructor := func(name string) asynqutils.TaskConstructor {
+		return func() *asynq.Task {
+			return asynq.NewTask(name, nil)
+		}
+	}
+	items := []asynqutils.TaskConstructor{
+		newConstructor("test:task:enabled"),
+		newConstructor("test:task:disabled"),
+		newConstructor("test:task:unknown"),
+	}
+	enabled := map[string]bool{
+		"test:task:enabled":  true,
+		"test:task:disabled": false,
+	}
+
+	got := make([]string, 0)
+	for _, fn := range asynqutils.SkipDisabledTasks(context.Background(), items, enabled) {
+		got = append(got, fn().Type())
+	}
+
+	want := []string{"test:task:enabled", "test:task:unknown"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("got tasks %v, wanted %v", got, want)
+	}
+}
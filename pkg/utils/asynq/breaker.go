@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package asynq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/metrics"
+)
+
+// ErrCircuitOpen is an error, which is returned when a task is skipped, because
+// the circuit breaker of its account and region is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// circuit represents the state of the circuit breaker of a single account and
+// region.
+type circuit struct {
+	// failures specifies the number of consecutive failures.
+	failures int
+
+	// openedAt specifies the time, when the circuit has been opened last.
+	openedAt time.Time
+
+	// probing specifies whether a task is being processed in order to
+	// probe an open circuit.
+	probing bool
+}
+
+// circuitBreaker tracks the consecutive failures of the tasks per account and
+// region.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	circuits  map[taskScope]*circuit
+}
+
+// allow returns true, if a task of the given scope may be processed. Once the
+// cooldown of an open circuit has passed, a single task is allowed in order to
+// probe the scope.
+func (cb *circuitBreaker) allow(scope taskScope) bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	c, ok := cb.circuits[scope]
+	if !ok || c.failures < cb.threshold {
+		return true
+	}
+
+	if c.probing || time.Since(c.openedAt) < cb.cooldown {
+		return false
+	}
+	c.probing = true
+
+	return true
+}
+
+// record records the result of a task of the given scope, and returns the new
+// state of the circuit, if it has changed.
+func (cb *circuitBreaker) record(scope taskScope, failed bool) (opened bool, closed bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	c, ok := cb.circuits[scope]
+	if !failed {
+		if !ok {
+			return false, false
+		}
+		delete(cb.circuits, scope)
+
+		return false, c.failures >= cb.threshold
+	}
+
+	if !ok {
+		c = &circuit{}
+		cb.circuits[scope] = c
+	}
+
+	wasProbing := c.probing
+	c.probing = false
+	c.failures++
+	if c.failures < cb.threshold {
+		return false, false
+	}
+	c.openedAt = time.Now()
+
+	return c.failures == cb.threshold || wasProbing, false
+}
+
+// isScopeFailure returns true, if the given error indicates a failing account
+// or region, i.e. throttled or unauthorized requests, or any other error, which
+// would be retried.
+func isScopeFailure(err error) bool {
+	switch ClassifyError(err) {
+	case ErrorClassThrottled, ErrorClassAuth:
+		return true
+	case ErrorClassOther:
+		return !errors.Is(err, asynq.SkipRetry)
+	default:
+		return false
+	}
+}
+
+// NewCircuitBreakerMiddleware returns a new [asynq.MiddlewareFunc], which
+// skips the tasks of a provider account and region after the configured number
+// of consecutive failures. The tasks are skipped for the configured cooldown,
+// after which a single task is processed in order to probe the account and
+// region again. Skipped tasks are neither retried nor archived.
+//
+// The account is taken from the `account_id', `project_id',
+// `subscription_id', `scope.ProjectID' or `seed' field of the payload. Tasks,
+// which specify none of them, are always processed and never counted.
+//
+// The state of the circuit breaker is kept in the memory of the worker
+// process, and is not shared between worker replicas, i.e. each replica opens
+// its circuits independently.
+func NewCircuitBreakerMiddleware(conf config.CircuitBreakerConfig) asynq.MiddlewareFunc {
+	cb := &circuitBreaker{
+		threshold: conf.FailureThreshold,
+		cooldown:  conf.Cooldown,
+		circuits:  make(map[taskScope]*circuit),
+	}
+
+	middleware := func(handler asynq.Handler) asynq.Handler {
+		mw := func(ctx context.Context, task *asynq.Task) error {
+			scope := getTaskScope(task)
+			if scope.AccountID == "" {
+				return handler.ProcessTask(ctx, task)
+			}
+
+			logger := GetLogger(ctx)
+			if !cb.allow(scope) {
+				logger.Info("skipping task, circuit breaker is open", "region", scope.Region)
+				metrics.TaskCircuitOpenTotal.WithLabelValues(task.Type(), GetQueueName(ctx)).Inc()
+
+				return fmt.Errorf("%w: %w", ErrCircuitOpen, asynq.RevokeTask)
+			}
+
+			err := handler.ProcessTask(ctx, task)
+			opened, closed := cb.record(scope, err != nil && isScopeFailure(err))
+			switch {
+			case opened:
+				logger.Warn(
+					"circuit breaker opened",
+					"region", scope.Region,
+					"threshold", cb.threshold,
+					"cooldown", cb.cooldown,
+				)
+				metrics.CircuitBreakerOpen.WithLabelValues(scope.Provider, scope.AccountID, scope.Region).Set(1)
+			case closed:
+				logger.Info("circuit breaker closed", "region", scope.Region)
+				metrics.CircuitBreakerOpen.WithLabelValues(scope.Provider, scope.AccountID, scope.Region).Set(0)
+			}
+
+			return err
+		}
+
+		return asynq.HandlerFunc(mw)
+	}
+
+	return asynq.MiddlewareFunc(middleware)
+}
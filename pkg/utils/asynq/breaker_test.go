@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package asynq_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/gardener/inventory/pkg/core/config"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+func TestCircuitBreakerMiddleware(t *testing.T) {
+	var calls int
+	var failing bool
+	handler := asynq.HandlerFunc(func(_ context.Context, _ *asynq.Task) error {
+		calls++
+		if failing {
+			return errors.New("connection refused")
+		}
+
+		return nil
+	})
+
+	conf := config.CircuitBreakerConfig{
+		IsEnabled:        true,
+		FailureThreshold: 2,
+		Cooldown:         50 * time.Millisecond,
+	}
+	h := asynqutils.NewCircuitBreakerMiddleware(conf)(handler)
+	ctx := context.Background()
+	task := asynq.NewTask("aws:task:collect-vpcs", []byte(`{"account_id": "123", "region": "eu-west-1"}`))
+	otherTask := asynq.NewTask("aws:task:collect-vpcs", []byte(`{"account_id": "123", "region": "eu-central-1"}`))
+
+	// Consecutive failures open the circuit of the account and region
+	failing = true
+	for range conf.FailureThreshold {
+		if err := h.ProcessTask(ctx, task); errors.Is(err, asynqutils.ErrCircuitOpen) {
+			t.Fatalf("got error %v before reaching the threshold", err)
+		}
+	}
+
+	err := h.ProcessTask(ctx, task)
+	if !errors.Is(err, asynqutils.ErrCircuitOpen) || !errors.Is(err, asynq.RevokeTask) {
+		t.Fatalf("got error %v, wanted %v", err, asynqutils.ErrCircuitOpen)
+	}
+	if calls != conf.FailureThreshold {
+		t.Fatalf("got %d calls, wanted %d", calls, conf.FailureThreshold)
+	}
+
+	// Other regions are not affected
+	failing = false
+	if err := h.ProcessTask(ctx, otherTask); err != nil {
+		t.Fatalf("got error %v for other region", err)
+	}
+
+	// A successful probe after the cooldown closes the circuit
+	time.Sleep(conf.Cooldown)
+	if err := h.ProcessTask(ctx, task); err != nil {
+		t.Fatalf("got error %v for probe", err)
+	}
+	if err := h.ProcessTask(ctx, task); err != nil {
+		t.Fatalf("got error %v after closing the circuit", err)
+	}
+}
+
+func TestCircuitBreakerMiddlewareFailedProbe(t *testing.T) {
+	handler := asynq.HandlerFunc(func(_ context.Context, _ *asynq.Task) error {
+		return asynqutils.ErrThrottled
+	})
+
+	conf := config.CircuitBreakerConfig{
+		IsEnabled:        true,
+		FailureThreshold: 1,
+		Cooldown:         50 * time.Millisecond,
+	}
+	h := asynqutils.NewCircuitBreakerMiddleware(conf)(handler)
+	ctx := context.Background()
+	task := asynq.NewTask("gcp:task:collect-disks", []byte(`{"project_id": "my-project"}`))
+
+	if err := h.ProcessTask(ctx, task); !errors.Is(err, asynqutils.ErrThrottled) {
+		t.Fatalf("got error %v, wanted %v", err, asynqutils.ErrThrottled)
+	}
+
+	// A failing probe opens the circuit again
+	time.Sleep(conf.Cooldown)
+	if err := h.ProcessTask(ctx, task); !errors.Is(err, asynqutils.ErrThrottled) {
+		t.Fatalf("got error %v for probe, wanted %v", err, asynqutils.ErrThrottled)
+	}
+	if err := h.ProcessTask(ctx, task); !errors.Is(err, asynqutils.ErrCircuitOpen) {
+		t.Fatalf("got error %v, wanted %v", err, asynqutils.ErrCircuitOpen)
+	}
+}
+
+func TestCircuitBreakerMiddlewareIgnoredErrors(t *testing.T) {
+	handler := asynq.HandlerFunc(func(_ context.Context, task *asynq.Task) error {
+		if task.Payload() == nil {
+			return errors.New("connection refused")
+		}
+
+		return asynqutils.ErrNotFound
+	})
+
+	conf := config.CircuitBreakerConfig{
+		IsEnabled:        true,
+		FailureThreshold: 1,
+		Cooldown:         time.Hour,
+	}
+	h := asynqutils.NewCircuitBreakerMiddleware(conf)(handler)
+	ctx := context.Background()
+
+	testCases := []struct {
+		desc string
+		task *asynq.Task
+		want error
+	}{
+		{
+			desc: "missing resources",
+			task: asynq.NewTask("az:task:collect-vms", []byte(`{"subscription_id": "sub-1"}`)),
+			want: asynqutils.ErrNotFound,
+		},
+		{
+			desc: "tasks without account",
+			task: asynq.NewTask("aws:task:collect-vpcs", nil),
+			want: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			for range 2 {
+				err := h.ProcessTask(ctx, tc.task)
+				if errors.Is(err, asynqutils.ErrCircuitOpen) {
+					t.Fatalf("got error %v", err)
+				}
+				if tc.want != nil && !errors.Is(err, tc.want) {
+					t.Fatalf("got error %v, wanted %v", err, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCircuitBreakerMiddlewareScopes(t *testing.T) {
+	handler := asynq.HandlerFunc(func(_ context.Context, _ *asynq.Task) error {
+		return asynqutils.ErrThrottled
+	})
+
+	conf := config.CircuitBreakerConfig{
+		IsEnabled:        true,
+		FailureThreshold: 1,
+		Cooldown:         time.Hour,
+	}
+
+	testCases := []struct {
+		desc  string
+		task  *asynq.Task
+		other *asynq.Task
+	}{
+		{
+			desc:  "aws account",
+			task:  asynq.NewTask("aws:task:collect-vpcs", []byte(`{"account_id": "123", "region": "eu-west-1"}`)),
+			other: asynq.NewTask("aws:task:collect-vpcs", []byte(`{"account_id": "456", "region": "eu-west-1"}`)),
+		},
+		{
+			desc:  "gcp project",
+			task:  asynq.NewTask("gcp:task:collect-disks", []byte(`{"project_id": "my-project"}`)),
+			other: asynq.NewTask("gcp:task:collect-disks", []byte(`{"project_id": "other-project"}`)),
+		},
+		{
+			desc:  "azure subscription",
+			task:  asynq.NewTask("az:task:collect-vms", []byte(`{"subscription_id": "sub-1"}`)),
+			other: asynq.NewTask("az:task:collect-vms", []byte(`{"subscription_id": "sub-2"}`)),
+		},
+		{
+			desc:  "openstack scope",
+			task:  asynq.NewTask("openstack:task:collect-servers", []byte(`{"scope": {"ProjectID": "p1", "Region": "eu-de-1"}}`)),
+			other: asynq.NewTask("openstack:task:collect-servers", []byte(`{"scope": {"ProjectID": "p1", "Region": "eu-de-2"}}`)),
+		},
+		{
+			desc:  "gardener seed",
+			task:  asynq.NewTask("g:task:collect-machines", []byte(`{"seed": "seed-1"}`)),
+			other: asynq.NewTask("g:task:collect-machines", []byte(`{"seed": "seed-2"}`)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			h := asynqutils.NewCircuitBreakerMiddleware(conf)(handler)
+			ctx := context.Background()
+
+			if err := h.ProcessTask(ctx, tc.task); !errors.Is(err, asynqutils.ErrThrottled) {
+				t.Fatalf("got error %v, wanted %v", err, asynqutils.ErrThrottled)
+			}
+			if err := h.ProcessTask(ctx, tc.task); !errors.Is(err, asynqutils.ErrCircuitOpen) {
+				t.Fatalf("got error %v, wanted %v", err, asynqutils.ErrCircuitOpen)
+			}
+			if err := h.ProcessTask(ctx, tc.other); errors.Is(err, asynqutils.ErrCircuitOpen) {
+				t.Fatalf("got error %v for other scope", err)
+			}
+		})
+	}
+}
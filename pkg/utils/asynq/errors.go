@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package asynq
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/aws/smithy-go"
+	"github.com/hibiken/asynq"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorClass represents the class of an error returned by a task handler.
+type ErrorClass string
+
+const (
+	// ErrorClassThrottled is the class of errors caused by rate limiting
+	// of the provider APIs.
+	ErrorClassThrottled ErrorClass = "throttled"
+
+	// ErrorClassAuth is the class of errors caused by invalid credentials
+	// or missing permissions.
+	ErrorClassAuth ErrorClass = "auth"
+
+	// ErrorClassNotFound is the class of errors caused by resources,
+	// which do not exist.
+	ErrorClassNotFound ErrorClass = "not_found"
+
+	// ErrorClassPanic is the class of errors caused by panicking task
+	// handlers.
+	ErrorClassPanic ErrorClass = "panic"
+
+	// ErrorClassOther is the class of all remaining errors.
+	ErrorClassOther ErrorClass = "other"
+)
+
+var (
+	// ErrThrottled is an error, which is returned when a request to a
+	// provider API has been rate limited.
+	ErrThrottled = errors.New("request throttled")
+
+	// ErrUnauthorized is an error, which is returned when a request to a
+	// provider API has been rejected due to invalid credentials or missing
+	// permissions.
+	ErrUnauthorized = errors.New("request unauthorized")
+
+	// ErrNotFound is an error, which is returned when a requested resource
+	// does not exist.
+	ErrNotFound = errors.New("resource not found")
+
+	// ErrPanic is an error, which is returned when a task handler panics.
+	ErrPanic = errors.New("task handler panicked")
+)
+
+// classError associates an error class with its typed error.
+type classError struct {
+	class ErrorClass
+	err   error
+}
+
+// classErrors are the typed errors of the error classes in order of
+// precedence, which is used for errors wrapping more than one of them, e.g.
+// joined errors. Missing resources come last, so that they do not hide
+// failures of the whole account.
+var classErrors = []classError{
+	{class: ErrorClassPanic, err: ErrPanic},
+	{class: ErrorClassAuth, err: ErrUnauthorized},
+	{class: ErrorClassThrottled, err: ErrThrottled},
+	{class: ErrorClassNotFound, err: ErrNotFound},
+}
+
+// throttledCodes are the AWS error codes of rate limited requests, which are
+// not necessarily reported with HTTP status code 429.
+var throttledCodes = []string{
+	"Throttling",
+	"ThrottlingException",
+	"ThrottledException",
+	"RequestLimitExceeded",
+	"RequestThrottled",
+	"RequestThrottledException",
+	"TooManyRequestsException",
+	"SlowDown",
+}
+
+// authCodes are the AWS error codes of requests with invalid credentials or
+// missing permissions, which are not necessarily reported with HTTP status
+// codes 401 or 403.
+var authCodes = []string{
+	"AccessDenied",
+	"AccessDeniedException",
+	"AuthFailure",
+	"ExpiredToken",
+	"ExpiredTokenException",
+	"InvalidClientTokenId",
+	"UnauthorizedOperation",
+	"UnrecognizedClientException",
+}
+
+// ClassifyError returns the [ErrorClass] of the given error. Errors, which
+// wrap any of the typed errors, e.g. [ErrThrottled], are classified
+// accordingly, where errors wrapping more than one of them are classified as
+// panics, auth, throttled and not found errors in this order. Otherwise the AWS error codes, the HTTP status codes and the
+// gRPC status codes reported by the provider SDKs are used.
+func ClassifyError(err error) ErrorClass {
+	if err == nil {
+		return ""
+	}
+
+	for _, ce := range classErrors {
+		if errors.Is(err, ce.err) {
+			return ce.class
+		}
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		code := apiErr.ErrorCode()
+		switch {
+		case slices.Contains(throttledCodes, code):
+			return ErrorClassThrottled
+		case slices.Contains(authCodes, code):
+			return ErrorClassAuth
+		case strings.HasSuffix(code, "NotFound"), strings.HasPrefix(code, "NoSuch"):
+			return ErrorClassNotFound
+		}
+	}
+
+	if statusCode, ok := httpStatusCode(err); ok {
+		switch statusCode {
+		case http.StatusTooManyRequests:
+			return ErrorClassThrottled
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return ErrorClassAuth
+		case http.StatusNotFound:
+			return ErrorClassNotFound
+		}
+	}
+
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.ResourceExhausted:
+			return ErrorClassThrottled
+		case codes.Unauthenticated, codes.PermissionDenied:
+			return ErrorClassAuth
+		case codes.NotFound:
+			return ErrorClassNotFound
+		}
+	}
+
+	return ErrorClassOther
+}
+
+// httpStatusCode returns the HTTP status code of the response, from which the
+// given error originates, if known.
+func httpStatusCode(err error) (int, bool) {
+	// AWS SDK errors
+	var awsErr interface{ HTTPStatusCode() int }
+	if errors.As(err, &awsErr) {
+		return awsErr.HTTPStatusCode(), true
+	}
+
+	// Azure SDK errors
+	var azErr *azcore.ResponseError
+	if errors.As(err, &azErr) {
+		return azErr.StatusCode, true
+	}
+
+	// Azure Graph SDK and OpenStack errors
+	var statusErr interface{ GetStatusCode() int }
+	if errors.As(err, &statusErr) {
+		return statusErr.GetStatusCode(), true
+	}
+
+	// GCP errors of REST clients
+	var gcpErr *googleapi.Error
+	if errors.As(err, &gcpErr) {
+		return gcpErr.Code, true
+	}
+
+	return 0, false
+}
+
+// WrapClassifiedError wraps the given error with the typed error of its
+// [ErrorClass], if any. Errors caused by invalid credentials, missing
+// resources and panics are also wrapped with [asynq.SkipRetry], since retrying
+// the task would fail in the same way.
+func WrapClassifiedError(err error) error {
+	class := ClassifyError(err)
+	idx := slices.IndexFunc(classErrors, func(ce classError) bool {
+		return ce.class == class
+	})
+	if idx == -1 {
+		return err
+	}
+	classErr := classErrors[idx].err
+
+	if !errors.Is(err, classErr) {
+		err = fmt.Errorf("%w: %w", classErr, err)
+	}
+
+	if class != ErrorClassThrottled && !errors.Is(err, asynq.SkipRetry) {
+		err = SkipRetry(err)
+	}
+
+	return err
+}
+
+// RetryDelay is an [asynq.RetryDelayFunc], which doubles the default delay of
+// the retries of tasks, which failed due to rate limiting, in order to give
+// the provider APIs time to recover.
+func RetryDelay(n int, err error, task *asynq.Task) time.Duration {
+	delay := asynq.DefaultRetryDelayFunc(n, err, task)
+	if errors.Is(err, ErrThrottled) {
+		delay *= 2
+	}
+
+	return delay
+}
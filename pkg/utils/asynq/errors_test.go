@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package asynq_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/aws/smithy-go"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/hibiken/asynq"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+func TestClassifyError(t *testing.T) {
+	testCases := []struct {
+		desc string
+		err  error
+		want asynqutils.ErrorClass
+	}{
+		{
+			desc: "no error",
+			err:  nil,
+			want: "",
+		},
+		{
+			desc: "typed error",
+			err:  fmt.Errorf("collecting: %w", asynqutils.ErrNotFound),
+			want: asynqutils.ErrorClassNotFound,
+		},
+		{
+			desc: "throttled and not found",
+			err:  errors.Join(asynqutils.ErrNotFound, asynqutils.ErrThrottled),
+			want: asynqutils.ErrorClassThrottled,
+		},
+		{
+			desc: "auth and throttled",
+			err:  fmt.Errorf("%w: %w", asynqutils.ErrThrottled, asynqutils.ErrUnauthorized),
+			want: asynqutils.ErrorClassAuth,
+		},
+		{
+			desc: "panic and not found",
+			err:  errors.Join(asynqutils.ErrNotFound, asynqutils.ErrPanic),
+			want: asynqutils.ErrorClassPanic,
+		},
+		{
+			desc: "aws throttling",
+			err:  &smithy.GenericAPIError{Code: "RequestLimitExceeded"},
+			want: asynqutils.ErrorClassThrottled,
+		},
+		{
+			desc: "aws auth failure",
+			err:  &smithy.GenericAPIError{Code: "AuthFailure"},
+			want: asynqutils.ErrorClassAuth,
+		},
+		{
+			desc: "aws not found",
+			err:  &smithy.GenericAPIError{Code: "InvalidInstanceID.NotFound"},
+			want: asynqutils.ErrorClassNotFound,
+		},
+		{
+			desc: "azure forbidden",
+			err:  &azcore.ResponseError{StatusCode: 403},
+			want: asynqutils.ErrorClassAuth,
+		},
+		{
+			desc: "gcp too many requests",
+			err:  &googleapi.Error{Code: 429},
+			want: asynqutils.ErrorClassThrottled,
+		},
+		{
+			desc: "gcp grpc not found",
+			err:  status.Error(codes.NotFound, "not found"),
+			want: asynqutils.ErrorClassNotFound,
+		},
+		{
+			desc: "openstack unauthorized",
+			err:  gophercloud.ErrUnexpectedResponseCode{Actual: 401},
+			want: asynqutils.ErrorClassAuth,
+		},
+		{
+			desc: "server error",
+			err:  &azcore.ResponseError{StatusCode: 500},
+			want: asynqutils.ErrorClassOther,
+		},
+		{
+			desc: "unknown error",
+			err:  errors.New("boom"),
+			want: asynqutils.ErrorClassOther,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := asynqutils.ClassifyError(tc.err)
+			if got != tc.want {
+				t.Fatalf("got class %q, wanted %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWrapClassifiedError(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		err       error
+		want      error
+		skipRetry bool
+	}{
+		{
+			desc:      "throttled",
+			err:       &googleapi.Error{Code: 429},
+			want:      asynqutils.ErrThrottled,
+			skipRetry: false,
+		},
+		{
+			desc:      "auth",
+			err:       &azcore.ResponseError{StatusCode: 401},
+			want:      asynqutils.ErrUnauthorized,
+			skipRetry: true,
+		},
+		{
+			desc:      "not found",
+			err:       status.Error(codes.NotFound, "not found"),
+			want:      asynqutils.ErrNotFound,
+			skipRetry: true,
+		},
+		{
+			desc:      "other",
+			err:       errors.New("boom"),
+			want:      nil,
+			skipRetry: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := asynqutils.WrapClassifiedError(tc.err)
+			if !errors.Is(got, tc.err) {
+				t.Fatalf("got error %v, which does not wrap %v", got, tc.err)
+			}
+			if tc.want != nil && !errors.Is(got, tc.want) {
+				t.Fatalf("got error %v, wanted %v", got, tc.want)
+			}
+			if errors.Is(got, asynq.SkipRetry) != tc.skipRetry {
+				t.Fatalf("got error %v, wanted skip retry %t", got, tc.skipRetry)
+			}
+		})
+	}
+}
+
+func TestErrorClassificationMiddleware(t *testing.T) {
+	handler := asynq.HandlerFunc(func(_ context.Context, _ *asynq.Task) error {
+		panic("boom")
+	})
+
+	mw := asynqutils.NewErrorClassificationMiddleware()
+	err := mw(handler).ProcessTask(context.Background(), asynq.NewTask("test:task:panic", nil))
+	if !errors.Is(err, asynqutils.ErrPanic) {
+		t.Fatalf("got error %v, wanted %v", err, asynqutils.ErrPanic)
+	}
+	if !errors.Is(err, asynq.SkipRetry) {
+		t.Fatalf("got error %v, wanted %v", err, asynq.SkipRetry)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	// The default delay of the first retry is at least 15 seconds.
+	task := asynq.NewTask("test:task:throttled", nil)
+	err := asynqutils.WrapClassifiedError(&googleapi.Error{Code: 429})
+	if got := asynqutils.RetryDelay(0, err, task); got < 30*time.Second {
+		t.Fatalf("got delay %s, wanted at least 30s", got)
+	}
+}
@@ -8,18 +8,32 @@ package asynq
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"runtime/debug"
+	"strings"
 	"time"
 
 	"github.com/hibiken/asynq"
 
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+	"github.com/gardener/inventory/pkg/clients/db"
 	"github.com/gardener/inventory/pkg/core/config"
+	"github.com/gardener/inventory/pkg/core/registry"
 	"github.com/gardener/inventory/pkg/metrics"
+	"github.com/gardener/inventory/pkg/utils/schema"
+	slogutils "github.com/gardener/inventory/pkg/utils/slog"
 )
 
 // NewLoggerMiddleware returns a new [asynq.MiddlewareFunc], which embeds a
-// [slog.Logger] in the context provided to task handlers.
-func NewLoggerMiddleware(logger *slog.Logger) asynq.MiddlewareFunc {
+// [slog.Logger] in the context provided to task handlers. The log events
+// emitted by the embedded logger carry the task id, name and queue, along with
+// the provider and account of the task, if known. The per-task log level
+// overrides, sampling and rate limiting settings from the given
+// [config.LoggingConfig] are applied to the embedded logger. The rate limits
+// apply across the executions of the tasks, see [slogutils.TaskHandlers].
+func NewLoggerMiddleware(logger *slog.Logger, conf config.LoggingConfig) asynq.MiddlewareFunc {
+	taskHandlers := slogutils.NewTaskHandlers(conf)
 	middleware := func(handler asynq.Handler) asynq.Handler {
 		mw := func(ctx context.Context, task *asynq.Task) error {
 			// Add the task id, queue and task name as default
@@ -37,7 +51,21 @@ func NewLoggerMiddleware(logger *slog.Logger) asynq.MiddlewareFunc {
 
 			taskName := task.Type()
 			attrs = append(attrs, slog.String("task_name", taskName))
+
+			// Add the provider and account, so that the log
+			// events of a given account can be correlated across
+			// the tasks.
+			scope := getTaskScope(task)
+			if scope.Provider != "" {
+				attrs = append(attrs, slog.String("task_provider", scope.Provider))
+			}
+			if scope.AccountID != "" {
+				attrs = append(attrs, slog.String("task_account", scope.AccountID))
+			}
 			logHandler := logger.Handler().WithAttrs(attrs)
+
+			logHandler = taskHandlers.Wrap(logHandler, taskName)
+
 			newLogger := slog.New(logHandler)
 			newCtx := context.WithValue(ctx, loggerKey{}, newLogger)
 
@@ -88,12 +116,15 @@ func NewMeasuringMiddleware() asynq.MiddlewareFunc {
 }
 
 // NewMetricsMiddleware returns a new [asynq.MiddlewareFunc] which provides
-// metrics about task handlers.
+// metrics about task handlers. The context provided to task handlers embeds a
+// [metrics.CollectionStats], which tracks the provider API calls and pages of
+// the task execution.
 func NewMetricsMiddleware() asynq.MiddlewareFunc {
 	middleware := func(handler asynq.Handler) asynq.Handler {
 		mw := func(ctx context.Context, task *asynq.Task) error {
 			taskName := task.Type()
 			queueName := GetQueueName(ctx)
+			ctx, stats := metrics.WithCollectionStats(ctx)
 
 			start := time.Now()
 			err := handler.ProcessTask(ctx, task)
@@ -104,7 +135,12 @@ func NewMetricsMiddleware() asynq.MiddlewareFunc {
 				// OK
 				metrics.TaskSuccessfulTotal.WithLabelValues(taskName, queueName).Inc()
 				metrics.TaskDurationSeconds.WithLabelValues(taskName, queueName).Observe(elapsed.Seconds())
-			case errors.Is(err, asynq.SkipRetry):
+
+				accountID := getTaskScope(task).AccountID
+				metrics.CollectionDurationSeconds.WithLabelValues(taskName, accountID).Observe(elapsed.Seconds())
+				metrics.CollectionAPICalls.WithLabelValues(taskName, accountID).Observe(float64(stats.APICalls()))
+				metrics.CollectionPages.WithLabelValues(taskName, accountID).Observe(float64(stats.Pages()))
+			case errors.Is(err, asynq.SkipRetry), errors.Is(err, asynq.RevokeTask):
 				// Skipped
 				metrics.TaskSkippedTotal.WithLabelValues(taskName, queueName).Inc()
 			default:
@@ -120,3 +156,177 @@ func NewMetricsMiddleware() asynq.MiddlewareFunc {
 
 	return asynq.MiddlewareFunc(middleware)
 }
+
+// NewErrorClassificationMiddleware returns a new [asynq.MiddlewareFunc], which
+// recovers panics of task handlers, and classifies the errors returned by task
+// handlers using [ClassifyError]. The errors are wrapped using
+// [WrapClassifiedError], so that tasks failing due to invalid credentials,
+// missing resources or panics are not retried.
+func NewErrorClassificationMiddleware() asynq.MiddlewareFunc {
+	middleware := func(handler asynq.Handler) asynq.Handler {
+		mw := func(ctx context.Context, task *asynq.Task) error {
+			err := processTaskRecover(ctx, handler, task)
+			if err == nil {
+				return nil
+			}
+
+			class := ClassifyError(err)
+			metrics.TaskErrorsTotal.WithLabelValues(task.Type(), GetQueueName(ctx), string(class)).Inc()
+
+			return WrapClassifiedError(err)
+		}
+
+		return asynq.HandlerFunc(mw)
+	}
+
+	return asynq.MiddlewareFunc(middleware)
+}
+
+// processTaskRecover processes the task using the given handler, and returns
+// an error wrapping [ErrPanic], if the handler panics.
+func processTaskRecover(ctx context.Context, handler asynq.Handler, task *asynq.Task) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			GetLogger(ctx).Error("task handler panicked", "panic", r, "stack", string(debug.Stack()))
+			err = fmt.Errorf("%w: %v", ErrPanic, r)
+		}
+	}()
+
+	return handler.ProcessTask(ctx, task)
+}
+
+// scopePayload represents the fields of task payloads, which identify the
+// provider and account for which a task is executed.
+type scopePayload struct {
+	Provider       string `json:"provider" yaml:"provider"`
+	AccountID      string `json:"account_id" yaml:"account_id"`
+	ProjectID      string `json:"project_id" yaml:"project_id"`
+	SubscriptionID string `json:"subscription_id" yaml:"subscription_id"`
+	Seed           string `json:"seed" yaml:"seed"`
+	Region         string `json:"region" yaml:"region"`
+	Scope          struct {
+		ProjectID string
+		Region    string
+	} `json:"scope" yaml:"scope"`
+}
+
+// taskScope identifies the provider and account for which a task is executed.
+type taskScope struct {
+	// Provider specifies the name of the provider, e.g. aws. It is
+	// derived from the `provider' field of the payload, or from the
+	// prefix of the task name otherwise, e.g. aws for
+	// `aws:task:collect-instances'.
+	Provider string
+
+	// AccountID specifies the AWS Account ID, GCP Project ID, Azure
+	// Subscription ID, OpenStack Project ID or Gardener Seed name from the
+	// payload. It is empty, if the payload does not specify an account.
+	AccountID string
+
+	// Region specifies the region from the payload, if any.
+	Region string
+}
+
+// getTaskScope returns the [taskScope] of the given task.
+func getTaskScope(task *asynq.Task) taskScope {
+	scope := taskScope{}
+	if prefix, _, ok := strings.Cut(task.Type(), ":"); ok {
+		scope.Provider = prefix
+	}
+
+	data := task.Payload()
+	if data == nil {
+		return scope
+	}
+
+	var payload scopePayload
+	if err := Unmarshal(data, &payload); err != nil {
+		return scope
+	}
+
+	if payload.Provider != "" {
+		scope.Provider = payload.Provider
+	}
+	scope.Region = payload.Region
+	if scope.Region == "" {
+		scope.Region = payload.Scope.Region
+	}
+
+	for _, id := range []string{payload.AccountID, payload.ProjectID, payload.SubscriptionID, payload.Scope.ProjectID, payload.Seed} {
+		if id != "" {
+			scope.AccountID = id
+
+			break
+		}
+	}
+
+	return scope
+}
+
+// NewCollectionStateMiddleware returns a new [asynq.MiddlewareFunc], which
+// records the time of the last successful execution of tasks per account in
+// the [models.CollectionState] table. Failing to record the state is logged,
+// but does not fail the task.
+func NewCollectionStateMiddleware() asynq.MiddlewareFunc {
+	middleware := func(handler asynq.Handler) asynq.Handler {
+		mw := func(ctx context.Context, task *asynq.Task) error {
+			err := handler.ProcessTask(ctx, task)
+			if err != nil || db.DB == nil {
+				return err
+			}
+
+			item := models.CollectionState{
+				TaskName:      task.Type(),
+				AccountID:     getTaskScope(task).AccountID,
+				LastSuccessAt: time.Now(),
+			}
+
+			_, dbErr := db.DB.NewInsert().
+				Model(&item).
+				On("CONFLICT (task_name, account_id) DO UPDATE").
+				Set("last_success_at = EXCLUDED.last_success_at").
+				Set("updated_at = EXCLUDED.updated_at").
+				Exec(ctx)
+
+			if dbErr != nil {
+				GetLogger(ctx).Warn("could not record collection state", "reason", dbErr)
+			}
+
+			return nil
+		}
+
+		return asynq.HandlerFunc(mw)
+	}
+
+	return asynq.MiddlewareFunc(middleware)
+}
+
+// NewPayloadValidationMiddleware returns a new [asynq.MiddlewareFunc], which
+// validates the payloads of tasks against the schemas registered in the given
+// registry. Tasks with invalid payloads are not retried. Tasks without a
+// payload or without a registered schema are passed as-is to the handlers.
+func NewPayloadValidationMiddleware(r *registry.Registry[string, *schema.Schema]) asynq.MiddlewareFunc {
+	middleware := func(handler asynq.Handler) asynq.Handler {
+		mw := func(ctx context.Context, task *asynq.Task) error {
+			data := task.Payload()
+			if data == nil {
+				return handler.ProcessTask(ctx, task)
+			}
+
+			s, ok := r.Get(task.Type())
+			if !ok {
+				return handler.ProcessTask(ctx, task)
+			}
+
+			if err := s.ValidatePayload(data); err != nil {
+				return SkipRetry(err)
+			}
+
+			return handler.ProcessTask(ctx, task)
+		}
+
+		return asynq.HandlerFunc(mw)
+	}
+
+	return asynq.MiddlewareFunc(middleware)
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package asynq
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/robfig/cron/v3"
+)
+
+// DeadlineJob is a [cron.Job], which enqueues a periodic task with a deadline
+// relative to the time the task is enqueued.
+//
+// The [asynq.Scheduler] enqueues periodic tasks with the options provided upon
+// registration, which cannot express a relative deadline. A [DeadlineJob]
+// computes a new set of options for each enqueued task instead.
+type DeadlineJob struct {
+	client   *asynq.Client
+	task     *asynq.Task
+	deadline time.Duration
+	opts     []asynq.Option
+}
+
+var _ cron.Job = &DeadlineJob{}
+
+// NewDeadlineJob creates a new [DeadlineJob], which enqueues the given task
+// using the given client and options, with a deadline relative to the time the
+// task is enqueued.
+func NewDeadlineJob(client *asynq.Client, task *asynq.Task, deadline time.Duration, opts ...asynq.Option) *DeadlineJob {
+	job := &DeadlineJob{
+		client:   client,
+		task:     task,
+		deadline: deadline,
+		opts:     opts,
+	}
+
+	return job
+}
+
+// Options returns the options for enqueueing the task at the given time. Each
+// call returns a new set of options, which is not shared with other calls.
+func (j *DeadlineJob) Options(now time.Time) []asynq.Option {
+	opts := make([]asynq.Option, 0, len(j.opts)+1)
+	opts = append(opts, j.opts...)
+
+	return append(opts, asynq.Deadline(now.Add(j.deadline)))
+}
+
+// Run implements the [cron.Job] interface. The task is enqueued using the
+// same hooks as the tasks enqueued by the [asynq.Scheduler], i.e.
+// [SchedulerPreEnqueueFunc] and [SchedulerEnqueueErrorHandler].
+func (j *DeadlineJob) Run() {
+	opts := j.Options(time.Now())
+	SchedulerPreEnqueueFunc(j.task, opts)
+	info, err := j.client.Enqueue(j.task, opts...)
+	if err != nil {
+		SchedulerEnqueueErrorHandler(j.task, opts, err)
+
+		return
+	}
+
+	slog.Info("enqueued task", "name", j.task.Type(), "id", info.ID, "queue", info.Queue)
+}
+
+// SchedulerPreEnqueueFunc is called before a periodic task is enqueued, and
+// logs the task being enqueued.
+func SchedulerPreEnqueueFunc(task *asynq.Task, _ []asynq.Option) {
+	slog.Info("enqueueing task", "name", task.Type())
+}
+
+// SchedulerEnqueueErrorHandler is called when a periodic task could not be
+// enqueued, and logs the reason. Duplicate tasks are logged as skipped.
+func SchedulerEnqueueErrorHandler(task *asynq.Task, _ []asynq.Option, err error) {
+	if errors.Is(err, asynq.ErrDuplicateTask) {
+		slog.Info("skipping duplicate task", "name", task.Type())
+
+		return
+	}
+	slog.Error("failed to enqueue", "name", task.Type(), "error", err)
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package asynq_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+)
+
+func TestDeadlineJobOptions(t *testing.T) {
+	baseOpts := []asynq.Option{asynq.Queue("aws"), asynq.MaxRetry(3)}
+	task := asynq.NewTask("aws:task:collect-vpcs", nil)
+	job := asynqutils.NewDeadlineJob(nil, task, 50*time.Minute, baseOpts...)
+
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	for i := range 3 {
+		now := start.Add(time.Duration(i) * time.Hour)
+		opts := job.Options(now)
+
+		if len(opts) != len(baseOpts)+1 {
+			t.Fatalf("cycle %d: got %d options, wanted %d", i, len(opts), len(baseOpts)+1)
+		}
+
+		var deadline time.Time
+		for _, opt := range opts {
+			if opt.Type() == asynq.DeadlineOpt {
+				deadline, _ = opt.Value().(time.Time)
+			}
+		}
+
+		want := now.Add(50 * time.Minute)
+		if !deadline.Equal(want) {
+			t.Fatalf("cycle %d: got deadline %s, wanted %s", i, deadline, want)
+		}
+
+		// Mutating the returned options must not affect other cycles
+		opts[0] = asynq.Queue("other")
+	}
+
+	for _, opt := range baseOpts {
+		if opt.Type() == asynq.DeadlineOpt {
+			t.Fatal("got deadline in the base options")
+		}
+	}
+
+	if got := job.Options(start)[0].String(); got != `Queue("aws")` {
+		t.Fatalf("got option %s, wanted %s", got, `Queue("aws")`)
+	}
+}
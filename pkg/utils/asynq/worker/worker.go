@@ -11,9 +11,12 @@ import (
 	"runtime"
 
 	"github.com/hibiken/asynq"
+	asynqmetrics "github.com/hibiken/asynq/x/metrics"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/gardener/inventory/pkg/core/config"
 	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/gardener/inventory/pkg/core/supervisor"
 	"github.com/gardener/inventory/pkg/metrics"
 )
 
@@ -29,6 +32,9 @@ type Worker struct {
 	metricsAddr   string
 	metricsPath   string
 	metricsServer *http.Server
+
+	// inspector is used for reporting the queue metrics, if enabled.
+	inspector *asynq.Inspector
 }
 
 // WithLogLevel is an [Option], which configures the log level of the [Worker].
@@ -50,6 +56,26 @@ func WithErrorHandler(handler asynq.ErrorHandler) Option {
 	return opt
 }
 
+// WithGroupAggregator is an [Option], which configures the [Worker] to use the
+// specified [asynq.GroupAggregator] for aggregating grouped tasks.
+func WithGroupAggregator(aggregator asynq.GroupAggregator) Option {
+	opt := func(conf *asynq.Config) {
+		conf.GroupAggregator = aggregator
+	}
+
+	return opt
+}
+
+// WithRetryDelayFunc is an [Option], which configures the [Worker] to use the
+// specified [asynq.RetryDelayFunc] for computing the delay of task retries.
+func WithRetryDelayFunc(fn asynq.RetryDelayFunc) Option {
+	opt := func(conf *asynq.Config) {
+		conf.RetryDelayFunc = fn
+	}
+
+	return opt
+}
+
 // NewFromConfig creates a new [Worker] based on the provided
 // [config.WorkerConfig] spec.
 func NewFromConfig(ctx context.Context, r asynq.RedisClientOpt, conf config.WorkerConfig, opts ...Option) *Worker {
@@ -58,19 +84,15 @@ func NewFromConfig(ctx context.Context, r asynq.RedisClientOpt, conf config.Work
 		concurrency = runtime.NumCPU()
 	}
 
-	defaultQueues := map[string]int{
-		config.DefaultQueueName: 1,
-	}
-
-	queues := conf.Queues
-	if len(queues) == 0 {
-		queues = defaultQueues
-	}
+	queues := conf.ProcessedQueues()
 
 	asynqConfig := asynq.Config{
-		Concurrency:    concurrency,
-		Queues:         queues,
-		StrictPriority: conf.StrictPriority,
+		Concurrency:      concurrency,
+		Queues:           queues,
+		StrictPriority:   conf.StrictPriority,
+		GroupGracePeriod: conf.Groups.GracePeriod,
+		GroupMaxDelay:    conf.Groups.MaxDelay,
+		GroupMaxSize:     conf.Groups.MaxSize,
 	}
 
 	for _, opt := range opts {
@@ -87,9 +109,17 @@ func NewFromConfig(ctx context.Context, r asynq.RedisClientOpt, conf config.Work
 		metricsPath = config.DefaultWorkerMetricsPath
 	}
 
+	// Queue metrics
+	var inspector *asynq.Inspector
+	collectors := make([]prometheus.Collector, 0)
+	if conf.Metrics.Queues {
+		inspector = asynq.NewInspector(r)
+		collectors = append(collectors, asynqmetrics.NewQueueMetricsCollector(inspector))
+	}
+
 	asynqServer := asynq.NewServer(r, asynqConfig)
 	asynqMux := asynq.NewServeMux()
-	metricsServer := metrics.NewServer(ctx, metricsAddr, metricsPath)
+	metricsServer := metrics.NewServer(ctx, metricsAddr, metricsPath, collectors...)
 
 	worker := &Worker{
 		asynqServer:   asynqServer,
@@ -97,6 +127,7 @@ func NewFromConfig(ctx context.Context, r asynq.RedisClientOpt, conf config.Work
 		metricsAddr:   metricsAddr,
 		metricsPath:   metricsPath,
 		metricsServer: metricsServer,
+		inspector:     inspector,
 	}
 
 	return worker
@@ -123,29 +154,49 @@ func (w *Worker) HandlersFromRegistry(reg *registry.Registry[string, asynq.Handl
 	})
 }
 
-// Run starts the task processing by calling [asynq.Server.Start] and blocks
-// until an OS signal is received.
-func (w *Worker) Run() error {
-	go func() {
-		slog.Info(
-			"starting metrics server",
-			"address", w.metricsAddr,
-			"path", w.metricsPath,
-		)
-		if err := w.metricsServer.ListenAndServe(); err != http.ErrServerClosed {
-			slog.Error("failed to start metrics server", "reason", err)
-		}
-	}()
-
-	return w.asynqServer.Run(w.asynqMux)
-}
+// Components returns the [supervisor.Component] items of the [Worker], which
+// are the metrics server and the [asynq.Server] itself.
+func (w *Worker) Components() []supervisor.Component {
+	metricsComponent := supervisor.HTTPServerComponent("metrics-server", w.metricsServer)
+
+	asynqComponent := supervisor.Component{
+		Name: "asynq-server",
+		Run: func(ctx context.Context) error {
+			if err := w.asynqServer.Start(w.asynqMux); err != nil {
+				return err
+			}
+			<-ctx.Done()
+
+			return nil
+		},
+		Shutdown: func(_ context.Context) error {
+			w.asynqServer.Shutdown()
+			if w.inspector != nil {
+				return w.inspector.Close()
+			}
+
+			return nil
+		},
+		RestartPolicy: supervisor.RestartNever,
+	}
 
-// Shutdown gracefully shuts down the server by calling [asynq.Server.Shutdown].
-func (w *Worker) Shutdown() {
-	w.asynqServer.Shutdown()
+	return []supervisor.Component{metricsComponent, asynqComponent}
+}
 
-	slog.Info("shutting down metrics server")
-	if err := w.metricsServer.Shutdown(context.Background()); err != nil {
-		slog.Error("failed to gracefully shutdown metrics server", "reason", err)
-	}
+// Run starts the metrics server and the task processing under a
+// [supervisor.Supervisor], and blocks until the context is cancelled, an OS
+// signal is received, or any of the components fails. Additional components,
+// which share the lifecycle of the [Worker], may be specified as well.
+func (w *Worker) Run(ctx context.Context, components ...supervisor.Component) error {
+	slog.Info(
+		"starting metrics server",
+		"address", w.metricsAddr,
+		"path", w.metricsPath,
+	)
+
+	sup := supervisor.New()
+	sup.Add(w.Components()...)
+	sup.Add(components...)
+
+	return sup.Run(ctx)
 }
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+)
+
+// ErrAPITokenNotFound is an error, which is returned when an API token does not
+// exist, or has been revoked already.
+var ErrAPITokenNotFound = errors.New("api token not found")
+
+// InsertAPIToken stores the given [models.APIToken].
+func InsertAPIToken(ctx context.Context, db bun.IDB, item *models.APIToken) error {
+	_, err := db.NewInsert().Model(item).Exec(ctx)
+
+	return err
+}
+
+// GetAPITokenByHash returns the [models.APIToken] with the given hash.
+func GetAPITokenByHash(ctx context.Context, db bun.IDB, hash string) (*models.APIToken, error) {
+	var item models.APIToken
+	err := db.NewSelect().
+		Model(&item).
+		Where("token_hash = ?", hash).
+		Scan(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// ListAPITokens returns the API tokens ordered by name.
+func ListAPITokens(ctx context.Context, db bun.IDB) ([]models.APIToken, error) {
+	items := make([]models.APIToken, 0)
+	err := db.NewSelect().
+		Model(&items).
+		Order("name").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// RevokeAPIToken revokes the API token with the given name. It returns
+// [ErrAPITokenNotFound], if the token does not exist, or has been revoked
+// already.
+func RevokeAPIToken(ctx context.Context, db bun.IDB, name string) error {
+	now := time.Now()
+	res, err := db.NewUpdate().
+		Model((*models.APIToken)(nil)).
+		Set("revoked_at = ?", now).
+		Set("updated_at = ?", now).
+		Where("name = ?", name).
+		Where("revoked_at IS NULL").
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrAPITokenNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+)
+
+// DefaultAuditLogLimit specifies the default max number of audit log entries
+// returned by [ListAuditLog].
+const DefaultAuditLogLimit = 100
+
+// AuditLogOptions specifies the options for listing audit log entries.
+type AuditLogOptions struct {
+	// Action specifies the action to filter by, if not empty.
+	Action string
+
+	// Identity specifies the identity to filter by, if not empty.
+	Identity string
+
+	// Since specifies to return only entries recorded at or after the
+	// given time, if not zero.
+	Since time.Time
+
+	// Limit specifies the max number of entries to return. If zero,
+	// [DefaultAuditLogLimit] is used.
+	Limit int
+}
+
+// AuditLogEntry represents a single entry of the audit log.
+type AuditLogEntry struct {
+	// Timestamp specifies when the operation was performed.
+	Timestamp time.Time `bun:"created_at" json:"timestamp" yaml:"timestamp"`
+
+	// Identity specifies the user or identity, which performed the
+	// operation.
+	Identity string `bun:"identity" json:"identity" yaml:"identity"`
+
+	// Source specifies where the operation was performed.
+	Source string `bun:"source" json:"source" yaml:"source"`
+
+	// Action specifies the operation.
+	Action string `bun:"action" json:"action" yaml:"action"`
+
+	// Parameters specifies the parameters of the operation.
+	Parameters map[string]string `bun:"parameters,type:jsonb" json:"parameters" yaml:"parameters"`
+
+	// Error specifies the error returned by the operation, if any.
+	Error string `bun:"error" json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// InsertAuditLog records the given [models.AuditLog] entry.
+func InsertAuditLog(ctx context.Context, db bun.IDB, item *models.AuditLog) error {
+	_, err := db.NewInsert().Model(item).Exec(ctx)
+
+	return err
+}
+
+// ListAuditLog returns the audit log entries matching the given options,
+// ordered from the most recent one.
+func ListAuditLog(ctx context.Context, db *bun.DB, opts AuditLogOptions) ([]AuditLogEntry, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultAuditLogLimit
+	}
+
+	items := make([]AuditLogEntry, 0)
+	query := db.NewSelect().
+		Model((*models.AuditLog)(nil)).
+		Column("created_at", "identity", "source", "action", "parameters", "error").
+		Order("created_at DESC").
+		Limit(limit)
+
+	if opts.Action != "" {
+		query = query.Where("action = ?", opts.Action)
+	}
+	if opts.Identity != "" {
+		query = query.Where("identity = ?", opts.Identity)
+	}
+	if !opts.Since.IsZero() {
+		query = query.Where("created_at >= ?", opts.Since)
+	}
+
+	if err := query.Scan(ctx, &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
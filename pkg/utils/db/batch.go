@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"context"
+
+	"github.com/gardener/inventory/pkg/core/config"
+)
+
+// FlushFunc is a function, which persists a batch of items, e.g. by upserting
+// them into the database, and returns the number of affected rows.
+type FlushFunc[T any] func(ctx context.Context, items []T) (int64, error)
+
+// Batcher accumulates items and passes them in batches of a fixed size to a
+// [FlushFunc]. Collectors use a Batcher in order to upsert the items of large
+// collections while paginating through them, instead of keeping all items in
+// memory.
+//
+// The batch passed to the [FlushFunc] is reused afterwards, so the function
+// must not retain it.
+//
+// Once the [FlushFunc] fails, the current batch is discarded and the Batcher
+// is stopped, i.e. any subsequent call to [Batcher.Add] or [Batcher.Flush]
+// returns the same error without flushing any more items.
+type Batcher[T any] struct {
+	size  int
+	items []T
+	flush FlushFunc[T]
+	count int64
+	err   error
+}
+
+// NewBatcher returns a new [Batcher], which flushes the items in batches of
+// the given size using the given [FlushFunc]. If the size is not positive,
+// then [config.DefaultWorkerBatchSize] is used.
+func NewBatcher[T any](size int, flush FlushFunc[T]) *Batcher[T] {
+	if size <= 0 {
+		size = config.DefaultWorkerBatchSize
+	}
+
+	return &Batcher[T]{
+		size:  size,
+		items: make([]T, 0, size),
+		flush: flush,
+	}
+}
+
+// Add adds the given items to the current batch, and flushes the batch each
+// time it is full.
+func (b *Batcher[T]) Add(ctx context.Context, items ...T) error {
+	if b.err != nil {
+		return b.err
+	}
+
+	for _, item := range items {
+		b.items = append(b.items, item)
+		if len(b.items) < b.size {
+			continue
+		}
+		if err := b.Flush(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Flush flushes the items of the current batch, if any. Flush must be called
+// once all items have been added.
+func (b *Batcher[T]) Flush(ctx context.Context) error {
+	if b.err != nil {
+		return b.err
+	}
+
+	if len(b.items) == 0 {
+		return nil
+	}
+
+	count, err := b.flush(ctx, b.items)
+	clear(b.items)
+	b.items = b.items[:0]
+	if err != nil {
+		b.err = err
+
+		return err
+	}
+	b.count += count
+
+	return nil
+}
+
+// Err returns the error, which stopped the Batcher, if any.
+func (b *Batcher[T]) Err() error {
+	return b.err
+}
+
+// Count returns the total number of rows affected by the flushed batches.
+func (b *Batcher[T]) Count() int64 {
+	return b.count
+}
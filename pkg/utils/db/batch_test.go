@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+func TestBatcher(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		size        int
+		items       []int
+		wantBatches [][]int
+	}{
+		{
+			desc:        "no items",
+			size:        2,
+			items:       []int{},
+			wantBatches: [][]int{},
+		},
+		{
+			desc:        "partial batch",
+			size:        3,
+			items:       []int{1, 2},
+			wantBatches: [][]int{{1, 2}},
+		},
+		{
+			desc:        "full batches",
+			size:        2,
+			items:       []int{1, 2, 3, 4},
+			wantBatches: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			desc:        "full and partial batches",
+			size:        2,
+			items:       []int{1, 2, 3, 4, 5},
+			wantBatches: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			desc:        "default size",
+			size:        0,
+			items:       []int{1, 2, 3},
+			wantBatches: [][]int{{1, 2, 3}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			batches := make([][]int, 0)
+			flush := func(_ context.Context, items []int) (int64, error) {
+				batches = append(batches, slices.Clone(items))
+
+				return int64(len(items)), nil
+			}
+
+			ctx := context.Background()
+			batcher := dbutils.NewBatcher(tc.size, flush)
+			for _, item := range tc.items {
+				if err := batcher.Add(ctx, item); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+			if err := batcher.Flush(ctx); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !slices.EqualFunc(batches, tc.wantBatches, slices.Equal) {
+				t.Fatalf("got batches %v, wanted %v", batches, tc.wantBatches)
+			}
+			if batcher.Count() != int64(len(tc.items)) {
+				t.Fatalf("got count %d, wanted %d", batcher.Count(), len(tc.items))
+			}
+		})
+	}
+}
+
+func TestBatcherFlushError(t *testing.T) {
+	wantErr := errors.New("flush failed")
+	calls := 0
+	flush := func(_ context.Context, _ []int) (int64, error) {
+		calls++
+
+		return 0, wantErr
+	}
+
+	ctx := context.Background()
+	batcher := dbutils.NewBatcher(2, flush)
+	if err := batcher.Add(ctx, 1, 2, 3); !errors.Is(err, wantErr) {
+		t.Fatalf("got error %v, wanted %v", err, wantErr)
+	}
+
+	// The batcher is stopped after the failed flush
+	if err := batcher.Add(ctx, 4, 5); !errors.Is(err, wantErr) {
+		t.Fatalf("got error %v on add, wanted %v", err, wantErr)
+	}
+	if err := batcher.Flush(ctx); !errors.Is(err, wantErr) {
+		t.Fatalf("got error %v on flush, wanted %v", err, wantErr)
+	}
+	if err := batcher.Err(); !errors.Is(err, wantErr) {
+		t.Fatalf("got error %v from Err, wanted %v", err, wantErr)
+	}
+
+	if calls != 1 {
+		t.Fatalf("got %d flush calls, wanted 1", calls)
+	}
+	if batcher.Count() != 0 {
+		t.Fatalf("got count %d, wanted 0", batcher.Count())
+	}
+}
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"slices"
+
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// ChangeEventsTrigger is the name of the trigger, which records the changes of
+// the rows of a table as change events.
+const ChangeEventsTrigger = "aux_change_event_trigger"
+
+// changeEventModel is the name of the model of the recorded change events,
+// whose own changes are never recorded.
+const changeEventModel = "aux:model:change_event"
+
+// ConfigureChangeEvents installs the [ChangeEventsTrigger] on the tables of
+// the given models, and removes it from the tables of any other model. Models,
+// which are backed by views, or whose tables don't exist or don't have an id
+// column are skipped. It returns the names of the models, whose changes are
+// recorded.
+//
+// The trigger is only created or dropped when needed, so that calling
+// ConfigureChangeEvents repeatedly does not lock the tables.
+func ConfigureChangeEvents(ctx context.Context, db *bun.DB, modelNames []string) ([]string, error) {
+	existing := make([]string, 0)
+	existingQuery := `SELECT tgrelid::regclass::text FROM pg_trigger WHERE tgname = ?`
+	if err := db.NewRaw(existingQuery, ChangeEventsTrigger).Scan(ctx, &existing); err != nil {
+		return nil, err
+	}
+
+	enabled := make([]string, 0, len(modelNames))
+	walker := func(name string, model any) error {
+		table := db.Table(reflect.TypeOf(model).Elem())
+		isInstalled := slices.Contains(existing, table.Name)
+		isWanted := slices.Contains(modelNames, name) && name != changeEventModel
+
+		if isWanted {
+			if _, ok := table.FieldMap["id"]; !ok {
+				isWanted = false
+			}
+		}
+
+		if isWanted {
+			var relkind string
+			if err := db.NewRaw("SELECT COALESCE((SELECT relkind::text FROM pg_class WHERE oid = to_regclass(?)), '')", table.Name).Scan(ctx, &relkind); err != nil {
+				return err
+			}
+			isWanted = relkind == "r" || relkind == "p"
+		}
+
+		switch {
+		case isWanted && !isInstalled:
+			query := `CREATE TRIGGER ? AFTER INSERT OR UPDATE OR DELETE ON ? FOR EACH ROW EXECUTE FUNCTION aux_record_change(?)`
+			if _, err := db.ExecContext(ctx, query, bun.Ident(ChangeEventsTrigger), bun.Ident(table.Name), name); err != nil {
+				return err
+			}
+		case !isWanted && isInstalled:
+			if _, err := db.ExecContext(ctx, "DROP TRIGGER IF EXISTS ? ON ?", bun.Ident(ChangeEventsTrigger), bun.Ident(table.Name)); err != nil {
+				return err
+			}
+		}
+
+		if isWanted {
+			enabled = append(enabled, name)
+		}
+
+		return nil
+	}
+
+	if err := registry.ModelRegistry.Range(walker); err != nil {
+		return nil, err
+	}
+	slices.Sort(enabled)
+
+	return enabled, nil
+}
+
+// PublishChangeEvents passes the recorded change events in batches of the
+// given size to the publish function, in the order in which they were
+// recorded. The events of a batch are deleted, once the publish function
+// succeeds for the batch. Events, which are being published by concurrent
+// callers are skipped. It returns the number of published events.
+func PublishChangeEvents(ctx context.Context, db *bun.DB, batchSize int, publish func(ctx context.Context, items []models.ChangeEvent) error) (int, error) {
+	count := 0
+	for {
+		var n int
+		err := db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+			items := make([]models.ChangeEvent, 0, batchSize)
+			err := tx.NewSelect().
+				Model(&items).
+				Order("created_at").
+				Limit(batchSize).
+				For("UPDATE SKIP LOCKED").
+				Scan(ctx)
+			if err != nil || len(items) == 0 {
+				return err
+			}
+
+			if err := publish(ctx, items); err != nil {
+				return err
+			}
+
+			ids := make([]any, 0, len(items))
+			for _, item := range items {
+				ids = append(ids, item.ID)
+			}
+			_, err = tx.NewDelete().
+				Model((*models.ChangeEvent)(nil)).
+				Where("id IN (?)", bun.In(ids)).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+			n = len(items)
+
+			return nil
+		})
+
+		if err != nil {
+			return count, err
+		}
+		if n == 0 {
+			return count, nil
+		}
+		count += n
+	}
+}
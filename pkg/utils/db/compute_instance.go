@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+)
+
+// DefaultComputeInstanceLimit specifies the default max number of compute
+// instances returned by [ListComputeInstances].
+const DefaultComputeInstanceLimit = 100
+
+// ComputeInstanceOptions specifies the options for listing compute instances.
+type ComputeInstanceOptions struct {
+	// Provider specifies the cloud provider to filter by, if not empty.
+	Provider string
+
+	// AccountID specifies the account, project or subscription to filter
+	// by, if not empty.
+	AccountID string
+
+	// Region specifies the region to filter by, if not empty.
+	Region string
+
+	// Shoot specifies the technical ID of the Gardener Shoot to filter by,
+	// if not empty.
+	Shoot string
+
+	// Project specifies the Gardener Project to filter by, if not empty.
+	Project string
+
+	// Limit specifies the max number of instances to return. If zero,
+	// [DefaultComputeInstanceLimit] is used.
+	Limit int
+}
+
+// ComputeInstance represents a virtual machine of any supported cloud
+// provider, as provided by the `aux_compute_instance' view.
+type ComputeInstance struct {
+	// Provider specifies the cloud provider of the instance.
+	Provider string `bun:"provider" json:"provider" yaml:"provider"`
+
+	// Model specifies the name of the model of the underlying record.
+	Model string `bun:"model_name" json:"model" yaml:"model"`
+
+	// AccountID specifies the account, project or subscription of the
+	// instance.
+	AccountID string `bun:"account_id" json:"account_id" yaml:"account_id"`
+
+	// Region specifies the region of the instance.
+	Region string `bun:"region" json:"region" yaml:"region"`
+
+	// Zone specifies the zone of the instance, if known.
+	Zone string `bun:"zone" json:"zone,omitempty" yaml:"zone,omitempty"`
+
+	// Name specifies the name of the instance.
+	Name string `bun:"name" json:"name" yaml:"name"`
+
+	// InstanceID specifies the provider-specific ID of the instance.
+	InstanceID string `bun:"instance_id" json:"instance_id" yaml:"instance_id"`
+
+	// State specifies the state of the instance.
+	State string `bun:"state" json:"state" yaml:"state"`
+
+	// MachineType specifies the machine type of the instance, if known.
+	MachineType string `bun:"machine_type" json:"machine_type,omitempty" yaml:"machine_type,omitempty"`
+
+	// Shoot specifies the technical ID of the Gardener Shoot, if any.
+	Shoot string `bun:"technical_id" json:"shoot,omitempty" yaml:"shoot,omitempty"`
+
+	// Project specifies the Gardener Project of the Shoot, if any.
+	Project string `bun:"project" json:"project,omitempty" yaml:"project,omitempty"`
+
+	// UpdatedAt specifies when the underlying record was last updated.
+	UpdatedAt time.Time `bun:"updated_at" json:"updated_at" yaml:"updated_at"`
+}
+
+// ListComputeInstances returns the compute instances of all cloud providers
+// matching the given options, ordered by provider, account and name.
+func ListComputeInstances(ctx context.Context, db bun.IDB, opts ComputeInstanceOptions) ([]ComputeInstance, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultComputeInstanceLimit
+	}
+
+	items := make([]ComputeInstance, 0)
+	query := db.NewSelect().
+		Model((*models.ComputeInstance)(nil)).
+		Column(
+			"provider",
+			"model_name",
+			"account_id",
+			"region",
+			"zone",
+			"name",
+			"instance_id",
+			"state",
+			"machine_type",
+			"technical_id",
+			"project",
+			"updated_at",
+		).
+		Order("provider", "account_id", "name").
+		Limit(limit)
+
+	if opts.Provider != "" {
+		query = query.Where("provider = ?", opts.Provider)
+	}
+	if opts.AccountID != "" {
+		query = query.Where("account_id = ?", opts.AccountID)
+	}
+	if opts.Region != "" {
+		query = query.Where("region = ?", opts.Region)
+	}
+	if opts.Shoot != "" {
+		query = query.Where("technical_id = ?", opts.Shoot)
+	}
+	if opts.Project != "" {
+		query = query.Where("project = ?", opts.Project)
+	}
+
+	if err := query.Scan(ctx, &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// ModelCount represents the number of records of a model.
+type ModelCount struct {
+	// Model specifies the name of the model.
+	Model string `json:"model" yaml:"model"`
+
+	// Table specifies the name of the table of the model.
+	Table string `json:"table" yaml:"table"`
+
+	// Count specifies the number of records.
+	Count int64 `json:"count" yaml:"count" bun:"count"`
+
+	// MinUpdatedAt specifies the oldest update time of the records. It is
+	// nil, if the table is empty, or has no updated_at column.
+	MinUpdatedAt *time.Time `json:"min_updated_at" yaml:"min_updated_at" bun:"min_updated_at"`
+
+	// MaxUpdatedAt specifies the latest update time of the records. It is
+	// nil, if the table is empty, or has no updated_at column.
+	MaxUpdatedAt *time.Time `json:"max_updated_at" yaml:"max_updated_at" bun:"max_updated_at"`
+}
+
+// CountModels returns the exact number of records, along with the oldest and
+// latest update times of the records, for the registered models, which match
+// any of the given shell patterns. If no patterns are given, then the records
+// of all registered models are counted.
+func CountModels(ctx context.Context, db *bun.DB, patterns []string) ([]ModelCount, error) {
+	modelNames, err := matchModels(patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]ModelCount, 0, len(modelNames))
+	for _, name := range modelNames {
+		model, _ := registry.ModelRegistry.Get(name)
+		table := db.Table(reflect.TypeOf(model).Elem())
+		item := ModelCount{
+			Model: name,
+			Table: table.Name,
+		}
+
+		query := db.NewSelect().
+			TableExpr("?", bun.Ident(table.Name)).
+			ColumnExpr("COUNT(*) AS count")
+		if _, ok := table.FieldMap["updated_at"]; ok {
+			query = query.ColumnExpr("MIN(updated_at) AS min_updated_at, MAX(updated_at) AS max_updated_at")
+		}
+
+		if err := query.Scan(ctx, &item); err != nil {
+			return nil, fmt.Errorf("cannot count records of %s: %w", name, err)
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/pgdialect"
@@ -29,10 +30,78 @@ func NewFromConfig(conf config.DatabaseConfig) (*bun.DB, error) {
 		return nil, ErrInvalidDSN
 	}
 
-	pgdb := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(conf.DSN)))
-	db := bun.NewDB(pgdb, pgdialect.New())
+	return newDB(conf.DSN, conf), nil
+}
+
+// NewReadOnlyFromConfig creates a new [bun.DB] for read-only query workloads
+// based on the provided [config.DatabaseConfig] spec. If a read replica is not
+// configured, the returned database connects to the primary instead.
+func NewReadOnlyFromConfig(conf config.DatabaseConfig) (*bun.DB, error) {
+	if conf.ReadOnlyDSN == "" {
+		return NewFromConfig(conf)
+	}
+
+	return newDB(conf.ReadOnlyDSN, conf), nil
+}
+
+// WithStatementTimeout returns a [pgdriver.Option], which sets the
+// `statement_timeout' connection param to the given timeout. Connection params
+// may already be set from the DSN, in which case the value from the DSN is
+// preserved.
+func WithStatementTimeout(timeout time.Duration) pgdriver.Option {
+	return func(c *pgdriver.Config) {
+		if _, ok := c.ConnParams["statement_timeout"]; ok {
+			return
+		}
+		if c.ConnParams == nil {
+			c.ConnParams = make(map[string]any)
+		}
+		c.ConnParams["statement_timeout"] = timeout.Milliseconds()
+	}
+}
+
+// newDB creates a new [bun.DB], which connects to the given DSN and is
+// configured with the connection pool and timeout settings from the
+// [config.DatabaseConfig] spec.
+func newDB(dsn string, conf config.DatabaseConfig) *bun.DB {
+	opts := []pgdriver.Option{
+		pgdriver.WithDSN(dsn),
+	}
+
+	if conf.DialTimeout > 0 {
+		opts = append(opts, pgdriver.WithDialTimeout(conf.DialTimeout))
+	}
+
+	if conf.ReadTimeout > 0 {
+		opts = append(opts, pgdriver.WithReadTimeout(conf.ReadTimeout))
+	}
+
+	if conf.WriteTimeout > 0 {
+		opts = append(opts, pgdriver.WithWriteTimeout(conf.WriteTimeout))
+	}
+
+	if conf.StatementTimeout > 0 {
+		opts = append(opts, WithStatementTimeout(conf.StatementTimeout))
+	}
+
+	pgdb := sql.OpenDB(pgdriver.NewConnector(opts...))
+	if conf.MaxOpenConns > 0 {
+		pgdb.SetMaxOpenConns(conf.MaxOpenConns)
+	}
+
+	if conf.MaxIdleConns > 0 {
+		pgdb.SetMaxIdleConns(conf.MaxIdleConns)
+	}
+
+	if conf.ConnMaxLifetime > 0 {
+		pgdb.SetConnMaxLifetime(conf.ConnMaxLifetime)
+	}
+
+	if conf.ConnMaxIdleTime > 0 {
+		pgdb.SetConnMaxIdleTime(conf.ConnMaxIdleTime)
+	}
 
-	return db, nil
+	return bun.NewDB(pgdb, pgdialect.New())
 }
 
 // LinkFunction is a function, which establishes relationships between models.
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/schema"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// ErrModelNotFound is an error, which is returned when a model is not
+// registered in the [registry.ModelRegistry].
+var ErrModelNotFound = errors.New("model not found in registry")
+
+// relationTypes maps the bun relation types to their names.
+var relationTypes = map[int]string{
+	schema.HasOneRelation:     "has-one",
+	schema.BelongsToRelation:  "belongs-to",
+	schema.HasManyRelation:    "has-many",
+	schema.ManyToManyRelation: "m2m",
+}
+
+// ColumnDescription describes a column of a model.
+type ColumnDescription struct {
+	// Name specifies the name of the column.
+	Name string `json:"name" yaml:"name"`
+
+	// Type specifies the SQL type of the column.
+	Type string `json:"type" yaml:"type"`
+
+	// PrimaryKey specifies whether the column is part of the primary key.
+	PrimaryKey bool `json:"primary_key" yaml:"primary_key"`
+
+	// NotNull specifies whether the column is NOT NULL.
+	NotNull bool `json:"not_null" yaml:"not_null"`
+
+	// Default specifies the SQL default of the column, if any.
+	Default string `json:"default,omitempty" yaml:"default,omitempty"`
+}
+
+// UniqueKeyDescription describes a unique key of a model.
+type UniqueKeyDescription struct {
+	// Name specifies the name of the unique key.
+	Name string `json:"name" yaml:"name"`
+
+	// Columns specifies the columns of the unique key.
+	Columns []string `json:"columns" yaml:"columns"`
+}
+
+// RelationDescription describes a relation of a model, which may be loaded
+// when querying the model.
+type RelationDescription struct {
+	// Name specifies the name of the relation.
+	Name string `json:"name" yaml:"name"`
+
+	// Type specifies the type of the relation, e.g. has-many.
+	Type string `json:"type" yaml:"type"`
+
+	// Model specifies the name of the related model, if registered.
+	Model string `json:"model,omitempty" yaml:"model,omitempty"`
+
+	// Table specifies the name of the table of the related model.
+	Table string `json:"table" yaml:"table"`
+
+	// Columns specifies the columns of the model used for the join.
+	Columns []string `json:"columns" yaml:"columns"`
+
+	// JoinColumns specifies the columns of the related model used for the
+	// join.
+	JoinColumns []string `json:"join_columns" yaml:"join_columns"`
+
+	// Through specifies the name of the junction table of m2m relations.
+	Through string `json:"through,omitempty" yaml:"through,omitempty"`
+}
+
+// ModelDescription describes a model registered in the
+// [registry.ModelRegistry].
+type ModelDescription struct {
+	// Model specifies the name of the model.
+	Model string `json:"model" yaml:"model"`
+
+	// Table specifies the name of the table of the model.
+	Table string `json:"table" yaml:"table"`
+
+	// Columns specifies the columns of the model in order.
+	Columns []ColumnDescription `json:"columns" yaml:"columns"`
+
+	// UniqueKeys specifies the unique keys of the model.
+	UniqueKeys []UniqueKeyDescription `json:"unique_keys" yaml:"unique_keys"`
+
+	// Relations specifies the relations of the model.
+	Relations []RelationDescription `json:"relations" yaml:"relations"`
+
+	// AccountColumn specifies the column, which references the provider
+	// account of the records, if any.
+	AccountColumn string `json:"account_column,omitempty" yaml:"account_column,omitempty"`
+
+	// Ingestible specifies whether records of the model may be pushed by
+	// external systems.
+	Ingestible bool `json:"ingestible" yaml:"ingestible"`
+}
+
+// DescribeModel returns the [ModelDescription] of the model with the given
+// name, as defined by the Go struct of the model.
+func DescribeModel(name string) (*ModelDescription, error) {
+	model, ok := registry.ModelRegistry.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrModelNotFound, name)
+	}
+
+	tables := pgdialect.New().Tables()
+	table := tables.Get(reflect.TypeOf(model).Elem())
+	desc := &ModelDescription{
+		Model:      name,
+		Table:      table.Name,
+		Columns:    make([]ColumnDescription, 0, len(table.Fields)),
+		UniqueKeys: make([]UniqueKeyDescription, 0, len(table.Unique)),
+		Relations:  make([]RelationDescription, 0, len(table.Relations)),
+		Ingestible: registry.IngestModelRegistry.Exists(name),
+	}
+
+	if link, ok := registry.AccountLinkRegistry.Get(name); ok {
+		desc.AccountColumn = link.Column
+	}
+
+	for _, field := range table.Fields {
+		desc.Columns = append(desc.Columns, ColumnDescription{
+			Name:       field.Name,
+			Type:       strings.ToLower(cmp.Or(field.UserSQLType, field.DiscoveredSQLType)),
+			PrimaryKey: field.IsPK,
+			NotNull:    field.NotNull,
+			Default:    field.SQLDefault,
+		})
+	}
+
+	for key, fields := range table.Unique {
+		desc.UniqueKeys = append(desc.UniqueKeys, UniqueKeyDescription{
+			Name:    key,
+			Columns: fieldNames(fields),
+		})
+	}
+	slices.SortFunc(desc.UniqueKeys, func(a, b UniqueKeyDescription) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	// Related models are resolved by their tables
+	modelsByTable := make(map[string]string)
+	_ = registry.ModelRegistry.Range(func(name string, model any) error {
+		modelsByTable[tables.Get(reflect.TypeOf(model).Elem()).Name] = name
+
+		return nil
+	})
+
+	for relName, rel := range table.Relations {
+		item := RelationDescription{
+			Name:        relName,
+			Type:        relationTypes[rel.Type],
+			Model:       modelsByTable[rel.JoinTable.Name],
+			Table:       rel.JoinTable.Name,
+			Columns:     fieldNames(rel.BasePKs),
+			JoinColumns: fieldNames(rel.JoinPKs),
+		}
+		if rel.M2MTable != nil {
+			item.Through = rel.M2MTable.Name
+		}
+		desc.Relations = append(desc.Relations, item)
+	}
+	slices.SortFunc(desc.Relations, func(a, b RelationDescription) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return desc, nil
+}
+
+// fieldNames returns the names of the given fields.
+func fieldNames(fields []*schema.Field) []string {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, field.Name)
+	}
+
+	return names
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+type describeParent struct {
+	bun.BaseModel `bun:"table:test_describe_parent"`
+
+	ID       int              `bun:"id,pk,autoincrement"`
+	Name     string           `bun:"name,notnull,unique:test_describe_parent_key"`
+	Region   string           `bun:"region,notnull,unique:test_describe_parent_key"`
+	Children []*describeChild `bun:"rel:has-many,join:name=parent_name"`
+}
+
+type describeChild struct {
+	bun.BaseModel `bun:"table:test_describe_child"`
+
+	ID         int    `bun:"id,pk,autoincrement"`
+	ParentName string `bun:"parent_name,notnull"`
+	Size       int    `bun:"size,default:1"`
+}
+
+func TestDescribeModel(t *testing.T) {
+	registry.ModelRegistry.MustRegister("test:model:describe_parent", &describeParent{})
+	registry.ModelRegistry.MustRegister("test:model:describe_child", &describeChild{})
+	t.Cleanup(func() {
+		registry.ModelRegistry.Unregister("test:model:describe_parent")
+		registry.ModelRegistry.Unregister("test:model:describe_child")
+	})
+
+	desc, err := dbutils.DescribeModel("test:model:describe_parent")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if desc.Table != "test_describe_parent" {
+		t.Fatalf("got table %q, wanted %q", desc.Table, "test_describe_parent")
+	}
+
+	columns := make([]string, 0)
+	for _, c := range desc.Columns {
+		columns = append(columns, c.Name)
+	}
+	if want := []string{"id", "name", "region"}; !slices.Equal(columns, want) {
+		t.Fatalf("got columns %v, wanted %v", columns, want)
+	}
+	if !desc.Columns[0].PrimaryKey || desc.Columns[1].Type != "varchar" {
+		t.Fatalf("got columns %+v", desc.Columns)
+	}
+
+	if len(desc.UniqueKeys) != 1 || !slices.Equal(desc.UniqueKeys[0].Columns, []string{"name", "region"}) {
+		t.Fatalf("got unique keys %+v", desc.UniqueKeys)
+	}
+
+	if len(desc.Relations) != 1 {
+		t.Fatalf("got %d relations, wanted 1", len(desc.Relations))
+	}
+	rel := desc.Relations[0]
+	if rel.Name != "Children" || rel.Type != "has-many" || rel.Model != "test:model:describe_child" {
+		t.Fatalf("got relation %+v", rel)
+	}
+	if !slices.Equal(rel.Columns, []string{"name"}) || !slices.Equal(rel.JoinColumns, []string{"parent_name"}) {
+		t.Fatalf("got relation columns %v and %v", rel.Columns, rel.JoinColumns)
+	}
+
+	if _, err := dbutils.DescribeModel("test:model:unknown"); !errors.Is(err, dbutils.ErrModelNotFound) {
+		t.Fatalf("got error %v, wanted %v", err, dbutils.ErrModelNotFound)
+	}
+}
@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// ErrInvalidFixture is an error, which is returned when a fixture file cannot
+// be loaded.
+var ErrInvalidFixture = errors.New("invalid fixture")
+
+// fixtureExtensions specifies the file extensions of fixture files.
+var fixtureExtensions = []string{".yaml", ".yml", ".json"}
+
+// Fixture represents the records of a model, which are loaded into the
+// database.
+type Fixture struct {
+	// Model specifies the name of the model as registered in the
+	// [registry.ModelRegistry], e.g. aws:model:instance.
+	Model string `json:"model" yaml:"model"`
+
+	// Records specifies the records of the model, keyed by column name.
+	// Columns, which are not specified, are set to their default values.
+	Records []map[string]any `json:"records" yaml:"records"`
+}
+
+// FixtureResult represents the result of loading a single fixture file.
+type FixtureResult struct {
+	// File specifies the path to the fixture file.
+	File string `json:"file" yaml:"file"`
+
+	// Model specifies the name of the model.
+	Model string `json:"model" yaml:"model"`
+
+	// Records specifies the number of records in the fixture file.
+	Records int `json:"records" yaml:"records"`
+
+	// Inserted specifies the number of inserted records. Records, which
+	// conflict with existing ones are skipped.
+	Inserted int64 `json:"inserted" yaml:"inserted"`
+}
+
+// ReadFixture reads the [Fixture] from the given YAML or JSON file.
+func ReadFixture(path string) (Fixture, error) {
+	var fixture Fixture
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fixture, err
+	}
+
+	if err := yaml.Unmarshal(data, &fixture); err != nil {
+		return fixture, fmt.Errorf("%w: %s: %w", ErrInvalidFixture, path, err)
+	}
+
+	if fixture.Model == "" {
+		return fixture, fmt.Errorf("%w: %s: no model specified", ErrInvalidFixture, path)
+	}
+
+	return fixture, nil
+}
+
+// LoadFixtures loads the fixture files from the given directory into the
+// database. The files are loaded in lexical order within a single transaction,
+// so that fixtures of referenced models may be loaded first by naming the files
+// accordingly, e.g. 01-regions.yaml and 02-instances.yaml.
+func LoadFixtures(ctx context.Context, db *bun.DB, dir string) ([]FixtureResult, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0)
+	for _, entry := range entries {
+		if entry.IsDir() || !slices.Contains(fixtureExtensions, filepath.Ext(entry.Name())) {
+			continue
+		}
+		files = append(files, filepath.Join(dir, entry.Name()))
+	}
+
+	results := make([]FixtureResult, 0, len(files))
+	err = db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		for _, file := range files {
+			fixture, err := ReadFixture(file)
+			if err != nil {
+				return err
+			}
+
+			inserted, err := loadFixture(ctx, db, tx, fixture)
+			if err != nil {
+				return fmt.Errorf("%s: %w", file, err)
+			}
+
+			results = append(results, FixtureResult{
+				File:     file,
+				Model:    fixture.Model,
+				Records:  len(fixture.Records),
+				Inserted: inserted,
+			})
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// loadFixture inserts the records of the given [Fixture] and returns the number
+// of inserted records.
+func loadFixture(ctx context.Context, db *bun.DB, tx bun.Tx, fixture Fixture) (int64, error) {
+	model, ok := registry.ModelRegistry.Get(fixture.Model)
+	if !ok {
+		return 0, fmt.Errorf("%w: model %q not found in registry", ErrInvalidFixture, fixture.Model)
+	}
+
+	table := db.Table(reflect.TypeOf(model).Elem())
+	var inserted int64
+	for i, record := range fixture.Records {
+		columns := make([]string, 0, len(record))
+		for name := range record {
+			field, ok := table.FieldMap[name]
+			if !ok {
+				return 0, fmt.Errorf("%w: record %d: unknown column %q", ErrInvalidFixture, i, name)
+			}
+			columns = append(columns, string(field.SQLName))
+		}
+		slices.Sort(columns)
+
+		data, err := json.Marshal(record)
+		if err != nil {
+			return 0, err
+		}
+
+		// The record is converted to a row of the table, so that the
+		// values are cast to the respective column types.
+		columnList := bun.Safe(strings.Join(columns, ", "))
+		out, err := tx.NewRaw(
+			"INSERT INTO ? (?) SELECT ? FROM jsonb_populate_record(NULL::?, ?::jsonb) ON CONFLICT DO NOTHING",
+			table.SQLName,
+			columnList,
+			columnList,
+			table.SQLName,
+			string(data),
+		).Exec(ctx)
+
+		if err != nil {
+			return 0, fmt.Errorf("record %d: %w", i, err)
+		}
+
+		count, err := out.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		inserted += count
+	}
+
+	return inserted, nil
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/schema"
+)
+
+// ErrNoDocuments is an error, which is returned when ingesting an empty list of
+// documents.
+var ErrNoDocuments = errors.New("no documents")
+
+// ErrInvalidDocument is an error, which is returned when a document does not
+// match the schema of the model.
+var ErrInvalidDocument = errors.New("invalid document")
+
+// ManagedColumns specifies the columns, which are set by the inventory and
+// must not be provided by ingested documents.
+var ManagedColumns = []string{"id", "created_at", "updated_at"}
+
+// DecodeDocuments decodes the given JSON array of documents into records of the
+// model described by the given table. The keys of each document are the column
+// names of the model. Documents with unknown or managed columns, with values
+// of the wrong type, or without the required columns are rejected.
+//
+// The returned value is a pointer to a slice of records, which may be passed
+// to [IngestDocuments].
+func DecodeDocuments(table *schema.Table, data []byte) (any, error) {
+	var docs []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &docs); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDocument, err)
+	}
+
+	if len(docs) == 0 {
+		return nil, ErrNoDocuments
+	}
+
+	records := reflect.New(reflect.SliceOf(table.Type)).Elem()
+	for i, doc := range docs {
+		record := reflect.New(table.Type).Elem()
+		for column, raw := range doc {
+			field, ok := table.FieldMap[column]
+			if !ok {
+				return nil, fmt.Errorf("%w: document %d: unknown column %q", ErrInvalidDocument, i, column)
+			}
+			if slices.Contains(ManagedColumns, column) {
+				return nil, fmt.Errorf("%w: document %d: column %q is managed by the inventory", ErrInvalidDocument, i, column)
+			}
+			if bytes.Equal(raw, []byte("null")) {
+				continue
+			}
+
+			value := reflect.New(field.StructField.Type)
+			if err := json.Unmarshal(raw, value.Interface()); err != nil {
+				return nil, fmt.Errorf("%w: document %d: column %q: %w", ErrInvalidDocument, i, column, err)
+			}
+			field.Value(record).Set(value.Elem())
+		}
+
+		for _, field := range table.Fields {
+			if !field.NotNull || field.SQLDefault != "" || slices.Contains(ManagedColumns, field.Name) {
+				continue
+			}
+			if raw, ok := doc[field.Name]; !ok || bytes.Equal(raw, []byte("null")) {
+				return nil, fmt.Errorf("%w: document %d: missing column %q", ErrInvalidDocument, i, field.Name)
+			}
+		}
+
+		records = reflect.Append(records, record)
+	}
+
+	result := reflect.New(records.Type())
+	result.Elem().Set(records)
+
+	return result.Interface(), nil
+}
+
+// IngestDocuments persists the records decoded by [DecodeDocuments] for the
+// model described by the given table. Records matching an existing record by
+// the given conflict columns replace the existing record. It returns the
+// number of persisted records.
+func IngestDocuments(ctx context.Context, db bun.IDB, table *schema.Table, records any, conflictColumns []string) (int64, error) {
+	query := db.NewInsert().
+		Model(records).
+		On(fmt.Sprintf("CONFLICT (%s) DO UPDATE", strings.Join(conflictColumns, ", ")))
+
+	for _, field := range table.Fields {
+		if slices.Contains(ManagedColumns, field.Name) || slices.Contains(conflictColumns, field.Name) {
+			continue
+		}
+		query = query.Set("? = EXCLUDED.?", bun.Ident(field.Name), bun.Ident(field.Name))
+	}
+
+	out, err := query.
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return out.RowsAffected()
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/uptrace/bun/dialect/pgdialect"
+
+	"github.com/gardener/inventory/pkg/auxiliary/models"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+func TestDecodeDocuments(t *testing.T) {
+	table := pgdialect.New().Tables().Get(reflect.TypeFor[models.ExternalResource]())
+
+	testCases := []struct {
+		desc    string
+		data    string
+		wantErr error
+	}{
+		{
+			desc: "valid documents",
+			data: `[
+{"source": "dns", "kind": "appliance", "external_id": "1", "name": "ns1", "ip_address": "10.0.0.1", "labels": {"dc": "eu"}},
+{"source": "dns", "kind": "appliance", "external_id": "2", "name": "ns2", "location": null}
+]`,
+		},
+		{
+			desc:    "not an array",
+			data:    `{"source": "dns"}`,
+			wantErr: dbutils.ErrInvalidDocument,
+		},
+		{
+			desc:    "empty array",
+			data:    `[]`,
+			wantErr: dbutils.ErrNoDocuments,
+		},
+		{
+			desc:    "unknown column",
+			data:    `[{"source": "dns", "kind": "appliance", "external_id": "1", "name": "ns1", "color": "red"}]`,
+			wantErr: dbutils.ErrInvalidDocument,
+		},
+		{
+			desc:    "managed column",
+			data:    `[{"id": "6f1f0ea4-5c8c-4a2a-9f35-4a4f0e0f4f7e", "source": "dns", "kind": "appliance", "external_id": "1", "name": "ns1"}]`,
+			wantErr: dbutils.ErrInvalidDocument,
+		},
+		{
+			desc:    "missing required column",
+			data:    `[{"source": "dns", "kind": "appliance", "name": "ns1"}]`,
+			wantErr: dbutils.ErrInvalidDocument,
+		},
+		{
+			desc:    "null required column",
+			data:    `[{"source": "dns", "kind": "appliance", "external_id": null, "name": "ns1"}]`,
+			wantErr: dbutils.ErrInvalidDocument,
+		},
+		{
+			desc:    "wrong type",
+			data:    `[{"source": "dns", "kind": "appliance", "external_id": 1, "name": "ns1"}]`,
+			wantErr: dbutils.ErrInvalidDocument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			records, err := dbutils.DecodeDocuments(table, []byte(tc.data))
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got error %v wanted %v", err, tc.wantErr)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			items, ok := records.(*[]models.ExternalResource)
+			if !ok {
+				t.Fatalf("got %T wanted *[]models.ExternalResource", records)
+			}
+			if len(*items) != 2 {
+				t.Fatalf("got %d records wanted 2", len(*items))
+			}
+			if got := (*items)[0].IPAddress.String(); got != "10.0.0.1" {
+				t.Fatalf("got ip address %s wanted 10.0.0.1", got)
+			}
+			if got := (*items)[0].Labels["dc"]; got != "eu" {
+				t.Fatalf("got label %q wanted eu", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/schema"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// ErrInvalidIPQuery is an error, which is returned when the IP lookup query
+// is neither an IP address, nor a CIDR.
+var ErrInvalidIPQuery = errors.New("invalid ip address or cidr")
+
+// ipColumnSuffixes specifies the suffixes of string columns, which hold IP
+// addresses, e.g. `public_ip_address' or `gateway_ip'. Columns of type
+// [net.IP] are always considered.
+var ipColumnSuffixes = []string{
+	"_ip",
+	"ip_address",
+	"vip_address",
+}
+
+// IPLookupResult represents a record, which uses an IP address matching the
+// lookup query.
+type IPLookupResult struct {
+	// Model specifies the name of the model.
+	Model string `bun:"-" json:"model" yaml:"model"`
+
+	// Column specifies the column holding the IP address.
+	Column string `bun:"-" json:"column" yaml:"column"`
+
+	// ID specifies the ID of the record.
+	ID string `bun:"id" json:"id" yaml:"id"`
+
+	// Name specifies the name of the record, if the model has one.
+	Name string `bun:"name" json:"name" yaml:"name"`
+
+	// IP specifies the matching IP address.
+	IP string `bun:"ip" json:"ip" yaml:"ip"`
+
+	// Shoot specifies the name of the Gardener Shoot owning the record, if
+	// resolved.
+	Shoot string `bun:"shoot" json:"shoot" yaml:"shoot"`
+
+	// Project specifies the name of the Gardener Project of the Shoot.
+	Project string `bun:"project" json:"project" yaml:"project"`
+}
+
+// ParseIPQuery parses the given IP address or CIDR into a [netip.Prefix]. IP
+// addresses are converted to single-address prefixes.
+func ParseIPQuery(s string) (netip.Prefix, error) {
+	if prefix, err := netip.ParsePrefix(s); err == nil {
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%w: %s", ErrInvalidIPQuery, s)
+	}
+
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// isIPColumn returns true, if the given field of a model holds IP addresses.
+func isIPColumn(field *schema.Field) bool {
+	if field.IndirectType == reflect.TypeOf(net.IP{}) {
+		return true
+	}
+
+	if field.IndirectType.Kind() != reflect.String {
+		return false
+	}
+
+	for _, suffix := range ipColumnSuffixes {
+		if strings.HasSuffix(field.Name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LookupIP searches the IP address columns of the models returned by
+// [SearchableModels] for addresses contained in the given prefix. The Gardener Shoot owning each
+// matching record is resolved via the `l_aux_shoot_to_resource' table, the
+// `aux_public_exposure' view, or the technical id of the Shoot referenced by
+// the record.
+func LookupIP(ctx context.Context, db *bun.DB, prefix netip.Prefix) ([]IPLookupResult, error) {
+	modelNames, err := SearchableModels()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]IPLookupResult, 0)
+	for _, name := range modelNames {
+		model, _ := registry.ModelRegistry.Get(name)
+		table := db.Table(reflect.TypeOf(model).Elem())
+		if _, ok := table.FieldMap["id"]; !ok {
+			continue
+		}
+
+		for _, field := range table.Fields {
+			if !isIPColumn(field) {
+				continue
+			}
+
+			items := make([]IPLookupResult, 0)
+			query := newIPLookupQuery(db, name, table, field.Name, prefix)
+			if err := query.Scan(ctx, &items); err != nil {
+				return nil, fmt.Errorf("cannot lookup ip in %s.%s: %w", name, field.Name, err)
+			}
+
+			for _, item := range items {
+				item.Model = name
+				item.Column = field.Name
+				results = append(results, item)
+			}
+		}
+	}
+
+	slices.SortFunc(results, func(a, b IPLookupResult) int {
+		return cmp.Or(
+			cmp.Compare(a.IP, b.IP),
+			cmp.Compare(a.Model, b.Model),
+			cmp.Compare(a.Column, b.Column),
+		)
+	})
+
+	return results, nil
+}
+
+// newIPLookupQuery creates the query, which searches the given column of the
+// model for IP addresses contained in the prefix. Empty values and values
+// stored as text are handled by casting the column to inet.
+func newIPLookupQuery(db *bun.DB, name string, table *schema.Table, column string, prefix netip.Prefix) *bun.SelectQuery {
+	ipExpr := "NULLIF(t.?::text, '')::inet"
+	query := db.NewSelect().
+		TableExpr("? AS t", bun.Ident(table.Name)).
+		ColumnExpr("t.id::text AS id").
+		ColumnExpr("host("+ipExpr+") AS ip", bun.Ident(column)).
+		ColumnExpr("COALESCE(s.name, '') AS shoot").
+		ColumnExpr("COALESCE(s.project_name, '') AS project")
+
+	if _, ok := table.FieldMap["name"]; ok {
+		query = query.ColumnExpr("COALESCE(t.name::text, '') AS name")
+	} else {
+		query = query.ColumnExpr("'' AS name")
+	}
+
+	owners := `SELECT l.shoot_id FROM l_aux_shoot_to_resource AS l WHERE l.model_name = ? AND l.resource_id = t.id
+UNION ALL
+SELECT e.shoot_id FROM aux_public_exposure AS e WHERE e.model_name = ? AND e.resource_id = t.id AND e.shoot_id IS NOT NULL`
+
+	// Gardener models referencing a Shoot by its technical id, e.g. the
+	// advertised kube-apiserver addresses, are owned by that Shoot.
+	_, hasTechnicalID := table.FieldMap["technical_id"]
+	_, hasLandscape := table.FieldMap["landscape"]
+	if hasTechnicalID && hasLandscape {
+		owners += `
+UNION ALL
+SELECT gs.id FROM g_shoot AS gs WHERE gs.technical_id = t.technical_id AND gs.landscape = t.landscape`
+	}
+
+	return query.
+		Join("LEFT JOIN LATERAL ("+owners+"\nLIMIT 1) AS owner ON true", name, name).
+		Join("LEFT JOIN g_shoot AS s ON s.id = owner.shoot_id").
+		Where(ipExpr+" <<= ?::inet", bun.Ident(column), prefix.String())
+}
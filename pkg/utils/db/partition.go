@@ -0,0 +1,619 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/schema"
+)
+
+// Supported partitioning strategies
+const (
+	// PartitionStrategyList partitions a table by a list of values, e.g.
+	// by account.
+	PartitionStrategyList = "list"
+
+	// PartitionStrategyRange partitions a table by a range of values, e.g.
+	// by month.
+	PartitionStrategyRange = "range"
+)
+
+// ErrUnknownPartitionStrategy is an error, which is returned when an unknown
+// partitioning strategy was specified.
+var ErrUnknownPartitionStrategy = errors.New("unknown partitioning strategy")
+
+// ErrTableNotPartitioned is an error, which is returned when attempting to
+// manage the partitions of a table, which is not partitioned.
+var ErrTableNotPartitioned = errors.New("table is not partitioned")
+
+// ErrPartitionColumnNotReferenced is an error, which is returned when
+// attempting to partition a table, which is referenced by a foreign key that
+// does not include the partition column.
+var ErrPartitionColumnNotReferenced = errors.New("foreign key does not reference the partition column")
+
+// ErrPartitionColumnNotUnique is an error, which is returned when attempting to
+// partition a table with a unique constraint or unique index, which does not
+// include the partition column.
+var ErrPartitionColumnNotUnique = errors.New("unique constraint does not include the partition column")
+
+// Partition represents a single partition of a partitioned table.
+type Partition struct {
+	// Name specifies the name of the partition.
+	Name string `bun:"name"`
+
+	// Bound specifies the partition bound, e.g. FOR VALUES IN ('foo').
+	Bound string `bun:"bound"`
+
+	// EstimatedRows specifies the estimated number of rows in the
+	// partition.
+	EstimatedRows int64 `bun:"estimated_rows"`
+}
+
+// PartitionKey describes how a table is partitioned.
+type PartitionKey struct {
+	// Strategy specifies the partitioning strategy.
+	Strategy string `bun:"strategy"`
+
+	// Column specifies the column by which the table is partitioned.
+	Column string `bun:"column"`
+}
+
+// DefaultPartitionName returns the name of the default partition for the given
+// table.
+func DefaultPartitionName(table string) string {
+	return table + "_default"
+}
+
+// monthlyPartitionLayout is the layout of the suffix of monthly partition
+// names.
+const monthlyPartitionLayout = "200601"
+
+// MonthlyPartition represents a range partition, which holds the records of a
+// single month.
+type MonthlyPartition struct {
+	// Name specifies the name of the partition.
+	Name string
+
+	// From specifies the inclusive lower bound of the partition.
+	From time.Time
+
+	// To specifies the exclusive upper bound of the partition.
+	To time.Time
+}
+
+// MonthlyPartitionName returns the name of the monthly partition of the given
+// table for the month of the given time, e.g. `aws_net_interface_202601'.
+func MonthlyPartitionName(table string, t time.Time) string {
+	return table + "_" + t.UTC().Format(monthlyPartitionLayout)
+}
+
+// startOfMonth returns the start of the month of the given time in UTC.
+func startOfMonth(t time.Time) time.Time {
+	t = t.UTC()
+
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// MonthlyPartitions returns the given number of monthly partitions of the
+// table, starting with the month of the given time.
+func MonthlyPartitions(table string, start time.Time, months int) []MonthlyPartition {
+	items := make([]MonthlyPartition, 0, max(months, 0))
+	from := startOfMonth(start)
+	for range months {
+		to := from.AddDate(0, 1, 0)
+		items = append(items, MonthlyPartition{
+			Name: MonthlyPartitionName(table, from),
+			From: from,
+			To:   to,
+		})
+		from = to
+	}
+
+	return items
+}
+
+// ExpiredMonthlyPartitions returns the monthly partitions of the given table,
+// which are older than the given number of months to keep, counting the month
+// of now as the first one. Partitions, which are not named according to
+// [MonthlyPartitionName], e.g. the default partition, are never returned.
+func ExpiredMonthlyPartitions(table string, partitions []Partition, now time.Time, keep int) []Partition {
+	cutoff := startOfMonth(now).AddDate(0, -max(keep-1, 0), 0)
+	items := make([]Partition, 0)
+	for _, p := range partitions {
+		suffix, ok := strings.CutPrefix(p.Name, table+"_")
+		if !ok {
+			continue
+		}
+
+		month, err := time.Parse(monthlyPartitionLayout, suffix)
+		if err != nil {
+			continue
+		}
+
+		if month.Before(cutoff) {
+			items = append(items, p)
+		}
+	}
+
+	return items
+}
+
+// GetPartitionKey returns the [PartitionKey] of the given table. It returns
+// [ErrTableNotPartitioned] if the table is not partitioned.
+func GetPartitionKey(ctx context.Context, db bun.IDB, table string) (PartitionKey, error) {
+	var key PartitionKey
+	query := `SELECT
+CASE p.partstrat WHEN 'l' THEN 'list' WHEN 'r' THEN 'range' ELSE 'hash' END AS strategy,
+a.attname AS column
+FROM pg_partitioned_table AS p
+INNER JOIN pg_attribute AS a ON a.attrelid = p.partrelid AND a.attnum = p.partattrs[0]
+WHERE p.partrelid = to_regclass(?)`
+
+	err := db.NewRaw(query, table).Scan(ctx, &key)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return key, fmt.Errorf("%w: %s", ErrTableNotPartitioned, table)
+	case err != nil:
+		return key, err
+	}
+
+	return key, nil
+}
+
+// ListPartitions returns the partitions of the given table.
+func ListPartitions(ctx context.Context, db bun.IDB, table string) ([]Partition, error) {
+	if _, err := GetPartitionKey(ctx, db, table); err != nil {
+		return nil, err
+	}
+
+	items := make([]Partition, 0)
+	query := `SELECT
+c.relname AS name,
+pg_get_expr(c.relpartbound, c.oid) AS bound,
+GREATEST(c.reltuples, 0)::bigint AS estimated_rows
+FROM pg_inherits AS i
+INNER JOIN pg_class AS c ON c.oid = i.inhrelid
+WHERE i.inhparent = to_regclass(?)
+ORDER BY c.relname`
+
+	if err := db.NewRaw(query, table).Scan(ctx, &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// ConvertToPartitioned converts an existing table into a partitioned one,
+// using the given strategy and column. The existing records are moved into the
+// default partition of the table, from which they are moved into dedicated
+// partitions once these are created.
+//
+// See [PartitionConversion] for the requirements on the table and how its
+// dependent objects are re-created.
+func ConvertToPartitioned(ctx context.Context, db *bun.DB, table, strategy, column string) error {
+	if err := validatePartitionStrategy(strategy); err != nil {
+		return err
+	}
+
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		conv, err := GetPartitionConversion(ctx, tx, table, strategy, column)
+		if err != nil {
+			return err
+		}
+
+		stmts, err := conv.Statements(db.QueryGen())
+		if err != nil {
+			return err
+		}
+
+		for _, stmt := range stmts {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// validatePartitionStrategy returns [ErrUnknownPartitionStrategy], if the
+// given partitioning strategy is not supported.
+func validatePartitionStrategy(strategy string) error {
+	switch strategy {
+	case PartitionStrategyList, PartitionStrategyRange:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownPartitionStrategy, strategy)
+	}
+}
+
+// DependentView represents a view or materialized view, which depends on a
+// table, either directly or through other views.
+type DependentView struct {
+	// Name specifies the name of the view.
+	Name string `bun:"name"`
+
+	// Kind specifies the kind of the view, i.e. `v' for views and `m' for
+	// materialized views.
+	Kind string `bun:"kind"`
+
+	// Def specifies the definition of the view.
+	Def string `bun:"def"`
+
+	// Indexes specifies the definitions of the indexes of a materialized
+	// view.
+	Indexes []string `bun:"-"`
+}
+
+// IsMaterialized returns true, if the view is a materialized view.
+func (v DependentView) IsMaterialized() bool {
+	return v.Kind == "m"
+}
+
+// TableConstraint represents a unique or foreign key constraint, which is
+// re-created when converting a table into a partitioned one.
+type TableConstraint struct {
+	// Table specifies the table of the constraint.
+	Table string `bun:"table"`
+
+	// Name specifies the name of the constraint.
+	Name string `bun:"name"`
+
+	// Def specifies the definition of the constraint.
+	Def string `bun:"def"`
+
+	// IncludesColumn specifies whether the unique constraint includes the
+	// partition column, or whether the foreign key references it.
+	IncludesColumn bool `bun:"includes_column"`
+}
+
+// TableIndex represents an index of a table, which is not backing a
+// constraint.
+type TableIndex struct {
+	// Name specifies the name of the index.
+	Name string `bun:"name"`
+
+	// Def specifies the definition of the index.
+	Def string `bun:"def"`
+
+	// Unique specifies whether the index is a unique index.
+	Unique bool `bun:"is_unique"`
+
+	// IncludesColumn specifies whether the index includes the partition
+	// column.
+	IncludesColumn bool `bun:"includes_column"`
+}
+
+// PartitionConversion describes the conversion of a table into a partitioned
+// one, along with the objects depending on the table, which are dropped and
+// re-created during the conversion.
+//
+// The primary key of the partitioned table is extended with the partition
+// column, since Postgres requires unique constraints of partitioned tables to
+// include the partition key. Unique constraints and unique indexes, which do
+// not include the partition column, are rejected instead of being extended,
+// because extending them would break any upsert using them as the conflict
+// target. For the same reason foreign keys of other tables referencing the
+// table must include the partition column.
+type PartitionConversion struct {
+	// Table specifies the table to convert.
+	Table string
+
+	// Strategy specifies the partitioning strategy.
+	Strategy string
+
+	// Column specifies the partition column.
+	Column string
+
+	// Views specifies the views depending on the table, ordered such that
+	// each view comes after the views it depends on.
+	Views []DependentView
+
+	// UniqueConstraints specifies the unique constraints of the table.
+	UniqueConstraints []TableConstraint
+
+	// ForeignKeys specifies the foreign keys of the table.
+	ForeignKeys []TableConstraint
+
+	// ReferencingForeignKeys specifies the foreign keys of other tables,
+	// which reference the table.
+	ReferencingForeignKeys []TableConstraint
+
+	// Indexes specifies the indexes of the table, which are not backing
+	// a constraint.
+	Indexes []TableIndex
+}
+
+// GetPartitionConversion returns the [PartitionConversion] for converting the
+// given table into a partitioned one, by capturing the objects depending on
+// the table.
+func GetPartitionConversion(ctx context.Context, db bun.IDB, table, strategy, column string) (PartitionConversion, error) {
+	conv := PartitionConversion{
+		Table:                  table,
+		Strategy:               strategy,
+		Column:                 column,
+		Views:                  make([]DependentView, 0),
+		UniqueConstraints:      make([]TableConstraint, 0),
+		ForeignKeys:            make([]TableConstraint, 0),
+		ReferencingForeignKeys: make([]TableConstraint, 0),
+		Indexes:                make([]TableIndex, 0),
+	}
+
+	// Views may depend on the table through other views, in which case
+	// they have to be dropped before, and re-created after the views
+	// they depend on.
+	viewsQuery := `WITH RECURSIVE deps AS (
+  SELECT v.oid, 1 AS depth
+  FROM pg_depend AS d
+  INNER JOIN pg_rewrite AS r ON r.oid = d.objid
+  INNER JOIN pg_class AS v ON v.oid = r.ev_class
+  WHERE d.refobjid = to_regclass(?) AND v.oid <> d.refobjid AND v.relkind IN ('v', 'm')
+  UNION ALL
+  SELECT v.oid, deps.depth + 1
+  FROM deps
+  INNER JOIN pg_depend AS d ON d.refobjid = deps.oid
+  INNER JOIN pg_rewrite AS r ON r.oid = d.objid
+  INNER JOIN pg_class AS v ON v.oid = r.ev_class
+  WHERE v.oid <> d.refobjid AND v.relkind IN ('v', 'm')
+)
+SELECT v.oid::regclass::text AS name, v.relkind::text AS kind, pg_get_viewdef(v.oid) AS def
+FROM (SELECT oid, MAX(depth) AS depth FROM deps GROUP BY oid) AS deps
+INNER JOIN pg_class AS v ON v.oid = deps.oid
+ORDER BY deps.depth, name`
+	if err := db.NewRaw(viewsQuery, table).Scan(ctx, &conv.Views); err != nil {
+		return conv, err
+	}
+
+	viewIndexesQuery := `SELECT pg_get_indexdef(indexrelid) FROM pg_index WHERE indrelid = to_regclass(?)`
+	for i, v := range conv.Views {
+		if !v.IsMaterialized() {
+			continue
+		}
+
+		indexes := make([]string, 0)
+		if err := db.NewRaw(viewIndexesQuery, v.Name).Scan(ctx, &indexes); err != nil {
+			return conv, err
+		}
+		conv.Views[i].Indexes = indexes
+	}
+
+	// Whether the partition column is part of the given constraint
+	// columns of the given table.
+	includesColumn := `? = ANY (ARRAY(SELECT a.attname FROM pg_attribute AS a WHERE a.attrelid = %s AND a.attnum = ANY (%s)))`
+
+	constraintsQuery := `SELECT c.conrelid::regclass::text AS table, c.conname AS name, pg_get_constraintdef(c.oid) AS def,
+` + fmt.Sprintf(includesColumn, "c.conrelid", "c.conkey") + ` AS includes_column
+FROM pg_constraint AS c
+WHERE c.conrelid = to_regclass(?) AND c.contype = ?`
+	if err := db.NewRaw(constraintsQuery, column, table, "u").Scan(ctx, &conv.UniqueConstraints); err != nil {
+		return conv, err
+	}
+
+	if err := db.NewRaw(constraintsQuery, column, table, "f").Scan(ctx, &conv.ForeignKeys); err != nil {
+		return conv, err
+	}
+
+	// Postgres requires a unique constraint on the columns referenced by
+	// a foreign key, which after the conversion must include the
+	// partition column.
+	referencingQuery := `SELECT c.conrelid::regclass::text AS table, c.conname AS name, pg_get_constraintdef(c.oid) AS def,
+` + fmt.Sprintf(includesColumn, "c.confrelid", "c.confkey") + ` AS includes_column
+FROM pg_constraint AS c
+WHERE c.contype = 'f' AND c.conrelid <> c.confrelid AND c.confrelid = to_regclass(?)`
+	if err := db.NewRaw(referencingQuery, column, table).Scan(ctx, &conv.ReferencingForeignKeys); err != nil {
+		return conv, err
+	}
+
+	indexesQuery := `SELECT c.relname AS name, pg_get_indexdef(i.indexrelid) AS def, i.indisunique AS is_unique,
+` + fmt.Sprintf(includesColumn, "i.indrelid", "i.indkey") + ` AS includes_column
+FROM pg_index AS i
+INNER JOIN pg_class AS c ON c.oid = i.indexrelid
+WHERE i.indrelid = to_regclass(?) AND NOT EXISTS (
+  SELECT 1 FROM pg_constraint WHERE conindid = i.indexrelid
+)`
+	if err := db.NewRaw(indexesQuery, column, table).Scan(ctx, &conv.Indexes); err != nil {
+		return conv, err
+	}
+
+	return conv, nil
+}
+
+// Statements returns the statements, which convert the table into a
+// partitioned one. It returns [ErrPartitionColumnNotUnique] or
+// [ErrPartitionColumnNotReferenced], if the table cannot be converted, before
+// any statement is executed.
+func (c PartitionConversion) Statements(gen schema.QueryGen) ([]string, error) {
+	if err := validatePartitionStrategy(c.Strategy); err != nil {
+		return nil, err
+	}
+
+	for _, uc := range c.UniqueConstraints {
+		if !uc.IncludesColumn {
+			return nil, fmt.Errorf("%w: %s of %s", ErrPartitionColumnNotUnique, uc.Name, c.Table)
+		}
+	}
+
+	for _, idx := range c.Indexes {
+		if idx.Unique && !idx.IncludesColumn {
+			return nil, fmt.Errorf("%w: %s of %s", ErrPartitionColumnNotUnique, idx.Name, c.Table)
+		}
+	}
+
+	for _, fk := range c.ReferencingForeignKeys {
+		if !fk.IncludesColumn {
+			return nil, fmt.Errorf("%w: %s of %s", ErrPartitionColumnNotReferenced, fk.Name, fk.Table)
+		}
+	}
+
+	// View, constraint and index definitions may contain `?', so
+	// statements are formatted upfront and executed without any
+	// arguments. Views are dropped in reverse order, so that views
+	// depending on other views are dropped first.
+	tmpTable := c.Table + "_partitioned"
+	stmts := make([]string, 0)
+	for i := len(c.Views) - 1; i >= 0; i-- {
+		v := c.Views[i]
+		if v.IsMaterialized() {
+			stmts = append(stmts, gen.FormatQuery("DROP MATERIALIZED VIEW ?", bun.Safe(v.Name)))
+		} else {
+			stmts = append(stmts, gen.FormatQuery("DROP VIEW ?", bun.Safe(v.Name)))
+		}
+	}
+
+	for _, fk := range c.ReferencingForeignKeys {
+		stmts = append(stmts, gen.FormatQuery(
+			"ALTER TABLE ? DROP CONSTRAINT ?",
+			bun.Safe(fk.Table),
+			bun.Ident(fk.Name),
+		))
+	}
+
+	stmts = append(stmts,
+		gen.FormatQuery(
+			fmt.Sprintf("CREATE TABLE ? (LIKE ? INCLUDING DEFAULTS INCLUDING CONSTRAINTS) PARTITION BY %s (?)", c.Strategy),
+			bun.Ident(tmpTable),
+			bun.Ident(c.Table),
+			bun.Ident(c.Column),
+		),
+		gen.FormatQuery(
+			"CREATE TABLE ? PARTITION OF ? DEFAULT",
+			bun.Ident(DefaultPartitionName(c.Table)),
+			bun.Ident(tmpTable),
+		),
+		gen.FormatQuery("INSERT INTO ? SELECT * FROM ?", bun.Ident(tmpTable), bun.Ident(c.Table)),
+		gen.FormatQuery("DROP TABLE ?", bun.Ident(c.Table)),
+		gen.FormatQuery("ALTER TABLE ? RENAME TO ?", bun.Ident(tmpTable), bun.Ident(c.Table)),
+		gen.FormatQuery("ALTER TABLE ? ADD PRIMARY KEY (id, ?)", bun.Ident(c.Table), bun.Ident(c.Column)),
+	)
+
+	for _, uc := range c.UniqueConstraints {
+		stmt := gen.FormatQuery("ALTER TABLE ? ADD CONSTRAINT ?", bun.Ident(c.Table), bun.Ident(uc.Name))
+		stmts = append(stmts, stmt+" "+uc.Def)
+	}
+
+	for _, fk := range c.ForeignKeys {
+		stmt := gen.FormatQuery("ALTER TABLE ? ADD CONSTRAINT ?", bun.Ident(c.Table), bun.Ident(fk.Name))
+		stmts = append(stmts, stmt+" "+fk.Def)
+	}
+
+	for _, fk := range c.ReferencingForeignKeys {
+		stmt := gen.FormatQuery("ALTER TABLE ? ADD CONSTRAINT ?", bun.Safe(fk.Table), bun.Ident(fk.Name))
+		stmts = append(stmts, stmt+" "+fk.Def)
+	}
+
+	for _, idx := range c.Indexes {
+		stmts = append(stmts, idx.Def)
+	}
+
+	for _, v := range c.Views {
+		if v.IsMaterialized() {
+			stmt := gen.FormatQuery("CREATE MATERIALIZED VIEW ? AS", bun.Safe(v.Name))
+			stmts = append(stmts, stmt+" "+v.Def)
+			stmts = append(stmts, v.Indexes...)
+		} else {
+			stmt := gen.FormatQuery("CREATE VIEW ? AS", bun.Safe(v.Name))
+			stmts = append(stmts, stmt+" "+v.Def)
+		}
+	}
+
+	return stmts, nil
+}
+
+// CreatePartition creates a new partition of the given table using the given
+// bound, e.g. FOR VALUES IN ('foo'). Any records matching the new partition,
+// which reside in the default partition are moved into the new partition.
+func CreatePartition(ctx context.Context, db *bun.DB, table, name, bound string, args ...any) error {
+	if _, err := GetPartitionKey(ctx, db, table); err != nil {
+		return err
+	}
+
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		defaultPartition := DefaultPartitionName(table)
+		var hasDefault bool
+		query := `SELECT EXISTS (
+  SELECT 1 FROM pg_inherits WHERE inhparent = to_regclass(?) AND inhrelid = to_regclass(?)
+)`
+		if err := tx.NewRaw(query, table, defaultPartition).Scan(ctx, &hasDefault); err != nil {
+			return err
+		}
+
+		if hasDefault {
+			_, err := tx.NewRaw(
+				"ALTER TABLE ? DETACH PARTITION ?",
+				bun.Ident(table),
+				bun.Ident(defaultPartition),
+			).Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		createArgs := append([]any{bun.Ident(name), bun.Ident(table)}, args...)
+		if _, err := tx.NewRaw("CREATE TABLE ? PARTITION OF ? "+bound, createArgs...).Exec(ctx); err != nil {
+			return err
+		}
+
+		if !hasDefault {
+			return nil
+		}
+
+		// Move the matching records from the default partition into
+		// the new one, and re-attach the default partition.
+		var constraintDef string
+		err := tx.NewRaw("SELECT pg_get_partition_constraintdef(to_regclass(?))", name).Scan(ctx, &constraintDef)
+		if err != nil {
+			return err
+		}
+
+		moveQuery := db.QueryGen().FormatQuery(
+			"WITH moved AS (DELETE FROM ? WHERE ",
+			bun.Ident(defaultPartition),
+		) + constraintDef + db.QueryGen().FormatQuery(
+			" RETURNING *) INSERT INTO ? SELECT * FROM moved",
+			bun.Ident(name),
+		)
+		_, err = tx.ExecContext(ctx, moveQuery)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewRaw(
+			"ALTER TABLE ? ATTACH PARTITION ? DEFAULT",
+			bun.Ident(table),
+			bun.Ident(defaultPartition),
+		).Exec(ctx)
+
+		return err
+	})
+}
+
+// DetachPartition detaches the given partition from the table. The detached
+// partition is kept as a regular table.
+func DetachPartition(ctx context.Context, db bun.IDB, table, name string) error {
+	_, err := db.NewRaw("ALTER TABLE ? DETACH PARTITION ?", bun.Ident(table), bun.Ident(name)).Exec(ctx)
+
+	return err
+}
+
+// DropPartition detaches the given partition from the table and drops it,
+// along with its records.
+func DropPartition(ctx context.Context, db *bun.DB, table, name string) error {
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := DetachPartition(ctx, tx, table, name); err != nil {
+			return err
+		}
+
+		_, err := tx.NewRaw("DROP TABLE ?", bun.Ident(name)).Exec(ctx)
+
+		return err
+	})
+}
@@ -0,0 +1,305 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+func TestMonthlyPartitionName(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		ts     time.Time
+		wanted string
+	}{
+		{
+			desc:   "start of month",
+			ts:     time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+			wanted: "aws_net_interface_202601",
+		},
+		{
+			desc:   "end of year",
+			ts:     time.Date(2025, time.December, 31, 23, 59, 59, 0, time.UTC),
+			wanted: "aws_net_interface_202512",
+		},
+		{
+			desc:   "converted to UTC",
+			ts:     time.Date(2026, time.February, 1, 0, 30, 0, 0, time.FixedZone("CET", 3600)),
+			wanted: "aws_net_interface_202601",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := dbutils.MonthlyPartitionName("aws_net_interface", tc.ts)
+			if got != tc.wanted {
+				t.Fatalf("got name %q, wanted %q", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestMonthlyPartitions(t *testing.T) {
+	month := func(year int, m time.Month) time.Time {
+		return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	testCases := []struct {
+		desc   string
+		start  time.Time
+		months int
+		wanted []dbutils.MonthlyPartition
+	}{
+		{
+			desc:   "no months",
+			start:  month(2026, time.January),
+			months: 0,
+			wanted: []dbutils.MonthlyPartition{},
+		},
+		{
+			desc:   "single month from mid-month",
+			start:  time.Date(2026, time.January, 31, 12, 0, 0, 0, time.UTC),
+			months: 1,
+			wanted: []dbutils.MonthlyPartition{
+				{Name: "t_202601", From: month(2026, time.January), To: month(2026, time.February)},
+			},
+		},
+		{
+			desc:   "across year boundary",
+			start:  month(2025, time.November),
+			months: 3,
+			wanted: []dbutils.MonthlyPartition{
+				{Name: "t_202511", From: month(2025, time.November), To: month(2025, time.December)},
+				{Name: "t_202512", From: month(2025, time.December), To: month(2026, time.January)},
+				{Name: "t_202601", From: month(2026, time.January), To: month(2026, time.February)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := dbutils.MonthlyPartitions("t", tc.start, tc.months)
+			if !slices.Equal(got, tc.wanted) {
+				t.Fatalf("got partitions %v, wanted %v", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestExpiredMonthlyPartitions(t *testing.T) {
+	partitions := []dbutils.Partition{
+		{Name: "t_default"},
+		{Name: "t_202510"},
+		{Name: "t_202511"},
+		{Name: "t_202512"},
+		{Name: "t_202601"},
+		{Name: "t_202602"},
+		{Name: "t_acme"},
+		{Name: "other_202501"},
+		{Name: "t_extra_202501"},
+	}
+	now := time.Date(2026, time.January, 15, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		desc   string
+		keep   int
+		wanted []string
+	}{
+		{
+			desc:   "keep current month only",
+			keep:   1,
+			wanted: []string{"t_202510", "t_202511", "t_202512"},
+		},
+		{
+			desc:   "keep three months",
+			keep:   3,
+			wanted: []string{"t_202510"},
+		},
+		{
+			desc:   "keep more months than present",
+			keep:   12,
+			wanted: []string{},
+		},
+		{
+			desc:   "invalid keep",
+			keep:   0,
+			wanted: []string{"t_202510", "t_202511", "t_202512"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := make([]string, 0)
+			for _, p := range dbutils.ExpiredMonthlyPartitions("t", partitions, now, tc.keep) {
+				got = append(got, p.Name)
+			}
+
+			if !slices.Equal(got, tc.wanted) {
+				t.Fatalf("got partitions %v, wanted %v", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestConvertToPartitionedUnknownStrategy(t *testing.T) {
+	// The strategy is validated before connecting to the database.
+	db := bun.NewDB(sql.OpenDB(pgdriver.NewConnector()), pgdialect.New())
+	defer db.Close() // nolint: errcheck
+
+	err := dbutils.ConvertToPartitioned(context.Background(), db, "aws_net_interface", "hash", "account_id")
+	if !errors.Is(err, dbutils.ErrUnknownPartitionStrategy) {
+		t.Fatalf("got error %v, wanted %v", err, dbutils.ErrUnknownPartitionStrategy)
+	}
+}
+
+func TestPartitionConversionStatements(t *testing.T) {
+	db := bun.NewDB(sql.OpenDB(pgdriver.NewConnector()), pgdialect.New())
+	defer db.Close() // nolint: errcheck
+
+	conv := dbutils.PartitionConversion{
+		Table:    "aws_net_interface",
+		Strategy: dbutils.PartitionStrategyList,
+		Column:   "account_id",
+		Views: []dbutils.DependentView{
+			{Name: "aws_lb_interface", Kind: "v", Def: "SELECT 1;"},
+			{
+				Name:    "aux_summary",
+				Kind:    "m",
+				Def:     "SELECT 2;",
+				Indexes: []string{"CREATE UNIQUE INDEX aux_summary_key ON aux_summary USING btree (id)"},
+			},
+		},
+		UniqueConstraints: []dbutils.TableConstraint{
+			{
+				Table:          "aws_net_interface",
+				Name:           "aws_net_interface_key",
+				Def:            "UNIQUE (interface_id, account_id)",
+				IncludesColumn: true,
+			},
+		},
+		ReferencingForeignKeys: []dbutils.TableConstraint{
+			{
+				Table:          "l_aws_instance_to_net_interface",
+				Name:           "l_aws_instance_to_net_interface_ni_id_fkey",
+				Def:            "FOREIGN KEY (ni_id, ni_account_id) REFERENCES aws_net_interface(id, account_id) ON DELETE CASCADE",
+				IncludesColumn: true,
+			},
+		},
+		Indexes: []dbutils.TableIndex{
+			{
+				Name: "aws_net_interface_status_idx",
+				Def:  "CREATE INDEX aws_net_interface_status_idx ON public.aws_net_interface USING btree (status)",
+			},
+		},
+	}
+
+	got, err := conv.Statements(db.QueryGen())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	wanted := []string{
+		`DROP MATERIALIZED VIEW aux_summary`,
+		`DROP VIEW aws_lb_interface`,
+		`ALTER TABLE l_aws_instance_to_net_interface DROP CONSTRAINT "l_aws_instance_to_net_interface_ni_id_fkey"`,
+		`CREATE TABLE "aws_net_interface_partitioned" (LIKE "aws_net_interface" INCLUDING DEFAULTS INCLUDING CONSTRAINTS) PARTITION BY list ("account_id")`,
+		`CREATE TABLE "aws_net_interface_default" PARTITION OF "aws_net_interface_partitioned" DEFAULT`,
+		`INSERT INTO "aws_net_interface_partitioned" SELECT * FROM "aws_net_interface"`,
+		`DROP TABLE "aws_net_interface"`,
+		`ALTER TABLE "aws_net_interface_partitioned" RENAME TO "aws_net_interface"`,
+		`ALTER TABLE "aws_net_interface" ADD PRIMARY KEY (id, "account_id")`,
+		`ALTER TABLE "aws_net_interface" ADD CONSTRAINT "aws_net_interface_key" UNIQUE (interface_id, account_id)`,
+		`ALTER TABLE l_aws_instance_to_net_interface ADD CONSTRAINT "l_aws_instance_to_net_interface_ni_id_fkey" FOREIGN KEY (ni_id, ni_account_id) REFERENCES aws_net_interface(id, account_id) ON DELETE CASCADE`,
+		`CREATE INDEX aws_net_interface_status_idx ON public.aws_net_interface USING btree (status)`,
+		`CREATE VIEW aws_lb_interface AS SELECT 1;`,
+		`CREATE MATERIALIZED VIEW aux_summary AS SELECT 2;`,
+		`CREATE UNIQUE INDEX aux_summary_key ON aux_summary USING btree (id)`,
+	}
+
+	if !slices.Equal(got, wanted) {
+		t.Fatalf("got statements %q, wanted %q", got, wanted)
+	}
+}
+
+func TestPartitionConversionStatementsRejected(t *testing.T) {
+	db := bun.NewDB(sql.OpenDB(pgdriver.NewConnector()), pgdialect.New())
+	defer db.Close() // nolint: errcheck
+
+	testCases := []struct {
+		desc   string
+		conv   dbutils.PartitionConversion
+		wanted error
+	}{
+		{
+			desc: "unknown strategy",
+			conv: dbutils.PartitionConversion{
+				Table:    "t",
+				Strategy: "hash",
+				Column:   "account_id",
+			},
+			wanted: dbutils.ErrUnknownPartitionStrategy,
+		},
+		{
+			desc: "unique constraint without partition column",
+			conv: dbutils.PartitionConversion{
+				Table:    "t",
+				Strategy: dbutils.PartitionStrategyList,
+				Column:   "account_id",
+				UniqueConstraints: []dbutils.TableConstraint{
+					{Table: "t", Name: "t_key", Def: "UNIQUE (name)"},
+				},
+			},
+			wanted: dbutils.ErrPartitionColumnNotUnique,
+		},
+		{
+			desc: "unique index without partition column",
+			conv: dbutils.PartitionConversion{
+				Table:    "t",
+				Strategy: dbutils.PartitionStrategyList,
+				Column:   "account_id",
+				Indexes: []dbutils.TableIndex{
+					{Name: "t_name_idx", Def: "CREATE UNIQUE INDEX t_name_idx ON t (name)", Unique: true},
+				},
+			},
+			wanted: dbutils.ErrPartitionColumnNotUnique,
+		},
+		{
+			desc: "referencing foreign key without partition column",
+			conv: dbutils.PartitionConversion{
+				Table:    "t",
+				Strategy: dbutils.PartitionStrategyList,
+				Column:   "account_id",
+				ReferencingForeignKeys: []dbutils.TableConstraint{
+					{Table: "l_t", Name: "l_t_t_id_fkey", Def: "FOREIGN KEY (t_id) REFERENCES t(id)"},
+				},
+			},
+			wanted: dbutils.ErrPartitionColumnNotReferenced,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			stmts, err := tc.conv.Statements(db.QueryGen())
+			if !errors.Is(err, tc.wanted) {
+				t.Fatalf("got error %v, wanted %v", err, tc.wanted)
+			}
+
+			if len(stmts) != 0 {
+				t.Fatalf("got statements %q, wanted none", stmts)
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// DefaultQueryTimeout specifies the default max duration of named queries.
+const DefaultQueryTimeout = 30 * time.Second
+
+// DefaultQueryMaxRows specifies the default max number of rows returned by
+// named queries.
+const DefaultQueryMaxRows = 1000
+
+// QueryFileExtension specifies the extension of the named query files.
+const QueryFileExtension = ".sql"
+
+// ErrInvalidQuery is an error, which is returned when a named query file is
+// malformed.
+var ErrInvalidQuery = errors.New("invalid query")
+
+// ErrUnknownQuery is an error, which is returned when running a named query,
+// which is not known.
+var ErrUnknownQuery = errors.New("unknown query")
+
+// ErrInvalidQueryParams is an error, which is returned when running a named
+// query with missing or unknown parameters.
+var ErrInvalidQueryParams = errors.New("invalid query parameters")
+
+// queryNameRegexp matches valid names of named queries and their parameters.
+var queryNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// reservedQueryParams specifies the names, which may not be used for query
+// parameters, since they are used for the options of the queries.
+var reservedQueryParams = []string{"limit"}
+
+// QueryParam describes a parameter of a [NamedQuery].
+type QueryParam struct {
+	// Name specifies the name of the parameter, which is referenced as
+	// `?name' in the query.
+	Name string `json:"name" yaml:"name"`
+
+	// Description provides a short description of the parameter.
+	Description string `json:"description" yaml:"description"`
+}
+
+// NamedQuery represents a read-only SQL query, which is loaded from a query
+// file. The name of the query is derived from the name of the file.
+//
+// The query file starts with optional header comments, which provide the
+// description and the parameters of the query, followed by a single SELECT
+// statement.
+//
+//	-- description: Compute instances in a region
+//	-- param: region Name of the region
+//	SELECT name, provider FROM aux_compute_instance WHERE region = ?region
+type NamedQuery struct {
+	// Name specifies the name of the query.
+	Name string `json:"name" yaml:"name"`
+
+	// Description provides a short description of the query.
+	Description string `json:"description" yaml:"description"`
+
+	// Params specifies the parameters of the query. All parameters are
+	// required.
+	Params []QueryParam `json:"params" yaml:"params"`
+
+	// Query specifies the SQL statement.
+	Query string `json:"-" yaml:"-"`
+}
+
+// QueryOptions specifies the options for running a [NamedQuery].
+type QueryOptions struct {
+	// Params specifies the values of the query parameters.
+	Params map[string]string
+
+	// Timeout specifies the max duration of the query, after which it is
+	// aborted. If zero, [DefaultQueryTimeout] is used.
+	Timeout time.Duration
+
+	// MaxRows specifies the max number of returned rows. If zero,
+	// [DefaultQueryMaxRows] is used.
+	MaxRows int
+}
+
+// QueryResult represents the result of a [NamedQuery].
+type QueryResult struct {
+	// Name specifies the name of the query.
+	Name string `json:"name" yaml:"name" desc:"Name of the query"`
+
+	// Columns specifies the names of the returned columns in order.
+	Columns []string `json:"columns" yaml:"columns" desc:"Names of the returned columns"`
+
+	// Rows specifies the returned rows keyed by column name.
+	Rows []map[string]any `json:"rows" yaml:"rows" desc:"Returned rows"`
+
+	// Truncated specifies whether more rows than the max number of rows
+	// are available.
+	Truncated bool `json:"truncated" yaml:"truncated" desc:"Whether the rows were truncated"`
+}
+
+// ParseNamedQuery parses the [NamedQuery] with the given name from the given
+// contents of a query file.
+func ParseNamedQuery(name string, data string) (*NamedQuery, error) {
+	if !queryNameRegexp.MatchString(name) {
+		return nil, fmt.Errorf("%w: invalid name %q", ErrInvalidQuery, name)
+	}
+
+	q := &NamedQuery{
+		Name:   name,
+		Params: make([]QueryParam, 0),
+	}
+
+	var body strings.Builder
+	header := true
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if header && strings.HasPrefix(trimmed, "--") {
+			key, value, ok := strings.Cut(strings.TrimSpace(strings.TrimPrefix(trimmed, "--")), ":")
+			if !ok {
+				continue
+			}
+			value = strings.TrimSpace(value)
+			switch strings.TrimSpace(key) {
+			case "description":
+				q.Description = value
+			case "param":
+				paramName, desc, _ := strings.Cut(value, " ")
+				if !queryNameRegexp.MatchString(paramName) || slices.Contains(reservedQueryParams, paramName) {
+					return nil, fmt.Errorf("%w: %s: invalid parameter name %q", ErrInvalidQuery, name, paramName)
+				}
+				if slices.ContainsFunc(q.Params, func(p QueryParam) bool { return p.Name == paramName }) {
+					return nil, fmt.Errorf("%w: %s: duplicate parameter %q", ErrInvalidQuery, name, paramName)
+				}
+				q.Params = append(q.Params, QueryParam{Name: paramName, Description: strings.TrimSpace(desc)})
+			}
+
+			continue
+		}
+		if trimmed != "" {
+			header = false
+		}
+		body.WriteString(line)
+		body.WriteString("\n")
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// Only a single SELECT statement is allowed. The statement is further
+	// wrapped in a sub-query, when being run, which rejects multiple
+	// statements.
+	q.Query = strings.TrimRight(strings.TrimSpace(body.String()), "; \t\n")
+	fields := strings.Fields(strings.ToUpper(q.Query))
+	if len(fields) == 0 || (fields[0] != "SELECT" && fields[0] != "WITH") {
+		return nil, fmt.Errorf("%w: %s: query must be a SELECT statement", ErrInvalidQuery, name)
+	}
+	if strings.Contains(q.Query, ";") {
+		return nil, fmt.Errorf("%w: %s: query must be a single statement", ErrInvalidQuery, name)
+	}
+
+	return q, nil
+}
+
+// LoadNamedQueries loads the named queries from the query files in the given
+// directory, and returns them keyed by name.
+func LoadNamedQueries(dir string) (map[string]*NamedQuery, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := make(map[string]*NamedQuery)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != QueryFileExtension {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		name := strings.TrimSuffix(entry.Name(), QueryFileExtension)
+		q, err := ParseNamedQuery(name, string(data))
+		if err != nil {
+			return nil, err
+		}
+		queries[name] = q
+	}
+
+	return queries, nil
+}
+
+// RunNamedQuery runs the given [NamedQuery] in a read-only transaction, which
+// is aborted by the database server after the configured timeout. At most the
+// configured max number of rows is returned.
+func RunNamedQuery(ctx context.Context, db *bun.DB, q *NamedQuery, opts QueryOptions) (*QueryResult, error) {
+	for key := range opts.Params {
+		if !slices.ContainsFunc(q.Params, func(p QueryParam) bool { return p.Name == key }) {
+			return nil, fmt.Errorf("%w: unknown parameter %q", ErrInvalidQueryParams, key)
+		}
+	}
+
+	for _, p := range q.Params {
+		value, ok := opts.Params[p.Name]
+		if !ok {
+			return nil, fmt.Errorf("%w: missing parameter %q", ErrInvalidQueryParams, p.Name)
+		}
+		db = db.WithNamedArg(p.Name, value)
+	}
+
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = DefaultQueryTimeout
+	}
+	maxRows := opts.MaxRows
+	if maxRows <= 0 {
+		maxRows = DefaultQueryMaxRows
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result := &QueryResult{
+		Name: q.Name,
+		Rows: make([]map[string]any, 0),
+	}
+	err := db.RunInTx(ctx, &sql.TxOptions{ReadOnly: true}, func(ctx context.Context, tx bun.Tx) error {
+		stmt := fmt.Sprintf("SET LOCAL statement_timeout = %d", timeout.Milliseconds())
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+
+		// Fetch an additional row in order to detect truncated results
+		query := fmt.Sprintf("SELECT * FROM (%s) AS q LIMIT %d", q.Query, maxRows+1)
+		rows, err := tx.QueryContext(ctx, query)
+		if err != nil {
+			return err
+		}
+		defer rows.Close() // nolint: errcheck
+
+		columns, err := rows.Columns()
+		if err != nil {
+			return err
+		}
+		result.Columns = columns
+
+		for rows.Next() {
+			if len(result.Rows) == maxRows {
+				result.Truncated = true
+
+				break
+			}
+
+			values := make([]any, len(columns))
+			dest := make([]any, len(columns))
+			for i := range values {
+				dest[i] = &values[i]
+			}
+			if err := rows.Scan(dest...); err != nil {
+				return err
+			}
+
+			row := make(map[string]any, len(columns))
+			for i, column := range columns {
+				if b, ok := values[i].([]byte); ok {
+					values[i] = string(b)
+				}
+				row[column] = values[i]
+			}
+			result.Rows = append(result.Rows, row)
+		}
+
+		return rows.Err()
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+func TestParseNamedQuery(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		name       string
+		data       string
+		wantParams int
+		wantErr    error
+	}{
+		{
+			desc: "query with params",
+			name: "instances_by_region",
+			data: `-- description: Compute instances in a region
+-- param: region Name of the region
+-- param: provider Name of the provider
+SELECT name
+FROM aux_compute_instance
+WHERE region = ?region AND provider = ?provider;
+`,
+			wantParams: 2,
+		},
+		{
+			desc: "query with cte",
+			name: "shoots",
+			data: "WITH s AS (SELECT name FROM g_shoot) SELECT * FROM s",
+		},
+		{
+			desc:    "invalid name",
+			name:    "Shoots-All",
+			data:    "SELECT 1",
+			wantErr: dbutils.ErrInvalidQuery,
+		},
+		{
+			desc:    "reserved param",
+			name:    "shoots",
+			data:    "-- param: limit Max number of rows\nSELECT 1",
+			wantErr: dbutils.ErrInvalidQuery,
+		},
+		{
+			desc:    "duplicate param",
+			name:    "shoots",
+			data:    "-- param: name Name\n-- param: name Name\nSELECT 1",
+			wantErr: dbutils.ErrInvalidQuery,
+		},
+		{
+			desc:    "not a select statement",
+			name:    "cleanup",
+			data:    "DELETE FROM g_shoot",
+			wantErr: dbutils.ErrInvalidQuery,
+		},
+		{
+			desc:    "multiple statements",
+			name:    "shoots",
+			data:    "SELECT 1; DROP TABLE g_shoot",
+			wantErr: dbutils.ErrInvalidQuery,
+		},
+		{
+			desc:    "empty query",
+			name:    "shoots",
+			data:    "-- description: Nothing to see",
+			wantErr: dbutils.ErrInvalidQuery,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			q, err := dbutils.ParseNamedQuery(tc.name, tc.data)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got error %v, wanted %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(q.Params) != tc.wantParams {
+				t.Fatalf("got %d params, wanted %d", len(q.Params), tc.wantParams)
+			}
+		})
+	}
+}
+
+func TestLoadNamedQueries(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"shoots.sql":  "-- description: All shoots\nSELECT name FROM g_shoot",
+		"seeds.sql":   "SELECT name FROM g_seed",
+		"README.md":   "Not a query",
+		"ignored.txt": "DELETE FROM g_shoot",
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	queries, err := dbutils.LoadNamedQueries(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(queries) != 2 {
+		t.Fatalf("got %d queries, wanted %d", len(queries), 2)
+	}
+	if q, ok := queries["shoots"]; !ok || q.Description != "All shoots" {
+		t.Fatalf("got query %+v", q)
+	}
+}
@@ -0,0 +1,365 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/schema"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// Kinds of schema drift
+const (
+	// SchemaDriftMissingTable specifies that the table of a model does not
+	// exist in the database.
+	SchemaDriftMissingTable = "missing-table"
+
+	// SchemaDriftMissingColumn specifies that a column of a model does not
+	// exist in the database.
+	SchemaDriftMissingColumn = "missing-column"
+
+	// SchemaDriftMissingView specifies that the view of a model, which
+	// implements [ViewModel], does not exist in the database.
+	SchemaDriftMissingView = "missing-view"
+
+	// SchemaDriftMissingIndex specifies that a unique index of a model
+	// does not exist in the database.
+	SchemaDriftMissingIndex = "missing-index"
+
+	// SchemaDriftExtraColumn specifies that a column exists in the
+	// database, which is not part of the model.
+	SchemaDriftExtraColumn = "extra-column"
+)
+
+// ViewModel is an interface, which is implemented by models backed by a view
+// instead of a table, e.g. aux:model:compute_instance.
+type ViewModel interface {
+	// IsView returns true, if the model is backed by a view.
+	IsView() bool
+}
+
+// isViewModel returns true, if the given model is backed by a view.
+func isViewModel(model any) bool {
+	v, ok := model.(ViewModel)
+
+	return ok && v.IsView()
+}
+
+// SchemaDrift represents a single difference between a model and the database
+// schema.
+type SchemaDrift struct {
+	// Model specifies the name of the model as registered in the
+	// [registry.ModelRegistry].
+	Model string `json:"model" yaml:"model"`
+
+	// Table specifies the name of the table of the model.
+	Table string `json:"table" yaml:"table"`
+
+	// Kind specifies the kind of drift, e.g. [SchemaDriftMissingColumn].
+	Kind string `json:"kind" yaml:"kind"`
+
+	// Name specifies the name of the column, or the comma-separated
+	// columns of the index.
+	Name string `json:"name" yaml:"name"`
+}
+
+// IsMissing returns true, if the drift represents something, which is defined
+// by the model, but missing in the database. Such drift usually indicates a
+// forgotten migration.
+func (d SchemaDrift) IsMissing() bool {
+	return d.Kind != SchemaDriftExtraColumn
+}
+
+// DiffSchema compares the models registered in the [registry.ModelRegistry]
+// against the schema of the database and returns the differences.
+func DiffSchema(ctx context.Context, db *bun.DB) ([]SchemaDrift, error) {
+	modelNames := make([]string, 0)
+	walker := func(name string, _ any) error {
+		modelNames = append(modelNames, name)
+
+		return nil
+	}
+
+	if err := registry.ModelRegistry.Range(walker); err != nil {
+		return nil, err
+	}
+	slices.Sort(modelNames)
+
+	items := make([]SchemaDrift, 0)
+	for _, name := range modelNames {
+		model, _ := registry.ModelRegistry.Get(name)
+		table := db.Table(reflect.TypeOf(model).Elem())
+		rel, err := GetRelation(ctx, db, table.Name)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, DiffRelation(name, table, rel)...)
+	}
+
+	return items, nil
+}
+
+// Relation represents the schema of a table or a view in the database.
+type Relation struct {
+	// Kind specifies the kind of the relation as in pg_class.relkind, e.g.
+	// `r' for tables, `p' for partitioned tables, `v' for views and `m'
+	// for materialized views. It is empty, if the relation does not
+	// exist.
+	Kind string
+
+	// Columns specifies the names of the columns.
+	Columns []string
+
+	// Indexes specifies the sorted, comma-separated columns of each
+	// unique index.
+	Indexes []string
+
+	// PartitionKey specifies the columns of the partition key of
+	// partitioned tables.
+	PartitionKey []string
+}
+
+// IsView returns true, if the relation is a view or a materialized view.
+func (r Relation) IsView() bool {
+	return r.Kind == "v" || r.Kind == "m"
+}
+
+// GetRelation returns the [Relation] with the given name from the database.
+func GetRelation(ctx context.Context, db bun.IDB, name string) (Relation, error) {
+	var rel Relation
+	if err := db.NewRaw("SELECT COALESCE((SELECT relkind::text FROM pg_class WHERE oid = to_regclass(?)), '')", name).Scan(ctx, &rel.Kind); err != nil {
+		return rel, err
+	}
+
+	// The columns and indexes of views are defined by the view query
+	if rel.Kind == "" || rel.IsView() {
+		return rel, nil
+	}
+
+	rel.Columns = make([]string, 0)
+	columnsQuery := `SELECT attname FROM pg_attribute
+WHERE attrelid = to_regclass(?) AND attnum > 0 AND NOT attisdropped`
+	if err := db.NewRaw(columnsQuery, name).Scan(ctx, &rel.Columns); err != nil {
+		return rel, err
+	}
+
+	// Unique indexes are compared by their columns, since the names of
+	// the indexes created by migrations may differ from the model tags.
+	rel.Indexes = make([]string, 0)
+	indexesQuery := `SELECT string_agg(a.attname, ',' ORDER BY a.attname) FROM pg_index AS i
+INNER JOIN pg_attribute AS a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)
+WHERE i.indrelid = to_regclass(?) AND i.indisunique
+GROUP BY i.indexrelid`
+	if err := db.NewRaw(indexesQuery, name).Scan(ctx, &rel.Indexes); err != nil {
+		return rel, err
+	}
+
+	if rel.Kind == "p" {
+		rel.PartitionKey = make([]string, 0)
+		partitionKeyQuery := `SELECT a.attname FROM pg_partitioned_table AS p
+INNER JOIN pg_attribute AS a ON a.attrelid = p.partrelid AND a.attnum = ANY(p.partattrs)
+WHERE p.partrelid = to_regclass(?)`
+		if err := db.NewRaw(partitionKeyQuery, name).Scan(ctx, &rel.PartitionKey); err != nil {
+			return rel, err
+		}
+	}
+
+	return rel, nil
+}
+
+// DiffRelation compares the table of the given model against the [Relation]
+// from the database. Views are compared by their existence only. The primary
+// key and the unique indexes of partitioned tables are expected to include the
+// partition key, since Postgres requires them to.
+func DiffRelation(model string, table *schema.Table, rel Relation) []SchemaDrift {
+	newDrift := func(kind, name string) SchemaDrift {
+		return SchemaDrift{
+			Model: model,
+			Table: table.Name,
+			Kind:  kind,
+			Name:  name,
+		}
+	}
+
+	switch {
+	case rel.Kind == "" && isViewModel(table.ZeroIface):
+		return []SchemaDrift{newDrift(SchemaDriftMissingView, table.Name)}
+	case rel.Kind == "":
+		return []SchemaDrift{newDrift(SchemaDriftMissingTable, table.Name)}
+	case rel.IsView():
+		return []SchemaDrift{}
+	}
+
+	items := make([]SchemaDrift, 0)
+	for _, field := range table.Fields {
+		if !slices.Contains(rel.Columns, field.Name) {
+			items = append(items, newDrift(SchemaDriftMissingColumn, field.Name))
+		}
+	}
+
+	for _, column := range rel.Columns {
+		if _, ok := table.FieldMap[column]; !ok {
+			items = append(items, newDrift(SchemaDriftExtraColumn, column))
+		}
+	}
+
+	uniques := make([][]*schema.Field, 0, len(table.Unique)+1)
+	if len(table.PKs) > 0 {
+		uniques = append(uniques, table.PKs)
+	}
+	for _, fields := range table.Unique {
+		uniques = append(uniques, fields)
+	}
+
+	wantIndexes := make([]string, 0, len(uniques))
+	for _, fields := range uniques {
+		names := slices.Clone(rel.PartitionKey)
+		for _, field := range fields {
+			names = append(names, field.Name)
+		}
+		slices.Sort(names)
+		index := strings.Join(slices.Compact(names), ",")
+		if !slices.Contains(wantIndexes, index) {
+			wantIndexes = append(wantIndexes, index)
+		}
+	}
+	slices.Sort(wantIndexes)
+
+	for _, index := range wantIndexes {
+		if !slices.Contains(rel.Indexes, index) {
+			items = append(items, newDrift(SchemaDriftMissingIndex, index))
+		}
+	}
+
+	slices.SortStableFunc(items, func(a, b SchemaDrift) int {
+		return cmp.Compare(a.Kind, b.Kind)
+	})
+
+	return items
+}
+
+// maxIdentifierLength specifies the maximum length of identifiers in Postgres.
+const maxIdentifierLength = 63
+
+// Migration represents the SQL statements of a generated migration.
+type Migration struct {
+	// Up specifies the statements, which apply the migration.
+	Up []string
+
+	// Down specifies the statements, which roll back the migration.
+	Down []string
+
+	// Skipped specifies the drift, for which no statements are generated,
+	// e.g. missing views, which have to be created by hand-written
+	// migrations.
+	Skipped []SchemaDrift
+}
+
+// IsEmpty returns true, if the migration does not contain any statements.
+func (m Migration) IsEmpty() bool {
+	return len(m.Up) == 0
+}
+
+// GenerateMigration generates a [Migration], which creates the tables, columns
+// and unique indexes of the registered models, which are missing in the
+// database. See [NewMigration] for more details.
+func GenerateMigration(ctx context.Context, db *bun.DB) (Migration, error) {
+	items, err := DiffSchema(ctx, db)
+	if err != nil {
+		return Migration{}, err
+	}
+
+	return NewMigration(db, items), nil
+}
+
+// NewMigration creates a [Migration] from the given drift of the models
+// registered in the [registry.ModelRegistry]. Extra columns are not dropped,
+// since they may still contain data. Missing views are skipped, since their
+// query is not known from the model. The unique indexes of partitioned tables
+// include the partition key as reported by [DiffRelation].
+//
+// The generated statements are a starting point and should be reviewed, e.g.
+// columns, which are NOT NULL without a default are added as nullable, since
+// the table may already contain records.
+func NewMigration(db *bun.DB, items []SchemaDrift) Migration {
+	var migration Migration
+	for _, item := range items {
+		model, ok := registry.ModelRegistry.Get(item.Model)
+		if !ok {
+			migration.Skipped = append(migration.Skipped, item)
+
+			continue
+		}
+		table := db.Table(reflect.TypeOf(model).Elem())
+
+		var up, down string
+		switch item.Kind {
+		case SchemaDriftMissingTable:
+			up = db.NewCreateTable().Model(model).IfNotExists().String()
+			down = db.NewDropTable().Model(model).IfExists().String()
+		case SchemaDriftMissingColumn:
+			// Columns added to partitioned tables are added to
+			// their partitions as well.
+			field := table.FieldMap[item.Name]
+			up = fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS %s", table.SQLName, columnDefinition(field))
+			down = fmt.Sprintf("ALTER TABLE %s DROP COLUMN IF EXISTS %s", table.SQLName, field.SQLName)
+		case SchemaDriftMissingIndex:
+			name := indexName(table.Name, item.Name)
+			columns := make([]string, 0)
+			for _, column := range strings.Split(item.Name, ",") {
+				columns = append(columns, string(table.FieldMap[column].SQLName))
+			}
+			up = fmt.Sprintf("CREATE UNIQUE INDEX IF NOT EXISTS %q ON %s (%s)", name, table.SQLName, strings.Join(columns, ", "))
+			down = fmt.Sprintf("DROP INDEX IF EXISTS %q", name)
+		case SchemaDriftMissingView:
+			migration.Skipped = append(migration.Skipped, item)
+
+			continue
+		default:
+			continue
+		}
+
+		migration.Up = append(migration.Up, up+";")
+		migration.Down = append(migration.Down, down+";")
+	}
+
+	// Roll back in reverse order
+	slices.Reverse(migration.Down)
+
+	return migration
+}
+
+// columnDefinition returns the definition of the given column as used in
+// ALTER TABLE ... ADD COLUMN statements.
+func columnDefinition(field *schema.Field) string {
+	def := fmt.Sprintf("%s %s", field.SQLName, field.CreateTableSQLType)
+	if field.SQLDefault != "" {
+		def += " DEFAULT " + field.SQLDefault
+		if field.NotNull {
+			def += " NOT NULL"
+		}
+	}
+
+	return def
+}
+
+// indexName returns the name of a unique index on the given comma-separated
+// columns of a table.
+func indexName(table string, columns string) string {
+	name := table + "_" + strings.ReplaceAll(columns, ",", "_") + "_key"
+	if len(name) > maxIdentifierLength {
+		name = name[:maxIdentifierLength]
+	}
+
+	return name
+}
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db_test
+
+import (
+	"database/sql"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+type schemaItem struct {
+	bun.BaseModel `bun:"table:test_schema_item"`
+
+	ID        int    `bun:"id,pk,autoincrement"`
+	Name      string `bun:"name,notnull,unique:test_schema_item_key"`
+	AccountID string `bun:"account_id,notnull,unique:test_schema_item_key"`
+}
+
+type schemaView struct {
+	bun.BaseModel `bun:"table:test_schema_view"`
+
+	ID   int    `bun:"id,pk"`
+	Name string `bun:"name"`
+}
+
+func (schemaView) IsView() bool {
+	return true
+}
+
+func TestDiffRelation(t *testing.T) {
+	db := bun.NewDB(sql.OpenDB(pgdriver.NewConnector()), pgdialect.New())
+	defer db.Close() // nolint: errcheck
+
+	itemTable := db.Table(reflect.TypeFor[schemaItem]())
+	viewTable := db.Table(reflect.TypeFor[schemaView]())
+
+	testCases := []struct {
+		desc  string
+		table string
+		rel   dbutils.Relation
+		want  []dbutils.SchemaDrift
+	}{
+		{
+			desc:  "missing table",
+			table: "item",
+			rel:   dbutils.Relation{},
+			want: []dbutils.SchemaDrift{
+				{Model: "item", Table: "test_schema_item", Kind: dbutils.SchemaDriftMissingTable, Name: "test_schema_item"},
+			},
+		},
+		{
+			desc:  "table in sync",
+			table: "item",
+			rel: dbutils.Relation{
+				Kind:    "r",
+				Columns: []string{"id", "name", "account_id"},
+				Indexes: []string{"id", "account_id,name"},
+			},
+			want: []dbutils.SchemaDrift{},
+		},
+		{
+			desc:  "missing column and index",
+			table: "item",
+			rel: dbutils.Relation{
+				Kind:    "r",
+				Columns: []string{"id", "name", "legacy"},
+				Indexes: []string{"id"},
+			},
+			want: []dbutils.SchemaDrift{
+				{Model: "item", Table: "test_schema_item", Kind: dbutils.SchemaDriftExtraColumn, Name: "legacy"},
+				{Model: "item", Table: "test_schema_item", Kind: dbutils.SchemaDriftMissingColumn, Name: "account_id"},
+				{Model: "item", Table: "test_schema_item", Kind: dbutils.SchemaDriftMissingIndex, Name: "account_id,name"},
+			},
+		},
+		{
+			desc:  "partitioned table in sync",
+			table: "item",
+			rel: dbutils.Relation{
+				Kind:         "p",
+				Columns:      []string{"id", "name", "account_id"},
+				Indexes:      []string{"account_id,id", "account_id,name"},
+				PartitionKey: []string{"account_id"},
+			},
+			want: []dbutils.SchemaDrift{},
+		},
+		{
+			desc:  "partitioned table with missing primary key",
+			table: "item",
+			rel: dbutils.Relation{
+				Kind:         "p",
+				Columns:      []string{"id", "name", "account_id"},
+				Indexes:      []string{"id", "account_id,name"},
+				PartitionKey: []string{"account_id"},
+			},
+			want: []dbutils.SchemaDrift{
+				{Model: "item", Table: "test_schema_item", Kind: dbutils.SchemaDriftMissingIndex, Name: "account_id,id"},
+			},
+		},
+		{
+			desc:  "missing view",
+			table: "view",
+			rel:   dbutils.Relation{},
+			want: []dbutils.SchemaDrift{
+				{Model: "view", Table: "test_schema_view", Kind: dbutils.SchemaDriftMissingView, Name: "test_schema_view"},
+			},
+		},
+		{
+			desc:  "existing view",
+			table: "view",
+			rel:   dbutils.Relation{Kind: "v"},
+			want:  []dbutils.SchemaDrift{},
+		},
+		{
+			desc:  "existing materialized view",
+			table: "view",
+			rel:   dbutils.Relation{Kind: "m"},
+			want:  []dbutils.SchemaDrift{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			table := itemTable
+			if tc.table == "view" {
+				table = viewTable
+			}
+
+			got := dbutils.DiffRelation(tc.table, table, tc.rel)
+			if !slices.Equal(got, tc.want) {
+				t.Fatalf("got drift %+v, wanted %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewMigration(t *testing.T) {
+	registry.ModelRegistry.MustRegister("test:model:schema_item", &schemaItem{})
+	registry.ModelRegistry.MustRegister("test:model:schema_view", &schemaView{})
+	t.Cleanup(func() {
+		registry.ModelRegistry.Unregister("test:model:schema_item")
+		registry.ModelRegistry.Unregister("test:model:schema_view")
+	})
+
+	db := bun.NewDB(sql.OpenDB(pgdriver.NewConnector()), pgdialect.New())
+	defer db.Close() // nolint: errcheck
+
+	// Drift of a table partitioned by account_id, and of a missing view
+	itemTable := db.Table(reflect.TypeFor[schemaItem]())
+	items := dbutils.DiffRelation("test:model:schema_item", itemTable, dbutils.Relation{
+		Kind:         "p",
+		Columns:      []string{"id", "account_id"},
+		Indexes:      []string{},
+		PartitionKey: []string{"account_id"},
+	})
+	missingView := dbutils.SchemaDrift{
+		Model: "test:model:schema_view",
+		Table: "test_schema_view",
+		Kind:  dbutils.SchemaDriftMissingView,
+		Name:  "test_schema_view",
+	}
+	items = append(items, missingView)
+
+	migration := dbutils.NewMigration(db, items)
+
+	wantUp := []string{
+		`ALTER TABLE "test_schema_item" ADD COLUMN IF NOT EXISTS "name" VARCHAR;`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS "test_schema_item_account_id_id_key" ON "test_schema_item" ("account_id", "id");`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS "test_schema_item_account_id_name_key" ON "test_schema_item" ("account_id", "name");`,
+	}
+	if !slices.Equal(migration.Up, wantUp) {
+		t.Fatalf("got up statements %q, wanted %q", migration.Up, wantUp)
+	}
+
+	wantDown := []string{
+		`DROP INDEX IF EXISTS "test_schema_item_account_id_name_key";`,
+		`DROP INDEX IF EXISTS "test_schema_item_account_id_id_key";`,
+		`ALTER TABLE "test_schema_item" DROP COLUMN IF EXISTS "name";`,
+	}
+	if !slices.Equal(migration.Down, wantDown) {
+		t.Fatalf("got down statements %q, wanted %q", migration.Down, wantDown)
+	}
+
+	wantSkipped := []dbutils.SchemaDrift{missingView}
+	if !slices.Equal(migration.Skipped, wantSkipped) {
+		t.Fatalf("got skipped drift %+v, wanted %+v", migration.Skipped, wantSkipped)
+	}
+}
@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/schema"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// DefaultSearchLimit specifies the default max number of records returned for
+// each searched column.
+const DefaultSearchLimit = 100
+
+// ErrNoSearchTerm is an error, which is returned when searching with an empty
+// term.
+var ErrNoSearchTerm = errors.New("no search term specified")
+
+// SearchOptions specifies the options for searching resources.
+type SearchOptions struct {
+	// Term specifies the value to search for. The search is
+	// case-insensitive, and the `*' wildcard may be used for matching any
+	// sequence of characters.
+	Term string
+
+	// Limit specifies the max number of records returned for each searched
+	// column. If zero, [DefaultSearchLimit] is used.
+	Limit int
+}
+
+// SearchResult represents a record, which matches the search term.
+type SearchResult struct {
+	// Provider specifies the provider of the model, e.g. `aws'.
+	Provider string `bun:"-" json:"provider" yaml:"provider"`
+
+	// Model specifies the name of the model.
+	Model string `bun:"-" json:"model" yaml:"model"`
+
+	// Column specifies the column, which matched the search term.
+	Column string `bun:"-" json:"column" yaml:"column"`
+
+	// Value specifies the value of the matching column.
+	Value string `bun:"value" json:"value" yaml:"value"`
+
+	// Row contains the matching record.
+	Row map[string]any `bun:"row" json:"row" yaml:"row"`
+}
+
+// hostColumns specifies the names of text columns, which hold host names or
+// domains, e.g. the advertised kube-apiserver hosts of Gardener Shoots, and
+// are searched in addition to the unique keys.
+var hostColumns = []string{
+	"host",
+	"hostname",
+	"fqdn",
+	"dns_domain",
+}
+
+// searchColumns returns the well-known identifier columns of the given model,
+// which are searched. These are the `name' column, the host name columns and
+// the text columns of the unique keys, excluding the column referencing the
+// provider account.
+func searchColumns(name string, table *schema.Table) []string {
+	var accountColumn string
+	if link, ok := registry.AccountLinkRegistry.Get(name); ok {
+		accountColumn = link.Column
+	}
+
+	columns := make([]string, 0)
+	add := func(field *schema.Field) {
+		if field.IndirectType.Kind() != reflect.String || field.Name == accountColumn {
+			return
+		}
+		if !slices.Contains(columns, field.Name) {
+			columns = append(columns, field.Name)
+		}
+	}
+
+	for _, column := range append([]string{"name"}, hostColumns...) {
+		if field, ok := table.FieldMap[column]; ok {
+			add(field)
+		}
+	}
+	for _, fields := range table.Unique {
+		for _, field := range fields {
+			add(field)
+		}
+	}
+	slices.Sort(columns)
+
+	return columns
+}
+
+// searchPattern converts the given search term into a pattern for the ILIKE
+// operator.
+func searchPattern(term string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		"%", `\%`,
+		"_", `\_`,
+		"*", "%",
+	)
+
+	return replacer.Replace(term)
+}
+
+// SearchableModels returns the sorted names of the registered models, which may
+// be searched. Models registered with [registry.SensitiveModelRegistry], e.g.
+// API tokens and the audit log, are excluded.
+func SearchableModels() ([]string, error) {
+	modelNames := make([]string, 0)
+	walker := func(name string, _ any) error {
+		if !registry.SensitiveModelRegistry.Exists(name) {
+			modelNames = append(modelNames, name)
+		}
+
+		return nil
+	}
+
+	if err := registry.ModelRegistry.Range(walker); err != nil {
+		return nil, err
+	}
+	slices.Sort(modelNames)
+
+	return modelNames, nil
+}
+
+// Search searches the well-known identifier columns of all registered models,
+// e.g. instance IDs, VPC IDs and bucket names, for the given term.
+func Search(ctx context.Context, db *bun.DB, opts SearchOptions) ([]SearchResult, error) {
+	term := strings.TrimSpace(opts.Term)
+	if term == "" {
+		return nil, ErrNoSearchTerm
+	}
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+
+	modelNames, err := SearchableModels()
+	if err != nil {
+		return nil, err
+	}
+
+	pattern := searchPattern(term)
+	results := make([]SearchResult, 0)
+	for _, name := range modelNames {
+		model, _ := registry.ModelRegistry.Get(name)
+		table := db.Table(reflect.TypeOf(model).Elem())
+		provider, _, _ := strings.Cut(name, ":")
+
+		for _, column := range searchColumns(name, table) {
+			items := make([]SearchResult, 0)
+			err := db.NewSelect().
+				TableExpr("? AS t", bun.Ident(table.Name)).
+				ColumnExpr("t.? AS value", bun.Ident(column)).
+				ColumnExpr("to_jsonb(t) AS row").
+				Where("t.? ILIKE ?", bun.Ident(column), pattern).
+				OrderExpr("t.?", bun.Ident(column)).
+				Limit(limit).
+				Scan(ctx, &items)
+
+			if err != nil {
+				return nil, fmt.Errorf("cannot search %s.%s: %w", name, column, err)
+			}
+
+			for _, item := range items {
+				item.Provider = provider
+				item.Model = name
+				item.Column = column
+				results = append(results, item)
+			}
+		}
+	}
+
+	return results, nil
+}
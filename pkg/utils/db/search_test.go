@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+type searchableItem struct {
+	bun.BaseModel `bun:"table:test_searchable_item"`
+
+	Name string `bun:"name"`
+}
+
+type sensitiveItem struct {
+	bun.BaseModel `bun:"table:test_sensitive_item"`
+
+	Name      string `bun:"name,unique"`
+	TokenHash string `bun:"token_hash,unique"`
+}
+
+func TestSearchableModels(t *testing.T) {
+	registry.ModelRegistry.MustRegister("test:model:searchable_item", &searchableItem{})
+	registry.ModelRegistry.MustRegister("test:model:sensitive_item", &sensitiveItem{})
+	registry.SensitiveModelRegistry.MustRegister("test:model:sensitive_item", registry.SensitiveModel{Reason: "test"})
+	defer func() {
+		registry.ModelRegistry.Unregister("test:model:searchable_item")
+		registry.ModelRegistry.Unregister("test:model:sensitive_item")
+		registry.SensitiveModelRegistry.Unregister("test:model:sensitive_item")
+	}()
+
+	names, err := dbutils.SearchableModels()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !slices.Contains(names, "test:model:searchable_item") {
+		t.Fatalf("got models %v, wanted test:model:searchable_item", names)
+	}
+	if slices.Contains(names, "test:model:sensitive_item") {
+		t.Fatalf("got models %v, wanted test:model:sensitive_item to be excluded", names)
+	}
+	if !slices.IsSorted(names) {
+		t.Fatalf("got models %v, wanted them sorted", names)
+	}
+}
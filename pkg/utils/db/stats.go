@@ -0,0 +1,259 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// Pseudo columns, which may be used for grouping in addition to the columns of
+// the models.
+const (
+	// StatsColumnProvider groups the stats by provider, which is derived
+	// from the model name, e.g. `aws' for `aws:model:instance'.
+	StatsColumnProvider = "provider"
+
+	// StatsColumnModel groups the stats by model name.
+	StatsColumnModel = "model"
+)
+
+// ErrNoMatchingModels is an error, which is returned when no registered model
+// matches the requested stats.
+var ErrNoMatchingModels = errors.New("no matching models")
+
+// ErrInvalidStatsColumn is an error, which is returned when a column cannot be
+// used for computing stats.
+var ErrInvalidStatsColumn = errors.New("invalid stats column")
+
+// StatsOptions specifies the options for computing stats.
+type StatsOptions struct {
+	// Models specifies the names of the models, for which to compute
+	// stats. Shell patterns as supported by [path.Match] may be used. If
+	// empty, then stats for all registered models are computed.
+	Models []string
+
+	// GroupBy specifies the columns by which the results are grouped. The
+	// [StatsColumnProvider] and [StatsColumnModel] pseudo columns may be
+	// used as well. Models, which don't have all of the columns are
+	// skipped.
+	GroupBy []string
+
+	// Sum specifies numeric columns, which are summed up for each group.
+	// Models, which don't have a given column contribute zero to the sum.
+	Sum []string
+}
+
+// StatsRow represents a single group of computed stats.
+type StatsRow struct {
+	// Group contains the values of the group-by columns.
+	Group map[string]string `json:"group" yaml:"group"`
+
+	// Count specifies the number of records in the group.
+	Count int64 `json:"count" yaml:"count"`
+
+	// Sums contains the sums of the requested columns.
+	Sums map[string]float64 `json:"sums,omitempty" yaml:"sums,omitempty"`
+}
+
+// statsResult is the result of the stats query for a single model.
+type statsResult struct {
+	Groups []string  `bun:"groups,array"`
+	Count  int64     `bun:"count"`
+	Sums   []float64 `bun:"sums,array"`
+}
+
+// isNumericKind returns true, if the given kind represents a numeric type.
+func isNumericKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// ComputeStats computes the number of records and the sums of the requested
+// columns for the registered models, grouped by the given columns. Column
+// names are validated against the model tables, so that only known
+// identifiers are used when generating the queries.
+func ComputeStats(ctx context.Context, db *bun.DB, opts StatsOptions) ([]StatsRow, error) {
+	modelNames, err := matchModels(opts.Models)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string]*StatsRow)
+	queried := 0
+	for _, name := range modelNames {
+		query, ok, err := NewStatsQuery(db, name, opts)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		results := make([]statsResult, 0)
+		if err := query.Scan(ctx, &results); err != nil {
+			return nil, fmt.Errorf("cannot compute stats for %s: %w", name, err)
+		}
+		queried++
+
+		for _, result := range results {
+			key := strings.Join(result.Groups, "\x00")
+			row, exists := groups[key]
+			if !exists {
+				row = &StatsRow{
+					Group: make(map[string]string, len(opts.GroupBy)),
+					Sums:  make(map[string]float64, len(opts.Sum)),
+				}
+				for i, column := range opts.GroupBy {
+					row.Group[column] = result.Groups[i]
+				}
+				for _, column := range opts.Sum {
+					row.Sums[column] = 0
+				}
+				groups[key] = row
+			}
+
+			row.Count += result.Count
+			for i, column := range opts.Sum {
+				row.Sums[column] += result.Sums[i]
+			}
+		}
+	}
+
+	if queried == 0 {
+		return nil, ErrNoMatchingModels
+	}
+
+	rows := make([]StatsRow, 0, len(groups))
+	for _, row := range groups {
+		rows = append(rows, *row)
+	}
+
+	slices.SortFunc(rows, func(a, b StatsRow) int {
+		for _, column := range opts.GroupBy {
+			if c := cmp.Compare(a.Group[column], b.Group[column]); c != 0 {
+				return c
+			}
+		}
+
+		return 0
+	})
+
+	return rows, nil
+}
+
+// matchModels returns the sorted names of the registered models, which match
+// any of the given shell patterns. If no patterns are given, then the names of
+// all registered models are returned.
+func matchModels(patterns []string) ([]string, error) {
+	modelNames := make([]string, 0)
+	walker := func(name string, _ any) error {
+		if len(patterns) == 0 {
+			modelNames = append(modelNames, name)
+
+			return nil
+		}
+
+		for _, pattern := range patterns {
+			ok, err := path.Match(pattern, name)
+			if err != nil {
+				return err
+			}
+			if ok {
+				modelNames = append(modelNames, name)
+
+				return nil
+			}
+		}
+
+		return nil
+	}
+
+	if err := registry.ModelRegistry.Range(walker); err != nil {
+		return nil, err
+	}
+
+	if len(modelNames) == 0 {
+		return nil, ErrNoMatchingModels
+	}
+	slices.Sort(modelNames)
+
+	return modelNames, nil
+}
+
+// NewStatsQuery creates the stats query for the registered model with the
+// given name. It returns false, if the model does not have all of the group-by
+// columns.
+func NewStatsQuery(db *bun.DB, name string, opts StatsOptions) (*bun.SelectQuery, bool, error) {
+	model, ok := registry.ModelRegistry.Get(name)
+	if !ok {
+		return nil, false, fmt.Errorf("%w: %s", ErrNoMatchingModels, name)
+	}
+	table := db.Table(reflect.TypeOf(model).Elem())
+
+	groupExprs := make([]string, 0, len(opts.GroupBy))
+	groupArgs := make([]any, 0, len(opts.GroupBy))
+	for _, column := range opts.GroupBy {
+		switch column {
+		case StatsColumnProvider:
+			provider, _, _ := strings.Cut(name, ":")
+			groupExprs = append(groupExprs, "?::text")
+			groupArgs = append(groupArgs, provider)
+		case StatsColumnModel:
+			groupExprs = append(groupExprs, "?::text")
+			groupArgs = append(groupArgs, name)
+		default:
+			if _, ok := table.FieldMap[column]; !ok {
+				return nil, false, nil
+			}
+			groupExprs = append(groupExprs, "COALESCE(?::text, '')")
+			groupArgs = append(groupArgs, bun.Ident(column))
+		}
+	}
+
+	sumExprs := make([]string, 0, len(opts.Sum))
+	sumArgs := make([]any, 0, len(opts.Sum))
+	for _, column := range opts.Sum {
+		field, ok := table.FieldMap[column]
+		if !ok {
+			sumExprs = append(sumExprs, "0")
+			continue
+		}
+
+		if !isNumericKind(field.IndirectType.Kind()) {
+			return nil, false, fmt.Errorf("%w: %s.%s is not numeric", ErrInvalidStatsColumn, name, column)
+		}
+		sumExprs = append(sumExprs, "COALESCE(SUM(?), 0)")
+		sumArgs = append(sumArgs, bun.Ident(column))
+	}
+
+	query := db.NewSelect().
+		TableExpr("?", bun.Ident(table.Name)).
+		ColumnExpr("ARRAY["+strings.Join(groupExprs, ", ")+"]::text[] AS groups", groupArgs...).
+		ColumnExpr("COUNT(*) AS count").
+		ColumnExpr("ARRAY["+strings.Join(sumExprs, ", ")+"]::double precision[] AS sums", sumArgs...)
+
+	if len(groupExprs) > 0 {
+		query = query.GroupExpr("1")
+	}
+
+	return query, true, nil
+}
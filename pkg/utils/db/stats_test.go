@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	dbutils "github.com/gardener/inventory/pkg/utils/db"
+)
+
+type statsItem struct {
+	bun.BaseModel `bun:"table:test_stats_item"`
+
+	Name   string `bun:"name"`
+	Region string `bun:"region"`
+	Size   int    `bun:"size"`
+}
+
+func TestNewStatsQuery(t *testing.T) {
+	registry.ModelRegistry.MustRegister("test:model:stats_item", &statsItem{})
+	t.Cleanup(func() {
+		registry.ModelRegistry.Unregister("test:model:stats_item")
+	})
+
+	// The queries are only rendered, so the database is never connected to.
+	db := bun.NewDB(sql.OpenDB(pgdriver.NewConnector()), pgdialect.New())
+	defer db.Close() // nolint: errcheck
+
+	testCases := []struct {
+		desc      string
+		name      string
+		opts      dbutils.StatsOptions
+		wantQuery string
+		wantOk    bool
+		wantErr   error
+	}{
+		{
+			desc:      "count only",
+			name:      "test:model:stats_item",
+			wantQuery: `SELECT ARRAY[]::text[] AS groups, COUNT(*) AS count, ARRAY[]::double precision[] AS sums FROM "test_stats_item"`,
+			wantOk:    true,
+		},
+		{
+			desc: "group by pseudo and model columns",
+			name: "test:model:stats_item",
+			opts: dbutils.StatsOptions{
+				GroupBy: []string{dbutils.StatsColumnProvider, dbutils.StatsColumnModel, "region"},
+			},
+			wantQuery: `SELECT ARRAY['test'::text, 'test:model:stats_item'::text, COALESCE("region"::text, '')]::text[] AS groups, COUNT(*) AS count, ARRAY[]::double precision[] AS sums FROM "test_stats_item" GROUP BY 1`,
+			wantOk:    true,
+		},
+		{
+			desc: "sum of existing and missing columns",
+			name: "test:model:stats_item",
+			opts: dbutils.StatsOptions{
+				Sum: []string{"size", "cpus"},
+			},
+			wantQuery: `SELECT ARRAY[]::text[] AS groups, COUNT(*) AS count, ARRAY[COALESCE(SUM("size"), 0), 0]::double precision[] AS sums FROM "test_stats_item"`,
+			wantOk:    true,
+		},
+		{
+			desc: "missing group by column",
+			name: "test:model:stats_item",
+			opts: dbutils.StatsOptions{
+				GroupBy: []string{"zone"},
+			},
+			wantOk: false,
+		},
+		{
+			desc: "sum of non-numeric column",
+			name: "test:model:stats_item",
+			opts: dbutils.StatsOptions{
+				Sum: []string{"name"},
+			},
+			wantErr: dbutils.ErrInvalidStatsColumn,
+		},
+		{
+			desc:    "unknown model",
+			name:    "test:model:unknown",
+			wantErr: dbutils.ErrNoMatchingModels,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			query, ok, err := dbutils.NewStatsQuery(db, tc.name, tc.opts)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("got error %v, wanted %v", err, tc.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if ok != tc.wantOk {
+				t.Fatalf("got ok %t, wanted %t", ok, tc.wantOk)
+			}
+
+			if !ok {
+				return
+			}
+
+			if got := query.String(); got != tc.wantQuery {
+				t.Fatalf("got query %s, wanted %s", got, tc.wantQuery)
+			}
+		})
+	}
+}
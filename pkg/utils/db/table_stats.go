@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/uptrace/bun"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+)
+
+// TableStats represents the estimated number of rows and the size of the table
+// of a model.
+type TableStats struct {
+	// Model specifies the name of the model as registered in the
+	// [registry.ModelRegistry].
+	Model string `json:"model" yaml:"model"`
+
+	// Table specifies the name of the table of the model.
+	Table string `json:"table" yaml:"table"`
+
+	// EstimatedRows specifies the estimated number of rows in the table,
+	// as of the last VACUUM or ANALYZE of the table.
+	EstimatedRows int64 `json:"estimated_rows" yaml:"estimated_rows" bun:"estimated_rows"`
+
+	// SizeBytes specifies the total size of the table in bytes, including
+	// indexes and TOAST data.
+	SizeBytes int64 `json:"size_bytes" yaml:"size_bytes" bun:"size_bytes"`
+}
+
+// tableStatsQuery computes the estimated number of rows and the total size of
+// a table. Partitioned tables don't have storage on their own, so the stats of
+// their partitions are summed up. Views, which are not materialized, don't
+// have any storage and are reported with zero rows and size.
+const tableStatsQuery = `WITH RECURSIVE tree AS (
+    SELECT to_regclass(?)::oid AS relid
+    UNION ALL
+    SELECT i.inhrelid FROM pg_inherits AS i INNER JOIN tree AS t ON i.inhparent = t.relid
+)
+SELECT
+    COALESCE(SUM(GREATEST(c.reltuples, 0)), 0)::bigint AS estimated_rows,
+    COALESCE(SUM(pg_total_relation_size(c.oid)), 0)::bigint AS size_bytes
+FROM tree
+INNER JOIN pg_class AS c ON c.oid = tree.relid
+WHERE c.relkind IN ('r', 'm')`
+
+// ComputeTableStats returns the [TableStats] for the tables of the models
+// registered in the [registry.ModelRegistry]. Models, whose tables don't exist
+// in the database are skipped.
+func ComputeTableStats(ctx context.Context, db *bun.DB) ([]TableStats, error) {
+	modelNames := make([]string, 0)
+	walker := func(name string, _ any) error {
+		modelNames = append(modelNames, name)
+
+		return nil
+	}
+
+	if err := registry.ModelRegistry.Range(walker); err != nil {
+		return nil, err
+	}
+	slices.Sort(modelNames)
+
+	items := make([]TableStats, 0, len(modelNames))
+	for _, name := range modelNames {
+		model, _ := registry.ModelRegistry.Get(name)
+		table := db.Table(reflect.TypeOf(model).Elem())
+
+		var exists bool
+		if err := db.NewRaw("SELECT to_regclass(?) IS NOT NULL", table.Name).Scan(ctx, &exists); err != nil {
+			return nil, err
+		}
+		if !exists {
+			continue
+		}
+
+		item := TableStats{
+			Model: name,
+			Table: table.Name,
+		}
+		if err := db.NewRaw(tableStatsQuery, table.Name).Scan(ctx, &item); err != nil {
+			return nil, fmt.Errorf("cannot compute table stats for %s: %w", name, err)
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package printer provides utilities for printing the results of the CLI
+// commands as human-readable tables or in machine-readable formats.
+package printer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+)
+
+// Supported output formats
+const (
+	// FormatTable prints the results as a table.
+	FormatTable = "table"
+
+	// FormatWide prints the results as a table with additional columns.
+	FormatWide = "wide"
+
+	// FormatJSON prints the results as JSON.
+	FormatJSON = "json"
+
+	// FormatYAML prints the results as YAML.
+	FormatYAML = "yaml"
+)
+
+// Formats is the list of supported output formats.
+var Formats = []string{
+	FormatTable,
+	FormatWide,
+	FormatJSON,
+	FormatYAML,
+}
+
+// ErrUnknownFormat is an error, which is returned when an unsupported output
+// format was specified.
+var ErrUnknownFormat = errors.New("unknown output format")
+
+// TableWriter is the interface used for rendering tabular data.
+type TableWriter interface {
+	// Append appends a row to the table.
+	Append(rows ...any) error
+
+	// Render renders the table.
+	Render() error
+}
+
+// ValidateFormat validates the given output format.
+func ValidateFormat(format string) error {
+	if !slices.Contains(Formats, format) {
+		return fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+
+	return nil
+}
+
+// IsStructured returns true, if the given output format is a machine-readable
+// one.
+func IsStructured(format string) bool {
+	return format == FormatJSON || format == FormatYAML
+}
+
+// Print encodes the given value to the [io.Writer] using the given
+// machine-readable output format.
+func Print(w io.Writer, format string, v any) error {
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(v)
+	case FormatYAML:
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+
+		return err
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+}
+
+// NewTable creates a new [tablewriter.Table] with the given [io.Writer] and
+// headers.
+func NewTable(w io.Writer, headers []string) *tablewriter.Table {
+	opts := []tablewriter.Option{
+		tablewriter.WithHeader(headers),
+		tablewriter.WithRendition(
+			tw.Rendition{
+				Borders: tw.Border{
+					Top:    tw.Off,
+					Bottom: tw.Off,
+					Left:   tw.Off,
+					Right:  tw.Off,
+				},
+			},
+		),
+	}
+	table := tablewriter.NewWriter(w).Options(opts...)
+
+	table.Configure(func(cfg *tablewriter.Config) {
+		cfg.Row.Alignment.Global = tw.AlignLeft
+		cfg.Row.Formatting.AutoWrap = tw.WrapNone
+		cfg.Header.Alignment.Global = tw.AlignLeft
+	})
+
+	return table
+}
+
+// New returns a [TableWriter], which renders the rows using the given output
+// format. The rows contain the values of the headers, followed by the values
+// of the wide headers. The values of the wide headers are rendered with the
+// [FormatWide] and the machine-readable output formats only.
+func New(w io.Writer, format string, headers []string, wideHeaders ...string) TableWriter {
+	allHeaders := slices.Concat(headers, wideHeaders)
+	switch {
+	case IsStructured(format):
+		// Headers are normalized, so that they can be used as keys,
+		// e.g. MIGRATED-AT becomes migrated_at.
+		replacer := strings.NewReplacer("-", "_", " ", "_")
+		keys := make([]string, 0, len(allHeaders))
+		for _, header := range allHeaders {
+			keys = append(keys, replacer.Replace(strings.ToLower(header)))
+		}
+
+		return &structuredWriter{
+			w:       w,
+			format:  format,
+			headers: keys,
+			items:   make([]map[string]string, 0),
+		}
+	case format == FormatWide:
+		return NewTable(w, allHeaders)
+	default:
+		return &narrowWriter{
+			table:   NewTable(w, headers),
+			columns: len(headers),
+		}
+	}
+}
+
+// structuredWriter is an implementation of [TableWriter], which renders the
+// rows as a list of objects keyed by the table headers.
+type structuredWriter struct {
+	w       io.Writer
+	format  string
+	headers []string
+	items   []map[string]string
+}
+
+// Append implements the [TableWriter] interface.
+func (t *structuredWriter) Append(rows ...any) error {
+	for _, r := range rows {
+		row, ok := r.([]string)
+		if !ok {
+			return fmt.Errorf("unsupported row type %T", r)
+		}
+
+		item := make(map[string]string, len(t.headers))
+		for i, header := range t.headers {
+			if i < len(row) {
+				item[header] = row[i]
+			}
+		}
+		t.items = append(t.items, item)
+	}
+
+	return nil
+}
+
+// Render implements the [TableWriter] interface.
+func (t *structuredWriter) Render() error {
+	return Print(t.w, t.format, t.items)
+}
+
+// narrowWriter is an implementation of [TableWriter], which omits the values
+// of the wide headers from the rows.
+type narrowWriter struct {
+	table   *tablewriter.Table
+	columns int
+}
+
+// Append implements the [TableWriter] interface.
+func (t *narrowWriter) Append(rows ...any) error {
+	for _, r := range rows {
+		row, ok := r.([]string)
+		if !ok {
+			return fmt.Errorf("unsupported row type %T", r)
+		}
+		if len(row) > t.columns {
+			row = row[:t.columns]
+		}
+		if err := t.table.Append(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Render implements the [TableWriter] interface.
+func (t *narrowWriter) Render() error {
+	return t.table.Render()
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package printer_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/utils/printer"
+)
+
+func TestValidateFormat(t *testing.T) {
+	for _, format := range printer.Formats {
+		if err := printer.ValidateFormat(format); err != nil {
+			t.Fatalf("got error %v for format %q", err, format)
+		}
+	}
+
+	if err := printer.ValidateFormat("xml"); !errors.Is(err, printer.ErrUnknownFormat) {
+		t.Fatalf("got error %v, wanted %v", err, printer.ErrUnknownFormat)
+	}
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		format  string
+		want    []string
+		notWant []string
+	}{
+		{
+			format:  printer.FormatTable,
+			want:    []string{"NAME", "my-queue"},
+			notWant: []string{"LAST ERROR", "boom"},
+		},
+		{
+			format: printer.FormatWide,
+			want:   []string{"NAME", "LAST ERROR", "my-queue", "boom"},
+		},
+		{
+			format: printer.FormatJSON,
+			want:   []string{`"name": "my-queue"`, `"last_error": "boom"`},
+		},
+		{
+			format: printer.FormatYAML,
+			want:   []string{"name: my-queue", "last_error: boom"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			table := printer.New(&buf, tc.format, []string{"NAME"}, "LAST ERROR")
+			if err := table.Append([]string{"my-queue", "boom"}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if err := table.Render(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			out := buf.String()
+			for _, s := range tc.want {
+				if !strings.Contains(out, s) {
+					t.Fatalf("got output %q, wanted it to contain %q", out, s)
+				}
+			}
+			for _, s := range tc.notWant {
+				if strings.Contains(out, s) {
+					t.Fatalf("got output %q, wanted it not to contain %q", out, s)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,433 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package schema provides utilities for describing and validating task
+// payloads using a subset of JSON Schema.
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-yaml"
+)
+
+// ErrInvalidPayload is an error, which is returned when a payload does not
+// conform to its schema.
+var ErrInvalidPayload = errors.New("invalid payload")
+
+// JSON Schema types
+const (
+	TypeString  = "string"
+	TypeInteger = "integer"
+	TypeNumber  = "number"
+	TypeBoolean = "boolean"
+	TypeArray   = "array"
+	TypeObject  = "object"
+)
+
+// Types represents the allowed types of a [Schema]. A single type is encoded
+// as a string, and multiple types are encoded as an array of strings.
+type Types []string
+
+// MarshalJSON implements the [json.Marshaler] interface.
+func (t Types) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+
+	return json.Marshal([]string(t))
+}
+
+// MarshalYAML implements the [yaml.InterfaceMarshaler] interface.
+func (t Types) MarshalYAML() (any, error) {
+	if len(t) == 1 {
+		return t[0], nil
+	}
+
+	return []string(t), nil
+}
+
+// Schema represents a JSON Schema, which describes a task payload.
+type Schema struct {
+	// Type specifies the allowed types of the value.
+	Type Types `json:"type,omitempty" yaml:"type,omitempty"`
+
+	// Format specifies an optional format of a string value.
+	Format string `json:"format,omitempty" yaml:"format,omitempty"`
+
+	// Properties specifies the known properties of an object.
+	Properties map[string]*Schema `json:"properties,omitempty" yaml:"properties,omitempty"`
+
+	// Required specifies the properties, which must be present in an
+	// object.
+	Required []string `json:"required,omitempty" yaml:"required,omitempty"`
+
+	// AdditionalProperties specifies the schema of object values for
+	// maps. It is nil for objects, which do not accept properties other
+	// than the ones in Properties.
+	AdditionalProperties *Schema `json:"additionalProperties,omitempty" yaml:"additionalProperties,omitempty"`
+
+	// Items specifies the schema of array items.
+	Items *Schema `json:"items,omitempty" yaml:"items,omitempty"`
+
+	// Description provides a short description of the value.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// Examples provides sample values.
+	Examples []any `json:"examples,omitempty" yaml:"examples,omitempty"`
+}
+
+// For returns the [Schema] for the payload type T.
+//
+// The description and sample value of struct fields are read from the `desc'
+// and `example' struct tags respectively. Sample values of arrays are
+// specified as comma-separated items.
+func For[T any]() *Schema {
+	return fromType(reflect.TypeFor[T]())
+}
+
+// ForType returns the [Schema] for the given [reflect.Type], in the same way
+// as [For] does.
+func ForType(t reflect.Type) *Schema {
+	return fromType(t)
+}
+
+// fromType returns the [Schema] for the given [reflect.Type].
+func fromType(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case reflect.TypeFor[time.Time]():
+		return &Schema{Type: Types{TypeString}, Format: "date-time"}
+	case reflect.TypeFor[time.Duration]():
+		// Durations are decoded from integers (nanoseconds) in JSON,
+		// and from duration strings (e.g. 1h30m) in YAML.
+		return &Schema{Type: Types{TypeString, TypeInteger}, Format: "duration"}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: Types{TypeString}}
+	case reflect.Bool:
+		return &Schema{Type: Types{TypeBoolean}}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: Types{TypeInteger}}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: Types{TypeNumber}}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: Types{TypeString}, Format: "byte"}
+		}
+
+		return &Schema{Type: Types{TypeArray}, Items: fromType(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: Types{TypeObject}, AdditionalProperties: fromType(t.Elem())}
+	case reflect.Struct:
+		s := &Schema{Type: Types{TypeObject}, Properties: make(map[string]*Schema)}
+		addStructFields(s, t)
+
+		return s
+	default:
+		// Accept any value
+		return &Schema{}
+	}
+}
+
+// addStructFields adds the exported fields of the given struct type as
+// properties to the [Schema]. Fields of embedded structs are promoted, the same
+// way as they are by [encoding/json].
+func addStructFields(s *Schema, t reflect.Type) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			addStructFields(s, fieldType)
+
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		prop := fromType(field.Type)
+		prop.Description = field.Tag.Get("desc")
+		if example, ok := field.Tag.Lookup("example"); ok {
+			prop.Examples = []any{prop.parseExample(example)}
+		}
+		s.Properties[name] = prop
+	}
+}
+
+// parseExample parses the given sample value according to the [Schema] type.
+// The value is returned as-is, if it cannot be parsed.
+func (s *Schema) parseExample(value string) any {
+	if len(s.Type) != 1 {
+		return value
+	}
+
+	switch s.Type[0] {
+	case TypeInteger:
+		if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return v
+		}
+	case TypeNumber:
+		if v, err := strconv.ParseFloat(value, 64); err == nil {
+			return v
+		}
+	case TypeBoolean:
+		if v, err := strconv.ParseBool(value); err == nil {
+			return v
+		}
+	case TypeArray:
+		items := make([]any, 0)
+		for item := range strings.SplitSeq(value, ",") {
+			if s.Items != nil {
+				items = append(items, s.Items.parseExample(item))
+			} else {
+				items = append(items, item)
+			}
+		}
+
+		return items
+	}
+
+	return value
+}
+
+// Example returns a sample value, which conforms to the [Schema]. The sample
+// value is composed of the examples of the schema, and the zero values of the
+// respective types, if no examples are available.
+func (s *Schema) Example() any {
+	if len(s.Examples) > 0 {
+		return s.Examples[0]
+	}
+
+	if len(s.Type) == 0 {
+		return nil
+	}
+
+	switch s.Type[0] {
+	case TypeString:
+		return ""
+	case TypeInteger, TypeNumber:
+		return 0
+	case TypeBoolean:
+		return false
+	case TypeArray:
+		if s.Items == nil {
+			return []any{}
+		}
+
+		return []any{s.Items.Example()}
+	case TypeObject:
+		result := make(map[string]any, len(s.Properties))
+		for name, prop := range s.Properties {
+			result[name] = prop.Example()
+		}
+
+		return result
+	default:
+		return nil
+	}
+}
+
+// ValidatePayload decodes the given payload data and validates it against the
+// [Schema]. The payload is decoded the same way as task handlers do, i.e. as
+// JSON first, and as YAML otherwise.
+func (s *Schema) ValidatePayload(data []byte) error {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		if err := yaml.Unmarshal(data, &value); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidPayload, err)
+		}
+	}
+
+	return s.Validate(value)
+}
+
+// Validate validates the given decoded value against the [Schema].
+func (s *Schema) Validate(value any) error {
+	return s.validate("$", value)
+}
+
+// validate validates the value at the given path against the [Schema].
+func (s *Schema) validate(path string, value any) error {
+	// Null values are decoded as zero values
+	if value == nil || len(s.Type) == 0 {
+		return nil
+	}
+
+	got := typeOf(value)
+	if !s.accepts(got) {
+		return fmt.Errorf("%w: %s: expected %s, got %s", ErrInvalidPayload, path, strings.Join(s.Type, " or "), got)
+	}
+
+	switch v := value.(type) {
+	case []any:
+		if s.Items == nil {
+			return nil
+		}
+		for i, item := range v {
+			if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		// Iterate in a stable order to report consistent errors
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range s.Required {
+			if _, ok := v[k]; !ok {
+				return fmt.Errorf("%w: %s: missing property %q", ErrInvalidPayload, path, k)
+			}
+		}
+
+		for _, k := range keys {
+			prop := s.property(k)
+			if prop == nil {
+				return fmt.Errorf("%w: %s: unknown property %q", ErrInvalidPayload, path, k)
+			}
+			if err := prop.validate(path+"."+k, v[k]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// accepts returns true, if the [Schema] accepts values of the given type.
+func (s *Schema) accepts(got string) bool {
+	for _, t := range s.Type {
+		switch {
+		case t == got:
+			return true
+		case t == TypeNumber && got == TypeInteger:
+			return true
+		}
+	}
+
+	return false
+}
+
+// property returns the [Schema] of the object property with the given name.
+// Similar to [encoding/json], property names are matched case-insensitively,
+// if there is no exact match.
+func (s *Schema) property(name string) *Schema {
+	if s.AdditionalProperties != nil {
+		return s.AdditionalProperties
+	}
+
+	if prop, ok := s.Properties[name]; ok {
+		return prop
+	}
+
+	for k, prop := range s.Properties {
+		if strings.EqualFold(k, name) {
+			return prop
+		}
+	}
+
+	return nil
+}
+
+// typeOf returns the JSON Schema type of the given decoded value.
+func typeOf(value any) string {
+	switch v := value.(type) {
+	case string:
+		return TypeString
+	case bool:
+		return TypeBoolean
+	case float64:
+		if v == math.Trunc(v) && !math.IsInf(v, 0) {
+			return TypeInteger
+		}
+
+		return TypeNumber
+	case float32:
+		return TypeNumber
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return TypeInteger
+	case []any:
+		return TypeArray
+	case map[string]any:
+		return TypeObject
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// Field describes a property of an object [Schema].
+type Field struct {
+	// Path specifies the path to the property. Properties of nested objects
+	// are separated by dots, and items of arrays are denoted by `[]'.
+	Path string `json:"path" yaml:"path"`
+
+	// Type specifies the allowed types of the property.
+	Type string `json:"type" yaml:"type"`
+
+	// Description provides a short description of the property.
+	Description string `json:"description" yaml:"description"`
+}
+
+// Fields returns the properties of the [Schema], including the properties of
+// nested objects, sorted by their path.
+func (s *Schema) Fields() []Field {
+	fields := make([]Field, 0)
+	s.addFields("", &fields)
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Path < fields[j].Path
+	})
+
+	return fields
+}
+
+// addFields appends the properties of the [Schema] to the given fields, using
+// the given prefix for their paths.
+func (s *Schema) addFields(prefix string, fields *[]Field) {
+	for name, prop := range s.Properties {
+		path := prefix + name
+		*fields = append(*fields, Field{
+			Path:        path,
+			Type:        strings.Join(prop.Type, "|"),
+			Description: prop.Description,
+		})
+
+		switch {
+		case prop.Properties != nil:
+			prop.addFields(path+".", fields)
+		case prop.Items != nil && prop.Items.Properties != nil:
+			prop.Items.addFields(path+"[].", fields)
+		}
+	}
+}
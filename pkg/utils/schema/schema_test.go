@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package schema_test
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gardener/inventory/pkg/utils/schema"
+)
+
+type scope struct {
+	Project string
+	Domain  string
+}
+
+type testPayload struct {
+	Name     string            `json:"name"`
+	Count    int               `json:"count"`
+	Enabled  bool              `json:"enabled"`
+	Window   time.Duration     `json:"window"`
+	Tags     []string          `json:"tags"`
+	Windows  map[string]string `json:"windows"`
+	Scope    scope             `json:"scope"`
+	Ignored  string            `json:"-"`
+	internal string
+}
+
+func TestForPayload(t *testing.T) {
+	s := schema.For[testPayload]()
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("cannot marshal schema: %s", err)
+	}
+
+	wanted := `{"type":"object","properties":{"count":{"type":"integer"},"enabled":{"type":"boolean"},"name":{"type":"string"},"scope":{"type":"object","properties":{"Domain":{"type":"string"},"Project":{"type":"string"}}},"tags":{"type":"array","items":{"type":"string"}},"window":{"type":["string","integer"],"format":"duration"},"windows":{"type":"object","additionalProperties":{"type":"string"}}}}`
+	if string(data) != wanted {
+		t.Fatalf("want %s got %s", wanted, string(data))
+	}
+}
+
+func TestValidatePayload(t *testing.T) {
+	s := schema.For[testPayload]()
+	testCases := []struct {
+		desc    string
+		payload string
+		wantErr bool
+	}{
+		{
+			desc:    "valid json",
+			payload: `{"name": "foo", "count": 1, "enabled": true, "tags": ["a", "b"]}`,
+			wantErr: false,
+		},
+		{
+			desc:    "valid yaml",
+			payload: "name: foo\ncount: 1\nwindow: 1h\nwindows:\n  foo: bar\n",
+			wantErr: false,
+		},
+		{
+			desc:    "json duration as integer",
+			payload: `{"window": 3600000000000}`,
+			wantErr: false,
+		},
+		{
+			desc:    "case-insensitive property",
+			payload: `{"scope": {"project": "foo"}}`,
+			wantErr: false,
+		},
+		{
+			desc:    "null value",
+			payload: `{"name": null}`,
+			wantErr: false,
+		},
+		{
+			desc:    "unknown property",
+			payload: `{"nme": "foo"}`,
+			wantErr: true,
+		},
+		{
+			desc:    "unknown nested property",
+			payload: `{"scope": {"region": "foo"}}`,
+			wantErr: true,
+		},
+		{
+			desc:    "wrong type",
+			payload: `{"name": 1}`,
+			wantErr: true,
+		},
+		{
+			desc:    "fractional integer",
+			payload: `{"count": 1.5}`,
+			wantErr: true,
+		},
+		{
+			desc:    "wrong item type",
+			payload: `{"tags": ["a", 1]}`,
+			wantErr: true,
+		},
+		{
+			desc:    "not an object",
+			payload: `["foo"]`,
+			wantErr: true,
+		},
+		{
+			desc:    "malformed payload",
+			payload: `{"name": [`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := s.ValidatePayload([]byte(tc.payload))
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("want error %t got %v", tc.wantErr, err)
+			}
+			if err != nil && !errors.Is(err, schema.ErrInvalidPayload) {
+				t.Fatalf("want ErrInvalidPayload got %v", err)
+			}
+		})
+	}
+}
+
+type describedPayload struct {
+	Region  string        `json:"region" desc:"The region" example:"eu-west-1"`
+	Count   int           `json:"count" desc:"The count" example:"3"`
+	Window  time.Duration `json:"window" example:"1h"`
+	Owners  []string      `json:"owners" example:"foo,bar"`
+	Enabled bool          `json:"enabled"`
+	Scope   scope         `json:"scope" desc:"The scope"`
+}
+
+func TestExample(t *testing.T) {
+	s := schema.For[describedPayload]()
+	data, err := json.Marshal(s.Example())
+	if err != nil {
+		t.Fatalf("cannot marshal example: %s", err)
+	}
+
+	wanted := `{"count":3,"enabled":false,"owners":["foo","bar"],"region":"eu-west-1","scope":{"Domain":"","Project":""},"window":"1h"}`
+	if string(data) != wanted {
+		t.Fatalf("want %s got %s", wanted, string(data))
+	}
+
+	if err := s.ValidatePayload(data); err != nil {
+		t.Fatalf("example does not conform to schema: %s", err)
+	}
+}
+
+func TestFields(t *testing.T) {
+	s := schema.For[describedPayload]()
+	wanted := []schema.Field{
+		{Path: "count", Type: "integer", Description: "The count"},
+		{Path: "enabled", Type: "boolean"},
+		{Path: "owners", Type: "array"},
+		{Path: "region", Type: "string", Description: "The region"},
+		{Path: "scope", Type: "object", Description: "The scope"},
+		{Path: "scope.Domain", Type: "string"},
+		{Path: "scope.Project", Type: "string"},
+		{Path: "window", Type: "string|integer"},
+	}
+
+	got := s.Fields()
+	if !slices.Equal(got, wanted) {
+		t.Fatalf("want %v got %v", wanted, got)
+	}
+}
+
+func TestValidateRequired(t *testing.T) {
+	s := schema.For[testPayload]()
+	s.Required = []string{"name"}
+
+	if err := s.ValidatePayload([]byte(`{"name": "foo"}`)); err != nil {
+		t.Fatalf("want no error got %v", err)
+	}
+	if err := s.ValidatePayload([]byte(`{"count": 1}`)); !errors.Is(err, schema.ErrInvalidPayload) {
+		t.Fatalf("want ErrInvalidPayload got %v", err)
+	}
+}
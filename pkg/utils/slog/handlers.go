@@ -0,0 +1,217 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package slog
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// LevelHandler is a [slog.Handler], which overrides the minimum level of the
+// wrapped handler.
+type LevelHandler struct {
+	level   slog.Leveler
+	handler slog.Handler
+}
+
+var _ slog.Handler = &LevelHandler{}
+
+// NewLevelHandler returns a new [LevelHandler], which wraps the given
+// [slog.Handler] and enables log events at the given level or above.
+func NewLevelHandler(level slog.Leveler, handler slog.Handler) *LevelHandler {
+	// Avoid chains of level handlers
+	if lh, ok := handler.(*LevelHandler); ok {
+		handler = lh.handler
+	}
+
+	return &LevelHandler{
+		level:   level,
+		handler: handler,
+	}
+}
+
+// Enabled implements the [slog.Handler] interface.
+func (h *LevelHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level.Level()
+}
+
+// Handle implements the [slog.Handler] interface.
+func (h *LevelHandler) Handle(ctx context.Context, r slog.Record) error {
+	return h.handler.Handle(ctx, r)
+}
+
+// WithAttrs implements the [slog.Handler] interface.
+func (h *LevelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return NewLevelHandler(h.level, h.handler.WithAttrs(attrs))
+}
+
+// WithGroup implements the [slog.Handler] interface.
+func (h *LevelHandler) WithGroup(name string) slog.Handler {
+	return NewLevelHandler(h.level, h.handler.WithGroup(name))
+}
+
+// samplerState keeps track of the number of log events seen for each message.
+// It is shared between a [SamplingHandler] and the handlers derived from it.
+type samplerState struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+// SamplingHandler is a [slog.Handler], which samples log events with the same
+// message. The first initial events are logged, and after that only every
+// thereafter-th event is logged. Events at [slog.LevelWarn] or above are never
+// sampled.
+type SamplingHandler struct {
+	initial    int
+	thereafter int
+	state      *samplerState
+	handler    slog.Handler
+}
+
+var _ slog.Handler = &SamplingHandler{}
+
+// NewSamplingHandler returns a new [SamplingHandler], which wraps the given
+// [slog.Handler].
+func NewSamplingHandler(initial, thereafter int, handler slog.Handler) *SamplingHandler {
+	return &SamplingHandler{
+		initial:    initial,
+		thereafter: thereafter,
+		state: &samplerState{
+			counts: make(map[string]int),
+		},
+		handler: handler,
+	}
+}
+
+// Enabled implements the [slog.Handler] interface.
+func (h *SamplingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+// Handle implements the [slog.Handler] interface.
+func (h *SamplingHandler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level >= slog.LevelWarn || h.sample(r.Message) {
+		return h.handler.Handle(ctx, r)
+	}
+
+	return nil
+}
+
+// sample returns true, if an event with the given message should be logged.
+func (h *SamplingHandler) sample(msg string) bool {
+	h.state.mu.Lock()
+	defer h.state.mu.Unlock()
+
+	h.state.counts[msg]++
+	n := h.state.counts[msg]
+	if n <= h.initial {
+		return true
+	}
+
+	return h.thereafter > 0 && (n-h.initial)%h.thereafter == 0
+}
+
+// WithAttrs implements the [slog.Handler] interface.
+func (h *SamplingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &SamplingHandler{
+		initial:    h.initial,
+		thereafter: h.thereafter,
+		state:      h.state,
+		handler:    h.handler.WithAttrs(attrs),
+	}
+}
+
+// WithGroup implements the [slog.Handler] interface.
+func (h *SamplingHandler) WithGroup(name string) slog.Handler {
+	return &SamplingHandler{
+		initial:    h.initial,
+		thereafter: h.thereafter,
+		state:      h.state,
+		handler:    h.handler.WithGroup(name),
+	}
+}
+
+// RateLimiter keeps track of the number of log events seen in the current
+// window of one second. A single [RateLimiter] may be shared between multiple
+// [RateLimitHandler] items, which limits their combined rate of log events.
+type RateLimiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Time
+	count  int
+}
+
+// NewRateLimiter returns a new [RateLimiter], which allows at most limit events
+// per second.
+func NewRateLimiter(limit int) *RateLimiter {
+	return &RateLimiter{
+		limit: limit,
+	}
+}
+
+// Allow returns true, if an event at the given time should be logged.
+func (l *RateLimiter) Allow(now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.window) >= time.Second {
+		l.window = now
+		l.count = 0
+	}
+	l.count++
+
+	return l.count <= l.limit
+}
+
+// RateLimitHandler is a [slog.Handler], which logs the events allowed by a
+// [RateLimiter]. Events at [slog.LevelWarn] or above are never dropped.
+type RateLimitHandler struct {
+	limiter *RateLimiter
+	handler slog.Handler
+}
+
+var _ slog.Handler = &RateLimitHandler{}
+
+// NewRateLimitHandler returns a new [RateLimitHandler], which wraps the given
+// [slog.Handler] and logs at most limit events per second.
+func NewRateLimitHandler(limit int, handler slog.Handler) *RateLimitHandler {
+	return NewSharedRateLimitHandler(NewRateLimiter(limit), handler)
+}
+
+// NewSharedRateLimitHandler returns a new [RateLimitHandler], which wraps the
+// given [slog.Handler] and uses the given [RateLimiter], which may be shared
+// with other handlers.
+func NewSharedRateLimitHandler(limiter *RateLimiter, handler slog.Handler) *RateLimitHandler {
+	return &RateLimitHandler{
+		limiter: limiter,
+		handler: handler,
+	}
+}
+
+// Enabled implements the [slog.Handler] interface.
+func (h *RateLimitHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+// Handle implements the [slog.Handler] interface.
+func (h *RateLimitHandler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level >= slog.LevelWarn || h.limiter.Allow(time.Now()) {
+		return h.handler.Handle(ctx, r)
+	}
+
+	return nil
+}
+
+// WithAttrs implements the [slog.Handler] interface.
+func (h *RateLimitHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return NewSharedRateLimitHandler(h.limiter, h.handler.WithAttrs(attrs))
+}
+
+// WithGroup implements the [slog.Handler] interface.
+func (h *RateLimitHandler) WithGroup(name string) slog.Handler {
+	return NewSharedRateLimitHandler(h.limiter, h.handler.WithGroup(name))
+}
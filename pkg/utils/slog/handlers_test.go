@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package slog_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/gardener/inventory/pkg/core/config"
+	slogutils "github.com/gardener/inventory/pkg/utils/slog"
+)
+
+func TestGetLoggerConfig(t *testing.T) {
+	conf := config.LoggingConfig{
+		Sampling: config.LogSamplingConfig{IsEnabled: true, Initial: 10, Thereafter: 100},
+		Loggers: map[string]config.LoggerConfig{
+			"openstack:task:collect-servers": {
+				Level: "debug",
+			},
+			"openstack:task:collect-servers*": {
+				Level: "error",
+			},
+			"openstack:task:*": {
+				Level:     "warn",
+				RateLimit: &config.LogRateLimitConfig{IsEnabled: true, EventsPerSecond: 5},
+			},
+			"openstack:task:collect-ports": {
+				Level:    "error",
+				Sampling: &config.LogSamplingConfig{IsEnabled: true, Initial: 1, Thereafter: 1000},
+			},
+		},
+	}
+
+	testCases := []struct {
+		desc          string
+		taskName      string
+		wantLevel     string
+		wantInitial   int
+		wantRateLimit bool
+	}{
+		{
+			desc:        "no matching pattern",
+			taskName:    "aws:task:collect-instances",
+			wantLevel:   "",
+			wantInitial: 10,
+		},
+		{
+			desc:          "wildcard pattern",
+			taskName:      "openstack:task:collect-networks",
+			wantLevel:     "warn",
+			wantInitial:   10,
+			wantRateLimit: true,
+		},
+		{
+			desc:        "longest pattern wins",
+			taskName:    "openstack:task:collect-ports",
+			wantLevel:   "error",
+			wantInitial: 1,
+		},
+		{
+			desc:        "exact task name takes precedence",
+			taskName:    "openstack:task:collect-servers",
+			wantLevel:   "debug",
+			wantInitial: 10,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := slogutils.GetLoggerConfig(conf, tc.taskName)
+			if got.Level != tc.wantLevel {
+				t.Fatalf("got level %q wanted %q", got.Level, tc.wantLevel)
+			}
+			if got.Sampling.Initial != tc.wantInitial {
+				t.Fatalf("got initial %d wanted %d", got.Sampling.Initial, tc.wantInitial)
+			}
+			if got.RateLimit.IsEnabled != tc.wantRateLimit {
+				t.Fatalf("got rate limit %t wanted %t", got.RateLimit.IsEnabled, tc.wantRateLimit)
+			}
+		})
+	}
+}
+
+func TestRateLimitHandler(t *testing.T) {
+	var buf bytes.Buffer
+	handler := slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+	logger := slog.New(slogutils.NewRateLimitHandler(3, handler)).With("key", "value")
+
+	for range 10 {
+		logger.Info("fetched page")
+	}
+	logger.Error("failed to fetch page")
+
+	got := buf.String()
+	if n := strings.Count(got, "fetched page"); n != 3 {
+		t.Fatalf("got %d info events wanted 3", n)
+	}
+	if !strings.Contains(got, "failed to fetch page") {
+		t.Fatal("error event was dropped")
+	}
+}
+
+func TestTaskHandlersSharedRateLimit(t *testing.T) {
+	conf := config.LoggingConfig{
+		Loggers: map[string]config.LoggerConfig{
+			"openstack:task:*": {
+				RateLimit: &config.LogRateLimitConfig{IsEnabled: true, EventsPerSecond: 3},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	handler := slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+	taskHandlers := slogutils.NewTaskHandlers(conf)
+
+	// The executions of the tasks matching the same rule share the rate
+	// limit.
+	for _, taskName := range []string{"openstack:task:collect-ports", "openstack:task:collect-servers"} {
+		logger := slog.New(taskHandlers.Wrap(handler, taskName))
+		for range 2 {
+			logger.Info("fetched page")
+		}
+	}
+
+	// Tasks, which do not match any rule, are not rate limited
+	logger := slog.New(taskHandlers.Wrap(handler, "aws:task:collect-instances"))
+	for range 5 {
+		logger.Info("fetched instances")
+	}
+
+	got := buf.String()
+	if n := strings.Count(got, "fetched page"); n != 3 {
+		t.Fatalf("got %d page events wanted 3", n)
+	}
+	if n := strings.Count(got, "fetched instances"); n != 5 {
+		t.Fatalf("got %d instance events wanted 5", n)
+	}
+}
+
+func TestLevelHandlerOverridesConfiguredLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := slogutils.NewFromConfig(&buf, config.LoggingConfig{Level: "info"})
+	if err != nil {
+		t.Fatalf("unable to create logger: %s", err)
+	}
+
+	logger.Debug("suppressed event")
+	debugLogger := slog.New(slogutils.NewLevelHandler(slog.LevelDebug, logger.Handler()))
+	debugLogger.Debug("debug event")
+
+	got := buf.String()
+	if strings.Contains(got, "suppressed event") {
+		t.Fatal("debug event was not suppressed at info level")
+	}
+	if !strings.Contains(got, "debug event") {
+		t.Fatal("debug event was dropped after overriding the level")
+	}
+}
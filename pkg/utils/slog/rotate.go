@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package slog
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNoLogFilePath is an error, which is returned when logging to a file is
+// enabled, but no path has been configured.
+var ErrNoLogFilePath = errors.New("no log file path specified")
+
+// backupTimeFormat is the time format used for naming rotated log files.
+const backupTimeFormat = "20060102T150405.000"
+
+// RotatingFile is an [io.WriteCloser], which writes to a file and rotates it
+// once it reaches a given size. Rotated files are named after the original
+// file with the rotation timestamp appended to it.
+type RotatingFile struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxAge     time.Duration
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+var _ io.WriteCloser = &RotatingFile{}
+
+// NewRotatingFile creates a new [RotatingFile] for the given path. A maxSize
+// of zero disables rotation, while a zero maxAge or maxBackups retains all
+// rotated files.
+func NewRotatingFile(path string, maxSize int64, maxAge time.Duration, maxBackups int) (*RotatingFile, error) {
+	if path == "" {
+		return nil, ErrNoLogFilePath
+	}
+
+	rf := &RotatingFile{
+		path:       filepath.Clean(path),
+		maxSize:    maxSize,
+		maxAge:     maxAge,
+		maxBackups: maxBackups,
+	}
+
+	if err := rf.open(); err != nil {
+		return nil, err
+	}
+
+	return rf, nil
+}
+
+// open opens the log file for appending.
+func (rf *RotatingFile) open() error {
+	if err := os.MkdirAll(filepath.Dir(rf.path), 0o750); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(rf.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	rf.file = file
+	rf.size = info.Size()
+
+	return nil
+}
+
+// Write implements the [io.Writer] interface.
+func (rf *RotatingFile) Write(p []byte) (int, error) {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+
+	if rf.maxSize > 0 && rf.size > 0 && rf.size+int64(len(p)) > rf.maxSize {
+		// A failed rotation is retried with the next write, as long
+		// as the log file could be reopened.
+		if err := rf.rotate(); err != nil && rf.file == nil {
+			return 0, fmt.Errorf("cannot rotate log file: %w", err)
+		}
+	}
+
+	if rf.file == nil {
+		if err := rf.open(); err != nil {
+			return 0, fmt.Errorf("cannot open log file: %w", err)
+		}
+	}
+
+	n, err := rf.file.Write(p)
+	rf.size += int64(n)
+
+	return n, err
+}
+
+// Close implements the [io.Closer] interface.
+func (rf *RotatingFile) Close() error {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+
+	if rf.file == nil {
+		return nil
+	}
+
+	err := rf.file.Close()
+	rf.file = nil
+
+	return err
+}
+
+// rotate renames the current log file, opens a new one and cleans up stale
+// rotated files. The log file at the original path is reopened, even if it
+// could not be renamed.
+func (rf *RotatingFile) rotate() error {
+	err := rf.file.Close()
+	rf.file = nil
+	if err == nil {
+		backup := fmt.Sprintf("%s.%s", rf.path, time.Now().UTC().Format(backupTimeFormat))
+		err = os.Rename(rf.path, backup)
+	}
+
+	if openErr := rf.open(); openErr != nil {
+		return errors.Join(err, openErr)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return rf.cleanup()
+}
+
+// cleanup removes rotated files, which exceed the configured max age or max
+// number of backups.
+func (rf *RotatingFile) cleanup() error {
+	if rf.maxAge == 0 && rf.maxBackups == 0 {
+		return nil
+	}
+
+	backups, err := filepath.Glob(rf.path + ".*")
+	if err != nil {
+		return err
+	}
+
+	// Sort backups from newest to oldest, which works since the
+	// timestamp format sorts lexicographically.
+	slices.Sort(backups)
+	slices.Reverse(backups)
+
+	cutoff := time.Now().UTC().Add(-rf.maxAge)
+	count := 0
+	for _, backup := range backups {
+		suffix := strings.TrimPrefix(backup, rf.path+".")
+		ts, err := time.Parse(backupTimeFormat, suffix)
+		if err != nil {
+			// Not one of our rotated files
+			continue
+		}
+
+		count++
+		tooMany := rf.maxBackups > 0 && count > rf.maxBackups
+		tooOld := rf.maxAge > 0 && ts.Before(cutoff)
+		if tooMany || tooOld {
+			if err := os.Remove(backup); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package slog_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	slogutils "github.com/gardener/inventory/pkg/utils/slog"
+)
+
+// backupName returns the name of a rotated log file for the given time.
+func backupName(path string, ts time.Time) string {
+	return path + "." + ts.UTC().Format("20060102T150405.000")
+}
+
+// listBackups returns the sorted names of the rotated files of the given path.
+func listBackups(t *testing.T, path string) []string {
+	t.Helper()
+	backups, err := filepath.Glob(path + ".*")
+	if err != nil {
+		t.Fatalf("unable to list backups: %s", err)
+	}
+	slices.Sort(backups)
+
+	return backups
+}
+
+func TestRotatingFileSizeRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "inventory.log")
+	rf, err := slogutils.NewRotatingFile(path, 10, 0, 0)
+	if err != nil {
+		t.Fatalf("unable to create rotating file: %s", err)
+	}
+	defer rf.Close() // nolint: errcheck
+
+	for _, line := range []string{"first\n", "second\n"} {
+		if _, err := rf.Write([]byte(line)); err != nil {
+			t.Fatalf("unable to write: %s", err)
+		}
+	}
+
+	backups := listBackups(t, path)
+	if len(backups) != 1 {
+		t.Fatalf("got %d backups, wanted 1", len(backups))
+	}
+
+	data, err := os.ReadFile(backups[0])
+	if err != nil {
+		t.Fatalf("unable to read backup: %s", err)
+	}
+	if string(data) != "first\n" {
+		t.Fatalf("got backup %q, wanted %q", data, "first\n")
+	}
+
+	data, err = os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to read log file: %s", err)
+	}
+	if string(data) != "second\n" {
+		t.Fatalf("got log file %q, wanted %q", data, "second\n")
+	}
+}
+
+func TestRotatingFileNoRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "inventory.log")
+	rf, err := slogutils.NewRotatingFile(path, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("unable to create rotating file: %s", err)
+	}
+	defer rf.Close() // nolint: errcheck
+
+	for range 10 {
+		if _, err := rf.Write([]byte("some log line\n")); err != nil {
+			t.Fatalf("unable to write: %s", err)
+		}
+	}
+
+	if backups := listBackups(t, path); len(backups) != 0 {
+		t.Fatalf("got backups %v, wanted none", backups)
+	}
+}
+
+func TestRotatingFileCleanup(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		desc       string
+		maxAge     time.Duration
+		maxBackups int
+		wanted     []time.Duration
+	}{
+		{
+			desc:       "max backups",
+			maxBackups: 2,
+			wanted:     []time.Duration{-2 * time.Hour},
+		},
+		{
+			desc:   "max age",
+			maxAge: 36 * time.Hour,
+			wanted: []time.Duration{-24 * time.Hour, -2 * time.Hour},
+		},
+		{
+			desc:       "max age and max backups",
+			maxAge:     time.Hour,
+			maxBackups: 3,
+			wanted:     []time.Duration{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "inventory.log")
+
+			// Existing rotated files, along with a file which
+			// is not a rotated file.
+			ages := []time.Duration{-72 * time.Hour, -24 * time.Hour, -2 * time.Hour}
+			for _, age := range ages {
+				if err := os.WriteFile(backupName(path, now.Add(age)), nil, 0o640); err != nil {
+					t.Fatalf("unable to create backup: %s", err)
+				}
+			}
+			other := path + ".old"
+			if err := os.WriteFile(other, nil, 0o640); err != nil {
+				t.Fatalf("unable to create file: %s", err)
+			}
+
+			rf, err := slogutils.NewRotatingFile(path, 10, tc.maxAge, tc.maxBackups)
+			if err != nil {
+				t.Fatalf("unable to create rotating file: %s", err)
+			}
+			defer rf.Close() // nolint: errcheck
+
+			for _, line := range []string{"first\n", "second\n"} {
+				if _, err := rf.Write([]byte(line)); err != nil {
+					t.Fatalf("unable to write: %s", err)
+				}
+			}
+
+			// The rotated file of the current write is always
+			// retained, along with the file which is not a
+			// rotated file.
+			got := make([]string, 0)
+			for _, backup := range listBackups(t, path) {
+				if backup == other {
+					continue
+				}
+				got = append(got, backup)
+			}
+
+			wanted := make([]string, 0)
+			for _, age := range tc.wanted {
+				wanted = append(wanted, backupName(path, now.Add(age)))
+			}
+
+			if len(got) != len(wanted)+1 {
+				t.Fatalf("got backups %v, wanted %v and the current one", got, wanted)
+			}
+			if !slices.Equal(got[:len(wanted)], wanted) {
+				t.Fatalf("got backups %v, wanted %v and the current one", got, wanted)
+			}
+
+			if _, err := os.Stat(other); err != nil {
+				t.Fatalf("file which is not a rotated file was removed: %s", err)
+			}
+		})
+	}
+}
+
+func TestRotatingFileRotationFailure(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "logs")
+	path := filepath.Join(dir, "inventory.log")
+	rf, err := slogutils.NewRotatingFile(path, 10, 0, 0)
+	if err != nil {
+		t.Fatalf("unable to create rotating file: %s", err)
+	}
+	defer rf.Close() // nolint: errcheck
+
+	if _, err := rf.Write([]byte("first\n")); err != nil {
+		t.Fatalf("unable to write: %s", err)
+	}
+
+	// Removing the log file makes renaming it fail, after which the
+	// log file is reopened at the original path.
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatalf("unable to remove log dir: %s", err)
+	}
+
+	for _, line := range []string{"second\n", "third\n"} {
+		if _, err := rf.Write([]byte(line)); err != nil {
+			t.Fatalf("unable to write after failed rotation: %s", err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to read log file: %s", err)
+	}
+	if !strings.HasSuffix(string(data), "third\n") {
+		t.Fatalf("got log file %q, wanted it to end with %q", data, "third\n")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"path"
 
 	"github.com/gardener/inventory/pkg/core/config"
 )
@@ -21,6 +22,10 @@ var ErrInvalidLogLevel = errors.New("invalid log level")
 // has been configured.
 var ErrInvalidLogFormat = errors.New("invalid log format")
 
+// ErrInvalidLoggerPattern is an error, which is returned when an invalid
+// logger pattern is configured.
+var ErrInvalidLoggerPattern = errors.New("invalid logger pattern")
+
 // LogLevel represents the log level.
 type LogLevel string
 
@@ -45,9 +50,50 @@ var (
 	FormatJSON LogFormat = "json"
 )
 
+// ParseLevel returns the [slog.Level] for the given [LogLevel].
+func ParseLevel(logLevel LogLevel) (slog.Level, error) {
+	// Supported log levels
+	levels := map[LogLevel]slog.Level{
+		LevelInfo:  slog.LevelInfo,
+		LevelWarn:  slog.LevelWarn,
+		LevelError: slog.LevelError,
+		LevelDebug: slog.LevelDebug,
+	}
+
+	level, ok := levels[logLevel]
+	if !ok {
+		return slog.LevelInfo, fmt.Errorf("%w: %s", ErrInvalidLogLevel, logLevel)
+	}
+
+	return level, nil
+}
+
+// NewWriterFromConfig returns an [io.Writer], which writes log events to the
+// given [io.Writer], and to the log file, if enabled in the
+// [config.LoggingConfig] spec. Syslog output is handled by [NewFromConfig],
+// since it depends on the level of each log event.
+func NewWriterFromConfig(w io.Writer, conf config.LoggingConfig) (io.Writer, error) {
+	writers := []io.Writer{w}
+
+	if conf.File.IsEnabled {
+		maxSize := int64(conf.File.MaxSize) * 1024 * 1024
+		file, err := NewRotatingFile(conf.File.Path, maxSize, conf.File.MaxAge, conf.File.MaxBackups)
+		if err != nil {
+			return nil, err
+		}
+		writers = append(writers, file)
+	}
+
+	if len(writers) == 1 {
+		return w, nil
+	}
+
+	return io.MultiWriter(writers...), nil
+}
+
 // NewFromConfig creates a new [slog.Logger] based on the provided
 // [config.LoggingConfig] spec. The returned logger outputs to the given
-// [io.Writer].
+// [io.Writer], and to syslog, if enabled.
 func NewFromConfig(w io.Writer, conf config.LoggingConfig) (*slog.Logger, error) {
 	// Defaults, if we don't have any logging settings
 	logLevel := LevelInfo
@@ -61,40 +107,176 @@ func NewFromConfig(w io.Writer, conf config.LoggingConfig) (*slog.Logger, error)
 		logFormat = LogFormat(conf.Format)
 	}
 
-	// Supported log levels
-	levels := map[LogLevel]slog.Level{
-		LevelInfo:  slog.LevelInfo,
-		LevelWarn:  slog.LevelWarn,
-		LevelError: slog.LevelError,
-		LevelDebug: slog.LevelDebug,
+	level, err := ParseLevel(logLevel)
+	if err != nil {
+		return nil, err
 	}
 
-	level, ok := levels[logLevel]
-	if !ok {
-		return nil, fmt.Errorf("%w: %s", ErrInvalidLogLevel, logLevel)
+	// Validate the per-logger overrides
+	for pattern, loggerConf := range conf.Loggers {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidLoggerPattern, pattern)
+		}
+		if loggerConf.Level != "" {
+			if _, err := ParseLevel(LogLevel(loggerConf.Level)); err != nil {
+				return nil, err
+			}
+		}
 	}
 
+	// The sinks accept all log events and the level is enforced by a
+	// [LevelHandler] on top of them, so that the level can be overridden
+	// per task, or by the callers, without creating new sinks.
 	var handler slog.Handler
 	handlerOpts := &slog.HandlerOptions{
 		AddSource: conf.AddSource,
-		Level:     level,
+		Level:     slog.LevelDebug,
 	}
 
+	var newHandler func(w io.Writer) slog.Handler
 	switch logFormat {
 	case FormatText:
-		handler = slog.NewTextHandler(w, handlerOpts)
+		newHandler = func(w io.Writer) slog.Handler {
+			return slog.NewTextHandler(w, handlerOpts)
+		}
 	case FormatJSON:
-		handler = slog.NewJSONHandler(w, handlerOpts)
+		newHandler = func(w io.Writer) slog.Handler {
+			return slog.NewJSONHandler(w, handlerOpts)
+		}
 	default:
 		return nil, fmt.Errorf("%w: %s", ErrInvalidLogFormat, logFormat)
 	}
 
+	handler = newHandler(w)
+	if conf.Syslog.IsEnabled {
+		syslogHandler, err := newSyslogHandler(conf.Syslog, newHandler)
+		if err != nil {
+			return nil, err
+		}
+		handler = slog.NewMultiHandler(handler, syslogHandler)
+	}
+
 	// Add default attributes to the logger
 	attrs := make([]slog.Attr, 0)
 	for k, v := range conf.Attributes {
 		attrs = append(attrs, slog.Any(k, v))
 	}
-	logger := slog.New(handler.WithAttrs(attrs))
+	logger := slog.New(NewLevelHandler(level, handler.WithAttrs(attrs)))
 
 	return logger, nil
 }
+
+// matchLogger returns the pattern of the rule in [config.LoggingConfig.Loggers],
+// which applies to the given task name, and true. A rule for the exact task
+// name takes precedence over the other matching patterns, and the longest one
+// of these is used otherwise. If no rule applies, then it returns an empty
+// string and false.
+func matchLogger(conf config.LoggingConfig, taskName string) (string, bool) {
+	if _, ok := conf.Loggers[taskName]; ok {
+		return taskName, true
+	}
+
+	var matched string
+	var found bool
+	for pattern := range conf.Loggers {
+		ok, err := path.Match(pattern, taskName)
+		if err != nil || !ok {
+			continue
+		}
+		if !found || len(pattern) > len(matched) || (len(pattern) == len(matched) && pattern < matched) {
+			matched = pattern
+			found = true
+		}
+	}
+
+	return matched, found
+}
+
+// GetLoggerConfig returns the [config.LoggerConfig] for the given task name,
+// by resolving the settings from [config.LoggingConfig]. The rule in
+// [config.LoggingConfig.Loggers], which applies to the task name, is applied on
+// top of the global settings. See [config.LoggingConfig.Loggers] for the
+// precedence of the rules.
+func GetLoggerConfig(conf config.LoggingConfig, taskName string) config.LoggerConfig {
+	result := config.LoggerConfig{
+		Sampling:  &conf.Sampling,
+		RateLimit: &conf.RateLimit,
+	}
+
+	pattern, ok := matchLogger(conf, taskName)
+	if !ok {
+		return result
+	}
+
+	loggerConf := conf.Loggers[pattern]
+	result.Level = loggerConf.Level
+	if loggerConf.Sampling != nil {
+		result.Sampling = loggerConf.Sampling
+	}
+	if loggerConf.RateLimit != nil {
+		result.RateLimit = loggerConf.RateLimit
+	}
+
+	return result
+}
+
+// TaskHandlers wraps the handlers of task executions with the level, sampling
+// and rate limiting settings for the task as returned by [GetLoggerConfig]. The
+// rate limiters are created once per rule, so that a rate limit applies to the
+// combined log events of all executions of the tasks matching the rule.
+type TaskHandlers struct {
+	conf     config.LoggingConfig
+	limiters map[string]*RateLimiter
+}
+
+// NewTaskHandlers returns new [TaskHandlers] based on the provided
+// [config.LoggingConfig] spec.
+func NewTaskHandlers(conf config.LoggingConfig) *TaskHandlers {
+	// The global rate limiter is keyed by the empty pattern
+	limiters := make(map[string]*RateLimiter)
+	if conf.RateLimit.IsEnabled {
+		limiters[""] = NewRateLimiter(conf.RateLimit.EventsPerSecond)
+	}
+	for pattern, loggerConf := range conf.Loggers {
+		if loggerConf.RateLimit != nil && loggerConf.RateLimit.IsEnabled {
+			limiters[pattern] = NewRateLimiter(loggerConf.RateLimit.EventsPerSecond)
+		}
+	}
+
+	return &TaskHandlers{
+		conf:     conf,
+		limiters: limiters,
+	}
+}
+
+// Wrap wraps the given [slog.Handler] of an execution of the task with the
+// given name.
+func (t *TaskHandlers) Wrap(handler slog.Handler, taskName string) slog.Handler {
+	loggerConf := GetLoggerConfig(t.conf, taskName)
+	if loggerConf.Level != "" {
+		level, err := ParseLevel(LogLevel(loggerConf.Level))
+		if err == nil {
+			handler = NewLevelHandler(level, handler)
+		}
+	}
+
+	if loggerConf.Sampling.IsEnabled {
+		handler = NewSamplingHandler(
+			loggerConf.Sampling.Initial,
+			loggerConf.Sampling.Thereafter,
+			handler,
+		)
+	}
+
+	// Rules, which do not specify a rate limit, share the global rate
+	// limiter.
+	var key string
+	if pattern, ok := matchLogger(t.conf, taskName); ok && t.conf.Loggers[pattern].RateLimit != nil {
+		key = pattern
+	}
+	if limiter, ok := t.limiters[key]; ok {
+		handler = NewSharedRateLimitHandler(limiter, handler)
+	}
+
+	return handler
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows && !plan9
+
+package slog
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"log/syslog"
+	"sync"
+
+	"github.com/gardener/inventory/pkg/core/config"
+)
+
+// syslogWriter is an [io.Writer], which sends log events to syslog with the
+// severity of the log event, which is currently being handled.
+type syslogWriter struct {
+	mu    sync.Mutex
+	w     *syslog.Writer
+	level slog.Level
+}
+
+// Write implements the [io.Writer] interface.
+func (sw *syslogWriter) Write(p []byte) (int, error) {
+	msg := string(p)
+	var err error
+	switch {
+	case sw.level >= slog.LevelError:
+		err = sw.w.Err(msg)
+	case sw.level >= slog.LevelWarn:
+		err = sw.w.Warning(msg)
+	case sw.level >= slog.LevelInfo:
+		err = sw.w.Info(msg)
+	default:
+		err = sw.w.Debug(msg)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// syslogHandler is a [slog.Handler], which formats log events using the
+// wrapped handler, and sends them to syslog with the severity matching the
+// level of each log event.
+type syslogHandler struct {
+	handler slog.Handler
+	w       *syslogWriter
+}
+
+// newSyslogHandler returns a new [slog.Handler], which sends log events to the
+// syslog daemon configured in the given [config.LogSyslogConfig]. The log
+// events are formatted by the handler returned by newHandler.
+func newSyslogHandler(conf config.LogSyslogConfig, newHandler func(w io.Writer) slog.Handler) (slog.Handler, error) {
+	w, err := syslog.Dial(conf.Network, conf.Address, syslog.LOG_INFO|syslog.LOG_DAEMON, conf.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	sw := &syslogWriter{w: w}
+	h := &syslogHandler{
+		handler: newHandler(sw),
+		w:       sw,
+	}
+
+	return h, nil
+}
+
+// Enabled implements the [slog.Handler] interface.
+func (h *syslogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+// Handle implements the [slog.Handler] interface.
+func (h *syslogHandler) Handle(ctx context.Context, r slog.Record) error {
+	// The built-in handlers write each log event at once, so the level
+	// is set for the duration of a single write.
+	h.w.mu.Lock()
+	defer h.w.mu.Unlock()
+	h.w.level = r.Level
+
+	return h.handler.Handle(ctx, r)
+}
+
+// WithAttrs implements the [slog.Handler] interface.
+func (h *syslogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &syslogHandler{handler: h.handler.WithAttrs(attrs), w: h.w}
+}
+
+// WithGroup implements the [slog.Handler] interface.
+func (h *syslogHandler) WithGroup(name string) slog.Handler {
+	return &syslogHandler{handler: h.handler.WithGroup(name), w: h.w}
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows && !plan9
+
+package slog_test
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gardener/inventory/pkg/core/config"
+	slogutils "github.com/gardener/inventory/pkg/utils/slog"
+)
+
+func TestSyslogSeverity(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen: %s", err)
+	}
+	defer conn.Close() // nolint: errcheck
+
+	conf := config.LoggingConfig{
+		Level: "debug",
+		Syslog: config.LogSyslogConfig{
+			IsEnabled: true,
+			Network:   "udp",
+			Address:   conn.LocalAddr().String(),
+			Tag:       "inventory",
+		},
+	}
+
+	var buf bytes.Buffer
+	logger, err := slogutils.NewFromConfig(&buf, conf)
+	if err != nil {
+		t.Fatalf("unable to create logger: %s", err)
+	}
+
+	// The priority is the facility (LOG_DAEMON) times 8 plus the
+	// severity of the log event.
+	testCases := []struct {
+		log      func(msg string, args ...any)
+		msg      string
+		priority int
+	}{
+		{log: logger.Error, msg: "error event", priority: 3*8 + 3},
+		{log: logger.Warn, msg: "warn event", priority: 3*8 + 4},
+		{log: logger.Info, msg: "info event", priority: 3*8 + 6},
+		{log: logger.Debug, msg: "debug event", priority: 3*8 + 7},
+	}
+
+	packet := make([]byte, 4096)
+	for _, tc := range testCases {
+		tc.log(tc.msg)
+
+		if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+			t.Fatalf("unable to set deadline: %s", err)
+		}
+		n, _, err := conn.ReadFrom(packet)
+		if err != nil {
+			t.Fatalf("unable to read syslog message: %s", err)
+		}
+
+		got := string(packet[:n])
+		prefix := fmt.Sprintf("<%d>", tc.priority)
+		if !strings.HasPrefix(got, prefix) || !strings.Contains(got, tc.msg) {
+			t.Fatalf("got syslog message %q, wanted priority %s and message %q", got, prefix, tc.msg)
+		}
+	}
+
+	if !strings.Contains(buf.String(), "debug event") {
+		t.Fatalf("got output %q, wanted it to contain the log events", buf.String())
+	}
+}
@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows || plan9
+
+package slog
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+
+	"github.com/gardener/inventory/pkg/core/config"
+)
+
+// newSyslogHandler returns an error, since syslog is not supported on this
+// platform.
+func newSyslogHandler(_ config.LogSyslogConfig, _ func(w io.Writer) slog.Handler) (slog.Handler, error) {
+	return nil, errors.New("syslog is not supported on this platform")
+}
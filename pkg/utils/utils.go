@@ -4,6 +4,19 @@
 
 package utils
 
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrInvalidJWT is an error, which is returned when a JSON Web Token cannot
+// be parsed.
+var ErrInvalidJWT = errors.New("invalid JWT")
+
 // GroupBy groups the given slice of items using a function which provides a
 // key, based on which the items will be grouped.
 func GroupBy[K comparable, V any](items []V, keyFunc func(item V) K) map[K][]V {
@@ -15,3 +28,30 @@ func GroupBy[K comparable, V any](items []V, keyFunc func(item V) K) map[K][]V {
 
 	return result
 }
+
+// JWTExpiration returns the expiration time of the given JSON Web Token, as
+// specified by its `exp' claim. The signature of the token is not verified.
+func JWTExpiration(token string) (time.Time, error) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 {
+		return time.Time{}, ErrInvalidJWT
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %w", ErrInvalidJWT, err)
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, fmt.Errorf("%w: %w", ErrInvalidJWT, err)
+	}
+
+	if claims.Exp == 0 {
+		return time.Time{}, fmt.Errorf("%w: no exp claim", ErrInvalidJWT)
+	}
+
+	return time.Unix(claims.Exp, 0).UTC(), nil
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gardener/inventory/pkg/utils"
+)
+
+// newJWT returns an unsigned JSON Web Token with the given payload.
+func newJWT(payload string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	body := base64.RawURLEncoding.EncodeToString([]byte(payload))
+
+	return header + "." + body + ".signature"
+}
+
+func TestJWTExpiration(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		token   string
+		wanted  time.Time
+		wantErr error
+	}{
+		{
+			desc:   "valid token",
+			token:  newJWT(`{"sub":"inventory","exp":1767268800}`),
+			wanted: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			desc:   "surrounding whitespace",
+			token:  "  " + newJWT(`{"exp":1767268800}`) + "\n",
+			wanted: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			desc:   "expired token",
+			token:  newJWT(`{"exp":946728000}`),
+			wanted: time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			desc:    "missing exp claim",
+			token:   newJWT(`{"sub":"inventory"}`),
+			wantErr: utils.ErrInvalidJWT,
+		},
+		{
+			desc:    "invalid exp claim",
+			token:   newJWT(`{"exp":"tomorrow"}`),
+			wantErr: utils.ErrInvalidJWT,
+		},
+		{
+			desc:    "invalid payload encoding",
+			token:   "header.!!!.signature",
+			wantErr: utils.ErrInvalidJWT,
+		},
+		{
+			desc:    "invalid payload",
+			token:   "header." + base64.RawURLEncoding.EncodeToString([]byte("not-json")) + ".signature",
+			wantErr: utils.ErrInvalidJWT,
+		},
+		{
+			desc:    "missing signature",
+			token:   "header.payload",
+			wantErr: utils.ErrInvalidJWT,
+		},
+		{
+			desc:    "empty token",
+			token:   "",
+			wantErr: utils.ErrInvalidJWT,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := utils.JWTExpiration(tc.token)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("got error %v, wanted %v", err, tc.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !got.Equal(tc.wanted) {
+				t.Fatalf("got expiration %s, wanted %s", got, tc.wanted)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	vault "github.com/hashicorp/vault/api"
@@ -56,6 +57,36 @@ type Client struct {
 	*vault.Client
 
 	am vault.AuthMethod
+
+	// mu guards tokenExpiresAt
+	mu sync.RWMutex
+
+	// tokenExpiresAt specifies when the managed auth token expires.
+	tokenExpiresAt time.Time
+}
+
+// TokenExpiration returns the time when the auth token managed by
+// [Client.ManageAuthTokenLifetime] expires. The returned bool is false, if the
+// token lifetime is not managed, or the token does not expire.
+func (c *Client) TokenExpiration() (time.Time, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.tokenExpiresAt, !c.tokenExpiresAt.IsZero()
+}
+
+// setTokenExpiration records the expiration time of the auth token based on
+// the given TTL.
+func (c *Client) setTokenExpiration(ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if ttl <= 0 {
+		c.tokenExpiresAt = time.Time{}
+
+		return
+	}
+	c.tokenExpiresAt = time.Now().Add(ttl)
 }
 
 // ManageAuthTokenLifetime starts managing the auth token lifetime.
@@ -90,6 +121,7 @@ func (c *Client) ManageAuthTokenLifetime(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	c.setTokenExpiration(ttl)
 
 	isRenewable, err := authInfo.TokenIsRenewable()
 	if err != nil {
@@ -190,6 +222,7 @@ func (c *Client) renewPeriodically(ctx context.Context, duration time.Duration)
 
 				continue
 			}
+			c.setTokenExpiration(ttl)
 
 			if ttl <= 0 {
 				slog.Warn(
@@ -246,6 +279,7 @@ func (c *Client) reAuthPeriodically(ctx context.Context, duration time.Duration)
 
 				continue
 			}
+			c.setTokenExpiration(ttl)
 
 			if ttl <= 0 {
 				slog.Warn(